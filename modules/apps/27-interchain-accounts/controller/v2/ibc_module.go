@@ -0,0 +1,84 @@
+package v2
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
+	"github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/api"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+)
+
+var _ api.IBCModule = (*IBCModule)(nil)
+
+// IBCModule implements the IBC v2 callbacks of the interchain accounts controller submodule.
+// Interchain accounts over IBC v2 do not require a channel handshake: the sender of a packet controls
+// the interchain accounts derived from the destination client, its address and the salt of the packet.
+type IBCModule struct {
+	keeper keeper.Keeper
+}
+
+// NewIBCModule creates a new IBCModule given the associated keeper
+func NewIBCModule(k keeper.Keeper) *IBCModule {
+	return &IBCModule{
+		keeper: k,
+	}
+}
+
+// OnSendPacket implements the IBCModule interface. It ensures the packet is sent by the
+// controller of the interchain account.
+func (im *IBCModule) OnSendPacket(ctx sdk.Context, _ string, _ string, _ uint64, payload channeltypesv2.Payload, signer sdk.AccAddress) error {
+	if !im.keeper.GetParams(ctx).ControllerEnabled {
+		return types.ErrControllerSubModuleDisabled
+	}
+
+	if payload.SourcePort != icatypes.ControllerPortID || payload.DestinationPort != icatypes.HostPortID {
+		return errorsmod.Wrapf(icatypes.ErrInvalidPayload, "expected source port %s and destination port %s, got %s and %s", icatypes.ControllerPortID, icatypes.HostPortID, payload.SourcePort, payload.DestinationPort)
+	}
+
+	data, err := icatypes.UnmarshalPacketDataV2(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return err
+	}
+
+	if data.Sender != signer.String() {
+		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "sender %s is different from signer %s", data.Sender, signer)
+	}
+
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface. A controller does not receive packets.
+func (*IBCModule) OnRecvPacket(_ sdk.Context, _ string, _ string, _ uint64, _ channeltypesv2.Payload, _ sdk.AccAddress) channeltypesv2.RecvPacketResult {
+	return channeltypesv2.RecvPacketResult{
+		Status: channeltypesv2.PacketStatus_Failure,
+	}
+}
+
+// OnTimeoutPacket implements the IBCModule interface
+func (im *IBCModule) OnTimeoutPacket(ctx sdk.Context, _ string, _ string, _ uint64, _ channeltypesv2.Payload, _ sdk.AccAddress) error {
+	if !im.keeper.GetParams(ctx).ControllerEnabled {
+		return types.ErrControllerSubModuleDisabled
+	}
+
+	return nil
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface
+func (im *IBCModule) OnAcknowledgementPacket(ctx sdk.Context, _ string, _ string, _ uint64, _ []byte, _ channeltypesv2.Payload, _ sdk.AccAddress) error {
+	if !im.keeper.GetParams(ctx).ControllerEnabled {
+		return types.ErrControllerSubModuleDisabled
+	}
+
+	return nil
+}
+
+// UnmarshalPacketData unmarshals the interchain accounts packet data based on the version and encoding
+// it implements the PacketDataUnmarshaler interface
+func (*IBCModule) UnmarshalPacketData(payload channeltypesv2.Payload) (any, error) {
+	return icatypes.UnmarshalPacketDataV2(payload.Value, payload.Version, payload.Encoding)
+}
@@ -0,0 +1,128 @@
+package v2_test
+
+import (
+	"testing"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+type InterchainAccountsTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+func (suite *InterchainAccountsTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.SetupV2()
+}
+
+func TestInterchainAccountsTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(InterchainAccountsTestSuite))
+}
+
+func (suite *InterchainAccountsTestSuite) TestOnSendPacket() {
+	var (
+		data    icatypes.InterchainAccountPacketDataV2
+		payload channeltypesv2.Payload
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: controller submodule is disabled",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false))
+			},
+			types.ErrControllerSubModuleDisabled,
+		},
+		{
+			"failure: invalid source port",
+			func() {
+				payload.SourcePort = icatypes.ControllerPortPrefix + "owner"
+			},
+			icatypes.ErrInvalidPayload,
+		},
+		{
+			"failure: invalid version",
+			func() {
+				payload.Version = "ics27-2"
+			},
+			icatypes.ErrInvalidVersion,
+		},
+		{
+			"failure: unsupported encoding",
+			func() {
+				payload.Encoding = "application/x-solidity-abi"
+			},
+			icatypes.ErrInvalidCodec,
+		},
+		{
+			"failure: sender is not the signer",
+			func() {
+				data.Sender = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+				payload.Value = icatypes.ModuleCdc.MustMarshal(&data)
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			interchainAccountAddr := icatypes.GenerateAddressV2(suite.path.EndpointB.ClientID, suite.chainA.SenderAccount.GetAddress().String(), "")
+			msg := disttypes.NewMsgSetWithdrawAddress(interchainAccountAddr, suite.chainB.SenderAccount.GetAddress())
+			txBz, err := icatypes.SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), []sdk.Msg{msg}, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			data = icatypes.NewInterchainAccountPacketDataV2(suite.chainA.SenderAccount.GetAddress().String(), "", icatypes.EXECUTE_TX, txBz, "")
+			payload = channeltypesv2.NewPayload(icatypes.ControllerPortID, icatypes.HostPortID, icatypes.Version, icatypes.EncodingProto, icatypes.ModuleCdc.MustMarshal(&data))
+
+			tc.malleate()
+
+			cbs := suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.Router.Route(icatypes.ControllerPortID)
+			err = cbs.OnSendPacket(suite.chainA.GetContext(), suite.path.EndpointA.ClientID, suite.path.EndpointA.Counterparty.ClientID, 1, payload, suite.chainA.SenderAccount.GetAddress())
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
+	cbs := suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.Router.Route(icatypes.ControllerPortID)
+	payload := channeltypesv2.NewPayload(icatypes.HostPortID, icatypes.ControllerPortID, icatypes.Version, icatypes.EncodingProto, []byte("data"))
+
+	res := cbs.OnRecvPacket(suite.chainA.GetContext(), suite.path.EndpointA.Counterparty.ClientID, suite.path.EndpointA.ClientID, 1, payload, suite.chainA.SenderAccount.GetAddress())
+	suite.Require().Equal(channeltypesv2.PacketStatus_Failure, res.Status)
+}
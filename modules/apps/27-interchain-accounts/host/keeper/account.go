@@ -33,3 +33,31 @@ func (k Keeper) createInterchainAccount(ctx sdk.Context, connectionID, controlle
 
 	return accAddress, nil
 }
+
+// getOrCreateInterchainAccountV2 returns the address of the interchain account controlled over IBC v2 by the given sender
+// through the given host client. The address is derived deterministically and the interchain account is created if it
+// does not exist yet. An existing base account which has never signed a transaction, for example one created by sending
+// funds to the derived address ahead of the first packet, is converted into an interchain account.
+func (k Keeper) getOrCreateInterchainAccountV2(ctx sdk.Context, clientID, sender, salt string) (sdk.AccAddress, error) {
+	accAddress := icatypes.GenerateAddressV2(clientID, sender, salt)
+
+	switch acc := k.accountKeeper.GetAccount(ctx, accAddress).(type) {
+	case nil:
+		interchainAccount := icatypes.NewInterchainAccount(authtypes.NewBaseAccountWithAddress(accAddress), sender)
+		k.accountKeeper.NewAccount(ctx, interchainAccount)
+		k.accountKeeper.SetAccount(ctx, interchainAccount)
+	case *icatypes.InterchainAccount:
+		// the interchain account has been created by a previous packet
+	case *authtypes.BaseAccount:
+		if acc.GetPubKey() != nil || acc.GetSequence() != 0 {
+			return nil, errorsmod.Wrapf(icatypes.ErrAccountAlreadyExist, "existing account for interchain account address %s", accAddress)
+		}
+
+		interchainAccount := icatypes.NewInterchainAccount(acc, sender)
+		k.accountKeeper.SetAccount(ctx, interchainAccount)
+	default:
+		return nil, errorsmod.Wrapf(icatypes.ErrAccountAlreadyExist, "existing account of type %T for interchain account address %s", acc, accAddress)
+	}
+
+	return accAddress, nil
+}
@@ -43,3 +43,23 @@ func EmitHostDisabledEvent(ctx sdk.Context, packet channeltypes.Packet) {
 		),
 	)
 }
+
+// EmitAcknowledgementEventV2 emits an event signalling a successful or failed acknowledgement of an IBC v2 packet
+// received on the given host client and including the error details if any.
+func EmitAcknowledgementEventV2(ctx sdk.Context, destinationClient string, success bool, err error) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+		sdk.NewAttribute(icatypes.AttributeKeyHostClientID, destinationClient),
+		sdk.NewAttribute(icatypes.AttributeKeyAckSuccess, strconv.FormatBool(success)),
+	}
+
+	if err != nil {
+		attributes = append(attributes, sdk.NewAttribute(icatypes.AttributeKeyAckError, err.Error()))
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypePacket,
+			attributes...,
+		),
+	)
+}
@@ -5,12 +5,6 @@ import (
 	"fmt"
 	"strings"
 
-	gogoproto "github.com/cosmos/gogoproto/proto"
-	"google.golang.org/protobuf/proto"
-	"google.golang.org/protobuf/reflect/protoreflect"
-
-	msgv1 "cosmossdk.io/api/cosmos/msg/v1"
-	queryv1 "cosmossdk.io/api/cosmos/query/v1"
 	corestore "cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
@@ -23,6 +17,7 @@ import (
 	genesistypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/genesis/types"
 	"github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	"github.com/cosmos/ibc-go/v10/modules/apps/internal/querysafe"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
 	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
@@ -45,6 +40,9 @@ type Keeper struct {
 	// mqsAllowList is a list of all module safe query paths
 	mqsAllowList []string
 
+	// executeTxCallback is an optional callback which validates or observes executed transactions
+	executeTxCallback types.ExecuteTxCallback
+
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
@@ -91,6 +89,18 @@ func (k Keeper) GetICS4Wrapper() porttypes.ICS4Wrapper {
 	return k.ics4Wrapper
 }
 
+// WithExecuteTxCallback sets the callback which is called for every transaction executed by an
+// interchain account. This function must be called before the keeper is provided to the host
+// IBC modules.
+func (k *Keeper) WithExecuteTxCallback(callback types.ExecuteTxCallback) {
+	k.executeTxCallback = callback
+}
+
+// GetExecuteTxCallback returns the ExecuteTxCallback.
+func (k Keeper) GetExecuteTxCallback() types.ExecuteTxCallback {
+	return k.executeTxCallback
+}
+
 // Logger returns the application logger, scoped to the associated module
 func (Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s-%s", exported.ModuleName, icatypes.ModuleName))
@@ -264,38 +274,5 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 
 // newModuleQuerySafeAllowList returns a list of all query paths labeled with module_query_safe in the proto files.
 func newModuleQuerySafeAllowList() []string {
-	allowList := []string{}
-	gogoproto.GogoResolver.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
-		for i := range fd.Services().Len() {
-			// Get the service descriptor
-			sd := fd.Services().Get(i)
-
-			// Skip services that are annotated with the "cosmos.msg.v1.service" option.
-			if ext := proto.GetExtension(sd.Options(), msgv1.E_Service); ext != nil {
-				val, ok := ext.(bool)
-				if !ok {
-					panic(fmt.Errorf("cannot convert %T to %T", ext, ok))
-				}
-				if val {
-					continue
-				}
-			}
-
-			for j := range sd.Methods().Len() {
-				// Get the method descriptor
-				md := sd.Methods().Get(j)
-
-				// Skip methods that are not annotated with the "cosmos.query.v1.module_query_safe" option.
-				if ext := proto.GetExtension(md.Options(), queryv1.E_ModuleQuerySafe); ext == nil || !ext.(bool) {
-					continue
-				}
-
-				// Add the method to the whitelist
-				allowList = append(allowList, fmt.Sprintf("/%s/%s", sd.FullName(), md.Name()))
-			}
-		}
-		return true
-	})
-
-	return allowList
+	return querysafe.NewAllowList()
 }
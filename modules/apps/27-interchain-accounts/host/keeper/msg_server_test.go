@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
 )
 
 func (suite *KeeperTestSuite) TestModuleQuerySafe() {
@@ -151,6 +152,17 @@ func (suite *KeeperTestSuite) TestModuleQuerySafe() {
 }
 
 func (suite *KeeperTestSuite) TestUpdateParams() {
+	paramsWithConstraints := types.DefaultParams()
+	paramsWithConstraints.MessageConstraints = []types.MessageConstraint{
+		{
+			TypeUrl:          sdk.MsgTypeURL(&banktypes.MsgSend{}),
+			ConnectionId:     ibctesting.FirstConnectionID,
+			AllowedDenoms:    []string{sdk.DefaultBondDenom},
+			MaxAmounts:       sdk.NewCoins(ibctesting.TestCoin),
+			AllowedAddresses: []string{TestOwnerAddress},
+		},
+	}
+
 	testCases := []struct {
 		name   string
 		msg    *types.MsgUpdateParams
@@ -161,6 +173,11 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 			types.NewMsgUpdateParams(suite.chainA.GetSimApp().ICAHostKeeper.GetAuthority(), types.DefaultParams()),
 			nil,
 		},
+		{
+			"success: message constraints",
+			types.NewMsgUpdateParams(suite.chainA.GetSimApp().ICAHostKeeper.GetAuthority(), paramsWithConstraints),
+			nil,
+		},
 		{
 			"invalid signer address",
 			types.NewMsgUpdateParams("signer", types.DefaultParams()),
@@ -179,6 +196,7 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 			if tc.expErr == nil {
 				suite.Require().NoError(err)
 				suite.Require().NotNil(res)
+				suite.Require().Equal(tc.msg.Params, suite.chainA.GetSimApp().ICAHostKeeper.GetParams(ctx))
 			} else {
 				suite.Require().ErrorIs(err, tc.expErr)
 				suite.Require().Nil(res)
@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
 	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
 )
 
@@ -36,7 +37,55 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byt
 			return nil, errorsmod.Wrapf(err, "failed to deserialize interchain account transaction")
 		}
 
-		txResponse, err := k.executeTx(ctx, packet.SourcePort, packet.DestinationPort, packet.DestinationChannel, msgs)
+		channel, found := k.channelKeeper.GetChannel(ctx, packet.DestinationPort, packet.DestinationChannel)
+		if !found {
+			return nil, channeltypes.ErrChannelNotFound
+		}
+
+		interchainAccountAddr, found := k.GetInterchainAccountAddress(ctx, channel.ConnectionHops[0], packet.SourcePort)
+		if !found {
+			return nil, errorsmod.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on port %s", packet.SourcePort)
+		}
+
+		owner := data.GetPacketSender(packet.SourcePort)
+		txResponse, err := k.executeTx(ctx, channel.ConnectionHops[0], owner, interchainAccountAddr, data.Memo, msgs)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to execute interchain account transaction")
+		}
+		return txResponse, nil
+	default:
+		return nil, icatypes.ErrUnknownDataType
+	}
+}
+
+// OnRecvPacketV2 handles a given interchain accounts IBC v2 payload on a destination host chain.
+// The interchain account is derived from the destination client, the packet sender and the salt, and is
+// created on the first packet it receives. If the transaction is successfully executed, the transaction
+// response bytes will be returned.
+func (k Keeper) OnRecvPacketV2(ctx sdk.Context, destinationClient string, payload channeltypesv2.Payload) ([]byte, error) {
+	data, err := icatypes.UnmarshalPacketDataV2(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return nil, err
+	}
+
+	switch data.Type {
+	case icatypes.EXECUTE_TX:
+		encoding, err := icatypes.CosmosTxEncoding(payload.Encoding)
+		if err != nil {
+			return nil, err
+		}
+
+		msgs, err := icatypes.DeserializeCosmosTx(k.cdc, data.Data, encoding)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to deserialize interchain account transaction")
+		}
+
+		interchainAccountAddr, err := k.getOrCreateInterchainAccountV2(ctx, destinationClient, data.Sender, data.Salt)
+		if err != nil {
+			return nil, err
+		}
+
+		txResponse, err := k.executeTx(ctx, destinationClient, data.Sender, interchainAccountAddr.String(), data.Memo, msgs)
 		if err != nil {
 			return nil, errorsmod.Wrapf(err, "failed to execute interchain account transaction")
 		}
@@ -50,13 +99,9 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byt
 // If authentication succeeds, it does basic validation of the messages before attempting to deliver each message
 // into state. The state changes will only be committed if all messages in the transaction succeed. Thus the
 // execution of the transaction is atomic, all state changes are reverted if a single message fails.
-func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel string, msgs []sdk.Msg) ([]byte, error) {
-	channel, found := k.channelKeeper.GetChannel(ctx, destPort, destChannel)
-	if !found {
-		return nil, channeltypes.ErrChannelNotFound
-	}
-
-	if err := k.authenticateTx(ctx, msgs, channel.ConnectionHops[0], sourcePort); err != nil {
+// If an ExecuteTxCallback is set, it is called before the state changes are committed and may reject the transaction.
+func (k Keeper) executeTx(ctx sdk.Context, hostConnectionOrClientID, owner, interchainAccountAddr, memo string, msgs []sdk.Msg) ([]byte, error) {
+	if err := k.authenticateTx(ctx, msgs, hostConnectionOrClientID, interchainAccountAddr); err != nil {
 		return nil, err
 	}
 
@@ -82,6 +127,20 @@ func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel str
 		txMsgData.MsgResponses[i] = protoAny
 	}
 
+	if k.executeTxCallback != nil {
+		tx := types.InterchainAccountTx{
+			HostConnectionOrClientID: hostConnectionOrClientID,
+			Owner:                    owner,
+			InterchainAccountAddress: interchainAccountAddr,
+			Memo:                     memo,
+			Msgs:                     msgs,
+			MsgResponses:             txMsgData.MsgResponses,
+		}
+		if err := k.executeTxCallback.OnExecuteTx(cacheCtx, tx); err != nil {
+			return nil, errorsmod.Wrapf(types.ErrTxRejected, "%v", err)
+		}
+	}
+
 	writeCache()
 
 	txResponse, err := proto.Marshal(txMsgData)
@@ -92,20 +151,30 @@ func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel str
 	return txResponse, nil
 }
 
-// authenticateTx ensures the provided msgs contain the correct interchain account signer address retrieved
-// from state using the provided controller port identifier
-func (k Keeper) authenticateTx(ctx sdk.Context, msgs []sdk.Msg, connectionID, portID string) error {
-	interchainAccountAddr, found := k.GetInterchainAccountAddress(ctx, connectionID, portID)
-	if !found {
-		return errorsmod.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on port %s", portID)
-	}
+// authenticateTx ensures the provided msgs are allowed by the host params, satisfy the message constraints which
+// apply to the interchain accounts of the provided connection, including the messages packed in them, and are signed
+// by the provided interchain account address
+func (k Keeper) authenticateTx(ctx sdk.Context, msgs []sdk.Msg, connectionID, interchainAccountAddr string) error {
+	params := k.GetParams(ctx)
 
-	allowMsgs := k.GetParams(ctx).AllowMessages
+	// the max amounts of the message constraints apply to all messages of the tx
+	constraintsChecker := types.NewTxConstraintsChecker(params.MessageConstraints, connectionID, interchainAccountAddr)
 	for _, msg := range msgs {
-		if !types.ContainsMsgType(allowMsgs, msg) {
+		if !types.ContainsMsgType(params.AllowMessages, msg) {
 			return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "message type not allowed: %s", sdk.MsgTypeURL(msg))
 		}
 
+		if len(params.MessageConstraints) != 0 {
+			msgJSON, err := k.cdc.MarshalJSON(msg)
+			if err != nil {
+				return errorsmod.Wrapf(err, "failed to marshal message type %s", sdk.MsgTypeURL(msg))
+			}
+
+			if err := constraintsChecker.CheckMessage(sdk.MsgTypeURL(msg), msgJSON); err != nil {
+				return err
+			}
+		}
+
 		// obtain the message signers using the proto signer annotations
 		// the msgv2 return value is discarded as it is not used
 		signers, _, err := k.cdc.GetMsgV1Signers(msg)
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -12,6 +13,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
@@ -21,6 +24,7 @@ import (
 	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
 	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
 	ibctesting "github.com/cosmos/ibc-go/v10/testing"
 )
@@ -877,6 +881,327 @@ func (suite *KeeperTestSuite) TestJSONOnRecvPacket() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestOnRecvPacketMessageConstraints() {
+	var (
+		msg    *banktypes.MsgSend
+		msgs   []proto.Message
+		params types.Params
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: no message constraints",
+			func() {},
+			nil,
+		},
+		{
+			"success: global constraint satisfied",
+			func() {
+				params.MessageConstraints = []types.MessageConstraint{
+					{TypeUrl: sdk.MsgTypeURL(msg), AllowedDenoms: []string{sdk.DefaultBondDenom}, AllowedAddresses: []string{msg.ToAddress}},
+				}
+			},
+			nil,
+		},
+		{
+			"success: constraint scoped to the connection takes precedence over the global constraint",
+			func() {
+				params.MessageConstraints = []types.MessageConstraint{
+					{TypeUrl: sdk.MsgTypeURL(msg), AllowedDenoms: []string{"atom"}},
+					{TypeUrl: sdk.MsgTypeURL(msg), ConnectionId: ibctesting.FirstConnectionID, AllowedDenoms: []string{sdk.DefaultBondDenom}},
+				}
+			},
+			nil,
+		},
+		{
+			"success: constraint scoped to another connection does not apply",
+			func() {
+				params.MessageConstraints = []types.MessageConstraint{
+					{TypeUrl: sdk.MsgTypeURL(msg), ConnectionId: ibctesting.SecondConnectionID, AllowedDenoms: []string{"atom"}},
+				}
+			},
+			nil,
+		},
+		{
+			"failure: global constraint not satisfied",
+			func() {
+				params.MessageConstraints = []types.MessageConstraint{
+					{TypeUrl: sdk.MsgTypeURL(msg), MaxAmounts: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, ibctesting.TestCoin.Amount.SubRaw(1)))},
+				}
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: total amount of the messages of the tx exceeds max amount",
+			func() {
+				msgs = []proto.Message{msg, msg}
+
+				params.MessageConstraints = []types.MessageConstraint{
+					{TypeUrl: sdk.MsgTypeURL(msg), MaxAmounts: sdk.NewCoins(ibctesting.TestCoin)},
+				}
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: constraint scoped to the connection not satisfied",
+			func() {
+				params.MessageConstraints = []types.MessageConstraint{
+					{TypeUrl: sdk.MsgTypeURL(msg)},
+					{
+						TypeUrl:       sdk.MsgTypeURL(msg),
+						ConnectionId:  ibctesting.FirstConnectionID,
+						FieldMatchers: []types.FieldMatcher{{Path: "to_address", Values: []string{TestOwnerAddress}}},
+					},
+				}
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"success: constraint of message executed by MsgExec satisfied",
+			func() {
+				msgExec := authz.NewMsgExec(sdk.MustAccAddressFromBech32(msg.FromAddress), []sdk.Msg{msg})
+				msgs = []proto.Message{&msgExec}
+
+				params.AllowMessages = append(params.AllowMessages, sdk.MsgTypeURL(&msgExec))
+				params.MessageConstraints = []types.MessageConstraint{
+					{TypeUrl: sdk.MsgTypeURL(msg), AllowedAddresses: []string{msg.ToAddress}},
+				}
+			},
+			nil,
+		},
+		{
+			"failure: constraint of message executed by MsgExec not satisfied",
+			func() {
+				msgExec := authz.NewMsgExec(sdk.MustAccAddressFromBech32(msg.FromAddress), []sdk.Msg{msg})
+				msgs = []proto.Message{&msgExec}
+
+				params.AllowMessages = append(params.AllowMessages, sdk.MsgTypeURL(&msgExec))
+				params.MessageConstraints = []types.MessageConstraint{
+					{TypeUrl: sdk.MsgTypeURL(msg), AllowedDenoms: []string{"atom"}},
+				}
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := NewICAPath(suite.chainA, suite.chainB, icatypes.EncodingProtobuf, channeltypes.ORDERED)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(ibctesting.TestCoin))
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			msg = &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+				Amount:      sdk.NewCoins(ibctesting.TestCoin),
+			}
+			msgs = []proto.Message{msg}
+			params = types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+
+			tc.malleate()
+
+			suite.Require().NoError(params.Validate())
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), msgs, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				suite.chainB.GetTimeoutHeight(),
+				0,
+			)
+
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(txResponse)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(txResponse)
+			}
+		})
+	}
+}
+
+// mockExecuteTxCallback records the executed interchain account transactions and returns err.
+type mockExecuteTxCallback struct {
+	txs []types.InterchainAccountTx
+	err error
+
+	// recipientBalance is the balance of the recipient observed by the callback
+	recipientBalance sdk.Coin
+	bankKeeper       bankkeeper.Keeper
+}
+
+func (cb *mockExecuteTxCallback) OnExecuteTx(cachedCtx sdk.Context, tx types.InterchainAccountTx) error {
+	cb.txs = append(cb.txs, tx)
+
+	msgSend, ok := tx.Msgs[0].(*banktypes.MsgSend)
+	if ok {
+		cb.recipientBalance = cb.bankKeeper.GetBalance(cachedCtx, sdk.MustAccAddressFromBech32(msgSend.ToAddress), sdk.DefaultBondDenom)
+	}
+
+	return cb.err
+}
+
+func (suite *KeeperTestSuite) TestExecuteTxCallback() {
+	var callback *mockExecuteTxCallback
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		v2       bool
+		expErr   error
+	}{
+		{
+			"success: callback observes executed transaction",
+			func() {},
+			false,
+			nil,
+		},
+		{
+			"success: callback observes transaction executed over IBC v2",
+			func() {},
+			true,
+			nil,
+		},
+		{
+			"failure: callback rejects transaction",
+			func() {
+				callback.err = errors.New("transaction not compliant")
+			},
+			false,
+			types.ErrTxRejected,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := NewICAPath(suite.chainA, suite.chainB, icatypes.EncodingProtobuf, channeltypes.ORDERED)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			var hostID, interchainAccountAddr string
+			if tc.v2 {
+				// IBC v2 interchain accounts are derived from the host client, the sender and the salt
+				hostID = ibctesting.FirstClientID
+				interchainAccountAddr = icatypes.GenerateAddressV2(hostID, TestOwnerAddress, "salt").String()
+
+				res, err := suite.chainB.SendMsgs(banktypes.NewMsgSend(suite.chainB.SenderAccount.GetAddress(), sdk.MustAccAddressFromBech32(interchainAccountAddr), sdk.NewCoins(ibctesting.TestCoin)))
+				suite.Require().NotEmpty(res)
+				suite.Require().NoError(err)
+			} else {
+				hostID = ibctesting.FirstConnectionID
+				suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(ibctesting.TestCoin))
+
+				var found bool
+				interchainAccountAddr, found = suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+			}
+
+			recipient := suite.chainB.SenderAccount.GetAddress()
+			msg := &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   recipient.String(),
+				Amount:      sdk.NewCoins(ibctesting.TestCoin),
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+				Memo: "memo",
+			}
+
+			params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			callback = &mockExecuteTxCallback{bankKeeper: suite.chainB.GetSimApp().BankKeeper}
+			suite.chainB.GetSimApp().ICAHostKeeper.WithExecuteTxCallback(callback)
+			suite.Require().Equal(callback, suite.chainB.GetSimApp().ICAHostKeeper.GetExecuteTxCallback())
+
+			tc.malleate()
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				suite.chainB.GetTimeoutHeight(),
+				0,
+			)
+
+			ctx := suite.chainB.GetContext()
+			balanceBefore := suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, recipient, sdk.DefaultBondDenom)
+
+			var txResponse []byte
+			if tc.v2 {
+				packetData := icatypes.NewInterchainAccountPacketDataV2(TestOwnerAddress, "salt", icatypes.EXECUTE_TX, data, "memo")
+				payload := channeltypesv2.NewPayload(icatypes.ControllerPortID, icatypes.HostPortID, icatypes.Version, icatypes.EncodingProto, icatypes.ModuleCdc.MustMarshal(&packetData))
+				txResponse, err = suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacketV2(ctx, hostID, payload)
+			} else {
+				txResponse, err = suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet)
+			}
+
+			// the callback is provided with the executed transaction and observes its state changes
+			suite.Require().Len(callback.txs, 1)
+			tx := callback.txs[0]
+			suite.Require().Equal(hostID, tx.HostConnectionOrClientID)
+			suite.Require().Equal(TestOwnerAddress, tx.Owner)
+			suite.Require().Equal(interchainAccountAddr, tx.InterchainAccountAddress)
+			suite.Require().Equal("memo", tx.Memo)
+			suite.Require().Len(tx.Msgs, 1)
+			suite.Require().Len(tx.MsgResponses, 1)
+			suite.Require().Equal(sdk.MsgTypeURL(&banktypes.MsgSendResponse{}), tx.MsgResponses[0].TypeUrl)
+			suite.Require().Equal(balanceBefore.Add(ibctesting.TestCoin), callback.recipientBalance)
+
+			balanceAfter := suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, recipient, sdk.DefaultBondDenom)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(txResponse)
+				suite.Require().Equal(balanceBefore.Add(ibctesting.TestCoin), balanceAfter)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(txResponse)
+				// the state changes of the transaction are reverted
+				suite.Require().Equal(balanceBefore, balanceAfter)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) fundICAWallet(ctx sdk.Context, portID string, amount sdk.Coins) {
 	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(ctx, ibctesting.FirstConnectionID, portID)
 	suite.Require().True(found)
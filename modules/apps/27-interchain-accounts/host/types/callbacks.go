@@ -0,0 +1,34 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InterchainAccountTx contains an interchain account transaction executed by the host.
+type InterchainAccountTx struct {
+	// HostConnectionOrClientID is the host connection identifier of the channel the packet was received on for
+	// IBC v1 channels, and the host client identifier the packet was received on for IBC v2 packets.
+	HostConnectionOrClientID string
+	// Owner is the owner of the interchain account on the controller chain.
+	Owner string
+	// InterchainAccountAddress is the address of the interchain account which executed the transaction.
+	InterchainAccountAddress string
+	// Memo is the memo of the interchain account packet data.
+	Memo string
+	// Msgs are the messages executed by the interchain account.
+	Msgs []sdk.Msg
+	// MsgResponses are the responses of the executed messages, in the same order as Msgs.
+	MsgResponses []*codectypes.Any
+}
+
+// ExecuteTxCallback defines the callback which a module of the host chain may implement to validate or observe
+// the transactions executed by interchain accounts.
+type ExecuteTxCallback interface {
+	// OnExecuteTx is called after all messages of an interchain account transaction have been executed, and before
+	// the state changes of the transaction are committed. The provided context holds the state changes of the
+	// transaction, and the state changes made by the callback are committed together with them.
+	// If an error is returned, all state changes of the transaction are reverted and an error acknowledgement
+	// is written.
+	OnExecuteTx(cachedCtx sdk.Context, tx InterchainAccountTx) error
+}
@@ -0,0 +1,350 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+)
+
+// MaxMessageConstraintsLength is the maximum number of message constraints
+const MaxMessageConstraintsLength = 500
+
+// GetMessageConstraint returns the constraint which applies to the messages of the provided type URL executed by an
+// interchain account of the provided connection. The constraint scoped to the connection is returned if present,
+// otherwise the global constraint of the message type is returned.
+func GetMessageConstraint(constraints []MessageConstraint, connectionID, typeURL string) (MessageConstraint, bool) {
+	var (
+		global      MessageConstraint
+		foundGlobal bool
+	)
+	for _, constraint := range constraints {
+		if constraint.TypeUrl != typeURL {
+			continue
+		}
+
+		switch constraint.ConnectionId {
+		case connectionID:
+			return constraint, true
+		case "":
+			global, foundGlobal = constraint, true
+		}
+	}
+
+	return global, foundGlobal
+}
+
+// CheckMessageConstraints returns an error if the message of the provided type URL, provided in its proto3 JSON
+// encoding, does not satisfy the constraint which applies to it. The messages packed as Any in the message, such as
+// the messages executed by an authz MsgExec, must satisfy the constraints which apply to their own type URLs.
+// The message is checked as if it were the only message of the transaction, the messages of a transaction must be
+// checked with a TxConstraintsChecker.
+func CheckMessageConstraints(constraints []MessageConstraint, connectionID, typeURL string, msgJSON []byte, interchainAccountAddr string) error {
+	return NewTxConstraintsChecker(constraints, connectionID, interchainAccountAddr).CheckMessage(typeURL, msgJSON)
+}
+
+// TxConstraintsChecker checks the messages of a transaction executed by an interchain account against the message
+// constraints which apply to them. The max amounts of a constraint limit the amounts of all messages of its type URL
+// in the transaction, including the messages packed in other messages, summed up per denomination.
+type TxConstraintsChecker struct {
+	constraints           []MessageConstraint
+	connectionID          string
+	interchainAccountAddr string
+
+	// totals are the summed up amounts per denomination of the messages checked so far, keyed by type URL
+	totals map[string]map[string]sdkmath.LegacyDec
+}
+
+// NewTxConstraintsChecker creates a new TxConstraintsChecker for a transaction executed by the provided interchain
+// account address of the provided connection.
+func NewTxConstraintsChecker(constraints []MessageConstraint, connectionID, interchainAccountAddr string) *TxConstraintsChecker {
+	return &TxConstraintsChecker{
+		constraints:           constraints,
+		connectionID:          connectionID,
+		interchainAccountAddr: interchainAccountAddr,
+		totals:                make(map[string]map[string]sdkmath.LegacyDec),
+	}
+}
+
+// CheckMessage returns an error if the message of the provided type URL, provided in its proto3 JSON encoding, or
+// any message packed as Any in it, does not satisfy the constraint which applies to it, given the messages of the
+// transaction checked before.
+func (c *TxConstraintsChecker) CheckMessage(typeURL string, msgJSON []byte) error {
+	var msg any
+	if err := json.Unmarshal(msgJSON, &msg); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "failed to unmarshal message: %v", err)
+	}
+
+	return c.checkMessage(typeURL, msg)
+}
+
+// checkMessage checks the decoded JSON message and, recursively, the messages packed in it against the
+// constraints which apply to them.
+func (c *TxConstraintsChecker) checkMessage(typeURL string, msg any) error {
+	if constraint, found := GetMessageConstraint(c.constraints, c.connectionID, typeURL); found {
+		total, ok := c.totals[typeURL]
+		if !ok {
+			total = make(map[string]sdkmath.LegacyDec)
+			c.totals[typeURL] = total
+		}
+
+		if err := constraint.check(msg, c.interchainAccountAddr, total); err != nil {
+			return err
+		}
+	}
+
+	for _, packedMsg := range packedMessages(msg) {
+		packedTypeURL, _ := packedMsg["@type"].(string)
+		if err := c.checkMessage(packedTypeURL, packedMsg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of the message constraint.
+func (c MessageConstraint) Validate() error {
+	if strings.TrimSpace(c.TypeUrl) == "" {
+		return fmt.Errorf("message constraint type URL cannot be empty")
+	}
+
+	if c.ConnectionId != "" && host.ConnectionIdentifierValidator(c.ConnectionId) != nil && host.ClientIdentifierValidator(c.ConnectionId) != nil {
+		return fmt.Errorf("message constraint for %s has invalid connection identifier %s", c.TypeUrl, c.ConnectionId)
+	}
+
+	for _, denom := range c.AllowedDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("message constraint for %s has invalid allowed denomination: %w", c.TypeUrl, err)
+		}
+	}
+
+	if err := c.MaxAmounts.Validate(); err != nil {
+		return fmt.Errorf("message constraint for %s has invalid max amounts: %w", c.TypeUrl, err)
+	}
+
+	for _, address := range c.AllowedAddresses {
+		if !isAddress(address) {
+			return fmt.Errorf("message constraint for %s has invalid allowed address %s", c.TypeUrl, address)
+		}
+	}
+
+	for _, matcher := range c.FieldMatchers {
+		if err := matcher.Validate(); err != nil {
+			return fmt.Errorf("message constraint for %s has invalid field matcher: %w", c.TypeUrl, err)
+		}
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of the field matcher.
+func (m FieldMatcher) Validate() error {
+	if strings.TrimSpace(m.Path) == "" || slices.Contains(strings.Split(m.Path, "."), "") {
+		return fmt.Errorf("invalid field path %q", m.Path)
+	}
+
+	if len(m.Values) == 0 {
+		return fmt.Errorf("field matcher for %s must have at least one value", m.Path)
+	}
+
+	return nil
+}
+
+// Check returns an error if the message, provided in its proto3 JSON encoding, does not satisfy the constraint.
+// The interchain account address is allowed to be present in the message regardless of the allowed addresses.
+// Only bech32 encoded strings are recognised as addresses, addresses encoded otherwise, e.g. as bytes, are not
+// restricted by the allowed addresses.
+func (c MessageConstraint) Check(msgJSON []byte, interchainAccountAddr string) error {
+	var msg any
+	if err := json.Unmarshal(msgJSON, &msg); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "failed to unmarshal message: %v", err)
+	}
+
+	return c.check(msg, interchainAccountAddr, make(map[string]sdkmath.LegacyDec))
+}
+
+// check returns an error if the decoded JSON message does not satisfy the constraint. The amounts of the coins in
+// the message are added to the provided total amounts per denomination, which are checked against the max amounts.
+func (c MessageConstraint) check(msg any, interchainAccountAddr string, total map[string]sdkmath.LegacyDec) error {
+	var (
+		coins     []sdk.DecCoin
+		addresses []string
+	)
+	collectCoinsAndAddresses(msg, &coins, &addresses)
+
+	for _, coin := range coins {
+		if len(c.AllowedDenoms) != 0 && !slices.Contains(c.AllowedDenoms, coin.Denom) {
+			return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "denomination not allowed for %s: %s", c.TypeUrl, coin.Denom)
+		}
+
+		if amount, ok := total[coin.Denom]; ok {
+			total[coin.Denom] = amount.Add(coin.Amount)
+		} else {
+			total[coin.Denom] = coin.Amount
+		}
+	}
+
+	for _, maxAmount := range c.MaxAmounts {
+		if amount, ok := total[maxAmount.Denom]; ok && amount.GT(sdkmath.LegacyNewDecFromInt(maxAmount.Amount)) {
+			return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "total amount %s%s exceeds maximum amount %s for %s", amount, maxAmount.Denom, maxAmount, c.TypeUrl)
+		}
+	}
+
+	if len(c.AllowedAddresses) != 0 {
+		for _, address := range addresses {
+			if address != interchainAccountAddr && !slices.Contains(c.AllowedAddresses, address) {
+				return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "address not allowed for %s: %s", c.TypeUrl, address)
+			}
+		}
+	}
+
+	for _, matcher := range c.FieldMatchers {
+		if err := matcher.match(msg); err != nil {
+			return errorsmod.Wrapf(err, "field matcher for %s", c.TypeUrl)
+		}
+	}
+
+	return nil
+}
+
+// match returns an error if the field of the message at the matcher path is not present, or if any of its values
+// is not one of the allowed values.
+func (m FieldMatcher) match(msg any) error {
+	values := fieldValues(msg, strings.Split(m.Path, "."))
+	if len(values) == 0 {
+		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "field %s not found", m.Path)
+	}
+
+	for _, value := range values {
+		if !slices.Contains(m.Values, value) {
+			return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "value of field %s not allowed: %s", m.Path, value)
+		}
+	}
+
+	return nil
+}
+
+// fieldValues returns the scalar values found at the path of the decoded JSON value. Arrays are traversed element
+// by element, so that a path may match multiple values.
+func fieldValues(value any, path []string) []string {
+	if array, ok := value.([]any); ok {
+		var values []string
+		for _, element := range array {
+			values = append(values, fieldValues(element, path)...)
+		}
+		return values
+	}
+
+	if len(path) == 0 {
+		switch v := value.(type) {
+		case string:
+			return []string{v}
+		case float64, bool:
+			return []string{fmt.Sprint(v)}
+		default:
+			return nil
+		}
+	}
+
+	object, ok := value.(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	return fieldValues(object[path[0]], path[1:])
+}
+
+// packedMessages returns the messages packed as Any, identified by their "@type" field, which are nested in the
+// decoded JSON value. The messages packed in the returned messages are not included.
+func packedMessages(value any) []map[string]any {
+	var children []any
+	switch v := value.(type) {
+	case map[string]any:
+		// the fields are traversed in a deterministic order
+		for _, key := range slices.Sorted(maps.Keys(v)) {
+			children = append(children, v[key])
+		}
+	case []any:
+		children = v
+	}
+
+	var msgs []map[string]any
+	for _, child := range children {
+		if object, ok := child.(map[string]any); ok {
+			if _, ok := object["@type"].(string); ok {
+				msgs = append(msgs, object)
+				continue
+			}
+		}
+
+		msgs = append(msgs, packedMessages(child)...)
+	}
+
+	return msgs
+}
+
+// collectCoinsAndAddresses traverses the decoded JSON value and collects all coins, objects consisting of a denom
+// and an amount, and all strings which are bech32 encoded addresses.
+func collectCoinsAndAddresses(value any, coins *[]sdk.DecCoin, addresses *[]string) {
+	switch v := value.(type) {
+	case map[string]any:
+		if coin, ok := parseCoin(v); ok {
+			*coins = append(*coins, coin)
+			return
+		}
+
+		// the fields are traversed in a deterministic order
+		for _, key := range slices.Sorted(maps.Keys(v)) {
+			collectCoinsAndAddresses(v[key], coins, addresses)
+		}
+	case []any:
+		for _, element := range v {
+			collectCoinsAndAddresses(element, coins, addresses)
+		}
+	case string:
+		if isAddress(v) {
+			*addresses = append(*addresses, v)
+		}
+	}
+}
+
+// parseCoin returns the coin represented by the decoded JSON object if it only consists of a denom and an amount.
+func parseCoin(object map[string]any) (sdk.DecCoin, bool) {
+	if len(object) != 2 {
+		return sdk.DecCoin{}, false
+	}
+
+	denom, ok := object["denom"].(string)
+	if !ok {
+		return sdk.DecCoin{}, false
+	}
+
+	amount, ok := object["amount"].(string)
+	if !ok {
+		return sdk.DecCoin{}, false
+	}
+
+	decAmount, err := sdkmath.LegacyNewDecFromStr(amount)
+	if err != nil || decAmount.IsNegative() {
+		return sdk.DecCoin{}, false
+	}
+
+	return sdk.DecCoin{Denom: denom, Amount: decAmount}, true
+}
+
+// isAddress returns true if the provided string is a bech32 encoded address, regardless of its prefix.
+func isAddress(s string) bool {
+	_, bz, err := bech32.DecodeAndConvert(s)
+	return err == nil && sdk.VerifyAddressFormat(bz) == nil
+}
@@ -0,0 +1,342 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+var (
+	msgSendTypeURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	interchainAccountAddr = sdk.AccAddress([]byte("interchain-account")).String()
+	recipientAddr         = sdk.AccAddress([]byte("recipient")).String()
+	validatorAddr         = sdk.ValAddress([]byte("validator")).String()
+)
+
+func TestGetMessageConstraint(t *testing.T) {
+	global := types.MessageConstraint{TypeUrl: msgSendTypeURL, AllowedDenoms: []string{"stake"}}
+	scoped := types.MessageConstraint{TypeUrl: msgSendTypeURL, ConnectionId: ibctesting.FirstConnectionID, AllowedDenoms: []string{"atom"}}
+	other := types.MessageConstraint{TypeUrl: sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})}
+
+	constraints := []types.MessageConstraint{global, scoped, other}
+
+	constraint, found := types.GetMessageConstraint(constraints, ibctesting.FirstConnectionID, msgSendTypeURL)
+	require.True(t, found)
+	require.Equal(t, scoped, constraint)
+
+	constraint, found = types.GetMessageConstraint(constraints, ibctesting.SecondConnectionID, msgSendTypeURL)
+	require.True(t, found)
+	require.Equal(t, global, constraint)
+
+	_, found = types.GetMessageConstraint([]types.MessageConstraint{scoped}, ibctesting.SecondConnectionID, msgSendTypeURL)
+	require.False(t, found)
+
+	_, found = types.GetMessageConstraint(constraints, ibctesting.FirstConnectionID, sdk.MsgTypeURL(&banktypes.MsgMultiSend{}))
+	require.False(t, found)
+}
+
+func TestMessageConstraintValidate(t *testing.T) {
+	var constraint types.MessageConstraint
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   bool
+	}{
+		{"success", func() {}, false},
+		{"success: global constraint", func() { constraint.ConnectionId = "" }, false},
+		{"success: client identifier", func() { constraint.ConnectionId = ibctesting.FirstClientID }, false},
+		{"failure: empty type URL", func() { constraint.TypeUrl = " " }, true},
+		{"failure: invalid connection identifier", func() { constraint.ConnectionId = "c" }, true},
+		{"failure: invalid allowed denom", func() { constraint.AllowedDenoms = []string{"1"} }, true},
+		{"failure: invalid max amounts", func() {
+			constraint.MaxAmounts = sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdkmath.NewInt(-1)}}
+		}, true},
+		{"failure: invalid allowed address", func() { constraint.AllowedAddresses = []string{"recipient"} }, true},
+		{"failure: empty field path", func() { constraint.FieldMatchers = []types.FieldMatcher{{Path: "", Values: []string{"a"}}} }, true},
+		{"failure: empty field path element", func() {
+			constraint.FieldMatchers = []types.FieldMatcher{{Path: "amount..denom", Values: []string{"a"}}}
+		}, true},
+		{"failure: field matcher without values", func() { constraint.FieldMatchers = []types.FieldMatcher{{Path: "to_address"}} }, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			constraint = types.MessageConstraint{
+				TypeUrl:          msgSendTypeURL,
+				ConnectionId:     ibctesting.FirstConnectionID,
+				AllowedDenoms:    []string{"stake"},
+				MaxAmounts:       sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+				AllowedAddresses: []string{recipientAddr, validatorAddr},
+				FieldMatchers:    []types.FieldMatcher{{Path: "amount.denom", Values: []string{"stake"}}},
+			}
+
+			tc.malleate()
+
+			err := constraint.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMessageConstraintCheck(t *testing.T) {
+	var (
+		constraint types.MessageConstraint
+		msg        sdk.Msg
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{"success: no restrictions", func() {}, nil},
+		{"success: allowed denom", func() { constraint.AllowedDenoms = []string{"atom", "stake"} }, nil},
+		{"success: amount equal to max amount", func() { constraint.MaxAmounts = sdk.NewCoins(sdk.NewInt64Coin("stake", 100)) }, nil},
+		{"success: max amount of other denom", func() { constraint.MaxAmounts = sdk.NewCoins(sdk.NewInt64Coin("atom", 1)) }, nil},
+		{"success: allowed recipient", func() { constraint.AllowedAddresses = []string{recipientAddr} }, nil},
+		{"success: field matcher", func() {
+			constraint.FieldMatchers = []types.FieldMatcher{{Path: "to_address", Values: []string{recipientAddr}}}
+		}, nil},
+		{"success: field matcher on repeated field", func() {
+			msg = &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   recipientAddr,
+				Amount:      sdk.NewCoins(sdk.NewInt64Coin("atom", 1), sdk.NewInt64Coin("stake", 100)),
+			}
+			constraint.FieldMatchers = []types.FieldMatcher{{Path: "amount.denom", Values: []string{"atom", "stake"}}}
+		}, nil},
+		{"success: allowed validator", func() {
+			msg = &stakingtypes.MsgDelegate{
+				DelegatorAddress: interchainAccountAddr,
+				ValidatorAddress: validatorAddr,
+				Amount:           sdk.NewInt64Coin("stake", 100),
+			}
+			constraint.AllowedAddresses = []string{validatorAddr}
+			constraint.MaxAmounts = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+		}, nil},
+		{"failure: denom not allowed", func() { constraint.AllowedDenoms = []string{"atom"} }, ibcerrors.ErrUnauthorized},
+		{"failure: amount exceeds max amount", func() { constraint.MaxAmounts = sdk.NewCoins(sdk.NewInt64Coin("stake", 99)) }, ibcerrors.ErrUnauthorized},
+		{"failure: amounts of all coins in the message are summed", func() {
+			msg = &banktypes.MsgMultiSend{
+				Inputs: []banktypes.Input{banktypes.NewInput(sdk.MustAccAddressFromBech32(interchainAccountAddr), sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))},
+				Outputs: []banktypes.Output{
+					banktypes.NewOutput(sdk.MustAccAddressFromBech32(recipientAddr), sdk.NewCoins(sdk.NewInt64Coin("stake", 50))),
+					banktypes.NewOutput(sdk.MustAccAddressFromBech32(recipientAddr), sdk.NewCoins(sdk.NewInt64Coin("stake", 50))),
+				},
+			}
+			constraint.MaxAmounts = sdk.NewCoins(sdk.NewInt64Coin("stake", 150))
+		}, ibcerrors.ErrUnauthorized},
+		{"failure: recipient not allowed", func() { constraint.AllowedAddresses = []string{validatorAddr} }, ibcerrors.ErrUnauthorized},
+		{"failure: validator not allowed", func() {
+			msg = &stakingtypes.MsgDelegate{
+				DelegatorAddress: interchainAccountAddr,
+				ValidatorAddress: validatorAddr,
+				Amount:           sdk.NewInt64Coin("stake", 100),
+			}
+			constraint.AllowedAddresses = []string{recipientAddr}
+		}, ibcerrors.ErrUnauthorized},
+		{"failure: field value not allowed", func() {
+			constraint.FieldMatchers = []types.FieldMatcher{{Path: "to_address", Values: []string{validatorAddr}}}
+		}, ibcerrors.ErrUnauthorized},
+		{"failure: field not found", func() {
+			constraint.FieldMatchers = []types.FieldMatcher{{Path: "recipient", Values: []string{recipientAddr}}}
+		}, ibcerrors.ErrUnauthorized},
+		{"failure: field is not a scalar", func() {
+			constraint.FieldMatchers = []types.FieldMatcher{{Path: "amount", Values: []string{"100stake"}}}
+		}, ibcerrors.ErrUnauthorized},
+	}
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg = &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   recipientAddr,
+				Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			}
+			constraint = types.MessageConstraint{TypeUrl: msgSendTypeURL}
+
+			tc.malleate()
+
+			msgJSON, err := cdc.MarshalJSON(msg)
+			require.NoError(t, err)
+
+			err = constraint.Check(msgJSON, interchainAccountAddr)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestCheckMessageConstraints(t *testing.T) {
+	var (
+		constraints []types.MessageConstraint
+		msg         sdk.Msg
+	)
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: interchainAccountAddr,
+		ToAddress:   recipientAddr,
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+	}
+
+	newMsgExec := func(msgs ...sdk.Msg) sdk.Msg {
+		msgExec := authz.NewMsgExec(sdk.MustAccAddressFromBech32(interchainAccountAddr), msgs)
+		return &msgExec
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{"success: no constraints", func() {}, nil},
+		{"success: constraint of message satisfied", func() {
+			constraints = []types.MessageConstraint{{TypeUrl: msgSendTypeURL, AllowedDenoms: []string{"stake"}}}
+		}, nil},
+		{"success: constraint of nested message satisfied", func() {
+			msg = newMsgExec(msgSend)
+			constraints = []types.MessageConstraint{{TypeUrl: msgSendTypeURL, AllowedAddresses: []string{recipientAddr}}}
+		}, nil},
+		{"failure: constraint of message not satisfied", func() {
+			constraints = []types.MessageConstraint{{TypeUrl: msgSendTypeURL, AllowedDenoms: []string{"atom"}}}
+		}, ibcerrors.ErrUnauthorized},
+		{"failure: constraint of nested message not satisfied", func() {
+			msg = newMsgExec(msgSend)
+			constraints = []types.MessageConstraint{{TypeUrl: msgSendTypeURL, AllowedDenoms: []string{"atom"}}}
+		}, ibcerrors.ErrUnauthorized},
+		{"failure: constraint scoped to the connection not satisfied by one of the nested messages", func() {
+			msg = newMsgExec(msgSend, &banktypes.MsgSend{FromAddress: interchainAccountAddr, ToAddress: validatorAddr, Amount: msgSend.Amount})
+			constraints = []types.MessageConstraint{{
+				TypeUrl:       msgSendTypeURL,
+				ConnectionId:  ibctesting.FirstConnectionID,
+				FieldMatchers: []types.FieldMatcher{{Path: "to_address", Values: []string{recipientAddr}}},
+			}}
+		}, ibcerrors.ErrUnauthorized},
+	}
+
+	registry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(registry)
+	authz.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg = msgSend
+			constraints = nil
+
+			tc.malleate()
+
+			msgJSON, err := cdc.MarshalJSON(msg)
+			require.NoError(t, err)
+
+			err = types.CheckMessageConstraints(constraints, ibctesting.FirstConnectionID, sdk.MsgTypeURL(msg), msgJSON, interchainAccountAddr)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestTxConstraintsChecker(t *testing.T) {
+	var (
+		constraints []types.MessageConstraint
+		msgs        []sdk.Msg
+	)
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: interchainAccountAddr,
+		ToAddress:   recipientAddr,
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+	}
+
+	newMsgExec := func(msgs ...sdk.Msg) sdk.Msg {
+		msgExec := authz.NewMsgExec(sdk.MustAccAddressFromBech32(interchainAccountAddr), msgs)
+		return &msgExec
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{"success: total amount of messages equal to max amount", func() {
+			constraints = []types.MessageConstraint{{TypeUrl: msgSendTypeURL, MaxAmounts: sdk.NewCoins(sdk.NewInt64Coin("stake", 200))}}
+		}, nil},
+		{"success: amounts of messages of other type URLs are not summed up", func() {
+			msgs = []sdk.Msg{msgSend, newMsgExec(msgSend)}
+			constraints = []types.MessageConstraint{
+				{TypeUrl: msgSendTypeURL, MaxAmounts: sdk.NewCoins(sdk.NewInt64Coin("stake", 200))},
+				{TypeUrl: sdk.MsgTypeURL(newMsgExec()), MaxAmounts: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))},
+			}
+		}, nil},
+		{"failure: total amount of messages exceeds max amount", func() {
+			constraints = []types.MessageConstraint{{TypeUrl: msgSendTypeURL, MaxAmounts: sdk.NewCoins(sdk.NewInt64Coin("stake", 150))}}
+		}, ibcerrors.ErrUnauthorized},
+		{"failure: total amount of message and nested message exceeds max amount", func() {
+			msgs = []sdk.Msg{msgSend, newMsgExec(msgSend)}
+			constraints = []types.MessageConstraint{{TypeUrl: msgSendTypeURL, MaxAmounts: sdk.NewCoins(sdk.NewInt64Coin("stake", 150))}}
+		}, ibcerrors.ErrUnauthorized},
+		{"failure: total amount of nested messages exceeds max amount", func() {
+			msgs = []sdk.Msg{newMsgExec(msgSend, msgSend)}
+			constraints = []types.MessageConstraint{{TypeUrl: msgSendTypeURL, MaxAmounts: sdk.NewCoins(sdk.NewInt64Coin("stake", 150))}}
+		}, ibcerrors.ErrUnauthorized},
+	}
+
+	registry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(registry)
+	authz.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msgs = []sdk.Msg{msgSend, msgSend}
+			constraints = nil
+
+			tc.malleate()
+
+			checker := types.NewTxConstraintsChecker(constraints, ibctesting.FirstConnectionID, interchainAccountAddr)
+
+			var err error
+			for _, msg := range msgs {
+				msgJSON, marshalErr := cdc.MarshalJSON(msg)
+				require.NoError(t, marshalErr)
+
+				if err = checker.CheckMessage(sdk.MsgTypeURL(msg), msgJSON); err != nil {
+					break
+				}
+			}
+
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
@@ -7,4 +7,5 @@ import (
 // ICA Host sentinel errors
 var (
 	ErrHostSubModuleDisabled = errorsmod.Register(SubModuleName, 2, "host submodule is disabled")
+	ErrTxRejected            = errorsmod.Register(SubModuleName, 3, "interchain account transaction rejected")
 )
@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -29,6 +32,8 @@ type Params struct {
 	HostEnabled bool `protobuf:"varint,1,opt,name=host_enabled,json=hostEnabled,proto3" json:"host_enabled,omitempty"`
 	// allow_messages defines a list of sdk message typeURLs allowed to be executed on a host chain.
 	AllowMessages []string `protobuf:"bytes,2,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty"`
+	// message_constraints defines optional constraints on the fields of the allowed messages.
+	MessageConstraints []MessageConstraint `protobuf:"bytes,3,rep,name=message_constraints,json=messageConstraints,proto3" json:"message_constraints"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -78,6 +83,170 @@ func (m *Params) GetAllowMessages() []string {
 	return nil
 }
 
+func (m *Params) GetMessageConstraints() []MessageConstraint {
+	if m != nil {
+		return m.MessageConstraints
+	}
+	return nil
+}
+
+// MessageConstraint defines the constraints which the fields of messages of a given type URL must satisfy to be
+// executed by an interchain account. The constraint applies to all interchain accounts if connection_id is empty,
+// or to the interchain accounts of the given host connection otherwise. A constraint scoped to a connection takes
+// precedence over the global constraint of the same type URL. The constraint also applies to the messages of the
+// type URL which are packed in other messages, such as the messages executed by an authz MsgExec.
+type MessageConstraint struct {
+	// type_url is the sdk message typeURL the constraint applies to.
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	// connection_id is the host connection identifier (or the host client identifier for IBC v2) the constraint
+	// is scoped to. The constraint is global if empty.
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// allowed_denoms is the list of denominations of the coins in the message. Any denomination is allowed if empty.
+	// Only objects of the message with exactly a denom and an amount field are recognised as coins.
+	AllowedDenoms []string `protobuf:"bytes,3,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	// max_amounts is the maximum amount per denomination of the coins in all messages of the type URL in a
+	// transaction, including the messages packed in other messages. The amount of denominations without a
+	// maximum is not limited.
+	MaxAmounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=max_amounts,json=maxAmounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_amounts"`
+	// allowed_addresses is the list of addresses, such as recipients or validators, which may be present in the
+	// message besides the interchain account address. Any address is allowed if empty. Only bech32 encoded strings
+	// of the message are recognised as addresses.
+	AllowedAddresses []string `protobuf:"bytes,5,rep,name=allowed_addresses,json=allowedAddresses,proto3" json:"allowed_addresses,omitempty"`
+	// field_matchers is the list of field matchers which the message must satisfy.
+	FieldMatchers []FieldMatcher `protobuf:"bytes,6,rep,name=field_matchers,json=fieldMatchers,proto3" json:"field_matchers"`
+}
+
+func (m *MessageConstraint) Reset()         { *m = MessageConstraint{} }
+func (m *MessageConstraint) String() string { return proto.CompactTextString(m) }
+func (*MessageConstraint) ProtoMessage()    {}
+func (*MessageConstraint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{1}
+}
+func (m *MessageConstraint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageConstraint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageConstraint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageConstraint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageConstraint.Merge(m, src)
+}
+func (m *MessageConstraint) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageConstraint) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageConstraint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageConstraint proto.InternalMessageInfo
+
+func (m *MessageConstraint) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+func (m *MessageConstraint) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *MessageConstraint) GetAllowedDenoms() []string {
+	if m != nil {
+		return m.AllowedDenoms
+	}
+	return nil
+}
+
+func (m *MessageConstraint) GetMaxAmounts() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxAmounts
+	}
+	return nil
+}
+
+func (m *MessageConstraint) GetAllowedAddresses() []string {
+	if m != nil {
+		return m.AllowedAddresses
+	}
+	return nil
+}
+
+func (m *MessageConstraint) GetFieldMatchers() []FieldMatcher {
+	if m != nil {
+		return m.FieldMatchers
+	}
+	return nil
+}
+
+// FieldMatcher restricts the values of a field of a message.
+type FieldMatcher struct {
+	// path is the dot separated path of the field in the proto3 JSON encoding of the message, using the
+	// proto field names, e.g. "amount.denom". Repeated fields are matched element by element.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// values is the list of allowed values of the field.
+	Values []string `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (m *FieldMatcher) Reset()         { *m = FieldMatcher{} }
+func (m *FieldMatcher) String() string { return proto.CompactTextString(m) }
+func (*FieldMatcher) ProtoMessage()    {}
+func (*FieldMatcher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{2}
+}
+func (m *FieldMatcher) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FieldMatcher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FieldMatcher.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FieldMatcher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldMatcher.Merge(m, src)
+}
+func (m *FieldMatcher) XXX_Size() int {
+	return m.Size()
+}
+func (m *FieldMatcher) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldMatcher.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldMatcher proto.InternalMessageInfo
+
+func (m *FieldMatcher) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *FieldMatcher) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
 // QueryRequest defines the parameters for a particular query request
 // by an interchain account.
 type QueryRequest struct {
@@ -93,7 +262,7 @@ func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
 func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRequest) ProtoMessage()    {}
 func (*QueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48e202774f13d08e, []int{1}
+	return fileDescriptor_48e202774f13d08e, []int{3}
 }
 func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -138,6 +307,8 @@ func (m *QueryRequest) GetData() []byte {
 
 func init() {
 	proto.RegisterType((*Params)(nil), "ibc.applications.interchain_accounts.host.v1.Params")
+	proto.RegisterType((*MessageConstraint)(nil), "ibc.applications.interchain_accounts.host.v1.MessageConstraint")
+	proto.RegisterType((*FieldMatcher)(nil), "ibc.applications.interchain_accounts.host.v1.FieldMatcher")
 	proto.RegisterType((*QueryRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryRequest")
 }
 
@@ -146,25 +317,42 @@ func init() {
 }
 
 var fileDescriptor_48e202774f13d08e = []byte{
-	// 284 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0xd0, 0x31, 0x4b, 0xfc, 0x30,
-	0x18, 0x06, 0xf0, 0xcb, 0xfd, 0xff, 0x1c, 0x5e, 0x3c, 0x1d, 0x3a, 0xdd, 0x14, 0xce, 0x03, 0xe1,
-	0x06, 0xdb, 0x58, 0x05, 0x6f, 0x17, 0x9c, 0x44, 0xd0, 0x8c, 0x2e, 0xe5, 0x4d, 0x1a, 0xda, 0x40,
-	0xdb, 0xd4, 0xbe, 0x69, 0xe5, 0xbe, 0x85, 0x1f, 0xcb, 0xf1, 0x46, 0x47, 0x69, 0xbf, 0x88, 0x34,
-	0x0a, 0x2a, 0x38, 0xe5, 0xe1, 0x07, 0x4f, 0xe0, 0x7d, 0xe8, 0xd6, 0x48, 0xc5, 0xa1, 0xae, 0x0b,
-	0xa3, 0xc0, 0x19, 0x5b, 0x21, 0x37, 0x95, 0xd3, 0x8d, 0xca, 0xc1, 0x54, 0x09, 0x28, 0x65, 0xdb,
-	0xca, 0x21, 0xcf, 0x2d, 0x3a, 0xde, 0xc5, 0xfe, 0x8d, 0xea, 0xc6, 0x3a, 0x1b, 0x9c, 0x19, 0xa9,
-	0xa2, 0x9f, 0xc5, 0xe8, 0x8f, 0x62, 0xe4, 0x0b, 0x5d, 0xbc, 0x16, 0x74, 0x76, 0x0f, 0x0d, 0x94,
-	0x18, 0x9c, 0xd0, 0xc5, 0x88, 0x89, 0xae, 0x40, 0x16, 0x3a, 0x5d, 0x92, 0x15, 0xd9, 0x1c, 0x88,
-	0xc3, 0xd1, 0x6e, 0x3e, 0x29, 0x38, 0xa5, 0xc7, 0x50, 0x14, 0xf6, 0x39, 0x29, 0x35, 0x22, 0x64,
-	0x1a, 0x97, 0xd3, 0xd5, 0xbf, 0xcd, 0x5c, 0x1c, 0x79, 0xbd, 0xfb, 0xc2, 0xf5, 0x15, 0x5d, 0x3c,
-	0xb4, 0xba, 0xd9, 0x09, 0xfd, 0xd4, 0x6a, 0x74, 0x41, 0x40, 0xff, 0xd7, 0xe0, 0x72, 0xff, 0xe3,
-	0x5c, 0xf8, 0x3c, 0x5a, 0x0a, 0x0e, 0x96, 0xd3, 0x15, 0xd9, 0x2c, 0x84, 0xcf, 0xd7, 0xfa, 0xb5,
-	0x67, 0x64, 0xdf, 0x33, 0xf2, 0xde, 0x33, 0xf2, 0x32, 0xb0, 0xc9, 0x7e, 0x60, 0x93, 0xb7, 0x81,
-	0x4d, 0x1e, 0x6f, 0x33, 0xe3, 0xf2, 0x56, 0x46, 0xca, 0x96, 0x5c, 0x59, 0x2c, 0x2d, 0x72, 0x23,
-	0x55, 0x98, 0x59, 0xde, 0xc5, 0xe7, 0xbc, 0xb4, 0x69, 0x5b, 0x68, 0x1c, 0xd7, 0x42, 0x7e, 0xb1,
-	0x0d, 0xbf, 0xef, 0x0d, 0x7f, 0x0f, 0xe5, 0x76, 0xb5, 0x46, 0x39, 0xf3, 0x3b, 0x5d, 0x7e, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0x6c, 0x06, 0x2e, 0x0a, 0x62, 0x01, 0x00, 0x00,
+	// 551 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0x6e, 0xda, 0x52, 0x36, 0xb7, 0x9d, 0x98, 0x41, 0x28, 0xdb, 0x21, 0x2b, 0x45, 0x48, 0x95,
+	0xa0, 0xf1, 0x3a, 0x24, 0x26, 0xed, 0x82, 0xd6, 0x01, 0x12, 0x42, 0x93, 0x20, 0x12, 0x17, 0x2e,
+	0x91, 0xe3, 0x78, 0xa9, 0x45, 0x62, 0x97, 0x3c, 0x27, 0x6c, 0xff, 0x82, 0xdf, 0xc1, 0x2f, 0x99,
+	0x38, 0xed, 0xc8, 0x09, 0x50, 0xfb, 0x3f, 0x10, 0x8a, 0x93, 0xae, 0x65, 0xdb, 0x65, 0xa7, 0x3c,
+	0x7f, 0x7e, 0xef, 0xd3, 0xf7, 0xf9, 0xcb, 0x43, 0xfb, 0x22, 0x60, 0x84, 0x4e, 0xa7, 0xb1, 0x60,
+	0x54, 0x0b, 0x25, 0x81, 0x08, 0xa9, 0x79, 0xca, 0x26, 0x54, 0x48, 0x9f, 0x32, 0xa6, 0x32, 0xa9,
+	0x81, 0x4c, 0x14, 0x68, 0x92, 0x8f, 0xcc, 0xd7, 0x9d, 0xa6, 0x4a, 0x2b, 0xfc, 0x4c, 0x04, 0xcc,
+	0x5d, 0x1d, 0x74, 0x6f, 0x18, 0x74, 0xcd, 0x40, 0x3e, 0xda, 0x76, 0x98, 0x82, 0x44, 0x01, 0x09,
+	0x28, 0x70, 0x92, 0x8f, 0x02, 0xae, 0xe9, 0x88, 0x30, 0x25, 0x64, 0xc9, 0xb6, 0xfd, 0x20, 0x52,
+	0x91, 0x32, 0x25, 0x29, 0xaa, 0x12, 0xed, 0xff, 0xb0, 0x50, 0xeb, 0x3d, 0x4d, 0x69, 0x02, 0xf8,
+	0x11, 0xea, 0x14, 0x5c, 0x3e, 0x97, 0x34, 0x88, 0x79, 0x68, 0x5b, 0x3d, 0x6b, 0xb0, 0xe6, 0xb5,
+	0x0b, 0xec, 0x75, 0x09, 0xe1, 0x27, 0x68, 0x83, 0xc6, 0xb1, 0xfa, 0xea, 0x27, 0x1c, 0x80, 0x46,
+	0x1c, 0xec, 0x7a, 0xaf, 0x31, 0x58, 0xf7, 0xba, 0x06, 0x3d, 0xae, 0x40, 0x9c, 0xa3, 0xfb, 0x55,
+	0x83, 0xcf, 0x94, 0x04, 0x9d, 0x52, 0x21, 0x35, 0xd8, 0x8d, 0x5e, 0x63, 0xd0, 0xde, 0x7b, 0xe9,
+	0xde, 0xc6, 0x96, 0x5b, 0x91, 0x1e, 0x5d, 0xf2, 0x8c, 0x9b, 0xe7, 0xbf, 0x76, 0x6a, 0x1e, 0x4e,
+	0xae, 0x5e, 0x40, 0xff, 0x6f, 0x1d, 0x6d, 0x5e, 0xeb, 0xc7, 0x5b, 0x68, 0x4d, 0x9f, 0x4d, 0xb9,
+	0x9f, 0xa5, 0xb1, 0xf1, 0xb4, 0xee, 0xdd, 0x2d, 0xce, 0x1f, 0xd3, 0x18, 0x3f, 0x46, 0x5d, 0xa6,
+	0xa4, 0xe4, 0xac, 0xd0, 0xe1, 0x8b, 0xd0, 0xae, 0x9b, 0xfb, 0xce, 0x12, 0x7c, 0xbb, 0x34, 0xcd,
+	0x43, 0x3f, 0xe4, 0x52, 0x25, 0xa5, 0x91, 0x85, 0x69, 0x1e, 0xbe, 0x32, 0x20, 0x8e, 0x51, 0x3b,
+	0xa1, 0xa7, 0x3e, 0x4d, 0x8c, 0x7e, 0xbb, 0x69, 0xcc, 0x6e, 0xb9, 0x65, 0x2a, 0x6e, 0x91, 0x8a,
+	0x5b, 0xa5, 0xe2, 0x1e, 0x29, 0x21, 0xc7, 0xbb, 0x85, 0x8d, 0xef, 0xbf, 0x77, 0x06, 0x91, 0xd0,
+	0x93, 0x2c, 0x70, 0x99, 0x4a, 0x48, 0x15, 0x61, 0xf9, 0x19, 0x42, 0xf8, 0x99, 0x14, 0x4a, 0xc1,
+	0x0c, 0x80, 0x87, 0x12, 0x7a, 0x7a, 0x58, 0xd2, 0xe3, 0xa7, 0x68, 0x73, 0x21, 0x8a, 0x86, 0x61,
+	0xca, 0x01, 0x38, 0xd8, 0x77, 0x8c, 0xae, 0x7b, 0xd5, 0xc5, 0xe1, 0x02, 0xc7, 0x11, 0xda, 0x38,
+	0x11, 0x3c, 0x0e, 0xfd, 0x84, 0x6a, 0x36, 0xe1, 0x29, 0xd8, 0x2d, 0xa3, 0xee, 0xe0, 0x76, 0x51,
+	0xbc, 0x29, 0x38, 0x8e, 0x4b, 0x8a, 0x2a, 0x85, 0xee, 0xc9, 0x0a, 0x06, 0xfd, 0x03, 0xd4, 0x59,
+	0x6d, 0xc2, 0x18, 0x35, 0xa7, 0x54, 0x4f, 0xaa, 0x67, 0x37, 0x35, 0x7e, 0x88, 0x5a, 0x39, 0x8d,
+	0xb3, 0xcb, 0x7f, 0xa7, 0x3a, 0xf5, 0x5f, 0xa0, 0xce, 0x87, 0x8c, 0xa7, 0x67, 0x1e, 0xff, 0x92,
+	0x71, 0xd0, 0x37, 0xce, 0x62, 0xd4, 0x0c, 0xa9, 0xa6, 0x26, 0xa6, 0x8e, 0x67, 0xea, 0x31, 0x3f,
+	0x9f, 0x39, 0xd6, 0xc5, 0xcc, 0xb1, 0xfe, 0xcc, 0x1c, 0xeb, 0xdb, 0xdc, 0xa9, 0x5d, 0xcc, 0x9d,
+	0xda, 0xcf, 0xb9, 0x53, 0xfb, 0xf4, 0xee, 0xfa, 0xcb, 0x8a, 0x80, 0x0d, 0x23, 0x45, 0xf2, 0xd1,
+	0x2e, 0x49, 0x54, 0x98, 0xc5, 0x1c, 0x8a, 0xcd, 0x04, 0xb2, 0xb7, 0x3f, 0x5c, 0x3a, 0x1f, 0xfe,
+	0xbf, 0x94, 0x26, 0x82, 0xa0, 0x65, 0xf6, 0xe5, 0xf9, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3f,
+	0x06, 0x4e, 0x1a, 0xce, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -187,6 +375,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MessageConstraints) > 0 {
+		for iNdEx := len(m.MessageConstraints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MessageConstraints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.AllowMessages) > 0 {
 		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowMessages[iNdEx])
@@ -209,6 +411,128 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MessageConstraint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageConstraint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageConstraint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FieldMatchers) > 0 {
+		for iNdEx := len(m.FieldMatchers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FieldMatchers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.AllowedAddresses) > 0 {
+		for iNdEx := len(m.AllowedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedAddresses[iNdEx])
+			copy(dAtA[i:], m.AllowedAddresses[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.AllowedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MaxAmounts) > 0 {
+		for iNdEx := len(m.MaxAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FieldMatcher) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FieldMatcher) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FieldMatcher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -272,6 +596,72 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovHost(uint64(l))
 		}
 	}
+	if len(m.MessageConstraints) > 0 {
+		for _, e := range m.MessageConstraints {
+			l = e.Size()
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MessageConstraint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if len(m.MaxAmounts) > 0 {
+		for _, e := range m.MaxAmounts {
+			l = e.Size()
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if len(m.AllowedAddresses) > 0 {
+		for _, s := range m.AllowedAddresses {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if len(m.FieldMatchers) > 0 {
+		for _, e := range m.FieldMatchers {
+			l = e.Size()
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FieldMatcher) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -379,6 +769,400 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageConstraints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageConstraints = append(m.MessageConstraints, MessageConstraint{})
+			if err := m.MessageConstraints[len(m.MessageConstraints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageConstraint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageConstraint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageConstraint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxAmounts = append(m.MaxAmounts, types.Coin{})
+			if err := m.MaxAmounts[len(m.MaxAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedAddresses = append(m.AllowedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMatchers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FieldMatchers = append(m.FieldMatchers, FieldMatcher{})
+			if err := m.FieldMatchers[len(m.FieldMatchers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FieldMatcher) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FieldMatcher: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FieldMatcher: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHost(dAtA[iNdEx:])
@@ -28,7 +28,11 @@ func DefaultParams() Params {
 
 // Validate validates all host submodule parameters
 func (p Params) Validate() error {
-	return validateAllowlist(p.AllowMessages)
+	if err := validateAllowlist(p.AllowMessages); err != nil {
+		return err
+	}
+
+	return validateMessageConstraints(p.MessageConstraints, p.AllowMessages)
 }
 
 func validateAllowlist(allowMsgs []string) error {
@@ -48,3 +52,30 @@ func validateAllowlist(allowMsgs []string) error {
 
 	return nil
 }
+
+func validateMessageConstraints(constraints []MessageConstraint, allowMsgs []string) error {
+	if len(constraints) > MaxMessageConstraintsLength {
+		return fmt.Errorf("message constraints length must not exceed %d items", MaxMessageConstraintsLength)
+	}
+
+	allowAll := len(allowMsgs) == 1 && allowMsgs[0] == AllowAllHostMsgs
+
+	seen := make(map[string]bool)
+	for _, constraint := range constraints {
+		if err := constraint.Validate(); err != nil {
+			return err
+		}
+
+		if !allowAll && !slices.Contains(allowMsgs, constraint.TypeUrl) {
+			return fmt.Errorf("message constraint type URL %s is not in the allow list", constraint.TypeUrl)
+		}
+
+		key := constraint.TypeUrl + "/" + constraint.ConnectionId
+		if seen[key] {
+			return fmt.Errorf("duplicate message constraint for type URL %s and connection %q", constraint.TypeUrl, constraint.ConnectionId)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
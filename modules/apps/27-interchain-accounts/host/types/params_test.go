@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
 )
 
 func TestValidateParams(t *testing.T) {
@@ -16,3 +17,37 @@ func TestValidateParams(t *testing.T) {
 	require.Error(t, types.NewParams(true, []string{"*", "/cosmos.bank.v1beta1.MsgSend"}).Validate())
 	require.Error(t, types.NewParams(true, make([]string, types.MaxAllowListLength+1)).Validate())
 }
+
+func TestValidateParamsMessageConstraints(t *testing.T) {
+	constraint := types.MessageConstraint{TypeUrl: msgSendTypeURL, AllowedDenoms: []string{"stake"}}
+	scoped := types.MessageConstraint{TypeUrl: msgSendTypeURL, ConnectionId: ibctesting.FirstConnectionID}
+
+	testCases := []struct {
+		name        string
+		allowMsgs   []string
+		constraints []types.MessageConstraint
+		expErr      bool
+	}{
+		{"success: allow all messages", []string{types.AllowAllHostMsgs}, []types.MessageConstraint{constraint}, false},
+		{"success: allowed message", []string{msgSendTypeURL}, []types.MessageConstraint{constraint}, false},
+		{"success: global and scoped constraints", []string{msgSendTypeURL}, []types.MessageConstraint{constraint, scoped}, false},
+		{"failure: message not allowed", []string{"/cosmos.staking.v1beta1.MsgDelegate"}, []types.MessageConstraint{constraint}, true},
+		{"failure: invalid constraint", []string{msgSendTypeURL}, []types.MessageConstraint{{TypeUrl: msgSendTypeURL, AllowedDenoms: []string{""}}}, true},
+		{"failure: duplicate constraint", []string{msgSendTypeURL}, []types.MessageConstraint{scoped, scoped}, true},
+		{"failure: too many constraints", []string{types.AllowAllHostMsgs}, make([]types.MessageConstraint, types.MaxMessageConstraintsLength+1), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.NewParams(true, tc.allowMsgs)
+			params.MessageConstraints = tc.constraints
+
+			err := params.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package v2
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/keeper"
+	"github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/api"
+)
+
+var _ api.IBCModule = (*IBCModule)(nil)
+
+// IBCModule implements the IBC v2 callbacks of the interchain accounts host submodule.
+// Interchain accounts over IBC v2 do not require a channel handshake: the interchain account is
+// derived from the packet and created on the first packet received from its controller.
+type IBCModule struct {
+	keeper keeper.Keeper
+}
+
+// NewIBCModule creates a new IBCModule given the associated keeper
+func NewIBCModule(k keeper.Keeper) *IBCModule {
+	return &IBCModule{
+		keeper: k,
+	}
+}
+
+// OnSendPacket implements the IBCModule interface
+func (*IBCModule) OnSendPacket(_ sdk.Context, _ string, _ string, _ uint64, _ channeltypesv2.Payload, _ sdk.AccAddress) error {
+	return errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "cannot send packet from the host port, a host chain does not send packets")
+}
+
+// OnRecvPacket implements the IBCModule interface. The transaction carried by the payload is executed
+// by the interchain account of the packet sender.
+func (im *IBCModule) OnRecvPacket(ctx sdk.Context, sourceClient string, destinationClient string, sequence uint64, payload channeltypesv2.Payload, _ sdk.AccAddress) channeltypesv2.RecvPacketResult {
+	if !im.keeper.GetParams(ctx).HostEnabled {
+		im.keeper.Logger(ctx).Info("host submodule is disabled")
+		keeper.EmitAcknowledgementEventV2(ctx, destinationClient, false, types.ErrHostSubModuleDisabled)
+		return channeltypesv2.RecvPacketResult{
+			Status: channeltypesv2.PacketStatus_Failure,
+		}
+	}
+
+	if payload.SourcePort != icatypes.ControllerPortID || payload.DestinationPort != icatypes.HostPortID {
+		err := errorsmod.Wrapf(icatypes.ErrInvalidPayload, "expected source port %s and destination port %s, got %s and %s", icatypes.ControllerPortID, icatypes.HostPortID, payload.SourcePort, payload.DestinationPort)
+		keeper.EmitAcknowledgementEventV2(ctx, destinationClient, false, err)
+		return channeltypesv2.RecvPacketResult{
+			Status: channeltypesv2.PacketStatus_Failure,
+		}
+	}
+
+	txResponse, err := im.keeper.OnRecvPacketV2(ctx, destinationClient, payload)
+	if err != nil {
+		im.keeper.Logger(ctx).Error(fmt.Sprintf("%s source client %s sequence %d", err.Error(), sourceClient, sequence))
+		keeper.EmitAcknowledgementEventV2(ctx, destinationClient, false, err)
+		return channeltypesv2.RecvPacketResult{
+			Status: channeltypesv2.PacketStatus_Failure,
+		}
+	}
+
+	im.keeper.Logger(ctx).Info("successfully handled packet", "source-client", sourceClient, "sequence", sequence)
+	keeper.EmitAcknowledgementEventV2(ctx, destinationClient, true, nil)
+
+	return channeltypesv2.RecvPacketResult{
+		Status:          channeltypesv2.PacketStatus_Success,
+		Acknowledgement: channeltypes.NewResultAcknowledgement(txResponse).Acknowledgement(),
+	}
+}
+
+// OnTimeoutPacket implements the IBCModule interface
+func (*IBCModule) OnTimeoutPacket(_ sdk.Context, _ string, _ string, _ uint64, _ channeltypesv2.Payload, _ sdk.AccAddress) error {
+	return errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "cannot cause a packet timeout on the host port, a host chain does not send packets")
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface
+func (*IBCModule) OnAcknowledgementPacket(_ sdk.Context, _ string, _ string, _ uint64, _ []byte, _ channeltypesv2.Payload, _ sdk.AccAddress) error {
+	return errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "cannot receive acknowledgement on the host port, a host chain does not send packets")
+}
+
+// UnmarshalPacketData unmarshals the interchain accounts packet data based on the version and encoding
+// it implements the PacketDataUnmarshaler interface
+func (*IBCModule) UnmarshalPacketData(payload channeltypesv2.Payload) (any, error) {
+	return icatypes.UnmarshalPacketDataV2(payload.Value, payload.Version, payload.Encoding)
+}
@@ -0,0 +1,212 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	testifysuite "github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+const testSalt = "salt"
+
+type InterchainAccountsTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+func (suite *InterchainAccountsTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.SetupV2()
+}
+
+func TestInterchainAccountsTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(InterchainAccountsTestSuite))
+}
+
+// payload returns an interchain accounts payload executing the provided msgs, sent by the chainA sender account.
+func (suite *InterchainAccountsTestSuite) payload(encoding string, msgs ...proto.Message) channeltypesv2.Payload {
+	txEncoding, err := icatypes.CosmosTxEncoding(encoding)
+	suite.Require().NoError(err)
+
+	txBz, err := icatypes.SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), msgs, txEncoding)
+	suite.Require().NoError(err)
+
+	data := icatypes.NewInterchainAccountPacketDataV2(suite.chainA.SenderAccount.GetAddress().String(), testSalt, icatypes.EXECUTE_TX, txBz, "")
+
+	var bz []byte
+	if encoding == icatypes.EncodingJSON {
+		bz = icatypes.ModuleCdc.MustMarshalJSON(&data)
+	} else {
+		bz = icatypes.ModuleCdc.MustMarshal(&data)
+	}
+
+	return channeltypesv2.NewPayload(icatypes.ControllerPortID, icatypes.HostPortID, icatypes.Version, encoding, bz)
+}
+
+// expectedAck returns the acknowledgement written by the host for the successful execution of msgs with the provided responses.
+func (suite *InterchainAccountsTestSuite) expectedAck(responses ...proto.Message) channeltypesv2.Acknowledgement {
+	txMsgData := &sdk.TxMsgData{MsgResponses: make([]*codectypes.Any, len(responses))}
+	for i, res := range responses {
+		protoAny, err := codectypes.NewAnyWithValue(res)
+		suite.Require().NoError(err)
+		txMsgData.MsgResponses[i] = protoAny
+	}
+
+	bz, err := proto.Marshal(txMsgData)
+	suite.Require().NoError(err)
+
+	return channeltypesv2.NewAcknowledgement(channeltypes.NewResultAcknowledgement(bz).Acknowledgement())
+}
+
+func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
+	var (
+		interchainAccountAddr sdk.AccAddress
+		payload               channeltypesv2.Payload
+		expAck                channeltypesv2.Acknowledgement
+	)
+
+	amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)))
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: interchain account is created on first packet",
+			func() {
+				msg := disttypes.NewMsgSetWithdrawAddress(interchainAccountAddr, suite.chainB.SenderAccount.GetAddress())
+				payload = suite.payload(icatypes.EncodingProto, msg)
+				expAck = suite.expectedAck(&disttypes.MsgSetWithdrawAddressResponse{})
+			},
+			true,
+		},
+		{
+			"success: funded account is converted into an interchain account",
+			func() {
+				err := suite.chainB.GetSimApp().BankKeeper.SendCoins(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), interchainAccountAddr, amount)
+				suite.Require().NoError(err)
+
+				msg := banktypes.NewMsgSend(interchainAccountAddr, suite.chainB.SenderAccount.GetAddress(), amount)
+				payload = suite.payload(icatypes.EncodingJSON, msg)
+				expAck = suite.expectedAck(&banktypes.MsgSendResponse{})
+			},
+			true,
+		},
+		{
+			"failure: host submodule is disabled",
+			func() {
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{types.AllowAllHostMsgs}))
+
+				msg := disttypes.NewMsgSetWithdrawAddress(interchainAccountAddr, suite.chainB.SenderAccount.GetAddress())
+				payload = suite.payload(icatypes.EncodingProto, msg)
+			},
+			false,
+		},
+		{
+			"failure: message type is not allowed",
+			func() {
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}))
+
+				msg := disttypes.NewMsgSetWithdrawAddress(interchainAccountAddr, suite.chainB.SenderAccount.GetAddress())
+				payload = suite.payload(icatypes.EncodingProto, msg)
+			},
+			false,
+		},
+		{
+			"failure: message is not signed by the interchain account",
+			func() {
+				msg := disttypes.NewMsgSetWithdrawAddress(suite.chainB.SenderAccount.GetAddress(), suite.chainB.SenderAccount.GetAddress())
+				payload = suite.payload(icatypes.EncodingProto, msg)
+			},
+			false,
+		},
+		{
+			"failure: invalid destination port",
+			func() {
+				msg := disttypes.NewMsgSetWithdrawAddress(interchainAccountAddr, suite.chainB.SenderAccount.GetAddress())
+				payload = suite.payload(icatypes.EncodingProto, msg)
+				payload.DestinationPort = ibctesting.MockPort
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			interchainAccountAddr = icatypes.GenerateAddressV2(suite.path.EndpointB.ClientID, suite.chainA.SenderAccount.GetAddress().String(), testSalt)
+
+			tc.malleate()
+
+			cbs := suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.Router.Route(icatypes.HostPortID)
+			res := cbs.OnRecvPacket(suite.chainB.GetContext(), suite.path.EndpointB.Counterparty.ClientID, suite.path.EndpointB.ClientID, 1, payload, suite.chainB.SenderAccount.GetAddress())
+
+			if tc.expPass {
+				suite.Require().Equal(channeltypesv2.PacketStatus_Success, res.Status)
+				suite.Require().Equal(expAck.AppAcknowledgements[0], res.Acknowledgement)
+
+				acc := suite.chainB.GetSimApp().AccountKeeper.GetAccount(suite.chainB.GetContext(), interchainAccountAddr)
+				interchainAccount, ok := acc.(*icatypes.InterchainAccount)
+				suite.Require().True(ok)
+				suite.Require().Equal(suite.chainA.SenderAccount.GetAddress().String(), interchainAccount.AccountOwner)
+			} else {
+				suite.Require().Equal(channeltypesv2.PacketStatus_Failure, res.Status)
+			}
+		})
+	}
+}
+
+func (suite *InterchainAccountsTestSuite) TestInterchainAccountV2() {
+	interchainAccountAddr := icatypes.GenerateAddressV2(suite.path.EndpointB.ClientID, suite.chainA.SenderAccount.GetAddress().String(), testSalt)
+
+	// fund the interchain account before it is created
+	amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)))
+	err := suite.chainB.GetSimApp().BankKeeper.SendCoins(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), interchainAccountAddr, amount)
+	suite.Require().NoError(err)
+
+	recipient := suite.chainB.SenderAccounts[1].SenderAccount.GetAddress()
+	expBalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), recipient, sdk.DefaultBondDenom).Add(amount[0])
+
+	msg := banktypes.NewMsgSend(interchainAccountAddr, recipient, amount)
+	packet, err := suite.path.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), suite.payload(icatypes.EncodingProto, msg))
+	suite.Require().NoError(err)
+
+	err = suite.path.EndpointB.MsgRecvPacket(packet)
+	suite.Require().NoError(err)
+
+	ack := suite.expectedAck(&banktypes.MsgSendResponse{})
+	commitment := suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.DestinationClient, packet.Sequence)
+	suite.Require().Equal(channeltypesv2.CommitAcknowledgement(ack), commitment)
+
+	balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), recipient, sdk.DefaultBondDenom)
+	suite.Require().Equal(expBalance, balance)
+
+	err = suite.path.EndpointA.MsgAcknowledgePacket(packet, ack)
+	suite.Require().NoError(err)
+}
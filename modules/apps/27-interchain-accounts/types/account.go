@@ -53,6 +53,20 @@ func GenerateAddress(ctx sdk.Context, connectionID, portID string) sdk.AccAddres
 	return sdkaddress.Derive(hostModuleAcc, buf)
 }
 
+// GenerateAddressV2 returns an sdk.AccAddress derived using a host module account address, the host client ID,
+// the controller sender address and the salt of an IBC v2 interchain accounts packet. Unlike GenerateAddress, the
+// address is deterministic, it may be computed by the controller before the interchain account is created on the host.
+func GenerateAddressV2(clientID, sender, salt string) sdk.AccAddress {
+	hostModuleAcc := sdkaddress.Module(ModuleName, []byte(hostAccountsKey))
+
+	// the client ID and sender are length prefixed to ensure that distinct tuples cannot derive the same address
+	buf := sdkaddress.MustLengthPrefix([]byte(clientID))
+	buf = append(buf, sdkaddress.MustLengthPrefix([]byte(sender))...)
+	buf = append(buf, salt...)
+
+	return sdkaddress.Derive(hostModuleAcc, buf)
+}
+
 // ValidateAccountAddress performs basic validation of interchain account addresses, enforcing constraints
 // on address length and character set
 func ValidateAccountAddress(addr string) error {
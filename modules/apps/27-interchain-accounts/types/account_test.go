@@ -51,6 +51,19 @@ func (suite *TypesTestSuite) TestGenerateAddress() {
 	suite.Require().NotEmpty(accAddr)
 }
 
+func (suite *TypesTestSuite) TestGenerateAddressV2() {
+	addr := types.GenerateAddressV2(ibctesting.FirstClientID, TestOwnerAddress, "salt")
+	suite.Require().NotEmpty(addr)
+
+	// the address is deterministic
+	suite.Require().Equal(addr, types.GenerateAddressV2(ibctesting.FirstClientID, TestOwnerAddress, "salt"))
+
+	// every input is committed to
+	suite.Require().NotEqual(addr, types.GenerateAddressV2(ibctesting.SecondClientID, TestOwnerAddress, "salt"))
+	suite.Require().NotEqual(addr, types.GenerateAddressV2(ibctesting.FirstClientID, TestOwnerAddress+"salt", ""))
+	suite.Require().NotEqual(addr, types.GenerateAddressV2(ibctesting.FirstClientID, TestOwnerAddress, ""))
+}
+
 func (suite *TypesTestSuite) TestValidateAccountAddress() {
 	testCases := []struct {
 		name     string
@@ -6,6 +6,7 @@ const (
 
 	AttributeKeyAckError            = "error"
 	AttributeKeyHostChannelID       = "host_channel_id"
+	AttributeKeyHostClientID        = "host_client_id"
 	AttributeKeyControllerChannelID = "controller_channel_id"
 	AttributeKeyAckSuccess          = "success"
 )
@@ -14,6 +14,9 @@ const (
 	// ControllerPortPrefix is the default port prefix that the interchain accounts controller submodule binds to
 	ControllerPortPrefix = "icacontroller-"
 
+	// ControllerPortID is the port id that the interchain accounts controller submodule binds to for IBC v2 packets
+	ControllerPortID = "icacontroller"
+
 	// Version defines the current version for interchain accounts
 	Version = "ics27-1"
 
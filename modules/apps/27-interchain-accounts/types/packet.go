@@ -9,12 +9,14 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
 	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
 )
 
 var (
 	_ ibcexported.PacketData         = (*InterchainAccountPacketData)(nil)
 	_ ibcexported.PacketDataProvider = (*InterchainAccountPacketData)(nil)
+	_ ibcexported.PacketData         = (*InterchainAccountPacketDataV2)(nil)
 )
 
 // MaxMemoCharLength defines the maximum length for the InterchainAccountPacketData memo field
@@ -97,3 +99,90 @@ func (iapd InterchainAccountPacketData) GetCustomPacketData(key string) any {
 
 	return memoData
 }
+
+const (
+	// EncodingJSON defines the JSON encoding of IBC v2 payloads
+	EncodingJSON = "application/json"
+	// EncodingProto defines the protobuf encoding of IBC v2 payloads
+	EncodingProto = "application/x-protobuf"
+
+	// MaxSaltLength defines the maximum length for the InterchainAccountPacketDataV2 salt field
+	MaxSaltLength = 64
+)
+
+// NewInterchainAccountPacketDataV2 creates a new InterchainAccountPacketDataV2 instance.
+func NewInterchainAccountPacketDataV2(sender, salt string, dataType Type, data []byte, memo string) InterchainAccountPacketDataV2 {
+	return InterchainAccountPacketDataV2{
+		Sender: sender,
+		Salt:   salt,
+		Type:   dataType,
+		Data:   data,
+		Memo:   memo,
+	}
+}
+
+// ValidateBasic performs basic validation of the IBC v2 interchain account packet data.
+// The salt and memo may be empty.
+func (iapd InterchainAccountPacketDataV2) ValidateBasic() error {
+	if strings.TrimSpace(iapd.Sender) == "" {
+		return errorsmod.Wrap(ErrInvalidOutgoingData, "packet data sender cannot be empty")
+	}
+
+	if len(iapd.Sender) > DefaultMaxAddrLength {
+		return errorsmod.Wrapf(ErrInvalidOutgoingData, "packet data sender cannot be greater than %d characters", DefaultMaxAddrLength)
+	}
+
+	if len(iapd.Salt) > MaxSaltLength {
+		return errorsmod.Wrapf(ErrInvalidOutgoingData, "packet data salt cannot be greater than %d characters", MaxSaltLength)
+	}
+
+	return InterchainAccountPacketData{Type: iapd.Type, Data: iapd.Data, Memo: iapd.Memo}.ValidateBasic()
+}
+
+// GetPacketSender returns the sender address of the IBC v2 interchain accounts packet data.
+// The source port ID is not used in this implementation.
+func (iapd InterchainAccountPacketDataV2) GetPacketSender(_ string) string {
+	return iapd.Sender
+}
+
+// UnmarshalPacketDataV2 unmarshals the value of an IBC v2 interchain accounts payload using the given
+// payload encoding. The payload version must be the interchain accounts version.
+func UnmarshalPacketDataV2(bz []byte, version, encoding string) (InterchainAccountPacketDataV2, error) {
+	if version != Version {
+		return InterchainAccountPacketDataV2{}, errorsmod.Wrapf(ErrInvalidVersion, "expected %s, got %s", Version, version)
+	}
+
+	var data InterchainAccountPacketDataV2
+	switch encoding {
+	case EncodingJSON:
+		if err := ModuleCdc.UnmarshalJSON(bz, &data); err != nil {
+			return InterchainAccountPacketDataV2{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal interchain account packet data with json: %v", err)
+		}
+	case EncodingProto:
+		if err := ModuleCdc.Unmarshal(bz, &data); err != nil {
+			return InterchainAccountPacketDataV2{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal interchain account packet data with protobuf: %v", err)
+		}
+	default:
+		return InterchainAccountPacketDataV2{}, errorsmod.Wrapf(ErrInvalidCodec, "unsupported payload encoding format %s", encoding)
+	}
+
+	if err := data.ValidateBasic(); err != nil {
+		return InterchainAccountPacketDataV2{}, err
+	}
+
+	return data, nil
+}
+
+// CosmosTxEncoding returns the encoding of the CosmosTx carried by an IBC v2 interchain accounts payload
+// with the given payload encoding. JSON encoded payloads carry proto3 JSON encoded transactions, while
+// protobuf encoded payloads carry protobuf encoded transactions.
+func CosmosTxEncoding(payloadEncoding string) (string, error) {
+	switch payloadEncoding {
+	case EncodingJSON:
+		return EncodingProto3JSON, nil
+	case EncodingProto:
+		return EncodingProtobuf, nil
+	default:
+		return "", errorsmod.Wrapf(ErrInvalidCodec, "unsupported payload encoding format %s", payloadEncoding)
+	}
+}
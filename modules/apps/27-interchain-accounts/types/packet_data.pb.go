@@ -0,0 +1,523 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/interchain_accounts/v2/packet_data.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InterchainAccountPacketDataV2 is the payload value of interchain accounts packets sent over IBC v2.
+// The interchain account executing the transaction is derived from the destination client, the sender
+// and the salt, allowing a single sender to control multiple interchain accounts on a host.
+type InterchainAccountPacketDataV2 struct {
+	// the address of the controller of the interchain account on the sending chain
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// arbitrary salt used to derive the interchain account address
+	Salt string `protobuf:"bytes,2,opt,name=salt,proto3" json:"salt,omitempty"`
+	// type of the packet data
+	Type Type `protobuf:"varint,3,opt,name=type,proto3,enum=ibc.applications.interchain_accounts.v1.Type" json:"type,omitempty"`
+	// the raw transaction, encoded as a CosmosTx
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	// optional memo
+	Memo string `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (m *InterchainAccountPacketDataV2) Reset()         { *m = InterchainAccountPacketDataV2{} }
+func (m *InterchainAccountPacketDataV2) String() string { return proto.CompactTextString(m) }
+func (*InterchainAccountPacketDataV2) ProtoMessage()    {}
+func (*InterchainAccountPacketDataV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a850111d789e77d6, []int{0}
+}
+func (m *InterchainAccountPacketDataV2) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterchainAccountPacketDataV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InterchainAccountPacketDataV2.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InterchainAccountPacketDataV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterchainAccountPacketDataV2.Merge(m, src)
+}
+func (m *InterchainAccountPacketDataV2) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterchainAccountPacketDataV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterchainAccountPacketDataV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterchainAccountPacketDataV2 proto.InternalMessageInfo
+
+func (m *InterchainAccountPacketDataV2) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *InterchainAccountPacketDataV2) GetSalt() string {
+	if m != nil {
+		return m.Salt
+	}
+	return ""
+}
+
+func (m *InterchainAccountPacketDataV2) GetType() Type {
+	if m != nil {
+		return m.Type
+	}
+	return UNSPECIFIED
+}
+
+func (m *InterchainAccountPacketDataV2) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *InterchainAccountPacketDataV2) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*InterchainAccountPacketDataV2)(nil), "ibc.applications.interchain_accounts.v2.InterchainAccountPacketDataV2")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/interchain_accounts/v2/packet_data.proto", fileDescriptor_a850111d789e77d6)
+}
+
+var fileDescriptor_a850111d789e77d6 = []byte{
+	// 295 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x50, 0xbf, 0x4a, 0xc4, 0x30,
+	0x1c, 0x6e, 0xb4, 0x1e, 0x58, 0xc4, 0xa1, 0x83, 0x14, 0xc1, 0x50, 0x5c, 0xec, 0xd2, 0xc4, 0x56,
+	0x41, 0x1c, 0x4f, 0x44, 0x70, 0x93, 0x22, 0x0e, 0x2e, 0x67, 0x9a, 0x86, 0xbb, 0x60, 0xdb, 0x84,
+	0x26, 0x2d, 0xdc, 0x5b, 0xf8, 0x34, 0x3e, 0x83, 0xe3, 0x8d, 0x8e, 0xd2, 0xbe, 0x88, 0x24, 0x3d,
+	0x4e, 0x87, 0x1b, 0x6e, 0xfb, 0xf2, 0xe3, 0xfb, 0x97, 0xcf, 0xbb, 0xe5, 0x39, 0xc5, 0x44, 0xca,
+	0x92, 0x53, 0xa2, 0xb9, 0xa8, 0x15, 0xe6, 0xb5, 0x66, 0x0d, 0x5d, 0x10, 0x5e, 0xcf, 0x08, 0xa5,
+	0xa2, 0xad, 0xb5, 0xc2, 0x5d, 0x8a, 0x25, 0xa1, 0xef, 0x4c, 0xcf, 0x0a, 0xa2, 0x09, 0x92, 0x8d,
+	0xd0, 0xc2, 0xbf, 0xe0, 0x39, 0x45, 0xff, 0xa5, 0x68, 0x8b, 0x14, 0x75, 0xe9, 0xe9, 0xf5, 0x6e,
+	0x19, 0xc9, 0x3a, 0x63, 0xb4, 0x3f, 0xff, 0x04, 0xde, 0xd9, 0xe3, 0x86, 0x37, 0x1d, 0x69, 0x4f,
+	0x96, 0x71, 0x4f, 0x34, 0x79, 0x49, 0xfd, 0x13, 0x6f, 0xa2, 0x58, 0x5d, 0xb0, 0x26, 0x00, 0x21,
+	0x88, 0x0e, 0xb3, 0xf5, 0xcb, 0xf7, 0x3d, 0x57, 0x91, 0x52, 0x07, 0x7b, 0xf6, 0x6a, 0xb1, 0x3f,
+	0xf5, 0x5c, 0xbd, 0x94, 0x2c, 0xd8, 0x0f, 0x41, 0x74, 0x9c, 0xc6, 0x68, 0xb7, 0xee, 0x09, 0x7a,
+	0x5e, 0x4a, 0x96, 0x59, 0xa9, 0xb1, 0x35, 0xbf, 0x0f, 0xdc, 0x10, 0x44, 0x47, 0x99, 0xc5, 0xe6,
+	0x56, 0xb1, 0x4a, 0x04, 0x07, 0x63, 0x94, 0xc1, 0x77, 0x6f, 0x5f, 0x3d, 0x04, 0xab, 0x1e, 0x82,
+	0x9f, 0x1e, 0x82, 0x8f, 0x01, 0x3a, 0xab, 0x01, 0x3a, 0xdf, 0x03, 0x74, 0x5e, 0x1f, 0xe6, 0x5c,
+	0x2f, 0xda, 0x1c, 0x51, 0x51, 0x61, 0x2a, 0x54, 0x25, 0x14, 0xe6, 0x39, 0x8d, 0xe7, 0x02, 0x77,
+	0xc9, 0x25, 0xae, 0x44, 0xd1, 0x96, 0x4c, 0x99, 0xa5, 0x14, 0x4e, 0x6f, 0xe2, 0xbf, 0x46, 0xf1,
+	0x66, 0x24, 0x53, 0x44, 0xe5, 0x13, 0xbb, 0xd0, 0xd5, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x65,
+	0x51, 0xb6, 0xa0, 0xbd, 0x01, 0x00, 0x00,
+}
+
+func (m *InterchainAccountPacketDataV2) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InterchainAccountPacketDataV2) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterchainAccountPacketDataV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintPacketData(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintPacketData(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Type != 0 {
+		i = encodeVarintPacketData(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintPacketData(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintPacketData(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPacketData(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPacketData(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InterchainAccountPacketDataV2) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovPacketData(uint64(l))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovPacketData(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovPacketData(uint64(m.Type))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovPacketData(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovPacketData(uint64(l))
+	}
+	return n
+}
+
+func sovPacketData(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPacketData(x uint64) (n int) {
+	return sovPacketData(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InterchainAccountPacketDataV2) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacketData
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterchainAccountPacketDataV2: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterchainAccountPacketDataV2: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacketData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacketData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacketData
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacketData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacketData(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacketData
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPacketData(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPacketData
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacketData
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacketData
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPacketData
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPacketData
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPacketData
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPacketData        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPacketData          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPacketData = fmt.Errorf("proto: unexpected end of group")
+)
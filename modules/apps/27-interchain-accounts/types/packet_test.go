@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
 	ibctesting "github.com/cosmos/ibc-go/v10/testing"
 )
 
@@ -201,3 +202,150 @@ func (suite *TypesTestSuite) TestPacketDataUnmarshalerInterface() {
 	suite.Require().Error(err)
 	suite.Require().Equal(types.InterchainAccountPacketData{}, invalidPacketData)
 }
+
+func (suite *TypesTestSuite) TestValidateBasicV2() {
+	testCases := []struct {
+		name       string
+		packetData types.InterchainAccountPacketDataV2
+		expErr     error
+	}{
+		{
+			"success",
+			types.NewInterchainAccountPacketDataV2(TestOwnerAddress, "salt", types.EXECUTE_TX, []byte("data"), "memo"),
+			nil,
+		},
+		{
+			"success, empty salt and memo",
+			types.NewInterchainAccountPacketDataV2(TestOwnerAddress, "", types.EXECUTE_TX, []byte("data"), ""),
+			nil,
+		},
+		{
+			"empty sender",
+			types.NewInterchainAccountPacketDataV2(" ", "salt", types.EXECUTE_TX, []byte("data"), "memo"),
+			types.ErrInvalidOutgoingData,
+		},
+		{
+			"sender too long",
+			types.NewInterchainAccountPacketDataV2(ibctesting.GenerateString(uint(types.DefaultMaxAddrLength)+1), "salt", types.EXECUTE_TX, []byte("data"), "memo"),
+			types.ErrInvalidOutgoingData,
+		},
+		{
+			"salt too long",
+			types.NewInterchainAccountPacketDataV2(TestOwnerAddress, ibctesting.GenerateString(types.MaxSaltLength+1), types.EXECUTE_TX, []byte("data"), "memo"),
+			types.ErrInvalidOutgoingData,
+		},
+		{
+			"type unspecified",
+			types.NewInterchainAccountPacketDataV2(TestOwnerAddress, "salt", types.UNSPECIFIED, []byte("data"), "memo"),
+			types.ErrInvalidOutgoingData,
+		},
+		{
+			"empty data",
+			types.NewInterchainAccountPacketDataV2(TestOwnerAddress, "salt", types.EXECUTE_TX, nil, "memo"),
+			types.ErrInvalidOutgoingData,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.packetData.ValidateBasic()
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestUnmarshalPacketDataV2() {
+	var (
+		bz       []byte
+		version  string
+		encoding string
+	)
+
+	expPacketData := types.NewInterchainAccountPacketDataV2(TestOwnerAddress, "salt", types.EXECUTE_TX, []byte("data"), "memo")
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: protobuf encoding",
+			func() {},
+			nil,
+		},
+		{
+			"success: json encoding",
+			func() {
+				encoding = types.EncodingJSON
+				bz = types.ModuleCdc.MustMarshalJSON(&expPacketData)
+			},
+			nil,
+		},
+		{
+			"failure: invalid version",
+			func() {
+				version = "ics27-2"
+			},
+			types.ErrInvalidVersion,
+		},
+		{
+			"failure: unsupported encoding",
+			func() {
+				encoding = "application/x-solidity-abi"
+			},
+			types.ErrInvalidCodec,
+		},
+		{
+			"failure: encoding does not match value",
+			func() {
+				encoding = types.EncodingJSON
+			},
+			ibcerrors.ErrInvalidType,
+		},
+		{
+			"failure: invalid packet data",
+			func() {
+				invalidPacketData := types.NewInterchainAccountPacketDataV2("", "salt", types.EXECUTE_TX, []byte("data"), "memo")
+				bz = types.ModuleCdc.MustMarshal(&invalidPacketData)
+			},
+			types.ErrInvalidOutgoingData,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			bz = types.ModuleCdc.MustMarshal(&expPacketData)
+			version = types.Version
+			encoding = types.EncodingProto
+
+			tc.malleate()
+
+			packetData, err := types.UnmarshalPacketDataV2(bz, version, encoding)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expPacketData, packetData)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestCosmosTxEncoding() {
+	encoding, err := types.CosmosTxEncoding(types.EncodingJSON)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.EncodingProto3JSON, encoding)
+
+	encoding, err = types.CosmosTxEncoding(types.EncodingProto)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.EncodingProtobuf, encoding)
+
+	_, err = types.CosmosTxEncoding(types.EncodingProtobuf)
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+}
@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the query commands for 29-fee
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        "ibc-fee",
+		Short:                      "IBC relayer incentivization query subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdIncentivizedPackets(),
+		GetCmdIncentivizedPacket(),
+		GetCmdPayee(),
+		GetCmdCounterpartyPayee(),
+	)
+
+	return queryCmd
+}
+
+// NewTxCmd returns the transaction commands for 29-fee
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        "ibc-fee",
+		Short:                      "IBC relayer incentivization transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	txCmd.AddCommand(
+		NewRegisterPayeeCmd(),
+		NewRegisterCounterpartyPayeeCmd(),
+		NewPayPacketFeeCmd(),
+	)
+
+	return txCmd
+}
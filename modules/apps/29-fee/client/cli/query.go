@@ -0,0 +1,160 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/29-fee/types"
+)
+
+// GetCmdIncentivizedPackets returns all of the incentivized packets
+func GetCmdIncentivizedPackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "packets",
+		Short:   "Query for all of the incentivized packets and their associated fees",
+		Long:    "Query for all of the incentivized packets and their associated fees",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc-fee packets", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryIncentivizedPacketsRequest{
+				Pagination: pageReq,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.IncentivizedPackets(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "packets")
+
+	return cmd
+}
+
+// GetCmdIncentivizedPacket returns the fees escrowed for an incentivized packet
+func GetCmdIncentivizedPacket() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "packet [client-id] [sequence]",
+		Short:   "Query for an unrelayed incentivized packet by client-id and sequence",
+		Long:    "Query for an unrelayed incentivized packet by client-id and sequence",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query ibc-fee packet 07-tendermint-0 1", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			seq, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryIncentivizedPacketRequest{
+				ClientId: args[0],
+				Sequence: seq,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.IncentivizedPacket(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdPayee returns the command handler for the Query/Payee rpc.
+func GetCmdPayee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "payee [client-id] [relayer]",
+		Short:   "Query the relayer payee address on a given client",
+		Long:    "Query the relayer payee address on a given client",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query ibc-fee payee 07-tendermint-0 cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPayeeRequest{
+				ClientId: args[0],
+				Relayer:  args[1],
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Payee(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdCounterpartyPayee returns the command handler for the Query/CounterpartyPayee rpc.
+func GetCmdCounterpartyPayee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "counterparty-payee [client-id] [relayer]",
+		Short:   "Query the relayer counterparty payee on a given client",
+		Long:    "Query the relayer counterparty payee on a given client",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query ibc-fee counterparty-payee 07-tendermint-0 cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryCounterpartyPayeeRequest{
+				ClientId: args[0],
+				Relayer:  args[1],
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CounterpartyPayee(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
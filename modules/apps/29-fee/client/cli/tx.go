@@ -0,0 +1,131 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/29-fee/types"
+)
+
+const (
+	flagRecvFee    = "recv-fee"
+	flagAckFee     = "ack-fee"
+	flagTimeoutFee = "timeout-fee"
+)
+
+// NewRegisterPayeeCmd returns the command to create a MsgRegisterPayee
+func NewRegisterPayeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "register-payee [client-id] [relayer] [payee]",
+		Short:   "Register a payee on a given client.",
+		Long:    strings.TrimSpace(`Register a payee address on a given client. The payee is paid the acknowledgement and timeout fees of packets relayed by the relayer.`),
+		Example: fmt.Sprintf("%s tx ibc-fee register-payee 07-tendermint-0 cosmos1rsp837a4kvtgp2m4uqzdge0zzu6efqgucm0qdh cosmos153lf4zntqt33a4v0sm5cytrxyqn78q7kz8j8x5", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterPayee(args[0], args[1], args[2])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRegisterCounterpartyPayeeCmd returns the command to create a MsgRegisterCounterpartyPayee
+func NewRegisterCounterpartyPayeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "register-counterparty-payee [client-id] [relayer] [counterparty-payee]",
+		Short:   "Register a counterparty payee address on a given client.",
+		Long:    strings.TrimSpace(`Register a counterparty payee address on a given client. The counterparty payee is paid the receive fees of packets relayed by the relayer on the counterparty chain.`),
+		Example: fmt.Sprintf("%s tx ibc-fee register-counterparty-payee 07-tendermint-0 cosmos1rsp837a4kvtgp2m4uqzdge0zzu6efqgucm0qdh osmo1v5y0tz01llxzf4c2afml8s3awue0ymju22wxx2", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterCounterpartyPayee(args[0], args[1], args[2])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewPayPacketFeeCmd returns the command to create a MsgPayPacketFee
+func NewPayPacketFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pay-packet-fee [client-id] [sequence]",
+		Short:   "Pay a fee to incentivize an existing IBC v2 packet",
+		Long:    "Pay a fee to incentivize an existing IBC v2 packet. The payload of the packet must be sent with the fee version metadata for the fee to be distributed to relayers.",
+		Example: fmt.Sprintf("%s tx ibc-fee pay-packet-fee 07-tendermint-0 1 --recv-fee 10stake --ack-fee 10stake --timeout-fee 10stake", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			seq, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			fee, err := parseFeeFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPayPacketFee(args[0], seq, fee, clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagRecvFee, "", "Fee paid to a relayer for relaying a packet receive.")
+	cmd.Flags().String(flagAckFee, "", "Fee paid to a relayer for relaying a packet acknowledgement.")
+	cmd.Flags().String(flagTimeoutFee, "", "Fee paid to a relayer for relaying a packet timeout.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseFeeFlags parses the recv, ack and timeout fee flags into a Fee
+func parseFeeFlags(cmd *cobra.Command) (types.Fee, error) {
+	var coins []sdk.Coins
+	for _, flag := range []string{flagRecvFee, flagAckFee, flagTimeoutFee} {
+		feeStr, err := cmd.Flags().GetString(flag)
+		if err != nil {
+			return types.Fee{}, err
+		}
+
+		fee, err := sdk.ParseCoinsNormalized(feeStr)
+		if err != nil {
+			return types.Fee{}, err
+		}
+
+		coins = append(coins, fee)
+	}
+
+	return types.NewFee(coins[0], coins[1], coins[2]), nil
+}
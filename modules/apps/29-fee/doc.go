@@ -0,0 +1,12 @@
+/*
+Package fee implements the ICS29 relayer incentivization middleware for IBC v2 packets.
+
+A packet sender escrows receive, acknowledgement and timeout fees for an in-flight packet, keyed by
+its source client and sequence. A payload is incentivized when its version is the JSON encoded fee
+Metadata wrapping the version of the underlying application. On the destination chain the middleware
+wraps the acknowledgement of incentivized payloads with the counterparty payee registered by the
+relayer which submitted the receive, or the relayer address itself. On the source chain the receive
+fee is paid to that address, the acknowledgement and timeout fees are paid to the payee registered by
+the relayer which submitted the acknowledgement or timeout, and unused fees are refunded.
+*/
+package fee
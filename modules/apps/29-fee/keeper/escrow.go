@@ -0,0 +1,210 @@
+package keeper
+
+import (
+	"bytes"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/29-fee/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+)
+
+// escrowPacketFee sends the packet fee to the 29-fee module account to hold in escrow
+func (k Keeper) escrowPacketFee(ctx sdk.Context, clientID string, sequence uint64, packetFee types.PacketFee) error {
+	// check if the refund address is valid
+	refundAddr, err := sdk.AccAddressFromBech32(packetFee.RefundAddress)
+	if err != nil {
+		return err
+	}
+
+	coins := packetFee.Fee.Total()
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, refundAddr, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	// multiple fees may be escrowed for a single packet, firstly create a slice containing the new fee
+	// retrieve any previous fees stored in escrow for the packet and append them to the list
+	fees := []types.PacketFee{packetFee}
+	if feesInEscrow, found := k.GetFeesInEscrow(ctx, clientID, sequence); found {
+		fees = append(fees, feesInEscrow.PacketFees...)
+	}
+
+	packetFees := types.NewPacketFees(fees)
+	k.SetFeesInEscrow(ctx, clientID, sequence, packetFees)
+
+	emitIncentivizedPacketEvent(ctx, clientID, sequence, packetFees)
+
+	return nil
+}
+
+// DistributePacketFeesOnAcknowledgement pays all the acknowledgement & receive fees for a given packet and refunds the timeout fees
+func (k Keeper) DistributePacketFeesOnAcknowledgement(ctx sdk.Context, forwardRelayer string, reverseRelayer sdk.AccAddress, packetFees []types.PacketFee, clientID string, sequence uint64) {
+	// use a cached context to prevent accidental state changes
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	// forward relayer address will be empty if conversion fails
+	forwardAddr, _ := sdk.AccAddressFromBech32(forwardRelayer)
+
+	for _, packetFee := range packetFees {
+		if !k.EscrowAccountHasBalance(cacheCtx, packetFee.Fee.Total()) {
+			// if the escrow account does not have sufficient funds then there must exist a severe bug
+			k.Logger(ctx).Error("escrow account has insufficient funds to distribute packet fees", "client-id", clientID, "sequence", sequence)
+			return
+		}
+
+		// check if refundAcc address works
+		refundAddr, err := sdk.AccAddressFromBech32(packetFee.RefundAddress)
+		if err != nil {
+			panic(errorsmod.Wrapf(err, "could not parse refundAcc %s to sdk.AccAddress", packetFee.RefundAddress))
+		}
+
+		k.distributePacketFeeOnAcknowledgement(cacheCtx, refundAddr, forwardAddr, reverseRelayer, packetFee)
+	}
+
+	// write the cache
+	writeFn()
+
+	// removes the fees from the store as fees are now paid
+	k.DeleteFeesInEscrow(ctx, clientID, sequence)
+}
+
+// distributePacketFeeOnAcknowledgement pays the receive fee for a given packetID while refunding the timeout fee to the refund account associated with the Fee.
+// If there was no forward relayer or the associated forward relayer address is blocked, the receive fee is refunded.
+func (k Keeper) distributePacketFeeOnAcknowledgement(ctx sdk.Context, refundAddr, forwardRelayer, reverseRelayer sdk.AccAddress, packetFee types.PacketFee) {
+	// distribute fee to valid forward relayer address otherwise refund the fee
+	if !forwardRelayer.Empty() && !k.bankKeeper.BlockedAddr(forwardRelayer) {
+		// distribute fee for forward relaying
+		k.distributeFee(ctx, forwardRelayer, refundAddr, packetFee.Fee.RecvFee)
+	} else {
+		// refund onRecv fee as forward relayer is not valid
+		k.distributeFee(ctx, refundAddr, refundAddr, packetFee.Fee.RecvFee)
+	}
+
+	// distribute fee for reverse relaying
+	k.distributeFee(ctx, reverseRelayer, refundAddr, packetFee.Fee.AckFee)
+
+	// refund timeout fee for unused timeout
+	refundCoins := packetFee.Fee.Total().Sub(packetFee.Fee.RecvFee...).Sub(packetFee.Fee.AckFee...)
+	k.distributeFee(ctx, refundAddr, refundAddr, refundCoins)
+}
+
+// DistributePacketFeesOnTimeout pays all the timeout fees for a given packet and refunds the acknowledgement & receive fees
+func (k Keeper) DistributePacketFeesOnTimeout(ctx sdk.Context, timeoutRelayer sdk.AccAddress, packetFees []types.PacketFee, clientID string, sequence uint64) {
+	// use a cached context to prevent accidental state changes
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	for _, packetFee := range packetFees {
+		if !k.EscrowAccountHasBalance(cacheCtx, packetFee.Fee.Total()) {
+			// if the escrow account does not have sufficient funds then there must exist a severe bug
+			k.Logger(ctx).Error("escrow account has insufficient funds to distribute packet fees", "client-id", clientID, "sequence", sequence)
+			return
+		}
+
+		// check if refundAcc address works
+		refundAddr, err := sdk.AccAddressFromBech32(packetFee.RefundAddress)
+		if err != nil {
+			panic(errorsmod.Wrapf(err, "could not parse refundAcc %s to sdk.AccAddress", packetFee.RefundAddress))
+		}
+
+		k.distributePacketFeeOnTimeout(cacheCtx, refundAddr, timeoutRelayer, packetFee)
+	}
+
+	// write the cache
+	writeFn()
+
+	// removing the fee from the store as the fee is now paid
+	k.DeleteFeesInEscrow(ctx, clientID, sequence)
+}
+
+// distributePacketFeeOnTimeout pays the timeout fee to the timeout relayer and refunds the acknowledgement & receive fee.
+func (k Keeper) distributePacketFeeOnTimeout(ctx sdk.Context, refundAddr, timeoutRelayer sdk.AccAddress, packetFee types.PacketFee) {
+	// distribute fee for timeout relaying
+	k.distributeFee(ctx, timeoutRelayer, refundAddr, packetFee.Fee.TimeoutFee)
+
+	// refund receive and acknowledgement fee for unused fees
+	refundCoins := packetFee.Fee.Total().Sub(packetFee.Fee.TimeoutFee...)
+	k.distributeFee(ctx, refundAddr, refundAddr, refundCoins)
+}
+
+// RefundPacketFees refunds all the fees escrowed for a given packet. It is used when the fees cannot be
+// distributed, for instance because the payload of the packet is not incentivized.
+func (k Keeper) RefundPacketFees(ctx sdk.Context, packetFees []types.PacketFee, clientID string, sequence uint64) {
+	// use a cached context to prevent accidental state changes
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	for _, packetFee := range packetFees {
+		if !k.EscrowAccountHasBalance(cacheCtx, packetFee.Fee.Total()) {
+			// if the escrow account does not have sufficient funds then there must exist a severe bug
+			k.Logger(ctx).Error("escrow account has insufficient funds to refund packet fees", "client-id", clientID, "sequence", sequence)
+			return
+		}
+
+		// check if refundAcc address works
+		refundAddr, err := sdk.AccAddressFromBech32(packetFee.RefundAddress)
+		if err != nil {
+			panic(errorsmod.Wrapf(err, "could not parse refundAcc %s to sdk.AccAddress", packetFee.RefundAddress))
+		}
+
+		k.distributeFee(cacheCtx, refundAddr, refundAddr, packetFee.Fee.Total())
+	}
+
+	// write the cache
+	writeFn()
+
+	// removing the fee from the store as the fee is now refunded
+	k.DeleteFeesInEscrow(ctx, clientID, sequence)
+}
+
+// distributeFee will attempt to distribute the escrowed fee to the receiver address.
+// If the distribution fails for any reason (such as the receiving address being blocked),
+// the state changes will be discarded and the fee will be refunded to the refund address.
+func (k Keeper) distributeFee(ctx sdk.Context, receiver, refundAccAddress sdk.AccAddress, fee sdk.Coins) {
+	// cache context before trying to distribute fees
+	// if the escrow account has insufficient balance then we want to avoid partially distributing fees
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	// send fee to receiver
+	err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, receiver, fee)
+	if err != nil {
+		if bytes.Equal(receiver, refundAccAddress) {
+			k.Logger(ctx).Error("error distributing fee", "receiver address", receiver, "fee", fee)
+			return // if sending to the refund address already failed, then return (no-op)
+		}
+
+		// if an error is returned from x/bank and the receiver is not the refundAccAddress
+		// then attempt to refund the fee to the original sender
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, refundAccAddress, fee)
+		if err != nil {
+			k.Logger(ctx).Error("error refunding fee to the original sender", "refund address", refundAccAddress, "fee", fee)
+			return // if sending to the refund address fails, no-op
+		}
+
+		emitDistributeFeeEvent(ctx, refundAccAddress.String(), fee)
+	} else {
+		emitDistributeFeeEvent(ctx, receiver.String(), fee)
+	}
+
+	// write the cache
+	writeFn()
+}
+
+// EscrowAccountHasBalance verifies if the escrow account has the provided fee.
+func (k Keeper) EscrowAccountHasBalance(ctx sdk.Context, coins sdk.Coins) bool {
+	return k.bankKeeper.SpendableCoins(ctx, k.GetFeeModuleAddress()).IsAllGTE(coins)
+}
+
+// validatePacketFee performs the stateful validation of a packet fee to be escrowed.
+func (k Keeper) validatePacketFee(ctx sdk.Context, packetFee types.PacketFee) error {
+	refundAddr, err := sdk.AccAddressFromBech32(packetFee.RefundAddress)
+	if err != nil {
+		return err
+	}
+
+	if k.bankKeeper.BlockedAddr(refundAddr) {
+		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to escrow fees", refundAddr)
+	}
+
+	return k.bankKeeper.IsSendEnabledCoins(ctx, packetFee.Fee.Total()...)
+}
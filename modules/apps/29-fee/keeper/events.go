@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/29-fee/types"
+)
+
+// emitIncentivizedPacketEvent emits an event containing information on the total amount of fees incentivizing
+// a specific packet. It should be emitted on every fee escrowed for the given packet.
+func emitIncentivizedPacketEvent(ctx sdk.Context, clientID string, sequence uint64, packetFees types.PacketFees) {
+	var (
+		totalRecvFees    sdk.Coins
+		totalAckFees     sdk.Coins
+		totalTimeoutFees sdk.Coins
+	)
+
+	for _, fee := range packetFees.PacketFees {
+		totalRecvFees = totalRecvFees.Add(fee.Fee.RecvFee...)
+		totalAckFees = totalAckFees.Add(fee.Fee.AckFee...)
+		totalTimeoutFees = totalTimeoutFees.Add(fee.Fee.TimeoutFee...)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeIncentivizedPacket,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecvFee, totalRecvFees.String()),
+			sdk.NewAttribute(types.AttributeKeyAckFee, totalAckFees.String()),
+			sdk.NewAttribute(types.AttributeKeyTimeoutFee, totalTimeoutFees.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitRegisterPayeeEvent emits an event containing information of a registered payee for a relayer on a particular client
+func emitRegisterPayeeEvent(ctx sdk.Context, relayer, payee, clientID string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRegisterPayee,
+			sdk.NewAttribute(types.AttributeKeyRelayer, relayer),
+			sdk.NewAttribute(types.AttributeKeyPayee, payee),
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitRegisterCounterpartyPayeeEvent emits an event containing information of a registered counterparty payee for a relayer on a particular client
+func emitRegisterCounterpartyPayeeEvent(ctx sdk.Context, relayer, counterpartyPayee, clientID string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRegisterCounterpartyPayee,
+			sdk.NewAttribute(types.AttributeKeyRelayer, relayer),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyPayee, counterpartyPayee),
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitDistributeFeeEvent emits an event containing a distribution fee and receiver address
+func emitDistributeFeeEvent(ctx sdk.Context, receiver string, fee sdk.Coins) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDistributeFee,
+			sdk.NewAttribute(types.AttributeKeyReceiver, receiver),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
+	})
+}
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/29-fee/types"
+)
+
+// InitGenesis initializes the fee middleware application state from a provided genesis state
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	for _, identifiedFees := range state.IdentifiedFees {
+		k.SetFeesInEscrow(ctx, identifiedFees.ClientId, identifiedFees.Sequence, types.NewPacketFees(identifiedFees.PacketFees))
+	}
+
+	for _, registeredPayee := range state.RegisteredPayees {
+		k.SetPayeeAddress(ctx, registeredPayee.Relayer, registeredPayee.Payee, registeredPayee.ClientId)
+	}
+
+	for _, registeredCounterpartyPayee := range state.RegisteredCounterpartyPayees {
+		k.SetCounterpartyPayeeAddress(ctx, registeredCounterpartyPayee.Relayer, registeredCounterpartyPayee.CounterpartyPayee, registeredCounterpartyPayee.ClientId)
+	}
+
+	for _, forwardAddr := range state.ForwardRelayers {
+		k.SetRelayerAddressForAsyncAck(ctx, forwardAddr.ClientId, forwardAddr.Sequence, forwardAddr.Address)
+	}
+}
+
+// ExportGenesis returns the fee middleware application exported genesis
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(
+		k.GetAllIdentifiedPacketFees(ctx),
+		k.GetAllPayees(ctx),
+		k.GetAllCounterpartyPayees(ctx),
+		k.GetAllForwardRelayerAddresses(ctx),
+	)
+}
@@ -0,0 +1,37 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v10/modules/apps/29-fee/types"
+)
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	ctx := suite.chainA.GetContext()
+	feeKeeper := suite.chainA.GetSimApp().IBCFeeKeeper
+
+	clientID := suite.path.EndpointA.ClientID
+	relayer := suite.chainA.SenderAccounts[0].SenderAccount.GetAddress().String()
+	payee := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	packetFees := []types.PacketFee{types.NewPacketFee(fee, relayer)}
+
+	feeKeeper.SetFeesInEscrow(ctx, clientID, 1, types.NewPacketFees(packetFees))
+	feeKeeper.SetPayeeAddress(ctx, relayer, payee, clientID)
+	feeKeeper.SetCounterpartyPayeeAddress(ctx, relayer, payee, clientID)
+	feeKeeper.SetRelayerAddressForAsyncAck(ctx, clientID, 1, relayer)
+
+	genesis := feeKeeper.ExportGenesis(ctx)
+	suite.Require().NoError(genesis.Validate())
+	suite.Require().Equal([]types.IdentifiedPacketFees{types.NewIdentifiedPacketFees(clientID, 1, packetFees)}, genesis.IdentifiedFees)
+	suite.Require().Equal([]types.RegisteredPayee{types.NewRegisteredPayee(clientID, relayer, payee)}, genesis.RegisteredPayees)
+	suite.Require().Equal([]types.RegisteredCounterpartyPayee{types.NewRegisteredCounterpartyPayee(clientID, relayer, payee)}, genesis.RegisteredCounterpartyPayees)
+	suite.Require().Equal([]types.ForwardRelayerAddress{types.NewForwardRelayerAddress(relayer, clientID, 1)}, genesis.ForwardRelayers)
+
+	// initialize a fresh chain with the exported genesis
+	suite.SetupTest()
+	ctx = suite.chainA.GetContext()
+	feeKeeper = suite.chainA.GetSimApp().IBCFeeKeeper
+
+	feeKeeper.InitGenesis(ctx, *genesis)
+	suite.Require().Equal(genesis, feeKeeper.ExportGenesis(ctx))
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/29-fee/types"
+)
+
+var _ types.QueryServer = (*Keeper)(nil)
+
+// IncentivizedPackets implements the Query/IncentivizedPackets gRPC method
+func (k Keeper) IncentivizedPackets(goCtx context.Context, req *types.QueryIncentivizedPacketsRequest) (*types.QueryIncentivizedPacketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var identifiedPackets []types.IdentifiedPacketFees
+	pageRes, err := query.Paginate(k.feesInEscrowStore(ctx), req.Pagination, func(key, value []byte) error {
+		clientID, sequence, err := types.ParseKeyFeesInEscrow(types.FeesInEscrowPrefix + string(key))
+		if err != nil {
+			return err
+		}
+
+		var packetFees types.PacketFees
+		k.cdc.MustUnmarshal(value, &packetFees)
+
+		identifiedPackets = append(identifiedPackets, types.NewIdentifiedPacketFees(clientID, sequence, packetFees.PacketFees))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryIncentivizedPacketsResponse{
+		IncentivizedPackets: identifiedPackets,
+		Pagination:          pageRes,
+	}, nil
+}
+
+// IncentivizedPacket implements the Query/IncentivizedPacket gRPC method
+func (k Keeper) IncentivizedPacket(goCtx context.Context, req *types.QueryIncentivizedPacketRequest) (*types.QueryIncentivizedPacketResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	feesInEscrow, exists := k.GetFeesInEscrow(ctx, req.ClientId, req.Sequence)
+	if !exists {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrFeeNotFound, "client-id: %s, sequence: %d", req.ClientId, req.Sequence).Error(),
+		)
+	}
+
+	return &types.QueryIncentivizedPacketResponse{
+		IncentivizedPacket: types.NewIdentifiedPacketFees(req.ClientId, req.Sequence, feesInEscrow.PacketFees),
+	}, nil
+}
+
+// Payee implements the Query/Payee gRPC method and returns the registered payee address to which packet fees are paid out
+func (k Keeper) Payee(goCtx context.Context, req *types.QueryPayeeRequest) (*types.QueryPayeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	payeeAddr, found := k.GetPayeeAddress(ctx, req.Relayer, req.ClientId)
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("payee address not found for address: %s on client: %s", req.Relayer, req.ClientId))
+	}
+
+	return &types.QueryPayeeResponse{
+		PayeeAddress: payeeAddr,
+	}, nil
+}
+
+// CounterpartyPayee implements the Query/CounterpartyPayee gRPC method and returns the registered counterparty payee address for forward relaying
+func (k Keeper) CounterpartyPayee(goCtx context.Context, req *types.QueryCounterpartyPayeeRequest) (*types.QueryCounterpartyPayeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	counterpartyPayeeAddr, found := k.GetCounterpartyPayeeAddress(ctx, req.Relayer, req.ClientId)
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("counterparty payee address not found for address: %s on client: %s", req.Relayer, req.ClientId))
+	}
+
+	return &types.QueryCounterpartyPayeeResponse{
+		CounterpartyPayee: counterpartyPayeeAddr,
+	}, nil
+}
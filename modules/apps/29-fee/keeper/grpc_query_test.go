@@ -0,0 +1,233 @@
+package keeper_test
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/29-fee/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+func (suite *KeeperTestSuite) TestQueryIncentivizedPackets() {
+	var (
+		req               *types.QueryIncentivizedPacketsRequest
+		expIdentifiedFees []types.IdentifiedPacketFees
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: paginated",
+			func() {
+				req.Pagination = &query.PageRequest{Limit: 2}
+				expIdentifiedFees = expIdentifiedFees[:2]
+			},
+			nil,
+		},
+		{
+			"success: no fees in escrow",
+			func() {
+				for _, identifiedFees := range expIdentifiedFees {
+					suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeesInEscrow(suite.chainA.GetContext(), identifiedFees.ClientId, identifiedFees.Sequence)
+				}
+				expIdentifiedFees = nil
+			},
+			nil,
+		},
+		{
+			"failure: empty request",
+			func() { req = nil },
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			packetFees := []types.PacketFee{types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String())}
+
+			expIdentifiedFees = nil
+			for seq := uint64(1); seq <= 3; seq++ {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), ibctesting.FirstClientID, seq, types.NewPacketFees(packetFees))
+				expIdentifiedFees = append(expIdentifiedFees, types.NewIdentifiedPacketFees(ibctesting.FirstClientID, seq, packetFees))
+			}
+
+			req = &types.QueryIncentivizedPacketsRequest{}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.IncentivizedPackets(suite.chainA.GetContext(), req)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expIdentifiedFees, res.IncentivizedPackets)
+			} else {
+				suite.Require().Equal(status.Code(tc.expErr), status.Code(err))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryIncentivizedPacket() {
+	var req *types.QueryIncentivizedPacketRequest
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: fees not found for packet",
+			func() { req.Sequence = 2 },
+			status.Error(codes.NotFound, "not found"),
+		},
+		{
+			"failure: empty request",
+			func() { req = nil },
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			packetFees := []types.PacketFee{types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String())}
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), ibctesting.FirstClientID, 1, types.NewPacketFees(packetFees))
+
+			req = &types.QueryIncentivizedPacketRequest{
+				ClientId: ibctesting.FirstClientID,
+				Sequence: 1,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.IncentivizedPacket(suite.chainA.GetContext(), req)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(types.NewIdentifiedPacketFees(ibctesting.FirstClientID, 1, packetFees), res.IncentivizedPacket)
+			} else {
+				suite.Require().Equal(status.Code(tc.expErr), status.Code(err))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPayee() {
+	var req *types.QueryPayeeRequest
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: payee not found",
+			func() { req.ClientId = ibctesting.SecondClientID },
+			status.Error(codes.NotFound, "not found"),
+		},
+		{
+			"failure: empty request",
+			func() { req = nil },
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			relayer := suite.chainA.SenderAccounts[0].SenderAccount.GetAddress().String()
+			payee := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddress(suite.chainA.GetContext(), relayer, payee, ibctesting.FirstClientID)
+
+			req = &types.QueryPayeeRequest{
+				ClientId: ibctesting.FirstClientID,
+				Relayer:  relayer,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.Payee(suite.chainA.GetContext(), req)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(payee, res.PayeeAddress)
+			} else {
+				suite.Require().Equal(status.Code(tc.expErr), status.Code(err))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryCounterpartyPayee() {
+	var req *types.QueryCounterpartyPayeeRequest
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: counterparty payee not found",
+			func() { req.ClientId = ibctesting.SecondClientID },
+			status.Error(codes.NotFound, "not found"),
+		},
+		{
+			"failure: empty request",
+			func() { req = nil },
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			relayer := suite.chainA.SenderAccounts[0].SenderAccount.GetAddress().String()
+			counterpartyPayee := suite.chainB.SenderAccounts[1].SenderAccount.GetAddress().String()
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetCounterpartyPayeeAddress(suite.chainA.GetContext(), relayer, counterpartyPayee, ibctesting.FirstClientID)
+
+			req = &types.QueryCounterpartyPayeeRequest{
+				ClientId: ibctesting.FirstClientID,
+				Relayer:  relayer,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.CounterpartyPayee(suite.chainA.GetContext(), req)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(counterpartyPayee, res.CounterpartyPayee)
+			} else {
+				suite.Require().Equal(status.Code(tc.expErr), status.Code(err))
+			}
+		})
+	}
+}
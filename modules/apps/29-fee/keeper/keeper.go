@@ -0,0 +1,249 @@
+package keeper
+
+import (
+	"errors"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/29-fee/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+// Keeper defines the IBC fee middleware keeper
+type Keeper struct {
+	storeService corestore.KVStoreService
+	cdc          codec.BinaryCodec
+
+	clientKeeper    types.ClientKeeper
+	channelKeeperV2 types.ChannelKeeperV2
+	authKeeper      types.AccountKeeper
+	bankKeeper      types.BankKeeper
+}
+
+// NewKeeper creates a new 29-fee Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService corestore.KVStoreService,
+	clientKeeper types.ClientKeeper,
+	channelKeeperV2 types.ChannelKeeperV2,
+	authKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+) Keeper {
+	// ensure ibc fee module account is set
+	if addr := authKeeper.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(errors.New("the IBC fee module account has not been set"))
+	}
+
+	return Keeper{
+		cdc:             cdc,
+		storeService:    storeService,
+		clientKeeper:    clientKeeper,
+		channelKeeperV2: channelKeeperV2,
+		authKeeper:      authKeeper,
+		bankKeeper:      bankKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+ibcexported.ModuleName+"-"+types.ModuleName)
+}
+
+// GetFeeModuleAddress returns the ICS29 Fee ModuleAccount address
+func (k Keeper) GetFeeModuleAddress() sdk.AccAddress {
+	return k.authKeeper.GetModuleAddress(types.ModuleName)
+}
+
+// WriteAcknowledgement writes the acknowledgement of an asynchronously acknowledged packet
+// through the IBC v2 channel keeper.
+func (k Keeper) WriteAcknowledgement(ctx sdk.Context, clientID string, sequence uint64, ack channeltypesv2.Acknowledgement) error {
+	return k.channelKeeperV2.WriteAcknowledgement(ctx, clientID, sequence, ack)
+}
+
+// SetPayeeAddress stores the fee payee address in state keyed by the provided client identifier and relayer address
+func (k Keeper) SetPayeeAddress(ctx sdk.Context, relayerAddr, payeeAddr, clientID string) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.KeyPayee(relayerAddr, clientID), []byte(payeeAddr))
+}
+
+// GetPayeeAddress retrieves the fee payee address stored in state given the provided client identifier and relayer address
+func (k Keeper) GetPayeeAddress(ctx sdk.Context, relayerAddr, clientID string) (string, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.KeyPayee(relayerAddr, clientID))
+	if len(bz) == 0 {
+		return "", false
+	}
+
+	return string(bz), true
+}
+
+// GetAllPayees returns all registered payees addresses
+func (k Keeper) GetAllPayees(ctx sdk.Context) []types.RegisteredPayee {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.PayeeKeyPrefix))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var registeredPayees []types.RegisteredPayee
+	for ; iterator.Valid(); iterator.Next() {
+		relayerAddr, clientID, err := types.ParseKeyPayeeAddress(string(iterator.Key()))
+		if err != nil {
+			panic(err)
+		}
+
+		payee := types.NewRegisteredPayee(clientID, relayerAddr, string(iterator.Value()))
+		registeredPayees = append(registeredPayees, payee)
+	}
+
+	return registeredPayees
+}
+
+// SetCounterpartyPayeeAddress maps the destination client identifier and relayer address to the
+// counterparty payee address
+func (k Keeper) SetCounterpartyPayeeAddress(ctx sdk.Context, relayerAddr, counterpartyAddress, clientID string) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.KeyCounterpartyPayee(relayerAddr, clientID), []byte(counterpartyAddress))
+}
+
+// GetCounterpartyPayeeAddress gets the counterparty payee address given a destination relayer address
+func (k Keeper) GetCounterpartyPayeeAddress(ctx sdk.Context, relayerAddr, clientID string) (string, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	key := types.KeyCounterpartyPayee(relayerAddr, clientID)
+
+	addr := store.Get(key)
+	if len(addr) == 0 {
+		return "", false
+	}
+
+	return string(addr), true
+}
+
+// GetAllCounterpartyPayees returns all registered counterparty payee addresses
+func (k Keeper) GetAllCounterpartyPayees(ctx sdk.Context) []types.RegisteredCounterpartyPayee {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.CounterpartyPayeeKeyPrefix))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var registeredCounterpartyPayees []types.RegisteredCounterpartyPayee
+	for ; iterator.Valid(); iterator.Next() {
+		relayerAddr, clientID, err := types.ParseKeyCounterpartyPayee(string(iterator.Key()))
+		if err != nil {
+			panic(err)
+		}
+
+		counterpartyPayee := types.NewRegisteredCounterpartyPayee(clientID, relayerAddr, string(iterator.Value()))
+		registeredCounterpartyPayees = append(registeredCounterpartyPayees, counterpartyPayee)
+	}
+
+	return registeredCounterpartyPayees
+}
+
+// SetRelayerAddressForAsyncAck sets the forward relayer address during OnRecvPacket in case of async acknowledgement
+func (k Keeper) SetRelayerAddressForAsyncAck(ctx sdk.Context, clientID string, sequence uint64, address string) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.KeyRelayerAddressForAsyncAck(clientID, sequence), []byte(address))
+}
+
+// GetRelayerAddressForAsyncAck gets forward relayer address for a particular packet
+func (k Keeper) GetRelayerAddressForAsyncAck(ctx sdk.Context, clientID string, sequence uint64) (string, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	key := types.KeyRelayerAddressForAsyncAck(clientID, sequence)
+	if !store.Has(key) {
+		return "", false
+	}
+
+	addr := store.Get(key)
+	return string(addr), true
+}
+
+// GetAllForwardRelayerAddresses returns all forward relayer addresses stored for async acknowledgements
+func (k Keeper) GetAllForwardRelayerAddresses(ctx sdk.Context) []types.ForwardRelayerAddress {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.ForwardRelayerPrefix))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var forwardRelayers []types.ForwardRelayerAddress
+	for ; iterator.Valid(); iterator.Next() {
+		clientID, sequence, err := types.ParseKeyRelayerAddressForAsyncAck(string(iterator.Key()))
+		if err != nil {
+			panic(err)
+		}
+
+		forwardRelayers = append(forwardRelayers, types.NewForwardRelayerAddress(string(iterator.Value()), clientID, sequence))
+	}
+
+	return forwardRelayers
+}
+
+// DeleteForwardRelayerAddress deletes the forwardRelayerAddr associated with the packet
+func (k Keeper) DeleteForwardRelayerAddress(ctx sdk.Context, clientID string, sequence uint64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Delete(types.KeyRelayerAddressForAsyncAck(clientID, sequence))
+}
+
+// GetFeesInEscrow returns all escrowed packet fees for a given packet
+func (k Keeper) GetFeesInEscrow(ctx sdk.Context, clientID string, sequence uint64) (types.PacketFees, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.KeyFeesInEscrow(clientID, sequence))
+	if len(bz) == 0 {
+		return types.PacketFees{}, false
+	}
+
+	var fees types.PacketFees
+	k.cdc.MustUnmarshal(bz, &fees)
+
+	return fees, true
+}
+
+// HasFeesInEscrow returns true if packet fees exist for the provided packet
+func (k Keeper) HasFeesInEscrow(ctx sdk.Context, clientID string, sequence uint64) bool {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return store.Has(types.KeyFeesInEscrow(clientID, sequence))
+}
+
+// SetFeesInEscrow sets the given packet fees in escrow keyed by the packet identifiers
+func (k Keeper) SetFeesInEscrow(ctx sdk.Context, clientID string, sequence uint64, fees types.PacketFees) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := k.cdc.MustMarshal(&fees)
+	store.Set(types.KeyFeesInEscrow(clientID, sequence), bz)
+}
+
+// DeleteFeesInEscrow deletes the fee associated with the given packet
+func (k Keeper) DeleteFeesInEscrow(ctx sdk.Context, clientID string, sequence uint64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Delete(types.KeyFeesInEscrow(clientID, sequence))
+}
+
+// GetAllIdentifiedPacketFees returns a list of all IdentifiedPacketFees that are stored in state
+func (k Keeper) GetAllIdentifiedPacketFees(ctx sdk.Context) []types.IdentifiedPacketFees {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.FeesInEscrowPrefix))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var identifiedFees []types.IdentifiedPacketFees
+	for ; iterator.Valid(); iterator.Next() {
+		clientID, sequence, err := types.ParseKeyFeesInEscrow(string(iterator.Key()))
+		if err != nil {
+			panic(err)
+		}
+
+		var feesInEscrow types.PacketFees
+		k.cdc.MustUnmarshal(iterator.Value(), &feesInEscrow)
+
+		identifiedFees = append(identifiedFees, types.NewIdentifiedPacketFees(clientID, sequence, feesInEscrow.PacketFees))
+	}
+
+	return identifiedFees
+}
+
+// feesInEscrowStore returns the prefix store of the escrowed packet fees
+func (k Keeper) feesInEscrowStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), []byte(types.FeesInEscrowPrefix))
+}
@@ -0,0 +1,165 @@
+package keeper_test
+
+import (
+	"testing"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/29-fee/keeper"
+	"github.com/cosmos/ibc-go/v10/modules/apps/29-fee/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+	mockv2 "github.com/cosmos/ibc-go/v10/testing/mock/v2"
+)
+
+var (
+	defaultRecvFee    = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdkmath.NewInt(100)}}
+	defaultAckFee     = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdkmath.NewInt(200)}}
+	defaultTimeoutFee = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdkmath.NewInt(300)}}
+)
+
+type KeeperTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.SetupV2()
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(KeeperTestSuite))
+}
+
+// sendPacket sends a mock packet from chainA and returns it.
+func (suite *KeeperTestSuite) sendPacket() channeltypesv2.Packet {
+	payload := mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)
+	packet, err := suite.path.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), payload)
+	suite.Require().NoError(err)
+
+	return packet
+}
+
+// emptyAccountKeeper is an AccountKeeper which has no module accounts set.
+type emptyAccountKeeper struct{}
+
+func (emptyAccountKeeper) GetModuleAddress(string) sdk.AccAddress { return nil }
+
+func (suite *KeeperTestSuite) TestNewKeeper() {
+	testCases := []struct {
+		name          string
+		instantiateFn func()
+		panicMsg      string
+	}{
+		{"success", func() {
+			keeper.NewKeeper(
+				suite.chainA.GetSimApp().AppCodec(),
+				runtime.NewKVStoreService(suite.chainA.GetSimApp().GetKey(types.StoreKey)),
+				suite.chainA.GetSimApp().IBCKeeper.ClientKeeper,
+				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeperV2,
+				suite.chainA.GetSimApp().AccountKeeper,
+				suite.chainA.GetSimApp().BankKeeper,
+			)
+		}, ""},
+		{"failure: fee module account is not set", func() {
+			keeper.NewKeeper(
+				suite.chainA.GetSimApp().AppCodec(),
+				runtime.NewKVStoreService(suite.chainA.GetSimApp().GetKey(types.StoreKey)),
+				suite.chainA.GetSimApp().IBCKeeper.ClientKeeper,
+				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeperV2,
+				emptyAccountKeeper{},
+				suite.chainA.GetSimApp().BankKeeper,
+			)
+		}, "the IBC fee module account has not been set"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			if tc.panicMsg == "" {
+				suite.Require().NotPanics(tc.instantiateFn)
+			} else {
+				suite.Require().PanicsWithError(tc.panicMsg, tc.instantiateFn)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGetAllIdentifiedPacketFees() {
+	ctx := suite.chainA.GetContext()
+	feeKeeper := suite.chainA.GetSimApp().IBCFeeKeeper
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	packetFees := []types.PacketFee{types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String())}
+
+	var expIdentifiedFees []types.IdentifiedPacketFees
+	for _, clientID := range []string{ibctesting.FirstClientID, ibctesting.SecondClientID} {
+		for seq := uint64(1); seq <= 3; seq++ {
+			feeKeeper.SetFeesInEscrow(ctx, clientID, seq, types.NewPacketFees(packetFees))
+			expIdentifiedFees = append(expIdentifiedFees, types.NewIdentifiedPacketFees(clientID, seq, packetFees))
+		}
+	}
+
+	suite.Require().Equal(expIdentifiedFees, feeKeeper.GetAllIdentifiedPacketFees(ctx))
+}
+
+func (suite *KeeperTestSuite) TestGetAllPayees() {
+	ctx := suite.chainA.GetContext()
+	feeKeeper := suite.chainA.GetSimApp().IBCFeeKeeper
+
+	var (
+		expPayees             []types.RegisteredPayee
+		expCounterpartyPayees []types.RegisteredCounterpartyPayee
+	)
+	for i := range 3 {
+		relayer := suite.chainA.SenderAccounts[i].SenderAccount.GetAddress().String()
+		payee := suite.chainB.SenderAccounts[i].SenderAccount.GetAddress().String()
+
+		feeKeeper.SetPayeeAddress(ctx, relayer, payee, ibctesting.FirstClientID)
+		feeKeeper.SetCounterpartyPayeeAddress(ctx, relayer, payee, ibctesting.FirstClientID)
+
+		expPayees = append(expPayees, types.NewRegisteredPayee(ibctesting.FirstClientID, relayer, payee))
+		expCounterpartyPayees = append(expCounterpartyPayees, types.NewRegisteredCounterpartyPayee(ibctesting.FirstClientID, relayer, payee))
+	}
+
+	suite.Require().ElementsMatch(expPayees, feeKeeper.GetAllPayees(ctx))
+	suite.Require().ElementsMatch(expCounterpartyPayees, feeKeeper.GetAllCounterpartyPayees(ctx))
+}
+
+func (suite *KeeperTestSuite) TestGetAllForwardRelayerAddresses() {
+	ctx := suite.chainA.GetContext()
+	feeKeeper := suite.chainA.GetSimApp().IBCFeeKeeper
+
+	relayer := suite.chainA.SenderAccount.GetAddress().String()
+
+	var expForwardRelayers []types.ForwardRelayerAddress
+	for seq := uint64(1); seq <= 3; seq++ {
+		feeKeeper.SetRelayerAddressForAsyncAck(ctx, ibctesting.FirstClientID, seq, relayer)
+		expForwardRelayers = append(expForwardRelayers, types.NewForwardRelayerAddress(relayer, ibctesting.FirstClientID, seq))
+	}
+
+	suite.Require().Equal(expForwardRelayers, feeKeeper.GetAllForwardRelayerAddresses(ctx))
+
+	feeKeeper.DeleteForwardRelayerAddress(ctx, ibctesting.FirstClientID, 1)
+	_, found := feeKeeper.GetRelayerAddressForAsyncAck(ctx, ibctesting.FirstClientID, 1)
+	suite.Require().False(found)
+	suite.Require().Len(feeKeeper.GetAllForwardRelayerAddresses(ctx), 2)
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/29-fee/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+)
+
+var _ types.MsgServer = (*Keeper)(nil)
+
+// RegisterPayee defines a rpc handler method for MsgRegisterPayee
+// RegisterPayee is called by the relayer on each client on the chain it relays acknowledgements and timeouts
+// for. The payee address is paid the ack and timeout fees of packets sent over the client.
+func (k Keeper) RegisterPayee(goCtx context.Context, msg *types.MsgRegisterPayee) (*types.MsgRegisterPayeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	payee, err := sdk.AccAddressFromBech32(msg.Payee)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.bankKeeper.BlockedAddr(payee) {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not authorized to be a payee", payee)
+	}
+
+	if _, found := k.clientKeeper.GetClientState(ctx, msg.ClientId); !found {
+		return nil, errorsmod.Wrapf(clienttypes.ErrClientNotFound, "client-id: %s", msg.ClientId)
+	}
+
+	k.SetPayeeAddress(ctx, msg.Relayer, msg.Payee, msg.ClientId)
+
+	k.Logger(ctx).Info("registering payee address for relayer", "relayer", msg.Relayer, "payee", msg.Payee, "client-id", msg.ClientId)
+
+	emitRegisterPayeeEvent(ctx, msg.Relayer, msg.Payee, msg.ClientId)
+
+	return &types.MsgRegisterPayeeResponse{}, nil
+}
+
+// RegisterCounterpartyPayee defines a rpc handler method for MsgRegisterCounterpartyPayee
+// RegisterCounterpartyPayee is called by the relayer on each client on the chain it relays packets to.
+// The counterparty payee address is included in the acknowledgement and paid the recv fee of the
+// packet on the source chain.
+func (k Keeper) RegisterCounterpartyPayee(goCtx context.Context, msg *types.MsgRegisterCounterpartyPayee) (*types.MsgRegisterCounterpartyPayeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.clientKeeper.GetClientState(ctx, msg.ClientId); !found {
+		return nil, errorsmod.Wrapf(clienttypes.ErrClientNotFound, "client-id: %s", msg.ClientId)
+	}
+
+	k.SetCounterpartyPayeeAddress(ctx, msg.Relayer, msg.CounterpartyPayee, msg.ClientId)
+
+	k.Logger(ctx).Info("registering counterparty payee for relayer", "relayer", msg.Relayer, "counterparty payee", msg.CounterpartyPayee, "client-id", msg.ClientId)
+
+	emitRegisterCounterpartyPayeeEvent(ctx, msg.Relayer, msg.CounterpartyPayee, msg.ClientId)
+
+	return &types.MsgRegisterCounterpartyPayeeResponse{}, nil
+}
+
+// PayPacketFee defines a rpc handler method for MsgPayPacketFee
+// PayPacketFee is an open callback that may be called by any module/user that wishes to escrow funds in order to
+// incentivize the relaying of an IBC v2 packet which has been sent and not yet acknowledged or timed out.
+func (k Keeper) PayPacketFee(goCtx context.Context, msg *types.MsgPayPacketFee) (*types.MsgPayPacketFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// only in-flight packets can be incentivized
+	if commitment := k.channelKeeperV2.GetPacketCommitment(ctx, msg.ClientId, msg.Sequence); len(commitment) == 0 {
+		return nil, errorsmod.Wrapf(channeltypesv2.ErrPacketCommitmentNotFound, "packet has already been acknowledged or timed out, or has not been sent: client-id %s, sequence %d", msg.ClientId, msg.Sequence)
+	}
+
+	packetFee := types.NewPacketFee(msg.Fee, msg.Signer)
+	if err := k.validatePacketFee(ctx, packetFee); err != nil {
+		return nil, err
+	}
+
+	if err := k.escrowPacketFee(ctx, msg.ClientId, msg.Sequence, packetFee); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPayPacketFeeResponse{}, nil
+}
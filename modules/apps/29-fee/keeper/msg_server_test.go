@@ -0,0 +1,187 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/29-fee/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+func (suite *KeeperTestSuite) TestRegisterPayee() {
+	var msg *types.MsgRegisterPayee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{"success", func() {}, nil},
+		{
+			"failure: client does not exist",
+			func() { msg.ClientId = ibctesting.SecondClientID },
+			clienttypes.ErrClientNotFound,
+		},
+		{
+			"failure: payee is a blocked address",
+			func() {
+				msg.Payee = suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(transfertypes.ModuleName).String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			msg = types.NewMsgRegisterPayee(
+				suite.path.EndpointA.ClientID,
+				suite.chainA.SenderAccounts[0].SenderAccount.GetAddress().String(),
+				suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(),
+			)
+
+			tc.malleate()
+
+			_, err := suite.chainA.GetSimApp().IBCFeeKeeper.RegisterPayee(suite.chainA.GetContext(), msg)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				payee, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetPayeeAddress(suite.chainA.GetContext(), msg.Relayer, msg.ClientId)
+				suite.Require().True(found)
+				suite.Require().Equal(msg.Payee, payee)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRegisterCounterpartyPayee() {
+	var msg *types.MsgRegisterCounterpartyPayee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{"success", func() {}, nil},
+		{
+			"success: counterparty payee is an arbitrary string",
+			func() { msg.CounterpartyPayee = "arbitrary-string" },
+			nil,
+		},
+		{
+			"failure: client does not exist",
+			func() { msg.ClientId = ibctesting.SecondClientID },
+			clienttypes.ErrClientNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			msg = types.NewMsgRegisterCounterpartyPayee(
+				suite.path.EndpointB.ClientID,
+				suite.chainB.SenderAccounts[0].SenderAccount.GetAddress().String(),
+				suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(),
+			)
+
+			tc.malleate()
+
+			_, err := suite.chainB.GetSimApp().IBCFeeKeeper.RegisterCounterpartyPayee(suite.chainB.GetContext(), msg)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				counterpartyPayee, found := suite.chainB.GetSimApp().IBCFeeKeeper.GetCounterpartyPayeeAddress(suite.chainB.GetContext(), msg.Relayer, msg.ClientId)
+				suite.Require().True(found)
+				suite.Require().Equal(msg.CounterpartyPayee, counterpartyPayee)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPayPacketFee() {
+	var (
+		msg        *types.MsgPayPacketFee
+		packetFees []types.PacketFee
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{"success", func() {}, nil},
+		{
+			"success: fees are appended to fees already in escrow",
+			func() {
+				_, err := suite.chainA.GetSimApp().IBCFeeKeeper.PayPacketFee(suite.chainA.GetContext(), msg)
+				suite.Require().NoError(err)
+
+				packetFees = append(packetFees, types.NewPacketFee(msg.Fee, msg.Signer))
+			},
+			nil,
+		},
+		{
+			"failure: packet has not been sent",
+			func() { msg.Sequence = 2 },
+			channeltypesv2.ErrPacketCommitmentNotFound,
+		},
+		{
+			"failure: packet has already been acknowledged",
+			func() {
+				suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.DeletePacketCommitment(suite.chainA.GetContext(), msg.ClientId, msg.Sequence)
+			},
+			channeltypesv2.ErrPacketCommitmentNotFound,
+		},
+		{
+			"failure: refund account is a blocked address",
+			func() {
+				msg.Signer = suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName).String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: refund account does not have sufficient funds",
+			func() {
+				msg.Fee.RecvFee = msg.Fee.RecvFee.Add(sdk.NewCoin("atom", defaultRecvFee.AmountOf(sdk.DefaultBondDenom)))
+			},
+			sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			packet := suite.sendPacket()
+
+			fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			msg = types.NewMsgPayPacketFee(packet.SourceClient, packet.Sequence, fee, suite.chainA.SenderAccount.GetAddress().String())
+			packetFees = nil
+
+			tc.malleate()
+
+			_, err := suite.chainA.GetSimApp().IBCFeeKeeper.PayPacketFee(suite.chainA.GetContext(), msg)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				packetFees = append(packetFees, types.NewPacketFee(msg.Fee, msg.Signer))
+				feesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), msg.ClientId, msg.Sequence)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewPacketFees(packetFees), feesInEscrow)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
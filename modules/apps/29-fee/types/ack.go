@@ -0,0 +1,15 @@
+package types
+
+// NewIncentivizedAcknowledgement creates a new instance of IncentivizedAcknowledgement
+func NewIncentivizedAcknowledgement(relayer string, ack []byte) IncentivizedAcknowledgement {
+	return IncentivizedAcknowledgement{
+		AppAcknowledgement:    ack,
+		ForwardRelayerAddress: relayer,
+	}
+}
+
+// Acknowledgement implements the Acknowledgement interface. It returns the
+// acknowledgement serialised using JSON.
+func (ack IncentivizedAcknowledgement) Acknowledgement() []byte {
+	return ModuleCdc.MustMarshalJSON(&ack)
+}
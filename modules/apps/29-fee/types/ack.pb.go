@@ -0,0 +1,376 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/fee/v2/ack.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// IncentivizedAcknowledgement is the acknowledgement format to be used by applications wrapped in the fee middleware
+type IncentivizedAcknowledgement struct {
+	// the underlying app acknowledgement bytes
+	AppAcknowledgement []byte `protobuf:"bytes,1,opt,name=app_acknowledgement,json=appAcknowledgement,proto3" json:"app_acknowledgement,omitempty"`
+	// the relayer address which submits the recv packet message
+	ForwardRelayerAddress string `protobuf:"bytes,2,opt,name=forward_relayer_address,json=forwardRelayerAddress,proto3" json:"forward_relayer_address,omitempty"`
+}
+
+func (m *IncentivizedAcknowledgement) Reset()         { *m = IncentivizedAcknowledgement{} }
+func (m *IncentivizedAcknowledgement) String() string { return proto.CompactTextString(m) }
+func (*IncentivizedAcknowledgement) ProtoMessage()    {}
+func (*IncentivizedAcknowledgement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36d4919baf1604e0, []int{0}
+}
+func (m *IncentivizedAcknowledgement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentivizedAcknowledgement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentivizedAcknowledgement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IncentivizedAcknowledgement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentivizedAcknowledgement.Merge(m, src)
+}
+func (m *IncentivizedAcknowledgement) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentivizedAcknowledgement) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentivizedAcknowledgement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncentivizedAcknowledgement proto.InternalMessageInfo
+
+func (m *IncentivizedAcknowledgement) GetAppAcknowledgement() []byte {
+	if m != nil {
+		return m.AppAcknowledgement
+	}
+	return nil
+}
+
+func (m *IncentivizedAcknowledgement) GetForwardRelayerAddress() string {
+	if m != nil {
+		return m.ForwardRelayerAddress
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*IncentivizedAcknowledgement)(nil), "ibc.applications.fee.v2.IncentivizedAcknowledgement")
+}
+
+func init() { proto.RegisterFile("ibc/applications/fee/v2/ack.proto", fileDescriptor_36d4919baf1604e0) }
+
+var fileDescriptor_36d4919baf1604e0 = []byte{
+	// 251 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0xcf, 0xbf, 0x4e, 0xc3, 0x30,
+	0x10, 0xc7, 0xf1, 0x9a, 0x01, 0x89, 0x88, 0x29, 0x08, 0xb5, 0x12, 0x92, 0x55, 0x98, 0xba, 0x34,
+	0x07, 0x41, 0xaa, 0xc4, 0x58, 0x36, 0x36, 0x94, 0x91, 0x25, 0xf2, 0x9f, 0x4b, 0xb0, 0x9a, 0xf8,
+	0x2c, 0xdb, 0x4d, 0x55, 0x1e, 0x80, 0x99, 0xc7, 0x62, 0xec, 0xc8, 0x88, 0x92, 0x17, 0x41, 0xa5,
+	0x0c, 0xc0, 0x7a, 0xdf, 0xfb, 0x0d, 0x9f, 0xe4, 0xd2, 0x48, 0x05, 0xc2, 0xb9, 0xc6, 0x28, 0x11,
+	0x0d, 0xd9, 0x00, 0x15, 0x22, 0x74, 0x39, 0x08, 0xb5, 0xca, 0x9c, 0xa7, 0x48, 0xe9, 0xd8, 0x48,
+	0x95, 0xfd, 0x7e, 0xc9, 0x2a, 0xc4, 0xac, 0xcb, 0xaf, 0x5e, 0x59, 0x72, 0xf1, 0x60, 0x15, 0xda,
+	0x68, 0x3a, 0xf3, 0x82, 0x7a, 0xa9, 0x56, 0x96, 0x36, 0x0d, 0xea, 0x1a, 0x5b, 0xb4, 0x31, 0x85,
+	0xe4, 0x4c, 0x38, 0x57, 0x8a, 0xbf, 0xe7, 0x09, 0x9b, 0xb2, 0xd9, 0x69, 0x91, 0x0a, 0xe7, 0xfe,
+	0x0f, 0x16, 0xc9, 0xb8, 0x22, 0xbf, 0x11, 0x5e, 0x97, 0x1e, 0x1b, 0xb1, 0x45, 0x5f, 0x0a, 0xad,
+	0x3d, 0x86, 0x30, 0x39, 0x9a, 0xb2, 0xd9, 0x49, 0x71, 0xfe, 0x93, 0x8b, 0x43, 0x5d, 0x1e, 0xe2,
+	0xfd, 0xe3, 0x7b, 0xcf, 0xd9, 0xae, 0xe7, 0xec, 0xb3, 0xe7, 0xec, 0x6d, 0xe0, 0xa3, 0xdd, 0xc0,
+	0x47, 0x1f, 0x03, 0x1f, 0x3d, 0x2d, 0x6a, 0x13, 0x9f, 0xd7, 0x32, 0x53, 0xd4, 0x82, 0xa2, 0xd0,
+	0x52, 0x00, 0x23, 0xd5, 0xbc, 0x26, 0xe8, 0x6e, 0xae, 0xa1, 0x25, 0xbd, 0x6e, 0x30, 0xec, 0xfd,
+	0x01, 0xf2, 0xbb, 0xf9, 0x9e, 0x1e, 0xb7, 0x0e, 0x83, 0x3c, 0xfe, 0xa6, 0xdf, 0x7e, 0x05, 0x00,
+	0x00, 0xff, 0xff, 0x8f, 0xeb, 0xa5, 0xab, 0x1f, 0x01, 0x00, 0x00,
+}
+
+func (m *IncentivizedAcknowledgement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncentivizedAcknowledgement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncentivizedAcknowledgement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ForwardRelayerAddress) > 0 {
+		i -= len(m.ForwardRelayerAddress)
+		copy(dAtA[i:], m.ForwardRelayerAddress)
+		i = encodeVarintAck(dAtA, i, uint64(len(m.ForwardRelayerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AppAcknowledgement) > 0 {
+		i -= len(m.AppAcknowledgement)
+		copy(dAtA[i:], m.AppAcknowledgement)
+		i = encodeVarintAck(dAtA, i, uint64(len(m.AppAcknowledgement)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAck(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAck(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IncentivizedAcknowledgement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AppAcknowledgement)
+	if l > 0 {
+		n += 1 + l + sovAck(uint64(l))
+	}
+	l = len(m.ForwardRelayerAddress)
+	if l > 0 {
+		n += 1 + l + sovAck(uint64(l))
+	}
+	return n
+}
+
+func sovAck(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAck(x uint64) (n int) {
+	return sovAck(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IncentivizedAcknowledgement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAck
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncentivizedAcknowledgement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncentivizedAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppAcknowledgement", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAck
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAck
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppAcknowledgement = append(m.AppAcknowledgement[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppAcknowledgement == nil {
+				m.AppAcknowledgement = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardRelayerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAck
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardRelayerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAck(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAck
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAck(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAck
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAck
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAck
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAck
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAck
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAck
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAck        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAck          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAck = fmt.Errorf("proto: unexpected end of group")
+)
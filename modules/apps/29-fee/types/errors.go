@@ -0,0 +1,13 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// 29-fee sentinel errors
+var (
+	ErrInvalidVersion                = errorsmod.Register(ModuleName, 2, "invalid ICS29 middleware version")
+	ErrFeeNotFound                   = errorsmod.Register(ModuleName, 3, "there is no fee escrowed for the given packet")
+	ErrCounterpartyPayeeEmpty        = errorsmod.Register(ModuleName, 4, "counterparty payee must not be empty")
+	ErrForwardRelayerAddressNotFound = errorsmod.Register(ModuleName, 5, "forward relayer address not found")
+)
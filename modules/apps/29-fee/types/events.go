@@ -0,0 +1,29 @@
+package types
+
+import (
+	"fmt"
+
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+// 29-fee events
+const (
+	EventTypeIncentivizedPacket        = "incentivized_ibc_packet"
+	EventTypeRegisterPayee             = "register_payee"
+	EventTypeRegisterCounterpartyPayee = "register_counterparty_payee"
+	EventTypeDistributeFee             = "distribute_fee"
+
+	AttributeKeyRecvFee           = "recv_fee"
+	AttributeKeyAckFee            = "ack_fee"
+	AttributeKeyTimeoutFee        = "timeout_fee"
+	AttributeKeyClientID          = "client_id"
+	AttributeKeySequence          = "packet_sequence"
+	AttributeKeyRelayer           = "relayer"
+	AttributeKeyPayee             = "payee"
+	AttributeKeyCounterpartyPayee = "counterparty_payee"
+	AttributeKeyReceiver          = "receiver"
+	AttributeKeyFee               = "fee"
+)
+
+// AttributeValueCategory is the module name used as the message event category
+var AttributeValueCategory = fmt.Sprintf("%s_%s", ibcexported.ModuleName, ModuleName)
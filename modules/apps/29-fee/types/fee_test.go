@@ -0,0 +1,105 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/29-fee/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+var (
+	defaultRecvFee    = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdkmath.NewInt(100)}}
+	defaultAckFee     = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdkmath.NewInt(200)}}
+	defaultTimeoutFee = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdkmath.NewInt(300)}}
+	invalidFee        = sdk.Coins{sdk.Coin{Denom: "invalid-denom", Amount: sdkmath.NewInt(-2)}}
+)
+
+func TestFeeTotal(t *testing.T) {
+	var fee types.Fee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expTotal sdk.Coins
+	}{
+		{
+			"success",
+			func() {},
+			sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(300))),
+		},
+		{
+			"success: timeout fee is larger than the sum of receive and ack fees",
+			func() {
+				fee.TimeoutFee = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(500)))
+			},
+			sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(500))),
+		},
+		{
+			"success: multiple denoms",
+			func() {
+				fee.TimeoutFee = sdk.NewCoins(sdk.NewCoin("atom", sdkmath.NewInt(50)))
+			},
+			sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(300)), sdk.NewCoin("atom", sdkmath.NewInt(50))),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fee = types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+			tc.malleate()
+
+			require.Equal(t, tc.expTotal, fee.Total())
+		})
+	}
+}
+
+func TestPacketFeeValidation(t *testing.T) {
+	var packetFee types.PacketFee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{"success", func() {}, nil},
+		{"success: empty receive fee", func() { packetFee.Fee.RecvFee = sdk.Coins{} }, nil},
+		{"success: empty ack fee", func() { packetFee.Fee.AckFee = sdk.Coins{} }, nil},
+		{"success: empty timeout fee", func() { packetFee.Fee.TimeoutFee = sdk.Coins{} }, nil},
+		{
+			"failure: all fees are empty",
+			func() {
+				packetFee.Fee = types.NewFee(sdk.Coins{}, sdk.Coins{}, sdk.Coins{})
+			},
+			ibcerrors.ErrInvalidCoins,
+		},
+		{"failure: invalid receive fee", func() { packetFee.Fee.RecvFee = invalidFee }, ibcerrors.ErrInvalidCoins},
+		{"failure: invalid ack fee", func() { packetFee.Fee.AckFee = invalidFee }, ibcerrors.ErrInvalidCoins},
+		{"failure: invalid timeout fee", func() { packetFee.Fee.TimeoutFee = invalidFee }, ibcerrors.ErrInvalidCoins},
+		{"failure: invalid refund address", func() { packetFee.RefundAddress = ibctesting.InvalidID }, errors.New("failed to convert RefundAddress into sdk.AccAddress")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			packetFee = types.NewPacketFee(fee, ibctesting.TestAccAddress)
+
+			tc.malleate()
+
+			err := packetFee.Validate()
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+)
+
+// NewGenesisState creates a 29-fee GenesisState instance.
+func NewGenesisState(
+	identifiedFees []IdentifiedPacketFees,
+	registeredPayees []RegisteredPayee,
+	registeredCounterpartyPayees []RegisteredCounterpartyPayee,
+	forwardRelayers []ForwardRelayerAddress,
+) *GenesisState {
+	return &GenesisState{
+		IdentifiedFees:               identifiedFees,
+		RegisteredPayees:             registeredPayees,
+		RegisteredCounterpartyPayees: registeredCounterpartyPayees,
+		ForwardRelayers:              forwardRelayers,
+	}
+}
+
+// DefaultGenesisState returns a default instance of the 29-fee GenesisState.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		IdentifiedFees:               []IdentifiedPacketFees{},
+		RegisteredPayees:             []RegisteredPayee{},
+		RegisteredCounterpartyPayees: []RegisteredCounterpartyPayee{},
+		ForwardRelayers:              []ForwardRelayerAddress{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any failure.
+func (gs GenesisState) Validate() error {
+	// Validate IdentifiedPacketFees
+	for _, identifiedFees := range gs.IdentifiedFees {
+		if err := host.ClientIdentifierValidator(identifiedFees.ClientId); err != nil {
+			return err
+		}
+
+		if identifiedFees.Sequence == 0 {
+			return errorsmod.Wrap(ibcerrors.ErrInvalidSequence, "packet sequence cannot be 0")
+		}
+
+		for _, packetFee := range identifiedFees.PacketFees {
+			if err := packetFee.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Validate RegisteredPayees
+	for _, registeredPayee := range gs.RegisteredPayees {
+		if err := NewMsgRegisterPayee(registeredPayee.ClientId, registeredPayee.Relayer, registeredPayee.Payee).ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	// Validate RegisteredCounterpartyPayees
+	for _, registeredCounterpartyPayee := range gs.RegisteredCounterpartyPayees {
+		if err := NewMsgRegisterCounterpartyPayee(
+			registeredCounterpartyPayee.ClientId, registeredCounterpartyPayee.Relayer, registeredCounterpartyPayee.CounterpartyPayee,
+		).ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	// Validate ForwardRelayers
+	for _, rel := range gs.ForwardRelayers {
+		if _, err := sdk.AccAddressFromBech32(rel.Address); err != nil {
+			return err
+		}
+
+		if err := host.ClientIdentifierValidator(rel.ClientId); err != nil {
+			return err
+		}
+
+		if rel.Sequence == 0 {
+			return errorsmod.Wrap(ibcerrors.ErrInvalidSequence, "packet sequence cannot be 0")
+		}
+	}
+
+	return nil
+}
+
+// NewRegisteredPayee creates a new instance of RegisteredPayee
+func NewRegisteredPayee(clientID, relayerAddr, payeeAddr string) RegisteredPayee {
+	return RegisteredPayee{
+		ClientId: clientID,
+		Relayer:  relayerAddr,
+		Payee:    payeeAddr,
+	}
+}
+
+// NewRegisteredCounterpartyPayee creates a new instance of RegisteredCounterpartyPayee
+func NewRegisteredCounterpartyPayee(clientID, relayerAddr, counterpartyPayeeAddr string) RegisteredCounterpartyPayee {
+	return RegisteredCounterpartyPayee{
+		ClientId:          clientID,
+		Relayer:           relayerAddr,
+		CounterpartyPayee: counterpartyPayeeAddr,
+	}
+}
+
+// NewForwardRelayerAddress creates a new instance of ForwardRelayerAddress
+func NewForwardRelayerAddress(address, clientID string, sequence uint64) ForwardRelayerAddress {
+	return ForwardRelayerAddress{
+		Address:  address,
+		ClientId: clientID,
+		Sequence: sequence,
+	}
+}
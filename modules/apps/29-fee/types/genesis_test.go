@@ -0,0 +1,93 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/29-fee/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+func TestValidateDefaultGenesis(t *testing.T) {
+	err := types.DefaultGenesisState().Validate()
+	require.NoError(t, err)
+}
+
+func TestValidateGenesis(t *testing.T) {
+	var genState *types.GenesisState
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{"success", func() {}, nil},
+		{
+			"failure: invalid client identifier for identified fees",
+			func() { genState.IdentifiedFees[0].ClientId = "" },
+			host.ErrInvalidID,
+		},
+		{
+			"failure: zero sequence for identified fees",
+			func() { genState.IdentifiedFees[0].Sequence = 0 },
+			ibcerrors.ErrInvalidSequence,
+		},
+		{
+			"failure: invalid packet fee",
+			func() { genState.IdentifiedFees[0].PacketFees[0].Fee.AckFee = invalidFee },
+			ibcerrors.ErrInvalidCoins,
+		},
+		{
+			"failure: relayer and payee are equal",
+			func() { genState.RegisteredPayees[0].Payee = ibctesting.TestAccAddress },
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"failure: invalid client identifier for registered payee",
+			func() { genState.RegisteredPayees[0].ClientId = "" },
+			host.ErrInvalidID,
+		},
+		{
+			"failure: empty counterparty payee",
+			func() { genState.RegisteredCounterpartyPayees[0].CounterpartyPayee = "" },
+			types.ErrCounterpartyPayeeEmpty,
+		},
+		{
+			"failure: invalid client identifier for forward relayer",
+			func() { genState.ForwardRelayers[0].ClientId = "" },
+			host.ErrInvalidID,
+		},
+		{
+			"failure: zero sequence for forward relayer",
+			func() { genState.ForwardRelayers[0].Sequence = 0 },
+			ibcerrors.ErrInvalidSequence,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+			genState = types.NewGenesisState(
+				[]types.IdentifiedPacketFees{
+					types.NewIdentifiedPacketFees(ibctesting.FirstClientID, 1, []types.PacketFee{types.NewPacketFee(fee, ibctesting.TestAccAddress)}),
+				},
+				[]types.RegisteredPayee{types.NewRegisteredPayee(ibctesting.FirstClientID, ibctesting.TestAccAddress, defaultPayee)},
+				[]types.RegisteredCounterpartyPayee{types.NewRegisteredCounterpartyPayee(ibctesting.FirstClientID, ibctesting.TestAccAddress, defaultPayee)},
+				[]types.ForwardRelayerAddress{types.NewForwardRelayerAddress(ibctesting.TestAccAddress, ibctesting.FirstClientID, 1)},
+			)
+
+			tc.malleate()
+
+			err := genState.Validate()
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+)
+
+const (
+	// ModuleName defines the 29-fee name
+	ModuleName = "feeibc"
+
+	// StoreKey is the store key string for IBC fee module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for IBC fee module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for IBC fee module
+	QuerierRoute = ModuleName
+
+	// Version defines the current version of the ICS29 fee middleware
+	Version = "ics29-1"
+
+	// PayeeKeyPrefix is the key prefix for the fee payee address stored in state
+	PayeeKeyPrefix = "payee"
+
+	// CounterpartyPayeeKeyPrefix is the key prefix for the counterparty payee address mapping
+	CounterpartyPayeeKeyPrefix = "counterpartyFeePayee"
+
+	// FeesInEscrowPrefix is the key prefix for fee in escrow mapping
+	FeesInEscrowPrefix = "feesInEscrow"
+
+	// ForwardRelayerPrefix is the key prefix for forward relayer addresses stored in state for async packet acks
+	ForwardRelayerPrefix = "forwardRelayer"
+)
+
+// KeyPayee returns the key for relayer address -> payee address mapping
+func KeyPayee(relayerAddr, clientID string) []byte {
+	return fmt.Appendf(nil, "%s/%s/%s", PayeeKeyPrefix, relayerAddr, clientID)
+}
+
+// ParseKeyPayeeAddress returns the registered relayer address and client identifier used to store the payee address
+func ParseKeyPayeeAddress(key string) (relayerAddr, clientID string, err error) {
+	keySplit := strings.Split(key, "/")
+	if len(keySplit) != 3 {
+		return "", "", errorsmod.Wrapf(
+			ibcerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 3, len(keySplit),
+		)
+	}
+
+	return keySplit[1], keySplit[2], nil
+}
+
+// KeyCounterpartyPayee returns the key for relayer address -> counterparty payee address mapping
+func KeyCounterpartyPayee(relayerAddr, clientID string) []byte {
+	return fmt.Appendf(nil, "%s/%s/%s", CounterpartyPayeeKeyPrefix, relayerAddr, clientID)
+}
+
+// ParseKeyCounterpartyPayee returns the registered relayer address and client identifier used to store the counterparty payee address
+func ParseKeyCounterpartyPayee(key string) (relayerAddr, clientID string, err error) {
+	keySplit := strings.Split(key, "/")
+	if len(keySplit) != 3 {
+		return "", "", errorsmod.Wrapf(
+			ibcerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 3, len(keySplit),
+		)
+	}
+
+	return keySplit[1], keySplit[2], nil
+}
+
+// KeyFeesInEscrow returns the key for escrowed fees of the packet sent over the given client with the given sequence
+func KeyFeesInEscrow(clientID string, sequence uint64) []byte {
+	return fmt.Appendf(nil, "%s/%d", KeyFeesInEscrowClientPrefix(clientID), sequence)
+}
+
+// KeyFeesInEscrowClientPrefix returns the key prefix for escrowed fees of packets sent over the given client
+func KeyFeesInEscrowClientPrefix(clientID string) []byte {
+	return fmt.Appendf(nil, "%s/%s", FeesInEscrowPrefix, clientID)
+}
+
+// ParseKeyFeesInEscrow parses the key used to store fees in escrow and returns the client identifier and sequence
+func ParseKeyFeesInEscrow(key string) (string, uint64, error) {
+	return parseClientSequenceKey(key)
+}
+
+// KeyRelayerAddressForAsyncAck returns the key for the forward relayer address of the packet
+// received on the given client with the given sequence, whose acknowledgement is written asynchronously
+func KeyRelayerAddressForAsyncAck(clientID string, sequence uint64) []byte {
+	return fmt.Appendf(nil, "%s/%s/%d", ForwardRelayerPrefix, clientID, sequence)
+}
+
+// ParseKeyRelayerAddressForAsyncAck parses the key used to store the forward relayer address and returns the
+// client identifier and sequence
+func ParseKeyRelayerAddressForAsyncAck(key string) (string, uint64, error) {
+	return parseClientSequenceKey(key)
+}
+
+// parseClientSequenceKey parses a key of the format {prefix}/{clientID}/{sequence}
+func parseClientSequenceKey(key string) (string, uint64, error) {
+	keySplit := strings.Split(key, "/")
+	if len(keySplit) != 3 {
+		return "", 0, errorsmod.Wrapf(
+			ibcerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 3, len(keySplit),
+		)
+	}
+
+	seq, err := strconv.ParseUint(keySplit[2], 10, 64)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return keySplit[1], seq, nil
+}
@@ -0,0 +1,114 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/29-fee/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+func TestKeyPayee(t *testing.T) {
+	key := types.KeyPayee(ibctesting.TestAccAddress, ibctesting.FirstClientID)
+	require.Equal(t, fmt.Sprintf("%s/%s/%s", types.PayeeKeyPrefix, ibctesting.TestAccAddress, ibctesting.FirstClientID), string(key))
+}
+
+func TestParseKeyPayee(t *testing.T) {
+	testCases := []struct {
+		name   string
+		key    string
+		expErr error
+	}{
+		{"success", string(types.KeyPayee(ibctesting.TestAccAddress, ibctesting.FirstClientID)), nil},
+		{"failure: incorrect key length", fmt.Sprintf("%s/%s", types.PayeeKeyPrefix, ibctesting.TestAccAddress), ibcerrors.ErrLogic},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			relayer, clientID, err := types.ParseKeyPayeeAddress(tc.key)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+				require.Equal(t, ibctesting.TestAccAddress, relayer)
+				require.Equal(t, ibctesting.FirstClientID, clientID)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestParseKeyCounterpartyPayee(t *testing.T) {
+	testCases := []struct {
+		name   string
+		key    string
+		expErr error
+	}{
+		{"success", string(types.KeyCounterpartyPayee(ibctesting.TestAccAddress, ibctesting.FirstClientID)), nil},
+		{"failure: incorrect key length", fmt.Sprintf("%s/%s", types.CounterpartyPayeeKeyPrefix, ibctesting.TestAccAddress), ibcerrors.ErrLogic},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			relayer, clientID, err := types.ParseKeyCounterpartyPayee(tc.key)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+				require.Equal(t, ibctesting.TestAccAddress, relayer)
+				require.Equal(t, ibctesting.FirstClientID, clientID)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestParseKeyFeesInEscrow(t *testing.T) {
+	testCases := []struct {
+		name    string
+		key     string
+		expPass bool
+	}{
+		{"success", string(types.KeyFeesInEscrow(ibctesting.FirstClientID, 1)), true},
+		{"failure: incorrect key length", fmt.Sprintf("%s/%s", types.FeesInEscrowPrefix, ibctesting.FirstClientID), false},
+		{"failure: invalid sequence", fmt.Sprintf("%s/%s/%s", types.FeesInEscrowPrefix, ibctesting.FirstClientID, "sequence"), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clientID, sequence, err := types.ParseKeyFeesInEscrow(tc.key)
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, ibctesting.FirstClientID, clientID)
+				require.Equal(t, uint64(1), sequence)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestParseKeyRelayerAddressForAsyncAck(t *testing.T) {
+	testCases := []struct {
+		name    string
+		key     string
+		expPass bool
+	}{
+		{"success", string(types.KeyRelayerAddressForAsyncAck(ibctesting.FirstClientID, 1)), true},
+		{"failure: incorrect key length", fmt.Sprintf("%s/%s", types.ForwardRelayerPrefix, ibctesting.FirstClientID), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clientID, sequence, err := types.ParseKeyRelayerAddressForAsyncAck(tc.key)
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, ibctesting.FirstClientID, clientID)
+				require.Equal(t, uint64(1), sequence)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// NewMetadata creates a new Metadata instance given the fee and application versions.
+func NewMetadata(feeVersion, appVersion string) Metadata {
+	return Metadata{
+		FeeVersion: feeVersion,
+		AppVersion: appVersion,
+	}
+}
+
+// MetadataFromVersion attempts to parse the given string into a fee version Metadata,
+// an error is returned if it fails to do so.
+func MetadataFromVersion(version string) (Metadata, error) {
+	var metadata Metadata
+	if err := ModuleCdc.UnmarshalJSON([]byte(version), &metadata); err != nil {
+		return Metadata{}, errorsmod.Wrapf(ErrInvalidVersion, "failed to unmarshal metadata from version: %s", version)
+	}
+
+	return metadata, nil
+}
+
+// Version returns the JSON encoded metadata, which is used as the version of incentivized payloads.
+func (m Metadata) Version() string {
+	return string(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic performs a basic validation of the Metadata fields.
+func (m Metadata) ValidateBasic() error {
+	if m.FeeVersion != Version {
+		return errorsmod.Wrapf(ErrInvalidVersion, "expected %s, got %s", Version, m.FeeVersion)
+	}
+
+	if strings.TrimSpace(m.AppVersion) == "" {
+		return errorsmod.Wrap(ErrInvalidVersion, "app version cannot be empty")
+	}
+
+	return nil
+}
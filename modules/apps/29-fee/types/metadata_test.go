@@ -0,0 +1,67 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/29-fee/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+)
+
+func TestMetadataFromVersion(t *testing.T) {
+	testCases := []struct {
+		name        string
+		version     string
+		expMetadata types.Metadata
+		expErr      error
+	}{
+		{
+			"success",
+			types.NewMetadata(types.Version, transfertypes.V1).Version(),
+			types.NewMetadata(types.Version, transfertypes.V1),
+			nil,
+		},
+		{
+			"failure: version is not json encoded metadata",
+			transfertypes.V1,
+			types.Metadata{},
+			types.ErrInvalidVersion,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			metadata, err := types.MetadataFromVersion(tc.version)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+			require.Equal(t, tc.expMetadata, metadata)
+		})
+	}
+}
+
+func TestMetadataValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name     string
+		metadata types.Metadata
+		expErr   error
+	}{
+		{"success", types.NewMetadata(types.Version, transfertypes.V1), nil},
+		{"failure: invalid fee version", types.NewMetadata("ics29-2", transfertypes.V1), types.ErrInvalidVersion},
+		{"failure: empty app version", types.NewMetadata(types.Version, " "), types.ErrInvalidVersion},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.metadata.ValidateBasic()
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
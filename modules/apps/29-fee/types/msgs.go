@@ -0,0 +1,104 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+)
+
+var (
+	_ sdk.Msg = (*MsgRegisterPayee)(nil)
+	_ sdk.Msg = (*MsgRegisterCounterpartyPayee)(nil)
+	_ sdk.Msg = (*MsgPayPacketFee)(nil)
+
+	_ sdk.HasValidateBasic = (*MsgRegisterPayee)(nil)
+	_ sdk.HasValidateBasic = (*MsgRegisterCounterpartyPayee)(nil)
+	_ sdk.HasValidateBasic = (*MsgPayPacketFee)(nil)
+)
+
+// NewMsgRegisterPayee creates a new instance of MsgRegisterPayee
+func NewMsgRegisterPayee(clientID, relayerAddr, payeeAddr string) *MsgRegisterPayee {
+	return &MsgRegisterPayee{
+		ClientId: clientID,
+		Relayer:  relayerAddr,
+		Payee:    payeeAddr,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic and performs basic stateless validation
+func (msg *MsgRegisterPayee) ValidateBasic() error {
+	if err := host.ClientIdentifierValidator(msg.ClientId); err != nil {
+		return err
+	}
+
+	if msg.Relayer == msg.Payee {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "relayer address and payee must not be equal")
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Relayer)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to create sdk.AccAddress from relayer address")
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Payee)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to create sdk.AccAddress from payee address")
+	}
+
+	return nil
+}
+
+// NewMsgRegisterCounterpartyPayee creates a new instance of MsgRegisterCounterpartyPayee
+func NewMsgRegisterCounterpartyPayee(clientID, relayerAddr, counterpartyPayeeAddr string) *MsgRegisterCounterpartyPayee {
+	return &MsgRegisterCounterpartyPayee{
+		ClientId:          clientID,
+		Relayer:           relayerAddr,
+		CounterpartyPayee: counterpartyPayeeAddr,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic and performs basic stateless validation
+func (msg *MsgRegisterCounterpartyPayee) ValidateBasic() error {
+	if err := host.ClientIdentifierValidator(msg.ClientId); err != nil {
+		return err
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Relayer)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to create sdk.AccAddress from relayer address")
+	}
+
+	if strings.TrimSpace(msg.CounterpartyPayee) == "" {
+		return ErrCounterpartyPayeeEmpty
+	}
+
+	return nil
+}
+
+// NewMsgPayPacketFee creates and returns a new MsgPayPacketFee
+func NewMsgPayPacketFee(clientID string, sequence uint64, fee Fee, signerAddr string) *MsgPayPacketFee {
+	return &MsgPayPacketFee{
+		ClientId: clientID,
+		Sequence: sequence,
+		Fee:      fee,
+		Signer:   signerAddr,
+	}
+}
+
+// ValidateBasic implements sdk.HasValidateBasic and performs basic stateless validation
+func (msg *MsgPayPacketFee) ValidateBasic() error {
+	if err := host.ClientIdentifierValidator(msg.ClientId); err != nil {
+		return err
+	}
+
+	if msg.Sequence == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidSequence, "packet sequence cannot be 0")
+	}
+
+	return NewPacketFee(msg.Fee, msg.Signer).Validate()
+}
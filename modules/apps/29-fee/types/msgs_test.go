@@ -0,0 +1,112 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/29-fee/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+var defaultPayee = sdk.AccAddress("payee_______________").String()
+
+func TestMsgRegisterPayeeValidateBasic(t *testing.T) {
+	var msg *types.MsgRegisterPayee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{"success", func() {}, nil},
+		{"failure: invalid client identifier", func() { msg.ClientId = "" }, host.ErrInvalidID},
+		{"failure: relayer and payee are equal", func() { msg.Payee = msg.Relayer }, ibcerrors.ErrInvalidRequest},
+		{"failure: invalid relayer address", func() { msg.Relayer = ibctesting.InvalidID }, errors.New("failed to create sdk.AccAddress from relayer address")},
+		{"failure: invalid payee address", func() { msg.Payee = ibctesting.InvalidID }, errors.New("failed to create sdk.AccAddress from payee address")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg = types.NewMsgRegisterPayee(ibctesting.FirstClientID, ibctesting.TestAccAddress, defaultPayee)
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr.Error())
+			}
+		})
+	}
+}
+
+func TestMsgRegisterCounterpartyPayeeValidateBasic(t *testing.T) {
+	var msg *types.MsgRegisterCounterpartyPayee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{"success", func() {}, nil},
+		{"success: counterparty payee is not validated as a local address", func() { msg.CounterpartyPayee = "arbitrary-address" }, nil},
+		{"failure: invalid client identifier", func() { msg.ClientId = "" }, host.ErrInvalidID},
+		{"failure: invalid relayer address", func() { msg.Relayer = ibctesting.InvalidID }, errors.New("failed to create sdk.AccAddress from relayer address")},
+		{"failure: empty counterparty payee", func() { msg.CounterpartyPayee = " " }, types.ErrCounterpartyPayeeEmpty},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg = types.NewMsgRegisterCounterpartyPayee(ibctesting.FirstClientID, ibctesting.TestAccAddress, defaultPayee)
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr.Error())
+			}
+		})
+	}
+}
+
+func TestMsgPayPacketFeeValidateBasic(t *testing.T) {
+	var msg *types.MsgPayPacketFee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{"success", func() {}, nil},
+		{"failure: invalid client identifier", func() { msg.ClientId = "" }, host.ErrInvalidID},
+		{"failure: zero sequence", func() { msg.Sequence = 0 }, ibcerrors.ErrInvalidSequence},
+		{"failure: invalid signer address", func() { msg.Signer = ibctesting.InvalidID }, errors.New("failed to convert RefundAddress into sdk.AccAddress")},
+		{"failure: invalid fee", func() { msg.Fee.RecvFee = invalidFee }, ibcerrors.ErrInvalidCoins},
+		{"failure: all fees are zero", func() { msg.Fee = types.NewFee(sdk.Coins{}, sdk.Coins{}, sdk.Coins{}) }, ibcerrors.ErrInvalidCoins},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			msg = types.NewMsgPayPacketFee(ibctesting.FirstClientID, 1, fee, ibctesting.TestAccAddress)
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr.Error())
+			}
+		})
+	}
+}
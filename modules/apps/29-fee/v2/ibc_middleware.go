@@ -0,0 +1,201 @@
+package v2
+
+import (
+	"bytes"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/29-fee/keeper"
+	"github.com/cosmos/ibc-go/v10/modules/apps/29-fee/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/api"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+)
+
+var (
+	_ api.IBCModule                   = (*IBCMiddleware)(nil)
+	_ api.WriteAcknowledgementWrapper = (*IBCMiddleware)(nil)
+)
+
+// IBCMiddleware implements the ICS29 fee middleware for IBC v2 packets. A payload is incentivized
+// if its version is the JSON encoded fee Metadata wrapping the version of the underlying application.
+type IBCMiddleware struct {
+	app    api.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper and underlying application
+func NewIBCMiddleware(app api.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		app:    app,
+		keeper: k,
+	}
+}
+
+// OnSendPacket implements the IBCModule interface. The fee metadata of incentivized payloads
+// is unwrapped before the payload is passed to the underlying application.
+func (im IBCMiddleware) OnSendPacket(ctx sdk.Context, sourceClient string, destinationClient string, sequence uint64, payload channeltypesv2.Payload, signer sdk.AccAddress) error {
+	payload, isIncentivized, err := unwrapPayload(payload)
+	if isIncentivized && err != nil {
+		return err
+	}
+
+	return im.app.OnSendPacket(ctx, sourceClient, destinationClient, sequence, payload, signer)
+}
+
+// OnRecvPacket implements the IBCModule interface. The successful acknowledgement of an incentivized
+// payload is wrapped in an IncentivizedAcknowledgement containing the address to be paid the receive fee.
+// If the acknowledgement is written asynchronously, the forward relayer address is stored until it is written.
+func (im IBCMiddleware) OnRecvPacket(ctx sdk.Context, sourceClient string, destinationClient string, sequence uint64, payload channeltypesv2.Payload, relayer sdk.AccAddress) channeltypesv2.RecvPacketResult {
+	payload, isIncentivized, err := unwrapPayload(payload)
+	if !isIncentivized {
+		return im.app.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+	}
+
+	if err != nil {
+		im.keeper.Logger(ctx).Error("invalid incentivized payload version", "error", err, "sequence", sequence)
+		return channeltypesv2.RecvPacketResult{
+			Status: channeltypesv2.PacketStatus_Failure,
+		}
+	}
+
+	recvResult := im.app.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+
+	forwardRelayer, found := im.keeper.GetCounterpartyPayeeAddress(ctx, relayer.String(), destinationClient)
+	if !found {
+		forwardRelayer = relayer.String()
+	}
+
+	switch recvResult.Status {
+	case channeltypesv2.PacketStatus_Async:
+		im.keeper.SetRelayerAddressForAsyncAck(ctx, destinationClient, sequence, forwardRelayer)
+	case channeltypesv2.PacketStatus_Success:
+		recvResult.Acknowledgement = types.NewIncentivizedAcknowledgement(forwardRelayer, recvResult.Acknowledgement).Acknowledgement()
+	}
+
+	return recvResult
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. The fees escrowed for the packet are
+// distributed: the receive fee is paid to the forward relayer included in the acknowledgement, the
+// acknowledgement fee is paid to the payee of the relayer and the timeout fee is refunded.
+// If the packet failed on the counterparty, no forward relayer is known and the receive fee is refunded.
+func (im IBCMiddleware) OnAcknowledgementPacket(ctx sdk.Context, sourceClient string, destinationClient string, sequence uint64, acknowledgement []byte, payload channeltypesv2.Payload, relayer sdk.AccAddress) error {
+	payload, isIncentivized, err := unwrapPayload(payload)
+	if isIncentivized && err != nil {
+		return err
+	}
+
+	var forwardRelayer string
+	if isIncentivized && !bytes.Equal(acknowledgement, channeltypesv2.ErrorAcknowledgement[:]) {
+		var ack types.IncentivizedAcknowledgement
+		if err := types.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal ICS-29 incentivized packet acknowledgement %v: %s", ack, err)
+		}
+
+		acknowledgement = ack.AppAcknowledgement
+		forwardRelayer = ack.ForwardRelayerAddress
+	}
+
+	if feesInEscrow, found := im.keeper.GetFeesInEscrow(ctx, sourceClient, sequence); found {
+		if isIncentivized {
+			payee := im.getPayee(ctx, relayer, sourceClient)
+			im.keeper.DistributePacketFeesOnAcknowledgement(ctx, forwardRelayer, payee, feesInEscrow.PacketFees, sourceClient, sequence)
+		} else {
+			im.keeper.RefundPacketFees(ctx, feesInEscrow.PacketFees, sourceClient, sequence)
+		}
+	}
+
+	// call underlying callback
+	return im.app.OnAcknowledgementPacket(ctx, sourceClient, destinationClient, sequence, acknowledgement, payload, relayer)
+}
+
+// OnTimeoutPacket implements the IBCModule interface. The timeout fee escrowed for the packet is paid to
+// the payee of the relayer and the receive and acknowledgement fees are refunded.
+func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, sourceClient string, destinationClient string, sequence uint64, payload channeltypesv2.Payload, relayer sdk.AccAddress) error {
+	payload, isIncentivized, err := unwrapPayload(payload)
+	if isIncentivized && err != nil {
+		return err
+	}
+
+	if feesInEscrow, found := im.keeper.GetFeesInEscrow(ctx, sourceClient, sequence); found {
+		if isIncentivized {
+			payee := im.getPayee(ctx, relayer, sourceClient)
+			im.keeper.DistributePacketFeesOnTimeout(ctx, payee, feesInEscrow.PacketFees, sourceClient, sequence)
+		} else {
+			im.keeper.RefundPacketFees(ctx, feesInEscrow.PacketFees, sourceClient, sequence)
+		}
+	}
+
+	// call underlying callback
+	return im.app.OnTimeoutPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+}
+
+// WriteAcknowledgement implements the WriteAcknowledgementWrapper interface. The acknowledgement of an
+// incentivized payload whose receipt was handled asynchronously is wrapped in an IncentivizedAcknowledgement
+// containing the forward relayer address stored during OnRecvPacket.
+func (im IBCMiddleware) WriteAcknowledgement(ctx sdk.Context, clientID string, sequence uint64, ack channeltypesv2.Acknowledgement) error {
+	forwardRelayer, found := im.keeper.GetRelayerAddressForAsyncAck(ctx, clientID, sequence)
+	if found {
+		im.keeper.DeleteForwardRelayerAddress(ctx, clientID, sequence)
+
+		// the sentinel error acknowledgement is never wrapped
+		if len(ack.AppAcknowledgements) == 1 && !bytes.Equal(ack.AppAcknowledgements[0], channeltypesv2.ErrorAcknowledgement[:]) {
+			ack = channeltypesv2.NewAcknowledgement(types.NewIncentivizedAcknowledgement(forwardRelayer, ack.AppAcknowledgements[0]).Acknowledgement())
+		}
+	}
+
+	return im.keeper.WriteAcknowledgement(ctx, clientID, sequence, ack)
+}
+
+// UnmarshalPacketData attempts to use the underlying app to unmarshal the packet data.
+// If the underlying app does not support the PacketDataUnmarshaler interface, an error is returned.
+func (im IBCMiddleware) UnmarshalPacketData(payload channeltypesv2.Payload) (any, error) {
+	unmarshaler, ok := im.app.(api.PacketDataUnmarshaler)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrInvalidVersion, "underlying app does not implement %T", (*api.PacketDataUnmarshaler)(nil))
+	}
+
+	payload, isIncentivized, err := unwrapPayload(payload)
+	if isIncentivized && err != nil {
+		return nil, err
+	}
+
+	return unmarshaler.UnmarshalPacketData(payload)
+}
+
+// getPayee returns the payee registered for the relayer on the given client, or the relayer itself
+// if no payee is registered.
+func (im IBCMiddleware) getPayee(ctx sdk.Context, relayer sdk.AccAddress, clientID string) sdk.AccAddress {
+	payeeAddr, found := im.keeper.GetPayeeAddress(ctx, relayer.String(), clientID)
+	if !found {
+		return relayer
+	}
+
+	payee, err := sdk.AccAddressFromBech32(payeeAddr)
+	if err != nil {
+		return relayer
+	}
+
+	return payee
+}
+
+// unwrapPayload returns the payload with the version of the underlying application if the payload
+// is incentivized. A boolean is returned indicating whether the payload version is fee metadata,
+// and an error is returned if the fee metadata is invalid.
+func unwrapPayload(payload channeltypesv2.Payload) (channeltypesv2.Payload, bool, error) {
+	metadata, err := types.MetadataFromVersion(payload.Version)
+	if err != nil || metadata.FeeVersion == "" {
+		// the payload is not incentivized
+		return payload, false, nil
+	}
+
+	if err := metadata.ValidateBasic(); err != nil {
+		return payload, true, err
+	}
+
+	payload.Version = metadata.AppVersion
+	return payload, true, nil
+}
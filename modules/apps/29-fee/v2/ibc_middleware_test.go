@@ -0,0 +1,241 @@
+package v2_test
+
+import (
+	"testing"
+	"time"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/29-fee/types"
+	feev2 "github.com/cosmos/ibc-go/v10/modules/apps/29-fee/v2"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+var (
+	defaultRecvFee    = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdkmath.NewInt(100)}}
+	defaultAckFee     = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdkmath.NewInt(200)}}
+	defaultTimeoutFee = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdkmath.NewInt(150)}}
+)
+
+type FeeTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+
+	payee             sdk.AccAddress
+	counterpartyPayee sdk.AccAddress
+}
+
+func (suite *FeeTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.SetupV2()
+
+	// register payees for the relayers, which are the sender accounts of the chains
+	suite.payee = sdk.AccAddress("payee_______________")
+	suite.counterpartyPayee = sdk.AccAddress("counterparty_payee__")
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddress(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress().String(), suite.payee.String(), suite.path.EndpointA.ClientID)
+	suite.chainB.GetSimApp().IBCFeeKeeper.SetCounterpartyPayeeAddress(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress().String(), suite.counterpartyPayee.String(), suite.path.EndpointB.ClientID)
+}
+
+func TestFeeTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(FeeTestSuite))
+}
+
+// transferPayload returns an ICS-20 payload sending the bond denom from chainA to the given receiver,
+// using the provided payload version.
+func (suite *FeeTestSuite) transferPayload(version, receiver string) channeltypesv2.Payload {
+	transferData := transfertypes.NewFungibleTokenPacketData(
+		sdk.DefaultBondDenom, "1000",
+		suite.chainA.SenderAccount.GetAddress().String(), receiver, "",
+	)
+	bz := suite.chainA.Codec.MustMarshal(&transferData)
+	return channeltypesv2.NewPayload(transfertypes.PortID, transfertypes.PortID, version, transfertypes.EncodingProtobuf, bz)
+}
+
+// sendIncentivizedPacket sends the payload from chainA and escrows the default fees for the packet.
+func (suite *FeeTestSuite) sendIncentivizedPacket(payload channeltypesv2.Payload) channeltypesv2.Packet {
+	packet, err := suite.path.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), payload)
+	suite.Require().NoError(err)
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	msg := types.NewMsgPayPacketFee(packet.SourceClient, packet.Sequence, fee, suite.chainA.SenderAccount.GetAddress().String())
+	_, err = suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.path.EndpointB.UpdateClient())
+
+	return packet
+}
+
+func (suite *FeeTestSuite) TestIncentivizedPacket() {
+	incentivizedVersion := types.NewMetadata(types.Version, transfertypes.V1).Version()
+	transferAck := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
+
+	testCases := []struct {
+		name               string
+		version            string
+		receiver           func() string
+		expAck             func() channeltypesv2.Acknowledgement
+		timeout            bool
+		expPayeeFee        sdk.Coins
+		expCounterpartyFee sdk.Coins
+	}{
+		{
+			"success: receive and ack fees are paid",
+			incentivizedVersion,
+			func() string { return suite.chainB.SenderAccount.GetAddress().String() },
+			func() channeltypesv2.Acknowledgement {
+				return channeltypesv2.NewAcknowledgement(types.NewIncentivizedAcknowledgement(suite.counterpartyPayee.String(), transferAck).Acknowledgement())
+			},
+			false,
+			defaultAckFee,
+			defaultRecvFee,
+		},
+		{
+			"success: receive fee is refunded on error acknowledgement",
+			incentivizedVersion,
+			func() string { return "invalid-address" },
+			channeltypesv2.NewErrorAcknowledgement,
+			false,
+			defaultAckFee,
+			sdk.NewCoins(),
+		},
+		{
+			"success: timeout fee is paid",
+			incentivizedVersion,
+			func() string { return suite.chainB.SenderAccount.GetAddress().String() },
+			nil,
+			true,
+			defaultTimeoutFee,
+			sdk.NewCoins(),
+		},
+		{
+			"success: fees are refunded for payloads which are not incentivized",
+			transfertypes.V1,
+			func() string { return suite.chainB.SenderAccount.GetAddress().String() },
+			func() channeltypesv2.Acknowledgement { return channeltypesv2.NewAcknowledgement(transferAck) },
+			false,
+			sdk.NewCoins(),
+			sdk.NewCoins(),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			packet := suite.sendIncentivizedPacket(suite.transferPayload(tc.version, tc.receiver()))
+
+			feeModuleAddr := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress()
+			escrowBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), feeModuleAddr, sdk.DefaultBondDenom)
+			suite.Require().Equal(types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee).Total().AmountOf(sdk.DefaultBondDenom), escrowBalance.Amount)
+
+			if tc.timeout {
+				suite.coordinator.IncrementTimeBy(time.Hour * 2)
+				suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+
+				err := suite.path.EndpointA.MsgTimeoutPacket(packet)
+				suite.Require().NoError(err)
+			} else {
+				err := suite.path.EndpointB.MsgRecvPacket(packet)
+				suite.Require().NoError(err)
+
+				commitment := suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.DestinationClient, packet.Sequence)
+				suite.Require().Equal(channeltypesv2.CommitAcknowledgement(tc.expAck()), commitment)
+
+				err = suite.path.EndpointA.MsgAcknowledgePacket(packet, tc.expAck())
+				suite.Require().NoError(err)
+			}
+
+			// the fees have been distributed and removed from escrow
+			_, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), packet.SourceClient, packet.Sequence)
+			suite.Require().False(found)
+
+			escrowBalance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), feeModuleAddr, sdk.DefaultBondDenom)
+			suite.Require().True(escrowBalance.IsZero())
+
+			payeeBalance := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), suite.payee)
+			suite.Require().Equal(tc.expPayeeFee, payeeBalance)
+
+			counterpartyPayeeBalance := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), suite.counterpartyPayee)
+			suite.Require().Equal(tc.expCounterpartyFee, counterpartyPayeeBalance)
+		})
+	}
+}
+
+func (suite *FeeTestSuite) TestWriteAcknowledgement() {
+	transferAck := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
+	forwardRelayer := suite.chainB.SenderAccount.GetAddress().String()
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		ack      channeltypesv2.Acknowledgement
+		expAck   channeltypesv2.Acknowledgement
+	}{
+		{
+			"success: acknowledgement is wrapped with the forward relayer",
+			func() {
+				suite.chainB.GetSimApp().IBCFeeKeeper.SetRelayerAddressForAsyncAck(suite.chainB.GetContext(), suite.path.EndpointB.ClientID, 1, forwardRelayer)
+			},
+			channeltypesv2.NewAcknowledgement(transferAck),
+			channeltypesv2.NewAcknowledgement(types.NewIncentivizedAcknowledgement(forwardRelayer, transferAck).Acknowledgement()),
+		},
+		{
+			"success: error acknowledgement is not wrapped",
+			func() {
+				suite.chainB.GetSimApp().IBCFeeKeeper.SetRelayerAddressForAsyncAck(suite.chainB.GetContext(), suite.path.EndpointB.ClientID, 1, forwardRelayer)
+			},
+			channeltypesv2.NewErrorAcknowledgement(),
+			channeltypesv2.NewErrorAcknowledgement(),
+		},
+		{
+			"success: acknowledgement of packet which is not incentivized is not wrapped",
+			func() {},
+			channeltypesv2.NewAcknowledgement(transferAck),
+			channeltypesv2.NewAcknowledgement(transferAck),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			packet := channeltypesv2.NewPacket(
+				1, suite.path.EndpointA.ClientID, suite.path.EndpointB.ClientID, suite.chainA.GetTimeoutTimestampSecs(),
+				suite.transferPayload(transfertypes.V1, suite.chainB.SenderAccount.GetAddress().String()),
+			)
+			suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetPacketReceipt(suite.chainB.GetContext(), packet.DestinationClient, packet.Sequence)
+			suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetAsyncPacket(suite.chainB.GetContext(), packet.DestinationClient, packet.Sequence, packet)
+
+			tc.malleate()
+
+			middleware := feev2.NewIBCMiddleware(suite.chainB.GetSimApp().MockModuleV2A, suite.chainB.GetSimApp().IBCFeeKeeper)
+			err := middleware.WriteAcknowledgement(suite.chainB.GetContext(), packet.DestinationClient, packet.Sequence, tc.ack)
+			suite.Require().NoError(err)
+
+			commitment := suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.DestinationClient, packet.Sequence)
+			suite.Require().Equal(channeltypesv2.CommitAcknowledgement(tc.expAck), commitment)
+
+			_, found := suite.chainB.GetSimApp().IBCFeeKeeper.GetRelayerAddressForAsyncAck(suite.chainB.GetContext(), packet.DestinationClient, packet.Sequence)
+			suite.Require().False(found)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the query commands for the async interchain queries module
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        "interchain-query",
+		Aliases:                    []string{"icq"},
+		Short:                      "IBC async interchain queries query subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdParams(),
+	)
+
+	return queryCmd
+}
+
+// NewTxCmd returns the transaction commands for the async interchain queries module
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        "interchain-query",
+		Aliases:                    []string{"icq"},
+		Short:                      "IBC async interchain queries transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	txCmd.AddCommand(
+		NewSendQueryCmd(),
+	)
+
+	return txCmd
+}
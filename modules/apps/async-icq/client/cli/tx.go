@@ -0,0 +1,98 @@
+package cli
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/async-icq/types"
+)
+
+const (
+	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
+	flagAbsoluteTimeouts       = "absolute-timeouts"
+	flagMemo                   = "memo"
+)
+
+// defaultRelativePacketTimeoutTimestamp is the default packet timeout timestamp (in nanoseconds)
+// relative to the local clock time. The default is currently set to a 10 minute timeout.
+var defaultRelativePacketTimeoutTimestamp = uint64((time.Duration(10) * time.Minute).Nanoseconds())
+
+// NewSendQueryCmd returns the command to create a MsgSendQuery transaction
+func NewSendQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-query [src-port] [src-channel] [path] [hex-encoded-request]",
+		Short: "Send an interchain query through IBC",
+		Long: strings.TrimSpace(`Send an interchain query through IBC. The query is identified by its fully qualified gRPC method name
+and carries the hex encoded protobuf request. The response is returned in the packet acknowledgement.
+Timeouts can be specified as absolute using the {absolute-timeouts} flag.`),
+		Example: fmt.Sprintf("%s tx interchain-query send-query icq channel-0 /cosmos.bank.v1beta1.Query/Balance 0a2d636f736d6f73...", version.AppName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender := clientCtx.GetFromAddress().String()
+			srcPort := args[0]
+			srcChannel := args[1]
+
+			data, err := hex.DecodeString(args[3])
+			if err != nil {
+				return fmt.Errorf("failed to decode query request: %w", err)
+			}
+
+			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+
+			absoluteTimeouts, err := cmd.Flags().GetBool(flagAbsoluteTimeouts)
+			if err != nil {
+				return err
+			}
+
+			memo, err := cmd.Flags().GetString(flagMemo)
+			if err != nil {
+				return err
+			}
+
+			// if the timeouts are not absolute, CLI users rely solely on local clock time in order to calculate relative timestamps.
+			if !absoluteTimeouts {
+				if timeoutTimestamp == 0 {
+					return errors.New("relative timeouts must provide a non zero value timestamp")
+				}
+
+				// use local clock time as reference time for calculating timeout timestamp.
+				now := time.Now().UnixNano()
+				if now <= 0 {
+					return errors.New("local clock time is not greater than Jan 1st, 1970 12:00 AM")
+				}
+
+				timeoutTimestamp = uint64(now) + timeoutTimestamp
+			}
+
+			requests := []types.QueryRequest{types.NewQueryRequest(args[2], data)}
+			msg := types.NewMsgSendQuery(sender, srcPort, srcChannel, requests, timeoutTimestamp, memo)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, defaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from now. Default is 10 minutes.")
+	cmd.Flags().Bool(flagAbsoluteTimeouts, false, "Timeout flags are used as absolute timeouts.")
+	cmd.Flags().String(flagMemo, "", "Memo to be sent along with the packet.")
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,14 @@
+/*
+Package icq implements the async interchain queries application over IBC v1 channels and IBC v2 clients.
+
+A module or account on the controller chain sends a batch of queries, each identified by the fully
+qualified gRPC method name of a module query safe query and its protobuf encoded request. The host
+chain executes the queries in order and returns their responses, together with the host block height
+at which they were executed, in the result of the acknowledgement. A packet is rejected with an error
+acknowledgement if any of its queries is not allowed by the host.
+
+The queries which may be executed by the host are defined by a governance-controlled allowlist, which
+may only contain query paths labeled with module_query_safe. The responses are not interpreted by the
+application, they are surfaced to the sender through the callbacks middleware.
+*/
+package icq
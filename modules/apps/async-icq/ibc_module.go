@@ -0,0 +1,227 @@
+package icq
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/async-icq/keeper"
+	"github.com/cosmos/ibc-go/v10/modules/apps/async-icq/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+var (
+	_ porttypes.IBCModule             = (*IBCModule)(nil)
+	_ porttypes.PacketDataUnmarshaler = (*IBCModule)(nil)
+)
+
+// IBCModule implements the ICS26 interface for async interchain queries given the keeper.
+type IBCModule struct {
+	keeper keeper.Keeper
+}
+
+// NewIBCModule creates a new IBCModule given the keeper
+func NewIBCModule(k keeper.Keeper) IBCModule {
+	return IBCModule{
+		keeper: k,
+	}
+}
+
+// ValidateICQChannelParams does validation of a newly created async interchain queries channel.
+// An async interchain queries channel must be UNORDERED and use the port the module is bound to
+// (by default 'icq').
+func ValidateICQChannelParams(
+	ctx sdk.Context,
+	icqKeeper keeper.Keeper,
+	order channeltypes.Order,
+	portID string,
+) error {
+	if order != channeltypes.UNORDERED {
+		return errorsmod.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s ", channeltypes.UNORDERED, order)
+	}
+
+	// Require portID is the portID the async interchain queries module is bound to
+	boundPort := icqKeeper.GetPort(ctx)
+	if boundPort != portID {
+		return errorsmod.Wrapf(porttypes.ErrInvalidPort, "invalid port: %s, expected %s", portID, boundPort)
+	}
+
+	return nil
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (im IBCModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	if err := ValidateICQChannelParams(ctx, im.keeper, order, portID); err != nil {
+		return "", err
+	}
+
+	if version == "" {
+		version = types.Version
+	}
+
+	if version != types.Version {
+		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected %s, got %s", types.Version, version)
+	}
+
+	return version, nil
+}
+
+// OnChanOpenTry implements the IBCModule interface.
+func (im IBCModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	if err := ValidateICQChannelParams(ctx, im.keeper, order, portID); err != nil {
+		return "", err
+	}
+
+	if counterpartyVersion != types.Version {
+		im.keeper.Logger(ctx).Debug("invalid counterparty version, proposing current app version", "counterpartyVersion", counterpartyVersion, "version", types.Version)
+		return types.Version, nil
+	}
+
+	return counterpartyVersion, nil
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (IBCModule) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	_ string,
+	counterpartyVersion string,
+) error {
+	if counterpartyVersion != types.Version {
+		return errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: expected %s, got %s", types.Version, counterpartyVersion)
+	}
+
+	return nil
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (IBCModule) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return nil
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (IBCModule) OnChanCloseInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	// Disallow user-initiated channel closing for async interchain queries channels
+	return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "user cannot close channel")
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (IBCModule) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface. A successful acknowledgement holding
+// the query responses is returned if the packet data is successfully decoded and all
+// queries are executed without error.
+func (im IBCModule) OnRecvPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	data, err := types.UnmarshalPacketData(packet.GetData(), channelVersion, "")
+	if err != nil {
+		im.keeper.Logger(ctx).Error(fmt.Sprintf("%s sequence %d", err.Error(), packet.Sequence))
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	result, err := im.keeper.OnRecvPacket(ctx, data)
+	keeper.EmitOnRecvPacketEvent(ctx, data, err)
+	if err != nil {
+		im.keeper.Logger(ctx).Error(fmt.Sprintf("%s sequence %d", err.Error(), packet.Sequence))
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	im.keeper.Logger(ctx).Info("successfully handled interchain queries packet", "sequence", packet.Sequence)
+
+	// NOTE: acknowledgement will be written synchronously during IBC handler execution.
+	return channeltypes.NewResultAcknowledgement(result)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. The query responses are
+// not interpreted by the module, they are surfaced to the sender through the callbacks middleware.
+func (IBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	var ack channeltypes.Acknowledgement
+	if err := types.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrUnknownRequest, "cannot unmarshal async interchain queries packet acknowledgement: %v", err)
+	}
+
+	data, err := types.UnmarshalPacketData(packet.GetData(), channelVersion, "")
+	if err != nil {
+		return err
+	}
+
+	keeper.EmitOnAcknowledgementPacketEvent(ctx, data, ack.Success())
+
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface
+func (IBCModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	data, err := types.UnmarshalPacketData(packet.GetData(), channelVersion, "")
+	if err != nil {
+		return err
+	}
+
+	keeper.EmitOnTimeoutPacketEvent(ctx, data)
+
+	return nil
+}
+
+// UnmarshalPacketData attempts to unmarshal the provided packet data bytes
+// into an InterchainQueryPacketData. This function implements the optional
+// PacketDataUnmarshaler interface required for ADR 008 support.
+func (im IBCModule) UnmarshalPacketData(ctx sdk.Context, portID string, channelID string, bz []byte) (any, string, error) {
+	version, found := im.keeper.GetICS4Wrapper().GetAppVersion(ctx, portID, channelID)
+	if !found {
+		return types.InterchainQueryPacketData{}, "", errorsmod.Wrapf(ibcerrors.ErrNotFound, "app version not found for port %s and channel %s", portID, channelID)
+	}
+
+	data, err := types.UnmarshalPacketData(bz, version, "")
+	return data, version, err
+}
@@ -0,0 +1,248 @@
+package icq_test
+
+import (
+	"testing"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	icq "github.com/cosmos/ibc-go/v10/modules/apps/async-icq"
+	"github.com/cosmos/ibc-go/v10/modules/apps/async-icq/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+const balanceQueryPath = "/cosmos.bank.v1beta1.Query/Balance"
+
+type ICQTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+}
+
+func (suite *ICQTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+}
+
+func TestICQTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(ICQTestSuite))
+}
+
+// NewICQPath creates a new path with async interchain queries channel endpoints.
+func NewICQPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = types.PortID
+	path.EndpointB.ChannelConfig.PortID = types.PortID
+	path.EndpointA.ChannelConfig.Version = types.Version
+	path.EndpointB.ChannelConfig.Version = types.Version
+
+	return path
+}
+
+func (suite *ICQTestSuite) TestOnChanOpenInit() {
+	var (
+		path    *ibctesting.Path
+		order   channeltypes.Order
+		version string
+	)
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expError   error
+		expVersion string
+	}{
+		{"success", func() {}, nil, types.Version},
+		{"success: empty version string", func() { version = "" }, nil, types.Version},
+		{"failure: invalid order - ORDERED", func() { order = channeltypes.ORDERED }, channeltypes.ErrInvalidChannelOrdering, ""},
+		{"failure: invalid port ID", func() { path.EndpointA.ChannelConfig.PortID = ibctesting.MockPort }, porttypes.ErrInvalidPort, ""},
+		{"failure: invalid version", func() { version = "icq-2" }, types.ErrInvalidVersion, ""},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = NewICQPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+			path.EndpointA.ChannelID = ibctesting.FirstChannelID
+
+			order = channeltypes.UNORDERED
+			version = types.Version
+
+			tc.malleate()
+
+			icqModule := icq.NewIBCModule(suite.chainA.GetSimApp().ICQKeeper)
+			counterparty := channeltypes.NewCounterparty(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			chanVersion, err := icqModule.OnChanOpenInit(suite.chainA.GetContext(), order, []string{path.EndpointA.ConnectionID},
+				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, counterparty, version,
+			)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expVersion, chanVersion)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *ICQTestSuite) TestOnChanOpenTry() {
+	var (
+		path                *ibctesting.Path
+		order               channeltypes.Order
+		counterpartyVersion string
+	)
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expError   error
+		expVersion string
+	}{
+		{"success", func() {}, nil, types.Version},
+		{"success: invalid counterparty version proposes current version", func() { counterpartyVersion = "icq-2" }, nil, types.Version},
+		{"failure: invalid order - ORDERED", func() { order = channeltypes.ORDERED }, channeltypes.ErrInvalidChannelOrdering, ""},
+		{"failure: invalid port ID", func() { path.EndpointA.ChannelConfig.PortID = ibctesting.MockPort }, porttypes.ErrInvalidPort, ""},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = NewICQPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+			path.EndpointA.ChannelID = ibctesting.FirstChannelID
+
+			order = channeltypes.UNORDERED
+			counterpartyVersion = types.Version
+
+			tc.malleate()
+
+			icqModule := icq.NewIBCModule(suite.chainA.GetSimApp().ICQKeeper)
+			counterparty := channeltypes.NewCounterparty(path.EndpointB.ChannelConfig.PortID, ibctesting.FirstChannelID)
+			version, err := icqModule.OnChanOpenTry(suite.chainA.GetContext(), order, []string{path.EndpointA.ConnectionID},
+				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, counterparty, counterpartyVersion,
+			)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expVersion, version)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *ICQTestSuite) TestOnChanOpenAck() {
+	icqModule := icq.NewIBCModule(suite.chainA.GetSimApp().ICQKeeper)
+
+	err := icqModule.OnChanOpenAck(suite.chainA.GetContext(), types.PortID, ibctesting.FirstChannelID, ibctesting.FirstChannelID, types.Version)
+	suite.Require().NoError(err)
+
+	err = icqModule.OnChanOpenAck(suite.chainA.GetContext(), types.PortID, ibctesting.FirstChannelID, ibctesting.FirstChannelID, "icq-2")
+	suite.Require().ErrorIs(err, types.ErrInvalidVersion)
+}
+
+func (suite *ICQTestSuite) TestOnChanCloseInit() {
+	icqModule := icq.NewIBCModule(suite.chainA.GetSimApp().ICQKeeper)
+
+	err := icqModule.OnChanCloseInit(suite.chainA.GetContext(), types.PortID, ibctesting.FirstChannelID)
+	suite.Require().ErrorIs(err, ibcerrors.ErrInvalidRequest)
+}
+
+func (suite *ICQTestSuite) TestOnRecvPacket() {
+	var (
+		path      *ibctesting.Path
+		queryPath string
+	)
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expSuccess bool
+	}{
+		{"success", func() {}, true},
+		{"failure: host disabled", func() {
+			suite.chainB.GetSimApp().ICQKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{balanceQueryPath}))
+		}, false},
+		{"failure: query not allowed", func() { queryPath = "/cosmos.bank.v1beta1.Query/AllBalances" }, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = NewICQPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			suite.chainB.GetSimApp().ICQKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, []string{balanceQueryPath}))
+			queryPath = balanceQueryPath
+
+			tc.malleate()
+
+			request := suite.chainB.GetSimApp().AppCodec().MustMarshal(&banktypes.QueryBalanceRequest{
+				Address: suite.chainB.SenderAccount.GetAddress().String(),
+				Denom:   sdk.DefaultBondDenom,
+			})
+			timeoutTimestamp := uint64(suite.chainB.GetContext().BlockTime().Add(ibctesting.TimeIncrement * 10).UnixNano())
+			msg := types.NewMsgSendQuery(
+				suite.chainA.SenderAccount.GetAddress().String(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				[]types.QueryRequest{types.NewQueryRequest(queryPath, request)},
+				timeoutTimestamp, "",
+			)
+
+			res, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+
+			packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+			suite.Require().NoError(err)
+
+			_, ackBz, err := path.RelayPacketWithResults(packet)
+			suite.Require().NoError(err)
+
+			var ack channeltypes.Acknowledgement
+			err = types.ModuleCdc.UnmarshalJSON(ackBz, &ack)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expSuccess, ack.Success())
+
+			if tc.expSuccess {
+				icqAck, err := types.UnmarshalPacketAck(ack.GetResult())
+				suite.Require().NoError(err)
+				suite.Require().Len(icqAck.Responses, 1)
+
+				var balanceResponse banktypes.QueryBalanceResponse
+				err = suite.chainB.GetSimApp().AppCodec().Unmarshal(icqAck.Responses[0], &balanceResponse)
+				suite.Require().NoError(err)
+				suite.Require().Equal(sdk.DefaultBondDenom, balanceResponse.Balance.Denom)
+			}
+		})
+	}
+}
+
+func (suite *ICQTestSuite) TestPacketDataUnmarshalerInterface() {
+	path := NewICQPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	expData := types.NewInterchainQueryPacketData(suite.chainA.SenderAccount.GetAddress().String(), []types.QueryRequest{types.NewQueryRequest(balanceQueryPath, nil)}, "memo")
+
+	icqModule := icq.NewIBCModule(suite.chainA.GetSimApp().ICQKeeper)
+	data, version, err := icqModule.UnmarshalPacketData(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, expData.GetBytes())
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.Version, version)
+	suite.Require().Equal(expData, data)
+
+	_, _, err = icqModule.UnmarshalPacketData(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, ibctesting.InvalidID, expData.GetBytes())
+	suite.Require().ErrorIs(err, ibcerrors.ErrNotFound)
+}
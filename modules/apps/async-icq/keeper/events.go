@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/async-icq/types"
+)
+
+// EmitOnRecvPacketEvent emits an event signalling the execution of the queries of a received packet,
+// including the error if their execution failed.
+func EmitOnRecvPacketEvent(ctx sdk.Context, data types.InterchainQueryPacketData, ackErr error) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeySender, data.Sender),
+		sdk.NewAttribute(types.AttributeKeyQueries, strconv.Itoa(len(data.Requests))),
+		sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
+		sdk.NewAttribute(types.AttributeKeyAckSuccess, strconv.FormatBool(ackErr == nil)),
+	}
+
+	if ackErr != nil {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyAckError, ackErr.Error()))
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(types.EventTypePacket, attributes...),
+	})
+}
+
+// EmitOnAcknowledgementPacketEvent emits an event signalling the acknowledgement of sent queries.
+func EmitOnAcknowledgementPacketEvent(ctx sdk.Context, data types.InterchainQueryPacketData, success bool) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePacket,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeySender, data.Sender),
+			sdk.NewAttribute(types.AttributeKeyQueries, strconv.Itoa(len(data.Requests))),
+			sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
+			sdk.NewAttribute(types.AttributeKeyAckSuccess, strconv.FormatBool(success)),
+		),
+	})
+}
+
+// EmitOnTimeoutPacketEvent emits an event signalling the timeout of sent queries.
+func EmitOnTimeoutPacketEvent(ctx sdk.Context, data types.InterchainQueryPacketData) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTimeout,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeySender, data.Sender),
+			sdk.NewAttribute(types.AttributeKeyQueries, strconv.Itoa(len(data.Requests))),
+			sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
+		),
+	})
+}
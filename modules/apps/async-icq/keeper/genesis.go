@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/async-icq/types"
+)
+
+// InitGenesis initializes the async interchain queries state from the provided genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	k.SetPort(ctx, state.PortId)
+
+	if err := k.validateAllowQueries(state.Params.AllowQueries); err != nil {
+		panic(fmt.Errorf("could not set async interchain queries params at genesis: %v", err))
+	}
+
+	k.SetParams(ctx, state.Params)
+}
+
+// ExportGenesis exports the async interchain queries module's port ID and params into its genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		PortId: k.GetPort(ctx),
+		Params: k.GetParams(ctx),
+	}
+}
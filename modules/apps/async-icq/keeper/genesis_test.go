@@ -0,0 +1,23 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v10/modules/apps/async-icq/types"
+)
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	icqKeeper := suite.chainA.GetSimApp().ICQKeeper
+
+	genesis := types.NewGenesisState(types.PortID, types.NewParams(false, []string{balanceQueryPath}))
+	icqKeeper.InitGenesis(suite.chainA.GetContext(), *genesis)
+
+	suite.Require().Equal(genesis, icqKeeper.ExportGenesis(suite.chainA.GetContext()))
+}
+
+func (suite *KeeperTestSuite) TestInitGenesisPanicsOnUnsafeQuery() {
+	icqKeeper := suite.chainA.GetSimApp().ICQKeeper
+
+	genesis := types.NewGenesisState(types.PortID, types.NewParams(true, []string{"/ibc.applications.transfer.v1.Query/Params"}))
+	suite.Require().Panics(func() {
+		icqKeeper.InitGenesis(suite.chainA.GetContext(), *genesis)
+	})
+}
@@ -0,0 +1,20 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v10/modules/apps/async-icq/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryParams() {
+	ctx := suite.chainA.GetContext()
+	icqKeeper := suite.chainA.GetSimApp().ICQKeeper
+
+	expParams := types.NewParams(false, []string{balanceQueryPath})
+	icqKeeper.SetParams(ctx, expParams)
+
+	res, err := icqKeeper.Params(ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&expParams, res.Params)
+
+	_, err = icqKeeper.Params(ctx, nil)
+	suite.Require().Error(err)
+}
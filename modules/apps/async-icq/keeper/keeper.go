@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"errors"
+	"slices"
+	"strings"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/async-icq/types"
+	"github.com/cosmos/ibc-go/v10/modules/apps/internal/querysafe"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+// Keeper defines the async interchain queries keeper
+type Keeper struct {
+	storeService corestore.KVStoreService
+	cdc          codec.BinaryCodec
+
+	ics4Wrapper porttypes.ICS4Wrapper
+	queryRouter types.QueryRouter
+
+	// mqsAllowList is the list of all query paths labeled with module_query_safe.
+	// Only these queries may be allowed to be executed on behalf of counterparty chains.
+	mqsAllowList []string
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new async interchain queries Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService corestore.KVStoreService,
+	ics4Wrapper porttypes.ICS4Wrapper,
+	queryRouter types.QueryRouter,
+	authority string,
+) Keeper {
+	if strings.TrimSpace(authority) == "" {
+		panic(errors.New("authority must be non-empty"))
+	}
+
+	return Keeper{
+		cdc:          cdc,
+		storeService: storeService,
+		ics4Wrapper:  ics4Wrapper,
+		queryRouter:  queryRouter,
+		mqsAllowList: querysafe.NewAllowList(),
+		authority:    authority,
+	}
+}
+
+// WithICS4Wrapper sets the ICS4Wrapper. This function may be used after
+// the keepers creation to set the middleware which is above this module
+// in the IBC application stack.
+func (k *Keeper) WithICS4Wrapper(wrapper porttypes.ICS4Wrapper) {
+	k.ics4Wrapper = wrapper
+}
+
+// GetICS4Wrapper returns the ICS4Wrapper.
+func (k Keeper) GetICS4Wrapper() porttypes.ICS4Wrapper {
+	return k.ics4Wrapper
+}
+
+// GetAuthority returns the async interchain queries module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+exported.ModuleName+"-"+types.ModuleName)
+}
+
+// GetPort returns the portID for the async interchain queries module.
+func (k Keeper) GetPort(ctx sdk.Context) string {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.PortKey)
+	if err != nil {
+		panic(err)
+	}
+	return string(bz)
+}
+
+// SetPort sets the portID for the async interchain queries module. Used in InitGenesis
+func (k Keeper) SetPort(ctx sdk.Context, portID string) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.PortKey, []byte(portID)); err != nil {
+		panic(err)
+	}
+}
+
+// GetParams returns the current async interchain queries module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get([]byte(types.ParamsKey))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil { // only panic on unset params and not on empty params
+		panic(errors.New("async interchain queries params are not set in store"))
+	}
+
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the async interchain queries module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&params)
+	if err := store.Set([]byte(types.ParamsKey), bz); err != nil {
+		panic(err)
+	}
+}
+
+// IsModuleQuerySafe returns true if the query path is labeled with module_query_safe.
+func (k Keeper) IsModuleQuerySafe(path string) bool {
+	return slices.Contains(k.mqsAllowList, path)
+}
@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"testing"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/async-icq/keeper"
+	"github.com/cosmos/ibc-go/v10/modules/apps/async-icq/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+const balanceQueryPath = "/cosmos.bank.v1beta1.Query/Balance"
+
+type KeeperTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = NewICQPath(suite.chainA, suite.chainB)
+	suite.path.Setup()
+}
+
+// NewICQPath creates a new path with async interchain queries channel endpoints.
+func NewICQPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = types.PortID
+	path.EndpointB.ChannelConfig.PortID = types.PortID
+	path.EndpointA.ChannelConfig.Version = types.Version
+	path.EndpointB.ChannelConfig.Version = types.Version
+
+	return path
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) TestNewKeeper() {
+	testCases := []struct {
+		name          string
+		instantiateFn func()
+		panicMsg      string
+	}{
+		{"success", func() {
+			keeper.NewKeeper(
+				suite.chainA.GetSimApp().AppCodec(),
+				runtime.NewKVStoreService(suite.chainA.GetSimApp().GetKey(types.StoreKey)),
+				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper,
+				suite.chainA.GetSimApp().GRPCQueryRouter(),
+				suite.chainA.GetSimApp().ICQKeeper.GetAuthority(),
+			)
+		}, ""},
+		{"failure: empty authority", func() {
+			keeper.NewKeeper(
+				suite.chainA.GetSimApp().AppCodec(),
+				runtime.NewKVStoreService(suite.chainA.GetSimApp().GetKey(types.StoreKey)),
+				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper,
+				suite.chainA.GetSimApp().GRPCQueryRouter(),
+				"", // authority
+			)
+		}, "authority must be non-empty"},
+	}
+
+	for _, tc := range testCases {
+		suite.SetupTest()
+
+		suite.Run(tc.name, func() {
+			if tc.panicMsg == "" {
+				suite.Require().NotPanics(tc.instantiateFn)
+			} else {
+				suite.Require().PanicsWithError(tc.panicMsg, tc.instantiateFn)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestParams() {
+	ctx := suite.chainA.GetContext()
+	icqKeeper := suite.chainA.GetSimApp().ICQKeeper
+
+	suite.Require().Equal(types.DefaultParams(), icqKeeper.GetParams(ctx))
+
+	expParams := types.NewParams(false, []string{balanceQueryPath})
+	icqKeeper.SetParams(ctx, expParams)
+	suite.Require().Equal(expParams, icqKeeper.GetParams(ctx))
+}
+
+func (suite *KeeperTestSuite) TestIsModuleQuerySafe() {
+	icqKeeper := suite.chainA.GetSimApp().ICQKeeper
+
+	suite.Require().True(icqKeeper.IsModuleQuerySafe(balanceQueryPath))
+	suite.Require().False(icqKeeper.IsModuleQuerySafe("/ibc.applications.transfer.v1.Query/Params"))
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/async-icq/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+)
+
+var _ types.MsgServer = (*Keeper)(nil)
+
+// SendQuery defines an rpc handler method for MsgSendQuery.
+func (k Keeper) SendQuery(goCtx context.Context, msg *types.MsgSendQuery) (*types.MsgSendQueryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sequence, err := k.SendInterchainQuery(ctx, msg.SourcePort, msg.SourceChannel, msg.TimeoutTimestamp, msg.PacketData())
+	if err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("interchain queries sent", "port-id", msg.SourcePort, "channel-id", msg.SourceChannel, "sequence", sequence)
+
+	return &types.MsgSendQueryResponse{Sequence: sequence}, nil
+}
+
+// UpdateParams defines an rpc handler method for MsgUpdateParams.
+func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	if err := k.validateAllowQueries(msg.Params.AllowQueries); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetParams(ctx, msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
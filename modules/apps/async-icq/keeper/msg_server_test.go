@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v10/modules/apps/async-icq/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+func (suite *KeeperTestSuite) TestMsgSendQuery() {
+	var msg *types.MsgSendQuery
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{"success", func() {}, nil},
+		{"failure: channel not found", func() { msg.SourceChannel = ibctesting.InvalidID }, channeltypes.ErrChannelNotFound},
+		{"failure: invalid packet data", func() { msg.Requests = nil }, types.ErrInvalidPacketData},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			timeoutTimestamp := uint64(suite.chainB.GetContext().BlockTime().Add(ibctesting.TimeIncrement * 10).UnixNano())
+			msg = types.NewMsgSendQuery(
+				suite.chainA.SenderAccount.GetAddress().String(),
+				suite.path.EndpointA.ChannelConfig.PortID,
+				suite.path.EndpointA.ChannelID,
+				[]types.QueryRequest{types.NewQueryRequest(balanceQueryPath, nil)},
+				timeoutTimestamp, "",
+			)
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().ICQKeeper.SendQuery(suite.chainA.GetContext(), msg)
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(1), res.Sequence)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgUpdateParams() {
+	var msg *types.MsgUpdateParams
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{"success", func() {}, nil},
+		{"success: disable host", func() { msg.Params.HostEnabled = false }, nil},
+		{"failure: unauthorized signer", func() { msg.Signer = suite.chainA.SenderAccount.GetAddress().String() }, ibcerrors.ErrUnauthorized},
+		{"failure: query not module query safe", func() {
+			msg.Params.AllowQueries = append(msg.Params.AllowQueries, "/ibc.applications.transfer.v1.Query/Params")
+		}, types.ErrQueryNotAllowed},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			icqKeeper := suite.chainA.GetSimApp().ICQKeeper
+			msg = types.NewMsgUpdateParams(icqKeeper.GetAuthority(), types.NewParams(true, []string{balanceQueryPath}))
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := icqKeeper.UpdateParams(ctx, msg)
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(msg.Params, icqKeeper.GetParams(ctx))
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+				suite.Require().Equal(types.DefaultParams(), icqKeeper.GetParams(ctx))
+			}
+		})
+	}
+}
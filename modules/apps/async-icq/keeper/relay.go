@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"slices"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/async-icq/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+)
+
+// SendInterchainQuery sends the queries in the provided packet data over the given IBC v1 channel. The queries are
+// executed by the counterparty chain and their results are returned in the packet acknowledgement,
+// which is surfaced to the sender through the callbacks middleware. It may be used by other modules
+// to send queries on their own behalf.
+func (k Keeper) SendInterchainQuery(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel string,
+	timeoutTimestamp uint64,
+	data types.InterchainQueryPacketData,
+) (uint64, error) {
+	if err := data.ValidateBasic(); err != nil {
+		return 0, err
+	}
+
+	return k.ics4Wrapper.SendPacket(ctx, sourcePort, sourceChannel, clienttypes.ZeroHeight(), timeoutTimestamp, data.GetBytes())
+}
+
+// OnRecvPacket executes the queries of the received packet data in order and returns the
+// acknowledgement result holding their responses. All queries must be allowed by the module
+// params, otherwise none are executed.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, data types.InterchainQueryPacketData) ([]byte, error) {
+	params := k.GetParams(ctx)
+	if !params.HostEnabled {
+		return nil, types.ErrHostDisabled
+	}
+
+	for _, request := range data.Requests {
+		if !slices.Contains(params.AllowQueries, request.Path) || !k.IsModuleQuerySafe(request.Path) {
+			return nil, errorsmod.Wrapf(types.ErrQueryNotAllowed, "query path %s is not allowed", request.Path)
+		}
+	}
+
+	responses := make([][]byte, len(data.Requests))
+	for i, request := range data.Requests {
+		route := k.queryRouter.Route(request.Path)
+		if route == nil {
+			return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "no route to query: %s", request.Path)
+		}
+
+		res, err := route(ctx, &abci.RequestQuery{
+			Path: request.Path,
+			Data: request.Data,
+		})
+		if err != nil {
+			k.Logger(ctx).Debug("query failed", "path", request.Path, "error", err)
+			return nil, err
+		}
+		if res == nil || res.Value == nil {
+			return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "no response for query: %s", request.Path)
+		}
+
+		responses[i] = res.Value
+	}
+
+	return types.NewInterchainQueryPacketAck(responses, uint64(ctx.BlockHeight())).GetBytes(), nil
+}
+
+// validateAllowQueries ensures all allowed queries are labeled with module_query_safe.
+func (k Keeper) validateAllowQueries(allowQueries []string) error {
+	for _, path := range allowQueries {
+		if !k.IsModuleQuerySafe(path) {
+			return errorsmod.Wrapf(types.ErrQueryNotAllowed, "query path %s is not module query safe", path)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/async-icq/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+func (suite *KeeperTestSuite) TestSendInterchainQuery() {
+	var (
+		data          types.InterchainQueryPacketData
+		sourceChannel string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{"success", func() {}, nil},
+		{"failure: invalid packet data", func() { data.Requests = nil }, types.ErrInvalidPacketData},
+		{"failure: channel not found", func() { sourceChannel = ibctesting.InvalidID }, channeltypes.ErrChannelNotFound},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			data = types.NewInterchainQueryPacketData(suite.chainA.SenderAccount.GetAddress().String(), []types.QueryRequest{types.NewQueryRequest(balanceQueryPath, nil)}, "")
+			sourceChannel = suite.path.EndpointA.ChannelID
+
+			tc.malleate()
+
+			timeoutTimestamp := uint64(suite.chainB.GetContext().BlockTime().Add(ibctesting.TimeIncrement * 10).UnixNano())
+			sequence, err := suite.chainA.GetSimApp().ICQKeeper.SendInterchainQuery(suite.chainA.GetContext(), types.PortID, sourceChannel, timeoutTimestamp, data)
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(1), sequence)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacket() {
+	var (
+		data   types.InterchainQueryPacketData
+		params types.Params
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{"success", func() {}, nil},
+		{"success: multiple queries", func() {
+			data.Requests = append(data.Requests, data.Requests[0])
+		}, nil},
+		{"failure: host disabled", func() { params.HostEnabled = false }, types.ErrHostDisabled},
+		{"failure: query not in allowlist", func() { params.AllowQueries = []string{} }, types.ErrQueryNotAllowed},
+		{"failure: query not module query safe", func() {
+			params.AllowQueries = append(params.AllowQueries, "/ibc.applications.transfer.v1.Query/Params")
+			data.Requests = append(data.Requests, types.NewQueryRequest("/ibc.applications.transfer.v1.Query/Params", nil))
+		}, types.ErrQueryNotAllowed},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			request := suite.chainB.GetSimApp().AppCodec().MustMarshal(&banktypes.QueryBalanceRequest{
+				Address: suite.chainB.SenderAccount.GetAddress().String(),
+				Denom:   sdk.DefaultBondDenom,
+			})
+			data = types.NewInterchainQueryPacketData(suite.chainA.SenderAccount.GetAddress().String(), []types.QueryRequest{types.NewQueryRequest(balanceQueryPath, request)}, "")
+			params = types.NewParams(true, []string{balanceQueryPath})
+
+			tc.malleate()
+
+			ctx := suite.chainB.GetContext()
+			icqKeeper := suite.chainB.GetSimApp().ICQKeeper
+			icqKeeper.SetParams(ctx, params)
+
+			result, err := icqKeeper.OnRecvPacket(ctx, data)
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				ack, err := types.UnmarshalPacketAck(result)
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(ctx.BlockHeight()), ack.Height)
+				suite.Require().Len(ack.Responses, len(data.Requests))
+
+				expBalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, suite.chainB.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+				for _, response := range ack.Responses {
+					var balanceResponse banktypes.QueryBalanceResponse
+					suite.Require().NoError(suite.chainB.GetSimApp().AppCodec().Unmarshal(response, &balanceResponse))
+					suite.Require().Equal(expBalance, *balanceResponse.Balance)
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(result)
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// async interchain queries sentinel errors
+var (
+	ErrInvalidVersion    = errorsmod.Register(ModuleName, 2, "invalid async interchain queries version")
+	ErrInvalidPacketData = errorsmod.Register(ModuleName, 3, "invalid packet data")
+	ErrInvalidCodec      = errorsmod.Register(ModuleName, 4, "codec is not supported")
+	ErrInvalidQuery      = errorsmod.Register(ModuleName, 5, "invalid query request")
+	ErrQueryNotAllowed   = errorsmod.Register(ModuleName, 6, "query is not allowed")
+	ErrHostDisabled      = errorsmod.Register(ModuleName, 7, "host is disabled")
+	ErrInvalidParams     = errorsmod.Register(ModuleName, 8, "invalid params")
+)
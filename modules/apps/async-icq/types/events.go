@@ -0,0 +1,13 @@
+package types
+
+// async interchain queries events
+const (
+	EventTypePacket  = "icq_packet"
+	EventTypeTimeout = "icq_timeout"
+
+	AttributeKeySender     = "sender"
+	AttributeKeyQueries    = "queries"
+	AttributeKeyMemo       = "memo"
+	AttributeKeyAckSuccess = "success"
+	AttributeKeyAckError   = "error"
+)
@@ -0,0 +1,12 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+)
+
+// QueryRouter expected interface used to route the queries executed on behalf of counterparty chains
+type QueryRouter interface {
+	// Route returns the GRPCQueryHandler for a given query route path or nil
+	// if not found
+	Route(path string) baseapp.GRPCQueryHandler
+}
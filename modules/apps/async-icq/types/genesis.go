@@ -0,0 +1,28 @@
+package types
+
+import (
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+)
+
+// NewGenesisState creates a new async interchain queries GenesisState instance.
+func NewGenesisState(portID string, params Params) *GenesisState {
+	return &GenesisState{
+		PortId: portID,
+		Params: params,
+	}
+}
+
+// DefaultGenesisState returns a GenesisState with the default port ID and params.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(PortID, DefaultParams())
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := host.PortIdentifierValidator(gs.PortId); err != nil {
+		return err
+	}
+
+	return gs.Params.Validate()
+}
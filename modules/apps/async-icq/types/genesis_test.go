@@ -0,0 +1,50 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/async-icq/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expError error
+	}{
+		{
+			"success: default genesis",
+			types.DefaultGenesisState(),
+			nil,
+		},
+		{
+			"success: valid genesis",
+			types.NewGenesisState(types.PortID, types.NewParams(false, []string{balanceQueryPath})),
+			nil,
+		},
+		{
+			"failure: invalid port ID",
+			types.NewGenesisState("", types.DefaultParams()),
+			host.ErrInvalidID,
+		},
+		{
+			"failure: invalid params",
+			types.NewGenesisState(types.PortID, types.NewParams(true, []string{"/cosmos.bank.v1beta1.Query/"})),
+			types.ErrInvalidParams,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
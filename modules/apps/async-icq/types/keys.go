@@ -0,0 +1,27 @@
+package types
+
+const (
+	// ModuleName defines the async interchain queries module name
+	ModuleName = "interchainquery"
+
+	// StoreKey is the store key string for the async interchain queries module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the async interchain queries module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the async interchain queries module
+	QuerierRoute = ModuleName
+
+	// PortID is the default port id that the async interchain queries module binds to
+	PortID = "icq"
+
+	// Version defines the current version of the async interchain queries application
+	Version = "icq-1"
+
+	// ParamsKey defines the key to store the params in store
+	ParamsKey = "params"
+)
+
+// PortKey defines the key to store the port ID in store
+var PortKey = []byte{0x01}
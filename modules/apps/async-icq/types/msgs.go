@@ -0,0 +1,80 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+)
+
+var (
+	_ sdk.Msg              = (*MsgSendQuery)(nil)
+	_ sdk.Msg              = (*MsgUpdateParams)(nil)
+	_ sdk.HasValidateBasic = (*MsgSendQuery)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
+)
+
+// NewMsgSendQuery creates a new MsgSendQuery instance
+func NewMsgSendQuery(signer, sourcePort, sourceChannel string, requests []QueryRequest, timeoutTimestamp uint64, memo string) *MsgSendQuery {
+	return &MsgSendQuery{
+		Signer:           signer,
+		SourcePort:       sourcePort,
+		SourceChannel:    sourceChannel,
+		Requests:         requests,
+		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             memo,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgSendQuery) ValidateBasic() error {
+	if err := validateSigner(msg.Signer); err != nil {
+		return err
+	}
+
+	if err := host.PortIdentifierValidator(msg.SourcePort); err != nil {
+		return errorsmod.Wrap(err, "invalid source port ID")
+	}
+
+	if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
+		return errorsmod.Wrap(err, "invalid source channel ID")
+	}
+
+	if msg.TimeoutTimestamp == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "timeout timestamp must be greater than zero")
+	}
+
+	return msg.PacketData().ValidateBasic()
+}
+
+// PacketData returns the packet data of the queries sent by the message.
+func (msg MsgSendQuery) PacketData() InterchainQueryPacketData {
+	return NewInterchainQueryPacketData(msg.Signer, msg.Requests, msg.Memo)
+}
+
+// NewMsgUpdateParams creates a new MsgUpdateParams instance
+func NewMsgUpdateParams(signer string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Signer: signer,
+		Params: params,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if err := validateSigner(msg.Signer); err != nil {
+		return err
+	}
+
+	return msg.Params.Validate()
+}
+
+func validateSigner(signer string) error {
+	if _, err := sdk.AccAddressFromBech32(signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/async-icq/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+func TestMsgSendQueryValidateBasic(t *testing.T) {
+	var msg *types.MsgSendQuery
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{"success", func() {}, nil},
+		{"failure: invalid signer", func() { msg.Signer = ibctesting.InvalidID }, ibcerrors.ErrInvalidAddress},
+		{"failure: invalid source port", func() { msg.SourcePort = "" }, host.ErrInvalidID},
+		{"failure: invalid source channel", func() { msg.SourceChannel = "" }, host.ErrInvalidID},
+		{"failure: zero timeout", func() { msg.TimeoutTimestamp = 0 }, ibcerrors.ErrInvalidRequest},
+		{"failure: no queries", func() { msg.Requests = nil }, types.ErrInvalidPacketData},
+		{"failure: invalid query", func() { msg.Requests[0].Path = "" }, types.ErrInvalidQuery},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg = types.NewMsgSendQuery(ibctesting.TestAccAddress, types.PortID, ibctesting.FirstChannelID, []types.QueryRequest{types.NewQueryRequest(balanceQueryPath, nil)}, 100, "")
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+func TestMsgUpdateParamsValidateBasic(t *testing.T) {
+	var msg *types.MsgUpdateParams
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{"success", func() {}, nil},
+		{"failure: invalid signer", func() { msg.Signer = ibctesting.InvalidID }, ibcerrors.ErrInvalidAddress},
+		{"failure: invalid allowed query", func() { msg.Params.AllowQueries = []string{"invalid"} }, types.ErrInvalidParams},
+		{"failure: duplicate allowed query", func() {
+			msg.Params.AllowQueries = []string{balanceQueryPath, balanceQueryPath}
+		}, types.ErrInvalidParams},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg = types.NewMsgUpdateParams(ibctesting.TestAccAddress, types.NewParams(true, []string{balanceQueryPath}))
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+const (
+	// EncodingJSON is the JSON encoding format of the packet data.
+	EncodingJSON = "application/json"
+	// EncodingProto is the protobuf encoding format of the packet data.
+	EncodingProto = "application/x-protobuf"
+
+	// MaxQueryRequests is the maximum number of queries which may be sent in a single packet
+	MaxQueryRequests = 64
+
+	// MaximumMemoLength is the maximum length of the memo in bytes (value chosen arbitrarily)
+	MaximumMemoLength = 32768
+)
+
+var (
+	_ ibcexported.PacketData         = (*InterchainQueryPacketData)(nil)
+	_ ibcexported.PacketDataProvider = (*InterchainQueryPacketData)(nil)
+)
+
+// NewQueryRequest creates a new QueryRequest instance
+func NewQueryRequest(path string, data []byte) QueryRequest {
+	return QueryRequest{
+		Path: path,
+		Data: data,
+	}
+}
+
+// ValidateBasic performs basic validation of the query request.
+func (q QueryRequest) ValidateBasic() error {
+	return validateQueryPath(q.Path)
+}
+
+// NewInterchainQueryPacketData creates a new InterchainQueryPacketData instance
+func NewInterchainQueryPacketData(sender string, requests []QueryRequest, memo string) InterchainQueryPacketData {
+	return InterchainQueryPacketData{
+		Sender:   sender,
+		Requests: requests,
+		Memo:     memo,
+	}
+}
+
+// ValidateBasic performs basic validation of the packet data.
+func (p InterchainQueryPacketData) ValidateBasic() error {
+	if strings.TrimSpace(p.Sender) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "sender address cannot be blank")
+	}
+
+	if len(p.Requests) == 0 {
+		return errorsmod.Wrap(ErrInvalidPacketData, "packet data must contain at least one query")
+	}
+
+	if len(p.Requests) > MaxQueryRequests {
+		return errorsmod.Wrapf(ErrInvalidPacketData, "packet data must not contain more than %d queries, got %d", MaxQueryRequests, len(p.Requests))
+	}
+
+	for i, request := range p.Requests {
+		if err := request.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "invalid query %d", i)
+		}
+	}
+
+	if len(p.Memo) > MaximumMemoLength {
+		return errorsmod.Wrapf(ErrInvalidPacketData, "memo must not exceed %d bytes", MaximumMemoLength)
+	}
+
+	return nil
+}
+
+// GetBytes returns the JSON marshalled packet data.
+func (p InterchainQueryPacketData) GetBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&p)
+}
+
+// GetPacketSender returns the sender address of the queries.
+// NOTE: The sender address is set at the source chain and not validated by
+// a signature check in IBC.
+func (p InterchainQueryPacketData) GetPacketSender(_ string) string {
+	return p.Sender
+}
+
+// GetCustomPacketData interprets the memo field of the packet data as a JSON object
+// and returns the value associated with the given key.
+// If the key is missing or the memo is not properly formatted, then nil is returned.
+func (p InterchainQueryPacketData) GetCustomPacketData(key string) any {
+	if len(p.Memo) == 0 {
+		return nil
+	}
+
+	jsonObject := make(map[string]any)
+	err := json.Unmarshal([]byte(p.Memo), &jsonObject)
+	if err != nil {
+		return nil
+	}
+
+	memoData, found := jsonObject[key]
+	if !found {
+		return nil
+	}
+
+	return memoData
+}
+
+// UnmarshalPacketData attempts to unmarshal the provided packet data bytes into an
+// InterchainQueryPacketData. IBC v1 packets are always JSON encoded and are unmarshalled
+// with an empty encoding.
+func UnmarshalPacketData(bz []byte, version, encoding string) (InterchainQueryPacketData, error) {
+	if version != Version {
+		return InterchainQueryPacketData{}, errorsmod.Wrapf(ErrInvalidVersion, "expected %s, got %s", Version, version)
+	}
+
+	var data InterchainQueryPacketData
+	switch encoding {
+	case "", EncodingJSON:
+		if err := ModuleCdc.UnmarshalJSON(bz, &data); err != nil {
+			return InterchainQueryPacketData{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal interchain query packet data with json: %v", err)
+		}
+	case EncodingProto:
+		if err := ModuleCdc.Unmarshal(bz, &data); err != nil {
+			return InterchainQueryPacketData{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal interchain query packet data with protobuf: %v", err)
+		}
+	default:
+		return InterchainQueryPacketData{}, errorsmod.Wrapf(ErrInvalidCodec, "unsupported payload encoding format %s", encoding)
+	}
+
+	if err := data.ValidateBasic(); err != nil {
+		return InterchainQueryPacketData{}, err
+	}
+
+	return data, nil
+}
+
+// NewInterchainQueryPacketAck creates a new InterchainQueryPacketAck instance
+func NewInterchainQueryPacketAck(responses [][]byte, height uint64) InterchainQueryPacketAck {
+	return InterchainQueryPacketAck{
+		Responses: responses,
+		Height:    height,
+	}
+}
+
+// GetBytes returns the protobuf marshalled acknowledgement result. It is carried in the
+// result of a successful acknowledgement.
+func (a InterchainQueryPacketAck) GetBytes() []byte {
+	return ModuleCdc.MustMarshal(&a)
+}
+
+// UnmarshalPacketAck unmarshals the result of a successful acknowledgement into an InterchainQueryPacketAck.
+func UnmarshalPacketAck(bz []byte) (InterchainQueryPacketAck, error) {
+	var ack InterchainQueryPacketAck
+	if err := ModuleCdc.Unmarshal(bz, &ack); err != nil {
+		return InterchainQueryPacketAck{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal interchain query packet acknowledgement: %v", err)
+	}
+
+	return ack, nil
+}
@@ -0,0 +1,142 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/async-icq/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+const balanceQueryPath = "/cosmos.bank.v1beta1.Query/Balance"
+
+func TestPacketDataValidateBasic(t *testing.T) {
+	var data types.InterchainQueryPacketData
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{"success", func() {}, nil},
+		{"success: max queries", func() {
+			data.Requests = make([]types.QueryRequest, types.MaxQueryRequests)
+			for i := range data.Requests {
+				data.Requests[i] = types.NewQueryRequest(balanceQueryPath, nil)
+			}
+		}, nil},
+		{"failure: empty sender", func() { data.Sender = " " }, ibcerrors.ErrInvalidAddress},
+		{"failure: no queries", func() { data.Requests = nil }, types.ErrInvalidPacketData},
+		{"failure: too many queries", func() {
+			data.Requests = make([]types.QueryRequest, types.MaxQueryRequests+1)
+			for i := range data.Requests {
+				data.Requests[i] = types.NewQueryRequest(balanceQueryPath, nil)
+			}
+		}, types.ErrInvalidPacketData},
+		{"failure: empty query path", func() { data.Requests[0].Path = "" }, types.ErrInvalidQuery},
+		{"failure: query path without method", func() { data.Requests[0].Path = "/cosmos.bank.v1beta1.Query" }, types.ErrInvalidQuery},
+		{"failure: query path without leading slash", func() { data.Requests[0].Path = "cosmos.bank.v1beta1.Query/Balance" }, types.ErrInvalidQuery},
+		{"failure: memo too long", func() { data.Memo = strings.Repeat("a", types.MaximumMemoLength+1) }, types.ErrInvalidPacketData},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data = types.NewInterchainQueryPacketData(ibctesting.TestAccAddress, []types.QueryRequest{types.NewQueryRequest(balanceQueryPath, []byte("request"))}, "")
+
+			tc.malleate()
+
+			err := data.ValidateBasic()
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+func TestUnmarshalPacketData(t *testing.T) {
+	var (
+		bz       []byte
+		version  string
+		encoding string
+	)
+
+	data := types.NewInterchainQueryPacketData(ibctesting.TestAccAddress, []types.QueryRequest{types.NewQueryRequest(balanceQueryPath, []byte("request"))}, "memo")
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{"success: v1 packet", func() {}, nil},
+		{"success: json encoding", func() { encoding = types.EncodingJSON }, nil},
+		{"success: protobuf encoding", func() {
+			bz = types.ModuleCdc.MustMarshal(&data)
+			encoding = types.EncodingProto
+		}, nil},
+		{"failure: invalid version", func() { version = "icq-2" }, types.ErrInvalidVersion},
+		{"failure: unsupported encoding", func() { encoding = "application/x-solidity-abi" }, types.ErrInvalidCodec},
+		{"failure: invalid json", func() { bz = []byte("invalid") }, ibcerrors.ErrInvalidType},
+		{"failure: invalid protobuf", func() {
+			bz = []byte("invalid")
+			encoding = types.EncodingProto
+		}, ibcerrors.ErrInvalidType},
+		{"failure: invalid packet data", func() {
+			invalidData := types.NewInterchainQueryPacketData(ibctesting.TestAccAddress, nil, "")
+			bz = invalidData.GetBytes()
+		}, types.ErrInvalidPacketData},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bz = data.GetBytes()
+			version = types.Version
+			encoding = ""
+
+			tc.malleate()
+
+			packetData, err := types.UnmarshalPacketData(bz, version, encoding)
+			if tc.expError == nil {
+				require.NoError(t, err)
+				require.Equal(t, data, packetData)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+func TestGetCustomPacketData(t *testing.T) {
+	testCases := []struct {
+		name    string
+		memo    string
+		expData any
+	}{
+		{"success: key found", `{"src_callback": {"address": "contract"}}`, map[string]any{"address": "contract"}},
+		{"success: key not found", `{"dest_callback": {"address": "contract"}}`, nil},
+		{"success: empty memo", "", nil},
+		{"success: malformed memo", `{"src_callback": {"address": "contract"}, malformed}`, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data := types.NewInterchainQueryPacketData(ibctesting.TestAccAddress, []types.QueryRequest{types.NewQueryRequest(balanceQueryPath, nil)}, tc.memo)
+			require.Equal(t, tc.expData, data.GetCustomPacketData("src_callback"))
+		})
+	}
+}
+
+func TestPacketAck(t *testing.T) {
+	ack := types.NewInterchainQueryPacketAck([][]byte{[]byte("response")}, 10)
+
+	unmarshalledAck, err := types.UnmarshalPacketAck(ack.GetBytes())
+	require.NoError(t, err)
+	require.Equal(t, ack, unmarshalledAck)
+
+	_, err = types.UnmarshalPacketAck([]byte("invalid"))
+	require.ErrorIs(t, err, ibcerrors.ErrInvalidType)
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// NewParams creates a new parameter configuration for the async interchain queries module
+func NewParams(enableHost bool, allowQueries []string) Params {
+	return Params{
+		HostEnabled:  enableHost,
+		AllowQueries: allowQueries,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the async interchain queries module.
+// The host is enabled, but no queries are allowed until they are added through governance.
+func DefaultParams() Params {
+	return NewParams(true, nil)
+}
+
+// Validate validates all async interchain queries module parameters
+func (p Params) Validate() error {
+	seen := make(map[string]bool)
+	for _, path := range p.AllowQueries {
+		if err := validateQueryPath(path); err != nil {
+			return errorsmod.Wrapf(ErrInvalidParams, "invalid allowed query: %v", err)
+		}
+
+		if seen[path] {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate allowed query %s", path)
+		}
+		seen[path] = true
+	}
+
+	return nil
+}
+
+// validateQueryPath ensures the query path has the form of a fully qualified gRPC method name,
+// e.g. /cosmos.bank.v1beta1.Query/Balance.
+func validateQueryPath(path string) error {
+	if strings.TrimSpace(path) != path || !strings.HasPrefix(path, "/") {
+		return errorsmod.Wrapf(ErrInvalidQuery, "query path %q must be a fully qualified gRPC method name", path)
+	}
+
+	parts := strings.Split(path[1:], "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return errorsmod.Wrapf(ErrInvalidQuery, "query path %q must be a fully qualified gRPC method name", path)
+	}
+
+	return nil
+}
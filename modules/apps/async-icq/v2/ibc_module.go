@@ -0,0 +1,129 @@
+package v2
+
+import (
+	"bytes"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/async-icq/keeper"
+	"github.com/cosmos/ibc-go/v10/modules/apps/async-icq/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/api"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+)
+
+var (
+	_ api.IBCModule             = (*IBCModule)(nil)
+	_ api.PacketDataUnmarshaler = (*IBCModule)(nil)
+)
+
+// IBCModule implements the IBC v2 callbacks of the async interchain queries application.
+type IBCModule struct {
+	keeper keeper.Keeper
+}
+
+// NewIBCModule creates a new IBCModule given the keeper
+func NewIBCModule(k keeper.Keeper) *IBCModule {
+	return &IBCModule{
+		keeper: k,
+	}
+}
+
+// OnSendPacket implements the IBCModule interface. The queries may only be sent by their sender.
+func (*IBCModule) OnSendPacket(_ sdk.Context, _ string, _ string, _ uint64, payload channeltypesv2.Payload, signer sdk.AccAddress) error {
+	if err := validatePorts(payload); err != nil {
+		return err
+	}
+
+	data, err := types.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return err
+	}
+
+	if data.Sender != signer.String() {
+		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "sender %s is different from signer %s", data.Sender, signer)
+	}
+
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface. The queries carried by the payload are executed
+// and their responses returned in the acknowledgement.
+func (im *IBCModule) OnRecvPacket(ctx sdk.Context, sourceClient string, _ string, sequence uint64, payload channeltypesv2.Payload, _ sdk.AccAddress) channeltypesv2.RecvPacketResult {
+	if err := validatePorts(payload); err != nil {
+		im.keeper.Logger(ctx).Error(fmt.Sprintf("%s source client %s sequence %d", err.Error(), sourceClient, sequence))
+		return channeltypesv2.RecvPacketResult{
+			Status: channeltypesv2.PacketStatus_Failure,
+		}
+	}
+
+	data, err := types.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		im.keeper.Logger(ctx).Error(fmt.Sprintf("%s source client %s sequence %d", err.Error(), sourceClient, sequence))
+		return channeltypesv2.RecvPacketResult{
+			Status: channeltypesv2.PacketStatus_Failure,
+		}
+	}
+
+	result, err := im.keeper.OnRecvPacket(ctx, data)
+	keeper.EmitOnRecvPacketEvent(ctx, data, err)
+	if err != nil {
+		im.keeper.Logger(ctx).Error(fmt.Sprintf("%s source client %s sequence %d", err.Error(), sourceClient, sequence))
+		return channeltypesv2.RecvPacketResult{
+			Status: channeltypesv2.PacketStatus_Failure,
+		}
+	}
+
+	im.keeper.Logger(ctx).Info("successfully handled interchain queries packet", "source-client", sourceClient, "sequence", sequence)
+
+	return channeltypesv2.RecvPacketResult{
+		Status:          channeltypesv2.PacketStatus_Success,
+		Acknowledgement: channeltypes.NewResultAcknowledgement(result).Acknowledgement(),
+	}
+}
+
+// OnTimeoutPacket implements the IBCModule interface
+func (*IBCModule) OnTimeoutPacket(ctx sdk.Context, _ string, _ string, _ uint64, payload channeltypesv2.Payload, _ sdk.AccAddress) error {
+	data, err := types.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return err
+	}
+
+	keeper.EmitOnTimeoutPacketEvent(ctx, data)
+
+	return nil
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. The query responses are
+// not interpreted by the module, they are surfaced to the sender through the callbacks middleware.
+func (*IBCModule) OnAcknowledgementPacket(ctx sdk.Context, _ string, _ string, _ uint64, acknowledgement []byte, payload channeltypesv2.Payload, _ sdk.AccAddress) error {
+	data, err := types.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return err
+	}
+
+	success := !bytes.Equal(acknowledgement, channeltypesv2.ErrorAcknowledgement[:])
+	keeper.EmitOnAcknowledgementPacketEvent(ctx, data, success)
+
+	return nil
+}
+
+// UnmarshalPacketData unmarshals the async interchain queries packet data based on the version and encoding
+// it implements the PacketDataUnmarshaler interface
+func (*IBCModule) UnmarshalPacketData(payload channeltypesv2.Payload) (any, error) {
+	return types.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+}
+
+// validatePorts ensures the payload is sent from and to the async interchain queries port.
+func validatePorts(payload channeltypesv2.Payload) error {
+	if payload.SourcePort != types.PortID || payload.DestinationPort != types.PortID {
+		return errorsmod.Wrapf(porttypes.ErrInvalidPort, "expected source and destination port %s, got %s and %s", types.PortID, payload.SourcePort, payload.DestinationPort)
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package v2_test
+
+import (
+	"testing"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/async-icq/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+const balanceQueryPath = "/cosmos.bank.v1beta1.Query/Balance"
+
+type ICQTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+func (suite *ICQTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.SetupV2()
+
+	suite.chainB.GetSimApp().ICQKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, []string{balanceQueryPath}))
+}
+
+func TestICQTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(ICQTestSuite))
+}
+
+// payload returns an async interchain queries payload querying the balance of the chainB sender account,
+// sent by the chainA sender account.
+func (suite *ICQTestSuite) payload(encoding string) channeltypesv2.Payload {
+	request := suite.chainB.GetSimApp().AppCodec().MustMarshal(&banktypes.QueryBalanceRequest{
+		Address: suite.chainB.SenderAccount.GetAddress().String(),
+		Denom:   sdk.DefaultBondDenom,
+	})
+	data := types.NewInterchainQueryPacketData(suite.chainA.SenderAccount.GetAddress().String(), []types.QueryRequest{types.NewQueryRequest(balanceQueryPath, request)}, "")
+
+	var bz []byte
+	if encoding == types.EncodingJSON {
+		bz = types.ModuleCdc.MustMarshalJSON(&data)
+	} else {
+		bz = types.ModuleCdc.MustMarshal(&data)
+	}
+
+	return channeltypesv2.NewPayload(types.PortID, types.PortID, types.Version, encoding, bz)
+}
+
+func (suite *ICQTestSuite) TestOnSendPacket() {
+	var (
+		payload channeltypesv2.Payload
+		signer  sdk.AccAddress
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{"success", func() {}, nil},
+		{"success: json encoding", func() { payload = suite.payload(types.EncodingJSON) }, nil},
+		{"failure: invalid source port", func() { payload.SourcePort = ibctesting.MockPort }, porttypes.ErrInvalidPort},
+		{"failure: invalid version", func() { payload.Version = "icq-2" }, types.ErrInvalidVersion},
+		{"failure: signer is not the sender", func() { signer = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress() }, ibcerrors.ErrUnauthorized},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			payload = suite.payload(types.EncodingProto)
+			signer = suite.chainA.SenderAccount.GetAddress()
+
+			tc.malleate()
+
+			cbs := suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.Router.Route(types.PortID)
+			err := cbs.OnSendPacket(suite.chainA.GetContext(), suite.path.EndpointA.ClientID, suite.path.EndpointB.ClientID, 1, payload, signer)
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *ICQTestSuite) TestOnRecvPacket() {
+	var payload channeltypesv2.Payload
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"success: json encoding", func() { payload = suite.payload(types.EncodingJSON) }, true},
+		{"failure: invalid destination port", func() { payload.DestinationPort = ibctesting.MockPort }, false},
+		{"failure: invalid packet data", func() { payload.Value = []byte("invalid") }, false},
+		{"failure: host disabled", func() {
+			suite.chainB.GetSimApp().ICQKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{balanceQueryPath}))
+		}, false},
+		{"failure: query not allowed", func() {
+			suite.chainB.GetSimApp().ICQKeeper.SetParams(suite.chainB.GetContext(), types.DefaultParams())
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			payload = suite.payload(types.EncodingProto)
+
+			tc.malleate()
+
+			ctx := suite.chainB.GetContext()
+			cbs := suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.Router.Route(types.PortID)
+			res := cbs.OnRecvPacket(ctx, suite.path.EndpointB.Counterparty.ClientID, suite.path.EndpointB.ClientID, 1, payload, suite.chainB.SenderAccount.GetAddress())
+
+			if tc.expPass {
+				suite.Require().Equal(channeltypesv2.PacketStatus_Success, res.Status)
+
+				var ack channeltypes.Acknowledgement
+				err := types.ModuleCdc.UnmarshalJSON(res.Acknowledgement, &ack)
+				suite.Require().NoError(err)
+
+				icqAck, err := types.UnmarshalPacketAck(ack.GetResult())
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(ctx.BlockHeight()), icqAck.Height)
+				suite.Require().Len(icqAck.Responses, 1)
+
+				var balanceResponse banktypes.QueryBalanceResponse
+				err = suite.chainB.GetSimApp().AppCodec().Unmarshal(icqAck.Responses[0], &balanceResponse)
+				suite.Require().NoError(err)
+				suite.Require().Equal(suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, suite.chainB.SenderAccount.GetAddress(), sdk.DefaultBondDenom), *balanceResponse.Balance)
+			} else {
+				suite.Require().Equal(channeltypesv2.PacketStatus_Failure, res.Status)
+			}
+		})
+	}
+}
+
+func (suite *ICQTestSuite) TestInterchainQueryV2() {
+	packet, err := suite.path.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), suite.payload(types.EncodingProto))
+	suite.Require().NoError(err)
+
+	err = suite.path.EndpointB.MsgRecvPacket(packet)
+	suite.Require().NoError(err)
+
+	commitment := suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.DestinationClient, packet.Sequence)
+	suite.Require().NotEmpty(commitment)
+	suite.Require().NotEqual(channeltypesv2.CommitAcknowledgement(channeltypesv2.NewErrorAcknowledgement()), commitment)
+}
@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the query commands for the ibc-callbacks middleware
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        "ibc-callbacks",
+		Short:                      "IBC callbacks query subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdQueryFailedCallbacks(),
+		GetCmdQueryFailedCallback(),
+	)
+
+	return queryCmd
+}
+
+// NewTxCmd returns the transaction commands for the ibc-callbacks middleware
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        "ibc-callbacks",
+		Short:                      "IBC callbacks transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	txCmd.AddCommand(
+		NewRetryCallbackCmd(),
+	)
+
+	return txCmd
+}
@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
+)
+
+// GetCmdQueryFailedCallbacks defines the command to query the pending failed callbacks of a contract.
+func GetCmdQueryFailedCallbacks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "failed-callbacks [contract-address]",
+		Short:   "Query the pending failed callbacks of a contract",
+		Long:    "Query the pending failed callbacks of a contract which can be retried",
+		Example: fmt.Sprintf("%s query ibc-callbacks failed-callbacks cosmos1abc...", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryFailedCallbacksRequest{
+				ContractAddress: args[0],
+				Pagination:      pageReq,
+			}
+
+			res, err := queryClient.FailedCallbacks(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "failed callbacks")
+	return cmd
+}
+
+// GetCmdQueryFailedCallback defines the command to query the failed callback of a contract for a packet.
+func GetCmdQueryFailedCallback() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "failed-callback [contract-address] [port-id] [channel-or-client-id] [sequence]",
+		Short:   "Query the failed callback of a contract for a packet",
+		Long:    "Query the failed callback of a contract for the packet with the given source port, source channel (IBC v1) or client (IBC v2) and sequence",
+		Example: fmt.Sprintf("%s query ibc-callbacks failed-callback cosmos1abc... transfer channel-0 1", version.AppName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			sequence, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryFailedCallbackRequest{
+				ContractAddress: args[0],
+				PortId:          args[1],
+				ChannelId:       args[2],
+				Sequence:        sequence,
+			}
+
+			res, err := queryClient.FailedCallback(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
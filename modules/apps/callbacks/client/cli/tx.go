@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
+)
+
+// NewRetryCallbackCmd returns the command to create a MsgRetryCallback
+func NewRetryCallbackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "retry-callback [contract-address] [port-id] [channel-or-client-id] [sequence]",
+		Short:   "Retry a failed callback",
+		Long:    strings.TrimSpace(`Retry the failed acknowledgement or timeout callback of a contract for a packet. The callback is executed with the gas remaining in the transaction, so the transaction gas should be set high enough for the callback to succeed.`),
+		Example: fmt.Sprintf("%s tx ibc-callbacks retry-callback cosmos1abc... transfer channel-0 1 --gas 2000000", version.AppName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sequence, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRetryCallback(clientCtx.GetFromAddress().String(), args[0], args[1], args[2], sequence)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/internal"
+	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/keeper"
 	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
@@ -26,6 +27,9 @@ type IBCMiddleware struct {
 	ics4Wrapper porttypes.ICS4Wrapper
 
 	contractKeeper types.ContractKeeper
+	// keeper is optional, if set the source callbacks whose execution failed are stored in it so that
+	// they can be retried
+	keeper *keeper.Keeper
 
 	// maxCallbackGas defines the maximum amount of gas that a callback actor can ask the
 	// relayer to pay for. If a callback fails due to insufficient gas, the entire tx
@@ -65,6 +69,18 @@ func NewIBCMiddleware(
 	}
 }
 
+// NewIBCMiddlewareWithFailedCallbacks creates a new IBCMiddleware given the ibc-callbacks keeper and underlying
+// application. Callbacks are executed on the contract keeper of the ibc-callbacks keeper, which stores the source
+// callbacks whose execution failed so that they can be retried.
+func NewIBCMiddlewareWithFailedCallbacks(
+	app porttypes.IBCModule, ics4Wrapper porttypes.ICS4Wrapper,
+	k keeper.Keeper, maxCallbackGas uint64,
+) IBCMiddleware {
+	im := NewIBCMiddleware(app, ics4Wrapper, k.GetContractKeeper(), maxCallbackGas)
+	im.keeper = &k
+	return im
+}
+
 // WithICS4Wrapper sets the ICS4Wrapper. This function may be used after the
 // middleware's creation to set the middleware which is above this module in
 // the IBC application stack.
@@ -136,7 +152,7 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 	relayer sdk.AccAddress,
 ) error {
 	// we first call the underlying app to handle the acknowledgement
-	err := im.app.OnAcknowledgementPacket(ctx, channelVersion, packet, acknowledgement, relayer)
+	err := im.app.OnAcknowledgementPacket(ctx, channelVersion, packet, im.getAppAcknowledgement(ctx, packet, acknowledgement), relayer)
 	if err != nil {
 		return err
 	}
@@ -161,12 +177,16 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 		)
 	}
 
-	// callback execution errors are not allowed to block the packet lifecycle, they are only used in event emissions
+	// callback execution errors are not allowed to block the packet lifecycle, they are used in event emissions
+	// and the failed callback is stored so that it can be retried if the ibc-callbacks keeper is set
 	err = internal.ProcessCallback(ctx, types.CallbackTypeAcknowledgementPacket, callbackData, callbackExecutor)
 	types.EmitCallbackEvent(
 		ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(),
 		types.CallbackTypeAcknowledgementPacket, callbackData, err,
 	)
+	if err != nil && im.keeper != nil {
+		im.keeper.RecordFailedCallback(ctx, types.CallbackTypeAcknowledgementPacket, packet, acknowledgement, relayer, callbackData, err)
+	}
 
 	return nil
 }
@@ -199,12 +219,16 @@ func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, channelVersion string,
 		return im.contractKeeper.IBCOnTimeoutPacketCallback(cachedCtx, packet, relayer, callbackData.CallbackAddress, callbackData.SenderAddress, callbackData.ApplicationVersion)
 	}
 
-	// callback execution errors are not allowed to block the packet lifecycle, they are only used in event emissions
+	// callback execution errors are not allowed to block the packet lifecycle, they are used in event emissions
+	// and the failed callback is stored so that it can be retried if the ibc-callbacks keeper is set
 	err = internal.ProcessCallback(ctx, types.CallbackTypeTimeoutPacket, callbackData, callbackExecutor)
 	types.EmitCallbackEvent(
 		ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(),
 		types.CallbackTypeTimeoutPacket, callbackData, err,
 	)
+	if err != nil && im.keeper != nil {
+		im.keeper.RecordFailedCallback(ctx, types.CallbackTypeTimeoutPacket, packet, nil, relayer, callbackData, err)
+	}
 
 	return nil
 }
@@ -236,8 +260,11 @@ func (im IBCMiddleware) OnRecvPacket(ctx sdk.Context, channelVersion string, pac
 		return channeltypes.NewErrorAcknowledgement(err)
 	}
 
+	var callbackResult []byte
 	callbackExecutor := func(cachedCtx sdk.Context) error {
-		return im.contractKeeper.IBCReceivePacketCallback(cachedCtx, packet, ack, callbackData.CallbackAddress, callbackData.ApplicationVersion)
+		var err error
+		callbackResult, err = internal.ReceivePacketCallback(cachedCtx, im.contractKeeper, packet, ack, callbackData)
+		return err
 	}
 
 	// callback execution errors in RecvPacket are allowed to write an error acknowledgement
@@ -253,6 +280,11 @@ func (im IBCMiddleware) OnRecvPacket(ctx sdk.Context, channelVersion string, pac
 		return channeltypes.NewErrorAcknowledgement(err)
 	}
 
+	// the callback result is returned to the source together with the acknowledgement of the underlying app
+	if callbackData.ReturnResult {
+		return types.NewCallbackResultAcknowledgement(ack.Acknowledgement(), callbackResult)
+	}
+
 	return ack
 }
 
@@ -266,11 +298,6 @@ func (im IBCMiddleware) WriteAcknowledgement(
 	packet ibcexported.PacketI,
 	ack ibcexported.Acknowledgement,
 ) error {
-	err := im.ics4Wrapper.WriteAcknowledgement(ctx, packet, ack)
-	if err != nil {
-		return err
-	}
-
 	chanPacket, ok := packet.(channeltypes.Packet)
 	if !ok {
 		panic(fmt.Errorf("expected type %T, got %T", &channeltypes.Packet{}, packet))
@@ -279,6 +306,14 @@ func (im IBCMiddleware) WriteAcknowledgement(
 	callbackData, isCbPacket, err := types.GetDestCallbackData(
 		ctx, im.app, chanPacket, im.maxCallbackGas,
 	)
+	// the callback must be executed before the acknowledgement is written if its result is returned to the source
+	returnResult := isCbPacket && err == nil && callbackData.ReturnResult && ack.Success()
+	if !returnResult {
+		if err := im.ics4Wrapper.WriteAcknowledgement(ctx, packet, ack); err != nil {
+			return err
+		}
+	}
+
 	// WriteAcknowledgement is not blocked if the packet does not opt-in to callbacks
 	if !isCbPacket {
 		return nil
@@ -288,8 +323,11 @@ func (im IBCMiddleware) WriteAcknowledgement(
 		return err
 	}
 
+	var callbackResult []byte
 	callbackExecutor := func(cachedCtx sdk.Context) error {
-		return im.contractKeeper.IBCReceivePacketCallback(cachedCtx, packet, ack, callbackData.CallbackAddress, callbackData.ApplicationVersion)
+		var err error
+		callbackResult, err = internal.ReceivePacketCallback(cachedCtx, im.contractKeeper, packet, ack, callbackData)
+		return err
 	}
 
 	// callback execution errors are not allowed to block the packet lifecycle, they are only used in event emissions
@@ -299,9 +337,34 @@ func (im IBCMiddleware) WriteAcknowledgement(
 		types.CallbackTypeReceivePacket, callbackData, err,
 	)
 
+	if returnResult {
+		// the acknowledgement of the underlying app is written without a callback result if the callback failed
+		if err == nil {
+			ack = types.NewCallbackResultAcknowledgement(ack.Acknowledgement(), callbackResult)
+		}
+		return im.ics4Wrapper.WriteAcknowledgement(ctx, packet, ack)
+	}
+
 	return nil
 }
 
+// getAppAcknowledgement returns the acknowledgement written by the underlying application. If the packet requests the
+// result of its destination callback, the acknowledgement wrapped in the CallbackResultAcknowledgement is returned.
+// Otherwise, for example for error acknowledgements, the acknowledgement is returned unchanged.
+func (im IBCMiddleware) getAppAcknowledgement(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) []byte {
+	resultAck, err := types.UnmarshalCallbackResultAcknowledgement(acknowledgement)
+	if err != nil {
+		return acknowledgement
+	}
+
+	packetData, _, err := im.app.UnmarshalPacketData(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetData())
+	if err != nil || !types.ReturnsCallbackResult(packetData) {
+		return acknowledgement
+	}
+
+	return resultAck.AppAcknowledgement
+}
+
 // OnChanOpenInit defers to the underlying application
 func (im IBCMiddleware) OnChanOpenInit(
 	ctx sdk.Context,
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
@@ -13,6 +14,7 @@ import (
 	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
 	ibccallbacks "github.com/cosmos/ibc-go/v10/modules/apps/callbacks"
 	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/internal"
+	ibccallbackskeeper "github.com/cosmos/ibc-go/v10/modules/apps/callbacks/keeper"
 	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/testing/simapp"
 	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
@@ -27,6 +29,8 @@ import (
 )
 
 func (s *CallbacksTestSuite) TestNewIBCMiddleware() {
+	callbacksKeeper := ibccallbackskeeper.NewKeeper(nil, nil, simapp.ContractKeeper{}, time.Hour)
+
 	testCases := []struct {
 		name          string
 		instantiateFn func()
@@ -39,6 +43,13 @@ func (s *CallbacksTestSuite) TestNewIBCMiddleware() {
 			},
 			nil,
 		},
+		{
+			"success: with failed callbacks keeper",
+			func() {
+				_ = ibccallbacks.NewIBCMiddlewareWithFailedCallbacks(ibcmock.IBCModule{}, &channelkeeper.Keeper{}, callbacksKeeper, maxCallbackGas)
+			},
+			nil,
+		},
 		{
 			"panics with nil underlying app",
 			func() {
@@ -53,6 +64,13 @@ func (s *CallbacksTestSuite) TestNewIBCMiddleware() {
 			},
 			errors.New("contract keeper cannot be nil"),
 		},
+		{
+			"panics with failed callbacks keeper without contract keeper",
+			func() {
+				_ = ibccallbacks.NewIBCMiddlewareWithFailedCallbacks(ibcmock.IBCModule{}, &channelkeeper.Keeper{}, ibccallbackskeeper.Keeper{}, maxCallbackGas)
+			},
+			errors.New("contract keeper cannot be nil"),
+		},
 		{
 			"panics with nil ics4Wrapper",
 			func() {
@@ -370,17 +388,29 @@ func (s *CallbacksTestSuite) TestOnAcknowledgementPacket() {
 
 			sourceStatefulCounter := GetSimApp(s.chainA).MockContractKeeper.GetStateEntryCounter(s.chainA.GetContext())
 			sourceCounters := GetSimApp(s.chainA).MockContractKeeper.Counters
+			failedCallbacks := GetSimApp(s.chainA).CallbacksKeeper.GetAllFailedCallbacks(s.chainA.GetContext())
 
 			switch tc.expResult {
 			case noExecution:
 				s.Require().Len(sourceCounters, 0)
 				s.Require().Equal(uint8(0), sourceStatefulCounter)
+				s.Require().Empty(failedCallbacks)
 
 			case callbackFailed:
 				s.Require().Len(sourceCounters, 1)
 				s.Require().Equal(1, sourceCounters[types.CallbackTypeAcknowledgementPacket])
 				s.Require().Equal(uint8(0), sourceStatefulCounter)
 
+				// the failed callback is stored for retries unless the transaction is reverted by the out of gas panic
+				if tc.expError == panicError {
+					s.Require().Empty(failedCallbacks)
+				} else {
+					s.Require().Len(failedCallbacks, 1)
+					s.Require().Equal(string(types.CallbackTypeAcknowledgementPacket), failedCallbacks[0].CallbackType)
+					s.Require().Equal(packet, failedCallbacks[0].Packet)
+					s.Require().Equal(ack, failedCallbacks[0].Acknowledgement)
+				}
+
 			case callbackSuccess:
 				s.Require().Len(sourceCounters, 1)
 				s.Require().Equal(1, sourceCounters[types.CallbackTypeAcknowledgementPacket])
@@ -538,12 +568,14 @@ func (s *CallbacksTestSuite) TestOnTimeoutPacket() {
 
 			sourceStatefulCounter := GetSimApp(s.chainA).MockContractKeeper.GetStateEntryCounter(s.chainA.GetContext())
 			sourceCounters := GetSimApp(s.chainA).MockContractKeeper.Counters
+			failedCallbacks := GetSimApp(s.chainA).CallbacksKeeper.GetAllFailedCallbacks(s.chainA.GetContext())
 
 			// account for SendPacket succeeding
 			switch tc.expResult {
 			case noExecution:
 				s.Require().Len(sourceCounters, 1)
 				s.Require().Equal(uint8(1), sourceStatefulCounter)
+				s.Require().Empty(failedCallbacks)
 
 			case callbackFailed:
 				s.Require().Len(sourceCounters, 2)
@@ -551,6 +583,16 @@ func (s *CallbacksTestSuite) TestOnTimeoutPacket() {
 				s.Require().Equal(1, sourceCounters[types.CallbackTypeSendPacket])
 				s.Require().Equal(uint8(1), sourceStatefulCounter)
 
+				// the failed callback is stored for retries unless the transaction is reverted by the out of gas panic
+				if _, isPanic := tc.expValue.(storetypes.ErrorOutOfGas); isPanic {
+					s.Require().Empty(failedCallbacks)
+				} else {
+					s.Require().Len(failedCallbacks, 1)
+					s.Require().Equal(string(types.CallbackTypeTimeoutPacket), failedCallbacks[0].CallbackType)
+					s.Require().Equal(packet, failedCallbacks[0].Packet)
+					s.Require().Empty(failedCallbacks[0].Acknowledgement)
+				}
+
 			case callbackSuccess:
 				s.Require().Len(sourceCounters, 2)
 				s.Require().Equal(1, sourceCounters[types.CallbackTypeTimeoutPacket])
@@ -1010,9 +1052,11 @@ func (s *CallbacksTestSuite) TestUnmarshalPacketDataV1() {
 	}
 
 	expPacketDataICS20V2 := transfertypes.InternalTransferRepresentation{
-		Token: transfertypes.Token{
-			Denom:  transfertypes.NewDenom(ibctesting.TestCoin.Denom),
-			Amount: ibctesting.TestCoin.Amount.String(),
+		Tokens: transfertypes.Tokens{
+			{
+				Denom:  transfertypes.NewDenom(ibctesting.TestCoin.Denom),
+				Amount: ibctesting.TestCoin.Amount.String(),
+			},
 		},
 		Sender:   ibctesting.TestAccAddress,
 		Receiver: ibctesting.TestAccAddress,
@@ -0,0 +1,154 @@
+package ibccallbacks_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	icqtypes "github.com/cosmos/ibc-go/v10/modules/apps/async-icq/types"
+	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/testing/simapp"
+	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+const balanceQueryPath = "/cosmos.bank.v1beta1.Query/Balance"
+
+// SetupICQTest sets up an async interchain queries channel between chainA (controller) and chainB (host),
+// allowing balance queries to be executed on chainB.
+func (s *CallbacksTestSuite) SetupICQTest() {
+	s.setupChains()
+
+	s.path.EndpointA.ChannelConfig.PortID = icqtypes.PortID
+	s.path.EndpointB.ChannelConfig.PortID = icqtypes.PortID
+	s.path.EndpointA.ChannelConfig.Version = icqtypes.Version
+	s.path.EndpointB.ChannelConfig.Version = icqtypes.Version
+
+	s.path.Setup()
+
+	GetSimApp(s.chainB).ICQKeeper.SetParams(s.chainB.GetContext(), icqtypes.NewParams(true, []string{balanceQueryPath}))
+}
+
+func (s *CallbacksTestSuite) TestICQCallbacks() {
+	testCases := []struct {
+		name        string
+		queryPath   string
+		icqMemo     string
+		expCallback types.CallbackType
+		expSuccess  bool
+	}{
+		{
+			"success: query with no memo",
+			balanceQueryPath,
+			"",
+			"none",
+			true,
+		},
+		{
+			"success: source callback",
+			balanceQueryPath,
+			fmt.Sprintf(`{"src_callback": {"address": "%s"}}`, simapp.SuccessContract),
+			types.CallbackTypeAcknowledgementPacket,
+			true,
+		},
+		{
+			"success: source callback with error acknowledgement",
+			"/cosmos.bank.v1beta1.Query/AllBalances",
+			fmt.Sprintf(`{"src_callback": {"address": "%s"}}`, simapp.SuccessContract),
+			types.CallbackTypeAcknowledgementPacket,
+			true,
+		},
+		{
+			"failure: source callback with low gas (panic)",
+			balanceQueryPath,
+			fmt.Sprintf(`{"src_callback": {"address": "%s"}}`, simapp.OogPanicContract),
+			types.CallbackTypeSendPacket,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupICQTest()
+
+			s.ExecuteICQ(tc.queryPath, tc.icqMemo)
+			s.AssertHasExecutedExpectedCallback(tc.expCallback, tc.expSuccess)
+		})
+	}
+}
+
+func (s *CallbacksTestSuite) TestICQCallbackReceivesResponses() {
+	s.SetupICQTest()
+
+	var callbackAck []byte
+	GetSimApp(s.chainA).MockContractKeeper.IBCOnAcknowledgementPacketCallbackFn = func(
+		_ sdk.Context,
+		_ channeltypes.Packet,
+		ack []byte,
+		_ sdk.AccAddress,
+		_, _, _ string,
+	) error {
+		callbackAck = ack
+		return nil
+	}
+
+	s.ExecuteICQ(balanceQueryPath, fmt.Sprintf(`{"src_callback": {"address": "%s"}}`, simapp.SuccessContract))
+	s.Require().NotNil(callbackAck)
+
+	var ack channeltypes.Acknowledgement
+	err := icqtypes.ModuleCdc.UnmarshalJSON(callbackAck, &ack)
+	s.Require().NoError(err)
+	s.Require().True(ack.Success())
+
+	icqAck, err := icqtypes.UnmarshalPacketAck(ack.GetResult())
+	s.Require().NoError(err)
+	s.Require().Len(icqAck.Responses, 1)
+
+	var balanceResponse banktypes.QueryBalanceResponse
+	err = GetSimApp(s.chainA).AppCodec().Unmarshal(icqAck.Responses[0], &balanceResponse)
+	s.Require().NoError(err)
+
+	expBalance := GetSimApp(s.chainB).BankKeeper.GetBalance(s.chainB.GetContext(), s.chainB.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	s.Require().Equal(expBalance, *balanceResponse.Balance)
+}
+
+// ExecuteICQ sends a query of the balance of chainB's SenderAccount from chainA and relays the packet and its
+// acknowledgement. The query is executed with the provided query path.
+func (s *CallbacksTestSuite) ExecuteICQ(queryPath, memo string) {
+	request, err := GetSimApp(s.chainA).AppCodec().Marshal(&banktypes.QueryBalanceRequest{
+		Address: s.chainB.SenderAccount.GetAddress().String(),
+		Denom:   sdk.DefaultBondDenom,
+	})
+	s.Require().NoError(err)
+
+	timeoutTimestamp := uint64(s.chainB.GetContext().BlockTime().Add(ibctesting.TimeIncrement * 10).UnixNano())
+	msg := icqtypes.NewMsgSendQuery(
+		s.chainA.SenderAccount.GetAddress().String(),
+		s.path.EndpointA.ChannelConfig.PortID,
+		s.path.EndpointA.ChannelID,
+		[]icqtypes.QueryRequest{icqtypes.NewQueryRequest(queryPath, request)},
+		timeoutTimestamp, memo,
+	)
+
+	res, err := s.chainA.SendMsgs(msg)
+	if err != nil {
+		return // we return if send packet is rejected
+	}
+
+	// packet found, relay from A to B
+	err = s.path.EndpointB.UpdateClient()
+	s.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+
+	res, err = s.path.EndpointB.RecvPacketWithResult(packet)
+	s.Require().NoError(err)
+
+	acknowledgement, err := ibctesting.ParseAckFromEvents(res.Events)
+	s.Require().NoError(err)
+
+	err = s.path.EndpointA.AcknowledgePacket(packet, acknowledgement)
+	s.Require().NoError(err)
+}
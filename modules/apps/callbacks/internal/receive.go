@@ -0,0 +1,29 @@
+package internal
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+// ReceivePacketCallback executes the destination callback of a packet with the contract keeper. The result of the
+// callback is returned if the packet requests it, in which case the contract keeper must implement the
+// ReceiveResultContractKeeper interface.
+func ReceivePacketCallback(
+	cachedCtx sdk.Context, contractKeeper types.ContractKeeper,
+	packet ibcexported.PacketI, ack ibcexported.Acknowledgement, callbackData types.CallbackData,
+) ([]byte, error) {
+	if !callbackData.ReturnResult {
+		return nil, contractKeeper.IBCReceivePacketCallback(cachedCtx, packet, ack, callbackData.CallbackAddress, callbackData.ApplicationVersion)
+	}
+
+	resultContractKeeper, ok := contractKeeper.(types.ReceiveResultContractKeeper)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrCallbackResultNotSupported, "contract keeper %T does not implement %T", contractKeeper, (*types.ReceiveResultContractKeeper)(nil))
+	}
+
+	return resultContractKeeper.IBCReceivePacketCallbackWithResult(cachedCtx, packet, ack, callbackData.CallbackAddress, callbackData.ApplicationVersion)
+}
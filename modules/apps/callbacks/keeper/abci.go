@@ -0,0 +1,10 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker prunes the failed callbacks whose retry period has elapsed.
+func (k Keeper) BeginBlocker(ctx sdk.Context) {
+	k.PruneExpiredFailedCallbacks(ctx)
+}
@@ -0,0 +1,193 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/internal"
+	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
+
+// GetFailedCallback returns the failed callback of the given contract for the packet with the given
+// source port, source channel (or client) and sequence.
+func (k Keeper) GetFailedCallback(ctx sdk.Context, contractAddress, portID, channelID string, sequence uint64) (types.FailedCallback, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.FailedCallbackKey(contractAddress, portID, channelID, sequence))
+	if err != nil {
+		panic(err)
+	}
+
+	if len(bz) == 0 {
+		return types.FailedCallback{}, false
+	}
+
+	var failedCallback types.FailedCallback
+	k.cdc.MustUnmarshal(bz, &failedCallback)
+
+	return failedCallback, true
+}
+
+// SetFailedCallback stores the provided failed callback and indexes it by its expiry.
+// An existing failed callback stored under the same key is replaced.
+func (k Keeper) SetFailedCallback(ctx sdk.Context, failedCallback types.FailedCallback) {
+	existing, found := k.GetFailedCallback(ctx, failedCallback.ContractAddress, failedCallback.Packet.SourcePort, failedCallback.Packet.SourceChannel, failedCallback.Packet.Sequence)
+	if found {
+		k.DeleteFailedCallback(ctx, existing)
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&failedCallback)
+	if err := store.Set(failedCallback.Key(), bz); err != nil {
+		panic(err)
+	}
+
+	if err := store.Set(types.FailedCallbackExpiryKey(failedCallback.Expiry, failedCallback.Key()), []byte{1}); err != nil {
+		panic(err)
+	}
+}
+
+// DeleteFailedCallback removes the provided failed callback and its expiry index.
+func (k Keeper) DeleteFailedCallback(ctx sdk.Context, failedCallback types.FailedCallback) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(failedCallback.Key()); err != nil {
+		panic(err)
+	}
+
+	if err := store.Delete(types.FailedCallbackExpiryKey(failedCallback.Expiry, failedCallback.Key())); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllFailedCallbacks returns all failed callbacks.
+func (k Keeper) GetAllFailedCallbacks(ctx sdk.Context) []types.FailedCallback {
+	return k.getFailedCallbacksWithPrefix(ctx, []byte(types.KeyFailedCallbackPrefix+"/"))
+}
+
+// GetFailedCallbacksByContract returns all failed callbacks of the given contract.
+func (k Keeper) GetFailedCallbacksByContract(ctx sdk.Context, contractAddress string) []types.FailedCallback {
+	return k.getFailedCallbacksWithPrefix(ctx, types.FailedCallbackContractPrefix(contractAddress))
+}
+
+func (k Keeper) getFailedCallbacksWithPrefix(ctx sdk.Context, keyPrefix []byte) []types.FailedCallback {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), keyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	failedCallbacks := []types.FailedCallback{}
+	for ; iterator.Valid(); iterator.Next() {
+		var failedCallback types.FailedCallback
+		k.cdc.MustUnmarshal(iterator.Value(), &failedCallback)
+		failedCallbacks = append(failedCallbacks, failedCallback)
+	}
+
+	return failedCallbacks
+}
+
+// RecordFailedCallback stores a source callback whose execution failed, so that it can be retried
+// with MsgRetryCallback until the retry period has elapsed. The failed callback is only stored if the
+// callback address belongs to a contract known to the ContractKeeper, see types.ContractResolverKeeper.
+func (k Keeper) RecordFailedCallback(
+	ctx sdk.Context,
+	callbackType types.CallbackType,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+	callbackData types.CallbackData,
+	err error,
+) {
+	resolver, ok := k.contractKeeper.(types.ContractResolverKeeper)
+	if !ok || !resolver.HasContract(ctx, callbackData.CallbackAddress) {
+		k.Logger(ctx).Info("failed callback not recorded for unknown contract", "callback_type", callbackType, "contract_address", callbackData.CallbackAddress, "port_id", packet.SourcePort, "channel_id", packet.SourceChannel, "sequence", packet.Sequence)
+		return
+	}
+
+	expiry := ctx.BlockTime().Add(k.retryPeriod)
+	failedCallback := types.NewFailedCallback(callbackType, packet, acknowledgement, relayer, callbackData, err, expiry)
+	k.SetFailedCallback(ctx, failedCallback)
+
+	k.Logger(ctx).Info("failed callback recorded", "callback_type", callbackType, "contract_address", callbackData.CallbackAddress, "port_id", packet.SourcePort, "channel_id", packet.SourceChannel, "sequence", packet.Sequence, "expiry", expiry)
+}
+
+// PruneExpiredFailedCallbacks removes all failed callbacks which expired at or before the current block time.
+func (k Keeper) PruneExpiredFailedCallbacks(ctx sdk.Context) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	// the expiry index is ordered by expiry, the end key is exclusive so the callbacks expiring
+	// at the current block time are included by iterating up to the next nanosecond
+	startKey := []byte(types.KeyFailedCallbackExpiryPrefix + "/")
+	endKey := types.FailedCallbackExpiryPrefix(ctx.BlockTime().Add(time.Nanosecond))
+	iterator := store.Iterator(startKey, endKey)
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var expiredKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		expiredKeys = append(expiredKeys, iterator.Key())
+	}
+
+	// the failed callback key follows the expiry and a separator in the expiry index key
+	expiryIndexLen := len(endKey) + 1
+	for _, expiryKey := range expiredKeys {
+		store.Delete(expiryKey)
+		store.Delete(expiryKey[expiryIndexLen:])
+
+		k.Logger(ctx).Info("expired failed callback pruned", "key", string(expiryKey[expiryIndexLen:]))
+	}
+}
+
+// retryCallback re-executes the provided failed callback, limited only by the gas remaining in the transaction.
+// The failed callback is removed if the execution succeeds.
+func (k Keeper) retryCallback(ctx sdk.Context, failedCallback types.FailedCallback) error {
+	if failedCallback.IsExpired(ctx.BlockTime()) {
+		return errorsmod.Wrapf(types.ErrFailedCallbackExpired, "failed callback expired at %s", failedCallback.Expiry)
+	}
+
+	var relayer sdk.AccAddress
+	if failedCallback.Relayer != "" {
+		relayer = sdk.MustAccAddressFromBech32(failedCallback.Relayer)
+	}
+
+	callbackType := types.CallbackType(failedCallback.CallbackType)
+	callbackData := types.CallbackData{
+		CallbackAddress:    failedCallback.ContractAddress,
+		ExecutionGasLimit:  ctx.GasMeter().GasRemaining(),
+		SenderAddress:      failedCallback.PacketSenderAddress,
+		CommitGasLimit:     ctx.GasMeter().GasRemaining(),
+		ApplicationVersion: failedCallback.ApplicationVersion,
+	}
+
+	callbackExecutor := func(cachedCtx sdk.Context) error {
+		switch callbackType {
+		case types.CallbackTypeAcknowledgementPacket:
+			return k.contractKeeper.IBCOnAcknowledgementPacketCallback(
+				cachedCtx, failedCallback.Packet, failedCallback.Acknowledgement, relayer, failedCallback.ContractAddress, failedCallback.PacketSenderAddress, failedCallback.ApplicationVersion,
+			)
+		case types.CallbackTypeTimeoutPacket:
+			return k.contractKeeper.IBCOnTimeoutPacketCallback(
+				cachedCtx, failedCallback.Packet, relayer, failedCallback.ContractAddress, failedCallback.PacketSenderAddress, failedCallback.ApplicationVersion,
+			)
+		default:
+			return errorsmod.Wrapf(types.ErrInvalidFailedCallback, "callback type %s cannot be retried", callbackType)
+		}
+	}
+
+	// the execution gas limit is equal to the commit gas limit, so running out of gas returns an error rather than panicking
+	if err := internal.ProcessCallback(ctx, callbackType, callbackData, callbackExecutor); err != nil {
+		return err
+	}
+
+	k.DeleteFailedCallback(ctx, failedCallback)
+
+	types.EmitCallbackEvent(
+		ctx, failedCallback.Packet.SourcePort, failedCallback.Packet.SourceChannel, failedCallback.Packet.Sequence,
+		callbackType, callbackData, nil,
+	)
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/testing/simapp"
+	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
+)
+
+func (s *KeeperTestSuite) TestSetGetDeleteFailedCallback() {
+	callbacksKeeper := GetSimApp(s.chainA).CallbacksKeeper
+	ctx := s.chainA.GetContext()
+
+	failedCallback := s.newFailedCallback(simapp.SuccessContract, 1)
+	_, found := callbacksKeeper.GetFailedCallback(ctx, simapp.SuccessContract, failedCallback.Packet.SourcePort, failedCallback.Packet.SourceChannel, 1)
+	s.Require().False(found)
+
+	callbacksKeeper.SetFailedCallback(ctx, failedCallback)
+
+	stored, found := callbacksKeeper.GetFailedCallback(ctx, simapp.SuccessContract, failedCallback.Packet.SourcePort, failedCallback.Packet.SourceChannel, 1)
+	s.Require().True(found)
+	s.Require().Equal(failedCallback, stored)
+
+	// replacing the failed callback with a later expiry must also replace its expiry index entry
+	failedCallback.Expiry = failedCallback.Expiry.Add(time.Hour)
+	callbacksKeeper.SetFailedCallback(ctx, failedCallback)
+	s.Require().Len(callbacksKeeper.GetAllFailedCallbacks(ctx), 1)
+
+	callbacksKeeper.PruneExpiredFailedCallbacks(ctx.WithBlockTime(failedCallback.Expiry.Add(-time.Hour)))
+	_, found = callbacksKeeper.GetFailedCallback(ctx, simapp.SuccessContract, failedCallback.Packet.SourcePort, failedCallback.Packet.SourceChannel, 1)
+	s.Require().True(found)
+
+	callbacksKeeper.DeleteFailedCallback(ctx, failedCallback)
+	_, found = callbacksKeeper.GetFailedCallback(ctx, simapp.SuccessContract, failedCallback.Packet.SourcePort, failedCallback.Packet.SourceChannel, 1)
+	s.Require().False(found)
+	s.Require().Empty(callbacksKeeper.GetAllFailedCallbacks(ctx))
+}
+
+func (s *KeeperTestSuite) TestGetFailedCallbacksByContract() {
+	callbacksKeeper := GetSimApp(s.chainA).CallbacksKeeper
+	ctx := s.chainA.GetContext()
+
+	expFailedCallbacks := []types.FailedCallback{
+		s.newFailedCallback(simapp.SuccessContract, 1),
+		s.newFailedCallback(simapp.SuccessContract, 2),
+	}
+	for _, failedCallback := range expFailedCallbacks {
+		callbacksKeeper.SetFailedCallback(ctx, failedCallback)
+	}
+	callbacksKeeper.SetFailedCallback(ctx, s.newFailedCallback(simapp.ErrorContract, 1))
+
+	s.Require().Equal(expFailedCallbacks, callbacksKeeper.GetFailedCallbacksByContract(ctx, simapp.SuccessContract))
+	s.Require().Len(callbacksKeeper.GetFailedCallbacksByContract(ctx, simapp.ErrorContract), 1)
+	s.Require().Len(callbacksKeeper.GetAllFailedCallbacks(ctx), 3)
+
+	// the failed callbacks of a contract whose address is a prefix of another contract address are not included
+	callbacksKeeper.SetFailedCallback(ctx, s.newFailedCallback(simapp.SuccessContract+"/other", 1))
+	s.Require().Equal(expFailedCallbacks, callbacksKeeper.GetFailedCallbacksByContract(ctx, simapp.SuccessContract))
+}
+
+func (s *KeeperTestSuite) TestRecordFailedCallback() {
+	callbacksKeeper := GetSimApp(s.chainA).CallbacksKeeper
+	ctx := s.chainA.GetContext()
+
+	expFailedCallback := s.newFailedCallback(simapp.ErrorContract, 1)
+	callbackData := types.CallbackData{
+		CallbackAddress:    expFailedCallback.ContractAddress,
+		SenderAddress:      expFailedCallback.PacketSenderAddress,
+		ApplicationVersion: expFailedCallback.ApplicationVersion,
+	}
+
+	callbacksKeeper.RecordFailedCallback(
+		ctx, types.CallbackTypeAcknowledgementPacket, expFailedCallback.Packet, expFailedCallback.Acknowledgement,
+		s.chainA.SenderAccount.GetAddress(), callbackData, errors.New("callback failed"),
+	)
+
+	failedCallback, found := callbacksKeeper.GetFailedCallback(ctx, simapp.ErrorContract, expFailedCallback.Packet.SourcePort, expFailedCallback.Packet.SourceChannel, 1)
+	s.Require().True(found)
+	s.Require().Equal(expFailedCallback, failedCallback)
+	s.Require().Equal(ctx.BlockTime().Add(simapp.DefaultCallbackRetryPeriod), failedCallback.Expiry)
+
+	// failed callbacks of contracts unknown to the contract keeper are not recorded
+	callbackData.CallbackAddress = simapp.UnknownContract
+	callbacksKeeper.RecordFailedCallback(
+		ctx, types.CallbackTypeAcknowledgementPacket, expFailedCallback.Packet, expFailedCallback.Acknowledgement,
+		s.chainA.SenderAccount.GetAddress(), callbackData, errors.New("callback failed"),
+	)
+
+	_, found = callbacksKeeper.GetFailedCallback(ctx, simapp.UnknownContract, expFailedCallback.Packet.SourcePort, expFailedCallback.Packet.SourceChannel, 1)
+	s.Require().False(found)
+	s.Require().Len(callbacksKeeper.GetAllFailedCallbacks(ctx), 1)
+}
+
+func (s *KeeperTestSuite) TestPruneExpiredFailedCallbacks() {
+	callbacksKeeper := GetSimApp(s.chainA).CallbacksKeeper
+	ctx := s.chainA.GetContext()
+
+	expiringCallback := s.newFailedCallback(simapp.SuccessContract, 1)
+	laterCallback := s.newFailedCallback(simapp.SuccessContract, 2)
+	laterCallback.Expiry = expiringCallback.Expiry.Add(time.Second)
+
+	callbacksKeeper.SetFailedCallback(ctx, expiringCallback)
+	callbacksKeeper.SetFailedCallback(ctx, laterCallback)
+
+	// nothing is pruned before the expiry
+	callbacksKeeper.BeginBlocker(ctx.WithBlockTime(expiringCallback.Expiry.Add(-time.Nanosecond)))
+	s.Require().Len(callbacksKeeper.GetAllFailedCallbacks(ctx), 2)
+
+	// a failed callback is pruned at its expiry
+	callbacksKeeper.BeginBlocker(ctx.WithBlockTime(expiringCallback.Expiry))
+	s.Require().Equal([]types.FailedCallback{laterCallback}, callbacksKeeper.GetAllFailedCallbacks(ctx))
+
+	callbacksKeeper.BeginBlocker(ctx.WithBlockTime(laterCallback.Expiry.Add(time.Hour)))
+	s.Require().Empty(callbacksKeeper.GetAllFailedCallbacks(ctx))
+}
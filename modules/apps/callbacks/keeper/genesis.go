@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
+)
+
+// InitGenesis initializes the ibc-callbacks state from the provided genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	for _, failedCallback := range state.FailedCallbacks {
+		k.SetFailedCallback(ctx, failedCallback)
+	}
+}
+
+// ExportGenesis exports the ibc-callbacks failed callbacks into its genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		FailedCallbacks: k.GetAllFailedCallbacks(ctx),
+	}
+}
@@ -0,0 +1,26 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/testing/simapp"
+	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
+)
+
+func (s *KeeperTestSuite) TestGenesis() {
+	callbacksKeeper := GetSimApp(s.chainA).CallbacksKeeper
+	ctx := s.chainA.GetContext()
+
+	s.Require().Equal(types.DefaultGenesisState(), callbacksKeeper.ExportGenesis(ctx))
+
+	genesisState := types.NewGenesisState([]types.FailedCallback{
+		s.newFailedCallback(simapp.ErrorContract, 1),
+		s.newFailedCallback(simapp.SuccessContract, 1),
+		s.newFailedCallback(simapp.SuccessContract, 2),
+	})
+	callbacksKeeper.InitGenesis(ctx, *genesisState)
+
+	s.Require().Equal(genesisState, callbacksKeeper.ExportGenesis(ctx))
+
+	// the expiry index is restored so that imported failed callbacks are pruned
+	callbacksKeeper.BeginBlocker(ctx.WithBlockTime(genesisState.FailedCallbacks[0].Expiry))
+	s.Require().Equal(types.DefaultGenesisState(), callbacksKeeper.ExportGenesis(ctx))
+}
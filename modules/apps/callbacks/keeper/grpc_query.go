@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
+)
+
+var _ types.QueryServer = (*Keeper)(nil)
+
+// FailedCallback implements the Query/FailedCallback gRPC method
+func (k Keeper) FailedCallback(goCtx context.Context, req *types.QueryFailedCallbackRequest) (*types.QueryFailedCallbackResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.ContractAddress) == "" {
+		return nil, status.Error(codes.InvalidArgument, "contract address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	failedCallback, found := k.GetFailedCallback(ctx, req.ContractAddress, req.PortId, req.ChannelId, req.Sequence)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrFailedCallbackNotFound, "contract %s, port %s, channel %s, sequence %d", req.ContractAddress, req.PortId, req.ChannelId, req.Sequence).Error(),
+		)
+	}
+
+	return &types.QueryFailedCallbackResponse{
+		FailedCallback: failedCallback,
+	}, nil
+}
+
+// FailedCallbacks implements the Query/FailedCallbacks gRPC method
+func (k Keeper) FailedCallbacks(ctx context.Context, req *types.QueryFailedCallbacksRequest) (*types.QueryFailedCallbacksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.ContractAddress) == "" {
+		return nil, status.Error(codes.InvalidArgument, "contract address cannot be empty")
+	}
+
+	var failedCallbacks []types.FailedCallback
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.FailedCallbackContractPrefix(req.ContractAddress))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var failedCallback types.FailedCallback
+		if err := k.cdc.Unmarshal(value, &failedCallback); err != nil {
+			return err
+		}
+
+		failedCallbacks = append(failedCallbacks, failedCallback)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFailedCallbacksResponse{
+		FailedCallbacks: failedCallbacks,
+		Pagination:      pageRes,
+	}, nil
+}
@@ -0,0 +1,161 @@
+package keeper_test
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/testing/simapp"
+	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
+)
+
+func (s *KeeperTestSuite) TestQueryFailedCallback() {
+	var (
+		req               *types.QueryFailedCallbackRequest
+		expFailedCallback types.FailedCallback
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"failure: empty contract address",
+			func() {
+				req.ContractAddress = ""
+			},
+			status.Error(codes.InvalidArgument, "contract address cannot be empty"),
+		},
+		{
+			"failure: failed callback not found",
+			func() {
+				req.Sequence = 2
+			},
+			status.Error(codes.NotFound, "contract success, port mock, channel channel-0, sequence 2: failed callback not found"),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			ctx := s.chainA.GetContext()
+			expFailedCallback = s.newFailedCallback(simapp.SuccessContract, 1)
+			GetSimApp(s.chainA).CallbacksKeeper.SetFailedCallback(ctx, expFailedCallback)
+
+			req = &types.QueryFailedCallbackRequest{
+				ContractAddress: simapp.SuccessContract,
+				PortId:          expFailedCallback.Packet.SourcePort,
+				ChannelId:       expFailedCallback.Packet.SourceChannel,
+				Sequence:        expFailedCallback.Packet.Sequence,
+			}
+
+			tc.malleate()
+
+			res, err := GetSimApp(s.chainA).CallbacksKeeper.FailedCallback(ctx, req)
+
+			if tc.expError == nil {
+				s.Require().NoError(err)
+				s.Require().Equal(expFailedCallback, res.FailedCallback)
+			} else {
+				s.Require().ErrorIs(err, tc.expError)
+				s.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryFailedCallbacks() {
+	var (
+		req                *types.QueryFailedCallbacksRequest
+		expFailedCallbacks []types.FailedCallback
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: paginated",
+			func() {
+				req.Pagination = &query.PageRequest{Limit: 1}
+				expFailedCallbacks = expFailedCallbacks[:1]
+			},
+			nil,
+		},
+		{
+			"success: no failed callbacks for contract",
+			func() {
+				req.ContractAddress = simapp.PanicContract
+				expFailedCallbacks = nil
+			},
+			nil,
+		},
+		{
+			"failure: empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"failure: empty contract address",
+			func() {
+				req.ContractAddress = " "
+			},
+			status.Error(codes.InvalidArgument, "contract address cannot be empty"),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			ctx := s.chainA.GetContext()
+			expFailedCallbacks = []types.FailedCallback{
+				s.newFailedCallback(simapp.SuccessContract, 1),
+				s.newFailedCallback(simapp.SuccessContract, 2),
+			}
+			for _, failedCallback := range expFailedCallbacks {
+				GetSimApp(s.chainA).CallbacksKeeper.SetFailedCallback(ctx, failedCallback)
+			}
+			GetSimApp(s.chainA).CallbacksKeeper.SetFailedCallback(ctx, s.newFailedCallback(simapp.ErrorContract, 1))
+
+			req = &types.QueryFailedCallbacksRequest{
+				ContractAddress: simapp.SuccessContract,
+			}
+
+			tc.malleate()
+
+			res, err := GetSimApp(s.chainA).CallbacksKeeper.FailedCallbacks(ctx, req)
+
+			if tc.expError == nil {
+				s.Require().NoError(err)
+				s.Require().Equal(expFailedCallbacks, res.FailedCallbacks)
+			} else {
+				s.Require().ErrorIs(err, tc.expError)
+				s.Require().Nil(res)
+			}
+		})
+	}
+}
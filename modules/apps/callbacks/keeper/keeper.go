@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"errors"
+	"time"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+// Keeper defines the ibc-callbacks keeper. It stores the source callbacks whose execution
+// failed so that they can be retried.
+type Keeper struct {
+	storeService corestore.KVStoreService
+	cdc          codec.BinaryCodec
+
+	contractKeeper types.ContractKeeper
+
+	// retryPeriod defines the period during which a failed callback can be retried
+	// before it expires and is pruned.
+	retryPeriod time.Duration
+}
+
+// NewKeeper creates a new ibc-callbacks Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService corestore.KVStoreService,
+	contractKeeper types.ContractKeeper,
+	retryPeriod time.Duration,
+) Keeper {
+	if contractKeeper == nil {
+		panic(errors.New("contract keeper cannot be nil"))
+	}
+
+	if retryPeriod <= 0 {
+		panic(errors.New("retry period must be positive"))
+	}
+
+	return Keeper{
+		storeService:   storeService,
+		cdc:            cdc,
+		contractKeeper: contractKeeper,
+		retryPeriod:    retryPeriod,
+	}
+}
+
+// GetContractKeeper returns the contract keeper used to execute callbacks.
+func (k Keeper) GetContractKeeper() types.ContractKeeper {
+	return k.contractKeeper
+}
+
+// Logger returns a module-specific logger.
+func (Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+exported.ModuleName+"-"+types.ModuleName)
+}
@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+	testifysuite "github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/keeper"
+	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/testing/simapp"
+	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+	ibcmock "github.com/cosmos/ibc-go/v10/testing/mock"
+)
+
+// SetupTestingApp provides the duplicated simapp which is specific to the callbacks module on chain creation.
+func SetupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	db := dbm.NewMemDB()
+	app := simapp.NewSimApp(log.NewNopLogger(), db, nil, true, simtestutil.EmptyAppOptions{})
+	return app, app.DefaultGenesis()
+}
+
+// GetSimApp returns the duplicated SimApp from within the callbacks directory.
+func GetSimApp(chain *ibctesting.TestChain) *simapp.SimApp {
+	app, ok := chain.App.(*simapp.SimApp)
+	if !ok {
+		panic(errors.New("chain is not a simapp.SimApp"))
+	}
+	return app
+}
+
+type KeeperTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	chainA *ibctesting.TestChain
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.coordinator = ibctesting.NewCustomAppCoordinator(s.T(), 1, SetupTestingApp)
+	s.chainA = s.coordinator.GetChain(ibctesting.GetChainID(1))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(KeeperTestSuite))
+}
+
+// newFailedCallback returns a failed acknowledgement callback of the given contract for the packet
+// with the given sequence, expiring after the retry period of the callbacks keeper.
+func (s *KeeperTestSuite) newFailedCallback(contractAddress string, sequence uint64) types.FailedCallback {
+	packet := channeltypes.NewPacket(
+		ibctesting.MockPacketData, sequence, ibctesting.MockPort, ibctesting.FirstChannelID,
+		ibctesting.MockPort, ibctesting.FirstChannelID, clienttypes.ZeroHeight(), 1,
+	)
+	callbackData := types.CallbackData{
+		CallbackAddress:    contractAddress,
+		SenderAddress:      s.chainA.SenderAccount.GetAddress().String(),
+		ApplicationVersion: ibcmock.Version,
+	}
+
+	expiry := s.chainA.GetContext().BlockTime().Add(simapp.DefaultCallbackRetryPeriod)
+	return types.NewFailedCallback(
+		types.CallbackTypeAcknowledgementPacket, packet, ibctesting.MockAcknowledgement, s.chainA.SenderAccount.GetAddress(),
+		callbackData, errors.New("callback failed"), expiry,
+	)
+}
+
+func (s *KeeperTestSuite) TestNewKeeper() {
+	testCases := []struct {
+		name          string
+		instantiateFn func()
+		expError      error
+	}{
+		{
+			"success",
+			func() {
+				keeper.NewKeeper(
+					GetSimApp(s.chainA).AppCodec(),
+					runtime.NewKVStoreService(GetSimApp(s.chainA).GetKey(types.StoreKey)),
+					GetSimApp(s.chainA).MockContractKeeper,
+					time.Hour,
+				)
+			},
+			nil,
+		},
+		{
+			"failure: nil contract keeper",
+			func() {
+				keeper.NewKeeper(
+					GetSimApp(s.chainA).AppCodec(),
+					runtime.NewKVStoreService(GetSimApp(s.chainA).GetKey(types.StoreKey)),
+					nil,
+					time.Hour,
+				)
+			},
+			errors.New("contract keeper cannot be nil"),
+		},
+		{
+			"failure: zero retry period",
+			func() {
+				keeper.NewKeeper(
+					GetSimApp(s.chainA).AppCodec(),
+					runtime.NewKVStoreService(GetSimApp(s.chainA).GetKey(types.StoreKey)),
+					GetSimApp(s.chainA).MockContractKeeper,
+					0,
+				)
+			},
+			errors.New("retry period must be positive"),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			if tc.expError == nil {
+				s.Require().NotPanics(tc.instantiateFn)
+			} else {
+				s.Require().PanicsWithError(tc.expError.Error(), tc.instantiateFn)
+			}
+		})
+	}
+}
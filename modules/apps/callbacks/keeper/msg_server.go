@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
+)
+
+var _ types.MsgServer = (*Keeper)(nil)
+
+// RetryCallback defines an rpc handler method for MsgRetryCallback. Any signer may retry a failed callback.
+func (k Keeper) RetryCallback(goCtx context.Context, msg *types.MsgRetryCallback) (*types.MsgRetryCallbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	failedCallback, found := k.GetFailedCallback(ctx, msg.ContractAddress, msg.PortId, msg.ChannelId, msg.Sequence)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrFailedCallbackNotFound, "contract %s, port %s, channel %s, sequence %d", msg.ContractAddress, msg.PortId, msg.ChannelId, msg.Sequence)
+	}
+
+	if err := k.retryCallback(ctx, failedCallback); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRetryCallbackResponse{}, nil
+}
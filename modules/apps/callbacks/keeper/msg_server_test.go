@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/testing/simapp"
+	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
+	ibcmock "github.com/cosmos/ibc-go/v10/testing/mock"
+)
+
+func (s *KeeperTestSuite) TestRetryCallback() {
+	var (
+		failedCallback types.FailedCallback
+		msg            *types.MsgRetryCallback
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expError  error
+		expStored bool
+	}{
+		{
+			"success: acknowledgement callback",
+			func() {},
+			nil,
+			false,
+		},
+		{
+			"success: timeout callback",
+			func() {
+				failedCallback.CallbackType = string(types.CallbackTypeTimeoutPacket)
+				failedCallback.Acknowledgement = nil
+			},
+			nil,
+			false,
+		},
+		{
+			"success: unknown relayer",
+			func() {
+				failedCallback.Relayer = ""
+			},
+			nil,
+			false,
+		},
+		{
+			"failure: failed callback not found",
+			func() {
+				msg.Sequence = 2
+			},
+			types.ErrFailedCallbackNotFound,
+			true,
+		},
+		{
+			"failure: failed callback expired",
+			func() {
+				failedCallback.Expiry = s.chainA.GetContext().BlockTime()
+			},
+			types.ErrFailedCallbackExpired,
+			true,
+		},
+		{
+			"failure: callback execution fails again",
+			func() {
+				failedCallback = s.newFailedCallback(simapp.ErrorContract, 1)
+				msg.ContractAddress = simapp.ErrorContract
+			},
+			ibcmock.MockApplicationCallbackError,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			callbacksKeeper := GetSimApp(s.chainA).CallbacksKeeper
+			failedCallback = s.newFailedCallback(simapp.SuccessContract, 1)
+			msg = types.NewMsgRetryCallback(
+				s.chainA.SenderAccount.GetAddress().String(), simapp.SuccessContract,
+				failedCallback.Packet.SourcePort, failedCallback.Packet.SourceChannel, failedCallback.Packet.Sequence,
+			)
+
+			tc.malleate()
+
+			ctx := s.chainA.GetContext()
+			callbacksKeeper.SetFailedCallback(ctx, failedCallback)
+
+			_, err := callbacksKeeper.RetryCallback(ctx, msg)
+
+			_, found := callbacksKeeper.GetFailedCallback(ctx, failedCallback.ContractAddress, failedCallback.Packet.SourcePort, failedCallback.Packet.SourceChannel, failedCallback.Packet.Sequence)
+			s.Require().Equal(tc.expStored, found)
+
+			if tc.expError == nil {
+				s.Require().NoError(err)
+				s.Require().Equal(1, GetSimApp(s.chainA).MockContractKeeper.Counters[types.CallbackType(failedCallback.CallbackType)])
+			} else {
+				s.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRetryCallbackAfterRetryPeriod() {
+	callbacksKeeper := GetSimApp(s.chainA).CallbacksKeeper
+
+	failedCallback := s.newFailedCallback(simapp.SuccessContract, 1)
+	callbacksKeeper.SetFailedCallback(s.chainA.GetContext(), failedCallback)
+
+	s.coordinator.IncrementTimeBy(simapp.DefaultCallbackRetryPeriod + time.Second)
+	s.coordinator.CommitBlock(s.chainA)
+
+	msg := types.NewMsgRetryCallback(
+		s.chainA.SenderAccount.GetAddress().String(), simapp.SuccessContract,
+		failedCallback.Packet.SourcePort, failedCallback.Packet.SourceChannel, failedCallback.Packet.Sequence,
+	)
+	_, err := callbacksKeeper.RetryCallback(s.chainA.GetContext(), msg)
+	s.Require().ErrorIs(err, types.ErrFailedCallbackNotFound)
+}
@@ -0,0 +1,85 @@
+package router
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+// The callbacks below may be implemented by native modules registered with the Router. A module only
+// needs to implement the callbacks it is interested in, the callbacks it does not implement are no-ops.
+//
+// The callbacks are executed by the callbacks middleware with a cached context whose gas meter is limited
+// to the callback gas limit, so they are subject to the same gas limits as contract callbacks. If an error
+// is returned, the changes in the cached context are not persisted.
+//
+// Source callbacks are only routed to a module for packets sent by its module account, so modules do not
+// need to validate the packet sender.
+//
+// Destination callbacks are routed without any check of the packet sender: any account on any counterparty
+// chain may send a packet whose destination callback address is the module account. Modules implementing
+// destination callbacks must therefore validate the packet data themselves, e.g. the sender, the source
+// client or channel and the amounts, before acting on it.
+
+// SendPacketCallback is called in the source chain when a packet is sent by the module account. If an error
+// is returned, the packet send fails.
+type SendPacketCallback interface {
+	IBCSendPacketCallback(
+		cachedCtx sdk.Context,
+		sourcePort string,
+		sourceChannel string,
+		timeoutHeight clienttypes.Height,
+		timeoutTimestamp uint64,
+		packetData []byte,
+		version string,
+	) error
+}
+
+// AcknowledgementPacketCallback is called in the source chain when the acknowledgement of a packet sent by
+// the module account is received.
+type AcknowledgementPacketCallback interface {
+	IBCOnAcknowledgementPacketCallback(
+		cachedCtx sdk.Context,
+		packet channeltypes.Packet,
+		acknowledgement []byte,
+		relayer sdk.AccAddress,
+		version string,
+	) error
+}
+
+// TimeoutPacketCallback is called in the source chain when a packet sent by the module account times out.
+type TimeoutPacketCallback interface {
+	IBCOnTimeoutPacketCallback(
+		cachedCtx sdk.Context,
+		packet channeltypes.Packet,
+		relayer sdk.AccAddress,
+		version string,
+	) error
+}
+
+// ReceivePacketCallback is called in the destination chain when the acknowledgement of a packet whose
+// destination callback address is the module account is written.
+// The packet sender is not checked before the callback is executed.
+type ReceivePacketCallback interface {
+	IBCReceivePacketCallback(
+		cachedCtx sdk.Context,
+		packet ibcexported.PacketI,
+		ack ibcexported.Acknowledgement,
+		version string,
+	) error
+}
+
+// ReceivePacketResultCallback is called instead of ReceivePacketCallback in the destination chain for packets
+// which request the result of their destination callback. The returned result is sent back to the source
+// chain in the acknowledgement.
+// As for ReceivePacketCallback, the packet sender is not checked before the callback is executed.
+type ReceivePacketResultCallback interface {
+	IBCReceivePacketCallbackWithResult(
+		cachedCtx sdk.Context,
+		packet ibcexported.PacketI,
+		ack ibcexported.Acknowledgement,
+		version string,
+	) ([]byte, error)
+}
@@ -0,0 +1,267 @@
+package router
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+var (
+	_ types.ContractKeeper              = (*Router)(nil)
+	_ types.ReceiveResultContractKeeper = (*Router)(nil)
+	_ types.ContractResolverKeeper      = (*Router)(nil)
+)
+
+// Router is a ContractKeeper which dispatches callbacks to native modules. Modules are registered by
+// module name and receive the callbacks whose callback address is their module account address.
+// Callbacks for any other address are passed to the fallback ContractKeeper, if one is set.
+type Router struct {
+	// routes is a map from module account address bytes to the registered module
+	routes map[string]route
+
+	fallback types.ContractKeeper
+}
+
+type route struct {
+	moduleName string
+	address    sdk.AccAddress
+	handler    any
+}
+
+// NewRouter creates a new Router instance. The fallback ContractKeeper, which may be nil, receives
+// the callbacks for addresses which are not registered with the Router.
+func NewRouter(fallback types.ContractKeeper) *Router {
+	return &Router{
+		routes:   make(map[string]route),
+		fallback: fallback,
+	}
+}
+
+// AddRoute registers the callbacks of the given module for its module account address. It returns the
+// Router so AddRoute calls can be linked. The handler must implement at least one of SendPacketCallback,
+// AcknowledgementPacketCallback, TimeoutPacketCallback, ReceivePacketCallback and ReceivePacketResultCallback.
+func (rtr *Router) AddRoute(moduleName string, handler any) *Router {
+	if !sdk.IsAlphaNumeric(moduleName) {
+		panic(errors.New("route expressions can only contain alphanumeric characters"))
+	}
+
+	if rtr.HasRoute(moduleName) {
+		panic(fmt.Errorf("route %s has already been registered", moduleName))
+	}
+
+	switch handler.(type) {
+	case SendPacketCallback, AcknowledgementPacketCallback, TimeoutPacketCallback, ReceivePacketCallback, ReceivePacketResultCallback:
+	default:
+		panic(fmt.Errorf("route %s does not implement any callback", moduleName))
+	}
+
+	address := authtypes.NewModuleAddress(moduleName)
+	rtr.routes[string(address)] = route{moduleName: moduleName, address: address, handler: handler}
+
+	return rtr
+}
+
+// HasRoute returns true if the Router has the given module registered or false otherwise.
+func (rtr *Router) HasRoute(moduleName string) bool {
+	_, ok := rtr.routes[string(authtypes.NewModuleAddress(moduleName))]
+	return ok
+}
+
+// Keys returns the sorted names of the registered modules.
+func (rtr *Router) Keys() []string {
+	keys := make([]string, 0, len(rtr.routes))
+	for _, r := range rtr.routes {
+		keys = append(keys, r.moduleName)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
+
+// HasContract implements the ContractResolverKeeper interface. It returns true if a module is registered
+// for the given callback address, or if the fallback ContractKeeper knows the contract.
+func (rtr *Router) HasContract(ctx sdk.Context, contractAddress string) bool {
+	if _, ok := rtr.route(contractAddress); ok {
+		return true
+	}
+
+	fallback, ok := rtr.fallback.(types.ContractResolverKeeper)
+	return ok && fallback.HasContract(ctx, contractAddress)
+}
+
+// route returns the module registered for the given callback address.
+func (rtr *Router) route(callbackAddress string) (route, bool) {
+	addr, err := sdk.AccAddressFromBech32(callbackAddress)
+	if err != nil {
+		return route{}, false
+	}
+
+	r, ok := rtr.routes[string(addr)]
+	return r, ok
+}
+
+// authenticateSender returns an error if the packet sender is not the module account of the route, source
+// callbacks are only routed to a module for packets sent by its module account.
+func authenticateSender(r route, packetSenderAddress string) error {
+	sender, err := sdk.AccAddressFromBech32(packetSenderAddress)
+	if err != nil || !sender.Equals(r.address) {
+		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "packet sender %s is not the %s module account", packetSenderAddress, r.moduleName)
+	}
+
+	return nil
+}
+
+// IBCSendPacketCallback implements the ContractKeeper interface.
+func (rtr *Router) IBCSendPacketCallback(
+	cachedCtx sdk.Context,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	packetData []byte,
+	contractAddress,
+	packetSenderAddress string,
+	version string,
+) error {
+	r, ok := rtr.route(contractAddress)
+	if !ok {
+		if rtr.fallback == nil {
+			return errorsmod.Wrapf(types.ErrCallbackHandlerNotFound, "no route for callback address %s", contractAddress)
+		}
+		return rtr.fallback.IBCSendPacketCallback(cachedCtx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetData, contractAddress, packetSenderAddress, version)
+	}
+
+	if err := authenticateSender(r, packetSenderAddress); err != nil {
+		return err
+	}
+
+	cbs, ok := r.handler.(SendPacketCallback)
+	if !ok {
+		return nil
+	}
+
+	return cbs.IBCSendPacketCallback(cachedCtx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetData, version)
+}
+
+// IBCOnAcknowledgementPacketCallback implements the ContractKeeper interface.
+func (rtr *Router) IBCOnAcknowledgementPacketCallback(
+	cachedCtx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+	contractAddress,
+	packetSenderAddress string,
+	version string,
+) error {
+	r, ok := rtr.route(contractAddress)
+	if !ok {
+		if rtr.fallback == nil {
+			return errorsmod.Wrapf(types.ErrCallbackHandlerNotFound, "no route for callback address %s", contractAddress)
+		}
+		return rtr.fallback.IBCOnAcknowledgementPacketCallback(cachedCtx, packet, acknowledgement, relayer, contractAddress, packetSenderAddress, version)
+	}
+
+	if err := authenticateSender(r, packetSenderAddress); err != nil {
+		return err
+	}
+
+	cbs, ok := r.handler.(AcknowledgementPacketCallback)
+	if !ok {
+		return nil
+	}
+
+	return cbs.IBCOnAcknowledgementPacketCallback(cachedCtx, packet, acknowledgement, relayer, version)
+}
+
+// IBCOnTimeoutPacketCallback implements the ContractKeeper interface.
+func (rtr *Router) IBCOnTimeoutPacketCallback(
+	cachedCtx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+	contractAddress,
+	packetSenderAddress string,
+	version string,
+) error {
+	r, ok := rtr.route(contractAddress)
+	if !ok {
+		if rtr.fallback == nil {
+			return errorsmod.Wrapf(types.ErrCallbackHandlerNotFound, "no route for callback address %s", contractAddress)
+		}
+		return rtr.fallback.IBCOnTimeoutPacketCallback(cachedCtx, packet, relayer, contractAddress, packetSenderAddress, version)
+	}
+
+	if err := authenticateSender(r, packetSenderAddress); err != nil {
+		return err
+	}
+
+	cbs, ok := r.handler.(TimeoutPacketCallback)
+	if !ok {
+		return nil
+	}
+
+	return cbs.IBCOnTimeoutPacketCallback(cachedCtx, packet, relayer, version)
+}
+
+// IBCReceivePacketCallback implements the ContractKeeper interface.
+func (rtr *Router) IBCReceivePacketCallback(
+	cachedCtx sdk.Context,
+	packet ibcexported.PacketI,
+	ack ibcexported.Acknowledgement,
+	contractAddress string,
+	version string,
+) error {
+	r, ok := rtr.route(contractAddress)
+	if !ok {
+		if rtr.fallback == nil {
+			return errorsmod.Wrapf(types.ErrCallbackHandlerNotFound, "no route for callback address %s", contractAddress)
+		}
+		return rtr.fallback.IBCReceivePacketCallback(cachedCtx, packet, ack, contractAddress, version)
+	}
+
+	cbs, ok := r.handler.(ReceivePacketCallback)
+	if !ok {
+		return nil
+	}
+
+	return cbs.IBCReceivePacketCallback(cachedCtx, packet, ack, version)
+}
+
+// IBCReceivePacketCallbackWithResult implements the ReceiveResultContractKeeper interface.
+func (rtr *Router) IBCReceivePacketCallbackWithResult(
+	cachedCtx sdk.Context,
+	packet ibcexported.PacketI,
+	ack ibcexported.Acknowledgement,
+	contractAddress string,
+	version string,
+) ([]byte, error) {
+	r, ok := rtr.route(contractAddress)
+	if !ok {
+		if rtr.fallback == nil {
+			return nil, errorsmod.Wrapf(types.ErrCallbackHandlerNotFound, "no route for callback address %s", contractAddress)
+		}
+
+		fallback, ok := rtr.fallback.(types.ReceiveResultContractKeeper)
+		if !ok {
+			return nil, errorsmod.Wrapf(types.ErrCallbackResultNotSupported, "fallback contract keeper %T does not return callback results", rtr.fallback)
+		}
+		return fallback.IBCReceivePacketCallbackWithResult(cachedCtx, packet, ack, contractAddress, version)
+	}
+
+	cbs, ok := r.handler.(ReceivePacketResultCallback)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrCallbackResultNotSupported, "module %s does not return callback results", r.moduleName)
+	}
+
+	return cbs.IBCReceivePacketCallbackWithResult(cachedCtx, packet, ack, version)
+}
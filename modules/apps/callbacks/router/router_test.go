@@ -0,0 +1,330 @@
+package router_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/router"
+	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/testing/simapp"
+	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+const moduleName = "vault"
+
+var errCallback = errors.New("callback error")
+
+// mockModule implements the acknowledgement and timeout callbacks only.
+type mockModule struct {
+	calls map[types.CallbackType]int
+	err   error
+}
+
+func (m *mockModule) IBCOnAcknowledgementPacketCallback(_ sdk.Context, _ channeltypes.Packet, _ []byte, _ sdk.AccAddress, _ string) error {
+	m.calls[types.CallbackTypeAcknowledgementPacket]++
+	return m.err
+}
+
+func (m *mockModule) IBCOnTimeoutPacketCallback(_ sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress, _ string) error {
+	m.calls[types.CallbackTypeTimeoutPacket]++
+	return m.err
+}
+
+// receiveModule implements the receive packet callback only.
+type receiveModule struct {
+	calls int
+}
+
+func (m *receiveModule) IBCReceivePacketCallback(_ sdk.Context, _ ibcexported.PacketI, _ ibcexported.Acknowledgement, _ string) error {
+	m.calls++
+	return nil
+}
+
+// resultModule implements the receive packet callback returning a result only.
+type resultModule struct{}
+
+func (resultModule) IBCReceivePacketCallbackWithResult(_ sdk.Context, _ ibcexported.PacketI, _ ibcexported.Acknowledgement, _ string) ([]byte, error) {
+	return []byte(moduleName), nil
+}
+
+func TestAddRoute(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(rtr *router.Router)
+		expError error
+	}{
+		{
+			"success",
+			func(rtr *router.Router) {
+				rtr.AddRoute(moduleName, &mockModule{}).AddRoute("receiver", &receiveModule{})
+			},
+			nil,
+		},
+		{
+			"failure: non alphanumeric module name",
+			func(rtr *router.Router) {
+				rtr.AddRoute("vault-module", &mockModule{})
+			},
+			errors.New("route expressions can only contain alphanumeric characters"),
+		},
+		{
+			"failure: route already registered",
+			func(rtr *router.Router) {
+				rtr.AddRoute(moduleName, &mockModule{}).AddRoute(moduleName, &receiveModule{})
+			},
+			errors.New("route vault has already been registered"),
+		},
+		{
+			"failure: handler does not implement any callback",
+			func(rtr *router.Router) {
+				rtr.AddRoute(moduleName, struct{}{})
+			},
+			errors.New("route vault does not implement any callback"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rtr := router.NewRouter(nil)
+
+			if tc.expError == nil {
+				require.NotPanics(t, func() { tc.malleate(rtr) })
+				require.True(t, rtr.HasRoute(moduleName))
+				require.Equal(t, []string{"receiver", moduleName}, rtr.Keys())
+			} else {
+				require.PanicsWithError(t, tc.expError.Error(), func() { tc.malleate(rtr) })
+			}
+		})
+	}
+}
+
+func TestRouterCallbacks(t *testing.T) {
+	var (
+		rtr           *router.Router
+		module        *mockModule
+		callbackAddr  string
+		senderAddr    string
+		mockContracts *simapp.ContractKeeper
+	)
+
+	moduleAddr := authtypes.NewModuleAddress(moduleName).String()
+	ctx := sdk.Context{}
+	packet := channeltypes.NewPacket(ibctesting.MockPacketData, 1, ibctesting.MockPort, ibctesting.FirstChannelID, ibctesting.MockPort, ibctesting.FirstChannelID, clienttypes.ZeroHeight(), 1)
+
+	sendPacket := func() error {
+		return rtr.IBCSendPacketCallback(ctx, packet.SourcePort, packet.SourceChannel, packet.TimeoutHeight, packet.TimeoutTimestamp, packet.Data, callbackAddr, senderAddr, ibctesting.MockPort)
+	}
+	acknowledgePacket := func() error {
+		return rtr.IBCOnAcknowledgementPacketCallback(ctx, packet, ibctesting.MockAcknowledgement, nil, callbackAddr, senderAddr, ibctesting.MockPort)
+	}
+	timeoutPacket := func() error {
+		return rtr.IBCOnTimeoutPacketCallback(ctx, packet, nil, callbackAddr, senderAddr, ibctesting.MockPort)
+	}
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		callback     func() error
+		callbackType types.CallbackType
+		expCalls     int
+		expError     error
+	}{
+		{
+			"success: acknowledgement callback",
+			func() {},
+			acknowledgePacket,
+			types.CallbackTypeAcknowledgementPacket,
+			1,
+			nil,
+		},
+		{
+			"success: timeout callback",
+			func() {},
+			timeoutPacket,
+			types.CallbackTypeTimeoutPacket,
+			1,
+			nil,
+		},
+		{
+			"success: callback not implemented by module is a no-op",
+			func() {},
+			sendPacket,
+			types.CallbackTypeSendPacket,
+			0,
+			nil,
+		},
+		{
+			"failure: module callback returns error",
+			func() {
+				module.err = errCallback
+			},
+			acknowledgePacket,
+			types.CallbackTypeAcknowledgementPacket,
+			1,
+			errCallback,
+		},
+		{
+			"failure: packet not sent by module account",
+			func() {
+				senderAddr = ibctesting.TestAccAddress
+			},
+			acknowledgePacket,
+			types.CallbackTypeAcknowledgementPacket,
+			0,
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: send packet not sent by module account",
+			func() {
+				senderAddr = ""
+			},
+			sendPacket,
+			types.CallbackTypeSendPacket,
+			0,
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: unregistered address without fallback",
+			func() {
+				rtr = router.NewRouter(nil).AddRoute(moduleName, module)
+				callbackAddr = ibctesting.TestAccAddress
+			},
+			timeoutPacket,
+			types.CallbackTypeTimeoutPacket,
+			0,
+			types.ErrCallbackHandlerNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			module = &mockModule{calls: make(map[types.CallbackType]int)}
+			mockContracts = &simapp.ContractKeeper{Counters: make(map[types.CallbackType]int)}
+			rtr = router.NewRouter(mockContracts).AddRoute(moduleName, module)
+			callbackAddr = moduleAddr
+			senderAddr = moduleAddr
+
+			tc.malleate()
+
+			err := tc.callback()
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+
+			require.Equal(t, tc.expCalls, module.calls[tc.callbackType])
+			require.Empty(t, mockContracts.Counters)
+		})
+	}
+}
+
+func TestRouterFallback(t *testing.T) {
+	var calls []string
+	fallback := &simapp.ContractKeeper{
+		IBCOnAcknowledgementPacketCallbackFn: func(_ sdk.Context, _ channeltypes.Packet, _ []byte, _ sdk.AccAddress, contractAddress, _, _ string) error {
+			calls = append(calls, contractAddress)
+			return nil
+		},
+		IBCReceivePacketCallbackFn: func(_ sdk.Context, _ ibcexported.PacketI, _ ibcexported.Acknowledgement, contractAddress, _ string) error {
+			calls = append(calls, contractAddress)
+			return nil
+		},
+	}
+
+	receiver := &receiveModule{}
+	rtr := router.NewRouter(fallback).AddRoute(moduleName, receiver)
+	packet := channeltypes.NewPacket(ibctesting.MockPacketData, 1, ibctesting.MockPort, ibctesting.FirstChannelID, ibctesting.MockPort, ibctesting.FirstChannelID, clienttypes.ZeroHeight(), 1)
+
+	// callback addresses which are not module accounts of registered modules are passed to the fallback
+	require.NoError(t, rtr.IBCOnAcknowledgementPacketCallback(sdk.Context{}, packet, ibctesting.MockAcknowledgement, nil, simapp.SuccessContract, ibctesting.TestAccAddress, ibctesting.MockPort))
+	require.NoError(t, rtr.IBCReceivePacketCallback(sdk.Context{}, packet, nil, ibctesting.TestAccAddress, ibctesting.MockPort))
+	require.Equal(t, []string{simapp.SuccessContract, ibctesting.TestAccAddress}, calls)
+
+	// receive callbacks are routed to the module regardless of the packet sender
+	require.NoError(t, rtr.IBCReceivePacketCallback(sdk.Context{}, packet, nil, authtypes.NewModuleAddress(moduleName).String(), ibctesting.MockPort))
+	require.Equal(t, 1, receiver.calls)
+	require.Len(t, calls, 2)
+}
+
+func TestRouterReceivePacketCallbackWithResult(t *testing.T) {
+	packet := channeltypes.NewPacket(ibctesting.MockPacketData, 1, ibctesting.MockPort, ibctesting.FirstChannelID, ibctesting.MockPort, ibctesting.FirstChannelID, clienttypes.ZeroHeight(), 1)
+	moduleAddress := authtypes.NewModuleAddress(moduleName).String()
+	fallback := &simapp.ContractKeeper{
+		IBCReceivePacketCallbackWithResultFn: func(_ sdk.Context, _ ibcexported.PacketI, _ ibcexported.Acknowledgement, _, _ string) ([]byte, error) {
+			return simapp.MockCallbackResult, nil
+		},
+	}
+
+	testCases := []struct {
+		name            string
+		rtr             *router.Router
+		contractAddress string
+		expResult       []byte
+		expError        error
+	}{
+		{
+			"success: module returns result",
+			router.NewRouter(nil).AddRoute(moduleName, resultModule{}),
+			moduleAddress,
+			[]byte(moduleName),
+			nil,
+		},
+		{
+			"success: fallback returns result",
+			router.NewRouter(fallback).AddRoute(moduleName, resultModule{}),
+			simapp.SuccessContract,
+			simapp.MockCallbackResult,
+			nil,
+		},
+		{
+			"failure: module does not return results",
+			router.NewRouter(nil).AddRoute(moduleName, &receiveModule{}),
+			moduleAddress,
+			nil,
+			types.ErrCallbackResultNotSupported,
+		},
+		{
+			"failure: no route and no fallback",
+			router.NewRouter(nil).AddRoute(moduleName, resultModule{}),
+			ibctesting.TestAccAddress,
+			nil,
+			types.ErrCallbackHandlerNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := tc.rtr.IBCReceivePacketCallbackWithResult(sdk.Context{}, packet, nil, tc.contractAddress, ibctesting.MockPort)
+			if tc.expError == nil {
+				require.NoError(t, err)
+				require.Equal(t, tc.expResult, result)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+func TestRouterHasContract(t *testing.T) {
+	moduleAddress := authtypes.NewModuleAddress(moduleName).String()
+
+	rtr := router.NewRouter(nil).AddRoute(moduleName, &receiveModule{})
+	require.True(t, rtr.HasContract(sdk.Context{}, moduleAddress))
+	require.False(t, rtr.HasContract(sdk.Context{}, simapp.SuccessContract))
+
+	// addresses which are not routed are resolved by the fallback
+	rtr = router.NewRouter(&simapp.ContractKeeper{}).AddRoute(moduleName, &receiveModule{})
+	require.True(t, rtr.HasContract(sdk.Context{}, moduleAddress))
+	require.True(t, rtr.HasContract(sdk.Context{}, simapp.SuccessContract))
+	require.False(t, rtr.HasContract(sdk.Context{}, simapp.UnknownContract))
+}
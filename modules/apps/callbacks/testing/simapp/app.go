@@ -7,6 +7,7 @@ import (
 	"maps"
 	"os"
 	"path/filepath"
+	"time"
 
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/gogoproto/proto"
@@ -87,7 +88,14 @@ import (
 	icahostkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/keeper"
 	icahosttypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	icq "github.com/cosmos/ibc-go/v10/modules/apps/async-icq"
+	icqkeeper "github.com/cosmos/ibc-go/v10/modules/apps/async-icq/keeper"
+	icqtypes "github.com/cosmos/ibc-go/v10/modules/apps/async-icq/types"
+	icqv2 "github.com/cosmos/ibc-go/v10/modules/apps/async-icq/v2"
 	ibccallbacks "github.com/cosmos/ibc-go/v10/modules/apps/callbacks"
+	ibccallbackskeeper "github.com/cosmos/ibc-go/v10/modules/apps/callbacks/keeper"
+	ibccallbacksrouter "github.com/cosmos/ibc-go/v10/modules/apps/callbacks/router"
+	ibccallbackstypes "github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
 	ibccallbacksv2 "github.com/cosmos/ibc-go/v10/modules/apps/callbacks/v2"
 	"github.com/cosmos/ibc-go/v10/modules/apps/transfer"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v10/modules/apps/transfer/keeper"
@@ -105,6 +113,9 @@ import (
 
 const appName = "SimApp"
 
+// DefaultCallbackRetryPeriod is the period during which failed callbacks can be retried.
+const DefaultCallbackRetryPeriod = 24 * time.Hour
+
 var (
 	// DefaultNodeHome default home directories for the application daemon
 	DefaultNodeHome string
@@ -156,10 +167,13 @@ type SimApp struct {
 	ICAControllerKeeper   icacontrollerkeeper.Keeper
 	ICAHostKeeper         icahostkeeper.Keeper
 	TransferKeeper        ibctransferkeeper.Keeper
+	ICQKeeper             icqkeeper.Keeper
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
 
-	// mock contract keeper used for testing
-	MockContractKeeper *ContractKeeper
+	// mock contract keeper and native module used for testing
+	MockContractKeeper    *ContractKeeper
+	NativeCallbacksModule *NativeCallbacksModule
+	CallbacksKeeper       ibccallbackskeeper.Keeper
 
 	// make IBC modules public for test purposes
 	// these modules are never directly routed to by the IBC Router
@@ -249,7 +263,7 @@ func NewSimApp(
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, paramstypes.StoreKey, ibcexported.StoreKey, upgradetypes.StoreKey,
 		ibctransfertypes.StoreKey, icacontrollertypes.StoreKey, icahosttypes.StoreKey,
-		consensusparamtypes.StoreKey,
+		consensusparamtypes.StoreKey, icqtypes.StoreKey, ibccallbackstypes.StoreKey,
 	)
 
 	// register streaming services
@@ -323,6 +337,17 @@ func NewSimApp(
 	// NOTE: The mock ContractKeeper is only created for testing.
 	// Real applications should not use the mock ContractKeeper
 	app.MockContractKeeper = NewContractKeeper(memKeys[ibcmock.MemStoreKey])
+	app.NativeCallbacksModule = NewNativeCallbacksModule(memKeys[ibcmock.MemStoreKey])
+
+	// The callbacks router dispatches the callbacks of the native module account and passes the callbacks
+	// of every other address to the mock ContractKeeper
+	callbacksRouter := ibccallbacksrouter.NewRouter(app.MockContractKeeper).
+		AddRoute(NativeCallbacksModuleName, app.NativeCallbacksModule)
+
+	// Create the ibc-callbacks Keeper, which stores failed callbacks so that they can be retried
+	app.CallbacksKeeper = ibccallbackskeeper.NewKeeper(
+		appCodec, runtime.NewKVStoreService(keys[ibccallbackstypes.StoreKey]), callbacksRouter, DefaultCallbackRetryPeriod,
+	)
 
 	govConfig := govtypes.DefaultConfig()
 	/*
@@ -371,11 +396,21 @@ func NewSimApp(
 		appCodec, runtime.NewKVStoreService(keys[ibctransfertypes.StoreKey]), app.GetSubspace(ibctransfertypes.ModuleName),
 		app.IBCKeeper.ChannelKeeper,
 		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ClientV2Keeper,
 		app.MsgServiceRouter(),
 		app.AccountKeeper, app.BankKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	// Create Async ICQ Keeper
+	// NOTE: the Async ICQ Keeper's ICS4Wrapper can later be replaced.
+	app.ICQKeeper = icqkeeper.NewKeeper(
+		appCodec, runtime.NewKVStoreService(keys[icqtypes.StoreKey]),
+		app.IBCKeeper.ChannelKeeper,
+		app.GRPCQueryRouter(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	// Mock Module Stack
 
 	// Mock Module setup for testing IBC and also acts as the interchain accounts authentication module
@@ -401,7 +436,7 @@ func NewSimApp(
 	// create IBC module from bottom to top of stack
 	var transferStack porttypes.IBCModule
 	transferStack = transfer.NewIBCModule(app.TransferKeeper)
-	transferStack = ibccallbacks.NewIBCMiddleware(transferStack, app.IBCKeeper.ChannelKeeper, app.MockContractKeeper, maxCallbackGas)
+	transferStack = ibccallbacks.NewIBCMiddlewareWithFailedCallbacks(transferStack, app.IBCKeeper.ChannelKeeper, app.CallbacksKeeper, maxCallbackGas)
 	var transferICS4Wrapper porttypes.ICS4Wrapper
 	transferICS4Wrapper, ok := transferStack.(porttypes.ICS4Wrapper)
 	if !ok {
@@ -426,7 +461,7 @@ func NewSimApp(
 		panic(fmt.Errorf("cannot convert %T to %T", icaControllerStack, app.ICAAuthModule))
 	}
 	icaControllerStack = icacontroller.NewIBCMiddlewareWithAuth(icaControllerStack, app.ICAControllerKeeper)
-	icaControllerStack = ibccallbacks.NewIBCMiddleware(icaControllerStack, app.IBCKeeper.ChannelKeeper, app.MockContractKeeper, maxCallbackGas)
+	icaControllerStack = ibccallbacks.NewIBCMiddlewareWithFailedCallbacks(icaControllerStack, app.IBCKeeper.ChannelKeeper, app.CallbacksKeeper, maxCallbackGas)
 	var icaICS4Wrapper porttypes.ICS4Wrapper
 	icaICS4Wrapper, ok = icaControllerStack.(porttypes.ICS4Wrapper)
 	if !ok {
@@ -446,6 +481,25 @@ func NewSimApp(
 		AddRoute(icahosttypes.SubModuleName, icaHostStack).
 		AddRoute(ibcmock.ModuleName+icacontrollertypes.SubModuleName, icaControllerStack) // ica with mock auth module stack route to ica (top level of middleware stack)
 
+	// Create Async ICQ Stack
+	// SendPacket, since it is originating from the application to core IBC:
+	// icqKeeper.SendInterchainQuery -> callbacks.SendPacket -> channel.SendPacket
+
+	// The query responses are surfaced to the sender by the callbacks middleware on acknowledgement:
+	// channel.OnAcknowledgementPacket -> callbacks.OnAcknowledgementPacket -> icq.OnAcknowledgementPacket
+	var icqStack porttypes.IBCModule
+	icqStack = icq.NewIBCModule(app.ICQKeeper)
+	icqStack = ibccallbacks.NewIBCMiddlewareWithFailedCallbacks(icqStack, app.IBCKeeper.ChannelKeeper, app.CallbacksKeeper, maxCallbackGas)
+	var icqICS4Wrapper porttypes.ICS4Wrapper
+	icqICS4Wrapper, ok = icqStack.(porttypes.ICS4Wrapper)
+	if !ok {
+		panic(fmt.Errorf("cannot convert %T to %T", icqStack, icqICS4Wrapper))
+	}
+	// Since the callbacks middleware itself is an ics4wrapper, it needs to be passed to the async icq keeper
+	app.ICQKeeper.WithICS4Wrapper(icqICS4Wrapper)
+
+	ibcRouter.AddRoute(icqtypes.PortID, icqStack)
+
 	// OnRecvPacket, message that originates from core IBC and goes down to app, the flow is the otherway
 	// channel.RecvPacket -> callbacks.OnRecvPacket -> mockModule.OnRecvPacket
 
@@ -453,9 +507,13 @@ func NewSimApp(
 	// mockModule.OnAcknowledgementPacket -> callbacks.OnAcknowledgementPacket -> channel.OnAcknowledgementPacket
 
 	// add transfer v2 module wrapped by callbacks v2 middleware
-	cbTransferModulev2 := ibccallbacksv2.NewIBCMiddleware(transferv2.NewIBCModule(app.TransferKeeper), app.IBCKeeper.ChannelKeeperV2, app.MockContractKeeper, app.IBCKeeper.ChannelKeeperV2, maxCallbackGas)
+	cbTransferModulev2 := ibccallbacksv2.NewIBCMiddlewareWithFailedCallbacks(transferv2.NewIBCModule(app.TransferKeeper), app.IBCKeeper.ChannelKeeperV2, app.CallbacksKeeper, app.IBCKeeper.ChannelKeeperV2, maxCallbackGas)
 	ibcRouterV2.AddRoute(ibctransfertypes.PortID, cbTransferModulev2)
 
+	// add async icq v2 module wrapped by callbacks v2 middleware
+	cbICQModulev2 := ibccallbacksv2.NewIBCMiddlewareWithFailedCallbacks(icqv2.NewIBCModule(app.ICQKeeper), app.IBCKeeper.ChannelKeeperV2, app.CallbacksKeeper, app.IBCKeeper.ChannelKeeperV2, maxCallbackGas)
+	ibcRouterV2.AddRoute(icqtypes.PortID, cbICQModulev2)
+
 	// Seal the IBC Router
 	app.IBCKeeper.SetRouter(ibcRouter)
 	app.IBCKeeper.SetRouterV2(ibcRouterV2)
@@ -493,7 +551,9 @@ func NewSimApp(
 		// IBC modules
 		ibc.NewAppModule(app.IBCKeeper),
 		transfer.NewAppModule(app.TransferKeeper),
+		icq.NewAppModule(app.ICQKeeper),
 		ica.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper),
+		ibccallbacks.NewAppModule(app.CallbacksKeeper),
 		mockModule,
 
 		// IBC light clients
@@ -537,6 +597,7 @@ func NewSimApp(
 		ibctransfertypes.ModuleName,
 		genutiltypes.ModuleName,
 		icatypes.ModuleName,
+		ibccallbackstypes.ModuleName,
 		ibcmock.ModuleName,
 	)
 	app.ModuleManager.SetOrderEndBlockers(
@@ -556,8 +617,8 @@ func NewSimApp(
 		authtypes.ModuleName,
 		banktypes.ModuleName, distrtypes.ModuleName, stakingtypes.ModuleName,
 		slashingtypes.ModuleName, govtypes.ModuleName, minttypes.ModuleName,
-		ibcexported.ModuleName, genutiltypes.ModuleName, ibctransfertypes.ModuleName,
-		icatypes.ModuleName, ibcmock.ModuleName, paramstypes.ModuleName, upgradetypes.ModuleName,
+		ibcexported.ModuleName, genutiltypes.ModuleName, ibctransfertypes.ModuleName, icqtypes.ModuleName,
+		icatypes.ModuleName, ibccallbackstypes.ModuleName, ibcmock.ModuleName, paramstypes.ModuleName, upgradetypes.ModuleName,
 		vestingtypes.ModuleName, consensusparamtypes.ModuleName,
 	}
 	app.ModuleManager.SetOrderInitGenesis(genesisModuleOrder...)
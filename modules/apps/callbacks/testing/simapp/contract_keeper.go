@@ -14,11 +14,19 @@ import (
 	ibcmock "github.com/cosmos/ibc-go/v10/testing/mock"
 )
 
-// MockKeeper implements callbacktypes.ContractKeeper
-var _ callbacktypes.ContractKeeper = (*ContractKeeper)(nil)
+// MockKeeper implements callbacktypes.ContractKeeper, callbacktypes.ReceiveResultContractKeeper
+// and callbacktypes.ContractResolverKeeper
+var (
+	_ callbacktypes.ContractKeeper              = (*ContractKeeper)(nil)
+	_ callbacktypes.ReceiveResultContractKeeper = (*ContractKeeper)(nil)
+	_ callbacktypes.ContractResolverKeeper      = (*ContractKeeper)(nil)
+)
 
 var StatefulCounterKey = "stateful-callback-counter"
 
+// MockCallbackResult is the result returned by successful receive packet callbacks which return a result.
+var MockCallbackResult = []byte("mock callback result")
+
 const (
 	// OogPanicContract is a contract address that will panic out of gas
 	OogPanicContract = "panics out of gas"
@@ -30,6 +38,8 @@ const (
 	ErrorContract = "errors"
 	// SuccessContract is a contract address that will return nil
 	SuccessContract = "success"
+	// UnknownContract is a contract address that is not known to the contract keeper
+	UnknownContract = "unknown"
 )
 
 // This is a mock contract keeper used for testing. It is not wired up to any modules.
@@ -85,6 +95,14 @@ type ContractKeeper struct {
 		contractAddress string,
 		version string,
 	) error
+
+	IBCReceivePacketCallbackWithResultFn func(
+		cachedCtx sdk.Context,
+		packet ibcexported.PacketI,
+		ack ibcexported.Acknowledgement,
+		contractAddress string,
+		version string,
+	) ([]byte, error)
 }
 
 // SetStateEntryCounter sets state entry counter. The number of stateful
@@ -133,6 +151,13 @@ func NewContractKeeper(key storetypes.StoreKey) *ContractKeeper {
 		return k.ProcessMockCallback(ctx, callbacktypes.CallbackTypeReceivePacket, contractAddress)
 	}
 
+	k.IBCReceivePacketCallbackWithResultFn = func(ctx sdk.Context, _ ibcexported.PacketI, _ ibcexported.Acknowledgement, contractAddress, _ string) ([]byte, error) {
+		if err := k.ProcessMockCallback(ctx, callbacktypes.CallbackTypeReceivePacket, contractAddress); err != nil {
+			return nil, err
+		}
+		return MockCallbackResult, nil
+	}
+
 	return k
 }
 
@@ -211,6 +236,23 @@ func (k ContractKeeper) IBCReceivePacketCallback(
 	return k.IBCReceivePacketCallbackFn(ctx, packet, ack, contractAddress, version)
 }
 
+// IBCReceivePacketCallbackWithResult increments the stateful entry counter and the receive_packet callback counter.
+// This function behaves like IBCReceivePacketCallback and returns MockCallbackResult on success.
+func (k ContractKeeper) IBCReceivePacketCallbackWithResult(
+	ctx sdk.Context,
+	packet ibcexported.PacketI,
+	ack ibcexported.Acknowledgement,
+	contractAddress,
+	version string,
+) ([]byte, error) {
+	return k.IBCReceivePacketCallbackWithResultFn(ctx, packet, ack, contractAddress, version)
+}
+
+// HasContract returns true for every contract address except UnknownContract.
+func (ContractKeeper) HasContract(_ sdk.Context, contractAddress string) bool {
+	return contractAddress != UnknownContract
+}
+
 // ProcessMockCallback processes a mock callback.
 // It increments the stateful entry counter and the callback counter.
 // This function:
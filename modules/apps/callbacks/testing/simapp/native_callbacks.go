@@ -0,0 +1,80 @@
+package simapp
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	callbacksrouter "github.com/cosmos/ibc-go/v10/modules/apps/callbacks/router"
+	callbacktypes "github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+var (
+	_ callbacksrouter.AcknowledgementPacketCallback = (*NativeCallbacksModule)(nil)
+	_ callbacksrouter.TimeoutPacketCallback         = (*NativeCallbacksModule)(nil)
+	_ callbacksrouter.ReceivePacketCallback         = (*NativeCallbacksModule)(nil)
+)
+
+const (
+	// NativeCallbacksModuleName is the name of the mock native module registered with the callbacks router.
+	NativeCallbacksModuleName = "nativecallbacks"
+
+	// NativeCallbackGas is the amount of gas consumed by every callback of the mock native module.
+	NativeCallbackGas = 100_000
+)
+
+var nativeStatefulCounterKey = "native-stateful-callback-counter"
+
+// NativeCallbacksModule is a mock native module which is registered with the callbacks router for testing.
+// It does not implement the send packet callback. The module tracks the number of callbacks executed per
+// callback type and a stateful entry counter, which is used to test state reversals.
+type NativeCallbacksModule struct {
+	key storetypes.StoreKey
+
+	Counters map[callbacktypes.CallbackType]int
+}
+
+// NewNativeCallbacksModule creates a new mock NativeCallbacksModule.
+func NewNativeCallbacksModule(key storetypes.StoreKey) *NativeCallbacksModule {
+	return &NativeCallbacksModule{
+		key:      key,
+		Counters: make(map[callbacktypes.CallbackType]int),
+	}
+}
+
+// GetStateEntryCounter returns the state entry counter stored in state.
+func (m NativeCallbacksModule) GetStateEntryCounter(ctx sdk.Context) uint8 {
+	bz := ctx.KVStore(m.key).Get([]byte(nativeStatefulCounterKey))
+	if bz == nil {
+		return 0
+	}
+	return bz[0]
+}
+
+// IBCOnAcknowledgementPacketCallback implements callbacksrouter.AcknowledgementPacketCallback.
+func (m NativeCallbacksModule) IBCOnAcknowledgementPacketCallback(ctx sdk.Context, _ channeltypes.Packet, _ []byte, _ sdk.AccAddress, _ string) error {
+	m.processCallback(ctx, callbacktypes.CallbackTypeAcknowledgementPacket)
+	return nil
+}
+
+// IBCOnTimeoutPacketCallback implements callbacksrouter.TimeoutPacketCallback.
+func (m NativeCallbacksModule) IBCOnTimeoutPacketCallback(ctx sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress, _ string) error {
+	m.processCallback(ctx, callbacktypes.CallbackTypeTimeoutPacket)
+	return nil
+}
+
+// IBCReceivePacketCallback implements callbacksrouter.ReceivePacketCallback.
+func (m NativeCallbacksModule) IBCReceivePacketCallback(ctx sdk.Context, _ ibcexported.PacketI, _ ibcexported.Acknowledgement, _ string) error {
+	m.processCallback(ctx, callbacktypes.CallbackTypeReceivePacket)
+	return nil
+}
+
+// processCallback increments the callback and stateful entry counters and consumes NativeCallbackGas.
+func (m NativeCallbacksModule) processCallback(ctx sdk.Context, callbackType callbacktypes.CallbackType) {
+	m.Counters[callbackType]++
+	ctx.KVStore(m.key).Set([]byte(nativeStatefulCounterKey), []byte{m.GetStateEntryCounter(ctx) + 1})
+
+	ctx.GasMeter().ConsumeGas(NativeCallbackGas, "mock native callback")
+}
@@ -6,12 +6,14 @@ import (
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/testing/simapp"
 	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
 	ibctesting "github.com/cosmos/ibc-go/v10/testing"
 )
 
@@ -173,6 +175,199 @@ func (s *CallbacksTestSuite) TestTransferTimeoutCallbacks() {
 	}
 }
 
+func (s *CallbacksTestSuite) TestTransferNativeModuleCallbacks() {
+	nativeModuleAddress := authtypes.NewModuleAddress(simapp.NativeCallbacksModuleName)
+
+	testCases := []struct {
+		name           string
+		memo           string
+		expSendError   error
+		expAckCallback bool
+	}{
+		{
+			"success: source callback",
+			fmt.Sprintf(`{"src_callback": {"address": "%s"}}`, nativeModuleAddress),
+			nil,
+			true,
+		},
+		{
+			"failure: source callback runs out of gas",
+			fmt.Sprintf(`{"src_callback": {"address": "%s", "gas_limit": "%d"}}`, nativeModuleAddress, simapp.NativeCallbackGas/2),
+			nil,
+			false,
+		},
+		{
+			"failure: source callback for a packet not sent by the module account",
+			fmt.Sprintf(`{"src_callback": {"address": "%s"}}`, nativeModuleAddress),
+			ibcerrors.ErrUnauthorized,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTransferTest()
+
+			sender := nativeModuleAddress
+			if tc.expSendError != nil {
+				sender = s.chainA.SenderAccount.GetAddress()
+			}
+
+			// fund the native module account so that it can send the transfer
+			ctx := s.chainA.GetContext()
+			err := GetSimApp(s.chainA).BankKeeper.SendCoins(ctx, s.chainA.SenderAccount.GetAddress(), nativeModuleAddress, sdk.NewCoins(ibctesting.TestCoin))
+			s.Require().NoError(err)
+
+			msg := transfertypes.NewMsgTransfer(
+				s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID, ibctesting.TestCoin,
+				sender.String(), s.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(1, 100), 0, tc.memo,
+			)
+
+			ctx = s.chainA.GetContext()
+			_, err = GetSimApp(s.chainA).TransferKeeper.Transfer(ctx, msg)
+			if tc.expSendError != nil {
+				s.Require().ErrorIs(err, tc.expSendError)
+				return
+			}
+			s.Require().NoError(err)
+
+			packet, err := ibctesting.ParsePacketFromEvents(ctx.EventManager().ABCIEvents())
+			s.Require().NoError(err)
+
+			s.coordinator.CommitBlock(s.chainA)
+			s.Require().NoError(s.path.RelayPacket(packet))
+
+			nativeModule := GetSimApp(s.chainA).NativeCallbacksModule
+			s.Require().Equal(1, nativeModule.Counters[types.CallbackTypeAcknowledgementPacket])
+			s.Require().Empty(GetSimApp(s.chainA).MockContractKeeper.Counters)
+
+			failedCallbacks := GetSimApp(s.chainA).CallbacksKeeper.GetFailedCallbacksByContract(s.chainA.GetContext(), nativeModuleAddress.String())
+			if tc.expAckCallback {
+				s.Require().Equal(uint8(1), nativeModule.GetStateEntryCounter(s.chainA.GetContext()))
+				s.Require().Empty(failedCallbacks)
+			} else {
+				// the callback state changes are reverted and the callback is stored so that it can be retried
+				s.Require().Equal(uint8(0), nativeModule.GetStateEntryCounter(s.chainA.GetContext()))
+				s.Require().Len(failedCallbacks, 1)
+			}
+		})
+	}
+}
+
+func (s *CallbacksTestSuite) TestTransferNativeModuleDestCallback() {
+	s.SetupTransferTest()
+
+	nativeModuleAddress := authtypes.NewModuleAddress(simapp.NativeCallbacksModuleName)
+	s.ExecuteTransfer(fmt.Sprintf(`{"dest_callback": {"address": "%s"}}`, nativeModuleAddress), true)
+
+	nativeModule := GetSimApp(s.chainB).NativeCallbacksModule
+	s.Require().Equal(1, nativeModule.Counters[types.CallbackTypeReceivePacket])
+	s.Require().Equal(uint8(1), nativeModule.GetStateEntryCounter(s.chainB.GetContext()))
+	s.Require().Empty(GetSimApp(s.chainB).MockContractKeeper.Counters)
+}
+
+func (s *CallbacksTestSuite) TestTransferDestCallbackResult() {
+	var srcCallbackAck []byte
+
+	testCases := []struct {
+		name              string
+		memo              string
+		expRecvSuccess    bool
+		expCallbackResult []byte
+		expSrcCallback    bool
+	}{
+		{
+			"success: dest callback returning result",
+			fmt.Sprintf(`{"dest_callback": {"address": "%s", "return_result": true}}`, simapp.SuccessContract),
+			true,
+			simapp.MockCallbackResult,
+			false,
+		},
+		{
+			"success: source and dest callbacks with dest callback returning result",
+			fmt.Sprintf(`{"src_callback": {"address": "%s"}, "dest_callback": {"address": "%s", "return_result": true}}`, simapp.SuccessContract, simapp.SuccessContract),
+			true,
+			simapp.MockCallbackResult,
+			true,
+		},
+		{
+			"failure: dest callback returning result errors",
+			fmt.Sprintf(`{"dest_callback": {"address": "%s", "return_result": true}}`, simapp.ErrorContract),
+			false,
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTransferTest()
+
+			srcCallbackAck = nil
+			mockContractKeeper := GetSimApp(s.chainA).MockContractKeeper
+			mockContractKeeper.IBCOnAcknowledgementPacketCallbackFn = func(ctx sdk.Context, _ channeltypes.Packet, acknowledgement []byte, _ sdk.AccAddress, contractAddress, _, _ string) error {
+				srcCallbackAck = acknowledgement
+				return mockContractKeeper.ProcessMockCallback(ctx, types.CallbackTypeAcknowledgementPacket, contractAddress)
+			}
+
+			senderBalance := GetSimApp(s.chainA).BankKeeper.GetBalance(s.chainA.GetContext(), s.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+			msg := transfertypes.NewMsgTransfer(
+				s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID, ibctesting.TestCoin,
+				s.chainA.SenderAccount.GetAddress().String(), s.chainB.SenderAccount.GetAddress().String(),
+				clienttypes.NewHeight(1, 100), 0, tc.memo,
+			)
+
+			res, err := s.chainA.SendMsgs(msg)
+			s.Require().NoError(err)
+
+			packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+			s.Require().NoError(err)
+
+			err = s.path.EndpointB.UpdateClient()
+			s.Require().NoError(err)
+
+			res, err = s.path.EndpointB.RecvPacketWithResult(packet)
+			s.Require().NoError(err)
+
+			acknowledgement, err := ibctesting.ParseAckFromEvents(res.Events)
+			s.Require().NoError(err)
+
+			err = s.path.EndpointA.AcknowledgePacket(packet, acknowledgement)
+			s.Require().NoError(err)
+
+			if tc.expRecvSuccess {
+				// the callback result is returned together with the transfer acknowledgement
+				resultAck, err := types.UnmarshalCallbackResultAcknowledgement(acknowledgement)
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expCallbackResult, resultAck.CallbackResult)
+				s.Require().Equal(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(), resultAck.AppAcknowledgement)
+
+				// the transfer application processes its own acknowledgement, so the tokens are not refunded
+				s.Require().Equal(senderBalance.Sub(ibctesting.TestCoin), GetSimApp(s.chainA).BankKeeper.GetBalance(s.chainA.GetContext(), s.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom))
+				s.Require().Equal(uint8(1), GetSimApp(s.chainB).MockContractKeeper.GetStateEntryCounter(s.chainB.GetContext()))
+			} else {
+				var ack channeltypes.Acknowledgement
+				err = transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack)
+				s.Require().NoError(err)
+				s.Require().False(ack.Success())
+
+				// the tokens are refunded
+				s.Require().Equal(senderBalance, GetSimApp(s.chainA).BankKeeper.GetBalance(s.chainA.GetContext(), s.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom))
+				s.Require().Equal(uint8(0), GetSimApp(s.chainB).MockContractKeeper.GetStateEntryCounter(s.chainB.GetContext()))
+			}
+			s.Require().Equal(1, GetSimApp(s.chainB).MockContractKeeper.Counters[types.CallbackTypeReceivePacket])
+
+			// the source callback receives the full acknowledgement including the callback result
+			if tc.expSrcCallback {
+				s.Require().Equal(acknowledgement, srcCallbackAck)
+			} else {
+				s.Require().Nil(srcCallbackAck)
+			}
+		})
+	}
+}
+
 // ExecuteTransfer executes a transfer message on chainA for ibctesting.TestCoin (100 "stake").
 // It checks that the transfer is successful and that the packet is relayed to chainB.
 func (s *CallbacksTestSuite) ExecuteTransfer(memo string, recvSuccess bool) {
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"bytes"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+var _ ibcexported.Acknowledgement = (*CallbackResultAcknowledgement)(nil)
+
+// NewCallbackResultAcknowledgement creates a new CallbackResultAcknowledgement wrapping the success acknowledgement
+// of the underlying application and the result of the destination callback.
+func NewCallbackResultAcknowledgement(appAcknowledgement, callbackResult []byte) CallbackResultAcknowledgement {
+	return CallbackResultAcknowledgement{
+		AppAcknowledgement: appAcknowledgement,
+		CallbackResult:     callbackResult,
+	}
+}
+
+// ValidateBasic performs a basic validation of the acknowledgement.
+func (ack CallbackResultAcknowledgement) ValidateBasic() error {
+	if len(ack.AppAcknowledgement) == 0 {
+		return errorsmod.Wrap(ErrInvalidAcknowledgement, "app acknowledgement cannot be empty")
+	}
+
+	return nil
+}
+
+// Success implements the Acknowledgement interface. Only success acknowledgements are written with a callback result.
+func (CallbackResultAcknowledgement) Success() bool {
+	return true
+}
+
+// Acknowledgement implements the Acknowledgement interface. It returns the acknowledgement serialised using JSON.
+func (ack CallbackResultAcknowledgement) Acknowledgement() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&ack))
+}
+
+// UnmarshalCallbackResultAcknowledgement unmarshals the provided acknowledgement bytes into a CallbackResultAcknowledgement.
+// An error is returned if the bytes are not a valid JSON encoded CallbackResultAcknowledgement.
+func UnmarshalCallbackResultAcknowledgement(bz []byte) (CallbackResultAcknowledgement, error) {
+	var ack CallbackResultAcknowledgement
+	if err := ModuleCdc.UnmarshalJSON(bz, &ack); err != nil {
+		return CallbackResultAcknowledgement{}, errorsmod.Wrapf(ErrInvalidAcknowledgement, "cannot unmarshal callback result acknowledgement: %v", err)
+	}
+
+	if err := ack.ValidateBasic(); err != nil {
+		return CallbackResultAcknowledgement{}, err
+	}
+
+	if !bytes.Equal(ack.Acknowledgement(), bz) {
+		return CallbackResultAcknowledgement{}, errorsmod.Wrap(ErrInvalidAcknowledgement, "acknowledgement did not marshal to expected bytes")
+	}
+
+	return ack, nil
+}
@@ -0,0 +1,100 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+	ibcmock "github.com/cosmos/ibc-go/v10/testing/mock"
+)
+
+var callbackResult = []byte("callback result")
+
+func TestCallbackResultAcknowledgement(t *testing.T) {
+	appAck := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
+
+	ack := types.NewCallbackResultAcknowledgement(appAck, callbackResult)
+	require.True(t, ack.Success())
+	require.NoError(t, ack.ValidateBasic())
+
+	resultAck, err := types.UnmarshalCallbackResultAcknowledgement(ack.Acknowledgement())
+	require.NoError(t, err)
+	require.Equal(t, ack, resultAck)
+
+	// the callback result is optional
+	ack = types.NewCallbackResultAcknowledgement(appAck, nil)
+	resultAck, err = types.UnmarshalCallbackResultAcknowledgement(ack.Acknowledgement())
+	require.NoError(t, err)
+	require.Equal(t, appAck, resultAck.AppAcknowledgement)
+	require.Empty(t, resultAck.CallbackResult)
+}
+
+func TestUnmarshalCallbackResultAcknowledgement(t *testing.T) {
+	appAck := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
+
+	testCases := []struct {
+		name     string
+		bz       []byte
+		expError error
+	}{
+		{"success", types.NewCallbackResultAcknowledgement(appAck, callbackResult).Acknowledgement(), nil},
+		{"failure: empty bytes", nil, types.ErrInvalidAcknowledgement},
+		{"failure: invalid json", []byte("invalid"), types.ErrInvalidAcknowledgement},
+		{"failure: channel acknowledgement", appAck, types.ErrInvalidAcknowledgement},
+		{"failure: mock acknowledgement", ibctesting.MockAcknowledgement, types.ErrInvalidAcknowledgement},
+		{"failure: empty app acknowledgement", types.NewCallbackResultAcknowledgement(nil, callbackResult).Acknowledgement(), types.ErrInvalidAcknowledgement},
+		{
+			"failure: non-canonical encoding",
+			[]byte(fmt.Sprintf(` {"app_acknowledgement":"%s"}`, "eyJyZXN1bHQiOiJBUT09In0=")),
+			types.ErrInvalidAcknowledgement,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := types.UnmarshalCallbackResultAcknowledgement(tc.bz)
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+func TestReturnsCallbackResult(t *testing.T) {
+	testCases := []struct {
+		name       string
+		packetData any
+		expResult  bool
+	}{
+		{"success: dest callback returning result", transferPacketData(`{"dest_callback": {"address": "cosmos1", "return_result": true}}`), true},
+		{"dest callback without return result", transferPacketData(`{"dest_callback": {"address": "cosmos1"}}`), false},
+		{"dest callback with return result disabled", transferPacketData(`{"dest_callback": {"address": "cosmos1", "return_result": false}}`), false},
+		{"dest callback with return result as string", transferPacketData(`{"dest_callback": {"address": "cosmos1", "return_result": "true"}}`), false},
+		{"src callback returning result", transferPacketData(`{"src_callback": {"address": "cosmos1", "return_result": true}}`), false},
+		{"empty memo", transferPacketData(""), false},
+		{"not a packet data provider", ibcmock.MockPacketData, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expResult, types.ReturnsCallbackResult(tc.packetData))
+		})
+	}
+}
+
+func transferPacketData(memo string) transfertypes.FungibleTokenPacketData {
+	return transfertypes.FungibleTokenPacketData{
+		Denom:    ibctesting.TestCoin.Denom,
+		Amount:   ibctesting.TestCoin.Amount.String(),
+		Sender:   ibctesting.TestAccAddress,
+		Receiver: ibctesting.TestAccAddress,
+		Memo:     memo,
+	}
+}
@@ -74,6 +74,9 @@ type CallbackData struct {
 	CommitGasLimit uint64
 	// ApplicationVersion is the base application version.
 	ApplicationVersion string
+	// ReturnResult is true if the result of the destination callback is returned to the source
+	// in the acknowledgement. It is always false for source callbacks.
+	ReturnResult bool
 }
 
 // GetSourceCallbackData parses the packet data and returns the source callback data.
@@ -143,6 +146,9 @@ func GetCallbackData(
 		}
 	}
 
+	// only destination callbacks can return a result
+	returnResult := callbackKey == DestinationCallbackKey && getReturnResult(callbackData)
+
 	// get the gas limit from the callback data
 	executionGasLimit, commitGasLimit := computeExecAndCommitGasLimit(callbackData, remainingGas, maxGas)
 
@@ -152,6 +158,7 @@ func GetCallbackData(
 		SenderAddress:      packetSender,
 		CommitGasLimit:     commitGasLimit,
 		ApplicationVersion: version,
+		ReturnResult:       returnResult,
 	}, true, nil
 }
 
@@ -212,6 +219,34 @@ func getCallbackAddress(callbackData map[string]any) string {
 	return callbackAddress
 }
 
+// getReturnResult returns true if the callback data requests the callback result to be returned in the
+// acknowledgement. It is assumed that callback data is not nil.
+//
+// The memo is expected to request the callback result in the following format:
+// { "dest_callback": { ... , "return_result": true }
+//
+// Note: the flag must be set as a json boolean.
+func getReturnResult(callbackData map[string]any) bool {
+	returnResult, ok := callbackData[ReturnResultKey].(bool)
+	return ok && returnResult
+}
+
+// ReturnsCallbackResult returns true if the packet data requests the result of the destination callback
+// to be returned in the acknowledgement.
+func ReturnsCallbackResult(packetData any) bool {
+	packetDataProvider, ok := packetData.(ibcexported.PacketDataProvider)
+	if !ok {
+		return false
+	}
+
+	callbackData, ok := packetDataProvider.GetCustomPacketData(DestinationCallbackKey).(map[string]any)
+	if callbackData == nil || !ok {
+		return false
+	}
+
+	return getReturnResult(callbackData)
+}
+
 // AllowRetry returns true if the callback execution gas limit is less than the commit gas limit.
 func (c CallbackData) AllowRetry() bool {
 	return c.ExecutionGasLimit < c.CommitGasLimit
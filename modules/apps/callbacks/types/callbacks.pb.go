@@ -0,0 +1,998 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/callbacks/v1/callbacks.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	types "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FailedCallback defines an acknowledgement or timeout callback whose execution failed, either by
+// returning an error or by running out of gas, after the relayer provided sufficient gas for the packet
+// lifecycle to continue. It can be retried with MsgRetryCallback until its expiry.
+type FailedCallback struct {
+	// callback_type is the type of the failed callback, either acknowledgement_packet or timeout_packet
+	CallbackType string `protobuf:"bytes,1,opt,name=callback_type,json=callbackType,proto3" json:"callback_type,omitempty"`
+	// packet is the packet for which the callback was executed. For IBC v2 packets, the source
+	// and destination channels are the source and destination clients and the data is the payload value
+	Packet types.Packet `protobuf:"bytes,2,opt,name=packet,proto3" json:"packet"`
+	// acknowledgement is the acknowledgement provided to an acknowledgement_packet callback
+	Acknowledgement []byte `protobuf:"bytes,3,opt,name=acknowledgement,proto3" json:"acknowledgement,omitempty"`
+	// relayer is the address of the relayer which relayed the acknowledgement or timeout
+	Relayer string `protobuf:"bytes,4,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	// contract_address is the address of the callback actor
+	ContractAddress string `protobuf:"bytes,5,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// packet_sender_address is the sender of the packet, if known
+	PacketSenderAddress string `protobuf:"bytes,6,opt,name=packet_sender_address,json=packetSenderAddress,proto3" json:"packet_sender_address,omitempty"`
+	// application_version is the base application version of the packet
+	ApplicationVersion string `protobuf:"bytes,7,opt,name=application_version,json=applicationVersion,proto3" json:"application_version,omitempty"`
+	// error is the error returned by the failed callback execution
+	Error string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	// expiry is the time after which the callback can no longer be retried and is pruned
+	Expiry time.Time `protobuf:"bytes,9,opt,name=expiry,proto3,stdtime" json:"expiry"`
+}
+
+func (m *FailedCallback) Reset()         { *m = FailedCallback{} }
+func (m *FailedCallback) String() string { return proto.CompactTextString(m) }
+func (*FailedCallback) ProtoMessage()    {}
+func (*FailedCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7769659511ffe57, []int{0}
+}
+func (m *FailedCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FailedCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FailedCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FailedCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailedCallback.Merge(m, src)
+}
+func (m *FailedCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *FailedCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailedCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailedCallback proto.InternalMessageInfo
+
+func (m *FailedCallback) GetCallbackType() string {
+	if m != nil {
+		return m.CallbackType
+	}
+	return ""
+}
+
+func (m *FailedCallback) GetPacket() types.Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return types.Packet{}
+}
+
+func (m *FailedCallback) GetAcknowledgement() []byte {
+	if m != nil {
+		return m.Acknowledgement
+	}
+	return nil
+}
+
+func (m *FailedCallback) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
+func (m *FailedCallback) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *FailedCallback) GetPacketSenderAddress() string {
+	if m != nil {
+		return m.PacketSenderAddress
+	}
+	return ""
+}
+
+func (m *FailedCallback) GetApplicationVersion() string {
+	if m != nil {
+		return m.ApplicationVersion
+	}
+	return ""
+}
+
+func (m *FailedCallback) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *FailedCallback) GetExpiry() time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return time.Time{}
+}
+
+// CallbackResultAcknowledgement defines the acknowledgement written for a packet whose destination callback
+// returns a result. It wraps the success acknowledgement written by the underlying application and the result
+// returned by the destination callback, which is delivered to the source acknowledgement callback.
+type CallbackResultAcknowledgement struct {
+	// app_acknowledgement is the success acknowledgement written by the underlying application
+	AppAcknowledgement []byte `protobuf:"bytes,1,opt,name=app_acknowledgement,json=appAcknowledgement,proto3" json:"app_acknowledgement,omitempty"`
+	// callback_result is the result returned by the destination callback
+	CallbackResult []byte `protobuf:"bytes,2,opt,name=callback_result,json=callbackResult,proto3" json:"callback_result,omitempty"`
+}
+
+func (m *CallbackResultAcknowledgement) Reset()         { *m = CallbackResultAcknowledgement{} }
+func (m *CallbackResultAcknowledgement) String() string { return proto.CompactTextString(m) }
+func (*CallbackResultAcknowledgement) ProtoMessage()    {}
+func (*CallbackResultAcknowledgement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7769659511ffe57, []int{1}
+}
+func (m *CallbackResultAcknowledgement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CallbackResultAcknowledgement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CallbackResultAcknowledgement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CallbackResultAcknowledgement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallbackResultAcknowledgement.Merge(m, src)
+}
+func (m *CallbackResultAcknowledgement) XXX_Size() int {
+	return m.Size()
+}
+func (m *CallbackResultAcknowledgement) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallbackResultAcknowledgement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallbackResultAcknowledgement proto.InternalMessageInfo
+
+func (m *CallbackResultAcknowledgement) GetAppAcknowledgement() []byte {
+	if m != nil {
+		return m.AppAcknowledgement
+	}
+	return nil
+}
+
+func (m *CallbackResultAcknowledgement) GetCallbackResult() []byte {
+	if m != nil {
+		return m.CallbackResult
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*FailedCallback)(nil), "ibc.applications.callbacks.v1.FailedCallback")
+	proto.RegisterType((*CallbackResultAcknowledgement)(nil), "ibc.applications.callbacks.v1.CallbackResultAcknowledgement")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/callbacks/v1/callbacks.proto", fileDescriptor_b7769659511ffe57)
+}
+
+var fileDescriptor_b7769659511ffe57 = []byte{
+	// 481 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x52, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0x6e, 0xd8, 0xd6, 0x6d, 0x66, 0xac, 0xc8, 0x1b, 0x52, 0x54, 0xb4, 0xb4, 0x8c, 0x03, 0xe5,
+	0x30, 0x9b, 0x8e, 0xd3, 0x24, 0x2e, 0x2b, 0x12, 0x67, 0x94, 0x4d, 0x1c, 0xb8, 0x54, 0x8e, 0xf3,
+	0xc8, 0xac, 0x3a, 0x71, 0x64, 0xbb, 0x81, 0xfc, 0x8b, 0x1d, 0xf9, 0x49, 0x3b, 0xee, 0xc8, 0x09,
+	0x50, 0xfb, 0x47, 0x50, 0xec, 0x66, 0x2b, 0xbd, 0xe5, 0xbd, 0xf7, 0x7d, 0xf9, 0x9e, 0xbf, 0xef,
+	0xa1, 0x33, 0x91, 0x70, 0xca, 0xca, 0x52, 0x0a, 0xce, 0xac, 0x50, 0x85, 0xa1, 0x9c, 0x49, 0x99,
+	0x30, 0x3e, 0x33, 0xb4, 0x1a, 0x3f, 0x16, 0xa4, 0xd4, 0xca, 0x2a, 0x7c, 0x22, 0x12, 0x4e, 0xd6,
+	0xe1, 0xe4, 0x11, 0x51, 0x8d, 0xfb, 0xc7, 0x99, 0xca, 0x94, 0x43, 0xd2, 0xe6, 0xcb, 0x93, 0xfa,
+	0x83, 0x4c, 0xa9, 0x4c, 0x02, 0x75, 0x55, 0x32, 0xff, 0x46, 0xad, 0xc8, 0xc1, 0x58, 0x96, 0x97,
+	0x2b, 0xc0, 0xab, 0x66, 0x09, 0xae, 0x34, 0x50, 0x7e, 0xc3, 0x8a, 0x02, 0xa4, 0x93, 0xf6, 0x9f,
+	0x1e, 0x72, 0xfa, 0x73, 0x0b, 0x1d, 0x7e, 0x62, 0x42, 0x42, 0xfa, 0x71, 0x25, 0x88, 0x5f, 0xa3,
+	0x67, 0xad, 0xf8, 0xd4, 0xd6, 0x25, 0x84, 0xc1, 0x30, 0x18, 0xed, 0xc7, 0x07, 0x6d, 0xf3, 0xba,
+	0x2e, 0x01, 0x5f, 0xa0, 0x6e, 0xc9, 0xf8, 0x0c, 0x6c, 0xf8, 0x64, 0x18, 0x8c, 0x9e, 0x9e, 0xbf,
+	0x24, 0xcd, 0x0b, 0x1a, 0x2d, 0xd2, 0x0a, 0x54, 0x63, 0xf2, 0xd9, 0x41, 0x26, 0xdb, 0x77, 0xbf,
+	0x07, 0x9d, 0x78, 0x45, 0xc0, 0x23, 0xd4, 0x63, 0x7c, 0x56, 0xa8, 0xef, 0x12, 0xd2, 0x0c, 0x72,
+	0x28, 0x6c, 0xb8, 0x35, 0x0c, 0x46, 0x07, 0xf1, 0x66, 0x1b, 0x87, 0x68, 0x57, 0x83, 0x64, 0x35,
+	0xe8, 0x70, 0xdb, 0xed, 0xd0, 0x96, 0xf8, 0x2d, 0x7a, 0xce, 0x55, 0x61, 0x35, 0xe3, 0x76, 0xca,
+	0xd2, 0x54, 0x83, 0x31, 0xe1, 0x8e, 0x83, 0xf4, 0xda, 0xfe, 0xa5, 0x6f, 0xe3, 0x73, 0xf4, 0xc2,
+	0x0b, 0x4f, 0x0d, 0x14, 0x29, 0xe8, 0x07, 0x7c, 0xd7, 0xe1, 0x8f, 0xfc, 0xf0, 0xca, 0xcd, 0x5a,
+	0x0e, 0x45, 0x47, 0x6b, 0x61, 0x4c, 0x2b, 0xd0, 0x46, 0xa8, 0x22, 0xdc, 0x75, 0x0c, 0xbc, 0x36,
+	0xfa, 0xe2, 0x27, 0xf8, 0x18, 0xed, 0x80, 0xd6, 0x4a, 0x87, 0x7b, 0x0e, 0xe2, 0x0b, 0xfc, 0x01,
+	0x75, 0xe1, 0x47, 0x29, 0x74, 0x1d, 0xee, 0x3b, 0x93, 0xfa, 0xc4, 0x27, 0x46, 0xda, 0xc4, 0xc8,
+	0x75, 0x9b, 0xd8, 0x64, 0xaf, 0xf1, 0xe8, 0xf6, 0xcf, 0x20, 0x88, 0x57, 0x9c, 0xd3, 0x1a, 0x9d,
+	0xb4, 0x99, 0xc4, 0x60, 0xe6, 0xd2, 0x5e, 0x6e, 0xd8, 0xe3, 0xb7, 0x9c, 0x6e, 0x9a, 0x19, 0x38,
+	0x33, 0x9b, 0x2d, 0x37, 0x09, 0x6f, 0x50, 0xef, 0x21, 0x59, 0xed, 0x7e, 0xe9, 0xd2, 0x3b, 0x88,
+	0x0f, 0xf9, 0x7f, 0x42, 0x93, 0xab, 0xbb, 0x45, 0x14, 0xdc, 0x2f, 0xa2, 0xe0, 0xef, 0x22, 0x0a,
+	0x6e, 0x97, 0x51, 0xe7, 0x7e, 0x19, 0x75, 0x7e, 0x2d, 0xa3, 0xce, 0xd7, 0x8b, 0x4c, 0xd8, 0x9b,
+	0x79, 0x42, 0xb8, 0xca, 0x29, 0x57, 0x26, 0x57, 0x86, 0x8a, 0x84, 0x9f, 0x65, 0x8a, 0x56, 0xe3,
+	0x77, 0x34, 0x57, 0xe9, 0x5c, 0x82, 0x69, 0x0e, 0x7f, 0xfd, 0xe0, 0x9b, 0x2b, 0x32, 0x49, 0xd7,
+	0xbd, 0xfa, 0xfd, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x04, 0x66, 0x76, 0x33, 0x1b, 0x03, 0x00,
+	0x00,
+}
+
+func (m *FailedCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FailedCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailedCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintCallbacks(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x4a
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintCallbacks(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ApplicationVersion) > 0 {
+		i -= len(m.ApplicationVersion)
+		copy(dAtA[i:], m.ApplicationVersion)
+		i = encodeVarintCallbacks(dAtA, i, uint64(len(m.ApplicationVersion)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.PacketSenderAddress) > 0 {
+		i -= len(m.PacketSenderAddress)
+		copy(dAtA[i:], m.PacketSenderAddress)
+		i = encodeVarintCallbacks(dAtA, i, uint64(len(m.PacketSenderAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintCallbacks(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintCallbacks(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Acknowledgement) > 0 {
+		i -= len(m.Acknowledgement)
+		copy(dAtA[i:], m.Acknowledgement)
+		i = encodeVarintCallbacks(dAtA, i, uint64(len(m.Acknowledgement)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCallbacks(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.CallbackType) > 0 {
+		i -= len(m.CallbackType)
+		copy(dAtA[i:], m.CallbackType)
+		i = encodeVarintCallbacks(dAtA, i, uint64(len(m.CallbackType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CallbackResultAcknowledgement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CallbackResultAcknowledgement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CallbackResultAcknowledgement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CallbackResult) > 0 {
+		i -= len(m.CallbackResult)
+		copy(dAtA[i:], m.CallbackResult)
+		i = encodeVarintCallbacks(dAtA, i, uint64(len(m.CallbackResult)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AppAcknowledgement) > 0 {
+		i -= len(m.AppAcknowledgement)
+		copy(dAtA[i:], m.AppAcknowledgement)
+		i = encodeVarintCallbacks(dAtA, i, uint64(len(m.AppAcknowledgement)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCallbacks(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCallbacks(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FailedCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CallbackType)
+	if l > 0 {
+		n += 1 + l + sovCallbacks(uint64(l))
+	}
+	l = m.Packet.Size()
+	n += 1 + l + sovCallbacks(uint64(l))
+	l = len(m.Acknowledgement)
+	if l > 0 {
+		n += 1 + l + sovCallbacks(uint64(l))
+	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovCallbacks(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovCallbacks(uint64(l))
+	}
+	l = len(m.PacketSenderAddress)
+	if l > 0 {
+		n += 1 + l + sovCallbacks(uint64(l))
+	}
+	l = len(m.ApplicationVersion)
+	if l > 0 {
+		n += 1 + l + sovCallbacks(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovCallbacks(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovCallbacks(uint64(l))
+	return n
+}
+
+func (m *CallbackResultAcknowledgement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AppAcknowledgement)
+	if l > 0 {
+		n += 1 + l + sovCallbacks(uint64(l))
+	}
+	l = len(m.CallbackResult)
+	if l > 0 {
+		n += 1 + l + sovCallbacks(uint64(l))
+	}
+	return n
+}
+
+func sovCallbacks(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCallbacks(x uint64) (n int) {
+	return sovCallbacks(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FailedCallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCallbacks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FailedCallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FailedCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgement", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Acknowledgement = append(m.Acknowledgement[:0], dAtA[iNdEx:postIndex]...)
+			if m.Acknowledgement == nil {
+				m.Acknowledgement = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketSenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketSenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApplicationVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCallbacks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CallbackResultAcknowledgement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCallbacks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CallbackResultAcknowledgement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CallbackResultAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppAcknowledgement", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppAcknowledgement = append(m.AppAcknowledgement[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppAcknowledgement == nil {
+				m.AppAcknowledgement = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackResult", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackResult = append(m.CallbackResult[:0], dAtA[iNdEx:postIndex]...)
+			if m.CallbackResult == nil {
+				m.CallbackResult = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCallbacks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCallbacks(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCallbacks
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCallbacks
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCallbacks
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCallbacks
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCallbacks        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCallbacks          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCallbacks = fmt.Errorf("proto: unexpected end of group")
+)
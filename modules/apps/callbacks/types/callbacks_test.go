@@ -110,6 +110,77 @@ func (s *CallbacksTypesTestSuite) TestGetCallbackData() {
 			true,
 			nil,
 		},
+		{
+			"success: destination callback returning result",
+			func() {
+				callbackKey = types.DestinationCallbackKey
+
+				remainingGas = 2_000_000
+				packetData = transfertypes.FungibleTokenPacketData{
+					Denom:    ibctesting.TestCoin.Denom,
+					Amount:   ibctesting.TestCoin.Amount.String(),
+					Sender:   sender,
+					Receiver: receiver,
+					Memo:     fmt.Sprintf(`{"dest_callback": {"address": "%s", "return_result": true}}`, sender),
+				}
+			},
+			types.CallbackData{
+				CallbackAddress:    sender,
+				SenderAddress:      "",
+				ExecutionGasLimit:  1_000_000,
+				CommitGasLimit:     1_000_000,
+				ApplicationVersion: transfertypes.V1,
+				ReturnResult:       true,
+			},
+			true,
+			nil,
+		},
+		{
+			"success: destination callback with return result not set as json boolean",
+			func() {
+				callbackKey = types.DestinationCallbackKey
+
+				remainingGas = 2_000_000
+				packetData = transfertypes.FungibleTokenPacketData{
+					Denom:    ibctesting.TestCoin.Denom,
+					Amount:   ibctesting.TestCoin.Amount.String(),
+					Sender:   sender,
+					Receiver: receiver,
+					Memo:     fmt.Sprintf(`{"dest_callback": {"address": "%s", "return_result": "true"}}`, sender),
+				}
+			},
+			types.CallbackData{
+				CallbackAddress:    sender,
+				SenderAddress:      "",
+				ExecutionGasLimit:  1_000_000,
+				CommitGasLimit:     1_000_000,
+				ApplicationVersion: transfertypes.V1,
+			},
+			true,
+			nil,
+		},
+		{
+			"success: source callback ignores return result",
+			func() {
+				remainingGas = 2_000_000
+				packetData = transfertypes.FungibleTokenPacketData{
+					Denom:    ibctesting.TestCoin.Denom,
+					Amount:   ibctesting.TestCoin.Amount.String(),
+					Sender:   sender,
+					Receiver: receiver,
+					Memo:     fmt.Sprintf(`{"src_callback": {"address": "%s", "return_result": true}}`, sender),
+				}
+			},
+			types.CallbackData{
+				CallbackAddress:    sender,
+				SenderAddress:      sender,
+				ExecutionGasLimit:  1_000_000,
+				CommitGasLimit:     1_000_000,
+				ApplicationVersion: transfertypes.V1,
+			},
+			true,
+			nil,
+		},
 		{
 			"success: source callback with gas limit < remaining gas < max gas",
 			func() {
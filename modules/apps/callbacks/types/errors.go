@@ -5,10 +5,16 @@ import (
 )
 
 var (
-	ErrCannotUnmarshalPacketData = errorsmod.Register(ModuleName, 2, "cannot unmarshal packet data")
-	ErrNotPacketDataProvider     = errorsmod.Register(ModuleName, 3, "packet is not a PacketDataProvider")
-	ErrCallbackKeyNotFound       = errorsmod.Register(ModuleName, 4, "callback key not found in packet data")
-	ErrCallbackAddressNotFound   = errorsmod.Register(ModuleName, 5, "callback address not found in packet data")
-	ErrCallbackOutOfGas          = errorsmod.Register(ModuleName, 6, "callback out of gas")
-	ErrCallbackPanic             = errorsmod.Register(ModuleName, 7, "callback panic")
+	ErrCannotUnmarshalPacketData  = errorsmod.Register(ModuleName, 2, "cannot unmarshal packet data")
+	ErrNotPacketDataProvider      = errorsmod.Register(ModuleName, 3, "packet is not a PacketDataProvider")
+	ErrCallbackKeyNotFound        = errorsmod.Register(ModuleName, 4, "callback key not found in packet data")
+	ErrCallbackAddressNotFound    = errorsmod.Register(ModuleName, 5, "callback address not found in packet data")
+	ErrCallbackOutOfGas           = errorsmod.Register(ModuleName, 6, "callback out of gas")
+	ErrCallbackPanic              = errorsmod.Register(ModuleName, 7, "callback panic")
+	ErrFailedCallbackNotFound     = errorsmod.Register(ModuleName, 8, "failed callback not found")
+	ErrFailedCallbackExpired      = errorsmod.Register(ModuleName, 9, "failed callback expired")
+	ErrInvalidFailedCallback      = errorsmod.Register(ModuleName, 10, "invalid failed callback")
+	ErrCallbackHandlerNotFound    = errorsmod.Register(ModuleName, 11, "callback handler not found")
+	ErrCallbackResultNotSupported = errorsmod.Register(ModuleName, 12, "callback result not supported")
+	ErrInvalidAcknowledgement     = errorsmod.Register(ModuleName, 13, "invalid callback result acknowledgement")
 )
@@ -99,6 +99,38 @@ type ContractKeeper interface {
 	) error
 }
 
+// ReceiveResultContractKeeper defines an optional interface which a ContractKeeper may implement to return a
+// result from the destination callback to the source of the packet.
+type ReceiveResultContractKeeper interface {
+	// IBCReceivePacketCallbackWithResult is called in the destination chain instead of IBCReceivePacketCallback
+	// for packets which request the callback result by setting "return_result" in their destination callback
+	// data. The returned result is written together with the success acknowledgement of the underlying
+	// application in a CallbackResultAcknowledgement, which is provided to the source acknowledgement callback.
+	// The contract is expected to handle the callback within the user defined gas limit, and handle any errors,
+	// out of gas, or panics gracefully.
+	// This entry point is called with a cached context. If an error is returned, then the changes in
+	// this context will not be persisted.
+	//
+	// The version provided is the base application version for the given packet send. This allows
+	// contracts to determine how to unmarshal the packetData.
+	IBCReceivePacketCallbackWithResult(
+		cachedCtx sdk.Context,
+		packet ibcexported.PacketI,
+		ack ibcexported.Acknowledgement,
+		contractAddress string,
+		version string,
+	) ([]byte, error)
+}
+
+// ContractResolverKeeper defines an optional interface which a ContractKeeper may implement to report
+// whether a callback address belongs to a known contract. Source callbacks whose execution failed are
+// only stored for retrying if the ContractKeeper implements this interface and knows the contract.
+type ContractResolverKeeper interface {
+	// HasContract returns true if the given callback address belongs to a contract which
+	// can be called by the ContractKeeper.
+	HasContract(ctx sdk.Context, contractAddress string) bool
+}
+
 type ChannelKeeperV2 interface {
 	GetAsyncPacket(
 		ctx sdk.Context,
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+)
+
+// NewFailedCallback creates a new FailedCallback instance for a source callback of the given packet.
+// The acknowledgement is only set for acknowledgement callbacks.
+func NewFailedCallback(
+	callbackType CallbackType,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+	callbackData CallbackData,
+	err error,
+	expiry time.Time,
+) FailedCallback {
+	return FailedCallback{
+		CallbackType:        string(callbackType),
+		Packet:              packet,
+		Acknowledgement:     acknowledgement,
+		Relayer:             relayer.String(),
+		ContractAddress:     callbackData.CallbackAddress,
+		PacketSenderAddress: callbackData.SenderAddress,
+		ApplicationVersion:  callbackData.ApplicationVersion,
+		Error:               err.Error(),
+		Expiry:              expiry,
+	}
+}
+
+// Key returns the store key under which the failed callback is stored.
+func (fc FailedCallback) Key() []byte {
+	return FailedCallbackKey(fc.ContractAddress, fc.Packet.SourcePort, fc.Packet.SourceChannel, fc.Packet.Sequence)
+}
+
+// IsExpired returns true if the failed callback can no longer be retried at the given block time.
+func (fc FailedCallback) IsExpired(blockTime time.Time) bool {
+	return !blockTime.Before(fc.Expiry)
+}
+
+// Validate performs a basic validation of the FailedCallback fields.
+func (fc FailedCallback) Validate() error {
+	switch CallbackType(fc.CallbackType) {
+	case CallbackTypeAcknowledgementPacket:
+	case CallbackTypeTimeoutPacket:
+		if len(fc.Acknowledgement) != 0 {
+			return errorsmod.Wrap(ErrInvalidFailedCallback, "acknowledgement must be empty for timeout callbacks")
+		}
+	default:
+		return errorsmod.Wrapf(ErrInvalidFailedCallback, "callback type %s cannot be retried", fc.CallbackType)
+	}
+
+	if err := validateFailedCallbackID(fc.ContractAddress, fc.Packet.SourcePort, fc.Packet.SourceChannel, fc.Packet.Sequence); err != nil {
+		return err
+	}
+
+	// the relayer may be unknown, in which case it is empty
+	if fc.Relayer != "" {
+		if _, err := sdk.AccAddressFromBech32(fc.Relayer); err != nil {
+			return errorsmod.Wrapf(ErrInvalidFailedCallback, "invalid relayer address: %v", err)
+		}
+	}
+
+	if fc.Expiry.IsZero() {
+		return errorsmod.Wrap(ErrInvalidFailedCallback, "expiry cannot be zero")
+	}
+
+	return nil
+}
+
+// validateFailedCallbackID validates the fields identifying a failed callback.
+func validateFailedCallbackID(contractAddress, portID, channelID string, sequence uint64) error {
+	if strings.TrimSpace(contractAddress) == "" {
+		return errorsmod.Wrap(ErrCallbackAddressNotFound, "contract address cannot be empty")
+	}
+
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return errorsmod.Wrapf(ErrInvalidFailedCallback, "invalid port ID %s: %v", portID, err)
+	}
+
+	// the channel ID is the channel identifier for IBC v1 packets and the client identifier for IBC v2 packets
+	if err := host.ClientIdentifierValidator(channelID); err != nil {
+		return errorsmod.Wrapf(ErrInvalidFailedCallback, "invalid channel or client ID %s: %v", channelID, err)
+	}
+
+	if sequence == 0 {
+		return errorsmod.Wrap(ErrInvalidFailedCallback, "packet sequence cannot be 0")
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+var expiry = time.Unix(1_700_000_000, 0).UTC()
+
+func newFailedCallback(sequence uint64) types.FailedCallback {
+	packet := channeltypes.NewPacket(
+		ibctesting.MockPacketData, sequence, ibctesting.MockPort, ibctesting.FirstChannelID,
+		ibctesting.MockPort, ibctesting.FirstChannelID, clienttypes.ZeroHeight(), 1,
+	)
+	callbackData := types.CallbackData{
+		CallbackAddress: ibctesting.TestAccAddress,
+		SenderAddress:   ibctesting.TestAccAddress,
+	}
+
+	return types.NewFailedCallback(
+		types.CallbackTypeAcknowledgementPacket, packet, ibctesting.MockAcknowledgement, nil,
+		callbackData, errors.New("callback failed"), expiry,
+	)
+}
+
+func TestFailedCallbackValidate(t *testing.T) {
+	var failedCallback types.FailedCallback
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{"success", func() {}, nil},
+		{"success: relayer", func() { failedCallback.Relayer = ibctesting.TestAccAddress }, nil},
+		{"success: timeout callback", func() {
+			failedCallback.CallbackType = string(types.CallbackTypeTimeoutPacket)
+			failedCallback.Acknowledgement = nil
+		}, nil},
+		{"success: client identifier", func() { failedCallback.Packet.SourceChannel = ibctesting.FirstClientID }, nil},
+		{"failure: receive callback", func() { failedCallback.CallbackType = string(types.CallbackTypeReceivePacket) }, types.ErrInvalidFailedCallback},
+		{"failure: timeout callback with acknowledgement", func() { failedCallback.CallbackType = string(types.CallbackTypeTimeoutPacket) }, types.ErrInvalidFailedCallback},
+		{"failure: empty contract address", func() { failedCallback.ContractAddress = " " }, types.ErrCallbackAddressNotFound},
+		{"failure: invalid port", func() { failedCallback.Packet.SourcePort = "" }, types.ErrInvalidFailedCallback},
+		{"failure: invalid channel", func() { failedCallback.Packet.SourceChannel = "" }, types.ErrInvalidFailedCallback},
+		{"failure: zero sequence", func() { failedCallback.Packet.Sequence = 0 }, types.ErrInvalidFailedCallback},
+		{"failure: invalid relayer", func() { failedCallback.Relayer = ibctesting.InvalidID }, types.ErrInvalidFailedCallback},
+		{"failure: zero expiry", func() { failedCallback.Expiry = time.Time{} }, types.ErrInvalidFailedCallback},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			failedCallback = newFailedCallback(1)
+
+			tc.malleate()
+
+			err := failedCallback.Validate()
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+func TestFailedCallbackIsExpired(t *testing.T) {
+	failedCallback := newFailedCallback(1)
+
+	require.False(t, failedCallback.IsExpired(expiry.Add(-time.Nanosecond)))
+	require.True(t, failedCallback.IsExpired(expiry))
+	require.True(t, failedCallback.IsExpired(expiry.Add(time.Nanosecond)))
+}
+
+func TestMsgRetryCallbackValidateBasic(t *testing.T) {
+	var msg *types.MsgRetryCallback
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{"success", func() {}, nil},
+		{"failure: invalid signer", func() { msg.Signer = ibctesting.InvalidID }, ibcerrors.ErrInvalidAddress},
+		{"failure: empty contract address", func() { msg.ContractAddress = "" }, types.ErrCallbackAddressNotFound},
+		{"failure: invalid port", func() { msg.PortId = "" }, types.ErrInvalidFailedCallback},
+		{"failure: invalid channel", func() { msg.ChannelId = "" }, types.ErrInvalidFailedCallback},
+		{"failure: zero sequence", func() { msg.Sequence = 0 }, types.ErrInvalidFailedCallback},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg = types.NewMsgRetryCallback(ibctesting.TestAccAddress, ibctesting.TestAccAddress, ibctesting.MockPort, ibctesting.FirstChannelID, 1)
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+func TestGenesisStateValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expError error
+	}{
+		{"success: default", types.DefaultGenesisState(), nil},
+		{"success: failed callbacks", types.NewGenesisState([]types.FailedCallback{newFailedCallback(1), newFailedCallback(2)}), nil},
+		{"failure: invalid failed callback", types.NewGenesisState([]types.FailedCallback{{}}), types.ErrInvalidFailedCallback},
+		{"failure: duplicate failed callback", types.NewGenesisState([]types.FailedCallback{newFailedCallback(1), newFailedCallback(1)}), types.ErrInvalidFailedCallback},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// NewGenesisState creates a new ibc-callbacks GenesisState instance.
+func NewGenesisState(failedCallbacks []FailedCallback) *GenesisState {
+	return &GenesisState{
+		FailedCallbacks: failedCallbacks,
+	}
+}
+
+// DefaultGenesisState returns a GenesisState with no failed callbacks.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		FailedCallbacks: []FailedCallback{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenFailedCallbacks := make(map[string]bool)
+	for i, failedCallback := range gs.FailedCallbacks {
+		if err := failedCallback.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "invalid failed callback %d", i)
+		}
+
+		key := string(failedCallback.Key())
+		if seenFailedCallbacks[key] {
+			return errorsmod.Wrapf(ErrInvalidFailedCallback, "duplicate failed callback %s", key)
+		}
+		seenFailedCallbacks[key] = true
+	}
+
+	return nil
+}
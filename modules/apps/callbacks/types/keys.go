@@ -1,10 +1,33 @@
 package types
 
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 type CallbackType string
 
 const (
 	ModuleName = "ibccallbacks"
 
+	// StoreKey is the store key string for the ibc-callbacks middleware. The module name is not used
+	// as it has the ibc store key as a prefix.
+	StoreKey = "callbacks"
+
+	// RouterKey is the message route for the ibc-callbacks middleware
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the ibc-callbacks middleware
+	QuerierRoute = ModuleName
+
+	// KeyFailedCallbackPrefix is the store key prefix under which failed callbacks are stored
+	KeyFailedCallbackPrefix = "failedCallback"
+
+	// KeyFailedCallbackExpiryPrefix is the store key prefix under which failed callbacks are indexed by expiry
+	KeyFailedCallbackExpiryPrefix = "failedCallbackExpiry"
+
 	CallbackTypeSendPacket            CallbackType = "send_packet"
 	CallbackTypeAcknowledgementPacket CallbackType = "acknowledgement_packet"
 	CallbackTypeTimeoutPacket         CallbackType = "timeout_packet"
@@ -28,4 +51,35 @@ const (
 	// The expected format for ICS20 and ICS27 memo field is as follows:
 	// { "{callbackKey}": { ... , "gas_limit": {stringForCallback} }
 	UserDefinedGasLimitKey = "gas_limit"
+	// Destination callbacks' packet data may request the result of the callback to be returned in the
+	// acknowledgement under this key. The expected format for ICS20 memo field is as follows:
+	// { "dest_callback": { ... , "return_result": true } }
+	ReturnResultKey = "return_result"
 )
+
+// FailedCallbackKey returns the store key under which the failed callback of the given contract
+// for the packet with the given source port, source channel (or client) and sequence is stored.
+func FailedCallbackKey(contractAddress, portID, channelID string, sequence uint64) []byte {
+	return fmt.Appendf(FailedCallbackContractPrefix(contractAddress), "%s/%s/%d", portID, channelID, sequence)
+}
+
+// FailedCallbackContractPrefix returns the store key prefix under which all failed callbacks
+// of the given contract are stored. The contract address is prefixed with its length so that
+// the prefix of a contract address never matches the failed callbacks of another contract.
+func FailedCallbackContractPrefix(contractAddress string) []byte {
+	key := fmt.Appendf(nil, "%s/", KeyFailedCallbackPrefix)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(len(contractAddress)))...)
+	return fmt.Appendf(key, "%s/", contractAddress)
+}
+
+// FailedCallbackExpiryPrefix returns the store key prefix under which the failed callbacks expiring
+// at the given time are indexed. The expiry is encoded in big endian so that the index is ordered by expiry.
+func FailedCallbackExpiryPrefix(expiry time.Time) []byte {
+	return append(fmt.Appendf(nil, "%s/", KeyFailedCallbackExpiryPrefix), sdk.Uint64ToBigEndian(uint64(expiry.UnixNano()))...)
+}
+
+// FailedCallbackExpiryKey returns the store key under which the failed callback stored under
+// the given failed callback key is indexed by its expiry.
+func FailedCallbackExpiryKey(expiry time.Time, failedCallbackKey []byte) []byte {
+	return append(append(FailedCallbackExpiryPrefix(expiry), '/'), failedCallbackKey...)
+}
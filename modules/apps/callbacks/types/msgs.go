@@ -0,0 +1,34 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+)
+
+var (
+	_ sdk.Msg              = (*MsgRetryCallback)(nil)
+	_ sdk.HasValidateBasic = (*MsgRetryCallback)(nil)
+)
+
+// NewMsgRetryCallback creates a new MsgRetryCallback instance
+func NewMsgRetryCallback(signer, contractAddress, portID, channelID string, sequence uint64) *MsgRetryCallback {
+	return &MsgRetryCallback{
+		Signer:          signer,
+		ContractAddress: contractAddress,
+		PortId:          portID,
+		ChannelId:       channelID,
+		Sequence:        sequence,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRetryCallback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return validateFailedCallbackID(msg.ContractAddress, msg.PortId, msg.ChannelId, msg.Sequence)
+}
@@ -0,0 +1,1262 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/callbacks/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryFailedCallbackRequest is the request type for the Query/FailedCallback RPC method.
+type QueryFailedCallbackRequest struct {
+	// contract_address is the address of the callback actor
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// port_id is the source port of the packet
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel_id is the source channel (IBC v1) or client (IBC v2) of the packet
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sequence is the packet sequence
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *QueryFailedCallbackRequest) Reset()         { *m = QueryFailedCallbackRequest{} }
+func (m *QueryFailedCallbackRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFailedCallbackRequest) ProtoMessage()    {}
+func (*QueryFailedCallbackRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e264909e6193ff2, []int{0}
+}
+func (m *QueryFailedCallbackRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFailedCallbackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFailedCallbackRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFailedCallbackRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailedCallbackRequest.Merge(m, src)
+}
+func (m *QueryFailedCallbackRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFailedCallbackRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailedCallbackRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFailedCallbackRequest proto.InternalMessageInfo
+
+func (m *QueryFailedCallbackRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *QueryFailedCallbackRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryFailedCallbackRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryFailedCallbackRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// QueryFailedCallbackResponse is the response type for the Query/FailedCallback RPC method.
+type QueryFailedCallbackResponse struct {
+	// failed_callback returns the failed callback
+	FailedCallback FailedCallback `protobuf:"bytes,1,opt,name=failed_callback,json=failedCallback,proto3" json:"failed_callback"`
+}
+
+func (m *QueryFailedCallbackResponse) Reset()         { *m = QueryFailedCallbackResponse{} }
+func (m *QueryFailedCallbackResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFailedCallbackResponse) ProtoMessage()    {}
+func (*QueryFailedCallbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e264909e6193ff2, []int{1}
+}
+func (m *QueryFailedCallbackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFailedCallbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFailedCallbackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFailedCallbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailedCallbackResponse.Merge(m, src)
+}
+func (m *QueryFailedCallbackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFailedCallbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailedCallbackResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFailedCallbackResponse proto.InternalMessageInfo
+
+func (m *QueryFailedCallbackResponse) GetFailedCallback() FailedCallback {
+	if m != nil {
+		return m.FailedCallback
+	}
+	return FailedCallback{}
+}
+
+// QueryFailedCallbacksRequest is the request type for the Query/FailedCallbacks RPC method.
+type QueryFailedCallbacksRequest struct {
+	// contract_address is the address of the callback actor
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFailedCallbacksRequest) Reset()         { *m = QueryFailedCallbacksRequest{} }
+func (m *QueryFailedCallbacksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFailedCallbacksRequest) ProtoMessage()    {}
+func (*QueryFailedCallbacksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e264909e6193ff2, []int{2}
+}
+func (m *QueryFailedCallbacksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFailedCallbacksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFailedCallbacksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFailedCallbacksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailedCallbacksRequest.Merge(m, src)
+}
+func (m *QueryFailedCallbacksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFailedCallbacksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailedCallbacksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFailedCallbacksRequest proto.InternalMessageInfo
+
+func (m *QueryFailedCallbacksRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *QueryFailedCallbacksRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFailedCallbacksResponse is the response type for the Query/FailedCallbacks RPC method.
+type QueryFailedCallbacksResponse struct {
+	// failed_callbacks returns the pending failed callbacks of the contract
+	FailedCallbacks []FailedCallback `protobuf:"bytes,1,rep,name=failed_callbacks,json=failedCallbacks,proto3" json:"failed_callbacks"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFailedCallbacksResponse) Reset()         { *m = QueryFailedCallbacksResponse{} }
+func (m *QueryFailedCallbacksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFailedCallbacksResponse) ProtoMessage()    {}
+func (*QueryFailedCallbacksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e264909e6193ff2, []int{3}
+}
+func (m *QueryFailedCallbacksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFailedCallbacksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFailedCallbacksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFailedCallbacksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailedCallbacksResponse.Merge(m, src)
+}
+func (m *QueryFailedCallbacksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFailedCallbacksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailedCallbacksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFailedCallbacksResponse proto.InternalMessageInfo
+
+func (m *QueryFailedCallbacksResponse) GetFailedCallbacks() []FailedCallback {
+	if m != nil {
+		return m.FailedCallbacks
+	}
+	return nil
+}
+
+func (m *QueryFailedCallbacksResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryFailedCallbackRequest)(nil), "ibc.applications.callbacks.v1.QueryFailedCallbackRequest")
+	proto.RegisterType((*QueryFailedCallbackResponse)(nil), "ibc.applications.callbacks.v1.QueryFailedCallbackResponse")
+	proto.RegisterType((*QueryFailedCallbacksRequest)(nil), "ibc.applications.callbacks.v1.QueryFailedCallbacksRequest")
+	proto.RegisterType((*QueryFailedCallbacksResponse)(nil), "ibc.applications.callbacks.v1.QueryFailedCallbacksResponse")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/callbacks/v1/query.proto", fileDescriptor_8e264909e6193ff2)
+}
+
+var fileDescriptor_8e264909e6193ff2 = []byte{
+	// 560 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xce, 0x26, 0xb1, 0xda, 0x29, 0x34, 0x65, 0x10, 0x0c, 0x6b, 0xbb, 0x96, 0x1c, 0xb4, 0x15,
+	0x32, 0xcf, 0xc4, 0x53, 0xab, 0x17, 0x23, 0x54, 0x7a, 0xd3, 0x78, 0x13, 0x31, 0xcc, 0xce, 0x4e,
+	0xb7, 0x8b, 0x9b, 0x9d, 0x6d, 0x66, 0x12, 0x29, 0x21, 0x17, 0x0f, 0x9e, 0x0b, 0x1e, 0xfd, 0x41,
+	0xf6, 0x24, 0x05, 0x2f, 0x9e, 0x44, 0x13, 0x7f, 0x88, 0xec, 0xec, 0x24, 0x4d, 0x96, 0xb4, 0x85,
+	0xbd, 0xcd, 0xbc, 0xf7, 0xbd, 0x79, 0xdf, 0xfb, 0xde, 0x7b, 0x83, 0x76, 0x03, 0x97, 0x01, 0x8d,
+	0xe3, 0x30, 0x60, 0x54, 0x05, 0x22, 0x92, 0xc0, 0x68, 0x18, 0xba, 0x94, 0x7d, 0x94, 0x30, 0x68,
+	0xc0, 0x49, 0x9f, 0xf7, 0x4e, 0x49, 0xdc, 0x13, 0x4a, 0xe0, 0xad, 0xc0, 0x65, 0x64, 0x1e, 0x4a,
+	0x66, 0x50, 0x32, 0x68, 0xd8, 0x77, 0x7d, 0xe1, 0x0b, 0x8d, 0x84, 0xe4, 0x94, 0x06, 0xd9, 0x9b,
+	0xbe, 0x10, 0x7e, 0xc8, 0x81, 0xc6, 0x01, 0xd0, 0x28, 0x12, 0xca, 0x84, 0xa6, 0xde, 0xc7, 0x4c,
+	0xc8, 0xae, 0x90, 0xe0, 0x52, 0xc9, 0xd3, 0x5c, 0x30, 0x68, 0xb8, 0x5c, 0xd1, 0x06, 0xc4, 0xd4,
+	0x0f, 0x22, 0x0d, 0x36, 0xd8, 0xfa, 0xf5, 0x4c, 0x2f, 0xb9, 0x68, 0x78, 0xed, 0x9b, 0x85, 0xec,
+	0x37, 0xc9, 0x8b, 0x07, 0x34, 0x08, 0xb9, 0xf7, 0xd2, 0xb8, 0xdb, 0xfc, 0xa4, 0xcf, 0xa5, 0xc2,
+	0xbb, 0x68, 0x83, 0x89, 0x48, 0xf5, 0x28, 0x53, 0x1d, 0xea, 0x79, 0x3d, 0x2e, 0x65, 0xd5, 0xda,
+	0xb6, 0x76, 0x56, 0xdb, 0x95, 0xa9, 0xfd, 0x45, 0x6a, 0xc6, 0xf7, 0xd0, 0xed, 0x58, 0xf4, 0x54,
+	0x27, 0xf0, 0xaa, 0x45, 0x8d, 0x58, 0x49, 0xae, 0x87, 0x1e, 0xde, 0x42, 0x88, 0x1d, 0xd3, 0x28,
+	0xe2, 0x61, 0xe2, 0x2b, 0x69, 0xdf, 0xaa, 0xb1, 0x1c, 0x7a, 0xd8, 0x46, 0x77, 0x64, 0x92, 0x2d,
+	0x62, 0xbc, 0x5a, 0xde, 0xb6, 0x76, 0xca, 0xed, 0xd9, 0xbd, 0x36, 0x44, 0xf7, 0x97, 0x92, 0x93,
+	0xb1, 0x88, 0x24, 0xc7, 0xef, 0x51, 0xe5, 0x48, 0x7b, 0x3a, 0xd3, 0xb2, 0x34, 0xb9, 0xb5, 0x66,
+	0x9d, 0x5c, 0xdb, 0x04, 0xb2, 0xf8, 0x5e, 0xab, 0x7c, 0xfe, 0xfb, 0x41, 0xa1, 0xbd, 0x7e, 0xb4,
+	0x60, 0xad, 0x9d, 0x59, 0x4b, 0xb3, 0xcb, 0x1c, 0xda, 0x1c, 0x20, 0x74, 0xd9, 0x28, 0x2d, 0xcf,
+	0x5a, 0xf3, 0x21, 0x49, 0xbb, 0x4a, 0x92, 0xae, 0x92, 0x74, 0x82, 0x4c, 0x57, 0xc9, 0x6b, 0xea,
+	0x73, 0x93, 0xa6, 0x3d, 0x17, 0x59, 0xfb, 0x6e, 0xa1, 0xcd, 0xe5, 0x94, 0x8c, 0x22, 0x1f, 0xd0,
+	0x46, 0x46, 0x91, 0x84, 0x53, 0x29, 0xaf, 0x24, 0x95, 0x45, 0x49, 0x24, 0x7e, 0xb5, 0xa4, 0x90,
+	0x47, 0x37, 0x16, 0x92, 0x92, 0x9b, 0xaf, 0xa4, 0xf9, 0xb7, 0x84, 0x6e, 0xe9, 0x4a, 0xf0, 0x97,
+	0x22, 0x5a, 0x5f, 0x4c, 0x8e, 0xf7, 0x6e, 0xe0, 0x7a, 0xf5, 0xc0, 0xda, 0xfb, 0x79, 0x42, 0x53,
+	0x7e, 0xb5, 0xd1, 0xe7, 0x9f, 0xff, 0xbe, 0x16, 0x3f, 0xe1, 0x3e, 0x98, 0x1d, 0xca, 0xec, 0x4e,
+	0x56, 0x59, 0x18, 0x66, 0xfb, 0x3f, 0x82, 0x64, 0xe6, 0x25, 0x0c, 0xcd, 0x26, 0x8c, 0xc0, 0xcc,
+	0x79, 0x82, 0x9d, 0xed, 0xc0, 0x08, 0xa6, 0x03, 0x2e, 0x61, 0x38, 0x3d, 0x8e, 0xf0, 0x0f, 0x0b,
+	0x55, 0x32, 0x7d, 0xc5, 0x39, 0xca, 0x99, 0xce, 0xa7, 0xfd, 0x2c, 0x57, 0xac, 0xd1, 0xa2, 0xa5,
+	0xb5, 0x78, 0x8e, 0xf7, 0xf3, 0x6b, 0xd1, 0x7a, 0x7b, 0x3e, 0x76, 0xac, 0x8b, 0xb1, 0x63, 0xfd,
+	0x19, 0x3b, 0xd6, 0xd9, 0xc4, 0x29, 0x5c, 0x4c, 0x9c, 0xc2, 0xaf, 0x89, 0x53, 0x78, 0xb7, 0xe7,
+	0x07, 0xea, 0xb8, 0xef, 0x12, 0x26, 0xba, 0x60, 0xfe, 0xb6, 0xc0, 0x65, 0x75, 0x5f, 0xc0, 0xa0,
+	0xf1, 0x04, 0xba, 0xc2, 0xeb, 0x87, 0x5c, 0x66, 0xb3, 0xaa, 0xd3, 0x98, 0x4b, 0x77, 0x45, 0xff,
+	0x5b, 0x4f, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x49, 0x95, 0x69, 0xda, 0x92, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// FailedCallback queries the failed callback of a contract for a packet.
+	FailedCallback(ctx context.Context, in *QueryFailedCallbackRequest, opts ...grpc.CallOption) (*QueryFailedCallbackResponse, error)
+	// FailedCallbacks queries all pending failed callbacks of a contract.
+	FailedCallbacks(ctx context.Context, in *QueryFailedCallbacksRequest, opts ...grpc.CallOption) (*QueryFailedCallbacksResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) FailedCallback(ctx context.Context, in *QueryFailedCallbackRequest, opts ...grpc.CallOption) (*QueryFailedCallbackResponse, error) {
+	out := new(QueryFailedCallbackResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.callbacks.v1.Query/FailedCallback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FailedCallbacks(ctx context.Context, in *QueryFailedCallbacksRequest, opts ...grpc.CallOption) (*QueryFailedCallbacksResponse, error) {
+	out := new(QueryFailedCallbacksResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.callbacks.v1.Query/FailedCallbacks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// FailedCallback queries the failed callback of a contract for a packet.
+	FailedCallback(context.Context, *QueryFailedCallbackRequest) (*QueryFailedCallbackResponse, error)
+	// FailedCallbacks queries all pending failed callbacks of a contract.
+	FailedCallbacks(context.Context, *QueryFailedCallbacksRequest) (*QueryFailedCallbacksResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) FailedCallback(ctx context.Context, req *QueryFailedCallbackRequest) (*QueryFailedCallbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailedCallback not implemented")
+}
+func (*UnimplementedQueryServer) FailedCallbacks(ctx context.Context, req *QueryFailedCallbacksRequest) (*QueryFailedCallbacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailedCallbacks not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_FailedCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFailedCallbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FailedCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.callbacks.v1.Query/FailedCallback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FailedCallback(ctx, req.(*QueryFailedCallbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FailedCallbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFailedCallbacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FailedCallbacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.callbacks.v1.Query/FailedCallbacks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FailedCallbacks(ctx, req.(*QueryFailedCallbacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.applications.callbacks.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FailedCallback",
+			Handler:    _Query_FailedCallback_Handler,
+		},
+		{
+			MethodName: "FailedCallbacks",
+			Handler:    _Query_FailedCallbacks_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/applications/callbacks/v1/query.proto",
+}
+
+func (m *QueryFailedCallbackRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailedCallbackRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedCallbackRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFailedCallbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailedCallbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedCallbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FailedCallback.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFailedCallbacksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailedCallbacksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedCallbacksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFailedCallbacksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailedCallbacksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedCallbacksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FailedCallbacks) > 0 {
+		for iNdEx := len(m.FailedCallbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedCallbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryFailedCallbackRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryFailedCallbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FailedCallback.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFailedCallbacksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFailedCallbacksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FailedCallbacks) > 0 {
+		for _, e := range m.FailedCallbacks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryFailedCallbackRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFailedCallbackRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFailedCallbackRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFailedCallbackResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFailedCallbackResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFailedCallbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedCallback", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FailedCallback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFailedCallbacksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFailedCallbacksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFailedCallbacksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFailedCallbacksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFailedCallbacksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFailedCallbacksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedCallbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedCallbacks = append(m.FailedCallbacks, FailedCallback{})
+			if err := m.FailedCallbacks[len(m.FailedCallbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -10,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/internal"
+	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/keeper"
 	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
@@ -46,7 +47,10 @@ type IBCMiddleware struct {
 	writeAckWrapper api.WriteAcknowledgementWrapper
 
 	contractKeeper types.ContractKeeper
-	chanKeeperV2   types.ChannelKeeperV2
+	// keeper is optional, if set the source callbacks whose execution failed are stored in it so that
+	// they can be retried
+	keeper       *keeper.Keeper
+	chanKeeperV2 types.ChannelKeeperV2
 
 	// maxCallbackGas defines the maximum amount of gas that a callback actor can ask the
 	// relayer to pay for. If a callback fails due to insufficient gas, the entire tx
@@ -91,6 +95,18 @@ func NewIBCMiddleware(
 	}
 }
 
+// NewIBCMiddlewareWithFailedCallbacks creates a new IBCMiddleware instance given the ibc-callbacks keeper and
+// underlying application. Callbacks are executed on the contract keeper of the ibc-callbacks keeper, which stores
+// the source callbacks whose execution failed so that they can be retried.
+func NewIBCMiddlewareWithFailedCallbacks(
+	app api.IBCModule, writeAckWrapper api.WriteAcknowledgementWrapper,
+	k keeper.Keeper, chanKeeperV2 types.ChannelKeeperV2, maxCallbackGas uint64,
+) IBCMiddleware {
+	im := NewIBCMiddleware(app, writeAckWrapper, k.GetContractKeeper(), chanKeeperV2, maxCallbackGas)
+	im.keeper = &k
+	return im
+}
+
 // WithWriteAckWrapper sets the WriteAcknowledgementWrapper for the middleware.
 func (im *IBCMiddleware) WithWriteAckWrapper(writeAckWrapper api.WriteAcknowledgementWrapper) {
 	im.writeAckWrapper = writeAckWrapper
@@ -195,6 +211,7 @@ func (im IBCMiddleware) OnRecvPacket(
 		}
 	}
 
+	var callbackResult []byte
 	callbackExecutor := func(cachedCtx sdk.Context) error {
 		// reconstruct a channel v1 packet from the v2 packet
 		// in order to preserve the same interface for the contract keeper
@@ -211,7 +228,9 @@ func (im IBCMiddleware) OnRecvPacket(
 		// wrap the individual acknowledgement into the RecvAcknowledgement since it implements the exported.Acknowledgement interface
 		// since we return early on failure, we are guaranteed that the ack is a successful acknowledgement
 		ack := RecvAcknowledgement(recvResult.Acknowledgement)
-		return im.contractKeeper.IBCReceivePacketCallback(cachedCtx, packetv1, ack, cbData.CallbackAddress, payload.Version)
+		var err error
+		callbackResult, err = internal.ReceivePacketCallback(cachedCtx, im.contractKeeper, packetv1, ack, cbData)
+		return err
 	}
 
 	// callback execution errors are not allowed to block the packet lifecycle, they are only used in event emissions
@@ -226,6 +245,11 @@ func (im IBCMiddleware) OnRecvPacket(
 		}
 	}
 
+	// the callback result is returned to the source together with the acknowledgement of the underlying app
+	if cbData.ReturnResult {
+		recvResult.Acknowledgement = types.NewCallbackResultAcknowledgement(recvResult.Acknowledgement, callbackResult).Acknowledgement()
+	}
+
 	return recvResult
 }
 
@@ -243,7 +267,7 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 	relayer sdk.AccAddress,
 ) error {
 	// we first call the underlying app to handle the acknowledgement
-	err := im.app.OnAcknowledgementPacket(ctx, sourceClient, destinationClient, sequence, acknowledgement, payload, relayer)
+	err := im.app.OnAcknowledgementPacket(ctx, sourceClient, destinationClient, sequence, im.getAppAcknowledgement(payload, acknowledgement), payload, relayer)
 	if err != nil {
 		return err
 	}
@@ -268,19 +292,19 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 		return err
 	}
 
+	// reconstruct a channel v1 packet from the v2 packet
+	// in order to preserve the same interface for the contract keeper
+	packetv1 := channeltypes.Packet{
+		Sequence:           sequence,
+		SourcePort:         payload.SourcePort,
+		SourceChannel:      sourceClient,
+		DestinationPort:    payload.DestinationPort,
+		DestinationChannel: destinationClient,
+		Data:               payload.Value,
+		TimeoutHeight:      clienttypes.Height{},
+		TimeoutTimestamp:   0,
+	}
 	callbackExecutor := func(cachedCtx sdk.Context) error {
-		// reconstruct a channel v1 packet from the v2 packet
-		// in order to preserve the same interface for the contract keeper
-		packetv1 := channeltypes.Packet{
-			Sequence:           sequence,
-			SourcePort:         payload.SourcePort,
-			SourceChannel:      sourceClient,
-			DestinationPort:    payload.DestinationPort,
-			DestinationChannel: destinationClient,
-			Data:               payload.Value,
-			TimeoutHeight:      clienttypes.Height{},
-			TimeoutTimestamp:   0,
-		}
 		// NOTE: The callback is receiving the acknowledgement that the application received for its particular payload.
 		// In the case of a successful acknowledgement, this will be the acknowledgement sent by the counterparty application for the given payload
 		// In the case of an error acknowledgement, this will be the sentinel error acknowledgement bytes defined by IBC v2 protocol.
@@ -291,12 +315,16 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 		)
 	}
 
-	// callback execution errors are not allowed to block the packet lifecycle, they are only used in event emissions
+	// callback execution errors are not allowed to block the packet lifecycle, they are used in event emissions
+	// and the failed callback is stored so that it can be retried if the ibc-callbacks keeper is set
 	err = internal.ProcessCallback(ctx, types.CallbackTypeAcknowledgementPacket, cbData, callbackExecutor)
 	types.EmitCallbackEvent(
 		ctx, payload.SourcePort, sourceClient, sequence,
 		types.CallbackTypeAcknowledgementPacket, cbData, err,
 	)
+	if err != nil && im.keeper != nil {
+		im.keeper.RecordFailedCallback(ctx, types.CallbackTypeAcknowledgementPacket, packetv1, acknowledgement, relayer, cbData, err)
+	}
 
 	return nil
 }
@@ -338,30 +366,34 @@ func (im IBCMiddleware) OnTimeoutPacket(
 		return err
 	}
 
+	// reconstruct a channel v1 packet from the v2 packet
+	// in order to preserve the same interface for the contract keeper
+	packetv1 := channeltypes.Packet{
+		Sequence:           sequence,
+		SourcePort:         payload.SourcePort,
+		SourceChannel:      sourceClient,
+		DestinationPort:    payload.DestinationPort,
+		DestinationChannel: destinationClient,
+		Data:               payload.Value,
+		TimeoutHeight:      clienttypes.Height{},
+		TimeoutTimestamp:   0,
+	}
 	callbackExecutor := func(cachedCtx sdk.Context) error {
-		// reconstruct a channel v1 packet from the v2 packet
-		// in order to preserve the same interface for the contract keeper
-		packetv1 := channeltypes.Packet{
-			Sequence:           sequence,
-			SourcePort:         payload.SourcePort,
-			SourceChannel:      sourceClient,
-			DestinationPort:    payload.DestinationPort,
-			DestinationChannel: destinationClient,
-			Data:               payload.Value,
-			TimeoutHeight:      clienttypes.Height{},
-			TimeoutTimestamp:   0,
-		}
 		return im.contractKeeper.IBCOnTimeoutPacketCallback(
 			cachedCtx, packetv1, relayer, cbData.CallbackAddress, cbData.SenderAddress, payload.Version,
 		)
 	}
 
-	// callback execution errors are not allowed to block the packet lifecycle, they are only used in event emissions
+	// callback execution errors are not allowed to block the packet lifecycle, they are used in event emissions
+	// and the failed callback is stored so that it can be retried if the ibc-callbacks keeper is set
 	err = internal.ProcessCallback(ctx, types.CallbackTypeTimeoutPacket, cbData, callbackExecutor)
 	types.EmitCallbackEvent(
 		ctx, payload.SourcePort, sourceClient, sequence,
 		types.CallbackTypeTimeoutPacket, cbData, err,
 	)
+	if err != nil && im.keeper != nil {
+		im.keeper.RecordFailedCallback(ctx, types.CallbackTypeTimeoutPacket, packetv1, nil, relayer, cbData, err)
+	}
 
 	return nil
 }
@@ -382,11 +414,6 @@ func (im IBCMiddleware) WriteAcknowledgement(
 		return errorsmod.Wrapf(channeltypesv2.ErrInvalidAcknowledgement, "async packet not found for clientID (%s) and sequence (%d)", clientID, sequence)
 	}
 
-	err := im.writeAckWrapper.WriteAcknowledgement(ctx, clientID, sequence, ack)
-	if err != nil {
-		return err
-	}
-
 	// NOTE: use first payload as the payload that is being handled by callbacks middleware
 	// must reconsider if multipacket data gets supported with async packets
 	// TRACKING ISSUE: https://github.com/cosmos/ibc-go/issues/7950
@@ -404,6 +431,15 @@ func (im IBCMiddleware) WriteAcknowledgement(
 		packetData, payload.GetVersion(), payload.GetDestinationPort(),
 		ctx.GasMeter().GasRemaining(), im.maxCallbackGas, types.DestinationCallbackKey,
 	)
+	// the callback must be executed before the acknowledgement is written if its result is returned to the source
+	returnResult := isCbPacket && err == nil && cbData.ReturnResult &&
+		!bytes.Equal(ack.AppAcknowledgements[0], channeltypesv2.ErrorAcknowledgement[:])
+	if !returnResult {
+		if err := im.writeAckWrapper.WriteAcknowledgement(ctx, clientID, sequence, ack); err != nil {
+			return err
+		}
+	}
+
 	// WriteAcknowledgement is not blocked if the packet does not opt-in to callbacks
 	if !isCbPacket {
 		return nil
@@ -418,6 +454,7 @@ func (im IBCMiddleware) WriteAcknowledgement(
 		Status:          channeltypesv2.PacketStatus_Success,
 		Acknowledgement: ack.AppAcknowledgements[0],
 	}
+	var callbackResult []byte
 	callbackExecutor := func(cachedCtx sdk.Context) error {
 		// reconstruct a channel v1 packet from the v2 packet
 		// in order to preserve the same interface for the contract keeper
@@ -438,9 +475,9 @@ func (im IBCMiddleware) WriteAcknowledgement(
 		} else {
 			ack = channeltypesv2.NewAcknowledgement(recvResult.Acknowledgement)
 		}
-		return im.contractKeeper.IBCReceivePacketCallback(
-			cachedCtx, packetv1, ack, cbData.CallbackAddress, payload.Version,
-		)
+		var err error
+		callbackResult, err = internal.ReceivePacketCallback(cachedCtx, im.contractKeeper, packetv1, ack, cbData)
+		return err
 	}
 
 	// callback execution errors are not allowed to block the packet lifecycle, they are only used in event emissions
@@ -450,5 +487,30 @@ func (im IBCMiddleware) WriteAcknowledgement(
 		types.CallbackTypeReceivePacket, cbData, err,
 	)
 
+	if returnResult {
+		// the acknowledgement of the underlying app is written without a callback result if the callback failed
+		if err == nil {
+			ack = channeltypesv2.NewAcknowledgement(types.NewCallbackResultAcknowledgement(ack.AppAcknowledgements[0], callbackResult).Acknowledgement())
+		}
+		return im.writeAckWrapper.WriteAcknowledgement(ctx, clientID, sequence, ack)
+	}
+
 	return nil
 }
+
+// getAppAcknowledgement returns the acknowledgement written by the underlying application. If the payload requests the
+// result of its destination callback, the acknowledgement wrapped in the CallbackResultAcknowledgement is returned.
+// Otherwise, for example for error acknowledgements, the acknowledgement is returned unchanged.
+func (im IBCMiddleware) getAppAcknowledgement(payload channeltypesv2.Payload, acknowledgement []byte) []byte {
+	resultAck, err := types.UnmarshalCallbackResultAcknowledgement(acknowledgement)
+	if err != nil {
+		return acknowledgement
+	}
+
+	packetData, err := im.app.UnmarshalPacketData(payload)
+	if err != nil || !types.ReturnsCallbackResult(packetData) {
+		return acknowledgement
+	}
+
+	return resultAck.AppAcknowledgement
+}
@@ -10,6 +10,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	ibccallbackskeeper "github.com/cosmos/ibc-go/v10/modules/apps/callbacks/keeper"
 	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/testing/simapp"
 	"github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
 	v2 "github.com/cosmos/ibc-go/v10/modules/apps/callbacks/v2"
@@ -25,6 +26,8 @@ import (
 )
 
 func (s *CallbacksTestSuite) TestNewIBCMiddleware() {
+	callbacksKeeper := ibccallbackskeeper.NewKeeper(nil, nil, simapp.ContractKeeper{}, time.Hour)
+
 	testCases := []struct {
 		name          string
 		instantiateFn func()
@@ -37,6 +40,13 @@ func (s *CallbacksTestSuite) TestNewIBCMiddleware() {
 			},
 			nil,
 		},
+		{
+			"success: with failed callbacks keeper",
+			func() {
+				_ = v2.NewIBCMiddlewareWithFailedCallbacks(ibcmockv2.IBCModule{}, &channelkeeperv2.Keeper{}, callbacksKeeper, &channelkeeperv2.Keeper{}, maxCallbackGas)
+			},
+			nil,
+		},
 		{
 			"panics with nil ics4wrapper",
 			func() {
@@ -58,6 +68,13 @@ func (s *CallbacksTestSuite) TestNewIBCMiddleware() {
 			},
 			errors.New("contract keeper cannot be nil"),
 		},
+		{
+			"panics with failed callbacks keeper without contract keeper",
+			func() {
+				_ = v2.NewIBCMiddlewareWithFailedCallbacks(ibcmockv2.IBCModule{}, &channelkeeperv2.Keeper{}, ibccallbackskeeper.Keeper{}, &channelkeeperv2.Keeper{}, maxCallbackGas)
+			},
+			errors.New("contract keeper cannot be nil"),
+		},
 		{
 			"panics with nil channel v2 keeper",
 			func() {
@@ -259,6 +276,15 @@ func (s *CallbacksTestSuite) TestOnAcknowledgementPacket() {
 			noExecution,
 			nil,
 		},
+		{
+			"success: acknowledgement with callback result is unwrapped for the underlying app",
+			func() {
+				packetData.Memo = fmt.Sprintf(`{"src_callback": {"address":"%s", "gas_limit":"%d"}, "dest_callback": {"address":"%s", "return_result": true}}`, simapp.SuccessContract, userGasLimit, simapp.SuccessContract)
+				ack = types.NewCallbackResultAcknowledgement(ack, simapp.MockCallbackResult).Acknowledgement()
+			},
+			callbackSuccess,
+			nil,
+		},
 		{
 			"failure: underlying app OnAcknowledgePacket fails",
 			func() {
@@ -267,6 +293,14 @@ func (s *CallbacksTestSuite) TestOnAcknowledgementPacket() {
 			noExecution,
 			ibcerrors.ErrUnknownRequest,
 		},
+		{
+			"failure: acknowledgement with callback result for packet not requesting the result",
+			func() {
+				ack = types.NewCallbackResultAcknowledgement(ack, simapp.MockCallbackResult).Acknowledgement()
+			},
+			noExecution,
+			ibcerrors.ErrUnknownRequest,
+		},
 		{
 			"failure: callback data is not valid",
 			func() {
@@ -368,6 +402,17 @@ func (s *CallbacksTestSuite) TestOnAcknowledgementPacket() {
 				s.Require().Equal(1, sourceCounters[types.CallbackTypeAcknowledgementPacket])
 				s.Require().Equal(uint8(0), sourceStatefulCounter)
 
+				// the failed callback is stored for retries unless the transaction is reverted by the out of gas panic
+				failedCallbacks := GetSimApp(s.chainA).CallbacksKeeper.GetAllFailedCallbacks(s.chainA.GetContext())
+				if tc.expError == panicError {
+					s.Require().Empty(failedCallbacks)
+				} else {
+					s.Require().Len(failedCallbacks, 1)
+					s.Require().Equal(string(types.CallbackTypeAcknowledgementPacket), failedCallbacks[0].CallbackType)
+					s.Require().Equal(s.path.EndpointA.ClientID, failedCallbacks[0].Packet.SourceChannel)
+					s.Require().Equal(ack, failedCallbacks[0].Acknowledgement)
+				}
+
 			case callbackSuccess:
 				s.Require().Len(sourceCounters, 1)
 				s.Require().Equal(1, sourceCounters[types.CallbackTypeAcknowledgementPacket])
@@ -533,6 +578,16 @@ func (s *CallbacksTestSuite) TestOnTimeoutPacket() {
 				s.Require().Equal(1, sourceCounters[types.CallbackTypeSendPacket])
 				s.Require().Equal(uint8(1), sourceStatefulCounter)
 
+				// the failed callback is stored for retries unless the transaction is reverted by the out of gas panic
+				failedCallbacks := GetSimApp(s.chainA).CallbacksKeeper.GetAllFailedCallbacks(s.chainA.GetContext())
+				if _, isPanic := tc.expValue.(storetypes.ErrorOutOfGas); isPanic {
+					s.Require().Empty(failedCallbacks)
+				} else {
+					s.Require().Len(failedCallbacks, 1)
+					s.Require().Equal(string(types.CallbackTypeTimeoutPacket), failedCallbacks[0].CallbackType)
+					s.Require().Equal(s.path.EndpointA.ClientID, failedCallbacks[0].Packet.SourceChannel)
+				}
+
 			case callbackSuccess:
 				s.Require().Len(sourceCounters, 2)
 				s.Require().Equal(1, sourceCounters[types.CallbackTypeTimeoutPacket])
@@ -583,6 +638,14 @@ func (s *CallbacksTestSuite) TestOnRecvPacket() {
 			callbackSuccess,
 			success,
 		},
+		{
+			"success: callback returning result",
+			func() {
+				packetData.Memo = fmt.Sprintf(`{"dest_callback": {"address":"%s", "gas_limit":"%d", "return_result": true}}`, ibctesting.TestAccAddress, userGasLimit)
+			},
+			callbackSuccess,
+			success,
+		},
 		{
 			"success: callback data nonexistent",
 			func() {
@@ -635,6 +698,14 @@ func (s *CallbacksTestSuite) TestOnRecvPacket() {
 			callbackFailed,
 			failure,
 		},
+		{
+			"failure: callback returning result fails",
+			func() {
+				packetData.Memo = fmt.Sprintf(`{"dest_callback": {"address":"%s", "return_result": true}}`, simapp.ErrorContract)
+			},
+			callbackFailed,
+			failure,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -677,6 +748,13 @@ func (s *CallbacksTestSuite) TestOnRecvPacket() {
 				recvResult := onRecvPacket()
 				s.Require().Equal(channeltypesv2.PacketStatus_Success, recvResult.Status)
 
+				if types.ReturnsCallbackResult(packetData) {
+					resultAck, err := types.UnmarshalCallbackResultAcknowledgement(recvResult.Acknowledgement)
+					s.Require().NoError(err)
+					s.Require().Equal(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(), resultAck.AppAcknowledgement)
+					s.Require().Equal(simapp.MockCallbackResult, resultAck.CallbackResult)
+				}
+
 			case panics:
 				s.Require().PanicsWithValue(storetypes.ErrorOutOfGas{
 					Descriptor: fmt.Sprintf("ibc %s callback out of gas; commitGasLimit: %d", types.CallbackTypeReceivePacket, userGasLimit),
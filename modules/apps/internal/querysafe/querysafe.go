@@ -0,0 +1,53 @@
+// Package querysafe provides helpers shared by the applications which execute queries
+// on behalf of counterparty chains, such as the interchain accounts host submodule and
+// the async interchain queries application.
+package querysafe
+
+import (
+	"fmt"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	msgv1 "cosmossdk.io/api/cosmos/msg/v1"
+	queryv1 "cosmossdk.io/api/cosmos/query/v1"
+)
+
+// NewAllowList returns a list of all query paths labeled with module_query_safe in the proto files.
+func NewAllowList() []string {
+	allowList := []string{}
+	gogoproto.GogoResolver.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		for i := range fd.Services().Len() {
+			// Get the service descriptor
+			sd := fd.Services().Get(i)
+
+			// Skip services that are annotated with the "cosmos.msg.v1.service" option.
+			if ext := proto.GetExtension(sd.Options(), msgv1.E_Service); ext != nil {
+				val, ok := ext.(bool)
+				if !ok {
+					panic(fmt.Errorf("cannot convert %T to %T", ext, ok))
+				}
+				if val {
+					continue
+				}
+			}
+
+			for j := range sd.Methods().Len() {
+				// Get the method descriptor
+				md := sd.Methods().Get(j)
+
+				// Skip methods that are not annotated with the "cosmos.query.v1.module_query_safe" option.
+				if ext := proto.GetExtension(md.Options(), queryv1.E_ModuleQuerySafe); ext == nil || !ext.(bool) {
+					continue
+				}
+
+				// Add the method to the whitelist
+				allowList = append(allowList, fmt.Sprintf("/%s/%s", sd.FullName(), md.Name()))
+			}
+		}
+		return true
+	})
+
+	return allowList
+}
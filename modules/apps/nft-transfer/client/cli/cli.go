@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the query commands for the nft-transfer module
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        "nft-transfer",
+		Short:                      "IBC non-fungible token transfer query subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdParams(),
+		GetCmdQueryClass(),
+		GetCmdQueryClasses(),
+		GetCmdQueryClassHash(),
+		GetCmdQueryEscrowAddress(),
+	)
+
+	return queryCmd
+}
+
+// NewTxCmd returns the transaction commands for the nft-transfer module
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        "nft-transfer",
+		Short:                      "IBC non-fungible token transfer transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	txCmd.AddCommand(
+		NewTransferTxCmd(),
+	)
+
+	return txCmd
+}
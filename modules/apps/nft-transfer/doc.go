@@ -0,0 +1,16 @@
+/*
+Package nfttransfer implements the ICS-721 non-fungible token transfer application over IBC v1
+channels and IBC v2 clients.
+
+Tokens are escrowed on the chain which is the source of their class and vouchers are minted on the
+receiving chain in a class identified by 'ibc/{hash(trace + "/" + baseClassID)}', analogous to ICS-20
+denominations. The token IDs are left untouched as they are unique within their class. The class and
+token URIs and data are carried in the packet such that the vouchers are created with the metadata of
+the original tokens. Vouchers sent back to the chain they were received from are burned and the
+original tokens are unescrowed.
+
+The application operates on the tokens through the NFTKeeper interface, whose method set mirrors the
+keeper of the x/nft module. Packet data may be encoded as JSON, protobuf or Solidity ABI for IBC v2
+packets such that tokens can be transferred to EVM chains.
+*/
+package nfttransfer
@@ -0,0 +1,210 @@
+package nfttransfer_test
+
+import (
+	"math"
+	"testing"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	nfttransfer "github.com/cosmos/ibc-go/v10/modules/apps/nft-transfer"
+	"github.com/cosmos/ibc-go/v10/modules/apps/nft-transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+type NFTTransferTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+}
+
+func (suite *NFTTransferTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+}
+
+func TestNFTTransferTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(NFTTransferTestSuite))
+}
+
+// NewNFTTransferPath creates a new path with nft-transfer channel endpoints.
+func NewNFTTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = types.PortID
+	path.EndpointB.ChannelConfig.PortID = types.PortID
+	path.EndpointA.ChannelConfig.Version = types.V1
+	path.EndpointB.ChannelConfig.Version = types.V1
+
+	return path
+}
+
+func (suite *NFTTransferTestSuite) TestOnChanOpenInit() {
+	var (
+		channel      *channeltypes.Channel
+		path         *ibctesting.Path
+		counterparty channeltypes.Counterparty
+	)
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expError   error
+		expVersion string
+	}{
+		{
+			"success", func() {}, nil, types.V1,
+		},
+		{
+			"success: empty version string", func() {
+				channel.Version = ""
+			}, nil, types.V1,
+		},
+		{
+			"max channels reached", func() {
+				path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(math.MaxUint32 + 1)
+			}, types.ErrMaxTransferChannels, "",
+		},
+		{
+			"invalid order - ORDERED", func() {
+				channel.Ordering = channeltypes.ORDERED
+			}, channeltypes.ErrInvalidChannelOrdering, "",
+		},
+		{
+			"invalid port ID", func() {
+				path.EndpointA.ChannelConfig.PortID = ibctesting.MockPort
+			}, porttypes.ErrInvalidPort, "",
+		},
+		{
+			"invalid version", func() {
+				channel.Version = "ics20-1"
+			}, types.ErrInvalidVersion, "",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = NewNFTTransferPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+			path.EndpointA.ChannelID = ibctesting.FirstChannelID
+
+			counterparty = channeltypes.NewCounterparty(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			channel = &channeltypes.Channel{
+				State:          channeltypes.INIT,
+				Ordering:       channeltypes.UNORDERED,
+				Counterparty:   counterparty,
+				ConnectionHops: []string{path.EndpointA.ConnectionID},
+				Version:        types.V1,
+			}
+
+			tc.malleate() // explicitly change fields in channel and testChannel
+
+			nftTransferModule := nfttransfer.NewIBCModule(suite.chainA.GetSimApp().NFTTransferKeeper)
+			version, err := nftTransferModule.OnChanOpenInit(suite.chainA.GetContext(), channel.Ordering, channel.ConnectionHops,
+				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, counterparty, channel.Version,
+			)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expVersion, version)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *NFTTransferTestSuite) TestOnChanOpenTry() {
+	var (
+		path                *ibctesting.Path
+		counterpartyVersion string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success", func() {}, nil,
+		},
+		{
+			"success: invalid counterparty version, we propose new version", func() {
+				counterpartyVersion = "version"
+			}, nil,
+		},
+		{
+			"failure: invalid port ID", func() {
+				path.EndpointA.ChannelConfig.PortID = ibctesting.MockPort
+			}, porttypes.ErrInvalidPort,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = NewNFTTransferPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+			path.EndpointA.ChannelID = ibctesting.FirstChannelID
+			counterpartyVersion = types.V1
+
+			tc.malleate()
+
+			nftTransferModule := nfttransfer.NewIBCModule(suite.chainA.GetSimApp().NFTTransferKeeper)
+			counterparty := channeltypes.NewCounterparty(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			version, err := nftTransferModule.OnChanOpenTry(suite.chainA.GetContext(), channeltypes.UNORDERED, []string{path.EndpointA.ConnectionID},
+				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, counterparty, counterpartyVersion,
+			)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(types.V1, version)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestOnRecvPacketErrorAck tests that a failed receive returns an error acknowledgement and
+// that the tokens are refunded to the sender when the acknowledgement is relayed back.
+func (suite *NFTTransferTestSuite) TestOnRecvPacketErrorAck() {
+	path := NewNFTTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	ctx := suite.chainA.GetContext()
+	sender := suite.chainA.SenderAccount.GetAddress()
+	nftKeeper := suite.chainA.GetSimApp().NFTKeeper
+	suite.Require().NoError(nftKeeper.SaveClass(ctx, "kitties", "", ""))
+	suite.Require().NoError(nftKeeper.Mint(ctx, "kitties", "1", "", "", sender))
+
+	// disable receiving on chainB so that the packet fails
+	suite.chainB.GetSimApp().NFTTransferKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, false))
+
+	msg := types.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, "kitties", []string{"1"},
+		sender.String(), suite.chainB.SenderAccount.GetAddress().String(),
+		clienttypes.ZeroHeight(), suite.chainA.GetTimeoutTimestamp(), "",
+	)
+
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID), nftKeeper.GetOwner(suite.chainA.GetContext(), "kitties", "1"))
+
+	_, ack, err := path.RelayPacketWithResults(packet)
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.NewErrorAcknowledgement(types.ErrReceiveDisabled).Acknowledgement(), ack)
+
+	suite.Require().Equal(sender, nftKeeper.GetOwner(suite.chainA.GetContext(), "kitties", "1"))
+}
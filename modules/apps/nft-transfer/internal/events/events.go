@@ -0,0 +1,133 @@
+package events
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/nft-transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
+
+// EmitTransferEvent emits a ibc nft-transfer event on successful transfers.
+func EmitTransferEvent(ctx sdk.Context, packetData types.NonFungibleTokenPacketData) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransfer,
+			sdk.NewAttribute(types.AttributeKeySender, packetData.Sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, packetData.Receiver),
+			sdk.NewAttribute(types.AttributeKeyClassID, packetData.ClassId),
+			sdk.NewAttribute(types.AttributeKeyTokenIDs, strings.Join(packetData.TokenIds, ",")),
+			sdk.NewAttribute(types.AttributeKeyMemo, packetData.Memo),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// EmitOnRecvPacketEvent emits a non-fungible token packet event in the OnRecvPacket callback
+func EmitOnRecvPacketEvent(ctx sdk.Context, packetData types.NonFungibleTokenPacketData, ackErr error) {
+	eventAttributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeySender, packetData.Sender),
+		sdk.NewAttribute(types.AttributeKeyReceiver, packetData.Receiver),
+		sdk.NewAttribute(types.AttributeKeyClassID, packetData.ClassId),
+		sdk.NewAttribute(types.AttributeKeyTokenIDs, strings.Join(packetData.TokenIds, ",")),
+		sdk.NewAttribute(types.AttributeKeyMemo, packetData.Memo),
+		sdk.NewAttribute(types.AttributeKeyAckSuccess, strconv.FormatBool(ackErr == nil)),
+	}
+
+	if ackErr != nil {
+		eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeKeyAckError, ackErr.Error()))
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePacket,
+			eventAttributes...,
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// EmitOnAcknowledgementPacketEvent emits a non-fungible token packet event in the OnAcknowledgementPacket callback
+func EmitOnAcknowledgementPacketEvent(ctx sdk.Context, packetData types.NonFungibleTokenPacketData, ack channeltypes.Acknowledgement) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePacket,
+			sdk.NewAttribute(sdk.AttributeKeySender, packetData.Sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, packetData.Receiver),
+			sdk.NewAttribute(types.AttributeKeyClassID, packetData.ClassId),
+			sdk.NewAttribute(types.AttributeKeyTokenIDs, strings.Join(packetData.TokenIds, ",")),
+			sdk.NewAttribute(types.AttributeKeyMemo, packetData.Memo),
+			sdk.NewAttribute(types.AttributeKeyAck, ack.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+
+	switch resp := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Result:
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePacket,
+				sdk.NewAttribute(types.AttributeKeyAckSuccess, string(resp.Result)),
+			),
+		)
+	case *channeltypes.Acknowledgement_Error:
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePacket,
+				sdk.NewAttribute(types.AttributeKeyAckError, resp.Error),
+			),
+		)
+	}
+}
+
+// EmitOnTimeoutEvent emits a non-fungible token packet event in the OnTimeoutPacket callback
+func EmitOnTimeoutEvent(ctx sdk.Context, packetData types.NonFungibleTokenPacketData) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTimeout,
+			sdk.NewAttribute(types.AttributeKeyReceiver, packetData.Sender),
+			sdk.NewAttribute(types.AttributeKeyClassID, packetData.ClassId),
+			sdk.NewAttribute(types.AttributeKeyTokenIDs, strings.Join(packetData.TokenIds, ",")),
+			sdk.NewAttribute(types.AttributeKeyMemo, packetData.Memo),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// EmitClassEvent emits a class event in the OnRecv callback.
+func EmitClassEvent(ctx sdk.Context, class types.Class) {
+	classStr := mustMarshalJSON(class)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClass,
+			sdk.NewAttribute(types.AttributeKeyClassHash, class.Hash().String()),
+			sdk.NewAttribute(types.AttributeKeyClassID, classStr),
+		),
+	)
+}
+
+// mustMarshalJSON json marshals the given type and panics on failure.
+func mustMarshalJSON(v any) string {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bz)
+}
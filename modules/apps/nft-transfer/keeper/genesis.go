@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/nft-transfer/types"
+)
+
+// InitGenesis initializes the ibc nft-transfer state and binds to PortID.
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	k.SetPort(ctx, state.PortId)
+
+	for _, class := range state.Classes {
+		k.SetClass(ctx, class)
+	}
+
+	k.SetParams(ctx, state.Params)
+}
+
+// ExportGenesis exports ibc nft-transfer module's portID and class trace info into its genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		PortId:  k.GetPort(ctx),
+		Classes: k.GetAllClasses(ctx),
+		Params:  k.GetParams(ctx),
+	}
+}
@@ -0,0 +1,33 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v10/modules/apps/nft-transfer/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+)
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	classes := types.Classes{
+		types.NewClass("kitties", transfertypes.NewHop(types.PortID, "channel-0")),
+		types.NewClass("kitties", transfertypes.NewHop(types.PortID, "channel-1"), transfertypes.NewHop(types.PortID, "channel-0")),
+		types.NewClass("punks/genesis", transfertypes.NewHop(types.PortID, "channel-2")),
+	}
+
+	for _, class := range classes {
+		suite.chainA.GetSimApp().NFTTransferKeeper.SetClass(suite.chainA.GetContext(), class)
+	}
+
+	genesis := suite.chainA.GetSimApp().NFTTransferKeeper.ExportGenesis(suite.chainA.GetContext())
+
+	suite.Require().Equal(types.PortID, genesis.PortId)
+	suite.Require().Equal(classes.Sort(), genesis.Classes)
+	suite.Require().Equal(types.DefaultParams(), genesis.Params)
+
+	suite.Require().NotPanics(func() {
+		suite.chainA.GetSimApp().NFTTransferKeeper.InitGenesis(suite.chainA.GetContext(), *genesis)
+	})
+
+	for _, class := range classes {
+		_, found := suite.chainA.GetSimApp().NFTTransferKeeper.GetClass(suite.chainA.GetContext(), class.Hash())
+		suite.Require().True(found)
+	}
+}
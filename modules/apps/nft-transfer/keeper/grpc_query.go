@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cosmos/ibc-go/v10/internal/validate"
+	"github.com/cosmos/ibc-go/v10/modules/apps/nft-transfer/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
+
+var _ types.QueryServer = (*Keeper)(nil)
+
+// Class implements the Query/Class gRPC method
+func (k Keeper) Class(goCtx context.Context, req *types.QueryClassRequest) (*types.QueryClassResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hash, err := transfertypes.ParseHexHash(strings.TrimPrefix(req.Hash, types.ClassPrefix+"/"))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid class trace hash: %s, error: %s", req.Hash, err))
+	}
+
+	class, found := k.GetClass(ctx, hash)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrap(types.ErrClassNotFound, req.Hash).Error(),
+		)
+	}
+
+	return &types.QueryClassResponse{
+		Class: &class,
+	}, nil
+}
+
+// Classes implements the Query/Classes gRPC method
+func (k Keeper) Classes(ctx context.Context, req *types.QueryClassesRequest) (*types.QueryClassesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var classes types.Classes
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ClassKey)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var class types.Class
+		if err := k.cdc.Unmarshal(value, &class); err != nil {
+			return err
+		}
+
+		classes = append(classes, class)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryClassesResponse{
+		Classes:    classes.Sort(),
+		Pagination: pageRes,
+	}, nil
+}
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(goCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{
+		Params: &params,
+	}, nil
+}
+
+// ClassHash implements the Query/ClassHash gRPC method
+func (k Keeper) ClassHash(goCtx context.Context, req *types.QueryClassHashRequest) (*types.QueryClassHashResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Convert given request trace path to Class struct to confirm the path in a valid class trace format
+	class := types.ExtractClassFromPath(req.Trace)
+	if err := class.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	classHash := class.Hash()
+	if !k.HasClass(ctx, classHash) {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrap(types.ErrClassNotFound, req.Trace).Error(),
+		)
+	}
+
+	return &types.QueryClassHashResponse{
+		Hash: classHash.String(),
+	}, nil
+}
+
+// EscrowAddress implements the EscrowAddress gRPC method
+func (k Keeper) EscrowAddress(goCtx context.Context, req *types.QueryEscrowAddressRequest) (*types.QueryEscrowAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	if !k.channelKeeper.HasChannel(ctx, req.PortId, req.ChannelId) {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	return &types.QueryEscrowAddressResponse{
+		EscrowAddress: types.GetEscrowAddress(req.PortId, req.ChannelId).String(),
+	}, nil
+}
@@ -0,0 +1,250 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/nft-transfer/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+func (suite *KeeperTestSuite) TestQueryClass() {
+	var (
+		req      *types.QueryClassRequest
+		expClass types.Class
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   bool
+	}{
+		{
+			"success: correct ibc class",
+			func() {
+				expClass = types.NewClass(classID, transfertypes.NewHop(types.PortID, ibctesting.FirstChannelID))
+				suite.chainA.GetSimApp().NFTTransferKeeper.SetClass(suite.chainA.GetContext(), expClass)
+				req = &types.QueryClassRequest{Hash: expClass.IBCClassID()}
+			},
+			false,
+		},
+		{
+			"success: correct hex hash",
+			func() {
+				expClass = types.NewClass(classID, transfertypes.NewHop(types.PortID, ibctesting.FirstChannelID))
+				suite.chainA.GetSimApp().NFTTransferKeeper.SetClass(suite.chainA.GetContext(), expClass)
+				req = &types.QueryClassRequest{Hash: expClass.Hash().String()}
+			},
+			false,
+		},
+		{
+			"failure: invalid hash",
+			func() {
+				req = &types.QueryClassRequest{Hash: "!@#!@#!"}
+			},
+			true,
+		},
+		{
+			"failure: not found class trace",
+			func() {
+				expClass = types.NewClass(classID, transfertypes.NewHop(types.PortID, ibctesting.FirstChannelID))
+				req = &types.QueryClassRequest{Hash: expClass.IBCClassID()}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.GetSimApp().NFTTransferKeeper.Class(ctx, req)
+
+			if !tc.expErr {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(&expClass, res.Class)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryClasses() {
+	var (
+		req        *types.QueryClassesRequest
+		expClasses = types.Classes(nil)
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"empty pagination",
+			func() {
+				req = &types.QueryClassesRequest{}
+			},
+		},
+		{
+			"success",
+			func() {
+				expClasses = append(expClasses, types.NewClass("kitties"))
+				expClasses = append(expClasses, types.NewClass("kitties", transfertypes.NewHop(types.PortID, "channel-1")))
+				expClasses = append(expClasses, types.NewClass("kitties", transfertypes.NewHop(types.PortID, "channel-1"), transfertypes.NewHop(types.PortID, "channel-2")))
+
+				for _, class := range expClasses {
+					suite.chainA.GetSimApp().NFTTransferKeeper.SetClass(suite.chainA.GetContext(), class)
+				}
+
+				req = &types.QueryClassesRequest{
+					Pagination: &query.PageRequest{
+						Limit:      5,
+						CountTotal: false,
+					},
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.GetSimApp().NFTTransferKeeper.Classes(ctx, req)
+
+			suite.Require().NoError(err)
+			suite.Require().NotNil(res)
+			suite.Require().Equal(expClasses.Sort(), res.Classes)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryParams() {
+	ctx := suite.chainA.GetContext()
+	expParams := types.DefaultParams()
+	res, _ := suite.chainA.GetSimApp().NFTTransferKeeper.Params(ctx, &types.QueryParamsRequest{})
+	suite.Require().Equal(&expParams, res.Params)
+}
+
+func (suite *KeeperTestSuite) TestQueryClassHash() {
+	reqTrace := types.NewClass("kitties", transfertypes.NewHop(types.PortID, "channel-1"), transfertypes.NewHop(types.PortID, "channel-2"))
+
+	var req *types.QueryClassHashRequest
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   bool
+	}{
+		{
+			"success",
+			func() {},
+			false,
+		},
+		{
+			"failure: invalid trace",
+			func() {
+				req = &types.QueryClassHashRequest{
+					Trace: "nfttransfer/channel-1/nfttransfer/",
+				}
+			},
+			true,
+		},
+		{
+			"failure: not found class trace",
+			func() {
+				req = &types.QueryClassHashRequest{
+					Trace: "nfttransfer/channel-1/kitties",
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			req = &types.QueryClassHashRequest{
+				Trace: reqTrace.Path(),
+			}
+			suite.chainA.GetSimApp().NFTTransferKeeper.SetClass(suite.chainA.GetContext(), reqTrace)
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.GetSimApp().NFTTransferKeeper.ClassHash(ctx, req)
+			if !tc.expErr {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(reqTrace.Hash().String(), res.Hash)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEscrowAddress() {
+	var req *types.QueryEscrowAddressRequest
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   bool
+	}{
+		{
+			"success",
+			func() {
+				req = &types.QueryEscrowAddressRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+				}
+			},
+			false,
+		},
+		{
+			"failure: channel not found",
+			func() {
+				req = &types.QueryEscrowAddressRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: ibctesting.InvalidID,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			path = NewNFTTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.GetSimApp().NFTTransferKeeper.EscrowAddress(ctx, req)
+
+			if !tc.expErr {
+				suite.Require().NoError(err)
+				expected := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID).String()
+				suite.Require().Equal(expected, res.EscrowAddress)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
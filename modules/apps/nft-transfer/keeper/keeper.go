@@ -0,0 +1,181 @@
+package keeper
+
+import (
+	"errors"
+	"strings"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/nft-transfer/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+// Keeper defines the IBC non-fungible token transfer keeper
+type Keeper struct {
+	storeService corestore.KVStoreService
+	cdc          codec.BinaryCodec
+
+	ics4Wrapper   porttypes.ICS4Wrapper
+	channelKeeper types.ChannelKeeper
+	msgRouter     types.MessageRouter
+	NFTKeeper     types.NFTKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new IBC non-fungible token transfer Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService corestore.KVStoreService,
+	ics4Wrapper porttypes.ICS4Wrapper,
+	channelKeeper types.ChannelKeeper,
+	msgRouter types.MessageRouter,
+	nftKeeper types.NFTKeeper,
+	authority string,
+) Keeper {
+	if strings.TrimSpace(authority) == "" {
+		panic(errors.New("authority must be non-empty"))
+	}
+
+	return Keeper{
+		cdc:           cdc,
+		storeService:  storeService,
+		ics4Wrapper:   ics4Wrapper,
+		channelKeeper: channelKeeper,
+		msgRouter:     msgRouter,
+		NFTKeeper:     nftKeeper,
+		authority:     authority,
+	}
+}
+
+// WithICS4Wrapper sets the ICS4Wrapper. This function may be used after
+// the keepers creation to set the middleware which is above this module
+// in the IBC application stack.
+func (k *Keeper) WithICS4Wrapper(wrapper porttypes.ICS4Wrapper) {
+	k.ics4Wrapper = wrapper
+}
+
+// GetICS4Wrapper returns the ICS4Wrapper.
+func (k Keeper) GetICS4Wrapper() porttypes.ICS4Wrapper {
+	return k.ics4Wrapper
+}
+
+// GetAuthority returns the nft-transfer module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+exported.ModuleName+"-"+types.ModuleName)
+}
+
+// GetPort returns the portID for the nft-transfer module. Used in ExportGenesis
+func (k Keeper) GetPort(ctx sdk.Context) string {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.PortKey)
+	if err != nil {
+		panic(err)
+	}
+	return string(bz)
+}
+
+// SetPort sets the portID for the nft-transfer module. Used in InitGenesis
+func (k Keeper) SetPort(ctx sdk.Context, portID string) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.PortKey, []byte(portID)); err != nil {
+		panic(err)
+	}
+}
+
+// GetParams returns the current nft-transfer module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get([]byte(types.ParamsKey))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil { // only panic on unset params and not on empty params
+		panic(errors.New("nft-transfer params are not set in store"))
+	}
+
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the nft-transfer module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&params)
+	if err := store.Set([]byte(types.ParamsKey), bz); err != nil {
+		panic(err)
+	}
+}
+
+// GetClass retrieves the class trace from store given the hash of the class.
+func (k Keeper) GetClass(ctx sdk.Context, classHash cmtbytes.HexBytes) (types.Class, bool) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ClassKey)
+	bz := store.Get(classHash)
+	if len(bz) == 0 {
+		return types.Class{}, false
+	}
+
+	var class types.Class
+	k.cdc.MustUnmarshal(bz, &class)
+
+	return class, true
+}
+
+// HasClass checks if a the key with the given class hash exists on the store.
+func (k Keeper) HasClass(ctx sdk.Context, classHash cmtbytes.HexBytes) bool {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ClassKey)
+	return store.Has(classHash)
+}
+
+// SetClass sets a new {class hash -> class trace} pair to the store.
+// This allows for reverse lookup of the class trace given the hash.
+func (k Keeper) SetClass(ctx sdk.Context, class types.Class) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ClassKey)
+	bz := k.cdc.MustMarshal(&class)
+	store.Set(class.Hash(), bz)
+}
+
+// GetAllClasses returns all the class traces.
+func (k Keeper) GetAllClasses(ctx sdk.Context) types.Classes {
+	classes := types.Classes{}
+	k.IterateClasses(ctx, func(class types.Class) bool {
+		classes = append(classes, class)
+		return false
+	})
+
+	return classes.Sort()
+}
+
+// IterateClasses iterates over the class traces in the store and performs a callback function.
+func (k Keeper) IterateClasses(ctx sdk.Context, cb func(class types.Class) bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.ClassKey)
+
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		var class types.Class
+		k.cdc.MustUnmarshal(iterator.Value(), &class)
+
+		if cb(class) {
+			break
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"testing"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/nft-transfer/keeper"
+	"github.com/cosmos/ibc-go/v10/modules/apps/nft-transfer/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+const classID = "kitties"
+
+type KeeperTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+	chainC *ibctesting.TestChain
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 3)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+	suite.chainC = suite.coordinator.GetChain(ibctesting.GetChainID(3))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(KeeperTestSuite))
+}
+
+// NewNFTTransferPath creates a new path with nft-transfer channel endpoints.
+func NewNFTTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = types.PortID
+	path.EndpointB.ChannelConfig.PortID = types.PortID
+	path.EndpointA.ChannelConfig.Version = types.V1
+	path.EndpointB.ChannelConfig.Version = types.V1
+
+	return path
+}
+
+// mintTokens creates the class if it does not exist and mints the tokens of the class to the sender account of the chain.
+func mintTokens(chain *ibctesting.TestChain, classID string, tokenIDs ...string) {
+	ctx := chain.GetContext()
+	nftKeeper := chain.GetSimApp().NFTKeeper
+
+	if !nftKeeper.HasClass(ctx, classID) {
+		if err := nftKeeper.SaveClass(ctx, classID, "class-uri", "class-data"); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, tokenID := range tokenIDs {
+		if err := nftKeeper.Mint(ctx, classID, tokenID, "uri-"+tokenID, "data-"+tokenID, chain.SenderAccount.GetAddress()); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// owner returns the owner of the token of the class on the chain.
+func owner(chain *ibctesting.TestChain, classID, tokenID string) sdk.AccAddress {
+	return chain.GetSimApp().NFTKeeper.GetOwner(chain.GetContext(), classID, tokenID)
+}
+
+func (suite *KeeperTestSuite) TestNewKeeper() {
+	testCases := []struct {
+		name          string
+		instantiateFn func()
+		panicMsg      string
+	}{
+		{"success", func() {
+			keeper.NewKeeper(
+				suite.chainA.GetSimApp().AppCodec(),
+				runtime.NewKVStoreService(suite.chainA.GetSimApp().GetKey(types.StoreKey)),
+				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper,
+				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper,
+				suite.chainA.GetSimApp().MsgServiceRouter(),
+				suite.chainA.GetSimApp().NFTKeeper,
+				suite.chainA.GetSimApp().NFTTransferKeeper.GetAuthority(),
+			)
+		}, ""},
+		{"failure: empty authority", func() {
+			keeper.NewKeeper(
+				suite.chainA.GetSimApp().AppCodec(),
+				runtime.NewKVStoreService(suite.chainA.GetSimApp().GetKey(types.StoreKey)),
+				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper,
+				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper,
+				suite.chainA.GetSimApp().MsgServiceRouter(),
+				suite.chainA.GetSimApp().NFTKeeper,
+				"", // authority
+			)
+		}, "authority must be non-empty"},
+	}
+
+	for _, tc := range testCases {
+		suite.SetupTest()
+
+		suite.Run(tc.name, func() {
+			if tc.panicMsg == "" {
+				suite.Require().NotPanics(tc.instantiateFn)
+			} else {
+				suite.Require().PanicsWithError(tc.panicMsg, tc.instantiateFn)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestParams() {
+	ctx := suite.chainA.GetContext()
+	nftTransferKeeper := suite.chainA.GetSimApp().NFTTransferKeeper
+
+	suite.Require().Equal(types.DefaultParams(), nftTransferKeeper.GetParams(ctx))
+
+	expParams := types.NewParams(false, true)
+	nftTransferKeeper.SetParams(ctx, expParams)
+	suite.Require().Equal(expParams, nftTransferKeeper.GetParams(ctx))
+}
+
+func (suite *KeeperTestSuite) TestSetGetClass() {
+	ctx := suite.chainA.GetContext()
+	nftTransferKeeper := suite.chainA.GetSimApp().NFTTransferKeeper
+
+	class := types.NewClass(classID, transfertypes.NewHop(types.PortID, ibctesting.FirstChannelID))
+	suite.Require().False(nftTransferKeeper.HasClass(ctx, class.Hash()))
+
+	nftTransferKeeper.SetClass(ctx, class)
+	suite.Require().True(nftTransferKeeper.HasClass(ctx, class.Hash()))
+
+	stored, found := nftTransferKeeper.GetClass(ctx, class.Hash())
+	suite.Require().True(found)
+	suite.Require().Equal(class, stored)
+	suite.Require().Equal(types.Classes{class}, nftTransferKeeper.GetAllClasses(ctx))
+
+	resolved, err := nftTransferKeeper.ClassFromClassID(ctx, class.IBCClassID())
+	suite.Require().NoError(err)
+	suite.Require().Equal(class, resolved)
+
+	_, err = nftTransferKeeper.ClassFromClassID(ctx, types.NewClass(classID, transfertypes.NewHop(types.PortID, "channel-1")).IBCClassID())
+	suite.Require().ErrorIs(err, types.ErrClassNotFound)
+}
@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/nft-transfer/internal/events"
+	"github.com/cosmos/ibc-go/v10/modules/apps/nft-transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+)
+
+var _ types.MsgServer = (*Keeper)(nil)
+
+// Transfer defines an rpc handler method for MsgTransfer.
+func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.MsgTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetParams(ctx).SendEnabled {
+		return nil, types.ErrSendDisabled
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	packetData, err := k.PacketDataFromTokens(ctx, msg.ClassId, msg.TokenIds, sender.String(), msg.Receiver, msg.Memo)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := packetData.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to validate %s packet data", types.V1)
+	}
+
+	// if a channel exists with source channel, then use IBC V1 protocol
+	// otherwise use IBC V2 protocol
+	var sequence uint64
+	if _, isIBCV1 := k.channelKeeper.GetChannel(ctx, msg.SourcePort, msg.SourceChannel); isIBCV1 {
+		sequence, err = k.transferV1Packet(ctx, msg.SourcePort, msg.SourceChannel, msg.TimeoutHeight, msg.TimeoutTimestamp, packetData)
+	} else {
+		// otherwise try to send an IBC V2 packet, if the sourceChannel is not a IBC V2 client
+		// then core IBC will return a CounterpartyNotFound error
+		sequence, err = k.transferV2Packet(ctx, msg.Encoding, msg.SourceChannel, msg.TimeoutTimestamp, packetData)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("IBC non-fungible token transfer", "class", msg.ClassId, "tokens", msg.TokenIds, "sender", msg.Sender, "receiver", msg.Receiver)
+
+	return &types.MsgTransferResponse{Sequence: sequence}, nil
+}
+
+func (k Keeper) transferV1Packet(ctx sdk.Context, sourcePort, sourceChannel string, timeoutHeight clienttypes.Height, timeoutTimestamp uint64, packetData types.NonFungibleTokenPacketData) (uint64, error) {
+	if err := k.SendTransfer(ctx, sourcePort, sourceChannel, packetData, sdk.MustAccAddressFromBech32(packetData.Sender)); err != nil {
+		return 0, err
+	}
+
+	sequence, err := k.ics4Wrapper.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetData.GetBytes())
+	if err != nil {
+		return 0, err
+	}
+
+	events.EmitTransferEvent(ctx, packetData)
+
+	return sequence, nil
+}
+
+func (k Keeper) transferV2Packet(ctx sdk.Context, encoding, sourceChannel string, timeoutTimestamp uint64, packetData types.NonFungibleTokenPacketData) (uint64, error) {
+	if encoding == "" {
+		encoding = types.EncodingJSON
+	}
+
+	data, err := types.MarshalPacketData(packetData, types.V1, encoding)
+	if err != nil {
+		return 0, err
+	}
+
+	payload := channeltypesv2.NewPayload(
+		types.PortID, types.PortID,
+		types.V1, encoding, data,
+	)
+	msg := channeltypesv2.NewMsgSendPacket(
+		sourceChannel, timeoutTimestamp,
+		packetData.Sender, payload,
+	)
+
+	handler := k.msgRouter.Handler(msg)
+	if handler == nil {
+		return 0, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "unrecognized packet type: %T", msg)
+	}
+	res, err := handler(ctx, msg)
+	if err != nil {
+		return 0, err
+	}
+
+	// NOTE: The sdk msg handler creates a new EventManager, so events must be correctly propagated back to the current context
+	ctx.EventManager().EmitEvents(res.GetEvents())
+
+	// Each individual sdk.Result has exactly one Msg response. We aggregate here.
+	msgResponse := res.MsgResponses[0]
+	if msgResponse == nil {
+		return 0, errorsmod.Wrapf(ibcerrors.ErrLogic, "got nil Msg response for msg %s", sdk.MsgTypeURL(msg))
+	}
+	var sendResponse channeltypesv2.MsgSendPacketResponse
+	err = proto.Unmarshal(msgResponse.Value, &sendResponse)
+	if err != nil {
+		return 0, err
+	}
+
+	return sendResponse.Sequence, nil
+}
+
+// UpdateParams defines an rpc handler method for MsgUpdateParams. Updates the ibc nft-transfer module's parameters.
+func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetParams(ctx, msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,198 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/nft-transfer/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	clienttypesv2 "github.com/cosmos/ibc-go/v10/modules/core/02-client/v2/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+func (suite *KeeperTestSuite) TestMsgTransfer() {
+	var (
+		path *ibctesting.Path
+		msg  *types.MsgTransfer
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: send transfers disabled",
+			func() {
+				suite.chainA.GetSimApp().NFTTransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false, true))
+			},
+			types.ErrSendDisabled,
+		},
+		{
+			"failure: class does not exist",
+			func() {
+				msg.ClassId = "dogs"
+			},
+			types.ErrClassNotFound,
+		},
+		{
+			"failure: voucher class trace not found",
+			func() {
+				msg.ClassId = types.NewClass(classID, transfertypes.NewHop(types.PortID, "channel-1")).IBCClassID()
+			},
+			types.ErrClassNotFound,
+		},
+		{
+			"failure: token does not exist",
+			func() {
+				msg.TokenIds = []string{"1", "3"}
+			},
+			types.ErrTokenNotFound,
+		},
+		{
+			"failure: sender is not the owner",
+			func() {
+				msg.Sender = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: channel does not exist",
+			func() {
+				msg.SourceChannel = "channel-100"
+			},
+			clienttypesv2.ErrCounterpartyNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = NewNFTTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			mintTokens(suite.chainA, classID, "1", "2")
+
+			msg = types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+				classID, []string{"1", "2"},
+				suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+				clienttypes.ZeroHeight(), suite.chainA.GetTimeoutTimestamp(), "",
+			)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().NFTTransferKeeper.Transfer(ctx, msg)
+
+			if tc.expError != nil {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().NotNil(res)
+			suite.Require().Equal(uint64(1), res.Sequence)
+
+			expEvents := sdk.Events{
+				sdk.NewEvent(types.EventTypeTransfer,
+					sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+					sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+					sdk.NewAttribute(types.AttributeKeyClassID, msg.ClassId),
+					sdk.NewAttribute(types.AttributeKeyTokenIDs, "1,2"),
+					sdk.NewAttribute(types.AttributeKeyMemo, msg.Memo),
+				),
+				sdk.NewEvent(
+					sdk.EventTypeMessage,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				),
+			}.ToABCIEvents()
+			ibctesting.AssertEvents(&suite.Suite, expEvents, ctx.EventManager().Events().ToABCIEvents())
+		})
+	}
+}
+
+// TestMsgTransferIBCV2 tests that a MsgTransfer is routed over IBC v2 when the source channel is a client identifier.
+func (suite *KeeperTestSuite) TestMsgTransferIBCV2() {
+	for _, encoding := range []string{"", types.EncodingJSON, types.EncodingProtobuf, types.EncodingABI} {
+		suite.Run(encoding, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupV2()
+
+			mintTokens(suite.chainA, classID, "1")
+
+			msg := types.NewMsgTransferWithEncoding(
+				types.PortID, path.EndpointA.ClientID,
+				classID, []string{"1"},
+				suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+				clienttypes.ZeroHeight(), suite.chainA.GetTimeoutTimestampSecs(), "", encoding,
+			)
+
+			res, err := suite.chainA.GetSimApp().NFTTransferKeeper.Transfer(suite.chainA.GetContext(), msg)
+			suite.Require().NoError(err)
+			suite.Require().Equal(uint64(1), res.Sequence)
+
+			escrow := types.GetEscrowAddress(types.PortID, path.EndpointA.ClientID)
+			suite.Require().Equal(escrow, owner(suite.chainA, classID, "1"))
+		})
+	}
+}
+
+// TestUpdateParams tests UpdateParams rpc handler
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	signer := suite.chainA.GetSimApp().NFTTransferKeeper.GetAuthority()
+	testCases := []struct {
+		name   string
+		msg    *types.MsgUpdateParams
+		expErr error
+	}{
+		{
+			"success: valid signer and default params",
+			types.NewMsgUpdateParams(signer, types.DefaultParams()),
+			nil,
+		},
+		{
+			"success: valid signer and disabled params",
+			types.NewMsgUpdateParams(signer, types.NewParams(false, false)),
+			nil,
+		},
+		{
+			"failure: malformed signer address",
+			types.NewMsgUpdateParams(ibctesting.InvalidID, types.DefaultParams()),
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: empty signer address",
+			types.NewMsgUpdateParams("", types.DefaultParams()),
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: unauthorized signer address",
+			types.NewMsgUpdateParams(ibctesting.TestAccAddress, types.DefaultParams()),
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			_, err := suite.chainA.GetSimApp().NFTTransferKeeper.UpdateParams(suite.chainA.GetContext(), tc.msg)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.msg.Params, suite.chainA.GetSimApp().NFTTransferKeeper.GetParams(suite.chainA.GetContext()))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
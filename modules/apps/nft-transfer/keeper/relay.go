@@ -0,0 +1,289 @@
+package keeper
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/nft-transfer/internal/events"
+	"github.com/cosmos/ibc-go/v10/modules/apps/nft-transfer/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+)
+
+// SendTransfer handles non-fungible token transfer sending logic. As for ICS20 fungible
+// token transfers, there are 2 possible cases:
+//
+// 1. Sender chain is acting as the source zone. The tokens are transferred
+// to an escrow address (i.e locked) on the sender chain and then transferred
+// to the receiving chain through IBC TAO logic. It is expected that the
+// receiving chain will mint vouchers of the tokens to the receiving address.
+//
+// 2. Sender chain is acting as the sink zone. The tokens (vouchers) are burned
+// on the sender chain and then transferred to the receiving chain though IBC
+// TAO logic. It is expected that the receiving chain, which had previously
+// sent the original tokens, will unescrow the tokens and send them to the
+// receiving address.
+//
+// The class ID of the packet data is the full path of the class, the token IDs
+// are left untouched as they are unique within their class.
+func (k Keeper) SendTransfer(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel string,
+	data types.NonFungibleTokenPacketData,
+	sender sdk.AccAddress,
+) error {
+	if !k.GetParams(ctx).SendEnabled {
+		return types.ErrSendDisabled
+	}
+
+	class := types.ExtractClassFromPath(data.ClassId)
+	classID := class.IBCClassID()
+
+	// if the class is prefixed by the port and channel on which we are sending
+	// the tokens, then we must be returning the tokens back to the chain they originated from
+	isSink := class.HasPrefix(sourcePort, sourceChannel)
+	escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
+
+	for _, tokenID := range data.TokenIds {
+		if owner := k.NFTKeeper.GetOwner(ctx, classID, tokenID); !sender.Equals(owner) {
+			return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not the owner of token %s of class %s", sender, tokenID, classID)
+		}
+
+		if isSink {
+			if err := k.NFTKeeper.Burn(ctx, classID, tokenID); err != nil {
+				return err
+			}
+		} else {
+			if err := k.NFTKeeper.Transfer(ctx, classID, tokenID, escrowAddress); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// OnRecvPacket processes a cross chain non-fungible token transfer.
+//
+// If the sender chain is the source of the tokens then vouchers of the tokens will be
+// minted to the receiving address, the class of the vouchers is created if it does not
+// exist yet. Otherwise if the sender chain is sending back tokens this chain originally
+// transferred to it, the tokens are unescrowed and sent to the receiving address.
+func (k Keeper) OnRecvPacket(
+	ctx sdk.Context,
+	data types.NonFungibleTokenPacketData,
+	sourcePort string,
+	sourceChannel string,
+	destPort string,
+	destChannel string,
+) error {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return errorsmod.Wrapf(err, "error validating ICS-721 transfer packet data")
+	}
+
+	if !k.GetParams(ctx).ReceiveEnabled {
+		return types.ErrReceiveDisabled
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "failed to decode receiver address: %s", data.Receiver)
+	}
+
+	class := types.ExtractClassFromPath(data.ClassId)
+
+	// NOTE: We use SourcePort and SourceChannel here, because the counterparty
+	// chain would have prefixed with DestPort and DestChannel when originally
+	// receiving this class.
+	if class.HasPrefix(sourcePort, sourceChannel) {
+		// sender chain is not the source, unescrow tokens
+
+		// remove prefix added by sender chain
+		class.Trace = class.Trace[1:]
+		classID := class.IBCClassID()
+
+		escrowAddress := types.GetEscrowAddress(destPort, destChannel)
+		for _, tokenID := range data.TokenIds {
+			if owner := k.NFTKeeper.GetOwner(ctx, classID, tokenID); !escrowAddress.Equals(owner) {
+				return errorsmod.Wrapf(types.ErrTokenNotFound, "token %s of class %s is not escrowed", tokenID, classID)
+			}
+
+			if err := k.NFTKeeper.Transfer(ctx, classID, tokenID, receiver); err != nil {
+				return errorsmod.Wrapf(err, "failed to unescrow token %s of class %s", tokenID, classID)
+			}
+		}
+
+		return nil
+	}
+
+	// sender chain is the source, mint vouchers
+
+	// since SendPacket did not prefix the class, we must add the destination port and channel to the trace
+	trace := []transfertypes.Hop{transfertypes.NewHop(destPort, destChannel)}
+	class.Trace = append(trace, class.Trace...)
+
+	if !k.HasClass(ctx, class.Hash()) {
+		k.SetClass(ctx, class)
+	}
+
+	voucherClassID := class.IBCClassID()
+	if !k.NFTKeeper.HasClass(ctx, voucherClassID) {
+		if err := k.NFTKeeper.SaveClass(ctx, voucherClassID, data.ClassUri, data.ClassData); err != nil {
+			return errorsmod.Wrapf(err, "failed to create class %s", voucherClassID)
+		}
+	}
+
+	events.EmitClassEvent(ctx, class)
+
+	for i, tokenID := range data.TokenIds {
+		if err := k.NFTKeeper.Mint(ctx, voucherClassID, tokenID, data.TokenURIAt(i), data.TokenDataAt(i), receiver); err != nil {
+			return errorsmod.Wrapf(err, "failed to mint token %s of class %s", tokenID, voucherClassID)
+		}
+	}
+
+	// The ibc_module.go module will return the proper ack.
+	return nil
+}
+
+// OnAcknowledgementPacket responds to the success or failure of a packet acknowledgment
+// written on the receiving chain.
+//
+// If the acknowledgement was a success then nothing occurs. Otherwise,
+// if the acknowledgement failed, then the sender is refunded their tokens.
+func (k Keeper) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	sourcePort string,
+	sourceChannel string,
+	data types.NonFungibleTokenPacketData,
+	ack channeltypes.Acknowledgement,
+) error {
+	switch ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Result:
+		// the acknowledgement succeeded on the receiving chain so nothing
+		// needs to be executed and no error needs to be returned
+		return nil
+	case *channeltypes.Acknowledgement_Error:
+		return k.refundPacketTokens(ctx, sourcePort, sourceChannel, data)
+	default:
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected one of [%T, %T], got %T", channeltypes.Acknowledgement_Result{}, channeltypes.Acknowledgement_Error{}, ack.Response)
+	}
+}
+
+// OnTimeoutPacket processes a non-fungible token transfer packet timeout by refunding the tokens to the sender
+func (k Keeper) OnTimeoutPacket(
+	ctx sdk.Context,
+	sourcePort string,
+	sourceChannel string,
+	data types.NonFungibleTokenPacketData,
+) error {
+	return k.refundPacketTokens(ctx, sourcePort, sourceChannel, data)
+}
+
+// refundPacketTokens will unescrow and send back the tokens to the sender
+// if the sending chain was the source chain. Otherwise, the sent tokens
+// were burnt in the original send so the tokens are minted again to the
+// sending address with the metadata carried in the packet.
+func (k Keeper) refundPacketTokens(
+	ctx sdk.Context,
+	sourcePort string,
+	sourceChannel string,
+	data types.NonFungibleTokenPacketData,
+) error {
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return err
+	}
+
+	class := types.ExtractClassFromPath(data.ClassId)
+	classID := class.IBCClassID()
+
+	// escrow address for unescrowing tokens back to sender
+	escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
+
+	// if the class of the tokens we must refund is prefixed by the source port and channel
+	// then the tokens were burnt when the packet was sent and we must mint them again
+	isSink := class.HasPrefix(sourcePort, sourceChannel)
+
+	for i, tokenID := range data.TokenIds {
+		if isSink {
+			if err := k.NFTKeeper.Mint(ctx, classID, tokenID, data.TokenURIAt(i), data.TokenDataAt(i), sender); err != nil {
+				return err
+			}
+		} else {
+			if err := k.NFTKeeper.Transfer(ctx, classID, tokenID, sender); err != nil {
+				return errorsmod.Wrapf(err, "failed to unescrow token %s of class %s from %s", tokenID, classID, escrowAddress)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ClassFromClassID returns the class trace of the given class ID. Class IDs of vouchers
+// in the format 'ibc/{hash}' are resolved to their trace, any other class ID is native.
+func (k Keeper) ClassFromClassID(ctx sdk.Context, classID string) (types.Class, error) {
+	// class is not an IBC voucher class, it is a native class
+	if !strings.HasPrefix(classID, types.ClassPrefix+"/") {
+		return types.NewClass(classID), nil
+	}
+
+	hash, err := transfertypes.ParseHexHash(strings.TrimPrefix(classID, types.ClassPrefix+"/"))
+	if err != nil {
+		return types.Class{}, errorsmod.Wrap(types.ErrInvalidClassForTransfer, err.Error())
+	}
+
+	class, found := k.GetClass(ctx, hash)
+	if !found {
+		return types.Class{}, errorsmod.Wrap(types.ErrClassNotFound, hash.String())
+	}
+
+	return class, nil
+}
+
+// PacketDataFromTokens builds the packet data of a transfer of the given tokens. The class
+// and token metadata are read from the non-fungible token keeper and carried in the packet
+// such that the receiving chain can create the vouchers with the same metadata.
+func (k Keeper) PacketDataFromTokens(ctx sdk.Context, classID string, tokenIDs []string, sender, receiver, memo string) (types.NonFungibleTokenPacketData, error) {
+	class, err := k.ClassFromClassID(ctx, classID)
+	if err != nil {
+		return types.NonFungibleTokenPacketData{}, err
+	}
+
+	classURI, classData, found := k.NFTKeeper.GetClass(ctx, classID)
+	if !found {
+		return types.NonFungibleTokenPacketData{}, errorsmod.Wrap(types.ErrClassNotFound, classID)
+	}
+
+	var (
+		tokenURIs   = make([]string, len(tokenIDs))
+		tokenData   = make([]string, len(tokenIDs))
+		hasURIs     bool
+		hasMetadata bool
+	)
+	for i, tokenID := range tokenIDs {
+		uri, data, found := k.NFTKeeper.GetNFT(ctx, classID, tokenID)
+		if !found {
+			return types.NonFungibleTokenPacketData{}, errorsmod.Wrapf(types.ErrTokenNotFound, "token %s of class %s", tokenID, classID)
+		}
+
+		tokenURIs[i], tokenData[i] = uri, data
+		hasURIs = hasURIs || uri != ""
+		hasMetadata = hasMetadata || data != ""
+	}
+
+	// omit the optional token metadata from the packet if it is not set for any token
+	if !hasURIs {
+		tokenURIs = nil
+	}
+	if !hasMetadata {
+		tokenData = nil
+	}
+
+	return types.NewNonFungibleTokenPacketData(class.Path(), classURI, classData, tokenIDs, tokenURIs, tokenData, sender, receiver, memo), nil
+}
@@ -0,0 +1,314 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v10/modules/apps/nft-transfer/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+func (suite *KeeperTestSuite) TestSendTransfer() {
+	var (
+		path       *ibctesting.Path
+		packetData types.NonFungibleTokenPacketData
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		isSink   bool
+		expError error
+	}{
+		{
+			"success: source chain escrows tokens",
+			func() {},
+			false,
+			nil,
+		},
+		{
+			"success: sink chain burns vouchers",
+			func() {
+				// receive vouchers of a class originating from chainB
+				voucher := types.NewClass(classID, transfertypes.NewHop(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+				mintTokens(suite.chainA, voucher.IBCClassID(), "2")
+				packetData.ClassId = voucher.Path()
+				packetData.TokenIds = []string{"2"}
+			},
+			true,
+			nil,
+		},
+		{
+			"failure: send disabled",
+			func() {
+				suite.chainA.GetSimApp().NFTTransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false, true))
+			},
+			false,
+			types.ErrSendDisabled,
+		},
+		{
+			"failure: sender is not the owner",
+			func() {
+				packetData.Sender = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+			},
+			false,
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: token does not exist",
+			func() {
+				packetData.TokenIds = []string{"1", "3"}
+			},
+			false,
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewNFTTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			mintTokens(suite.chainA, classID, "1")
+			packetData = types.NewNonFungibleTokenPacketData(classID, "", "", []string{"1"}, nil, nil, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "")
+
+			tc.malleate()
+
+			sender, err := suite.chainA.GetSimApp().AccountKeeper.AddressCodec().StringToBytes(packetData.Sender)
+			suite.Require().NoError(err)
+
+			err = suite.chainA.GetSimApp().NFTTransferKeeper.SendTransfer(
+				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, packetData, sender,
+			)
+
+			if tc.expError != nil {
+				suite.Require().ErrorIs(err, tc.expError)
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			localClassID := types.ExtractClassFromPath(packetData.ClassId).IBCClassID()
+			for _, tokenID := range packetData.TokenIds {
+				if tc.isSink {
+					suite.Require().Nil(owner(suite.chainA, localClassID, tokenID))
+				} else {
+					suite.Require().Equal(types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID), owner(suite.chainA, localClassID, tokenID))
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacket() {
+	var (
+		path       *ibctesting.Path
+		packetData types.NonFungibleTokenPacketData
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: receive disabled",
+			func() {
+				suite.chainB.GetSimApp().NFTTransferKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, false))
+			},
+			types.ErrReceiveDisabled,
+		},
+		{
+			"failure: invalid receiver",
+			func() {
+				packetData.Receiver = "invalid"
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: invalid packet data",
+			func() {
+				packetData.TokenIds = nil
+			},
+			types.ErrInvalidTokenID,
+		},
+		{
+			"failure: unescrowed token is not in escrow",
+			func() {
+				// chainA claims to send back a token of a class originating from chainB which was never escrowed
+				packetData.ClassId = types.NewClass(classID, transfertypes.NewHop(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)).Path()
+			},
+			types.ErrTokenNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewNFTTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			packetData = types.NewNonFungibleTokenPacketData(classID, "class-uri", "class-data", []string{"1", "2"}, []string{"uri-1", "uri-2"}, nil, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "")
+
+			tc.malleate()
+
+			err := suite.chainB.GetSimApp().NFTTransferKeeper.OnRecvPacket(
+				suite.chainB.GetContext(), packetData,
+				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID,
+			)
+
+			if tc.expError != nil {
+				suite.Require().ErrorIs(err, tc.expError)
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			ctx := suite.chainB.GetContext()
+			voucher := types.NewClass(classID, transfertypes.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+			suite.Require().True(suite.chainB.GetSimApp().NFTTransferKeeper.HasClass(ctx, voucher.Hash()))
+
+			classURI, classData, found := suite.chainB.GetSimApp().NFTKeeper.GetClass(ctx, voucher.IBCClassID())
+			suite.Require().True(found)
+			suite.Require().Equal("class-uri", classURI)
+			suite.Require().Equal("class-data", classData)
+
+			for i, tokenID := range packetData.TokenIds {
+				suite.Require().Equal(suite.chainB.SenderAccount.GetAddress(), owner(suite.chainB, voucher.IBCClassID(), tokenID))
+
+				tokenURI, tokenData, found := suite.chainB.GetSimApp().NFTKeeper.GetNFT(ctx, voucher.IBCClassID(), tokenID)
+				suite.Require().True(found)
+				suite.Require().Equal(packetData.TokenUris[i], tokenURI)
+				suite.Require().Empty(tokenData)
+			}
+		})
+	}
+}
+
+// TestTransferRoundTrip transfers tokens A -> B -> C and back C -> B -> A, checking that vouchers
+// are minted and burned and that the original tokens are escrowed and unescrowed on the way.
+func (suite *KeeperTestSuite) TestTransferRoundTrip() {
+	pathAToB := NewNFTTransferPath(suite.chainA, suite.chainB)
+	pathAToB.Setup()
+	pathBToC := NewNFTTransferPath(suite.chainB, suite.chainC)
+	pathBToC.Setup()
+
+	mintTokens(suite.chainA, classID, "1", "2")
+
+	transfer := func(path *ibctesting.Path, classID string) {
+		sender := path.EndpointA.Chain.SenderAccount.GetAddress().String()
+		receiver := path.EndpointB.Chain.SenderAccount.GetAddress().String()
+		msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, classID, []string{"1", "2"}, sender, receiver, clienttypes.ZeroHeight(), path.EndpointA.Chain.GetTimeoutTimestamp(), "")
+
+		res, err := path.EndpointA.Chain.SendMsgs(msg)
+		suite.Require().NoError(err)
+
+		packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+		suite.Require().NoError(err)
+
+		_, ack, err := path.RelayPacketWithResults(packet)
+		suite.Require().NoError(err)
+		suite.Require().Equal(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(), ack)
+	}
+
+	// A -> B: tokens are escrowed on A and vouchers are minted on B
+	transfer(pathAToB, classID)
+	escrowA := types.GetEscrowAddress(pathAToB.EndpointA.ChannelConfig.PortID, pathAToB.EndpointA.ChannelID)
+	suite.Require().Equal(escrowA, owner(suite.chainA, classID, "1"))
+
+	voucherB := types.NewClass(classID, transfertypes.NewHop(pathAToB.EndpointB.ChannelConfig.PortID, pathAToB.EndpointB.ChannelID))
+	suite.Require().Equal(suite.chainB.SenderAccount.GetAddress(), owner(suite.chainB, voucherB.IBCClassID(), "1"))
+
+	tokenURI, tokenData, found := suite.chainB.GetSimApp().NFTKeeper.GetNFT(suite.chainB.GetContext(), voucherB.IBCClassID(), "2")
+	suite.Require().True(found)
+	suite.Require().Equal("uri-2", tokenURI)
+	suite.Require().Equal("data-2", tokenData)
+
+	// B -> C: vouchers are escrowed on B and vouchers of vouchers are minted on C
+	transfer(pathBToC, voucherB.IBCClassID())
+	escrowB := types.GetEscrowAddress(pathBToC.EndpointA.ChannelConfig.PortID, pathBToC.EndpointA.ChannelID)
+	suite.Require().Equal(escrowB, owner(suite.chainB, voucherB.IBCClassID(), "1"))
+
+	voucherC := types.NewClass(classID, transfertypes.NewHop(pathBToC.EndpointB.ChannelConfig.PortID, pathBToC.EndpointB.ChannelID), voucherB.Trace[0])
+	suite.Require().Equal(suite.chainC.SenderAccount.GetAddress(), owner(suite.chainC, voucherC.IBCClassID(), "1"))
+
+	// C -> B: vouchers are burned on C and unescrowed on B
+	transfer(pathBToC.Reversed(), voucherC.IBCClassID())
+	suite.Require().Nil(owner(suite.chainC, voucherC.IBCClassID(), "1"))
+	suite.Require().Equal(suite.chainB.SenderAccount.GetAddress(), owner(suite.chainB, voucherB.IBCClassID(), "1"))
+
+	// B -> A: vouchers are burned on B and the original tokens are unescrowed on A
+	transfer(pathAToB.Reversed(), voucherB.IBCClassID())
+	suite.Require().Nil(owner(suite.chainB, voucherB.IBCClassID(), "1"))
+	suite.Require().Equal(suite.chainA.SenderAccount.GetAddress(), owner(suite.chainA, classID, "1"))
+	suite.Require().Equal(suite.chainA.SenderAccount.GetAddress(), owner(suite.chainA, classID, "2"))
+}
+
+func (suite *KeeperTestSuite) TestRefundPacketTokens() {
+	testCases := []struct {
+		name   string
+		refund func(path *ibctesting.Path, packetData types.NonFungibleTokenPacketData) error
+	}{
+		{
+			"error acknowledgement",
+			func(path *ibctesting.Path, packetData types.NonFungibleTokenPacketData) error {
+				ack := channeltypes.NewErrorAcknowledgement(ibcerrors.ErrInvalidRequest)
+				return suite.chainA.GetSimApp().NFTTransferKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, packetData, ack)
+			},
+		},
+		{
+			"timeout",
+			func(path *ibctesting.Path, packetData types.NonFungibleTokenPacketData) error {
+				return suite.chainA.GetSimApp().NFTTransferKeeper.OnTimeoutPacket(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, packetData)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := NewNFTTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			sender := suite.chainA.SenderAccount.GetAddress()
+			nftTransferKeeper := suite.chainA.GetSimApp().NFTTransferKeeper
+
+			// escrowed native token is unescrowed
+			mintTokens(suite.chainA, classID, "1")
+			packetData, err := nftTransferKeeper.PacketDataFromTokens(suite.chainA.GetContext(), classID, []string{"1"}, sender.String(), suite.chainB.SenderAccount.GetAddress().String(), "")
+			suite.Require().NoError(err)
+			suite.Require().NoError(nftTransferKeeper.SendTransfer(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, packetData, sender))
+
+			suite.Require().NoError(tc.refund(path, packetData))
+			suite.Require().Equal(sender, owner(suite.chainA, classID, "1"))
+
+			// burned voucher is minted again with its metadata
+			voucher := types.NewClass(classID, transfertypes.NewHop(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+			nftTransferKeeper.SetClass(suite.chainA.GetContext(), voucher)
+			mintTokens(suite.chainA, voucher.IBCClassID(), "2")
+			packetData, err = nftTransferKeeper.PacketDataFromTokens(suite.chainA.GetContext(), voucher.IBCClassID(), []string{"2"}, sender.String(), suite.chainB.SenderAccount.GetAddress().String(), "")
+			suite.Require().NoError(err)
+			suite.Require().NoError(nftTransferKeeper.SendTransfer(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, packetData, sender))
+			suite.Require().Nil(owner(suite.chainA, voucher.IBCClassID(), "2"))
+
+			suite.Require().NoError(tc.refund(path, packetData))
+			suite.Require().Equal(sender, owner(suite.chainA, voucher.IBCClassID(), "2"))
+
+			tokenURI, tokenData, found := suite.chainA.GetSimApp().NFTKeeper.GetNFT(suite.chainA.GetContext(), voucher.IBCClassID(), "2")
+			suite.Require().True(found)
+			suite.Require().Equal("uri-2", tokenURI)
+			suite.Require().Equal("data-2", tokenData)
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
+
+// NewClass creates a new Class instance given the base class ID and a variable number of hops.
+func NewClass(base string, trace ...transfertypes.Hop) Class {
+	return Class{
+		Base:  base,
+		Trace: trace,
+	}
+}
+
+// Validate performs a basic validation of the Class fields.
+func (c Class) Validate() error {
+	// NOTE: base class ID validation cannot be performed as each chain may define
+	// its own class ID validation
+	if strings.TrimSpace(c.Base) == "" {
+		return errorsmod.Wrap(ErrInvalidClassForTransfer, "base class ID cannot be blank")
+	}
+
+	for _, hop := range c.Trace {
+		if err := hop.Validate(); err != nil {
+			return errorsmod.Wrap(err, "invalid trace")
+		}
+	}
+
+	return nil
+}
+
+// Hash returns the hex bytes of the SHA256 hash of the Class fields using the following formula:
+//
+// hash = sha256(trace + "/" + baseClassID)
+func (c Class) Hash() cmtbytes.HexBytes {
+	hash := sha256.Sum256([]byte(c.Path()))
+	return hash[:]
+}
+
+// IBCClassID returns the class ID of a non-fungible token class in the format
+// 'ibc/{hash(trace + baseClassID)}'. If the trace is empty, it will return the base class ID.
+func (c Class) IBCClassID() string {
+	if c.IsNative() {
+		return c.Base
+	}
+
+	return fmt.Sprintf("%s/%s", ClassPrefix, c.Hash())
+}
+
+// Path returns the full class ID according to the ICS721 specification:
+// trace + "/" + baseClassID
+// If there exists no trace then the base class ID is returned.
+func (c Class) Path() string {
+	if c.IsNative() {
+		return c.Base
+	}
+
+	var sb strings.Builder
+	for _, t := range c.Trace {
+		sb.WriteString(t.String()) // nolint:revive // no error returned by WriteString
+		sb.WriteByte('/')          //nolint:revive // no error returned by WriteByte
+	}
+	sb.WriteString(c.Base) //nolint:revive
+	return sb.String()
+}
+
+// IsNative returns true if the class is native, thus containing no trace history.
+func (c Class) IsNative() bool {
+	return len(c.Trace) == 0
+}
+
+// HasPrefix returns true if the first element of the trace of the class
+// matches the provided portId and channelId.
+func (c Class) HasPrefix(portID, channelID string) bool {
+	// if the class is native, then it is not prefixed by any port/channel pair
+	if c.IsNative() {
+		return false
+	}
+
+	return c.Trace[0].PortId == portID && c.Trace[0].ChannelId == channelID
+}
+
+// Classes defines a wrapper type for a slice of Class.
+type Classes []Class
+
+// Validate performs a basic validation of each class trace info.
+func (c Classes) Validate() error {
+	seenClasses := make(map[string]bool)
+	for i, class := range c {
+		hash := class.Hash().String()
+		if seenClasses[hash] {
+			return fmt.Errorf("duplicated class with hash %s", class.Hash())
+		}
+
+		if err := class.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "failed class %d validation", i)
+		}
+		seenClasses[hash] = true
+	}
+	return nil
+}
+
+var _ sort.Interface = (*Classes)(nil)
+
+// Len implements sort.Interface for Classes
+func (c Classes) Len() int { return len(c) }
+
+// Less implements sort.Interface for Classes
+func (c Classes) Less(i, j int) bool {
+	if c[i].Base != c[j].Base {
+		return c[i].Base < c[j].Base
+	}
+
+	if len(c[i].Trace) != len(c[j].Trace) {
+		return len(c[i].Trace) < len(c[j].Trace)
+	}
+
+	return c[i].Path() < c[j].Path()
+}
+
+// Swap implements sort.Interface for Classes
+func (c Classes) Swap(i, j int) { c[i], c[j] = c[j], c[i] }
+
+// Sort is a helper function to sort the set of classes in-place
+func (c Classes) Sort() Classes {
+	sort.Sort(c)
+	return c
+}
+
+// ExtractClassFromPath returns the class from the full class ID path.
+func ExtractClassFromPath(fullPath string) Class {
+	classSplit := strings.Split(fullPath, "/")
+
+	if classSplit[0] == fullPath {
+		return Class{
+			Base: fullPath,
+		}
+	}
+
+	var (
+		trace          []transfertypes.Hop
+		baseClassSlice []string
+	)
+
+	length := len(classSplit)
+	for i := 0; i < length; i += 2 {
+		// As for ICS20 denominations, the base class ID is determined by expecting the
+		// channel identifier of every hop to be in the format ibc-go specifies.
+		if i < length-1 && length > 2 && (channeltypes.IsValidChannelID(classSplit[i+1]) || clienttypes.IsValidClientID(classSplit[i+1])) {
+			trace = append(trace, transfertypes.NewHop(classSplit[i], classSplit[i+1]))
+		} else {
+			baseClassSlice = classSplit[i:]
+			break
+		}
+	}
+
+	base := strings.Join(baseClassSlice, "/")
+
+	return Class{
+		Base:  base,
+		Trace: trace,
+	}
+}
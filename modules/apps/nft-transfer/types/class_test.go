@@ -0,0 +1,127 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/nft-transfer/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+)
+
+func TestClassesValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		classes  types.Classes
+		expError error
+	}{
+		{
+			"empty Classes",
+			types.Classes{},
+			nil,
+		},
+		{
+			"valid trace with client id",
+			types.Classes{types.NewClass("kitties", transfertypes.NewHop(types.PortID, "07-tendermint-0"))},
+			nil,
+		},
+		{
+			"valid multiple trace info",
+			types.Classes{types.NewClass("kitties", transfertypes.NewHop(types.PortID, "channel-1"), transfertypes.NewHop(types.PortID, "channel-2"))},
+			nil,
+		},
+		{
+			"duplicated class",
+			types.Classes{
+				types.NewClass("kitties", transfertypes.NewHop(types.PortID, "channel-1")),
+				types.NewClass("kitties", transfertypes.NewHop(types.PortID, "channel-1")),
+			},
+			errors.New("duplicated class with hash"),
+		},
+		{
+			"empty base class ID with trace",
+			types.Classes{types.NewClass("", transfertypes.NewHop(types.PortID, "channel-1"))},
+			errors.New("base class ID cannot be blank"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.classes.Validate()
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expError.Error())
+			}
+		})
+	}
+}
+
+func TestClassPath(t *testing.T) {
+	testCases := []struct {
+		name    string
+		class   types.Class
+		expPath string
+	}{
+		{
+			"native class",
+			types.NewClass("kitties"),
+			"kitties",
+		},
+		{
+			"single hop",
+			types.NewClass("kitties", transfertypes.NewHop(types.PortID, "channel-0")),
+			"nfttransfer/channel-0/kitties",
+		},
+		{
+			"multiple hops",
+			types.NewClass("kitties", transfertypes.NewHop(types.PortID, "channel-0"), transfertypes.NewHop(types.PortID, "channel-1")),
+			"nfttransfer/channel-0/nfttransfer/channel-1/kitties",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expPath, tc.class.Path())
+			require.Equal(t, tc.class, types.ExtractClassFromPath(tc.expPath))
+		})
+	}
+}
+
+func TestClassIBCClassID(t *testing.T) {
+	class := types.NewClass("kitties")
+	require.True(t, class.IsNative())
+	require.Equal(t, "kitties", class.IBCClassID())
+
+	class = types.NewClass("kitties", transfertypes.NewHop(types.PortID, "channel-0"))
+	require.False(t, class.IsNative())
+	require.Equal(t, "ibc/"+class.Hash().String(), class.IBCClassID())
+
+	// the class hash is computed like the ICS20 denomination hash
+	denom := transfertypes.NewDenom("kitties", transfertypes.NewHop(types.PortID, "channel-0"))
+	require.Equal(t, denom.Hash(), class.Hash())
+
+	require.True(t, class.HasPrefix(types.PortID, "channel-0"))
+	require.False(t, class.HasPrefix(types.PortID, "channel-1"))
+	require.False(t, types.NewClass("kitties").HasPrefix(types.PortID, "channel-0"))
+}
+
+func TestExtractClassFromPath(t *testing.T) {
+	testCases := []struct {
+		name     string
+		fullPath string
+		expClass types.Class
+	}{
+		{"base class ID with slashes", "gamm/pool/1", types.NewClass("gamm/pool/1")},
+		{"trace with client ID", "nfttransfer/07-tendermint-0/kitties", types.NewClass("kitties", transfertypes.NewHop(types.PortID, "07-tendermint-0"))},
+		{"trace with base class ID containing slashes", "nfttransfer/channel-0/a/b", types.NewClass("a/b", transfertypes.NewHop(types.PortID, "channel-0"))},
+		{"ibc voucher class ID", "ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2", types.NewClass("ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expClass, types.ExtractClassFromPath(tc.fullPath))
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// IBC non-fungible token transfer sentinel errors
+var (
+	ErrInvalidClassForTransfer = errorsmod.Register(ModuleName, 2, "invalid class for cross-chain transfer")
+	ErrInvalidVersion          = errorsmod.Register(ModuleName, 3, "invalid ICS721 version")
+	ErrInvalidTokenID          = errorsmod.Register(ModuleName, 4, "invalid token ID")
+	ErrInvalidPacketData       = errorsmod.Register(ModuleName, 5, "invalid packet data")
+	ErrClassNotFound           = errorsmod.Register(ModuleName, 6, "class not found")
+	ErrTokenNotFound           = errorsmod.Register(ModuleName, 7, "non-fungible token not found")
+	ErrSendDisabled            = errorsmod.Register(ModuleName, 8, "non-fungible token transfers from this chain are disabled")
+	ErrReceiveDisabled         = errorsmod.Register(ModuleName, 9, "non-fungible token transfers to this chain are disabled")
+	ErrInvalidMemo             = errorsmod.Register(ModuleName, 10, "invalid memo")
+	ErrAbiEncoding             = errorsmod.Register(ModuleName, 11, "encoding abi failed")
+	ErrAbiDecoding             = errorsmod.Register(ModuleName, 12, "decoding abi failed")
+	ErrReceiveFailed           = errorsmod.Register(ModuleName, 13, "receive packet failed")
+	ErrMaxTransferChannels     = errorsmod.Register(ModuleName, 14, "max nft-transfer channels")
+)
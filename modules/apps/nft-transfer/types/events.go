@@ -0,0 +1,19 @@
+package types
+
+// IBC non-fungible token transfer events
+const (
+	EventTypeTimeout  = "nft_timeout"
+	EventTypePacket   = "non_fungible_token_packet"
+	EventTypeTransfer = "ibc_nft_transfer"
+	EventTypeClass    = "class"
+
+	AttributeKeySender     = "sender"
+	AttributeKeyReceiver   = "receiver"
+	AttributeKeyClassID    = "class_id"
+	AttributeKeyClassHash  = "class_hash"
+	AttributeKeyTokenIDs   = "token_ids"
+	AttributeKeyAckSuccess = "success"
+	AttributeKeyAck        = "acknowledgement"
+	AttributeKeyAckError   = "error"
+	AttributeKeyMemo       = "memo"
+)
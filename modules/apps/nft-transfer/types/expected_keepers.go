@@ -0,0 +1,45 @@
+package types
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
+
+// NFTKeeper defines the expected non-fungible token keeper. The method set mirrors the
+// keeper of the x/nft module with the class and token metadata passed as plain values,
+// such that an x/nft keeper can be used through a thin adapter.
+type NFTKeeper interface {
+	// SaveClass creates a new class with the given URI and data.
+	SaveClass(ctx context.Context, classID, classURI, classData string) error
+	// HasClass returns true if the class exists.
+	HasClass(ctx context.Context, classID string) bool
+	// GetClass returns the URI and data of the class.
+	GetClass(ctx context.Context, classID string) (classURI string, classData string, found bool)
+
+	// Mint mints a new token of the class with the given URI and data to the receiver.
+	Mint(ctx context.Context, classID, tokenID, tokenURI, tokenData string, receiver sdk.AccAddress) error
+	// Burn removes the token of the class from the store.
+	Burn(ctx context.Context, classID, tokenID string) error
+	// Transfer changes the owner of the token of the class to the receiver.
+	Transfer(ctx context.Context, classID, tokenID string, receiver sdk.AccAddress) error
+	// GetOwner returns the owner of the token of the class.
+	GetOwner(ctx context.Context, classID, tokenID string) sdk.AccAddress
+	// GetNFT returns the URI and data of the token of the class.
+	GetNFT(ctx context.Context, classID, tokenID string) (tokenURI string, tokenData string, found bool)
+}
+
+// ChannelKeeper defines the expected IBC channel keeper
+type ChannelKeeper interface {
+	GetChannel(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool)
+	HasChannel(ctx sdk.Context, portID, channelID string) bool
+}
+
+// MessageRouter ADR 031 request type routing
+// https://github.com/cosmos/cosmos-sdk/blob/main/docs/architecture/adr-031-msg-service.md
+type MessageRouter interface {
+	Handler(msg sdk.Msg) baseapp.MsgServiceHandler
+}
@@ -0,0 +1,51 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/nft-transfer/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expError error
+	}{
+		{
+			"success: default genesis",
+			types.DefaultGenesisState(),
+			nil,
+		},
+		{
+			"success: valid genesis",
+			types.NewGenesisState(types.PortID, types.Classes{types.NewClass("kitties", transfertypes.NewHop(types.PortID, "channel-0"))}, types.NewParams(false, true)),
+			nil,
+		},
+		{
+			"failure: invalid port ID",
+			types.NewGenesisState("", types.Classes{}, types.DefaultParams()),
+			host.ErrInvalidID,
+		},
+		{
+			"failure: invalid class",
+			types.NewGenesisState(types.PortID, types.Classes{types.NewClass("", transfertypes.NewHop(types.PortID, "channel-0"))}, types.DefaultParams()),
+			types.ErrInvalidClassForTransfer,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
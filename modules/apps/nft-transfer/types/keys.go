@@ -0,0 +1,60 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the IBC non-fungible token transfer name
+	ModuleName = "nonfungibletokentransfer"
+
+	// PortID is the default port id that the non-fungible token transfer module binds to.
+	// IBC routes must be alphanumeric, so the port id omits the dash used in the ICS-721 specification.
+	PortID = "nfttransfer"
+
+	// StoreKey is the store key string for IBC non-fungible token transfer
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for IBC non-fungible token transfer
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for IBC non-fungible token transfer
+	QuerierRoute = ModuleName
+
+	// ClassPrefix is the prefix used for the class ID of vouchers of non-fungible tokens received over IBC.
+	ClassPrefix = "ibc"
+
+	ParamsKey = "params"
+
+	// V1 defines first version of the IBC non-fungible token transfer module
+	V1 = "ics721-1"
+
+	// escrowAddressVersion should remain as ics721-1 to avoid the address changing.
+	escrowAddressVersion = V1
+)
+
+var (
+	// PortKey defines the key to store the port ID in store
+	PortKey = []byte{0x01}
+	// ClassKey defines the key to store the class trace in store
+	ClassKey = []byte{0x02}
+)
+
+// GetEscrowAddress returns the escrow address for the specified channel.
+// The escrow address follows the format as outlined in ADR 028:
+// https://github.com/cosmos/cosmos-sdk/blob/master/docs/architecture/adr-028-public-key-addresses.md
+func GetEscrowAddress(portID, channelID string) sdk.AccAddress {
+	// a slash is used to create domain separation between port and channel identifiers to
+	// prevent address collisions between escrow addresses created for different channels
+	contents := fmt.Sprintf("%s/%s", portID, channelID)
+
+	// ADR 028 AddressHash construction
+	preImage := []byte(escrowAddressVersion)
+	preImage = append(preImage, 0)
+	preImage = append(preImage, contents...)
+	hash := sha256.Sum256(preImage)
+	return hash[:20]
+}
@@ -0,0 +1,114 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+)
+
+const (
+	MaximumReceiverLength = 2048  // maximum length of the receiver address in bytes (value chosen arbitrarily)
+	MaximumMemoLength     = 32768 // maximum length of the memo in bytes (value chosen arbitrarily)
+)
+
+var (
+	_ sdk.Msg              = (*MsgUpdateParams)(nil)
+	_ sdk.Msg              = (*MsgTransfer)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
+	_ sdk.HasValidateBasic = (*MsgTransfer)(nil)
+)
+
+// NewMsgUpdateParams creates a new MsgUpdateParams instance
+func NewMsgUpdateParams(signer string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Signer: signer,
+		Params: params,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateParams) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
+
+// NewMsgTransfer creates a new MsgTransfer instance
+func NewMsgTransfer(
+	sourcePort, sourceChannel string,
+	classID string, tokenIDs []string,
+	sender, receiver string,
+	timeoutHeight clienttypes.Height, timeoutTimestamp uint64,
+	memo string,
+) *MsgTransfer {
+	return &MsgTransfer{
+		SourcePort:       sourcePort,
+		SourceChannel:    sourceChannel,
+		ClassId:          classID,
+		TokenIds:         tokenIDs,
+		Sender:           sender,
+		Receiver:         receiver,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             memo,
+	}
+}
+
+// NewMsgTransferWithEncoding creates a new MsgTransfer instance
+// with the provided encoding
+func NewMsgTransferWithEncoding(
+	sourcePort, sourceChannel string,
+	classID string, tokenIDs []string,
+	sender, receiver string,
+	timeoutHeight clienttypes.Height, timeoutTimestamp uint64,
+	memo string, encoding string,
+) *MsgTransfer {
+	msg := NewMsgTransfer(sourcePort, sourceChannel, classID, tokenIDs, sender, receiver, timeoutHeight, timeoutTimestamp, memo)
+	msg.Encoding = encoding
+	return msg
+}
+
+// ValidateBasic performs a basic check of the MsgTransfer fields.
+// NOTE: If you are sending with V1 protocol, timeoutHeight or timeoutTimestamp must be non-zero,
+// if you are sending with V2 protocol, timeoutTimestamp must be non-zero and timeoutHeight must be zero
+// NOTE: The recipient addresses format is not validated as the format defined by
+// the chain is not known to IBC.
+func (msg MsgTransfer) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.SourcePort); err != nil {
+		return errorsmod.Wrapf(err, "invalid source port ID %s", msg.SourcePort)
+	}
+	if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
+		return errorsmod.Wrapf(err, "invalid source channel ID %s", msg.SourceChannel)
+	}
+	if strings.TrimSpace(msg.ClassId) == "" {
+		return errorsmod.Wrap(ErrInvalidClassForTransfer, "class ID cannot be blank")
+	}
+	if err := validateTokenIDs(msg.TokenIds); err != nil {
+		return err
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if strings.TrimSpace(msg.Receiver) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "missing recipient address")
+	}
+	if len(msg.Receiver) > MaximumReceiverLength {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "recipient address must not exceed %d bytes", MaximumReceiverLength)
+	}
+	if len(msg.Memo) > MaximumMemoLength {
+		return errorsmod.Wrapf(ErrInvalidMemo, "memo must not exceed %d bytes", MaximumMemoLength)
+	}
+
+	return nil
+}
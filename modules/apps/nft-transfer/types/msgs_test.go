@@ -0,0 +1,54 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/nft-transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+)
+
+func TestMsgTransferValidateBasic(t *testing.T) {
+	var msg *types.MsgTransfer
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{"success", func() {}, nil},
+		{"failure: invalid source port", func() { msg.SourcePort = "" }, host.ErrInvalidID},
+		{"failure: invalid source channel", func() { msg.SourceChannel = "" }, host.ErrInvalidID},
+		{"failure: empty class ID", func() { msg.ClassId = "" }, types.ErrInvalidClassForTransfer},
+		{"failure: no token IDs", func() { msg.TokenIds = nil }, types.ErrInvalidTokenID},
+		{"failure: duplicate token IDs", func() { msg.TokenIds = []string{"1", "1"} }, types.ErrInvalidTokenID},
+		{"failure: invalid sender", func() { msg.Sender = "invalid" }, ibcerrors.ErrInvalidAddress},
+		{"failure: empty receiver", func() { msg.Receiver = "" }, ibcerrors.ErrInvalidAddress},
+		{"failure: receiver too long", func() { msg.Receiver = strings.Repeat("a", types.MaximumReceiverLength+1) }, ibcerrors.ErrInvalidAddress},
+		{"failure: memo too long", func() { msg.Memo = strings.Repeat("a", types.MaximumMemoLength+1) }, types.ErrInvalidMemo},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg = types.NewMsgTransfer(types.PortID, "channel-0", "kitties", []string{"1"}, sender, receiver, clienttypes.ZeroHeight(), 100, "")
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+func TestMsgUpdateParamsValidateBasic(t *testing.T) {
+	require.NoError(t, types.NewMsgUpdateParams(sender, types.DefaultParams()).ValidateBasic())
+	require.ErrorIs(t, types.NewMsgUpdateParams("invalid", types.DefaultParams()).ValidateBasic(), ibcerrors.ErrInvalidAddress)
+}
@@ -0,0 +1,223 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec/unknownproto"
+
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+var (
+	_ ibcexported.PacketData         = (*NonFungibleTokenPacketData)(nil)
+	_ ibcexported.PacketDataProvider = (*NonFungibleTokenPacketData)(nil)
+)
+
+const (
+	EncodingJSON     = "application/json"
+	EncodingProtobuf = "application/x-protobuf"
+	EncodingABI      = "application/x-solidity-abi"
+
+	// MaximumTokensLength is the maximum number of tokens which may be transferred in a single packet (value chosen arbitrarily)
+	MaximumTokensLength = 100
+)
+
+// NewNonFungibleTokenPacketData constructs a new NonFungibleTokenPacketData instance
+func NewNonFungibleTokenPacketData(
+	classID, classURI, classData string,
+	tokenIDs, tokenURIs, tokenData []string,
+	sender, receiver string,
+	memo string,
+) NonFungibleTokenPacketData {
+	return NonFungibleTokenPacketData{
+		ClassId:   classID,
+		ClassUri:  classURI,
+		ClassData: classData,
+		TokenIds:  tokenIDs,
+		TokenUris: tokenURIs,
+		TokenData: tokenData,
+		Sender:    sender,
+		Receiver:  receiver,
+		Memo:      memo,
+	}
+}
+
+// ValidateBasic is used for validating the non-fungible token transfer.
+// NOTE: The addresses formats are not validated as the sender and recipient can have different
+// formats defined by their corresponding chains that are not known to IBC.
+func (nftpd NonFungibleTokenPacketData) ValidateBasic() error {
+	if strings.TrimSpace(nftpd.Sender) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "sender address cannot be blank")
+	}
+	if strings.TrimSpace(nftpd.Receiver) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "receiver address cannot be blank")
+	}
+	if err := validateTokenIDs(nftpd.TokenIds); err != nil {
+		return err
+	}
+	if len(nftpd.TokenUris) != 0 && len(nftpd.TokenUris) != len(nftpd.TokenIds) {
+		return errorsmod.Wrapf(ErrInvalidPacketData, "expected %d token URIs, got %d", len(nftpd.TokenIds), len(nftpd.TokenUris))
+	}
+	if len(nftpd.TokenData) != 0 && len(nftpd.TokenData) != len(nftpd.TokenIds) {
+		return errorsmod.Wrapf(ErrInvalidPacketData, "expected %d token data entries, got %d", len(nftpd.TokenIds), len(nftpd.TokenData))
+	}
+	if len(nftpd.Memo) > MaximumMemoLength {
+		return errorsmod.Wrapf(ErrInvalidMemo, "memo must not exceed %d bytes", MaximumMemoLength)
+	}
+
+	class := ExtractClassFromPath(nftpd.ClassId)
+	return class.Validate()
+}
+
+// TokenURIAt returns the URI of the token at the given index, or an empty string if no token URIs are set.
+func (nftpd NonFungibleTokenPacketData) TokenURIAt(i int) string {
+	if len(nftpd.TokenUris) == 0 {
+		return ""
+	}
+
+	return nftpd.TokenUris[i]
+}
+
+// TokenDataAt returns the data of the token at the given index, or an empty string if no token data is set.
+func (nftpd NonFungibleTokenPacketData) TokenDataAt(i int) string {
+	if len(nftpd.TokenData) == 0 {
+		return ""
+	}
+
+	return nftpd.TokenData[i]
+}
+
+// GetBytes is a helper for serialising the packet to bytes.
+// The optional fields of NonFungibleTokenPacketData are marked with the JSON omitempty tag
+// ensuring that they are not included in the marshalled bytes if they are not specified.
+func (nftpd NonFungibleTokenPacketData) GetBytes() []byte {
+	bz, err := json.Marshal(nftpd)
+	if err != nil {
+		panic(errors.New("cannot marshal NonFungibleTokenPacketData into bytes"))
+	}
+
+	return bz
+}
+
+// GetPacketSender returns the sender address embedded in the packet data.
+//
+// NOTE:
+//   - The sender address is set by the module which requested the packet to be sent,
+//     and this module may not have validated the sender address by a signature check.
+//   - The sender address must only be used by modules on the sending chain.
+//   - sourcePortID is not used in this implementation.
+func (nftpd NonFungibleTokenPacketData) GetPacketSender(sourcePortID string) string {
+	return nftpd.Sender
+}
+
+// GetCustomPacketData interprets the memo field of the packet data as a JSON object
+// and returns the value associated with the given key.
+// If the key is missing or the memo is not properly formatted, then nil is returned.
+func (nftpd NonFungibleTokenPacketData) GetCustomPacketData(key string) any {
+	if len(nftpd.Memo) == 0 {
+		return nil
+	}
+
+	jsonObject := make(map[string]any)
+	err := json.Unmarshal([]byte(nftpd.Memo), &jsonObject)
+	if err != nil {
+		return nil
+	}
+
+	memoData, found := jsonObject[key]
+	if !found {
+		return nil
+	}
+
+	return memoData
+}
+
+// MarshalPacketData attempts to marshal the provided NonFungibleTokenPacketData into bytes with the provided encoding.
+func MarshalPacketData(data NonFungibleTokenPacketData, ics721Version string, encoding string) ([]byte, error) {
+	if ics721Version != V1 {
+		return nil, errorsmod.Wrap(ErrInvalidVersion, ics721Version)
+	}
+
+	switch encoding {
+	case EncodingJSON:
+		return json.Marshal(data)
+	case EncodingProtobuf:
+		return proto.Marshal(&data)
+	case EncodingABI:
+		return EncodeABINonFungibleTokenPacketData(&data)
+	default:
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "invalid encoding provided, must be either empty or one of [%q, %q, %q], got %s", EncodingJSON, EncodingProtobuf, EncodingABI, encoding)
+	}
+}
+
+// UnmarshalPacketData attempts to unmarshal the provided packet data bytes into a NonFungibleTokenPacketData.
+// The packet data is validated after unmarshaling. IBC v1 packets are always JSON encoded and are unmarshalled
+// with an empty encoding.
+func UnmarshalPacketData(bz []byte, ics721Version string, encoding string) (NonFungibleTokenPacketData, error) {
+	const failedUnmarshalingErrorMsg = "cannot unmarshal ICS721-V1 transfer packet data: %s"
+
+	if ics721Version != V1 {
+		return NonFungibleTokenPacketData{}, errorsmod.Wrap(ErrInvalidVersion, ics721Version)
+	}
+
+	data := &NonFungibleTokenPacketData{}
+	switch encoding {
+	case "", EncodingJSON:
+		if err := json.Unmarshal(bz, data); err != nil {
+			return NonFungibleTokenPacketData{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, failedUnmarshalingErrorMsg, err.Error())
+		}
+	case EncodingProtobuf:
+		if err := unknownproto.RejectUnknownFieldsStrict(bz, data, unknownproto.DefaultAnyResolver{}); err != nil {
+			return NonFungibleTokenPacketData{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, failedUnmarshalingErrorMsg, err.Error())
+		}
+
+		if err := proto.Unmarshal(bz, data); err != nil {
+			return NonFungibleTokenPacketData{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, failedUnmarshalingErrorMsg, err.Error())
+		}
+	case EncodingABI:
+		var err error
+		data, err = DecodeABINonFungibleTokenPacketData(bz)
+		if err != nil {
+			return NonFungibleTokenPacketData{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, failedUnmarshalingErrorMsg, err.Error())
+		}
+	default:
+		return NonFungibleTokenPacketData{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "invalid encoding provided, must be either empty or one of [%q, %q, %q], got %s", EncodingJSON, EncodingProtobuf, EncodingABI, encoding)
+	}
+
+	if err := data.ValidateBasic(); err != nil {
+		return NonFungibleTokenPacketData{}, errorsmod.Wrapf(err, "invalid packet data")
+	}
+
+	return *data, nil
+}
+
+// validateTokenIDs checks that at least one and no more than MaximumTokensLength
+// non-blank and unique token IDs are provided.
+func validateTokenIDs(tokenIDs []string) error {
+	if len(tokenIDs) == 0 {
+		return errorsmod.Wrap(ErrInvalidTokenID, "token IDs cannot be empty")
+	}
+	if len(tokenIDs) > MaximumTokensLength {
+		return errorsmod.Wrapf(ErrInvalidTokenID, "number of token IDs must not exceed %d", MaximumTokensLength)
+	}
+
+	seenTokenIDs := make(map[string]bool)
+	for _, tokenID := range tokenIDs {
+		if strings.TrimSpace(tokenID) == "" {
+			return errorsmod.Wrap(ErrInvalidTokenID, "token ID cannot be blank")
+		}
+		if seenTokenIDs[tokenID] {
+			return errorsmod.Wrapf(ErrInvalidTokenID, "duplicate token ID %s", tokenID)
+		}
+		seenTokenIDs[tokenID] = true
+	}
+
+	return nil
+}
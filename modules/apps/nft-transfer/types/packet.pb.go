@@ -0,0 +1,765 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/nft_transfer/v1/packet.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// NonFungibleTokenPacketData defines a struct for the packet payload
+// See NonFungibleTokenPacketData spec:
+// https://github.com/cosmos/ibc/tree/master/spec/app/ics-721-nft-transfer#data-structures
+type NonFungibleTokenPacketData struct {
+	// the class ID of the tokens, prefixed by the trace of the class
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"classId"`
+	// optional URI of the class
+	ClassUri string `protobuf:"bytes,2,opt,name=class_uri,json=classUri,proto3" json:"classUri,omitempty"`
+	// optional data of the class
+	ClassData string `protobuf:"bytes,3,opt,name=class_data,json=classData,proto3" json:"classData,omitempty"`
+	// the IDs of the tokens to be transferred
+	TokenIds []string `protobuf:"bytes,4,rep,name=token_ids,json=tokenIds,proto3" json:"tokenIds"`
+	// optional URIs of the tokens, must be empty or match the length of the token IDs
+	TokenUris []string `protobuf:"bytes,5,rep,name=token_uris,json=tokenUris,proto3" json:"tokenUris,omitempty"`
+	// optional data of the tokens, must be empty or match the length of the token IDs
+	TokenData []string `protobuf:"bytes,6,rep,name=token_data,json=tokenData,proto3" json:"tokenData,omitempty"`
+	// the sender address
+	Sender string `protobuf:"bytes,7,opt,name=sender,proto3" json:"sender"`
+	// the recipient address on the destination chain
+	Receiver string `protobuf:"bytes,8,opt,name=receiver,proto3" json:"receiver"`
+	// optional memo
+	Memo string `protobuf:"bytes,9,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (m *NonFungibleTokenPacketData) Reset()         { *m = NonFungibleTokenPacketData{} }
+func (m *NonFungibleTokenPacketData) String() string { return proto.CompactTextString(m) }
+func (*NonFungibleTokenPacketData) ProtoMessage()    {}
+func (*NonFungibleTokenPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f82fdc932b824013, []int{0}
+}
+func (m *NonFungibleTokenPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NonFungibleTokenPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NonFungibleTokenPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NonFungibleTokenPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NonFungibleTokenPacketData.Merge(m, src)
+}
+func (m *NonFungibleTokenPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *NonFungibleTokenPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_NonFungibleTokenPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NonFungibleTokenPacketData proto.InternalMessageInfo
+
+func (m *NonFungibleTokenPacketData) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *NonFungibleTokenPacketData) GetClassUri() string {
+	if m != nil {
+		return m.ClassUri
+	}
+	return ""
+}
+
+func (m *NonFungibleTokenPacketData) GetClassData() string {
+	if m != nil {
+		return m.ClassData
+	}
+	return ""
+}
+
+func (m *NonFungibleTokenPacketData) GetTokenIds() []string {
+	if m != nil {
+		return m.TokenIds
+	}
+	return nil
+}
+
+func (m *NonFungibleTokenPacketData) GetTokenUris() []string {
+	if m != nil {
+		return m.TokenUris
+	}
+	return nil
+}
+
+func (m *NonFungibleTokenPacketData) GetTokenData() []string {
+	if m != nil {
+		return m.TokenData
+	}
+	return nil
+}
+
+func (m *NonFungibleTokenPacketData) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *NonFungibleTokenPacketData) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *NonFungibleTokenPacketData) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*NonFungibleTokenPacketData)(nil), "ibc.applications.nft_transfer.v1.NonFungibleTokenPacketData")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/nft_transfer/v1/packet.proto", fileDescriptor_f82fdc932b824013)
+}
+
+var fileDescriptor_f82fdc932b824013 = []byte{
+	// 416 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x1b, 0xbb, 0x76, 0x93, 0x51, 0x3c, 0x8c, 0xa2, 0xc3, 0x1e, 0x92, 0xb2, 0x87, 0xa5,
+	0x82, 0xcd, 0x58, 0x16, 0x3c, 0x79, 0x2a, 0x22, 0xec, 0x45, 0x24, 0xb8, 0x1e, 0xbc, 0x94, 0xc9,
+	0x64, 0x36, 0x0e, 0x9b, 0x64, 0xc2, 0xcc, 0x24, 0xb0, 0xdf, 0xc2, 0xcf, 0xe4, 0xc9, 0x63, 0x8f,
+	0x9e, 0x82, 0xb4, 0xb7, 0x7c, 0x0a, 0xc9, 0x4b, 0x9b, 0x0d, 0x3d, 0xf5, 0xf5, 0x97, 0xdf, 0x3f,
+	0xff, 0x17, 0x78, 0x68, 0x29, 0x63, 0x4e, 0x59, 0x59, 0x66, 0x92, 0x33, 0x2b, 0x55, 0x61, 0x68,
+	0x71, 0x67, 0x37, 0x56, 0xb3, 0xc2, 0xdc, 0x09, 0x4d, 0xeb, 0x15, 0x2d, 0x19, 0xbf, 0x17, 0x36,
+	0x2c, 0xb5, 0xb2, 0x0a, 0xcf, 0x65, 0xcc, 0xc3, 0xb1, 0x1e, 0x8e, 0xf5, 0xb0, 0x5e, 0x5d, 0xbc,
+	0x4a, 0x55, 0xaa, 0x40, 0xa6, 0xdd, 0xd4, 0xe7, 0x2e, 0x7f, 0x4f, 0xd1, 0xc5, 0x17, 0x55, 0x7c,
+	0xae, 0x8a, 0x54, 0xc6, 0x99, 0xf8, 0xa6, 0xee, 0x45, 0xf1, 0x15, 0x5e, 0xfc, 0x89, 0x59, 0x86,
+	0xaf, 0x90, 0xcb, 0x33, 0x66, 0xcc, 0x46, 0x26, 0xc4, 0x99, 0x3b, 0x0b, 0x6f, 0xfd, 0xac, 0x6d,
+	0x82, 0x73, 0x60, 0x37, 0x49, 0x74, 0x1c, 0xf0, 0x35, 0xf2, 0x7a, 0xaf, 0xd2, 0x92, 0x3c, 0x01,
+	0xf1, 0x75, 0xdb, 0x04, 0x18, 0xe0, 0xad, 0x96, 0xef, 0x54, 0x2e, 0xad, 0xc8, 0x4b, 0xfb, 0x10,
+	0xb9, 0x47, 0x86, 0x3f, 0x20, 0xd4, 0x87, 0x12, 0x66, 0x19, 0x99, 0x42, 0xea, 0x4d, 0xdb, 0x04,
+	0x2f, 0x81, 0x76, 0xfd, 0xa3, 0x98, 0x37, 0x40, 0xfc, 0x16, 0x79, 0xb6, 0xdb, 0x73, 0x23, 0x13,
+	0x43, 0xce, 0xe6, 0xd3, 0x85, 0xb7, 0x7e, 0xde, 0x36, 0x81, 0x0b, 0xf0, 0x26, 0x31, 0xd1, 0x30,
+	0x75, 0x15, 0xbd, 0x5a, 0x69, 0x69, 0xc8, 0x53, 0x70, 0xa1, 0x02, 0xe8, 0xad, 0x96, 0x66, 0x5c,
+	0x31, 0xc0, 0xc7, 0x1c, 0xac, 0x36, 0x3b, 0xc9, 0x9d, 0xae, 0x36, 0x40, 0x7c, 0x89, 0x66, 0x46,
+	0x14, 0x89, 0xd0, 0xe4, 0x1c, 0x3e, 0x07, 0xb5, 0x4d, 0x70, 0x20, 0xd1, 0xe1, 0x17, 0x2f, 0x90,
+	0xab, 0x05, 0x17, 0xb2, 0x16, 0x9a, 0xb8, 0x60, 0xc1, 0xf6, 0x47, 0x16, 0x0d, 0x13, 0xbe, 0x42,
+	0x67, 0xb9, 0xc8, 0x15, 0xf1, 0xc0, 0xc2, 0x6d, 0x13, 0xbc, 0xe8, 0xfe, 0x8f, 0xaa, 0xe1, 0xf9,
+	0xfa, 0xfb, 0x9f, 0x9d, 0xef, 0x6c, 0x77, 0xbe, 0xf3, 0x6f, 0xe7, 0x3b, 0xbf, 0xf6, 0xfe, 0x64,
+	0xbb, 0xf7, 0x27, 0x7f, 0xf7, 0xfe, 0xe4, 0xc7, 0xc7, 0x54, 0xda, 0x9f, 0x55, 0x1c, 0x72, 0x95,
+	0x53, 0xae, 0x4c, 0xae, 0x0c, 0x95, 0x31, 0x5f, 0xa6, 0x8a, 0xd6, 0xab, 0xf7, 0x34, 0x57, 0x49,
+	0x95, 0x09, 0xd3, 0x9d, 0x19, 0x9c, 0xd7, 0x72, 0x38, 0x2f, 0xfb, 0x50, 0x0a, 0x13, 0xcf, 0xe0,
+	0x46, 0xae, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x39, 0x18, 0x65, 0xea, 0x8c, 0x02, 0x00, 0x00,
+}
+
+func (m *NonFungibleTokenPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NonFungibleTokenPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NonFungibleTokenPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.TokenData) > 0 {
+		for iNdEx := len(m.TokenData) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TokenData[iNdEx])
+			copy(dAtA[i:], m.TokenData[iNdEx])
+			i = encodeVarintPacket(dAtA, i, uint64(len(m.TokenData[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.TokenUris) > 0 {
+		for iNdEx := len(m.TokenUris) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TokenUris[iNdEx])
+			copy(dAtA[i:], m.TokenUris[iNdEx])
+			i = encodeVarintPacket(dAtA, i, uint64(len(m.TokenUris[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TokenIds) > 0 {
+		for iNdEx := len(m.TokenIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TokenIds[iNdEx])
+			copy(dAtA[i:], m.TokenIds[iNdEx])
+			i = encodeVarintPacket(dAtA, i, uint64(len(m.TokenIds[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ClassData) > 0 {
+		i -= len(m.ClassData)
+		copy(dAtA[i:], m.ClassData)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.ClassData)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClassUri) > 0 {
+		i -= len(m.ClassUri)
+		copy(dAtA[i:], m.ClassUri)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.ClassUri)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPacket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *NonFungibleTokenPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.ClassUri)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.ClassData)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if len(m.TokenIds) > 0 {
+		for _, s := range m.TokenIds {
+			l = len(s)
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	if len(m.TokenUris) > 0 {
+		for _, s := range m.TokenUris {
+			l = len(s)
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	if len(m.TokenData) > 0 {
+		for _, s := range m.TokenData {
+			l = len(s)
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func sovPacket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPacket(x uint64) (n int) {
+	return sovPacket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *NonFungibleTokenPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NonFungibleTokenPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NonFungibleTokenPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassData", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassData = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIds = append(m.TokenIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenUris", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenUris = append(m.TokenUris, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenData", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenData = append(m.TokenData, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPacket(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPacket
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPacket
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPacket
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPacket        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPacket          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPacket = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,124 @@
+package types_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/nft-transfer/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+)
+
+const (
+	sender   = "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du"
+	receiver = "0x7e7f7e7f7e7f7e7f7e7f7e7f7e7f7e7f7e7f7e7f"
+	classID  = "nfttransfer/channel-0/kitties"
+)
+
+func TestNonFungibleTokenPacketDataValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name       string
+		packetData types.NonFungibleTokenPacketData
+		expError   error
+	}{
+		{"success", types.NewNonFungibleTokenPacketData(classID, "uri", "data", []string{"1", "2"}, []string{"uri-1", "uri-2"}, []string{"data-1", "data-2"}, sender, receiver, "memo"), nil},
+		{"success: without metadata", types.NewNonFungibleTokenPacketData(classID, "", "", []string{"1"}, nil, nil, sender, receiver, ""), nil},
+		{"failure: empty class ID", types.NewNonFungibleTokenPacketData("", "", "", []string{"1"}, nil, nil, sender, receiver, ""), types.ErrInvalidClassForTransfer},
+		{"failure: no token IDs", types.NewNonFungibleTokenPacketData(classID, "", "", nil, nil, nil, sender, receiver, ""), types.ErrInvalidTokenID},
+		{"failure: blank token ID", types.NewNonFungibleTokenPacketData(classID, "", "", []string{" "}, nil, nil, sender, receiver, ""), types.ErrInvalidTokenID},
+		{"failure: duplicate token ID", types.NewNonFungibleTokenPacketData(classID, "", "", []string{"1", "1"}, nil, nil, sender, receiver, ""), types.ErrInvalidTokenID},
+		{"failure: too many token IDs", types.NewNonFungibleTokenPacketData(classID, "", "", tokenIDs(types.MaximumTokensLength+1), nil, nil, sender, receiver, ""), types.ErrInvalidTokenID},
+		{"failure: token URIs length mismatch", types.NewNonFungibleTokenPacketData(classID, "", "", []string{"1", "2"}, []string{"uri-1"}, nil, sender, receiver, ""), types.ErrInvalidPacketData},
+		{"failure: token data length mismatch", types.NewNonFungibleTokenPacketData(classID, "", "", []string{"1"}, nil, []string{"data-1", "data-2"}, sender, receiver, ""), types.ErrInvalidPacketData},
+		{"failure: empty sender", types.NewNonFungibleTokenPacketData(classID, "", "", []string{"1"}, nil, nil, "", receiver, ""), ibcerrors.ErrInvalidAddress},
+		{"failure: empty receiver", types.NewNonFungibleTokenPacketData(classID, "", "", []string{"1"}, nil, nil, sender, " ", ""), ibcerrors.ErrInvalidAddress},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.packetData.ValidateBasic()
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+func TestNonFungibleTokenPacketDataGetBytes(t *testing.T) {
+	packetData := types.NewNonFungibleTokenPacketData(classID, "", "", []string{"1"}, nil, nil, sender, receiver, "")
+
+	// the optional fields are omitted and the fields use the ICS721 JSON names
+	expected := `{"classId":"nfttransfer/channel-0/kitties","tokenIds":["1"],"sender":"cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du","receiver":"0x7e7f7e7f7e7f7e7f7e7f7e7f7e7f7e7f7e7f7e7f"}`
+	require.Equal(t, expected, string(packetData.GetBytes()))
+}
+
+func TestMarshalUnmarshalPacketData(t *testing.T) {
+	testCases := []struct {
+		name       string
+		packetData types.NonFungibleTokenPacketData
+	}{
+		{"with metadata", types.NewNonFungibleTokenPacketData(classID, "uri", "data", []string{"1", "2"}, []string{"uri-1", "uri-2"}, []string{"data-1", "data-2"}, sender, receiver, "memo")},
+		{"without metadata", types.NewNonFungibleTokenPacketData(classID, "", "", []string{"1"}, nil, nil, sender, receiver, "")},
+	}
+
+	for _, tc := range testCases {
+		for _, encoding := range []string{types.EncodingJSON, types.EncodingProtobuf, types.EncodingABI} {
+			t.Run(tc.name+" "+encoding, func(t *testing.T) {
+				bz, err := types.MarshalPacketData(tc.packetData, types.V1, encoding)
+				require.NoError(t, err)
+
+				packetData, err := types.UnmarshalPacketData(bz, types.V1, encoding)
+				require.NoError(t, err)
+				require.Equal(t, tc.packetData, packetData)
+			})
+		}
+	}
+}
+
+func TestUnmarshalPacketData(t *testing.T) {
+	packetData := types.NewNonFungibleTokenPacketData(classID, "", "", []string{"1"}, nil, nil, sender, receiver, "")
+
+	testCases := []struct {
+		name     string
+		bz       []byte
+		version  string
+		encoding string
+		expError error
+	}{
+		{"success: empty encoding defaults to json", packetData.GetBytes(), types.V1, "", nil},
+		{"failure: invalid version", packetData.GetBytes(), "ics721-2", "", types.ErrInvalidVersion},
+		{"failure: invalid encoding", packetData.GetBytes(), types.V1, "application/x-unknown", ibcerrors.ErrInvalidType},
+		{"failure: invalid json", []byte("invalid"), types.V1, types.EncodingJSON, ibcerrors.ErrInvalidType},
+		{"failure: invalid abi", packetData.GetBytes(), types.V1, types.EncodingABI, ibcerrors.ErrInvalidType},
+		{"failure: invalid packet data", types.NewNonFungibleTokenPacketData(classID, "", "", nil, nil, nil, sender, receiver, "").GetBytes(), types.V1, "", types.ErrInvalidTokenID},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := types.UnmarshalPacketData(tc.bz, tc.version, tc.encoding)
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+func TestGetCustomPacketData(t *testing.T) {
+	packetData := types.NewNonFungibleTokenPacketData(classID, "", "", []string{"1"}, nil, nil, sender, receiver, `{"src_callback": {"address": "addr"}}`)
+	require.Equal(t, map[string]any{"address": "addr"}, packetData.GetCustomPacketData("src_callback"))
+	require.Nil(t, packetData.GetCustomPacketData("dest_callback"))
+	require.Equal(t, sender, packetData.GetPacketSender(types.PortID))
+}
+
+func tokenIDs(n int) []string {
+	ids := make([]string, n)
+	for i := range ids {
+		ids[i] = strconv.Itoa(i)
+	}
+	return ids
+}
@@ -0,0 +1,21 @@
+package types
+
+const (
+	// DefaultSendEnabled enabled
+	DefaultSendEnabled = true
+	// DefaultReceiveEnabled enabled
+	DefaultReceiveEnabled = true
+)
+
+// NewParams creates a new parameter configuration for the ibc nft-transfer module
+func NewParams(enableSend, enableReceive bool) Params {
+	return Params{
+		SendEnabled:    enableSend,
+		ReceiveEnabled: enableReceive,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the ibc nft-transfer module
+func DefaultParams() Params {
+	return NewParams(DefaultSendEnabled, DefaultReceiveEnabled)
+}
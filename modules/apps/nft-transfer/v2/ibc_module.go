@@ -0,0 +1,185 @@
+package v2
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/nft-transfer/internal/events"
+	"github.com/cosmos/ibc-go/v10/modules/apps/nft-transfer/keeper"
+	"github.com/cosmos/ibc-go/v10/modules/apps/nft-transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/api"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+)
+
+var (
+	_ api.IBCModule             = (*IBCModule)(nil)
+	_ api.PacketDataUnmarshaler = (*IBCModule)(nil)
+)
+
+// IBCModule implements the IBC v2 callbacks of the nft-transfer application.
+type IBCModule struct {
+	keeper keeper.Keeper
+}
+
+// NewIBCModule creates a new IBCModule given the keeper
+func NewIBCModule(k keeper.Keeper) *IBCModule {
+	return &IBCModule{
+		keeper: k,
+	}
+}
+
+// OnSendPacket implements the IBCModule interface. The tokens are escrowed or burned
+// depending on whether this chain is the source of the class.
+func (im *IBCModule) OnSendPacket(ctx sdk.Context, sourceChannel string, destinationChannel string, sequence uint64, payload channeltypesv2.Payload, signer sdk.AccAddress) error {
+	if err := validateIdentifiers(sourceChannel, destinationChannel, payload); err != nil {
+		return err
+	}
+
+	data, err := types.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return err
+	}
+
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return err
+	}
+
+	if !signer.Equals(sender) {
+		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "sender %s is different from signer %s", sender, signer)
+	}
+
+	// As for ICS20 denominations, the base class ID cannot contain any slashes for IBC v2 packets
+	// since the trace cannot be separated from the base class ID without channel identifiers.
+	if class := types.ExtractClassFromPath(data.ClassId); strings.Contains(class.Base, "/") {
+		return errorsmod.Wrapf(types.ErrInvalidClassForTransfer, "base class ID %s cannot contain slashes for IBC v2 packet", class.Base)
+	}
+
+	if err := im.keeper.SendTransfer(ctx, payload.SourcePort, sourceChannel, data, signer); err != nil {
+		return err
+	}
+
+	events.EmitTransferEvent(ctx, data)
+
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface. Vouchers are minted or tokens are unescrowed
+// to the receiver.
+func (im *IBCModule) OnRecvPacket(ctx sdk.Context, sourceChannel string, destinationChannel string, sequence uint64, payload channeltypesv2.Payload, relayer sdk.AccAddress) channeltypesv2.RecvPacketResult {
+	if err := validateIdentifiers(sourceChannel, destinationChannel, payload); err != nil {
+		im.keeper.Logger(ctx).Error(fmt.Sprintf("%s sequence %d", err.Error(), sequence))
+		return channeltypesv2.RecvPacketResult{
+			Status: channeltypesv2.PacketStatus_Failure,
+		}
+	}
+
+	data, err := types.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		im.keeper.Logger(ctx).Error(fmt.Sprintf("%s sequence %d", err.Error(), sequence))
+		return channeltypesv2.RecvPacketResult{
+			Status: channeltypesv2.PacketStatus_Failure,
+		}
+	}
+
+	err = im.keeper.OnRecvPacket(
+		ctx,
+		data,
+		payload.SourcePort,
+		sourceChannel,
+		payload.DestinationPort,
+		destinationChannel,
+	)
+	events.EmitOnRecvPacketEvent(ctx, data, err)
+	if err != nil {
+		im.keeper.Logger(ctx).Error(fmt.Sprintf("%s sequence %d", err.Error(), sequence))
+		return channeltypesv2.RecvPacketResult{
+			Status: channeltypesv2.PacketStatus_Failure,
+		}
+	}
+
+	im.keeper.Logger(ctx).Info("successfully handled ICS-721 packet", "sequence", sequence)
+
+	// NOTE: acknowledgement will be written synchronously during IBC handler execution.
+	return channeltypesv2.RecvPacketResult{
+		Status:          channeltypesv2.PacketStatus_Success,
+		Acknowledgement: channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(),
+	}
+}
+
+// OnTimeoutPacket implements the IBCModule interface. The tokens are refunded to the sender.
+func (im *IBCModule) OnTimeoutPacket(ctx sdk.Context, sourceChannel string, destinationChannel string, sequence uint64, payload channeltypesv2.Payload, relayer sdk.AccAddress) error {
+	data, err := types.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return err
+	}
+
+	// refund tokens
+	if err := im.keeper.OnTimeoutPacket(ctx, payload.SourcePort, sourceChannel, data); err != nil {
+		return err
+	}
+
+	events.EmitOnTimeoutEvent(ctx, data)
+
+	return nil
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. The tokens are refunded to the
+// sender if the packet failed on the receiving chain.
+func (im *IBCModule) OnAcknowledgementPacket(ctx sdk.Context, sourceChannel string, destinationChannel string, sequence uint64, acknowledgement []byte, payload channeltypesv2.Payload, relayer sdk.AccAddress) error {
+	var ack channeltypes.Acknowledgement
+	// construct an error acknowledgement if the acknowledgement bytes are the sentinel error acknowledgement so we can use the shared nft-transfer logic
+	if bytes.Equal(acknowledgement, channeltypesv2.ErrorAcknowledgement[:]) {
+		// the specific error does not matter
+		ack = channeltypes.NewErrorAcknowledgement(types.ErrReceiveFailed)
+	} else {
+		if err := types.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+			return errorsmod.Wrapf(ibcerrors.ErrUnknownRequest, "cannot unmarshal ICS-721 transfer packet acknowledgement: %v", err)
+		}
+		if !ack.Success() {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "cannot pass in a custom error acknowledgement with IBC v2")
+		}
+	}
+
+	data, err := types.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return err
+	}
+
+	if err := im.keeper.OnAcknowledgementPacket(ctx, payload.SourcePort, sourceChannel, data, ack); err != nil {
+		return err
+	}
+
+	events.EmitOnAcknowledgementPacketEvent(ctx, data, ack)
+
+	return nil
+}
+
+// UnmarshalPacketData unmarshals the ICS721 packet data based on the version and encoding
+// it implements the PacketDataUnmarshaler interface
+func (*IBCModule) UnmarshalPacketData(payload channeltypesv2.Payload) (any, error) {
+	return types.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+}
+
+// validateIdentifiers enforces that the source and destination ports are the nft-transfer port and that the
+// source and destination clients are in the client ID format: {clientid}-{sequence}. As for ICS20, this is
+// necessary for IBC v2 since the ports are not prenegotiated by a channel handshake and the trace hop on
+// receive only commits to the destination port.
+func validateIdentifiers(sourceChannel, destinationChannel string, payload channeltypesv2.Payload) error {
+	if payload.SourcePort != types.PortID || payload.DestinationPort != types.PortID {
+		return errorsmod.Wrapf(channeltypesv2.ErrInvalidPacket, "payload port ID is invalid: expected %s, got sourcePort: %s destPort: %s", types.PortID, payload.SourcePort, payload.DestinationPort)
+	}
+	if !clienttypes.IsValidClientID(sourceChannel) || !clienttypes.IsValidClientID(destinationChannel) {
+		return errorsmod.Wrapf(channeltypesv2.ErrInvalidPacket, "client IDs must be in valid format: {string}-{number}")
+	}
+
+	return nil
+}
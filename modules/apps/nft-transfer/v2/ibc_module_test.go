@@ -0,0 +1,174 @@
+package v2_test
+
+import (
+	"testing"
+	"time"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/nft-transfer/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+const classID = "kitties"
+
+type NFTTransferTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+func (suite *NFTTransferTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.SetupV2()
+
+	ctx := suite.chainA.GetContext()
+	nftKeeper := suite.chainA.GetSimApp().NFTKeeper
+	suite.Require().NoError(nftKeeper.SaveClass(ctx, classID, "class-uri", ""))
+	suite.Require().NoError(nftKeeper.Mint(ctx, classID, "1", "uri-1", "data-1", suite.chainA.SenderAccount.GetAddress()))
+}
+
+func TestNFTTransferTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(NFTTransferTestSuite))
+}
+
+// payload returns an nft-transfer payload sending token "1" of the class from chainA to chainB with the given encoding.
+func (suite *NFTTransferTestSuite) payload(encoding string) channeltypesv2.Payload {
+	data := types.NewNonFungibleTokenPacketData(
+		classID, "class-uri", "", []string{"1"}, []string{"uri-1"}, []string{"data-1"},
+		suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "",
+	)
+
+	bz, err := types.MarshalPacketData(data, types.V1, encoding)
+	suite.Require().NoError(err)
+
+	return channeltypesv2.NewPayload(types.PortID, types.PortID, types.V1, encoding, bz)
+}
+
+func (suite *NFTTransferTestSuite) TestOnSendPacket() {
+	var payload channeltypesv2.Payload
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success", func() {}, nil,
+		},
+		{
+			"failure: invalid destination port", func() {
+				payload.DestinationPort = ibctesting.MockPort
+			}, channeltypesv2.ErrInvalidPacket,
+		},
+		{
+			"failure: invalid version", func() {
+				payload.Version = transfertypes.V1
+			}, types.ErrInvalidVersion,
+		},
+		{
+			"failure: signer is not the sender", func() {
+				data := types.NewNonFungibleTokenPacketData(classID, "", "", []string{"1"}, nil, nil, suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "")
+				payload.Value = data.GetBytes()
+			}, ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			payload = suite.payload(types.EncodingJSON)
+
+			tc.malleate()
+
+			_, err := suite.path.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), payload)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				escrow := types.GetEscrowAddress(types.PortID, suite.path.EndpointA.ClientID)
+				suite.Require().Equal(escrow, suite.chainA.GetSimApp().NFTKeeper.GetOwner(suite.chainA.GetContext(), classID, "1"))
+			} else {
+				suite.Require().ErrorContains(err, tc.expError.Error())
+			}
+		})
+	}
+}
+
+func (suite *NFTTransferTestSuite) TestRecvAndAcknowledgePacket() {
+	for _, encoding := range []string{types.EncodingJSON, types.EncodingProtobuf, types.EncodingABI} {
+		suite.Run(encoding, func() {
+			suite.SetupTest() // reset
+
+			packet, err := suite.path.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), suite.payload(encoding))
+			suite.Require().NoError(err)
+
+			err = suite.path.EndpointB.MsgRecvPacket(packet)
+			suite.Require().NoError(err)
+
+			voucher := types.NewClass(classID, transfertypes.NewHop(types.PortID, suite.path.EndpointB.ClientID))
+			ctx := suite.chainB.GetContext()
+			suite.Require().Equal(suite.chainB.SenderAccount.GetAddress(), suite.chainB.GetSimApp().NFTKeeper.GetOwner(ctx, voucher.IBCClassID(), "1"))
+
+			tokenURI, tokenData, found := suite.chainB.GetSimApp().NFTKeeper.GetNFT(ctx, voucher.IBCClassID(), "1")
+			suite.Require().True(found)
+			suite.Require().Equal("uri-1", tokenURI)
+			suite.Require().Equal("data-1", tokenData)
+
+			ack := channeltypesv2.NewAcknowledgement(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement())
+			err = suite.path.EndpointA.MsgAcknowledgePacket(packet, ack)
+			suite.Require().NoError(err)
+
+			// on a successful acknowledgement the token remains in escrow
+			escrow := types.GetEscrowAddress(types.PortID, suite.path.EndpointA.ClientID)
+			suite.Require().Equal(escrow, suite.chainA.GetSimApp().NFTKeeper.GetOwner(suite.chainA.GetContext(), classID, "1"))
+		})
+	}
+}
+
+func (suite *NFTTransferTestSuite) TestOnAcknowledgementPacketErrorAck() {
+	packet, err := suite.path.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), suite.payload(types.EncodingJSON))
+	suite.Require().NoError(err)
+
+	ctx := suite.chainA.GetContext()
+	cbs := suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.Router.Route(types.PortID)
+
+	// the sentinel error acknowledgement refunds the token to the sender
+	err = cbs.OnAcknowledgementPacket(
+		ctx, packet.SourceClient, packet.DestinationClient,
+		packet.Sequence, channeltypesv2.ErrorAcknowledgement[:], packet.Payloads[0], suite.chainA.SenderAccount.GetAddress(),
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.chainA.SenderAccount.GetAddress(), suite.chainA.GetSimApp().NFTKeeper.GetOwner(ctx, classID, "1"))
+}
+
+func (suite *NFTTransferTestSuite) TestOnTimeoutPacket() {
+	packet, err := suite.path.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), suite.payload(types.EncodingABI))
+	suite.Require().NoError(err)
+
+	escrow := types.GetEscrowAddress(types.PortID, suite.path.EndpointA.ClientID)
+	suite.Require().Equal(escrow, suite.chainA.GetSimApp().NFTKeeper.GetOwner(suite.chainA.GetContext(), classID, "1"))
+
+	suite.coordinator.IncrementTimeBy(time.Hour * 2)
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+
+	err = suite.path.EndpointA.MsgTimeoutPacket(packet)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(suite.chainA.SenderAccount.GetAddress(), suite.chainA.GetSimApp().NFTKeeper.GetOwner(suite.chainA.GetContext(), classID, "1"))
+}
@@ -0,0 +1,116 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/types"
+)
+
+// GetCmdQueryAllRateLimits defines the command to query all rate limits.
+func GetCmdQueryAllRateLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rate-limits",
+		Short:   "Query all rate limits",
+		Long:    "Query all rate limits",
+		Example: fmt.Sprintf("%s query ratelimiting rate-limits", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAllRateLimitsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.AllRateLimits(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "rate limits")
+	return cmd
+}
+
+// GetCmdQueryRateLimit defines the command to query the rate limit of a denom on a channel or client.
+func GetCmdQueryRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rate-limit [denom] [channel-or-client-id]",
+		Short:   "Query the rate limit of a denom on a channel or client",
+		Long:    "Query the rate limit of a denom on a channel or client",
+		Example: fmt.Sprintf("%s query ratelimiting rate-limit uatom channel-0", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRateLimitRequest{
+				Denom:             args[0],
+				ChannelOrClientId: args[1],
+			}
+
+			res, err := queryClient.RateLimit(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryRateLimitsByChannelOrClient defines the command to query all rate limits on a channel or client.
+func GetCmdQueryRateLimitsByChannelOrClient() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rate-limits-by-channel-or-client [channel-or-client-id]",
+		Short:   "Query all rate limits on a channel or client",
+		Long:    "Query all rate limits on a channel or client",
+		Example: fmt.Sprintf("%s query ratelimiting rate-limits-by-channel-or-client channel-0", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRateLimitsByChannelOrClientRequest{
+				ChannelOrClientId: args[0],
+			}
+
+			res, err := queryClient.RateLimitsByChannelOrClient(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,14 @@
+/*
+Package ratelimiting implements a rate-limiting middleware for ICS-20 fungible token transfers
+over IBC v1 channels and IBC v2 clients.
+
+Rate limits are defined per denomination and local channel or client identifier. Each rate limit
+tracks the inflow and outflow of the denomination over a window of a configurable number of hours,
+and rejects transfers whose net flow would exceed a percentage of the denomination's total supply
+at the start of the window. Outgoing transfers exceeding the quota are rejected with an error,
+while incoming transfers exceeding the quota are rejected with an error acknowledgement. The flow
+of packets which fail or time out within the window in which they were sent is undone.
+
+Rate limits are managed through authority-gated messages, typically executed through governance.
+*/
+package ratelimiting
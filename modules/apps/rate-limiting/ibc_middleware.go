@@ -0,0 +1,231 @@
+package ratelimiting
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/keeper"
+	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+var (
+	_ porttypes.Middleware            = (*IBCMiddleware)(nil)
+	_ porttypes.PacketDataUnmarshaler = (*IBCMiddleware)(nil)
+)
+
+// IBCMiddleware implements the ICS26 callbacks for the rate-limiting middleware given
+// the underlying ICS-20 transfer application.
+type IBCMiddleware struct {
+	app         porttypes.IBCModule
+	ics4Wrapper porttypes.ICS4Wrapper
+
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper and underlying application.
+func NewIBCMiddleware(app porttypes.IBCModule, ics4Wrapper porttypes.ICS4Wrapper, k keeper.Keeper) IBCMiddleware {
+	if app == nil {
+		panic(errors.New("IBCModule cannot be nil"))
+	}
+
+	if ics4Wrapper == nil {
+		panic(errors.New("ICS4Wrapper cannot be nil"))
+	}
+
+	return IBCMiddleware{
+		app:         app,
+		ics4Wrapper: ics4Wrapper,
+		keeper:      k,
+	}
+}
+
+// WithICS4Wrapper sets the ICS4Wrapper. This function may be used after the
+// middleware's creation to set the middleware which is above this module in
+// the IBC application stack.
+func (im *IBCMiddleware) WithICS4Wrapper(wrapper porttypes.ICS4Wrapper) {
+	im.ics4Wrapper = wrapper
+}
+
+// GetICS4Wrapper returns the ICS4Wrapper.
+func (im *IBCMiddleware) GetICS4Wrapper() porttypes.ICS4Wrapper {
+	return im.ics4Wrapper
+}
+
+// SendPacket implements the ICS4Wrapper interface. The packet is sent by the underlying ICS4Wrapper
+// and its tokens are then added to the outflow of the matching rate limit. The packet send is
+// rejected if the send quota is exceeded.
+func (im IBCMiddleware) SendPacket(
+	ctx sdk.Context,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	seq, err := im.ics4Wrapper.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	if err != nil {
+		return 0, err
+	}
+
+	packetData, err := im.unmarshalPacketData(ctx, sourcePort, sourceChannel, data)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := im.keeper.SendRateLimitedPacket(ctx, sourceChannel, seq, packetData); err != nil {
+		return 0, err
+	}
+
+	return seq, nil
+}
+
+// OnRecvPacket implements the IBCModule interface. The tokens of the packet are added to the inflow
+// of the matching rate limit before deferring to the underlying application. An error acknowledgement
+// is returned if the receive quota is exceeded.
+func (im IBCMiddleware) OnRecvPacket(ctx sdk.Context, channelVersion string, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	packetData, err := transfertypes.UnmarshalPacketData(packet.GetData(), channelVersion, "")
+	if err != nil {
+		// the underlying application is responsible for rejecting packets with malformed packet data
+		return im.app.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+
+	if err := im.keeper.ReceiveRateLimitedPacket(ctx, packet.SourcePort, packet.SourceChannel, packet.DestinationPort, packet.DestinationChannel, packetData); err != nil {
+		im.keeper.Logger(ctx).Error("receive packet rate limited", "port-id", packet.DestinationPort, "channel-id", packet.DestinationChannel, "sequence", packet.Sequence, "error", err)
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return im.app.OnRecvPacket(ctx, channelVersion, packet, relayer)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. It defers to the underlying application
+// and then undoes the outflow of the packet if the acknowledgement is unsuccessful.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnAcknowledgementPacket(ctx, channelVersion, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
+	}
+
+	packetData, err := transfertypes.UnmarshalPacketData(packet.GetData(), channelVersion, "")
+	if err != nil {
+		return err
+	}
+
+	return im.keeper.AcknowledgeRateLimitedPacket(ctx, packet.SourceChannel, packet.Sequence, packetData, ack.Success())
+}
+
+// OnTimeoutPacket implements the IBCModule interface. It defers to the underlying application
+// and then undoes the outflow of the packet.
+func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, channelVersion string, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	if err := im.app.OnTimeoutPacket(ctx, channelVersion, packet, relayer); err != nil {
+		return err
+	}
+
+	packetData, err := transfertypes.UnmarshalPacketData(packet.GetData(), channelVersion, "")
+	if err != nil {
+		return err
+	}
+
+	return im.keeper.TimeoutRateLimitedPacket(ctx, packet.SourceChannel, packet.Sequence, packetData)
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface
+func (im IBCMiddleware) WriteAcknowledgement(ctx sdk.Context, packet ibcexported.PacketI, ack ibcexported.Acknowledgement) error {
+	return im.ics4Wrapper.WriteAcknowledgement(ctx, packet, ack)
+}
+
+// GetAppVersion implements the ICS4Wrapper interface. Rate-limiting has no version,
+// so the call is deferred to the underlying application.
+func (im IBCMiddleware) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return im.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// OnChanOpenInit defers to the underlying application
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	channelOrdering channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return im.app.OnChanOpenInit(ctx, channelOrdering, connectionHops, portID, channelID, counterparty, version)
+}
+
+// OnChanOpenTry defers to the underlying application
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	channelOrdering channeltypes.Order,
+	connectionHops []string, portID,
+	channelID string,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, channelOrdering, connectionHops, portID, channelID, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck defers to the underlying application
+func (im IBCMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID,
+	counterpartyChannelID,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm defers to the underlying application
+func (im IBCMiddleware) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit defers to the underlying application
+func (im IBCMiddleware) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm defers to the underlying application
+func (im IBCMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// UnmarshalPacketData defers to the underlying app to unmarshal the packet data.
+// This function implements the optional PacketDataUnmarshaler interface.
+func (im IBCMiddleware) UnmarshalPacketData(ctx sdk.Context, portID string, channelID string, bz []byte) (any, string, error) {
+	unmarshaler, ok := im.app.(porttypes.PacketDataUnmarshaler)
+	if !ok {
+		return nil, "", errorsmod.Wrapf(types.ErrInvalidPacketData, "underlying application does not implement %T", (*porttypes.PacketDataUnmarshaler)(nil))
+	}
+
+	return unmarshaler.UnmarshalPacketData(ctx, portID, channelID, bz)
+}
+
+// unmarshalPacketData unmarshals the ICS-20 packet data of an outgoing packet using the app version of the channel.
+func (im IBCMiddleware) unmarshalPacketData(ctx sdk.Context, portID, channelID string, bz []byte) (transfertypes.InternalTransferRepresentation, error) {
+	ics20Version, found := im.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+	if !found {
+		return transfertypes.InternalTransferRepresentation{}, errorsmod.Wrapf(ibcerrors.ErrNotFound, "app version not found for port %s and channel %s", portID, channelID)
+	}
+
+	return transfertypes.UnmarshalPacketData(bz, ics20Version, "")
+}
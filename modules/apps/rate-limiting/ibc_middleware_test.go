@@ -0,0 +1,173 @@
+package ratelimiting_test
+
+import (
+	"testing"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+type RateLimitingTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+func (suite *RateLimitingTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	suite.path.Setup()
+}
+
+func TestRateLimitingTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(RateLimitingTestSuite))
+}
+
+// setRateLimit stores a rate limit with a channel value of 1000 for the provided denom and
+// channel on the given chain, so that the thresholds are equal to 10 times the percentages.
+func setRateLimit(chain *ibctesting.TestChain, denom, channelOrClientID string, maxPercentSend, maxPercentRecv int64) {
+	rateLimit := types.NewRateLimit(
+		types.NewPath(denom, channelOrClientID),
+		types.NewQuota(sdkmath.NewInt(maxPercentSend), sdkmath.NewInt(maxPercentRecv), 24),
+		sdkmath.NewInt(1000),
+	)
+	chain.GetSimApp().RateLimitKeeper.SetRateLimit(chain.GetContext(), rateLimit)
+}
+
+func (suite *RateLimitingTestSuite) transfer(amount int64, timeoutHeight clienttypes.Height) (channeltypes.Packet, error) {
+	msg := transfertypes.NewMsgTransfer(
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+		sdk.NewInt64Coin(sdk.DefaultBondDenom, amount),
+		suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+		timeoutHeight, 0, "",
+	)
+
+	res, err := suite.chainA.SendMsgs(msg)
+	if err != nil {
+		return channeltypes.Packet{}, err
+	}
+
+	return ibctesting.ParsePacketFromEvents(res.Events)
+}
+
+func (suite *RateLimitingTestSuite) TestSendPacket() {
+	testCases := []struct {
+		name       string
+		amount     int64
+		expError   error
+		expOutflow sdkmath.Int
+	}{
+		{"success: within quota", 100, nil, sdkmath.NewInt(100)},
+		{"failure: quota exceeded", 101, types.ErrQuotaExceeded, sdkmath.ZeroInt()},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			setRateLimit(suite.chainA, sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID, 10, 10)
+
+			_, err := suite.transfer(tc.amount, suite.chainB.GetTimeoutHeight())
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorContains(err, tc.expError.Error())
+			}
+
+			rateLimit, found := suite.chainA.GetSimApp().RateLimitKeeper.GetRateLimit(suite.chainA.GetContext(), sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expOutflow, rateLimit.Flow.Outflow)
+		})
+	}
+}
+
+func (suite *RateLimitingTestSuite) TestRecvPacket() {
+	testCases := []struct {
+		name      string
+		amount    int64
+		expAck    bool
+		expInflow sdkmath.Int
+	}{
+		{"success: within quota", 100, true, sdkmath.NewInt(100)},
+		{"failure: quota exceeded", 101, false, sdkmath.ZeroInt()},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			voucherDenom := transfertypes.NewDenom(sdk.DefaultBondDenom, transfertypes.NewHop(suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID)).IBCDenom()
+			setRateLimit(suite.chainB, voucherDenom, suite.path.EndpointB.ChannelID, 10, 10)
+			setRateLimit(suite.chainA, sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID, 100, 100)
+
+			packet, err := suite.transfer(tc.amount, suite.chainB.GetTimeoutHeight())
+			suite.Require().NoError(err)
+
+			res, ack, err := suite.path.RelayPacketWithResults(packet)
+			suite.Require().NoError(err)
+			suite.Require().NotNil(res)
+
+			var acknowledgement channeltypes.Acknowledgement
+			err = transfertypes.ModuleCdc.UnmarshalJSON(ack, &acknowledgement)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expAck, acknowledgement.Success())
+
+			rateLimit, found := suite.chainB.GetSimApp().RateLimitKeeper.GetRateLimit(suite.chainB.GetContext(), voucherDenom, suite.path.EndpointB.ChannelID)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expInflow, rateLimit.Flow.Inflow)
+
+			// the outflow on the sending chain is undone if the packet failed
+			expOutflow := sdkmath.NewInt(tc.amount)
+			if !tc.expAck {
+				expOutflow = sdkmath.ZeroInt()
+			}
+
+			rateLimit, found = suite.chainA.GetSimApp().RateLimitKeeper.GetRateLimit(suite.chainA.GetContext(), sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID)
+			suite.Require().True(found)
+			suite.Require().Equal(expOutflow, rateLimit.Flow.Outflow)
+			suite.Require().False(suite.chainA.GetSimApp().RateLimitKeeper.HasPendingSendPacket(suite.chainA.GetContext(), suite.path.EndpointA.ChannelID, packet.Sequence, sdk.DefaultBondDenom))
+		})
+	}
+}
+
+func (suite *RateLimitingTestSuite) TestTimeoutPacket() {
+	setRateLimit(suite.chainA, sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID, 10, 10)
+
+	timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+	timeoutHeight.RevisionHeight++
+
+	packet, err := suite.transfer(100, timeoutHeight)
+	suite.Require().NoError(err)
+
+	// get past the timeout height on chainB
+	suite.coordinator.CommitNBlocks(suite.chainB, 3)
+
+	err = suite.path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	err = suite.path.EndpointA.TimeoutPacket(packet)
+	suite.Require().NoError(err)
+
+	rateLimit, found := suite.chainA.GetSimApp().RateLimitKeeper.GetRateLimit(suite.chainA.GetContext(), sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+}
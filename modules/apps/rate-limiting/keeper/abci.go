@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker resets the flow of every rate limit whose window ends at the start of a new hour epoch.
+func (k Keeper) BeginBlocker(ctx sdk.Context) {
+	started, epochNumber := k.CheckHourEpochStarting(ctx)
+	if !started {
+		return
+	}
+
+	for _, rateLimit := range k.GetAllRateLimits(ctx) {
+		if epochNumber%rateLimit.Quota.DurationHours != 0 {
+			continue
+		}
+
+		if err := k.resetRateLimit(ctx, rateLimit.Path.Denom, rateLimit.Path.ChannelOrClientId); err != nil {
+			k.Logger(ctx).Error("failed to reset rate limit", "denom", rateLimit.Path.Denom, "channel-or-client", rateLimit.Path.ChannelOrClientId, "error", err)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/types"
+)
+
+func (suite *KeeperTestSuite) TestCheckHourEpochStarting() {
+	ctx := suite.chainA.GetContext()
+	rateLimitKeeper := suite.chainA.GetSimApp().RateLimitKeeper
+
+	epoch := rateLimitKeeper.GetHourEpoch(ctx)
+	suite.Require().False(epoch.EpochStartTime.IsZero(), "epoch start time should be initialized by the begin blocker")
+
+	started, epochNumber := rateLimitKeeper.CheckHourEpochStarting(ctx.WithBlockTime(epoch.EpochStartTime.Add(time.Minute)))
+	suite.Require().False(started)
+	suite.Require().Equal(epoch.EpochNumber, epochNumber)
+
+	started, epochNumber = rateLimitKeeper.CheckHourEpochStarting(ctx.WithBlockTime(epoch.EpochStartTime.Add(epoch.Duration)))
+	suite.Require().True(started)
+	suite.Require().Equal(epoch.EpochNumber+1, epochNumber)
+
+	newEpoch := rateLimitKeeper.GetHourEpoch(ctx)
+	suite.Require().Equal(epoch.EpochStartTime.Add(epoch.Duration), newEpoch.EpochStartTime)
+}
+
+func (suite *KeeperTestSuite) TestBeginBlocker() {
+	ctx := suite.chainA.GetContext()
+	rateLimitKeeper := suite.chainA.GetSimApp().RateLimitKeeper
+	channelID := suite.path.EndpointA.ChannelID
+
+	// a rate limit with a window of one hour is reset at every epoch
+	hourlyRateLimit := suite.addRateLimit(10, 10)
+	hourlyRateLimit.Flow.Outflow = sdkmath.NewInt(100)
+	rateLimitKeeper.SetRateLimit(ctx, hourlyRateLimit)
+
+	// a rate limit with a window of two hours is reset at every other epoch
+	dailyRateLimit := types.NewRateLimit(
+		types.NewPath(sdk.DefaultBondDenom, suite.path.EndpointA.ClientID),
+		types.NewQuota(sdkmath.NewInt(10), sdkmath.NewInt(10), 2),
+		hourlyRateLimit.Flow.ChannelValue,
+	)
+	dailyRateLimit.Flow.Outflow = sdkmath.NewInt(100)
+	rateLimitKeeper.SetRateLimit(ctx, dailyRateLimit)
+
+	epoch := rateLimitKeeper.GetHourEpoch(ctx)
+	epoch.EpochNumber = 0
+	rateLimitKeeper.SetHourEpoch(ctx, epoch)
+
+	// advance to epoch 1
+	ctx = ctx.WithBlockTime(epoch.EpochStartTime.Add(epoch.Duration))
+	rateLimitKeeper.BeginBlocker(ctx)
+
+	rateLimit, found := rateLimitKeeper.GetRateLimit(ctx, sdk.DefaultBondDenom, channelID)
+	suite.Require().True(found)
+	suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+
+	rateLimit, found = rateLimitKeeper.GetRateLimit(ctx, sdk.DefaultBondDenom, suite.path.EndpointA.ClientID)
+	suite.Require().True(found)
+	suite.Require().Equal(sdkmath.NewInt(100), rateLimit.Flow.Outflow)
+
+	// advance to epoch 2
+	ctx = ctx.WithBlockTime(epoch.EpochStartTime.Add(2 * epoch.Duration))
+	rateLimitKeeper.BeginBlocker(ctx)
+
+	rateLimit, found = rateLimitKeeper.GetRateLimit(ctx, sdk.DefaultBondDenom, suite.path.EndpointA.ClientID)
+	suite.Require().True(found)
+	suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+}
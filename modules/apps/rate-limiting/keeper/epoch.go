@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/types"
+)
+
+// GetHourEpoch returns the current hour epoch.
+func (k Keeper) GetHourEpoch(ctx sdk.Context) types.HourEpoch {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get([]byte(types.KeyHourEpoch))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		panic(errors.New("rate-limiting hour epoch is not set in store"))
+	}
+
+	var epoch types.HourEpoch
+	k.cdc.MustUnmarshal(bz, &epoch)
+	return epoch
+}
+
+// SetHourEpoch stores the provided hour epoch.
+func (k Keeper) SetHourEpoch(ctx sdk.Context, epoch types.HourEpoch) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&epoch)
+	if err := store.Set([]byte(types.KeyHourEpoch), bz); err != nil {
+		panic(err)
+	}
+}
+
+// CheckHourEpochStarting advances the hour epoch if its duration has elapsed. It returns true
+// along with the new epoch number if a new epoch has started in this block.
+func (k Keeper) CheckHourEpochStarting(ctx sdk.Context) (bool, uint64) {
+	epoch := k.GetHourEpoch(ctx)
+
+	// the first epoch starts at the first block processed by the module
+	if epoch.EpochStartTime.IsZero() {
+		epoch.EpochStartTime = ctx.BlockTime()
+		epoch.EpochStartHeight = ctx.BlockHeight()
+		k.SetHourEpoch(ctx, epoch)
+		return false, epoch.EpochNumber
+	}
+
+	epochEndTime := epoch.EpochStartTime.Add(epoch.Duration)
+	if ctx.BlockTime().Before(epochEndTime) {
+		return false, epoch.EpochNumber
+	}
+
+	epoch.EpochNumber++
+	epoch.EpochStartTime = epochEndTime
+	epoch.EpochStartHeight = ctx.BlockHeight()
+	k.SetHourEpoch(ctx, epoch)
+
+	return true, epoch.EpochNumber
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/types"
+)
+
+// emitQuotaExceededEvent emits an event signalling that a packet was rejected because it exceeded the quota of a rate limit.
+func emitQuotaExceededEvent(ctx sdk.Context, rateLimit types.RateLimit, direction types.PacketDirection, amount sdkmath.Int) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeQuotaExceeded,
+			sdk.NewAttribute(types.AttributeKeyDenom, rateLimit.Path.Denom),
+			sdk.NewAttribute(types.AttributeKeyChannelOrClientID, rateLimit.Path.ChannelOrClientId),
+			sdk.NewAttribute(types.AttributeKeyDirection, direction.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyThreshold, rateLimit.Quota.Threshold(direction, rateLimit.Flow.ChannelValue).String()),
+		),
+	)
+}
+
+// emitRateLimitResetEvent emits an event signalling that the flow of a rate limit was reset.
+func emitRateLimitResetEvent(ctx sdk.Context, rateLimit types.RateLimit) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRateLimitReset,
+			sdk.NewAttribute(types.AttributeKeyDenom, rateLimit.Path.Denom),
+			sdk.NewAttribute(types.AttributeKeyChannelOrClientID, rateLimit.Path.ChannelOrClientId),
+		),
+	)
+}
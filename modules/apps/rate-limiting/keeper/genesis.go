@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/types"
+)
+
+// InitGenesis initializes the rate-limiting state from the provided genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	for _, rateLimit := range state.RateLimits {
+		k.SetRateLimit(ctx, rateLimit)
+	}
+
+	for _, pendingPacket := range state.PendingSendPackets {
+		k.SetPendingSendPacket(ctx, pendingPacket)
+	}
+
+	k.SetHourEpoch(ctx, state.HourEpoch)
+}
+
+// ExportGenesis exports the rate-limiting module's rate limits, pending send packets and hour epoch into its genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		RateLimits:         k.GetAllRateLimits(ctx),
+		PendingSendPackets: k.GetAllPendingSendPackets(ctx),
+		HourEpoch:          k.GetHourEpoch(ctx),
+	}
+}
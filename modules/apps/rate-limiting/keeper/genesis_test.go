@@ -0,0 +1,29 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/types"
+)
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	ctx := suite.chainA.GetContext()
+	rateLimitKeeper := suite.chainA.GetSimApp().RateLimitKeeper
+
+	rateLimit := suite.addRateLimit(10, 10)
+	pendingPacket := types.NewPendingSendPacket(suite.path.EndpointA.ChannelID, 1, sdk.DefaultBondDenom)
+	rateLimitKeeper.SetPendingSendPacket(ctx, pendingPacket)
+
+	genesis := rateLimitKeeper.ExportGenesis(ctx)
+	suite.Require().NoError(genesis.Validate())
+	suite.Require().Equal([]types.RateLimit{rateLimit}, genesis.RateLimits)
+	suite.Require().Equal([]types.PendingSendPacket{pendingPacket}, genesis.PendingSendPackets)
+
+	// initialize a fresh chain with the exported genesis
+	suite.SetupTest()
+	ctx = suite.chainA.GetContext()
+	rateLimitKeeper = suite.chainA.GetSimApp().RateLimitKeeper
+
+	rateLimitKeeper.InitGenesis(ctx, *genesis)
+	suite.Require().Equal(genesis, rateLimitKeeper.ExportGenesis(ctx))
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/types"
+)
+
+var _ types.QueryServer = (*Keeper)(nil)
+
+// AllRateLimits implements the Query/AllRateLimits gRPC method
+func (k Keeper) AllRateLimits(ctx context.Context, req *types.QueryAllRateLimitsRequest) (*types.QueryAllRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var rateLimits []types.RateLimit
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), []byte(types.KeyRateLimitPrefix+"/"))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var rateLimit types.RateLimit
+		if err := k.cdc.Unmarshal(value, &rateLimit); err != nil {
+			return err
+		}
+
+		rateLimits = append(rateLimits, rateLimit)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAllRateLimitsResponse{
+		RateLimits: rateLimits,
+		Pagination: pageRes,
+	}, nil
+}
+
+// RateLimit implements the Query/RateLimit gRPC method
+func (k Keeper) RateLimit(goCtx context.Context, req *types.QueryRateLimitRequest) (*types.QueryRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.NewPath(req.Denom, req.ChannelOrClientId).Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	rateLimit, found := k.GetRateLimit(ctx, req.Denom, req.ChannelOrClientId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrRateLimitNotFound, "denom %s, channel or client %s", req.Denom, req.ChannelOrClientId).Error(),
+		)
+	}
+
+	return &types.QueryRateLimitResponse{
+		RateLimit: &rateLimit,
+	}, nil
+}
+
+// RateLimitsByChannelOrClient implements the Query/RateLimitsByChannelOrClient gRPC method
+func (k Keeper) RateLimitsByChannelOrClient(goCtx context.Context, req *types.QueryRateLimitsByChannelOrClientRequest) (*types.QueryRateLimitsByChannelOrClientResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !types.IsValidChannelOrClientID(req.ChannelOrClientId) {
+		return nil, status.Errorf(codes.InvalidArgument, "%s is neither a valid channel ID nor a valid client ID", req.ChannelOrClientId)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryRateLimitsByChannelOrClientResponse{
+		RateLimits: k.GetRateLimitsByChannelOrClient(ctx, req.ChannelOrClientId),
+	}, nil
+}
@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+func (suite *KeeperTestSuite) TestQueryAllRateLimits() {
+	ctx := suite.chainA.GetContext()
+	rateLimitKeeper := suite.chainA.GetSimApp().RateLimitKeeper
+
+	rateLimit := suite.addRateLimit(10, 10)
+	secondRateLimit := types.NewRateLimit(
+		types.NewPath(sdk.DefaultBondDenom, suite.path.EndpointA.ClientID),
+		rateLimit.Quota,
+		rateLimit.Flow.ChannelValue,
+	)
+	rateLimitKeeper.SetRateLimit(ctx, secondRateLimit)
+
+	res, err := rateLimitKeeper.AllRateLimits(ctx, &types.QueryAllRateLimitsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch([]types.RateLimit{rateLimit, secondRateLimit}, res.RateLimits)
+
+	res, err = rateLimitKeeper.AllRateLimits(ctx, &types.QueryAllRateLimitsRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.RateLimits, 1)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	_, err = rateLimitKeeper.AllRateLimits(ctx, nil)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryRateLimit() {
+	var req *types.QueryRateLimitRequest
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expCode  codes.Code
+	}{
+		{
+			"success",
+			func() {},
+			codes.OK,
+		},
+		{
+			"failure: empty request",
+			func() {
+				req = nil
+			},
+			codes.InvalidArgument,
+		},
+		{
+			"failure: invalid channel or client identifier",
+			func() {
+				req.ChannelOrClientId = ibctesting.InvalidID
+			},
+			codes.InvalidArgument,
+		},
+		{
+			"failure: rate limit not found",
+			func() {
+				req.Denom = ibctesting.SecondaryDenom
+			},
+			codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			rateLimit := suite.addRateLimit(10, 20)
+			req = &types.QueryRateLimitRequest{
+				Denom:             sdk.DefaultBondDenom,
+				ChannelOrClientId: suite.path.EndpointA.ChannelID,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().RateLimitKeeper.RateLimit(suite.chainA.GetContext(), req)
+
+			if tc.expCode == codes.OK {
+				suite.Require().NoError(err)
+				suite.Require().Equal(&rateLimit, res.RateLimit)
+			} else {
+				suite.Require().Equal(tc.expCode, status.Code(err))
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryRateLimitsByChannelOrClient() {
+	ctx := suite.chainA.GetContext()
+	rateLimitKeeper := suite.chainA.GetSimApp().RateLimitKeeper
+
+	rateLimit := suite.addRateLimit(10, 10)
+	rateLimitKeeper.SetRateLimit(ctx, types.NewRateLimit(
+		types.NewPath(sdk.DefaultBondDenom, suite.path.EndpointA.ClientID),
+		rateLimit.Quota,
+		sdkmath.NewInt(1000),
+	))
+
+	res, err := rateLimitKeeper.RateLimitsByChannelOrClient(ctx, &types.QueryRateLimitsByChannelOrClientRequest{ChannelOrClientId: suite.path.EndpointA.ChannelID})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.RateLimit{rateLimit}, res.RateLimits)
+
+	_, err = rateLimitKeeper.RateLimitsByChannelOrClient(ctx, &types.QueryRateLimitsByChannelOrClientRequest{ChannelOrClientId: ibctesting.InvalidID})
+	suite.Require().Error(err)
+}
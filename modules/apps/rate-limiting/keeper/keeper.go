@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"errors"
+	"strings"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+// Keeper defines the rate-limiting keeper
+type Keeper struct {
+	storeService corestore.KVStoreService
+	cdc          codec.BinaryCodec
+
+	channelKeeper types.ChannelKeeper
+	clientKeeper  types.ClientKeeper
+	bankKeeper    types.BankKeeper
+
+	// the address capable of executing rate limit messages. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new rate-limiting Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService corestore.KVStoreService,
+	channelKeeper types.ChannelKeeper,
+	clientKeeper types.ClientKeeper,
+	bankKeeper types.BankKeeper,
+	authority string,
+) Keeper {
+	if strings.TrimSpace(authority) == "" {
+		panic(errors.New("authority must be non-empty"))
+	}
+
+	return Keeper{
+		cdc:           cdc,
+		storeService:  storeService,
+		channelKeeper: channelKeeper,
+		clientKeeper:  clientKeeper,
+		bankKeeper:    bankKeeper,
+		authority:     authority,
+	}
+}
+
+// GetAuthority returns the rate-limiting module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+exported.ModuleName+"-"+types.ModuleName)
+}
+
+// hasChannelOrClient returns true if the provided identifier refers to an existing
+// transfer channel (IBC v1) or an existing client (IBC v2).
+func (k Keeper) hasChannelOrClient(ctx sdk.Context, channelOrClientID string) bool {
+	if _, found := k.channelKeeper.GetChannel(ctx, transfertypes.PortID, channelOrClientID); found {
+		return true
+	}
+
+	_, found := k.clientKeeper.GetClientState(ctx, channelOrClientID)
+	return found
+}
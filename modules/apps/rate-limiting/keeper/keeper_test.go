@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"testing"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/keeper"
+	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+type KeeperTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	suite.path.Setup()
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(KeeperTestSuite))
+}
+
+// addRateLimit stores a rate limit for the bond denom on the channel of chainA with the provided
+// send and recv percentages, returning the stored rate limit.
+func (suite *KeeperTestSuite) addRateLimit(maxPercentSend, maxPercentRecv int64) types.RateLimit {
+	rateLimitKeeper := suite.chainA.GetSimApp().RateLimitKeeper
+
+	msg := types.NewMsgAddRateLimit(rateLimitKeeper.GetAuthority(), sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID, sdkmath.NewInt(maxPercentSend), sdkmath.NewInt(maxPercentRecv), 1)
+	_, err := rateLimitKeeper.AddRateLimit(suite.chainA.GetContext(), msg)
+	suite.Require().NoError(err)
+
+	rateLimit, found := rateLimitKeeper.GetRateLimit(suite.chainA.GetContext(), sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID)
+	suite.Require().True(found)
+
+	return rateLimit
+}
+
+func (suite *KeeperTestSuite) TestNewKeeper() {
+	testCases := []struct {
+		name          string
+		instantiateFn func()
+		panicMsg      string
+	}{
+		{"success", func() {
+			keeper.NewKeeper(
+				suite.chainA.GetSimApp().AppCodec(),
+				runtime.NewKVStoreService(suite.chainA.GetSimApp().GetKey(types.StoreKey)),
+				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper,
+				suite.chainA.GetSimApp().IBCKeeper.ClientKeeper,
+				suite.chainA.GetSimApp().BankKeeper,
+				suite.chainA.GetSimApp().TransferKeeper.GetAuthority(),
+			)
+		}, ""},
+		{"failure: empty authority", func() {
+			keeper.NewKeeper(
+				suite.chainA.GetSimApp().AppCodec(),
+				runtime.NewKVStoreService(suite.chainA.GetSimApp().GetKey(types.StoreKey)),
+				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper,
+				suite.chainA.GetSimApp().IBCKeeper.ClientKeeper,
+				suite.chainA.GetSimApp().BankKeeper,
+				"", // authority
+			)
+		}, "authority must be non-empty"},
+	}
+
+	for _, tc := range testCases {
+		suite.SetupTest()
+
+		suite.Run(tc.name, func() {
+			if tc.panicMsg == "" {
+				suite.Require().NotPanics(tc.instantiateFn)
+			} else {
+				suite.Require().PanicsWithError(tc.panicMsg, tc.instantiateFn)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPendingSendPackets() {
+	ctx := suite.chainA.GetContext()
+	rateLimitKeeper := suite.chainA.GetSimApp().RateLimitKeeper
+	channelID := suite.path.EndpointA.ChannelID
+
+	rateLimitKeeper.SetPendingSendPacket(ctx, types.NewPendingSendPacket(channelID, 1, sdk.DefaultBondDenom))
+	rateLimitKeeper.SetPendingSendPacket(ctx, types.NewPendingSendPacket(channelID, 2, sdk.DefaultBondDenom))
+	rateLimitKeeper.SetPendingSendPacket(ctx, types.NewPendingSendPacket(channelID, 2, ibctesting.SecondaryDenom))
+
+	suite.Require().True(rateLimitKeeper.HasPendingSendPacket(ctx, channelID, 1, sdk.DefaultBondDenom))
+	suite.Require().Len(rateLimitKeeper.GetAllPendingSendPackets(ctx), 3)
+
+	rateLimitKeeper.DeletePendingSendPacket(ctx, channelID, 1, sdk.DefaultBondDenom)
+	suite.Require().False(rateLimitKeeper.HasPendingSendPacket(ctx, channelID, 1, sdk.DefaultBondDenom))
+
+	// only the pending send packets of the provided denom are removed
+	rateLimitKeeper.DeletePendingSendPackets(ctx, channelID, sdk.DefaultBondDenom)
+	suite.Require().Equal(
+		[]types.PendingSendPacket{types.NewPendingSendPacket(channelID, 2, ibctesting.SecondaryDenom)},
+		rateLimitKeeper.GetAllPendingSendPackets(ctx),
+	)
+}
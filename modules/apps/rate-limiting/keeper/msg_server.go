@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+)
+
+var _ types.MsgServer = (*Keeper)(nil)
+
+// AddRateLimit defines an rpc handler method for MsgAddRateLimit.
+func (k Keeper) AddRateLimit(goCtx context.Context, msg *types.MsgAddRateLimit) (*types.MsgAddRateLimitResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	path := types.NewPath(msg.Denom, msg.ChannelOrClientId)
+	quota := types.NewQuota(msg.MaxPercentSend, msg.MaxPercentRecv, msg.DurationHours)
+	if err := k.addRateLimit(ctx, path, quota); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddRateLimitResponse{}, nil
+}
+
+// UpdateRateLimit defines an rpc handler method for MsgUpdateRateLimit.
+func (k Keeper) UpdateRateLimit(goCtx context.Context, msg *types.MsgUpdateRateLimit) (*types.MsgUpdateRateLimitResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	path := types.NewPath(msg.Denom, msg.ChannelOrClientId)
+	quota := types.NewQuota(msg.MaxPercentSend, msg.MaxPercentRecv, msg.DurationHours)
+	if err := k.updateRateLimit(ctx, path, quota); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateRateLimitResponse{}, nil
+}
+
+// RemoveRateLimit defines an rpc handler method for MsgRemoveRateLimit.
+func (k Keeper) RemoveRateLimit(goCtx context.Context, msg *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.removeRateLimit(ctx, msg.Denom, msg.ChannelOrClientId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveRateLimitResponse{}, nil
+}
+
+// ResetRateLimit defines an rpc handler method for MsgResetRateLimit.
+func (k Keeper) ResetRateLimit(goCtx context.Context, msg *types.MsgResetRateLimit) (*types.MsgResetRateLimitResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.resetRateLimit(ctx, msg.Denom, msg.ChannelOrClientId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResetRateLimitResponse{}, nil
+}
@@ -0,0 +1,283 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+// TestMsgAddRateLimit tests AddRateLimit rpc handler
+func (suite *KeeperTestSuite) TestMsgAddRateLimit() {
+	var msg *types.MsgAddRateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: channel identifier",
+			func() {},
+			nil,
+		},
+		{
+			"success: client identifier",
+			func() {
+				msg.ChannelOrClientId = suite.path.EndpointA.ClientID
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: rate limit already exists",
+			func() {
+				suite.addRateLimit(10, 10)
+			},
+			types.ErrRateLimitAlreadyExists,
+		},
+		{
+			"failure: channel or client does not exist",
+			func() {
+				msg.ChannelOrClientId = "channel-100"
+			},
+			types.ErrChannelOrClientNotFound,
+		},
+		{
+			"failure: denom has no supply",
+			func() {
+				msg.Denom = "nosupply"
+			},
+			types.ErrZeroChannelValue,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			rateLimitKeeper := suite.chainA.GetSimApp().RateLimitKeeper
+			msg = types.NewMsgAddRateLimit(rateLimitKeeper.GetAuthority(), sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID, sdkmath.NewInt(10), sdkmath.NewInt(20), 24)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := rateLimitKeeper.AddRateLimit(ctx, msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				rateLimit, found := rateLimitKeeper.GetRateLimit(ctx, msg.Denom, msg.ChannelOrClientId)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewQuota(msg.MaxPercentSend, msg.MaxPercentRecv, msg.DurationHours), rateLimit.Quota)
+				suite.Require().Equal(suite.chainA.GetSimApp().BankKeeper.GetSupply(ctx, msg.Denom).Amount, rateLimit.Flow.ChannelValue)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+// TestMsgUpdateRateLimit tests UpdateRateLimit rpc handler
+func (suite *KeeperTestSuite) TestMsgUpdateRateLimit() {
+	var msg *types.MsgUpdateRateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: rate limit not found",
+			func() {
+				msg.ChannelOrClientId = suite.path.EndpointA.ClientID
+			},
+			types.ErrRateLimitNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			rateLimitKeeper := suite.chainA.GetSimApp().RateLimitKeeper
+			channelID := suite.path.EndpointA.ChannelID
+
+			rateLimit := suite.addRateLimit(10, 10)
+			rateLimit.Flow.Outflow = sdkmath.NewInt(100)
+			rateLimitKeeper.SetRateLimit(suite.chainA.GetContext(), rateLimit)
+			rateLimitKeeper.SetPendingSendPacket(suite.chainA.GetContext(), types.NewPendingSendPacket(channelID, 1, sdk.DefaultBondDenom))
+
+			msg = types.NewMsgUpdateRateLimit(rateLimitKeeper.GetAuthority(), sdk.DefaultBondDenom, channelID, sdkmath.NewInt(50), sdkmath.NewInt(60), 48)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := rateLimitKeeper.UpdateRateLimit(ctx, msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				rateLimit, found := rateLimitKeeper.GetRateLimit(ctx, sdk.DefaultBondDenom, channelID)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewQuota(msg.MaxPercentSend, msg.MaxPercentRecv, msg.DurationHours), rateLimit.Quota)
+				suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+				suite.Require().False(rateLimitKeeper.HasPendingSendPacket(ctx, channelID, 1, sdk.DefaultBondDenom))
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+// TestMsgRemoveRateLimit tests RemoveRateLimit rpc handler
+func (suite *KeeperTestSuite) TestMsgRemoveRateLimit() {
+	var msg *types.MsgRemoveRateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: rate limit not found",
+			func() {
+				msg.Denom = ibctesting.SecondaryDenom
+			},
+			types.ErrRateLimitNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			rateLimitKeeper := suite.chainA.GetSimApp().RateLimitKeeper
+			channelID := suite.path.EndpointA.ChannelID
+
+			suite.addRateLimit(10, 10)
+			rateLimitKeeper.SetPendingSendPacket(suite.chainA.GetContext(), types.NewPendingSendPacket(channelID, 1, sdk.DefaultBondDenom))
+
+			msg = types.NewMsgRemoveRateLimit(rateLimitKeeper.GetAuthority(), sdk.DefaultBondDenom, channelID)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := rateLimitKeeper.RemoveRateLimit(ctx, msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				_, found := rateLimitKeeper.GetRateLimit(ctx, sdk.DefaultBondDenom, channelID)
+				suite.Require().False(found)
+				suite.Require().False(rateLimitKeeper.HasPendingSendPacket(ctx, channelID, 1, sdk.DefaultBondDenom))
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+// TestMsgResetRateLimit tests ResetRateLimit rpc handler
+func (suite *KeeperTestSuite) TestMsgResetRateLimit() {
+	var msg *types.MsgResetRateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: rate limit not found",
+			func() {
+				msg.Denom = ibctesting.SecondaryDenom
+			},
+			types.ErrRateLimitNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			rateLimitKeeper := suite.chainA.GetSimApp().RateLimitKeeper
+			channelID := suite.path.EndpointA.ChannelID
+
+			rateLimit := suite.addRateLimit(10, 10)
+			rateLimit.Flow.Inflow = sdkmath.NewInt(100)
+			rateLimit.Flow.Outflow = sdkmath.NewInt(100)
+			rateLimitKeeper.SetRateLimit(suite.chainA.GetContext(), rateLimit)
+
+			msg = types.NewMsgResetRateLimit(rateLimitKeeper.GetAuthority(), sdk.DefaultBondDenom, channelID)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := rateLimitKeeper.ResetRateLimit(ctx, msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				rateLimit, found := rateLimitKeeper.GetRateLimit(ctx, sdk.DefaultBondDenom, channelID)
+				suite.Require().True(found)
+				suite.Require().True(rateLimit.Flow.Inflow.IsZero())
+				suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
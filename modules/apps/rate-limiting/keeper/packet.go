@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+)
+
+// SendRateLimitedPacket adds the tokens of an outgoing transfer packet to the outflow of the rate limit
+// of each sent denom and source channel or client, if one exists. An error is returned if the send quota
+// is exceeded. Otherwise, the packet is recorded as pending so that its outflow can be undone if the
+// packet fails or times out within the current window.
+func (k Keeper) SendRateLimitedPacket(ctx sdk.Context, sourceChannelOrClient string, sequence uint64, data transfertypes.InternalTransferRepresentation) error {
+	for _, token := range data.Tokens {
+		if err := k.sendRateLimitedToken(ctx, sourceChannelOrClient, sequence, token); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) sendRateLimitedToken(ctx sdk.Context, sourceChannelOrClient string, sequence uint64, token transfertypes.Token) error {
+	denom := token.Denom.IBCDenom()
+
+	rateLimit, found := k.GetRateLimit(ctx, denom, sourceChannelOrClient)
+	if !found {
+		return nil
+	}
+
+	amount, err := parseAmount(token)
+	if err != nil {
+		return err
+	}
+
+	if err := rateLimit.CheckFlow(types.PACKET_SEND, amount); err != nil {
+		emitQuotaExceededEvent(ctx, rateLimit, types.PACKET_SEND, amount)
+		return err
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+	k.SetPendingSendPacket(ctx, types.NewPendingSendPacket(sourceChannelOrClient, sequence, denom))
+
+	return nil
+}
+
+// ReceiveRateLimitedPacket adds the tokens of an incoming transfer packet to the inflow of the rate limit
+// of each received denom and destination channel or client, if one exists. An error is returned if the
+// receive quota is exceeded.
+func (k Keeper) ReceiveRateLimitedPacket(
+	ctx sdk.Context,
+	sourcePort, sourceChannelOrClient, destPort, destChannelOrClient string,
+	data transfertypes.InternalTransferRepresentation,
+) error {
+	for _, token := range data.Tokens {
+		denom := ReceivedDenom(sourcePort, sourceChannelOrClient, destPort, destChannelOrClient, token.Denom)
+		if err := k.receiveRateLimitedToken(ctx, destChannelOrClient, denom, token); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) receiveRateLimitedToken(ctx sdk.Context, destChannelOrClient, denom string, token transfertypes.Token) error {
+	rateLimit, found := k.GetRateLimit(ctx, denom, destChannelOrClient)
+	if !found {
+		return nil
+	}
+
+	amount, err := parseAmount(token)
+	if err != nil {
+		return err
+	}
+
+	if err := rateLimit.CheckFlow(types.PACKET_RECV, amount); err != nil {
+		emitQuotaExceededEvent(ctx, rateLimit, types.PACKET_RECV, amount)
+		return err
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+
+	return nil
+}
+
+// AcknowledgeRateLimitedPacket completes the lifecycle of a sent packet. If the acknowledgement
+// is unsuccessful, the outflow of the packet is undone.
+func (k Keeper) AcknowledgeRateLimitedPacket(ctx sdk.Context, sourceChannelOrClient string, sequence uint64, data transfertypes.InternalTransferRepresentation, success bool) error {
+	if success {
+		for _, token := range data.Tokens {
+			k.DeletePendingSendPacket(ctx, sourceChannelOrClient, sequence, token.Denom.IBCDenom())
+		}
+		return nil
+	}
+
+	return k.undoSendPacket(ctx, sourceChannelOrClient, sequence, data)
+}
+
+// TimeoutRateLimitedPacket completes the lifecycle of a sent packet which timed out by
+// undoing its outflow.
+func (k Keeper) TimeoutRateLimitedPacket(ctx sdk.Context, sourceChannelOrClient string, sequence uint64, data transfertypes.InternalTransferRepresentation) error {
+	return k.undoSendPacket(ctx, sourceChannelOrClient, sequence, data)
+}
+
+// undoSendPacket removes the amounts of a failed packet from the outflow of their rate limits. The outflow
+// is only adjusted if the packet was sent within the current window, as the flow is reset between windows.
+func (k Keeper) undoSendPacket(ctx sdk.Context, sourceChannelOrClient string, sequence uint64, data transfertypes.InternalTransferRepresentation) error {
+	for _, token := range data.Tokens {
+		if err := k.undoSendToken(ctx, sourceChannelOrClient, sequence, token); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) undoSendToken(ctx sdk.Context, sourceChannelOrClient string, sequence uint64, token transfertypes.Token) error {
+	denom := token.Denom.IBCDenom()
+
+	if !k.HasPendingSendPacket(ctx, sourceChannelOrClient, sequence, denom) {
+		return nil
+	}
+	k.DeletePendingSendPacket(ctx, sourceChannelOrClient, sequence, denom)
+
+	rateLimit, found := k.GetRateLimit(ctx, denom, sourceChannelOrClient)
+	if !found {
+		return nil
+	}
+
+	amount, err := parseAmount(token)
+	if err != nil {
+		return err
+	}
+
+	rateLimit.Flow.RemoveOutflow(amount)
+	k.SetRateLimit(ctx, rateLimit)
+
+	return nil
+}
+
+// ReceivedDenom returns the denomination, as represented on this chain, of tokens received
+// in a transfer packet. This mirrors the denomination handling of the transfer module:
+// tokens returning to this chain have the hop added by this chain removed, while all
+// other tokens are prefixed with the destination port and channel or client.
+func ReceivedDenom(sourcePort, sourceChannelOrClient, destPort, destChannelOrClient string, denom transfertypes.Denom) string {
+	if denom.HasPrefix(sourcePort, sourceChannelOrClient) {
+		return transfertypes.NewDenom(denom.Base, denom.Trace[1:]...).IBCDenom()
+	}
+
+	trace := append([]transfertypes.Hop{transfertypes.NewHop(destPort, destChannelOrClient)}, denom.Trace...)
+	return transfertypes.NewDenom(denom.Base, trace...).IBCDenom()
+}
+
+func parseAmount(token transfertypes.Token) (sdkmath.Int, error) {
+	amount, ok := sdkmath.NewIntFromString(token.Amount)
+	if !ok {
+		return sdkmath.Int{}, errorsmod.Wrapf(types.ErrInvalidPacketData, "unable to parse transfer amount: %s", token.Amount)
+	}
+
+	return amount, nil
+}
@@ -0,0 +1,261 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/keeper"
+	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+func (suite *KeeperTestSuite) TestSendRateLimitedPacket() {
+	var (
+		rateLimit types.RateLimit
+		data      transfertypes.InternalTransferRepresentation
+	)
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expError   error
+		expOutflow func() sdkmath.Int
+	}{
+		{
+			"success: within quota",
+			func() {},
+			nil,
+			func() sdkmath.Int { return sdkmath.NewInt(100) },
+		},
+		{
+			"success: no rate limit for denom",
+			func() {
+				data.Tokens[0].Denom = transfertypes.NewDenom(ibctesting.SecondaryDenom)
+			},
+			nil,
+			func() sdkmath.Int { return sdkmath.ZeroInt() },
+		},
+		{
+			"failure: quota exceeded",
+			func() {
+				data.Tokens[0].Amount = rateLimit.Quota.Threshold(types.PACKET_SEND, rateLimit.Flow.ChannelValue).AddRaw(1).String()
+			},
+			types.ErrQuotaExceeded,
+			func() sdkmath.Int { return sdkmath.ZeroInt() },
+		},
+		{
+			"failure: invalid amount",
+			func() {
+				data.Tokens[0].Amount = "invalid"
+			},
+			types.ErrInvalidPacketData,
+			func() sdkmath.Int { return sdkmath.ZeroInt() },
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			rateLimit = suite.addRateLimit(10, 10)
+			data = transfertypes.NewInternalTransferRepresentation(
+				transfertypes.Tokens{transfertypes.Token{Denom: transfertypes.NewDenom(sdk.DefaultBondDenom), Amount: "100"}},
+				suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "",
+			)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			rateLimitKeeper := suite.chainA.GetSimApp().RateLimitKeeper
+			channelID := suite.path.EndpointA.ChannelID
+
+			err := rateLimitKeeper.SendRateLimitedPacket(ctx, channelID, 1, data)
+
+			rateLimit, found := rateLimitKeeper.GetRateLimit(ctx, sdk.DefaultBondDenom, channelID)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expOutflow(), rateLimit.Flow.Outflow)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(!tc.expOutflow().IsZero(), rateLimitKeeper.HasPendingSendPacket(ctx, channelID, 1, sdk.DefaultBondDenom))
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().False(rateLimitKeeper.HasPendingSendPacket(ctx, channelID, 1, sdk.DefaultBondDenom))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestReceiveRateLimitedPacket() {
+	var data transfertypes.InternalTransferRepresentation
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expError  error
+		expInflow sdkmath.Int
+	}{
+		{
+			"success: returning native tokens within quota",
+			func() {},
+			nil,
+			sdkmath.NewInt(100),
+		},
+		{
+			"success: no rate limit for received denom",
+			func() {
+				// tokens native to chainB are received with a prefixed denom on chainA
+				data.Tokens[0].Denom = transfertypes.NewDenom(sdk.DefaultBondDenom)
+			},
+			nil,
+			sdkmath.ZeroInt(),
+		},
+		{
+			"failure: quota exceeded",
+			func() {
+				data.Tokens[0].Amount = sdkmath.NewInt(1).Mul(sdkmath.NewIntWithDecimal(1, 30)).String()
+			},
+			types.ErrQuotaExceeded,
+			sdkmath.ZeroInt(),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			suite.addRateLimit(10, 10)
+
+			// tokens native to chainA returning from chainB are prefixed with the port and channel of chainB
+			data = transfertypes.NewInternalTransferRepresentation(
+				transfertypes.Tokens{
+					{
+						Denom:  transfertypes.NewDenom(sdk.DefaultBondDenom, transfertypes.NewHop(suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID)),
+						Amount: "100",
+					},
+				},
+				suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), "",
+			)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			rateLimitKeeper := suite.chainA.GetSimApp().RateLimitKeeper
+
+			err := rateLimitKeeper.ReceiveRateLimitedPacket(
+				ctx,
+				suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID,
+				suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+				data,
+			)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+
+			rateLimit, found := rateLimitKeeper.GetRateLimit(ctx, sdk.DefaultBondDenom, suite.path.EndpointA.ChannelID)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expInflow, rateLimit.Flow.Inflow)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAcknowledgeAndTimeoutRateLimitedPacket() {
+	testCases := []struct {
+		name       string
+		undoFn     func(keeper.Keeper, sdk.Context, string, transfertypes.InternalTransferRepresentation) error
+		expOutflow sdkmath.Int
+	}{
+		{
+			"successful acknowledgement keeps outflow",
+			func(k keeper.Keeper, ctx sdk.Context, channelID string, data transfertypes.InternalTransferRepresentation) error {
+				return k.AcknowledgeRateLimitedPacket(ctx, channelID, 1, data, true)
+			},
+			sdkmath.NewInt(100),
+		},
+		{
+			"error acknowledgement undoes outflow",
+			func(k keeper.Keeper, ctx sdk.Context, channelID string, data transfertypes.InternalTransferRepresentation) error {
+				return k.AcknowledgeRateLimitedPacket(ctx, channelID, 1, data, false)
+			},
+			sdkmath.ZeroInt(),
+		},
+		{
+			"timeout undoes outflow",
+			func(k keeper.Keeper, ctx sdk.Context, channelID string, data transfertypes.InternalTransferRepresentation) error {
+				return k.TimeoutRateLimitedPacket(ctx, channelID, 1, data)
+			},
+			sdkmath.ZeroInt(),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			suite.addRateLimit(10, 10)
+
+			ctx := suite.chainA.GetContext()
+			rateLimitKeeper := suite.chainA.GetSimApp().RateLimitKeeper
+			channelID := suite.path.EndpointA.ChannelID
+
+			data := transfertypes.NewInternalTransferRepresentation(
+				transfertypes.Tokens{transfertypes.Token{Denom: transfertypes.NewDenom(sdk.DefaultBondDenom), Amount: "100"}},
+				suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "",
+			)
+
+			err := rateLimitKeeper.SendRateLimitedPacket(ctx, channelID, 1, data)
+			suite.Require().NoError(err)
+
+			err = tc.undoFn(rateLimitKeeper, ctx, channelID, data)
+			suite.Require().NoError(err)
+
+			rateLimit, found := rateLimitKeeper.GetRateLimit(ctx, sdk.DefaultBondDenom, channelID)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expOutflow, rateLimit.Flow.Outflow)
+			suite.Require().False(rateLimitKeeper.HasPendingSendPacket(ctx, channelID, 1, sdk.DefaultBondDenom))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUndoSendPacketAfterReset() {
+	suite.addRateLimit(10, 10)
+
+	ctx := suite.chainA.GetContext()
+	rateLimitKeeper := suite.chainA.GetSimApp().RateLimitKeeper
+	channelID := suite.path.EndpointA.ChannelID
+
+	data := transfertypes.NewInternalTransferRepresentation(
+		transfertypes.Tokens{transfertypes.Token{Denom: transfertypes.NewDenom(sdk.DefaultBondDenom), Amount: "100"}},
+		suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "",
+	)
+
+	err := rateLimitKeeper.SendRateLimitedPacket(ctx, channelID, 1, data)
+	suite.Require().NoError(err)
+
+	// a reset starts a new window, packets sent in the previous window must not affect the new flow
+	_, err = rateLimitKeeper.ResetRateLimit(ctx, types.NewMsgResetRateLimit(rateLimitKeeper.GetAuthority(), sdk.DefaultBondDenom, channelID))
+	suite.Require().NoError(err)
+
+	err = rateLimitKeeper.SendRateLimitedPacket(ctx, channelID, 2, data)
+	suite.Require().NoError(err)
+
+	err = rateLimitKeeper.TimeoutRateLimitedPacket(ctx, channelID, 1, data)
+	suite.Require().NoError(err)
+
+	rateLimit, found := rateLimitKeeper.GetRateLimit(ctx, sdk.DefaultBondDenom, channelID)
+	suite.Require().True(found)
+	suite.Require().Equal(sdkmath.NewInt(100), rateLimit.Flow.Outflow)
+}
+
+func (suite *KeeperTestSuite) TestReceivedDenom() {
+	denom := keeper.ReceivedDenom(transfertypes.PortID, "channel-1", transfertypes.PortID, "channel-0", transfertypes.NewDenom(sdk.DefaultBondDenom))
+	suite.Require().Equal(transfertypes.NewDenom(sdk.DefaultBondDenom, transfertypes.NewHop(transfertypes.PortID, "channel-0")).IBCDenom(), denom)
+
+	denom = keeper.ReceivedDenom(transfertypes.PortID, "channel-1", transfertypes.PortID, "channel-0", transfertypes.NewDenom(sdk.DefaultBondDenom, transfertypes.NewHop(transfertypes.PortID, "channel-1")))
+	suite.Require().Equal(sdk.DefaultBondDenom, denom)
+}
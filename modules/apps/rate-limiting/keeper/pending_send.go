@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/types"
+)
+
+// SetPendingSendPacket records that a packet was sent within the current window of a rate limit.
+func (k Keeper) SetPendingSendPacket(ctx sdk.Context, pendingPacket types.PendingSendPacket) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&pendingPacket)
+	if err := store.Set(types.PendingSendPacketKey(pendingPacket.ChannelOrClientId, pendingPacket.Sequence, pendingPacket.Denom), bz); err != nil {
+		panic(err)
+	}
+}
+
+// HasPendingSendPacket returns true if the packet was sent within the current window of the rate limit for the given denom.
+func (k Keeper) HasPendingSendPacket(ctx sdk.Context, channelOrClientID string, sequence uint64, denom string) bool {
+	store := k.storeService.OpenKVStore(ctx)
+	has, err := store.Has(types.PendingSendPacketKey(channelOrClientID, sequence, denom))
+	if err != nil {
+		panic(err)
+	}
+
+	return has
+}
+
+// DeletePendingSendPacket removes a pending send packet.
+func (k Keeper) DeletePendingSendPacket(ctx sdk.Context, channelOrClientID string, sequence uint64, denom string) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.PendingSendPacketKey(channelOrClientID, sequence, denom)); err != nil {
+		panic(err)
+	}
+}
+
+// DeletePendingSendPackets removes all pending send packets of the given denom and channel or client ID.
+func (k Keeper) DeletePendingSendPackets(ctx sdk.Context, channelOrClientID, denom string) {
+	for _, pendingPacket := range k.getPendingSendPacketsWithPrefix(ctx, types.PendingSendPacketChannelOrClientPrefix(channelOrClientID)) {
+		if pendingPacket.Denom == denom {
+			k.DeletePendingSendPacket(ctx, pendingPacket.ChannelOrClientId, pendingPacket.Sequence, pendingPacket.Denom)
+		}
+	}
+}
+
+// GetAllPendingSendPackets returns all pending send packets.
+func (k Keeper) GetAllPendingSendPackets(ctx sdk.Context) []types.PendingSendPacket {
+	return k.getPendingSendPacketsWithPrefix(ctx, []byte(types.KeyPendingSendPacketPrefix+"/"))
+}
+
+func (k Keeper) getPendingSendPacketsWithPrefix(ctx sdk.Context, keyPrefix []byte) []types.PendingSendPacket {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), keyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	pendingPackets := []types.PendingSendPacket{}
+	for ; iterator.Valid(); iterator.Next() {
+		var pendingPacket types.PendingSendPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingPacket)
+		pendingPackets = append(pendingPackets, pendingPacket)
+	}
+
+	return pendingPackets
+}
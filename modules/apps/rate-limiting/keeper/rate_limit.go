@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/types"
+)
+
+// GetRateLimit returns the rate limit for the given denom and channel or client ID.
+func (k Keeper) GetRateLimit(ctx sdk.Context, denom, channelOrClientID string) (types.RateLimit, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.RateLimitKey(denom, channelOrClientID))
+	if err != nil {
+		panic(err)
+	}
+
+	if len(bz) == 0 {
+		return types.RateLimit{}, false
+	}
+
+	var rateLimit types.RateLimit
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+
+	return rateLimit, true
+}
+
+// SetRateLimit stores the provided rate limit.
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&rateLimit)
+	if err := store.Set(types.RateLimitKey(rateLimit.Path.Denom, rateLimit.Path.ChannelOrClientId), bz); err != nil {
+		panic(err)
+	}
+}
+
+// DeleteRateLimit removes the rate limit for the given denom and channel or client ID.
+func (k Keeper) DeleteRateLimit(ctx sdk.Context, denom, channelOrClientID string) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.RateLimitKey(denom, channelOrClientID)); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllRateLimits returns all rate limits.
+func (k Keeper) GetAllRateLimits(ctx sdk.Context) []types.RateLimit {
+	return k.getRateLimitsWithPrefix(ctx, []byte(types.KeyRateLimitPrefix+"/"))
+}
+
+// GetRateLimitsByChannelOrClient returns all rate limits of the given channel or client ID.
+func (k Keeper) GetRateLimitsByChannelOrClient(ctx sdk.Context, channelOrClientID string) []types.RateLimit {
+	return k.getRateLimitsWithPrefix(ctx, types.RateLimitChannelOrClientPrefix(channelOrClientID))
+}
+
+func (k Keeper) getRateLimitsWithPrefix(ctx sdk.Context, keyPrefix []byte) []types.RateLimit {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), keyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	rateLimits := []types.RateLimit{}
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+		rateLimits = append(rateLimits, rateLimit)
+	}
+
+	return rateLimits
+}
+
+// addRateLimit creates a new rate limit for the given path and quota. The channel value of the
+// flow is initialized to the current supply of the denom.
+func (k Keeper) addRateLimit(ctx sdk.Context, path types.Path, quota types.Quota) error {
+	if _, found := k.GetRateLimit(ctx, path.Denom, path.ChannelOrClientId); found {
+		return errorsmod.Wrapf(types.ErrRateLimitAlreadyExists, "denom %s, channel or client %s", path.Denom, path.ChannelOrClientId)
+	}
+
+	if !k.hasChannelOrClient(ctx, path.ChannelOrClientId) {
+		return errorsmod.Wrap(types.ErrChannelOrClientNotFound, path.ChannelOrClientId)
+	}
+
+	channelValue, err := k.getChannelValue(ctx, path.Denom)
+	if err != nil {
+		return err
+	}
+
+	k.SetRateLimit(ctx, types.NewRateLimit(path, quota, channelValue))
+
+	return nil
+}
+
+// updateRateLimit replaces the quota of an existing rate limit and resets its flow.
+func (k Keeper) updateRateLimit(ctx sdk.Context, path types.Path, quota types.Quota) error {
+	if _, found := k.GetRateLimit(ctx, path.Denom, path.ChannelOrClientId); !found {
+		return errorsmod.Wrapf(types.ErrRateLimitNotFound, "denom %s, channel or client %s", path.Denom, path.ChannelOrClientId)
+	}
+
+	channelValue, err := k.getChannelValue(ctx, path.Denom)
+	if err != nil {
+		return err
+	}
+
+	k.SetRateLimit(ctx, types.NewRateLimit(path, quota, channelValue))
+	k.DeletePendingSendPackets(ctx, path.ChannelOrClientId, path.Denom)
+
+	return nil
+}
+
+// removeRateLimit removes an existing rate limit along with its pending send packets.
+func (k Keeper) removeRateLimit(ctx sdk.Context, denom, channelOrClientID string) error {
+	if _, found := k.GetRateLimit(ctx, denom, channelOrClientID); !found {
+		return errorsmod.Wrapf(types.ErrRateLimitNotFound, "denom %s, channel or client %s", denom, channelOrClientID)
+	}
+
+	k.DeleteRateLimit(ctx, denom, channelOrClientID)
+	k.DeletePendingSendPackets(ctx, channelOrClientID, denom)
+
+	return nil
+}
+
+// resetRateLimit resets the flow of an existing rate limit, starting a new window. The channel
+// value is set to the current supply of the denom and pending send packets are discarded.
+func (k Keeper) resetRateLimit(ctx sdk.Context, denom, channelOrClientID string) error {
+	rateLimit, found := k.GetRateLimit(ctx, denom, channelOrClientID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrRateLimitNotFound, "denom %s, channel or client %s", denom, channelOrClientID)
+	}
+
+	channelValue, err := k.getChannelValue(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	rateLimit.Flow = types.NewFlow(channelValue)
+	k.SetRateLimit(ctx, rateLimit)
+	k.DeletePendingSendPackets(ctx, channelOrClientID, denom)
+
+	emitRateLimitResetEvent(ctx, rateLimit)
+
+	return nil
+}
+
+// getChannelValue returns the total supply of the given denom. An error is returned if the supply is zero.
+func (k Keeper) getChannelValue(ctx sdk.Context, denom string) (sdkmath.Int, error) {
+	channelValue := k.bankKeeper.GetSupply(ctx, denom).Amount
+	if channelValue.IsZero() {
+		return sdkmath.Int{}, errorsmod.Wrapf(types.ErrZeroChannelValue, "denom %s has no supply", denom)
+	}
+
+	return channelValue, nil
+}
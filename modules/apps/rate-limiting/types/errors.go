@@ -0,0 +1,17 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// rate-limiting sentinel errors
+var (
+	ErrRateLimitAlreadyExists  = errorsmod.Register(ModuleName, 2, "rate limit already exists")
+	ErrRateLimitNotFound       = errorsmod.Register(ModuleName, 3, "rate limit not found")
+	ErrZeroChannelValue        = errorsmod.Register(ModuleName, 4, "channel value is zero")
+	ErrQuotaExceeded           = errorsmod.Register(ModuleName, 5, "quota exceeded")
+	ErrInvalidQuota            = errorsmod.Register(ModuleName, 6, "invalid quota")
+	ErrChannelOrClientNotFound = errorsmod.Register(ModuleName, 7, "channel or client not found")
+	ErrInvalidPacketData       = errorsmod.Register(ModuleName, 8, "invalid packet data")
+	ErrInvalidEpoch            = errorsmod.Register(ModuleName, 9, "invalid hour epoch")
+)
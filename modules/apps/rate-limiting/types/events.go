@@ -0,0 +1,13 @@
+package types
+
+// rate-limiting events
+const (
+	EventTypeQuotaExceeded  = "quota_exceeded"
+	EventTypeRateLimitReset = "rate_limit_reset"
+
+	AttributeKeyDenom             = "denom"
+	AttributeKeyChannelOrClientID = "channel_or_client_id"
+	AttributeKeyDirection         = "direction"
+	AttributeKeyAmount            = "amount"
+	AttributeKeyThreshold         = "threshold"
+)
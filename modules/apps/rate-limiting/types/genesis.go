@@ -0,0 +1,88 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultHourEpochDuration is the duration of a rate-limiting epoch.
+const DefaultHourEpochDuration = time.Hour
+
+// NewGenesisState creates a new rate-limiting GenesisState instance.
+func NewGenesisState(rateLimits []RateLimit, pendingSendPackets []PendingSendPacket, hourEpoch HourEpoch) *GenesisState {
+	return &GenesisState{
+		RateLimits:         rateLimits,
+		PendingSendPackets: pendingSendPackets,
+		HourEpoch:          hourEpoch,
+	}
+}
+
+// DefaultGenesisState returns a GenesisState with no rate limits and a fresh hour epoch.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		RateLimits:         []RateLimit{},
+		PendingSendPackets: []PendingSendPacket{},
+		HourEpoch: HourEpoch{
+			EpochNumber: 0,
+			Duration:    DefaultHourEpochDuration,
+		},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenRateLimits := make(map[string]bool)
+	for i, rateLimit := range gs.RateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "invalid rate limit %d", i)
+		}
+
+		key := string(RateLimitKey(rateLimit.Path.Denom, rateLimit.Path.ChannelOrClientId))
+		if seenRateLimits[key] {
+			return errorsmod.Wrapf(ErrRateLimitAlreadyExists, "duplicate rate limit for denom %s and %s", rateLimit.Path.Denom, rateLimit.Path.ChannelOrClientId)
+		}
+		seenRateLimits[key] = true
+	}
+
+	for i, pendingPacket := range gs.PendingSendPackets {
+		if err := pendingPacket.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "invalid pending send packet %d", i)
+		}
+	}
+
+	if gs.HourEpoch.Duration <= 0 {
+		return errorsmod.Wrap(ErrInvalidEpoch, "hour epoch duration must be positive")
+	}
+
+	return nil
+}
+
+// NewPendingSendPacket creates a new PendingSendPacket instance.
+func NewPendingSendPacket(channelOrClientID string, sequence uint64, denom string) PendingSendPacket {
+	return PendingSendPacket{
+		ChannelOrClientId: channelOrClientID,
+		Sequence:          sequence,
+		Denom:             denom,
+	}
+}
+
+// Validate performs a basic validation of the PendingSendPacket fields.
+func (p PendingSendPacket) Validate() error {
+	if !IsValidChannelOrClientID(p.ChannelOrClientId) {
+		return errorsmod.Wrapf(ErrChannelOrClientNotFound, "%s is neither a valid channel ID nor a valid client ID", p.ChannelOrClientId)
+	}
+
+	if p.Sequence == 0 {
+		return errorsmod.Wrap(ErrInvalidPacketData, "pending send packet sequence cannot be 0")
+	}
+
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidPacketData, "invalid denom %s: %v", p.Denom, err)
+	}
+
+	return nil
+}
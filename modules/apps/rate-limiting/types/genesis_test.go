@@ -0,0 +1,84 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	rateLimit := types.NewRateLimit(
+		types.NewPath(ibctesting.TestCoin.Denom, validChannelID),
+		types.NewQuota(sdkmath.NewInt(10), sdkmath.NewInt(10), 24),
+		sdkmath.NewInt(1000),
+	)
+
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expError error
+	}{
+		{
+			"success: default genesis",
+			types.DefaultGenesisState(),
+			nil,
+		},
+		{
+			"success: valid genesis",
+			types.NewGenesisState(
+				[]types.RateLimit{rateLimit},
+				[]types.PendingSendPacket{types.NewPendingSendPacket(validChannelID, 1, ibctesting.TestCoin.Denom)},
+				types.DefaultGenesisState().HourEpoch,
+			),
+			nil,
+		},
+		{
+			"failure: duplicate rate limits",
+			types.NewGenesisState(
+				[]types.RateLimit{rateLimit, rateLimit},
+				nil,
+				types.DefaultGenesisState().HourEpoch,
+			),
+			types.ErrRateLimitAlreadyExists,
+		},
+		{
+			"failure: invalid rate limit",
+			types.NewGenesisState(
+				[]types.RateLimit{types.NewRateLimit(rateLimit.Path, rateLimit.Quota, sdkmath.ZeroInt())},
+				nil,
+				types.DefaultGenesisState().HourEpoch,
+			),
+			types.ErrZeroChannelValue,
+		},
+		{
+			"failure: invalid pending send packet",
+			types.NewGenesisState(
+				nil,
+				[]types.PendingSendPacket{types.NewPendingSendPacket(validChannelID, 0, ibctesting.TestCoin.Denom)},
+				types.DefaultGenesisState().HourEpoch,
+			),
+			types.ErrInvalidPacketData,
+		},
+		{
+			"failure: zero epoch duration",
+			types.NewGenesisState(nil, nil, types.HourEpoch{}),
+			types.ErrInvalidEpoch,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package types
+
+import "fmt"
+
+const (
+	// ModuleName defines the rate-limiting module name
+	ModuleName = "ratelimiting"
+
+	// StoreKey is the store key string for the rate-limiting module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the rate-limiting module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the rate-limiting module
+	QuerierRoute = ModuleName
+
+	// KeyRateLimitPrefix is the store key prefix under which rate limits are stored
+	KeyRateLimitPrefix = "rateLimit"
+
+	// KeyPendingSendPacketPrefix is the store key prefix under which pending send packets are stored
+	KeyPendingSendPacketPrefix = "pendingSendPacket"
+
+	// KeyHourEpoch is the store key under which the hour epoch is stored
+	KeyHourEpoch = "hourEpoch"
+)
+
+// RateLimitKey returns the store key under which the rate limit for the given
+// denom and channel or client ID is stored.
+func RateLimitKey(denom, channelOrClientID string) []byte {
+	return fmt.Appendf(nil, "%s/%s/%s", KeyRateLimitPrefix, channelOrClientID, denom)
+}
+
+// RateLimitChannelOrClientPrefix returns the store key prefix under which all rate limits
+// of the given channel or client ID are stored.
+func RateLimitChannelOrClientPrefix(channelOrClientID string) []byte {
+	return fmt.Appendf(nil, "%s/%s/", KeyRateLimitPrefix, channelOrClientID)
+}
+
+// PendingSendPacketKey returns the store key under which the pending send packet
+// for the given channel or client ID, sequence and denom is stored.
+func PendingSendPacketKey(channelOrClientID string, sequence uint64, denom string) []byte {
+	return fmt.Appendf(nil, "%s/%s/%d/%s", KeyPendingSendPacketPrefix, channelOrClientID, sequence, denom)
+}
+
+// PendingSendPacketChannelOrClientPrefix returns the store key prefix under which all pending
+// send packets of the given channel or client ID are stored.
+func PendingSendPacketChannelOrClientPrefix(channelOrClientID string) []byte {
+	return fmt.Appendf(nil, "%s/%s/", KeyPendingSendPacketPrefix, channelOrClientID)
+}
@@ -0,0 +1,115 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+)
+
+var (
+	_ sdk.Msg              = (*MsgAddRateLimit)(nil)
+	_ sdk.Msg              = (*MsgUpdateRateLimit)(nil)
+	_ sdk.Msg              = (*MsgRemoveRateLimit)(nil)
+	_ sdk.Msg              = (*MsgResetRateLimit)(nil)
+	_ sdk.HasValidateBasic = (*MsgAddRateLimit)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateRateLimit)(nil)
+	_ sdk.HasValidateBasic = (*MsgRemoveRateLimit)(nil)
+	_ sdk.HasValidateBasic = (*MsgResetRateLimit)(nil)
+)
+
+// NewMsgAddRateLimit creates a new MsgAddRateLimit instance
+func NewMsgAddRateLimit(signer, denom, channelOrClientID string, maxPercentSend, maxPercentRecv sdkmath.Int, durationHours uint64) *MsgAddRateLimit {
+	return &MsgAddRateLimit{
+		Signer:            signer,
+		Denom:             denom,
+		ChannelOrClientId: channelOrClientID,
+		MaxPercentSend:    maxPercentSend,
+		MaxPercentRecv:    maxPercentRecv,
+		DurationHours:     durationHours,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgAddRateLimit) ValidateBasic() error {
+	if err := validateSigner(msg.Signer); err != nil {
+		return err
+	}
+
+	if err := NewPath(msg.Denom, msg.ChannelOrClientId).Validate(); err != nil {
+		return err
+	}
+
+	return NewQuota(msg.MaxPercentSend, msg.MaxPercentRecv, msg.DurationHours).Validate()
+}
+
+// NewMsgUpdateRateLimit creates a new MsgUpdateRateLimit instance
+func NewMsgUpdateRateLimit(signer, denom, channelOrClientID string, maxPercentSend, maxPercentRecv sdkmath.Int, durationHours uint64) *MsgUpdateRateLimit {
+	return &MsgUpdateRateLimit{
+		Signer:            signer,
+		Denom:             denom,
+		ChannelOrClientId: channelOrClientID,
+		MaxPercentSend:    maxPercentSend,
+		MaxPercentRecv:    maxPercentRecv,
+		DurationHours:     durationHours,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateRateLimit) ValidateBasic() error {
+	if err := validateSigner(msg.Signer); err != nil {
+		return err
+	}
+
+	if err := NewPath(msg.Denom, msg.ChannelOrClientId).Validate(); err != nil {
+		return err
+	}
+
+	return NewQuota(msg.MaxPercentSend, msg.MaxPercentRecv, msg.DurationHours).Validate()
+}
+
+// NewMsgRemoveRateLimit creates a new MsgRemoveRateLimit instance
+func NewMsgRemoveRateLimit(signer, denom, channelOrClientID string) *MsgRemoveRateLimit {
+	return &MsgRemoveRateLimit{
+		Signer:            signer,
+		Denom:             denom,
+		ChannelOrClientId: channelOrClientID,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRemoveRateLimit) ValidateBasic() error {
+	if err := validateSigner(msg.Signer); err != nil {
+		return err
+	}
+
+	return NewPath(msg.Denom, msg.ChannelOrClientId).Validate()
+}
+
+// NewMsgResetRateLimit creates a new MsgResetRateLimit instance
+func NewMsgResetRateLimit(signer, denom, channelOrClientID string) *MsgResetRateLimit {
+	return &MsgResetRateLimit{
+		Signer:            signer,
+		Denom:             denom,
+		ChannelOrClientId: channelOrClientID,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgResetRateLimit) ValidateBasic() error {
+	if err := validateSigner(msg.Signer); err != nil {
+		return err
+	}
+
+	return NewPath(msg.Denom, msg.ChannelOrClientId).Validate()
+}
+
+func validateSigner(signer string) error {
+	if _, err := sdk.AccAddressFromBech32(signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
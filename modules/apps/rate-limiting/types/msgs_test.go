@@ -0,0 +1,123 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+func TestMsgAddRateLimitValidateBasic(t *testing.T) {
+	var msg *types.MsgAddRateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{"success", func() {}, nil},
+		{"success: client identifier", func() { msg.ChannelOrClientId = ibctesting.FirstClientID }, nil},
+		{"failure: invalid signer", func() { msg.Signer = ibctesting.InvalidID }, ibcerrors.ErrInvalidAddress},
+		{"failure: invalid denom", func() { msg.Denom = "" }, types.ErrInvalidPacketData},
+		{"failure: invalid identifier", func() { msg.ChannelOrClientId = ibctesting.InvalidID }, types.ErrChannelOrClientNotFound},
+		{"failure: invalid quota", func() { msg.DurationHours = 0 }, types.ErrInvalidQuota},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg = types.NewMsgAddRateLimit(ibctesting.TestAccAddress, sdk.DefaultBondDenom, validChannelID, sdkmath.NewInt(10), sdkmath.NewInt(10), 24)
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+func TestMsgUpdateRateLimitValidateBasic(t *testing.T) {
+	var msg *types.MsgUpdateRateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{"success", func() {}, nil},
+		{"failure: invalid signer", func() { msg.Signer = ibctesting.InvalidID }, ibcerrors.ErrInvalidAddress},
+		{"failure: invalid identifier", func() { msg.ChannelOrClientId = ibctesting.InvalidID }, types.ErrChannelOrClientNotFound},
+		{"failure: invalid quota", func() { msg.MaxPercentSend = sdkmath.NewInt(101) }, types.ErrInvalidQuota},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg = types.NewMsgUpdateRateLimit(ibctesting.TestAccAddress, sdk.DefaultBondDenom, validChannelID, sdkmath.NewInt(10), sdkmath.NewInt(10), 24)
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+func TestMsgRemoveRateLimitValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name     string
+		msg      *types.MsgRemoveRateLimit
+		expError error
+	}{
+		{"success", types.NewMsgRemoveRateLimit(ibctesting.TestAccAddress, sdk.DefaultBondDenom, validChannelID), nil},
+		{"failure: invalid signer", types.NewMsgRemoveRateLimit(ibctesting.InvalidID, sdk.DefaultBondDenom, validChannelID), ibcerrors.ErrInvalidAddress},
+		{"failure: invalid denom", types.NewMsgRemoveRateLimit(ibctesting.TestAccAddress, "", validChannelID), types.ErrInvalidPacketData},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+func TestMsgResetRateLimitValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name     string
+		msg      *types.MsgResetRateLimit
+		expError error
+	}{
+		{"success", types.NewMsgResetRateLimit(ibctesting.TestAccAddress, sdk.DefaultBondDenom, validChannelID), nil},
+		{"failure: invalid signer", types.NewMsgResetRateLimit(ibctesting.InvalidID, sdk.DefaultBondDenom, validChannelID), ibcerrors.ErrInvalidAddress},
+		{"failure: invalid identifier", types.NewMsgResetRateLimit(ibctesting.TestAccAddress, sdk.DefaultBondDenom, ibctesting.InvalidID), types.ErrChannelOrClientNotFound},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
+
+// NewPath creates a new Path instance.
+func NewPath(denom, channelOrClientID string) Path {
+	return Path{
+		Denom:             denom,
+		ChannelOrClientId: channelOrClientID,
+	}
+}
+
+// Validate performs a basic validation of the Path fields.
+func (p Path) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidPacketData, "invalid denom %s: %v", p.Denom, err)
+	}
+
+	if !IsValidChannelOrClientID(p.ChannelOrClientId) {
+		return errorsmod.Wrapf(ErrChannelOrClientNotFound, "%s is neither a valid channel ID nor a valid client ID", p.ChannelOrClientId)
+	}
+
+	return nil
+}
+
+// IsValidChannelOrClientID returns true if the provided identifier is a valid
+// channel identifier (IBC v1) or client identifier (IBC v2).
+func IsValidChannelOrClientID(id string) bool {
+	return channeltypes.IsValidChannelID(id) || clienttypes.IsValidClientID(id)
+}
+
+// NewQuota creates a new Quota instance.
+func NewQuota(maxPercentSend, maxPercentRecv sdkmath.Int, durationHours uint64) Quota {
+	return Quota{
+		MaxPercentSend: maxPercentSend,
+		MaxPercentRecv: maxPercentRecv,
+		DurationHours:  durationHours,
+	}
+}
+
+// Validate performs a basic validation of the Quota fields.
+func (q Quota) Validate() error {
+	if q.MaxPercentSend.IsNil() || q.MaxPercentRecv.IsNil() {
+		return errorsmod.Wrap(ErrInvalidQuota, "max percent send and max percent recv must be set")
+	}
+
+	if q.MaxPercentSend.IsNegative() || q.MaxPercentSend.GT(sdkmath.NewInt(100)) {
+		return errorsmod.Wrapf(ErrInvalidQuota, "max percent send must be between 0 and 100, got %s", q.MaxPercentSend)
+	}
+
+	if q.MaxPercentRecv.IsNegative() || q.MaxPercentRecv.GT(sdkmath.NewInt(100)) {
+		return errorsmod.Wrapf(ErrInvalidQuota, "max percent recv must be between 0 and 100, got %s", q.MaxPercentRecv)
+	}
+
+	if q.MaxPercentSend.IsZero() && q.MaxPercentRecv.IsZero() {
+		return errorsmod.Wrap(ErrInvalidQuota, "max percent send and max percent recv cannot both be zero")
+	}
+
+	if q.DurationHours == 0 {
+		return errorsmod.Wrap(ErrInvalidQuota, "duration hours must be greater than zero")
+	}
+
+	return nil
+}
+
+// Threshold returns the maximum net flow allowed in the provided direction given the channel value.
+func (q Quota) Threshold(direction PacketDirection, channelValue sdkmath.Int) sdkmath.Int {
+	maxPercent := q.MaxPercentRecv
+	if direction == PACKET_SEND {
+		maxPercent = q.MaxPercentSend
+	}
+
+	return channelValue.Mul(maxPercent).Quo(sdkmath.NewInt(100))
+}
+
+// NewFlow creates a new Flow instance with no inflow or outflow.
+func NewFlow(channelValue sdkmath.Int) Flow {
+	return Flow{
+		Inflow:       sdkmath.ZeroInt(),
+		Outflow:      sdkmath.ZeroInt(),
+		ChannelValue: channelValue,
+	}
+}
+
+// AddInflow adds the provided amount to the inflow of the window. An error is returned
+// if the net inflow exceeds the receive quota.
+func (f *Flow) AddInflow(amount sdkmath.Int, quota Quota) error {
+	netInflow := f.Inflow.Add(amount).Sub(f.Outflow)
+	if threshold := quota.Threshold(PACKET_RECV, f.ChannelValue); netInflow.GT(threshold) {
+		return errorsmod.Wrapf(ErrQuotaExceeded, "inflow exceeds quota: net inflow %s, threshold %s", netInflow, threshold)
+	}
+
+	f.Inflow = f.Inflow.Add(amount)
+	return nil
+}
+
+// AddOutflow adds the provided amount to the outflow of the window. An error is returned
+// if the net outflow exceeds the send quota.
+func (f *Flow) AddOutflow(amount sdkmath.Int, quota Quota) error {
+	netOutflow := f.Outflow.Add(amount).Sub(f.Inflow)
+	if threshold := quota.Threshold(PACKET_SEND, f.ChannelValue); netOutflow.GT(threshold) {
+		return errorsmod.Wrapf(ErrQuotaExceeded, "outflow exceeds quota: net outflow %s, threshold %s", netOutflow, threshold)
+	}
+
+	f.Outflow = f.Outflow.Add(amount)
+	return nil
+}
+
+// RemoveOutflow removes the provided amount from the outflow of the window. This is used
+// to undo the flow accounting of packets which failed or timed out.
+func (f *Flow) RemoveOutflow(amount sdkmath.Int) {
+	f.Outflow = sdkmath.MaxInt(f.Outflow.Sub(amount), sdkmath.ZeroInt())
+}
+
+// NewRateLimit creates a new RateLimit instance with an empty flow.
+func NewRateLimit(path Path, quota Quota, channelValue sdkmath.Int) RateLimit {
+	return RateLimit{
+		Path:  path,
+		Quota: quota,
+		Flow:  NewFlow(channelValue),
+	}
+}
+
+// Validate performs a basic validation of the RateLimit fields.
+func (r RateLimit) Validate() error {
+	if err := r.Path.Validate(); err != nil {
+		return err
+	}
+
+	if err := r.Quota.Validate(); err != nil {
+		return err
+	}
+
+	if r.Flow.Inflow.IsNil() || r.Flow.Outflow.IsNil() || r.Flow.ChannelValue.IsNil() {
+		return errorsmod.Wrap(ErrInvalidQuota, "flow amounts must be set")
+	}
+
+	if r.Flow.Inflow.IsNegative() || r.Flow.Outflow.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidQuota, "flow amounts cannot be negative")
+	}
+
+	if !r.Flow.ChannelValue.IsPositive() {
+		return errorsmod.Wrapf(ErrZeroChannelValue, "channel value must be positive for denom %s", r.Path.Denom)
+	}
+
+	return nil
+}
+
+// CheckFlow adds the provided amount to the flow of the rate limit in the given direction.
+// An error is returned if the quota would be exceeded.
+func (r *RateLimit) CheckFlow(direction PacketDirection, amount sdkmath.Int) error {
+	switch direction {
+	case PACKET_SEND:
+		return r.Flow.AddOutflow(amount, r.Quota)
+	case PACKET_RECV:
+		return r.Flow.AddInflow(amount, r.Quota)
+	default:
+		return errorsmod.Wrapf(ErrInvalidPacketData, "unsupported packet direction %s", direction)
+	}
+}
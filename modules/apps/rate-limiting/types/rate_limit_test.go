@@ -0,0 +1,164 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+const validChannelID = "channel-0"
+
+func TestPathValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		path     types.Path
+		expError error
+	}{
+		{"success: channel identifier", types.NewPath(ibctesting.TestCoin.Denom, validChannelID), nil},
+		{"success: client identifier", types.NewPath(ibctesting.TestCoin.Denom, ibctesting.FirstClientID), nil},
+		{"failure: invalid denom", types.NewPath("", validChannelID), types.ErrInvalidPacketData},
+		{"failure: invalid identifier", types.NewPath(ibctesting.TestCoin.Denom, "invalid"), types.ErrChannelOrClientNotFound},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.path.Validate()
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+func TestQuotaValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		quota    types.Quota
+		expError error
+	}{
+		{"success", types.NewQuota(sdkmath.NewInt(10), sdkmath.NewInt(20), 24), nil},
+		{"success: send only", types.NewQuota(sdkmath.NewInt(10), sdkmath.ZeroInt(), 1), nil},
+		{"failure: nil percentages", types.Quota{DurationHours: 1}, types.ErrInvalidQuota},
+		{"failure: send percent above 100", types.NewQuota(sdkmath.NewInt(101), sdkmath.NewInt(10), 1), types.ErrInvalidQuota},
+		{"failure: negative recv percent", types.NewQuota(sdkmath.NewInt(10), sdkmath.NewInt(-1), 1), types.ErrInvalidQuota},
+		{"failure: both percentages zero", types.NewQuota(sdkmath.ZeroInt(), sdkmath.ZeroInt(), 1), types.ErrInvalidQuota},
+		{"failure: zero duration", types.NewQuota(sdkmath.NewInt(10), sdkmath.NewInt(10), 0), types.ErrInvalidQuota},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.quota.Validate()
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+func TestRateLimitCheckFlow(t *testing.T) {
+	var rateLimit types.RateLimit
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		direction  types.PacketDirection
+		amount     sdkmath.Int
+		expError   error
+		expInflow  sdkmath.Int
+		expOutflow sdkmath.Int
+	}{
+		{
+			"success: send within quota",
+			func() {},
+			types.PACKET_SEND,
+			sdkmath.NewInt(100),
+			nil,
+			sdkmath.ZeroInt(),
+			sdkmath.NewInt(100),
+		},
+		{
+			"success: recv within quota",
+			func() {},
+			types.PACKET_RECV,
+			sdkmath.NewInt(200),
+			nil,
+			sdkmath.NewInt(200),
+			sdkmath.ZeroInt(),
+		},
+		{
+			"success: send offset by inflow",
+			func() {
+				rateLimit.Flow.Inflow = sdkmath.NewInt(50)
+			},
+			types.PACKET_SEND,
+			sdkmath.NewInt(150),
+			nil,
+			sdkmath.NewInt(50),
+			sdkmath.NewInt(150),
+		},
+		{
+			"failure: send exceeds quota",
+			func() {},
+			types.PACKET_SEND,
+			sdkmath.NewInt(101),
+			types.ErrQuotaExceeded,
+			sdkmath.ZeroInt(),
+			sdkmath.ZeroInt(),
+		},
+		{
+			"failure: recv exceeds quota",
+			func() {
+				rateLimit.Flow.Inflow = sdkmath.NewInt(150)
+			},
+			types.PACKET_RECV,
+			sdkmath.NewInt(51),
+			types.ErrQuotaExceeded,
+			sdkmath.NewInt(150),
+			sdkmath.ZeroInt(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// send quota of 10% and recv quota of 20% of a channel value of 1000
+			rateLimit = types.NewRateLimit(
+				types.NewPath(ibctesting.TestCoin.Denom, validChannelID),
+				types.NewQuota(sdkmath.NewInt(10), sdkmath.NewInt(20), 1),
+				sdkmath.NewInt(1000),
+			)
+
+			tc.malleate()
+
+			err := rateLimit.CheckFlow(tc.direction, tc.amount)
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+
+			require.Equal(t, tc.expInflow, rateLimit.Flow.Inflow)
+			require.Equal(t, tc.expOutflow, rateLimit.Flow.Outflow)
+		})
+	}
+}
+
+func TestFlowRemoveOutflow(t *testing.T) {
+	flow := types.NewFlow(sdkmath.NewInt(1000))
+	flow.Outflow = sdkmath.NewInt(100)
+
+	flow.RemoveOutflow(sdkmath.NewInt(40))
+	require.Equal(t, sdkmath.NewInt(60), flow.Outflow)
+
+	// the outflow never becomes negative
+	flow.RemoveOutflow(sdkmath.NewInt(100))
+	require.Equal(t, sdkmath.ZeroInt(), flow.Outflow)
+}
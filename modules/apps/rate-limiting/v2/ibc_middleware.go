@@ -0,0 +1,144 @@
+package v2
+
+import (
+	"bytes"
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/keeper"
+	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/api"
+)
+
+var (
+	_ api.IBCModule             = (*IBCMiddleware)(nil)
+	_ api.PacketDataUnmarshaler = (*IBCMiddleware)(nil)
+)
+
+// IBCMiddleware implements the IBC v2 middleware interface for the rate-limiting
+// middleware given the underlying ICS-20 transfer application.
+type IBCMiddleware struct {
+	app    api.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper and underlying application.
+func NewIBCMiddleware(app api.IBCModule, k keeper.Keeper) IBCMiddleware {
+	if app == nil {
+		panic(errors.New("IBCModule cannot be nil"))
+	}
+
+	return IBCMiddleware{
+		app:    app,
+		keeper: k,
+	}
+}
+
+// OnSendPacket defers to the underlying application and then adds the tokens of the payload
+// to the outflow of the matching rate limit. The packet send is rejected if the send quota is exceeded.
+func (im IBCMiddleware) OnSendPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	signer sdk.AccAddress,
+) error {
+	if err := im.app.OnSendPacket(ctx, sourceClient, destinationClient, sequence, payload, signer); err != nil {
+		return err
+	}
+
+	packetData, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return err
+	}
+
+	return im.keeper.SendRateLimitedPacket(ctx, sourceClient, sequence, packetData)
+}
+
+// OnRecvPacket adds the tokens of the payload to the inflow of the matching rate limit before
+// deferring to the underlying application. A failed result is returned if the receive quota is exceeded.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) channeltypesv2.RecvPacketResult {
+	packetData, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		// the underlying application is responsible for rejecting payloads with malformed packet data
+		return im.app.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+	}
+
+	if err := im.keeper.ReceiveRateLimitedPacket(ctx, payload.SourcePort, sourceClient, payload.DestinationPort, destinationClient, packetData); err != nil {
+		im.keeper.Logger(ctx).Error("receive packet rate limited", "client-id", destinationClient, "sequence", sequence, "error", err)
+		return channeltypesv2.RecvPacketResult{
+			Status: channeltypesv2.PacketStatus_Failure,
+		}
+	}
+
+	return im.app.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+}
+
+// OnAcknowledgementPacket defers to the underlying application and then undoes the outflow
+// of the payload if the acknowledgement is the sentinel error acknowledgement.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	acknowledgement []byte,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnAcknowledgementPacket(ctx, sourceClient, destinationClient, sequence, acknowledgement, payload, relayer); err != nil {
+		return err
+	}
+
+	packetData, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return err
+	}
+
+	success := !bytes.Equal(acknowledgement, channeltypesv2.ErrorAcknowledgement[:])
+	return im.keeper.AcknowledgeRateLimitedPacket(ctx, sourceClient, sequence, packetData, success)
+}
+
+// OnTimeoutPacket defers to the underlying application and then undoes the outflow of the payload.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnTimeoutPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer); err != nil {
+		return err
+	}
+
+	packetData, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return err
+	}
+
+	return im.keeper.TimeoutRateLimitedPacket(ctx, sourceClient, sequence, packetData)
+}
+
+// UnmarshalPacketData defers to the underlying app to unmarshal the packet data.
+// This function implements the optional PacketDataUnmarshaler interface.
+func (im IBCMiddleware) UnmarshalPacketData(payload channeltypesv2.Payload) (any, error) {
+	unmarshaler, ok := im.app.(api.PacketDataUnmarshaler)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPacketData, "underlying application does not implement %T", (*api.PacketDataUnmarshaler)(nil))
+	}
+
+	return unmarshaler.UnmarshalPacketData(payload)
+}
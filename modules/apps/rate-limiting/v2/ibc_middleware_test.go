@@ -0,0 +1,157 @@
+package v2_test
+
+import (
+	"testing"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+type RateLimitingTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+func (suite *RateLimitingTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.SetupV2()
+}
+
+func TestRateLimitingTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(RateLimitingTestSuite))
+}
+
+// setRateLimit stores a rate limit with a channel value of 1000 for the provided denom and
+// client on the given chain, so that the thresholds are equal to 10 times the percentages.
+func setRateLimit(chain *ibctesting.TestChain, denom, clientID string, maxPercentSend, maxPercentRecv int64) {
+	rateLimit := types.NewRateLimit(
+		types.NewPath(denom, clientID),
+		types.NewQuota(sdkmath.NewInt(maxPercentSend), sdkmath.NewInt(maxPercentRecv), 24),
+		sdkmath.NewInt(1000),
+	)
+	chain.GetSimApp().RateLimitKeeper.SetRateLimit(chain.GetContext(), rateLimit)
+}
+
+func (suite *RateLimitingTestSuite) transferPayload(amount int64) channeltypesv2.Payload {
+	transferData := transfertypes.NewFungibleTokenPacketData(
+		sdk.DefaultBondDenom,
+		sdkmath.NewInt(amount).String(),
+		suite.chainA.SenderAccount.GetAddress().String(),
+		suite.chainB.SenderAccount.GetAddress().String(),
+		"",
+	)
+
+	return channeltypesv2.NewPayload(
+		transfertypes.PortID, transfertypes.PortID, transfertypes.V1,
+		transfertypes.EncodingProtobuf, suite.chainA.Codec.MustMarshal(&transferData),
+	)
+}
+
+func (suite *RateLimitingTestSuite) TestOnSendPacket() {
+	testCases := []struct {
+		name       string
+		amount     int64
+		expError   error
+		expOutflow sdkmath.Int
+	}{
+		{"success: within quota", 100, nil, sdkmath.NewInt(100)},
+		{"failure: quota exceeded", 101, types.ErrQuotaExceeded, sdkmath.ZeroInt()},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			setRateLimit(suite.chainA, sdk.DefaultBondDenom, suite.path.EndpointA.ClientID, 10, 10)
+
+			packet, err := suite.path.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), suite.transferPayload(tc.amount))
+
+			rateLimitKeeper := suite.chainA.GetSimApp().RateLimitKeeper
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().True(rateLimitKeeper.HasPendingSendPacket(suite.chainA.GetContext(), suite.path.EndpointA.ClientID, packet.Sequence, sdk.DefaultBondDenom))
+			} else {
+				suite.Require().ErrorContains(err, tc.expError.Error())
+			}
+
+			rateLimit, found := rateLimitKeeper.GetRateLimit(suite.chainA.GetContext(), sdk.DefaultBondDenom, suite.path.EndpointA.ClientID)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expOutflow, rateLimit.Flow.Outflow)
+		})
+	}
+}
+
+func (suite *RateLimitingTestSuite) TestOnRecvAndAcknowledgementPacket() {
+	testCases := []struct {
+		name      string
+		amount    int64
+		expAck    channeltypesv2.Acknowledgement
+		expInflow sdkmath.Int
+	}{
+		{
+			"success: within quota",
+			100,
+			channeltypesv2.NewAcknowledgement(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()),
+			sdkmath.NewInt(100),
+		},
+		{
+			"failure: quota exceeded",
+			101,
+			channeltypesv2.NewErrorAcknowledgement(),
+			sdkmath.ZeroInt(),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			voucherDenom := transfertypes.NewDenom(sdk.DefaultBondDenom, transfertypes.NewHop(transfertypes.PortID, suite.path.EndpointB.ClientID)).IBCDenom()
+			setRateLimit(suite.chainB, voucherDenom, suite.path.EndpointB.ClientID, 10, 10)
+			setRateLimit(suite.chainA, sdk.DefaultBondDenom, suite.path.EndpointA.ClientID, 100, 100)
+
+			packet, err := suite.path.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), suite.transferPayload(tc.amount))
+			suite.Require().NoError(err)
+
+			err = suite.path.EndpointB.MsgRecvPacket(packet)
+			suite.Require().NoError(err)
+
+			rateLimit, found := suite.chainB.GetSimApp().RateLimitKeeper.GetRateLimit(suite.chainB.GetContext(), voucherDenom, suite.path.EndpointB.ClientID)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expInflow, rateLimit.Flow.Inflow)
+
+			err = suite.path.EndpointA.MsgAcknowledgePacket(packet, tc.expAck)
+			suite.Require().NoError(err)
+
+			// the outflow on the sending chain is undone if the packet failed
+			expOutflow := sdkmath.NewInt(tc.amount)
+			if !tc.expAck.Success() {
+				expOutflow = sdkmath.ZeroInt()
+			}
+
+			rateLimit, found = suite.chainA.GetSimApp().RateLimitKeeper.GetRateLimit(suite.chainA.GetContext(), sdk.DefaultBondDenom, suite.path.EndpointA.ClientID)
+			suite.Require().True(found)
+			suite.Require().Equal(expOutflow, rateLimit.Flow.Outflow)
+		})
+	}
+}
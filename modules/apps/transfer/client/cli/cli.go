@@ -22,6 +22,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryEscrowAddress(),
 		GetCmdQueryDenomHash(),
 		GetCmdQueryTotalEscrowForDenom(),
+		GetCmdQueryDenomTransferStatuses(),
+		GetCmdQueryChannelTransferStatuses(),
+		GetCmdQueryBlockedReceivers(),
 	)
 
 	return queryCmd
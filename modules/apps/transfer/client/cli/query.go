@@ -200,3 +200,117 @@ func GetCmdQueryTotalEscrowForDenom() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryDenomTransferStatuses defines the command to query the denominations for which sending or receiving is disabled.
+func GetCmdQueryDenomTransferStatuses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-transfer-statuses",
+		Short:   "Query the denominations for which sending or receiving is disabled",
+		Long:    "Query the denominations for which sending or receiving is disabled",
+		Example: fmt.Sprintf("%s query ibc-transfer denom-transfer-statuses", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDenomTransferStatusesRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.DenomTransferStatuses(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denom transfer statuses")
+
+	return cmd
+}
+
+// GetCmdQueryChannelTransferStatuses defines the command to query the channels and clients for which sending or receiving is disabled.
+func GetCmdQueryChannelTransferStatuses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "channel-transfer-statuses",
+		Short:   "Query the channels and clients for which sending or receiving is disabled",
+		Long:    "Query the channels and clients for which sending or receiving is disabled",
+		Example: fmt.Sprintf("%s query ibc-transfer channel-transfer-statuses", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryChannelTransferStatusesRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ChannelTransferStatuses(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "channel transfer statuses")
+
+	return cmd
+}
+
+// GetCmdQueryBlockedReceivers defines the command to query the receiver addresses which are blocked from receiving transfers.
+func GetCmdQueryBlockedReceivers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "blocked-receivers",
+		Short:   "Query the receiver addresses which are blocked from receiving transfers",
+		Long:    "Query the receiver addresses which are blocked from receiving transfers",
+		Example: fmt.Sprintf("%s query ibc-transfer blocked-receivers", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryBlockedReceiversRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.BlockedReceivers(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "blocked receivers")
+
+	return cmd
+}
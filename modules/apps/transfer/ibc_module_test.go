@@ -640,7 +640,7 @@ func (suite *TransferTestSuite) TestPacketDataUnmarshalerInterface() {
 
 				if v1PacketData, ok := initialPacketData.(types.FungibleTokenPacketData); ok {
 					// Note: testing of the denom trace parsing/conversion should be done as part of testing internal conversion functions
-					suite.Require().Equal(v1PacketData.Amount, v2PacketData.Token.Amount)
+					suite.Require().Equal(v1PacketData.Amount, v2PacketData.Tokens[0].Amount)
 					suite.Require().Equal(v1PacketData.Sender, v2PacketData.Sender)
 					suite.Require().Equal(v1PacketData.Receiver, v2PacketData.Receiver)
 					suite.Require().Equal(v1PacketData.Memo, v2PacketData.Memo)
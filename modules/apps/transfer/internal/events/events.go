@@ -3,6 +3,7 @@ package events
 import (
 	"encoding/json"
 	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -12,14 +13,15 @@ import (
 )
 
 // EmitTransferEvent emits a ibc transfer event on successful transfers.
-func EmitTransferEvent(ctx sdk.Context, sender, receiver string, token types.Token, memo string) {
+// The denominations and amounts of transfers of multiple tokens are comma separated.
+func EmitTransferEvent(ctx sdk.Context, sender, receiver string, tokens types.Tokens, memo string) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeTransfer,
 			sdk.NewAttribute(types.AttributeKeySender, sender),
 			sdk.NewAttribute(types.AttributeKeyReceiver, receiver),
-			sdk.NewAttribute(types.AttributeKeyDenom, token.Denom.Path()),
-			sdk.NewAttribute(types.AttributeKeyAmount, token.Amount),
+			sdk.NewAttribute(types.AttributeKeyDenom, denomPaths(tokens)),
+			sdk.NewAttribute(types.AttributeKeyAmount, amounts(tokens)),
 			sdk.NewAttribute(types.AttributeKeyMemo, memo),
 		),
 		sdk.NewEvent(
@@ -34,8 +36,8 @@ func EmitOnRecvPacketEvent(ctx sdk.Context, packetData types.InternalTransferRep
 	eventAttributes := []sdk.Attribute{
 		sdk.NewAttribute(types.AttributeKeySender, packetData.Sender),
 		sdk.NewAttribute(types.AttributeKeyReceiver, packetData.Receiver),
-		sdk.NewAttribute(types.AttributeKeyDenom, packetData.Token.Denom.Path()),
-		sdk.NewAttribute(types.AttributeKeyAmount, packetData.Token.Amount),
+		sdk.NewAttribute(types.AttributeKeyDenom, denomPaths(packetData.Tokens)),
+		sdk.NewAttribute(types.AttributeKeyAmount, amounts(packetData.Tokens)),
 		sdk.NewAttribute(types.AttributeKeyMemo, packetData.Memo),
 		sdk.NewAttribute(types.AttributeKeyAckSuccess, strconv.FormatBool(ack.Success())),
 	}
@@ -63,8 +65,8 @@ func EmitOnAcknowledgementPacketEvent(ctx sdk.Context, packetData types.Internal
 			types.EventTypePacket,
 			sdk.NewAttribute(sdk.AttributeKeySender, packetData.Sender),
 			sdk.NewAttribute(types.AttributeKeyReceiver, packetData.Receiver),
-			sdk.NewAttribute(types.AttributeKeyDenom, packetData.Token.Denom.Path()),
-			sdk.NewAttribute(types.AttributeKeyAmount, packetData.Token.Amount),
+			sdk.NewAttribute(types.AttributeKeyDenom, denomPaths(packetData.Tokens)),
+			sdk.NewAttribute(types.AttributeKeyAmount, amounts(packetData.Tokens)),
 			sdk.NewAttribute(types.AttributeKeyMemo, packetData.Memo),
 			sdk.NewAttribute(types.AttributeKeyAck, ack.String()),
 		),
@@ -94,7 +96,7 @@ func EmitOnAcknowledgementPacketEvent(ctx sdk.Context, packetData types.Internal
 
 // EmitOnTimeoutEvent emits a fungible token packet event in the OnTimeoutPacket callback
 func EmitOnTimeoutEvent(ctx sdk.Context, packetData types.InternalTransferRepresentation) {
-	tokenStr := mustMarshalJSON(packetData.Token)
+	tokenStr := mustMarshalJSON(packetData.Tokens)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -123,6 +125,26 @@ func EmitDenomEvent(ctx sdk.Context, token types.Token) {
 	)
 }
 
+// denomPaths returns the comma separated full denomination paths of the tokens.
+func denomPaths(tokens types.Tokens) string {
+	paths := make([]string, len(tokens))
+	for i, token := range tokens {
+		paths[i] = token.Denom.Path()
+	}
+
+	return strings.Join(paths, ",")
+}
+
+// amounts returns the comma separated amounts of the tokens.
+func amounts(tokens types.Tokens) string {
+	amounts := make([]string, len(tokens))
+	for i, token := range tokens {
+		amounts[i] = token.Amount
+	}
+
+	return strings.Join(amounts, ",")
+}
+
 // mustMarshalJSON json marshals the given type and panics on failure.
 func mustMarshalJSON(v any) string {
 	bz, err := json.Marshal(v)
@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+)
+
+// ForwardPacket receives the tokens of an IBC v2 packet into the forward address of the
+// destination port and client and forwards them to the next hop. The received packet is
+// stored until the forwarded packet is acknowledged or times out, at which point the
+// acknowledgement of the received packet must be written asynchronously.
+func (k Keeper) ForwardPacket(ctx sdk.Context, packet channeltypesv2.Packet, data types.InternalTransferRepresentation, forwarding types.Forwarding) error {
+	if len(packet.Payloads) != 1 {
+		return errorsmod.Wrapf(channeltypesv2.ErrInvalidPacket, "forwarded packet must contain exactly one payload, got %d", len(packet.Payloads))
+	}
+
+	// the tokens are received by the forward address, so the receiver of the packet, which is
+	// passed unchanged to the final hop, is checked against the denylist before forwarding.
+	// Only bech32 encoded receivers are checked, by their address bytes.
+	if k.isForwardReceiverBlocked(ctx, data.Receiver) {
+		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is blocked from receiving funds", data.Receiver)
+	}
+
+	payload := packet.Payloads[0]
+	forwardAddress := types.GetForwardAddress(payload.DestinationPort, packet.DestinationClient)
+
+	receiver := data.Receiver
+	data.Receiver = forwardAddress.String()
+	if err := k.OnRecvPacket(ctx, data, payload.SourcePort, packet.SourceClient, payload.DestinationPort, packet.DestinationClient); err != nil {
+		return err
+	}
+
+	memo, err := forwarding.NextMemo()
+	if err != nil {
+		return err
+	}
+
+	tokens := make(types.Tokens, len(data.Tokens))
+	for i, token := range data.Tokens {
+		tokens[i] = receivedToken(token, payload.SourcePort, packet.SourceClient, payload.DestinationPort, packet.DestinationClient)
+	}
+	forwardData := types.NewInternalTransferRepresentation(tokens, forwardAddress.String(), receiver, memo)
+
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(types.DefaultForwardingTimeout).Unix())
+	sequence, err := k.transferV2Packet(ctx, payload.Version, payload.Encoding, forwarding.Hops[0], timeoutTimestamp, forwardData)
+	if err != nil {
+		return errorsmod.Wrapf(err, "failed to forward packet to %s", forwarding.Hops[0])
+	}
+
+	k.SetForwardedPacket(ctx, types.NewForwardedPacket(forwarding.Hops[0], sequence, packet))
+
+	k.Logger(ctx).Info("forwarded ICS-20 packet", "source-client", packet.SourceClient, "sequence", packet.Sequence, "forward-client", forwarding.Hops[0], "forward-sequence", sequence)
+
+	return nil
+}
+
+// RevertForwardedPacket reverts the receipt of a packet whose forwarding failed. The tokens
+// refunded to the forward address are escrowed again if they were unescrowed upon receipt,
+// or burned if they were minted as vouchers upon receipt.
+func (k Keeper) RevertForwardedPacket(ctx sdk.Context, packet channeltypesv2.Packet) error {
+	if len(packet.Payloads) != 1 {
+		return errorsmod.Wrapf(channeltypesv2.ErrInvalidPacket, "forwarded packet must contain exactly one payload, got %d", len(packet.Payloads))
+	}
+
+	payload := packet.Payloads[0]
+	data, err := types.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return err
+	}
+
+	forwardAddress := types.GetForwardAddress(payload.DestinationPort, packet.DestinationClient)
+	for _, token := range data.Tokens {
+		if err := k.revertForwardedToken(ctx, token, forwardAddress, payload.SourcePort, packet.SourceClient, payload.DestinationPort, packet.DestinationClient); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// revertForwardedToken escrows again or burns a single token received into the forward address.
+func (k Keeper) revertForwardedToken(ctx sdk.Context, token types.Token, forwardAddress sdk.AccAddress, sourcePort, sourceClient, destPort, destClient string) error {
+	transferAmount, ok := sdkmath.NewIntFromString(token.Amount)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount: %s", token.Amount)
+	}
+
+	received := receivedToken(token, sourcePort, sourceClient, destPort, destClient)
+	coin := sdk.NewCoin(received.Denom.IBCDenom(), transferAmount)
+
+	if token.Denom.HasPrefix(sourcePort, sourceClient) {
+		// tokens were unescrowed upon receipt, escrow them again
+		escrowAddress := types.GetEscrowAddress(destPort, destClient)
+		return k.EscrowCoin(ctx, forwardAddress, escrowAddress, coin)
+	}
+
+	// vouchers were minted upon receipt, burn them
+	if err := k.BankKeeper.SendCoinsFromAccountToModule(ctx, forwardAddress, types.ModuleName, sdk.NewCoins(coin)); err != nil {
+		return err
+	}
+
+	if err := k.BankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(coin)); err != nil {
+		// NOTE: should not happen as the module account was
+		// retrieved on the step above and it has enough balance
+		// to burn.
+		panic(fmt.Errorf("cannot burn coins after a successful send to a module account: %v", err))
+	}
+
+	return nil
+}
+
+// GetForwardedPacket gets the packet awaiting the acknowledgement of the forwarded packet
+// with the given client ID and sequence.
+func (k Keeper) GetForwardedPacket(ctx sdk.Context, forwardClientID string, forwardSequence uint64) (types.ForwardedPacket, bool) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ForwardedPacketKey)
+	bz := store.Get(types.PacketForwardKey(forwardClientID, forwardSequence))
+	if len(bz) == 0 {
+		return types.ForwardedPacket{}, false
+	}
+
+	var forwardedPacket types.ForwardedPacket
+	k.cdc.MustUnmarshal(bz, &forwardedPacket)
+
+	return forwardedPacket, true
+}
+
+// SetForwardedPacket stores the packet awaiting the acknowledgement of the forwarded packet.
+func (k Keeper) SetForwardedPacket(ctx sdk.Context, forwardedPacket types.ForwardedPacket) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ForwardedPacketKey)
+	bz := k.cdc.MustMarshal(&forwardedPacket)
+	store.Set(types.PacketForwardKey(forwardedPacket.ForwardClientId, forwardedPacket.ForwardSequence), bz)
+}
+
+// DeleteForwardedPacket deletes the packet awaiting the acknowledgement of the forwarded packet
+// with the given client ID and sequence.
+func (k Keeper) DeleteForwardedPacket(ctx sdk.Context, forwardClientID string, forwardSequence uint64) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ForwardedPacketKey)
+	store.Delete(types.PacketForwardKey(forwardClientID, forwardSequence))
+}
+
+// GetAllForwardedPackets returns all the packets awaiting the acknowledgement of a forwarded packet.
+func (k Keeper) GetAllForwardedPackets(ctx sdk.Context) []types.ForwardedPacket {
+	var forwardedPackets []types.ForwardedPacket
+	k.IterateForwardedPackets(ctx, func(forwardedPacket types.ForwardedPacket) bool {
+		forwardedPackets = append(forwardedPackets, forwardedPacket)
+		return false
+	})
+
+	return forwardedPackets
+}
+
+// IterateForwardedPackets iterates over the forwarded packets in the store and performs a callback function.
+func (k Keeper) IterateForwardedPackets(ctx sdk.Context, cb func(forwardedPacket types.ForwardedPacket) bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.ForwardedPacketKey)
+
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		var forwardedPacket types.ForwardedPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &forwardedPacket)
+
+		if cb(forwardedPacket) {
+			break
+		}
+	}
+}
+
+// receivedToken returns the token as it is represented on this chain after being received
+// on the given destination port and client.
+func receivedToken(token types.Token, sourcePort, sourceClient, destPort, destClient string) types.Token {
+	if token.Denom.HasPrefix(sourcePort, sourceClient) {
+		token.Denom.Trace = token.Denom.Trace[1:]
+		return token
+	}
+
+	token.Denom.Trace = append([]types.Hop{types.NewHop(destPort, destClient)}, token.Denom.Trace...)
+	return token
+}
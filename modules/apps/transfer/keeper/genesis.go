@@ -22,14 +22,35 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 	for _, denomEscrow := range state.TotalEscrowed {
 		k.SetTotalEscrowForDenom(ctx, denomEscrow)
 	}
+
+	for _, forwardedPacket := range state.ForwardedPackets {
+		k.SetForwardedPacket(ctx, forwardedPacket)
+	}
+
+	for _, denomStatus := range state.DenomTransferStatuses {
+		k.SetDenomStatus(ctx, denomStatus)
+	}
+
+	for _, channelStatus := range state.ChannelTransferStatuses {
+		k.SetChannelStatus(ctx, channelStatus)
+	}
+
+	for _, receiver := range state.BlockedReceivers {
+		k.SetBlockedReceiver(ctx, sdk.MustAccAddressFromBech32(receiver))
+	}
 }
 
-// ExportGenesis exports ibc-transfer module's portID and denom trace info into its genesis state.
+// ExportGenesis exports ibc-transfer module's portID, denom trace info, forwarded packets and transfer
+// policies into its genesis state.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		PortId:        k.GetPort(ctx),
-		Denoms:        k.GetAllDenoms(ctx),
-		Params:        k.GetParams(ctx),
-		TotalEscrowed: k.GetAllTotalEscrowed(ctx),
+		PortId:                  k.GetPort(ctx),
+		Denoms:                  k.GetAllDenoms(ctx),
+		Params:                  k.GetParams(ctx),
+		TotalEscrowed:           k.GetAllTotalEscrowed(ctx),
+		ForwardedPackets:        k.GetAllForwardedPackets(ctx),
+		DenomTransferStatuses:   k.GetAllDenomStatuses(ctx),
+		ChannelTransferStatuses: k.GetAllChannelStatuses(ctx),
+		BlockedReceivers:        k.GetAllBlockedReceivers(ctx),
 	}
 }
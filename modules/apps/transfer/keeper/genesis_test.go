@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
 )
 
 func (suite *KeeperTestSuite) TestGenesis() {
@@ -42,11 +43,28 @@ func (suite *KeeperTestSuite) TestGenesis() {
 		suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), escrow)
 	}
 
+	payload := channeltypesv2.NewPayload(types.PortID, types.PortID, types.V1, types.EncodingJSON, []byte("data"))
+	forwardedPacket := types.NewForwardedPacket("07-tendermint-1", 1, channeltypesv2.NewPacket(1, "07-tendermint-0", "07-tendermint-2", 0, payload))
+	suite.chainA.GetSimApp().TransferKeeper.SetForwardedPacket(suite.chainA.GetContext(), forwardedPacket)
+
+	denomStatus := types.NewDenomTransferStatus(denoms[0].IBCDenom(), types.NewTransferStatus(true, false))
+	suite.chainA.GetSimApp().TransferKeeper.SetDenomStatus(suite.chainA.GetContext(), denomStatus)
+
+	channelStatus := types.NewChannelTransferStatus("07-tendermint-0", types.NewTransferStatus(false, true))
+	suite.chainA.GetSimApp().TransferKeeper.SetChannelStatus(suite.chainA.GetContext(), channelStatus)
+
+	blockedReceiver := suite.chainA.SenderAccount.GetAddress()
+	suite.chainA.GetSimApp().TransferKeeper.SetBlockedReceiver(suite.chainA.GetContext(), blockedReceiver)
+
 	genesis := suite.chainA.GetSimApp().TransferKeeper.ExportGenesis(suite.chainA.GetContext())
 
 	suite.Require().Equal(types.PortID, genesis.PortId)
 	suite.Require().Equal(denoms.Sort(), genesis.Denoms)
 	suite.Require().Equal(escrows.Sort(), genesis.TotalEscrowed)
+	suite.Require().Equal([]types.ForwardedPacket{forwardedPacket}, genesis.ForwardedPackets)
+	suite.Require().Equal([]types.DenomTransferStatus{denomStatus}, genesis.DenomTransferStatuses)
+	suite.Require().Equal([]types.ChannelTransferStatus{channelStatus}, genesis.ChannelTransferStatuses)
+	suite.Require().Equal([]string{blockedReceiver.String()}, genesis.BlockedReceivers)
 
 	suite.Require().NotPanics(func() {
 		suite.chainA.GetSimApp().TransferKeeper.InitGenesis(suite.chainA.GetContext(), *genesis)
@@ -56,4 +74,9 @@ func (suite *KeeperTestSuite) TestGenesis() {
 		_, found := suite.chainA.GetSimApp().BankKeeper.GetDenomMetaData(suite.chainA.GetContext(), denom.IBCDenom())
 		suite.Require().True(found)
 	}
+
+	storedPacket, found := suite.chainA.GetSimApp().TransferKeeper.GetForwardedPacket(suite.chainA.GetContext(), "07-tendermint-1", 1)
+	suite.Require().True(found)
+	suite.Require().Equal(forwardedPacket, storedPacket)
+	suite.Require().True(suite.chainA.GetSimApp().TransferKeeper.IsReceiverBlocked(suite.chainA.GetContext(), blockedReceiver))
 }
@@ -158,3 +158,82 @@ func (k Keeper) TotalEscrowForDenom(goCtx context.Context, req *types.QueryTotal
 		Amount: amount,
 	}, nil
 }
+
+// DenomTransferStatuses implements the Query/DenomTransferStatuses gRPC method
+func (k Keeper) DenomTransferStatuses(ctx context.Context, req *types.QueryDenomTransferStatusesRequest) (*types.QueryDenomTransferStatusesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var denomStatuses []types.DenomTransferStatus
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.DenomTransferStatusKey)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		var transferStatus types.TransferStatus
+		if err := k.cdc.Unmarshal(value, &transferStatus); err != nil {
+			return err
+		}
+
+		denomStatuses = append(denomStatuses, types.NewDenomTransferStatus(string(key), transferStatus))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomTransferStatusesResponse{
+		DenomTransferStatuses: denomStatuses,
+		Pagination:            pageRes,
+	}, nil
+}
+
+// ChannelTransferStatuses implements the Query/ChannelTransferStatuses gRPC method
+func (k Keeper) ChannelTransferStatuses(ctx context.Context, req *types.QueryChannelTransferStatusesRequest) (*types.QueryChannelTransferStatusesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var channelStatuses []types.ChannelTransferStatus
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ChannelTransferStatusKey)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		var transferStatus types.TransferStatus
+		if err := k.cdc.Unmarshal(value, &transferStatus); err != nil {
+			return err
+		}
+
+		channelStatuses = append(channelStatuses, types.NewChannelTransferStatus(string(key), transferStatus))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryChannelTransferStatusesResponse{
+		ChannelTransferStatuses: channelStatuses,
+		Pagination:              pageRes,
+	}, nil
+}
+
+// BlockedReceivers implements the Query/BlockedReceivers gRPC method
+func (k Keeper) BlockedReceivers(ctx context.Context, req *types.QueryBlockedReceiversRequest) (*types.QueryBlockedReceiversResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var receivers []string
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.BlockedReceiverKey)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		receivers = append(receivers, sdk.AccAddress(key).String())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBlockedReceiversResponse{
+		BlockedReceivers: receivers,
+		Pagination:       pageRes,
+	}, nil
+}
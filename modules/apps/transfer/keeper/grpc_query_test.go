@@ -396,3 +396,203 @@ func (suite *KeeperTestSuite) TestTotalEscrowForDenom() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryDenomTransferStatuses() {
+	var (
+		req         *types.QueryDenomTransferStatusesRequest
+		expStatuses []types.DenomTransferStatus
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"empty pagination",
+			func() {
+				req = &types.QueryDenomTransferStatusesRequest{}
+			},
+			nil,
+		},
+		{
+			"success",
+			func() {
+				denom := types.NewDenom("uatom", types.NewHop("transfer", "channel-0"))
+				expStatuses = []types.DenomTransferStatus{
+					types.NewDenomTransferStatus(denom.IBCDenom(), types.NewTransferStatus(false, true)),
+					types.NewDenomTransferStatus("uatom", types.NewTransferStatus(true, false)),
+				}
+
+				for _, denomStatus := range expStatuses {
+					suite.chainA.GetSimApp().TransferKeeper.SetDenomStatus(suite.chainA.GetContext(), denomStatus)
+				}
+
+				req = &types.QueryDenomTransferStatusesRequest{
+					Pagination: &query.PageRequest{
+						Limit: 5,
+					},
+				}
+			},
+			nil,
+		},
+		{
+			"failure: nil request",
+			func() {
+				req = nil
+			},
+			errors.New("empty request"),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			expStatuses = nil
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.DenomTransferStatuses(ctx, req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expStatuses, res.DenomTransferStatuses)
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryChannelTransferStatuses() {
+	var (
+		req         *types.QueryChannelTransferStatusesRequest
+		expStatuses []types.ChannelTransferStatus
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"empty pagination",
+			func() {
+				req = &types.QueryChannelTransferStatusesRequest{}
+			},
+			nil,
+		},
+		{
+			"success",
+			func() {
+				expStatuses = []types.ChannelTransferStatus{
+					types.NewChannelTransferStatus(ibctesting.FirstClientID, types.NewTransferStatus(true, true)),
+					types.NewChannelTransferStatus(ibctesting.FirstChannelID, types.NewTransferStatus(true, false)),
+				}
+
+				for _, channelStatus := range expStatuses {
+					suite.chainA.GetSimApp().TransferKeeper.SetChannelStatus(suite.chainA.GetContext(), channelStatus)
+				}
+
+				req = &types.QueryChannelTransferStatusesRequest{
+					Pagination: &query.PageRequest{
+						Limit: 5,
+					},
+				}
+			},
+			nil,
+		},
+		{
+			"failure: nil request",
+			func() {
+				req = nil
+			},
+			errors.New("empty request"),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			expStatuses = nil
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.ChannelTransferStatuses(ctx, req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expStatuses, res.ChannelTransferStatuses)
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryBlockedReceivers() {
+	var (
+		req          *types.QueryBlockedReceiversRequest
+		expReceivers []string
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"empty pagination",
+			func() {
+				req = &types.QueryBlockedReceiversRequest{}
+			},
+			nil,
+		},
+		{
+			"success",
+			func() {
+				receiver := suite.chainA.SenderAccount.GetAddress()
+				suite.chainA.GetSimApp().TransferKeeper.SetBlockedReceiver(suite.chainA.GetContext(), receiver)
+				expReceivers = []string{receiver.String()}
+
+				req = &types.QueryBlockedReceiversRequest{
+					Pagination: &query.PageRequest{
+						Limit: 5,
+					},
+				}
+			},
+			nil,
+		},
+		{
+			"failure: nil request",
+			func() {
+				req = nil
+			},
+			errors.New("empty request"),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			expReceivers = nil
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.BlockedReceivers(ctx, req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expReceivers, res.BlockedReceivers)
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
@@ -29,11 +29,12 @@ type Keeper struct {
 	cdc            codec.BinaryCodec
 	legacySubspace types.ParamSubspace
 
-	ics4Wrapper   porttypes.ICS4Wrapper
-	channelKeeper types.ChannelKeeper
-	msgRouter     types.MessageRouter
-	AuthKeeper    types.AccountKeeper
-	BankKeeper    types.BankKeeper
+	ics4Wrapper    porttypes.ICS4Wrapper
+	channelKeeper  types.ChannelKeeper
+	clientKeeperV2 types.ClientKeeperV2
+	msgRouter      types.MessageRouter
+	AuthKeeper     types.AccountKeeper
+	BankKeeper     types.BankKeeper
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
@@ -47,6 +48,7 @@ func NewKeeper(
 	legacySubspace types.ParamSubspace,
 	ics4Wrapper porttypes.ICS4Wrapper,
 	channelKeeper types.ChannelKeeper,
+	clientKeeperV2 types.ClientKeeperV2,
 	msgRouter types.MessageRouter,
 	authKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
@@ -67,6 +69,7 @@ func NewKeeper(
 		legacySubspace: legacySubspace,
 		ics4Wrapper:    ics4Wrapper,
 		channelKeeper:  channelKeeper,
+		clientKeeperV2: clientKeeperV2,
 		msgRouter:      msgRouter,
 		AuthKeeper:     authKeeper,
 		BankKeeper:     bankKeeper,
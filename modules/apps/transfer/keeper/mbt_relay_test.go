@@ -150,9 +150,11 @@ func FungibleTokenPacketFromTla(packet TlaFungibleTokenPacket) FungibleTokenPack
 		DestChannel:   packet.DestChannel,
 		DestPort:      packet.DestPort,
 		Data: types.NewInternalTransferRepresentation(
-			types.Token{
-				Denom:  denom,
-				Amount: packet.Data.Amount,
+			types.Tokens{
+				{
+					Denom:  denom,
+					Amount: packet.Data.Amount,
+				},
 			},
 			AddressFromString(packet.Data.Sender),
 			AddressFromString(packet.Data.Receiver),
@@ -314,8 +316,8 @@ func (suite *KeeperTestSuite) TestModelBasedRelay() {
 		for i, tlaTc := range tlaTestCases {
 			tc := OnRecvPacketTestCaseFromTla(tlaTc)
 			registerDenomFn := func() {
-				if !suite.chainB.GetSimApp().TransferKeeper.HasDenom(suite.chainB.GetContext(), tc.packet.Data.Token.Denom.Hash()) {
-					suite.chainB.GetSimApp().TransferKeeper.SetDenom(suite.chainB.GetContext(), tc.packet.Data.Token.Denom)
+				if !suite.chainB.GetSimApp().TransferKeeper.HasDenom(suite.chainB.GetContext(), tc.packet.Data.Tokens[0].Denom.Hash()) {
+					suite.chainB.GetSimApp().TransferKeeper.SetDenom(suite.chainB.GetContext(), tc.packet.Data.Tokens[0].Denom)
 				}
 			}
 
@@ -339,10 +341,10 @@ func (suite *KeeperTestSuite) TestModelBasedRelay() {
 						panic(errors.New("MBT failed to convert sender address"))
 					}
 					registerDenomFn()
-					denom := tc.packet.Data.Token.Denom.IBCDenom()
+					denom := tc.packet.Data.Tokens[0].Denom.IBCDenom()
 					err = sdk.ValidateDenom(denom)
 					if err == nil {
-						amount, ok := sdkmath.NewIntFromString(tc.packet.Data.Token.Amount)
+						amount, ok := sdkmath.NewIntFromString(tc.packet.Data.Tokens[0].Amount)
 						if !ok {
 							panic(errors.New("MBT failed to parse amount from string"))
 						}
@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/ibc-go/v10/modules/apps/transfer/internal/telemetry"
 	"github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	clientv2types "github.com/cosmos/ibc-go/v10/modules/core/02-client/v2/types"
 	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
 	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
 )
@@ -32,25 +33,34 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 		return nil, err
 	}
 
-	coin := msg.Token
+	coins := msg.GetCoins()
+	tokens := make(types.Tokens, 0, len(coins))
+	for _, coin := range coins {
+		// Using types.UnboundedSpendLimit allows us to send the entire balance of a given denom.
+		if coin.Amount.Equal(types.UnboundedSpendLimit()) {
+			coin.Amount = k.BankKeeper.SpendableCoin(ctx, sender, coin.Denom).Amount
+			if coin.Amount.IsZero() {
+				return nil, errorsmod.Wrapf(types.ErrInvalidAmount, "empty spendable balance for %s", coin.Denom)
+			}
+		}
 
-	// Using types.UnboundedSpendLimit allows us to send the entire balance of a given denom.
-	if coin.Amount.Equal(types.UnboundedSpendLimit()) {
-		coin.Amount = k.BankKeeper.SpendableCoin(ctx, sender, coin.Denom).Amount
-		if coin.Amount.IsZero() {
-			return nil, errorsmod.Wrapf(types.ErrInvalidAmount, "empty spendable balance for %s", coin.Denom)
+		token, err := k.TokenFromCoin(ctx, coin)
+		if err != nil {
+			return nil, err
 		}
-	}
 
-	token, err := k.TokenFromCoin(ctx, coin)
-	if err != nil {
-		return nil, err
+		tokens = append(tokens, token)
 	}
 
-	packetData := types.NewFungibleTokenPacketData(token.Denom.Path(), token.Amount, sender.String(), msg.Receiver, msg.Memo)
+	// multiple tokens are transferred in a single packet with the ICS20 v2 payload version
+	version := types.V1
+	if len(msg.Tokens) > 0 {
+		version = types.V2
+	}
 
-	if err := packetData.ValidateBasic(); err != nil {
-		return nil, errorsmod.Wrapf(err, "failed to validate %s packet data", types.V1)
+	data := types.NewInternalTransferRepresentation(tokens, sender.String(), msg.Receiver, msg.Memo)
+	if err := data.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to validate %s packet data", version)
 	}
 
 	// if a channel exists with source channel, then use IBC V1 protocol
@@ -59,26 +69,47 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 
 	var sequence uint64
 	if isIBCV1 {
+		if version != types.V1 {
+			return nil, errorsmod.Wrapf(types.ErrInvalidVersion, "tokens can only be transferred with the %s version over the IBC v2 protocol", types.V2)
+		}
+
+		if msg.DestinationClient != "" {
+			return nil, errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "destination client can only be set for transfers over the IBC v2 protocol")
+		}
+
+		packetData := types.NewFungibleTokenPacketData(tokens[0].Denom.Path(), tokens[0].Amount, sender.String(), msg.Receiver, msg.Memo)
+		if err := packetData.ValidateBasic(); err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to validate %s packet data", types.V1)
+		}
+
 		// if a V1 channel exists for the source channel, then use IBC V1 protocol
-		sequence, err = k.transferV1Packet(ctx, msg.SourceChannel, token, msg.TimeoutHeight, msg.TimeoutTimestamp, packetData)
+		sequence, err = k.transferV1Packet(ctx, msg.SourceChannel, tokens, msg.TimeoutHeight, msg.TimeoutTimestamp, packetData)
 		// telemetry for transfer occurs here, in IBC V2 this is done in the onSendPacket callback
-		telemetry.ReportTransfer(msg.SourcePort, msg.SourceChannel, channel.Counterparty.PortId, channel.Counterparty.ChannelId, token)
+		telemetry.ReportTransfer(msg.SourcePort, msg.SourceChannel, channel.Counterparty.PortId, channel.Counterparty.ChannelId, tokens)
 	} else {
 		// otherwise try to send an IBC V2 packet, if the sourceChannel is not a IBC V2 client
-		// then core IBC will return a CounterpartyNotFound error
-		sequence, err = k.transferV2Packet(ctx, msg.Encoding, msg.SourceChannel, msg.TimeoutTimestamp, packetData)
+		// then core IBC will return a CounterpartyNotFound error.
+		// The destination client is checked by TransferAuthorization allow lists, so it must be
+		// the counterparty of the source client if set
+		if msg.DestinationClient != "" {
+			if counterparty, found := k.clientKeeperV2.GetClientCounterparty(ctx, msg.SourceChannel); !found || counterparty.ClientId != msg.DestinationClient {
+				return nil, errorsmod.Wrapf(clientv2types.ErrInvalidCounterparty, "destination client %s is not the counterparty of source client %s", msg.DestinationClient, msg.SourceChannel)
+			}
+		}
+
+		sequence, err = k.transferV2Packet(ctx, version, msg.Encoding, msg.SourceChannel, msg.TimeoutTimestamp, data)
 	}
 	if err != nil {
 		return nil, err
 	}
 
-	k.Logger(ctx).Info("IBC fungible token transfer", "token", coin, "sender", msg.Sender, "receiver", msg.Receiver)
+	k.Logger(ctx).Info("IBC fungible token transfer", "tokens", coins, "sender", msg.Sender, "receiver", msg.Receiver)
 
 	return &types.MsgTransferResponse{Sequence: sequence}, nil
 }
 
-func (k Keeper) transferV1Packet(ctx sdk.Context, sourceChannel string, token types.Token, timeoutHeight clienttypes.Height, timeoutTimestamp uint64, packetData types.FungibleTokenPacketData) (uint64, error) {
-	if err := k.SendTransfer(ctx, types.PortID, sourceChannel, token, sdk.MustAccAddressFromBech32(packetData.Sender)); err != nil {
+func (k Keeper) transferV1Packet(ctx sdk.Context, sourceChannel string, tokens types.Tokens, timeoutHeight clienttypes.Height, timeoutTimestamp uint64, packetData types.FungibleTokenPacketData) (uint64, error) {
+	if err := k.SendTransfer(ctx, types.PortID, sourceChannel, tokens, sdk.MustAccAddressFromBech32(packetData.Sender)); err != nil {
 		return 0, err
 	}
 
@@ -88,28 +119,33 @@ func (k Keeper) transferV1Packet(ctx sdk.Context, sourceChannel string, token ty
 		return 0, err
 	}
 
-	events.EmitTransferEvent(ctx, packetData.Sender, packetData.Receiver, token, packetData.Memo)
+	events.EmitTransferEvent(ctx, packetData.Sender, packetData.Receiver, tokens, packetData.Memo)
 
 	return sequence, nil
 }
 
-func (k Keeper) transferV2Packet(ctx sdk.Context, encoding, sourceChannel string, timeoutTimestamp uint64, packetData types.FungibleTokenPacketData) (uint64, error) {
+// transferV2Packet sends an IBC v2 packet with a transfer payload of the given version and encoding.
+// The encoding defaults to JSON for ICS20 v1 payloads and to protobuf for ICS20 v2 payloads.
+func (k Keeper) transferV2Packet(ctx sdk.Context, version, encoding, sourceChannel string, timeoutTimestamp uint64, data types.InternalTransferRepresentation) (uint64, error) {
 	if encoding == "" {
 		encoding = types.EncodingJSON
+		if version == types.V2 {
+			encoding = types.EncodingProtobuf
+		}
 	}
 
-	data, err := types.MarshalPacketData(packetData, types.V1, encoding)
+	bz, err := marshalPayloadValue(version, encoding, data)
 	if err != nil {
 		return 0, err
 	}
 
 	payload := channeltypesv2.NewPayload(
 		types.PortID, types.PortID,
-		types.V1, encoding, data,
+		version, encoding, bz,
 	)
 	msg := channeltypesv2.NewMsgSendPacket(
 		sourceChannel, timeoutTimestamp,
-		packetData.Sender, payload,
+		data.Sender, payload,
 	)
 
 	handler := k.msgRouter.Handler(msg)
@@ -138,6 +174,24 @@ func (k Keeper) transferV2Packet(ctx sdk.Context, encoding, sourceChannel string
 	return sendResponse.Sequence, nil
 }
 
+// marshalPayloadValue marshals the transfer into the packet data of the given ICS20 version with the given encoding.
+func marshalPayloadValue(version, encoding string, data types.InternalTransferRepresentation) ([]byte, error) {
+	switch version {
+	case types.V1:
+		if len(data.Tokens) != 1 {
+			return nil, errorsmod.Wrapf(types.ErrInvalidVersion, "%s packet data must contain exactly one token, got %d", types.V1, len(data.Tokens))
+		}
+
+		packetData := types.NewFungibleTokenPacketData(data.Tokens[0].Denom.Path(), data.Tokens[0].Amount, data.Sender, data.Receiver, data.Memo)
+		return types.MarshalPacketData(packetData, types.V1, encoding)
+	case types.V2:
+		packetData := types.NewFungibleTokenPacketDataV2(data.Tokens, data.Sender, data.Receiver, data.Memo)
+		return types.MarshalPacketDataV2(packetData, encoding)
+	default:
+		return nil, errorsmod.Wrapf(types.ErrInvalidVersion, "version must be one of [%s, %s], got %s", types.V1, types.V2, version)
+	}
+}
+
 // UpdateParams defines an rpc handler method for MsgUpdateParams. Updates the ibc-transfer module's parameters.
 func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if k.GetAuthority() != msg.Signer {
@@ -149,3 +203,51 @@ func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// SetDenomTransferStatus defines an rpc handler method for MsgSetDenomTransferStatus. Enables or disables
+// sending and receiving of a denomination.
+func (k Keeper) SetDenomTransferStatus(goCtx context.Context, msg *types.MsgSetDenomTransferStatus) (*types.MsgSetDenomTransferStatusResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetDenomStatus(ctx, types.NewDenomTransferStatus(msg.Denom, msg.Status))
+
+	return &types.MsgSetDenomTransferStatusResponse{}, nil
+}
+
+// SetChannelTransferStatus defines an rpc handler method for MsgSetChannelTransferStatus. Enables or disables
+// sending and receiving through a channel or client.
+func (k Keeper) SetChannelTransferStatus(goCtx context.Context, msg *types.MsgSetChannelTransferStatus) (*types.MsgSetChannelTransferStatusResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetChannelStatus(ctx, types.NewChannelTransferStatus(msg.ChannelOrClientId, msg.Status))
+
+	return &types.MsgSetChannelTransferStatusResponse{}, nil
+}
+
+// SetReceiverBlocked defines an rpc handler method for MsgSetReceiverBlocked. Adds or removes
+// a receiver address from the denylist.
+func (k Keeper) SetReceiverBlocked(goCtx context.Context, msg *types.MsgSetReceiverBlocked) (*types.MsgSetReceiverBlockedResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if msg.Blocked {
+		k.SetBlockedReceiver(ctx, receiver)
+	} else {
+		k.DeleteBlockedReceiver(ctx, receiver)
+	}
+
+	return &types.MsgSetReceiverBlockedResponse{}, nil
+}
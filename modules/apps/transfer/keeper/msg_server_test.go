@@ -94,6 +94,21 @@ func (suite *KeeperTestSuite) TestMsgTransfer() {
 			},
 			clienttypesv2.ErrCounterpartyNotFound,
 		},
+		{
+			"failure: multiple tokens over an IBC v1 channel",
+			func() {
+				msg.Token = sdk.Coin{}
+				msg.Tokens = []sdk.Coin{ibctesting.TestCoin, ibctesting.SecondaryTestCoin}
+			},
+			types.ErrInvalidVersion,
+		},
+		{
+			"failure: destination client set over an IBC v1 channel",
+			func() {
+				msg.DestinationClient = path.EndpointB.ClientID
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -229,6 +244,13 @@ func (suite *KeeperTestSuite) TestMsgTransferIBCV2() {
 			},
 			types.ErrSendDisabled,
 		},
+		{
+			"success: destination client is the counterparty of the source client",
+			func() {
+				msg.DestinationClient = path.EndpointB.ClientID
+			},
+			nil,
+		},
 		{
 			"failure: client does not exist",
 			func() {
@@ -236,6 +258,13 @@ func (suite *KeeperTestSuite) TestMsgTransferIBCV2() {
 			},
 			clienttypesv2.ErrCounterpartyNotFound,
 		},
+		{
+			"failure: destination client is not the counterparty of the source client",
+			func() {
+				msg.DestinationClient = "07-tendermint-500"
+			},
+			clienttypesv2.ErrInvalidCounterparty,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -350,3 +379,173 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSetDenomTransferStatus() {
+	signer := suite.chainA.GetSimApp().TransferKeeper.GetAuthority()
+	testCases := []struct {
+		name      string
+		msg       *types.MsgSetDenomTransferStatus
+		expStatus bool
+		expErr    error
+	}{
+		{
+			"success: disable sending",
+			types.NewMsgSetDenomTransferStatus(signer, sdk.DefaultBondDenom, types.NewTransferStatus(true, false)),
+			true,
+			nil,
+		},
+		{
+			"success: enable sending and receiving removes the status",
+			types.NewMsgSetDenomTransferStatus(signer, sdk.DefaultBondDenom, types.NewTransferStatus(false, false)),
+			false,
+			nil,
+		},
+		{
+			"failure: unauthorized signer address",
+			types.NewMsgSetDenomTransferStatus(ibctesting.TestAccAddress, sdk.DefaultBondDenom, types.NewTransferStatus(true, true)),
+			false,
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			ctx := suite.chainA.GetContext()
+			_, err := suite.chainA.GetSimApp().TransferKeeper.SetDenomTransferStatus(ctx, tc.msg)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+
+			status, found := suite.chainA.GetSimApp().TransferKeeper.GetDenomStatus(ctx, tc.msg.Denom)
+			suite.Require().Equal(tc.expStatus, found)
+			if found {
+				suite.Require().Equal(tc.msg.Status, status)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSetChannelTransferStatus() {
+	signer := suite.chainA.GetSimApp().TransferKeeper.GetAuthority()
+	testCases := []struct {
+		name      string
+		msg       *types.MsgSetChannelTransferStatus
+		expStatus bool
+		expErr    error
+	}{
+		{
+			"success: disable receiving on channel",
+			types.NewMsgSetChannelTransferStatus(signer, ibctesting.FirstChannelID, types.NewTransferStatus(false, true)),
+			true,
+			nil,
+		},
+		{
+			"success: disable sending and receiving on client",
+			types.NewMsgSetChannelTransferStatus(signer, ibctesting.FirstClientID, types.NewTransferStatus(true, true)),
+			true,
+			nil,
+		},
+		{
+			"success: enable sending and receiving removes the status",
+			types.NewMsgSetChannelTransferStatus(signer, ibctesting.FirstChannelID, types.NewTransferStatus(false, false)),
+			false,
+			nil,
+		},
+		{
+			"failure: unauthorized signer address",
+			types.NewMsgSetChannelTransferStatus(ibctesting.TestAccAddress, ibctesting.FirstChannelID, types.NewTransferStatus(true, true)),
+			false,
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			ctx := suite.chainA.GetContext()
+			_, err := suite.chainA.GetSimApp().TransferKeeper.SetChannelTransferStatus(ctx, tc.msg)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+
+			status, found := suite.chainA.GetSimApp().TransferKeeper.GetChannelStatus(ctx, tc.msg.ChannelOrClientId)
+			suite.Require().Equal(tc.expStatus, found)
+			if found {
+				suite.Require().Equal(tc.msg.Status, status)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSetReceiverBlocked() {
+	var msg *types.MsgSetReceiverBlocked
+
+	signer := suite.chainA.GetSimApp().TransferKeeper.GetAuthority()
+	receiver := suite.chainA.SenderAccount.GetAddress()
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expBlocked bool
+		expErr     error
+	}{
+		{
+			"success: block receiver",
+			func() {},
+			true,
+			nil,
+		},
+		{
+			"success: unblock receiver",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetBlockedReceiver(suite.chainA.GetContext(), receiver)
+				msg.Blocked = false
+			},
+			false,
+			nil,
+		},
+		{
+			"failure: unauthorized signer address",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			false,
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: invalid receiver address",
+			func() {
+				msg.Receiver = ibctesting.InvalidID
+			},
+			false,
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			msg = types.NewMsgSetReceiverBlocked(signer, receiver.String(), true)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			_, err := suite.chainA.GetSimApp().TransferKeeper.SetReceiverBlocked(ctx, msg)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+
+			suite.Require().Equal(tc.expBlocked, suite.chainA.GetSimApp().TransferKeeper.IsReceiverBlocked(ctx, receiver))
+		})
+	}
+}
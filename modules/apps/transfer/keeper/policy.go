@@ -0,0 +1,192 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+)
+
+// GetDenomStatus returns the transfer status of the given denomination.
+func (k Keeper) GetDenomStatus(ctx sdk.Context, denom string) (types.TransferStatus, bool) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.DenomTransferStatusKey)
+	bz := store.Get([]byte(denom))
+	if len(bz) == 0 {
+		return types.TransferStatus{}, false
+	}
+
+	var status types.TransferStatus
+	k.cdc.MustUnmarshal(bz, &status)
+
+	return status, true
+}
+
+// SetDenomStatus stores the transfer status of a denomination. The status is removed
+// from the store if both sending and receiving are enabled.
+func (k Keeper) SetDenomStatus(ctx sdk.Context, denomStatus types.DenomTransferStatus) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.DenomTransferStatusKey)
+	if denomStatus.Status.IsEnabled() {
+		store.Delete([]byte(denomStatus.Denom))
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&denomStatus.Status)
+	store.Set([]byte(denomStatus.Denom), bz)
+}
+
+// GetAllDenomStatuses returns the transfer statuses of all the denominations.
+func (k Keeper) GetAllDenomStatuses(ctx sdk.Context) []types.DenomTransferStatus {
+	var denomStatuses []types.DenomTransferStatus
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.DenomTransferStatusKey)
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		var status types.TransferStatus
+		k.cdc.MustUnmarshal(iterator.Value(), &status)
+
+		denomStatuses = append(denomStatuses, types.NewDenomTransferStatus(string(iterator.Key()), status))
+	}
+
+	return denomStatuses
+}
+
+// GetChannelStatus returns the transfer status of the given channel or client.
+func (k Keeper) GetChannelStatus(ctx sdk.Context, channelOrClientID string) (types.TransferStatus, bool) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ChannelTransferStatusKey)
+	bz := store.Get([]byte(channelOrClientID))
+	if len(bz) == 0 {
+		return types.TransferStatus{}, false
+	}
+
+	var status types.TransferStatus
+	k.cdc.MustUnmarshal(bz, &status)
+
+	return status, true
+}
+
+// SetChannelStatus stores the transfer status of a channel or client. The status is removed
+// from the store if both sending and receiving are enabled.
+func (k Keeper) SetChannelStatus(ctx sdk.Context, channelStatus types.ChannelTransferStatus) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ChannelTransferStatusKey)
+	if channelStatus.Status.IsEnabled() {
+		store.Delete([]byte(channelStatus.ChannelOrClientId))
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&channelStatus.Status)
+	store.Set([]byte(channelStatus.ChannelOrClientId), bz)
+}
+
+// GetAllChannelStatuses returns the transfer statuses of all the channels and clients.
+func (k Keeper) GetAllChannelStatuses(ctx sdk.Context) []types.ChannelTransferStatus {
+	var channelStatuses []types.ChannelTransferStatus
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ChannelTransferStatusKey)
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		var status types.TransferStatus
+		k.cdc.MustUnmarshal(iterator.Value(), &status)
+
+		channelStatuses = append(channelStatuses, types.NewChannelTransferStatus(string(iterator.Key()), status))
+	}
+
+	return channelStatuses
+}
+
+// IsReceiverBlocked returns true if the given address is on the receiver denylist.
+func (k Keeper) IsReceiverBlocked(ctx sdk.Context, receiver sdk.AccAddress) bool {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.BlockedReceiverKey)
+	return store.Has(receiver)
+}
+
+// isForwardReceiverBlocked returns true if the bech32 encoded receiver of a forwarded transfer is on the
+// receiver denylist. The receiver is an address on the final hop, which is usually a remote chain, so
+// the local denylist is applied to addresses of remote chains: the bech32 prefix of the receiver is
+// ignored and the denylist is matched against its address bytes. Receivers which are not bech32
+// encoded, e.g. hex encoded addresses of EVM chains, are not checked and are never blocked.
+func (k Keeper) isForwardReceiverBlocked(ctx sdk.Context, receiver string) bool {
+	_, bz, err := bech32.DecodeAndConvert(receiver)
+	if err != nil {
+		return false
+	}
+
+	return k.IsReceiverBlocked(ctx, bz)
+}
+
+// SetBlockedReceiver adds the given address to the receiver denylist.
+func (k Keeper) SetBlockedReceiver(ctx sdk.Context, receiver sdk.AccAddress) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.BlockedReceiverKey)
+	store.Set(receiver, []byte{byte(1)})
+}
+
+// DeleteBlockedReceiver removes the given address from the receiver denylist.
+func (k Keeper) DeleteBlockedReceiver(ctx sdk.Context, receiver sdk.AccAddress) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.BlockedReceiverKey)
+	store.Delete(receiver)
+}
+
+// GetAllBlockedReceivers returns the addresses on the receiver denylist.
+func (k Keeper) GetAllBlockedReceivers(ctx sdk.Context) []string {
+	var receivers []string
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.BlockedReceiverKey)
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		receivers = append(receivers, sdk.AccAddress(iterator.Key()).String())
+	}
+
+	return receivers
+}
+
+// isDenomStatusDisabled returns true if the denomination matches a denomination transfer status
+// for which the given direction is disabled. A status matches on the base denomination, the full
+// denomination path or the ibc/{hash} denomination as represented on this chain.
+func (k Keeper) isDenomStatusDisabled(ctx sdk.Context, denom types.Denom, disabled func(types.TransferStatus) bool) bool {
+	for _, key := range []string{denom.Base, denom.Path(), denom.IBCDenom()} {
+		if status, found := k.GetDenomStatus(ctx, key); found && disabled(status) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateSendAllowed returns an error if sending the token through the given channel or client
+// has been disabled.
+func (k Keeper) validateSendAllowed(ctx sdk.Context, sourceChannel string, token types.Token) error {
+	isSendDisabled := func(status types.TransferStatus) bool { return status.SendDisabled }
+
+	if status, found := k.GetChannelStatus(ctx, sourceChannel); found && isSendDisabled(status) {
+		return errorsmod.Wrapf(types.ErrSendDisabled, "sending is disabled for %s", sourceChannel)
+	}
+
+	if k.isDenomStatusDisabled(ctx, token.Denom, isSendDisabled) {
+		return errorsmod.Wrapf(types.ErrSendDisabled, "sending is disabled for %s", token.Denom.Path())
+	}
+
+	return nil
+}
+
+// validateReceiveAllowed returns an error if receiving the token, as represented on this chain,
+// through the given channel or client has been disabled.
+func (k Keeper) validateReceiveAllowed(ctx sdk.Context, destChannel string, token types.Token) error {
+	isReceiveDisabled := func(status types.TransferStatus) bool { return status.ReceiveDisabled }
+
+	if status, found := k.GetChannelStatus(ctx, destChannel); found && isReceiveDisabled(status) {
+		return errorsmod.Wrapf(types.ErrReceiveDisabled, "receiving is disabled for %s", destChannel)
+	}
+
+	if k.isDenomStatusDisabled(ctx, token.Denom, isReceiveDisabled) {
+		return errorsmod.Wrapf(types.ErrReceiveDisabled, "receiving is disabled for %s", token.Denom.Path())
+	}
+
+	return nil
+}
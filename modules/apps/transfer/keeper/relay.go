@@ -51,7 +51,7 @@ func (k Keeper) SendTransfer(
 	ctx sdk.Context,
 	sourcePort,
 	sourceChannel string,
-	token types.Token,
+	tokens types.Tokens,
 	sender sdk.AccAddress,
 ) error {
 	if !k.GetParams(ctx).SendEnabled {
@@ -62,42 +62,54 @@ func (k Keeper) SendTransfer(
 		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to send funds", sender)
 	}
 
-	coin, err := token.ToCoin()
-	if err != nil {
-		return err
+	coins := make([]sdk.Coin, 0, len(tokens))
+	for _, token := range tokens {
+		if err := k.validateSendAllowed(ctx, sourceChannel, token); err != nil {
+			return err
+		}
+
+		coin, err := token.ToCoin()
+		if err != nil {
+			return err
+		}
+
+		coins = append(coins, coin)
 	}
 
-	if err := k.BankKeeper.IsSendEnabledCoins(ctx, coin); err != nil {
+	if err := k.BankKeeper.IsSendEnabledCoins(ctx, coins...); err != nil {
 		return errorsmod.Wrap(types.ErrSendDisabled, err.Error())
 	}
 
 	// NOTE: SendTransfer simply sends the denomination as it exists on its own
 	// chain inside the packet data. The receiving chain will perform denom
 	// prefixing as necessary.
-
-	// if the denom is prefixed by the port and channel on which we are sending
-	// the token, then we must be returning the token back to the chain they originated from
-	if token.Denom.HasPrefix(sourcePort, sourceChannel) {
-		// transfer the coins to the module account and burn them
-		if err := k.BankKeeper.SendCoinsFromAccountToModule(
-			ctx, sender, types.ModuleName, sdk.NewCoins(coin),
-		); err != nil {
-			return err
-		}
-
-		if err := k.BankKeeper.BurnCoins(
-			ctx, types.ModuleName, sdk.NewCoins(coin),
-		); err != nil {
-			// NOTE: should not happen as the module account was
-			// retrieved on the step above and it has enough balance
-			// to burn.
-			panic(fmt.Errorf("cannot burn coins after a successful send to a module account: %v", err))
-		}
-	} else {
-		// obtain the escrow address for the source channel end
-		escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
-		if err := k.EscrowCoin(ctx, sender, escrowAddress, coin); err != nil {
-			return err
+	for i, token := range tokens {
+		coin := coins[i]
+
+		// if the denom is prefixed by the port and channel on which we are sending
+		// the token, then we must be returning the token back to the chain they originated from
+		if token.Denom.HasPrefix(sourcePort, sourceChannel) {
+			// transfer the coins to the module account and burn them
+			if err := k.BankKeeper.SendCoinsFromAccountToModule(
+				ctx, sender, types.ModuleName, sdk.NewCoins(coin),
+			); err != nil {
+				return err
+			}
+
+			if err := k.BankKeeper.BurnCoins(
+				ctx, types.ModuleName, sdk.NewCoins(coin),
+			); err != nil {
+				// NOTE: should not happen as the module account was
+				// retrieved on the step above and it has enough balance
+				// to burn.
+				panic(fmt.Errorf("cannot burn coins after a successful send to a module account: %v", err))
+			}
+		} else {
+			// obtain the escrow address for the source channel end
+			escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
+			if err := k.EscrowCoin(ctx, sender, escrowAddress, coin); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -136,8 +148,39 @@ func (k Keeper) OnRecvPacket(
 		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to receive funds", receiver)
 	}
 
-	token := data.Token
+	if k.IsReceiverBlocked(ctx, receiver) {
+		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is blocked from receiving funds", receiver)
+	}
 
+	for _, token := range data.Tokens {
+		if err := k.validateReceiveAllowed(ctx, destChannel, receivedToken(token, sourcePort, sourceChannel, destPort, destChannel)); err != nil {
+			return err
+		}
+	}
+
+	// all tokens are received atomically: if any of them fails to be received the error is
+	// returned and the resulting error acknowledgement reverts the state changes of the packet
+	for _, token := range data.Tokens {
+		if err := k.receiveToken(ctx, token, receiver, sourcePort, sourceChannel, destPort, destChannel); err != nil {
+			return err
+		}
+	}
+
+	// The ibc_module.go module will return the proper ack.
+	return nil
+}
+
+// receiveToken unescrows the token if it is returning to this chain or mints vouchers for it
+// otherwise, and sends the tokens to the receiver.
+func (k Keeper) receiveToken(
+	ctx sdk.Context,
+	token types.Token,
+	receiver sdk.AccAddress,
+	sourcePort string,
+	sourceChannel string,
+	destPort string,
+	destChannel string,
+) error {
 	// parse the transfer amount
 	transferAmount, ok := sdkmath.NewIntFromString(token.Amount)
 	if !ok {
@@ -197,10 +240,8 @@ func (k Keeper) OnRecvPacket(
 		); err != nil {
 			return errorsmod.Wrapf(err, "failed to send coins to receiver %s", receiver.String())
 		}
-
 	}
 
-	// The ibc_module.go module will return the proper ack.
 	return nil
 }
 
@@ -265,28 +306,29 @@ func (k Keeper) refundPacketTokens(
 	escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
 
 	moduleAccountAddr := k.AuthKeeper.GetModuleAddress(types.ModuleName)
-	token := data.Token
-	coin, err := token.ToCoin()
-	if err != nil {
-		return err
-	}
-
-	// if the token we must refund is prefixed by the source port and channel
-	// then the tokens were burnt when the packet was sent and we must mint new tokens
-	if token.Denom.HasPrefix(sourcePort, sourceChannel) {
-		// mint vouchers back to sender
-		if err := k.BankKeeper.MintCoins(
-			ctx, types.ModuleName, sdk.NewCoins(coin),
-		); err != nil {
+	for _, token := range data.Tokens {
+		coin, err := token.ToCoin()
+		if err != nil {
 			return err
 		}
 
-		if err := k.BankKeeper.SendCoins(ctx, moduleAccountAddr, sender, sdk.NewCoins(coin)); err != nil {
-			panic(fmt.Errorf("unable to send coins from module to account despite previously minting coins to module account: %v", err))
-		}
-	} else {
-		if err := k.UnescrowCoin(ctx, escrowAddress, sender, coin); err != nil {
-			return err
+		// if the token we must refund is prefixed by the source port and channel
+		// then the tokens were burnt when the packet was sent and we must mint new tokens
+		if token.Denom.HasPrefix(sourcePort, sourceChannel) {
+			// mint vouchers back to sender
+			if err := k.BankKeeper.MintCoins(
+				ctx, types.ModuleName, sdk.NewCoins(coin),
+			); err != nil {
+				return err
+			}
+
+			if err := k.BankKeeper.SendCoins(ctx, moduleAccountAddr, sender, sdk.NewCoins(coin)); err != nil {
+				panic(fmt.Errorf("unable to send coins from module to account despite previously minting coins to module account: %v", err))
+			}
+		} else {
+			if err := k.UnescrowCoin(ctx, escrowAddress, sender, coin); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -177,6 +177,59 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 			},
 			sdkerrors.ErrInsufficientFunds,
 		},
+		{
+			"successful transfer of native token with receiving disabled for the denom and channel",
+			func() {
+				receiveDisabled := types.NewTransferStatus(false, true)
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomStatus(suite.chainA.GetContext(), types.NewDenomTransferStatus(coin.Denom, receiveDisabled))
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelStatus(suite.chainA.GetContext(), types.NewChannelTransferStatus(path.EndpointA.ChannelID, receiveDisabled))
+			},
+			nil,
+		},
+		{
+			"failure: sending is disabled for the native denom",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomStatus(suite.chainA.GetContext(), types.NewDenomTransferStatus(coin.Denom, types.NewTransferStatus(true, false)))
+			},
+			types.ErrSendDisabled,
+		},
+		{
+			"failure: sending is disabled for the base denom of an IBC token",
+			func() {
+				denom := types.NewDenom(ibctesting.TestCoin.Denom, types.NewHop(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+				coin = sdk.NewCoin(denom.IBCDenom(), ibctesting.TestCoin.Amount)
+
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomStatus(suite.chainA.GetContext(), types.NewDenomTransferStatus(denom.Base, types.NewTransferStatus(true, false)))
+			},
+			types.ErrSendDisabled,
+		},
+		{
+			"failure: sending is disabled for the denom trace of an IBC token",
+			func() {
+				denom := types.NewDenom(ibctesting.TestCoin.Denom, types.NewHop(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+				coin = sdk.NewCoin(denom.IBCDenom(), ibctesting.TestCoin.Amount)
+
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomStatus(suite.chainA.GetContext(), types.NewDenomTransferStatus(denom.Path(), types.NewTransferStatus(true, false)))
+			},
+			types.ErrSendDisabled,
+		},
+		{
+			"failure: sending is disabled for the ibc denom of an IBC token",
+			func() {
+				denom := types.NewDenom(ibctesting.TestCoin.Denom, types.NewHop(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+				coin = sdk.NewCoin(denom.IBCDenom(), ibctesting.TestCoin.Amount)
+
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomStatus(suite.chainA.GetContext(), types.NewDenomTransferStatus(denom.IBCDenom(), types.NewTransferStatus(true, false)))
+			},
+			types.ErrSendDisabled,
+		},
+		{
+			"failure: sending is disabled for the channel",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelStatus(suite.chainA.GetContext(), types.NewChannelTransferStatus(path.EndpointA.ChannelID, types.NewTransferStatus(true, false)))
+			},
+			types.ErrSendDisabled,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -317,7 +370,10 @@ func (suite *KeeperTestSuite) TestSendTransferSetsTotalEscrowAmountForSourceIBCT
 // loop since setup is intensive for all cases. The malleate function allows
 // for testing invalid cases.
 func (suite *KeeperTestSuite) TestOnRecvPacket_ReceiverIsNotSource() {
-	var packetData types.InternalTransferRepresentation
+	var (
+		packetData types.InternalTransferRepresentation
+		path       *ibctesting.Path
+	)
 
 	testCases := []struct {
 		msg      string
@@ -339,7 +395,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket_ReceiverIsNotSource() {
 		{
 			"failure: mint zero coin",
 			func() {
-				packetData.Token.Amount = zeroAmount.String()
+				packetData.Tokens[0].Amount = zeroAmount.String()
 			},
 			types.ErrInvalidAmount,
 		},
@@ -367,13 +423,51 @@ func (suite *KeeperTestSuite) TestOnRecvPacket_ReceiverIsNotSource() {
 			},
 			types.ErrReceiveDisabled,
 		},
+		{
+			"successful receive with sending disabled for the denom and channel",
+			func() {
+				sendDisabled := types.NewTransferStatus(true, false)
+				suite.chainB.GetSimApp().TransferKeeper.SetDenomStatus(suite.chainB.GetContext(), types.NewDenomTransferStatus(packetData.Tokens[0].Denom.Base, sendDisabled))
+				suite.chainB.GetSimApp().TransferKeeper.SetChannelStatus(suite.chainB.GetContext(), types.NewChannelTransferStatus(path.EndpointB.ChannelID, sendDisabled))
+			},
+			nil,
+		},
+		{
+			"failure: receiving is disabled for the base denom",
+			func() {
+				suite.chainB.GetSimApp().TransferKeeper.SetDenomStatus(suite.chainB.GetContext(), types.NewDenomTransferStatus(packetData.Tokens[0].Denom.Base, types.NewTransferStatus(false, true)))
+			},
+			types.ErrReceiveDisabled,
+		},
+		{
+			"failure: receiving is disabled for the ibc denom of the received token",
+			func() {
+				denom := types.NewDenom(packetData.Tokens[0].Denom.Base, types.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+				suite.chainB.GetSimApp().TransferKeeper.SetDenomStatus(suite.chainB.GetContext(), types.NewDenomTransferStatus(denom.IBCDenom(), types.NewTransferStatus(false, true)))
+			},
+			types.ErrReceiveDisabled,
+		},
+		{
+			"failure: receiving is disabled for the channel",
+			func() {
+				suite.chainB.GetSimApp().TransferKeeper.SetChannelStatus(suite.chainB.GetContext(), types.NewChannelTransferStatus(path.EndpointB.ChannelID, types.NewTransferStatus(false, true)))
+			},
+			types.ErrReceiveDisabled,
+		},
+		{
+			"failure: receiver is blocked",
+			func() {
+				suite.chainB.GetSimApp().TransferKeeper.SetBlockedReceiver(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress())
+			},
+			ibcerrors.ErrUnauthorized,
+		},
 	}
 
 	for _, tc := range testCases {
 		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
 			suite.SetupTest() // reset
 
-			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
 			path.Setup()
 
 			receiver := suite.chainB.SenderAccount.GetAddress().String() // must be explicitly changed in malleate
@@ -384,7 +478,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket_ReceiverIsNotSource() {
 			suite.Require().NoError(err) // message committed
 
 			token := types.Token{Denom: types.NewDenom(transferMsg.Token.Denom), Amount: transferMsg.Token.Amount.String()}
-			packetData = types.NewInternalTransferRepresentation(token, suite.chainA.SenderAccount.GetAddress().String(), receiver, "")
+			packetData = types.NewInternalTransferRepresentation(types.Tokens{token}, suite.chainA.SenderAccount.GetAddress().String(), receiver, "")
 			sourcePort := path.EndpointA.ChannelConfig.PortID
 			sourceChannel := path.EndpointA.ChannelID
 			destinationPort := path.EndpointB.ChannelConfig.PortID
@@ -454,7 +548,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket_ReceiverIsSource() {
 		{
 			"successful receive of half the amount",
 			func() {
-				packetData.Token.Amount = sdkmath.NewInt(50).String()
+				packetData.Tokens[0].Amount = sdkmath.NewInt(50).String()
 				// expect 50 remaining
 				expEscrowAmount = sdkmath.NewInt(50)
 			},
@@ -463,21 +557,21 @@ func (suite *KeeperTestSuite) TestOnRecvPacket_ReceiverIsSource() {
 		{
 			"failure: empty coin",
 			func() {
-				packetData.Token.Amount = zeroAmount.String()
+				packetData.Tokens[0].Amount = zeroAmount.String()
 			},
 			types.ErrInvalidAmount,
 		},
 		{
 			"failure: tries to unescrow more tokens than allowed",
 			func() {
-				packetData.Token.Amount = sdkmath.NewInt(1000000).String()
+				packetData.Tokens[0].Amount = sdkmath.NewInt(1000000).String()
 			},
 			sdkerrors.ErrInsufficientFunds,
 		},
 		{
 			"failure: empty denom",
 			func() {
-				packetData.Token.Denom = types.Denom{}
+				packetData.Tokens[0].Denom = types.Denom{}
 			},
 			types.ErrInvalidDenomForTransfer,
 		},
@@ -513,7 +607,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket_ReceiverIsSource() {
 			suite.Require().NoError(err) // message committed
 
 			token := types.Token{Denom: types.NewDenom(transferMsg.Token.Denom, types.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)), Amount: transferMsg.Token.Amount.String()}
-			packetData = types.NewInternalTransferRepresentation(token, suite.chainA.SenderAccount.GetAddress().String(), receiver, "")
+			packetData = types.NewInternalTransferRepresentation(types.Tokens{token}, suite.chainA.SenderAccount.GetAddress().String(), receiver, "")
 			sourcePort := path.EndpointB.ChannelConfig.PortID
 			sourceChannel := path.EndpointB.ChannelID
 			destinationPort := path.EndpointA.ChannelConfig.PortID
@@ -597,9 +691,11 @@ func (suite *KeeperTestSuite) TestOnRecvPacketSetsTotalEscrowAmountForSourceIBCT
 	)
 
 	data := types.NewInternalTransferRepresentation(
-		types.Token{
-			Denom:  denom,
-			Amount: amount.String(),
+		types.Tokens{
+			{
+				Denom:  denom,
+				Amount: amount.String(),
+			},
 		}, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "")
 	sourcePort := path2.EndpointA.ChannelConfig.PortID
 	sourceChannel := path2.EndpointA.ChannelID
@@ -726,9 +822,11 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
 			tc.malleate()
 
 			data := types.NewInternalTransferRepresentation(
-				types.Token{
-					Denom:  denom,
-					Amount: amount.String(),
+				types.Tokens{
+					{
+						Denom:  denom,
+						Amount: amount.String(),
+					},
 				}, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "")
 			sourcePort := path.EndpointA.ChannelConfig.PortID
 			sourceChannel := path.EndpointA.ChannelID
@@ -814,9 +912,11 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacketSetsTotalEscrowAmountFo
 	)
 
 	data := types.NewInternalTransferRepresentation(
-		types.Token{
-			Denom:  denom,
-			Amount: amount.String(),
+		types.Tokens{
+			{
+				Denom:  denom,
+				Amount: amount.String(),
+			},
 		},
 		suite.chainB.SenderAccount.GetAddress().String(),
 		suite.chainA.SenderAccount.GetAddress().String(),
@@ -946,9 +1046,11 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 			tc.malleate()
 
 			data := types.NewInternalTransferRepresentation(
-				types.Token{
-					Denom:  denom,
-					Amount: amount,
+				types.Tokens{
+					{
+						Denom:  denom,
+						Amount: amount,
+					},
 				}, sender, suite.chainB.SenderAccount.GetAddress().String(), "")
 			sourcePort := path.EndpointA.ChannelConfig.PortID
 			sourceChannel := path.EndpointA.ChannelID
@@ -1029,9 +1131,11 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacketSetsTotalEscrowAmountForSourceI
 	)
 
 	data := types.NewInternalTransferRepresentation(
-		types.Token{
-			Denom:  denom,
-			Amount: amount.String(),
+		types.Tokens{
+			{
+				Denom:  denom,
+				Amount: amount.String(),
+			},
 		}, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), "")
 	sourcePort := path2.EndpointB.ChannelConfig.PortID
 	sourceChannel := path2.EndpointB.ChannelID
@@ -100,7 +100,7 @@ func (suite *TransferTestSuite) TestHandleMsgTransfer() {
 			// Get the packet data to determine the amount of tokens being transferred (needed for sending entire balance)
 			packetData, err := types.UnmarshalPacketData(packet.GetData(), pathAToB.EndpointA.GetChannel().Version, "")
 			suite.Require().NoError(err)
-			transferAmount, ok := sdkmath.NewIntFromString(packetData.Token.Amount)
+			transferAmount, ok := sdkmath.NewIntFromString(packetData.Tokens[0].Amount)
 			suite.Require().True(ok)
 
 			// relay send
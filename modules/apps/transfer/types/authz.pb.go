@@ -10,15 +10,20 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -39,6 +44,17 @@ type Allocation struct {
 	// allow list of memo strings, an empty list prohibits all memo strings;
 	// a list only with "*" permits any memo string
 	AllowedPacketData []string `protobuf:"bytes,5,rep,name=allowed_packet_data,json=allowedPacketData,proto3" json:"allowed_packet_data,omitempty"`
+	// optional spend limit that resets every period, in addition to the lifetime spend_limit
+	PeriodicSpendLimit *PeriodicSpendLimit `protobuf:"bytes,6,opt,name=periodic_spend_limit,json=periodicSpendLimit,proto3" json:"periodic_spend_limit,omitempty"`
+	// maximum amount per denomination that can be transferred in a single message,
+	// if set, denominations not in the list cannot be transferred
+	MaxTransferAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=max_transfer_amount,json=maxTransferAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_transfer_amount"`
+	// allow list of destination client IDs for IBC v2 transfers, the client IDs of the receiving chain which are the
+	// counterparty of the source client. If set, only IBC v2 transfers which set one of the listed client IDs as
+	// destination_client are allowed, an empty allow list permits any destination client
+	AllowedDestinationClients []string `protobuf:"bytes,8,rep,name=allowed_destination_clients,json=allowedDestinationClients,proto3" json:"allowed_destination_clients,omitempty"`
+	// optional time after which the allocation can no longer be spent
+	Expiration *time.Time `protobuf:"bytes,9,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
 }
 
 func (m *Allocation) Reset()         { *m = Allocation{} }
@@ -109,6 +125,107 @@ func (m *Allocation) GetAllowedPacketData() []string {
 	return nil
 }
 
+func (m *Allocation) GetPeriodicSpendLimit() *PeriodicSpendLimit {
+	if m != nil {
+		return m.PeriodicSpendLimit
+	}
+	return nil
+}
+
+func (m *Allocation) GetMaxTransferAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxTransferAmount
+	}
+	return nil
+}
+
+func (m *Allocation) GetAllowedDestinationClients() []string {
+	if m != nil {
+		return m.AllowedDestinationClients
+	}
+	return nil
+}
+
+func (m *Allocation) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+// PeriodicSpendLimit defines a spend limit that is replenished at the start of every period
+type PeriodicSpendLimit struct {
+	// the duration of a period
+	Period time.Duration `protobuf:"bytes,1,opt,name=period,proto3,stdduration" json:"period"`
+	// the maximum amount of tokens that can be transferred in a period
+	PeriodSpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=period_spend_limit,json=periodSpendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_spend_limit"`
+	// the amount of tokens left to be transferred in the current period
+	PeriodCanSpend github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=period_can_spend,json=periodCanSpend,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_can_spend"`
+	// the time at which the current period ends and period_can_spend is reset to period_spend_limit
+	PeriodReset time.Time `protobuf:"bytes,4,opt,name=period_reset,json=periodReset,proto3,stdtime" json:"period_reset"`
+}
+
+func (m *PeriodicSpendLimit) Reset()         { *m = PeriodicSpendLimit{} }
+func (m *PeriodicSpendLimit) String() string { return proto.CompactTextString(m) }
+func (*PeriodicSpendLimit) ProtoMessage()    {}
+func (*PeriodicSpendLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1a28b55d17325aa, []int{1}
+}
+func (m *PeriodicSpendLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeriodicSpendLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeriodicSpendLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeriodicSpendLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeriodicSpendLimit.Merge(m, src)
+}
+func (m *PeriodicSpendLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeriodicSpendLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeriodicSpendLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeriodicSpendLimit proto.InternalMessageInfo
+
+func (m *PeriodicSpendLimit) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *PeriodicSpendLimit) GetPeriodSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PeriodSpendLimit
+	}
+	return nil
+}
+
+func (m *PeriodicSpendLimit) GetPeriodCanSpend() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PeriodCanSpend
+	}
+	return nil
+}
+
+func (m *PeriodicSpendLimit) GetPeriodReset() time.Time {
+	if m != nil {
+		return m.PeriodReset
+	}
+	return time.Time{}
+}
+
 // TransferAuthorization allows the grantee to spend up to spend_limit coins from
 // the granter's account for ibc transfer on a specific channel
 type TransferAuthorization struct {
@@ -120,7 +237,7 @@ func (m *TransferAuthorization) Reset()         { *m = TransferAuthorization{} }
 func (m *TransferAuthorization) String() string { return proto.CompactTextString(m) }
 func (*TransferAuthorization) ProtoMessage()    {}
 func (*TransferAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1a28b55d17325aa, []int{1}
+	return fileDescriptor_b1a28b55d17325aa, []int{2}
 }
 func (m *TransferAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -158,6 +275,7 @@ func (m *TransferAuthorization) GetAllocations() []Allocation {
 
 func init() {
 	proto.RegisterType((*Allocation)(nil), "ibc.applications.transfer.v1.Allocation")
+	proto.RegisterType((*PeriodicSpendLimit)(nil), "ibc.applications.transfer.v1.PeriodicSpendLimit")
 	proto.RegisterType((*TransferAuthorization)(nil), "ibc.applications.transfer.v1.TransferAuthorization")
 }
 
@@ -166,35 +284,50 @@ func init() {
 }
 
 var fileDescriptor_b1a28b55d17325aa = []byte{
-	// 436 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x9b, 0x75, 0x20, 0xd5, 0x15, 0x48, 0x04, 0x90, 0xb2, 0x09, 0xd2, 0xaa, 0x12, 0x28,
-	0x97, 0xda, 0x0b, 0x5c, 0x10, 0xb7, 0x75, 0x1c, 0x77, 0xa8, 0x22, 0x4e, 0x5c, 0x22, 0xc7, 0x31,
-	0xad, 0x35, 0x37, 0x6f, 0x14, 0xbf, 0x09, 0x62, 0x9f, 0x82, 0x7d, 0x0d, 0xce, 0x7c, 0x88, 0x89,
-	0xd3, 0x8e, 0x9c, 0x00, 0xb5, 0x5f, 0x04, 0xc5, 0xf6, 0xa0, 0x08, 0x89, 0x53, 0xe2, 0xe7, 0x7d,
-	0xfc, 0xfe, 0xf9, 0xf9, 0x25, 0x89, 0x2a, 0x04, 0xe3, 0x75, 0xad, 0x95, 0xe0, 0xa8, 0xa0, 0x32,
-	0x0c, 0x1b, 0x5e, 0x99, 0xf7, 0xb2, 0x61, 0x5d, 0xca, 0x78, 0x8b, 0xeb, 0x4b, 0x5a, 0x37, 0x80,
-	0x10, 0x3e, 0x51, 0x85, 0xa0, 0xfb, 0x4e, 0x7a, 0xeb, 0xa4, 0x5d, 0x7a, 0x7c, 0x24, 0xc0, 0x6c,
-	0xc0, 0xe4, 0xd6, 0xcb, 0xdc, 0xc1, 0x5d, 0x3c, 0x7e, 0xb4, 0x82, 0x15, 0x38, 0xbd, 0xff, 0xf3,
-	0x6a, 0xec, 0x3c, 0xac, 0xe0, 0x46, 0xb2, 0x2e, 0x2d, 0x24, 0xf2, 0x94, 0x09, 0x50, 0x95, 0x8b,
-	0xcf, 0xae, 0x0e, 0x08, 0x39, 0xd5, 0x1a, 0x5c, 0xb1, 0x70, 0x42, 0xc6, 0x06, 0xda, 0x46, 0xc8,
-	0xbc, 0x86, 0x06, 0xa3, 0x60, 0x1a, 0x24, 0xa3, 0x8c, 0x38, 0x69, 0x09, 0x0d, 0x86, 0xcf, 0xc8,
-	0x7d, 0x6f, 0x10, 0x6b, 0x5e, 0x55, 0x52, 0x47, 0x07, 0xd6, 0x73, 0xcf, 0xa9, 0x67, 0x4e, 0x0c,
-	0x35, 0x19, 0x9b, 0x5a, 0x56, 0x65, 0xae, 0xd5, 0x46, 0x61, 0x34, 0x9c, 0x0e, 0x93, 0xf1, 0x8b,
-	0x23, 0xea, 0x1b, 0xee, 0x9b, 0xa1, 0xbe, 0x19, 0x7a, 0x06, 0xaa, 0x5a, 0x9c, 0x5c, 0x7f, 0x9f,
-	0x0c, 0x3e, 0xff, 0x98, 0x24, 0x2b, 0x85, 0xeb, 0xb6, 0xa0, 0x02, 0x36, 0x7e, 0x3a, 0xff, 0x99,
-	0x9b, 0xf2, 0x82, 0xe1, 0xc7, 0x5a, 0x1a, 0x7b, 0xc1, 0x64, 0xc4, 0xe6, 0x3f, 0xef, 0xd3, 0x87,
-	0x4f, 0x09, 0xe1, 0x5a, 0xc3, 0x87, 0x5c, 0x2b, 0x83, 0xd1, 0xe1, 0x74, 0x98, 0x8c, 0xb2, 0x91,
-	0x55, 0xce, 0x95, 0xc1, 0x90, 0x92, 0x87, 0xf6, 0x20, 0xcb, 0xbc, 0xe6, 0xe2, 0x42, 0x62, 0x5e,
-	0x72, 0xe4, 0xd1, 0x1d, 0xeb, 0x7b, 0xe0, 0x43, 0x4b, 0x1b, 0x79, 0xc3, 0x91, 0xcf, 0xae, 0x02,
-	0xf2, 0xf8, 0xad, 0x87, 0x7e, 0xda, 0xe2, 0x1a, 0x1a, 0x75, 0xe9, 0xf0, 0x2c, 0xc9, 0x98, 0xff,
-	0x86, 0x65, 0xa2, 0xc0, 0x8e, 0x95, 0xd0, 0xff, 0x3d, 0x19, 0xfd, 0x43, 0x77, 0x71, 0xd8, 0x4f,
-	0x99, 0xed, 0xa7, 0x78, 0xfd, 0xfc, 0xeb, 0x97, 0xf9, 0xcc, 0x63, 0x71, 0x6b, 0x70, 0xcb, 0xe5,
-	0xaf, 0xca, 0x8b, 0xec, 0x7a, 0x1b, 0x07, 0x37, 0xdb, 0x38, 0xf8, 0xb9, 0x8d, 0x83, 0x4f, 0xbb,
-	0x78, 0x70, 0xb3, 0x8b, 0x07, 0xdf, 0x76, 0xf1, 0xe0, 0xdd, 0xab, 0x7f, 0x91, 0xa9, 0x42, 0xcc,
-	0x57, 0xc0, 0xba, 0xf4, 0x84, 0x6d, 0xa0, 0x6c, 0xb5, 0x34, 0xfd, 0xee, 0xed, 0xed, 0x9c, 0x05,
-	0x59, 0xdc, 0xb5, 0x2b, 0xf0, 0xf2, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe7, 0x77, 0x0f, 0x28,
-	0x9d, 0x02, 0x00, 0x00,
+	// 677 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0x8e, 0x9b, 0xfc, 0xfd, 0x9b, 0x0d, 0x54, 0x74, 0x5b, 0x24, 0xb7, 0x80, 0x13, 0x45, 0x02,
+	0xf9, 0xd2, 0x75, 0x53, 0x2e, 0x08, 0x24, 0x44, 0x93, 0x4a, 0x5c, 0x7a, 0x88, 0x4c, 0x4f, 0x5c,
+	0xac, 0xf5, 0x7a, 0x9b, 0xac, 0x6a, 0x7b, 0x2d, 0xef, 0x3a, 0xb4, 0xe5, 0x15, 0x38, 0x94, 0x1b,
+	0xcf, 0xc0, 0x99, 0x87, 0xa8, 0x38, 0xf5, 0xc8, 0x89, 0xa2, 0xf6, 0x15, 0x78, 0x00, 0xe4, 0xdd,
+	0x75, 0x9b, 0x36, 0x52, 0xb9, 0x94, 0x53, 0xb2, 0x33, 0xdf, 0xcc, 0xb7, 0xf3, 0xed, 0xe7, 0x01,
+	0x2e, 0x0b, 0x89, 0x87, 0xb3, 0x2c, 0x66, 0x04, 0x4b, 0xc6, 0x53, 0xe1, 0xc9, 0x1c, 0xa7, 0x62,
+	0x8f, 0xe6, 0xde, 0xa4, 0xe7, 0xe1, 0x42, 0x8e, 0x8f, 0x50, 0x96, 0x73, 0xc9, 0xe1, 0x63, 0x16,
+	0x12, 0x34, 0x8d, 0x44, 0x15, 0x12, 0x4d, 0x7a, 0x6b, 0xab, 0x84, 0x8b, 0x84, 0x8b, 0x40, 0x61,
+	0x3d, 0x7d, 0xd0, 0x85, 0x6b, 0x2b, 0x23, 0x3e, 0xe2, 0x3a, 0x5e, 0xfe, 0x33, 0x51, 0x47, 0x63,
+	0xbc, 0x10, 0x0b, 0xea, 0x4d, 0x7a, 0x21, 0x95, 0xb8, 0xe7, 0x11, 0xce, 0xd2, 0x2a, 0x3f, 0xe2,
+	0x7c, 0x14, 0x53, 0x4f, 0x9d, 0xc2, 0x62, 0xcf, 0x8b, 0x8a, 0x5c, 0xf1, 0x9a, 0x7c, 0xfb, 0x66,
+	0x5e, 0xb2, 0x84, 0x0a, 0x89, 0x93, 0x4c, 0x03, 0xba, 0xbf, 0x1b, 0x00, 0x6c, 0xc5, 0x31, 0xd7,
+	0xb7, 0x85, 0x6d, 0xd0, 0x12, 0xbc, 0xc8, 0x09, 0x0d, 0x32, 0x9e, 0x4b, 0xdb, 0xea, 0x58, 0x6e,
+	0xd3, 0x07, 0x3a, 0x34, 0xe4, 0xb9, 0x84, 0x4f, 0xc1, 0xa2, 0x01, 0x90, 0x31, 0x4e, 0x53, 0x1a,
+	0xdb, 0x73, 0x0a, 0x73, 0x5f, 0x47, 0x07, 0x3a, 0x08, 0x63, 0xd0, 0x12, 0x19, 0x4d, 0xa3, 0x20,
+	0x66, 0x09, 0x93, 0x76, 0xbd, 0x53, 0x77, 0x5b, 0x9b, 0xab, 0xc8, 0x4c, 0x5c, 0x4e, 0x83, 0xcc,
+	0x34, 0x68, 0xc0, 0x59, 0xda, 0xdf, 0x38, 0xf9, 0xd9, 0xae, 0x7d, 0x3d, 0x6b, 0xbb, 0x23, 0x26,
+	0xc7, 0x45, 0x88, 0x08, 0x4f, 0x8c, 0x3c, 0xe6, 0x67, 0x5d, 0x44, 0xfb, 0x9e, 0x3c, 0xcc, 0xa8,
+	0x50, 0x05, 0xc2, 0x07, 0xaa, 0xff, 0x4e, 0xd9, 0x1e, 0x3e, 0x01, 0x00, 0xc7, 0x31, 0xff, 0x10,
+	0xc4, 0x4c, 0x48, 0xbb, 0xd1, 0xa9, 0xbb, 0x4d, 0xbf, 0xa9, 0x22, 0x3b, 0x4c, 0x48, 0x88, 0xc0,
+	0xb2, 0x3a, 0xd0, 0x28, 0xc8, 0x30, 0xd9, 0xa7, 0x32, 0x88, 0xb0, 0xc4, 0xf6, 0x7f, 0x0a, 0xb7,
+	0x64, 0x52, 0x43, 0x95, 0xd9, 0xc6, 0x12, 0xc3, 0x10, 0xac, 0x64, 0x34, 0x67, 0x3c, 0x62, 0x24,
+	0x98, 0x9e, 0x62, 0xbe, 0x63, 0xb9, 0xad, 0xcd, 0x0d, 0x74, 0xdb, 0x13, 0xa3, 0xa1, 0xa9, 0x7c,
+	0x77, 0x79, 0x3d, 0x1f, 0x66, 0x33, 0x31, 0xf8, 0x11, 0x2c, 0x27, 0xf8, 0x20, 0xa8, 0x2a, 0x03,
+	0x9c, 0xf0, 0x22, 0x95, 0xf6, 0xff, 0x77, 0x2f, 0xd4, 0x52, 0x82, 0x0f, 0x76, 0x0d, 0xcd, 0x96,
+	0x62, 0x81, 0xaf, 0xc1, 0xa3, 0x4a, 0x90, 0x88, 0x0a, 0xc9, 0x52, 0x35, 0x47, 0x40, 0x62, 0x46,
+	0x53, 0x29, 0xec, 0x05, 0x25, 0xcc, 0xaa, 0x81, 0x6c, 0x5f, 0x21, 0x06, 0x1a, 0x00, 0xdf, 0x00,
+	0x40, 0x0f, 0x32, 0xa6, 0x9d, 0x66, 0x37, 0x95, 0x2c, 0x6b, 0x48, 0x5b, 0x0d, 0x55, 0x56, 0x43,
+	0xbb, 0x95, 0xd5, 0xfa, 0x8d, 0xe3, 0xb3, 0xb6, 0xe5, 0x4f, 0xd5, 0x74, 0x3f, 0xd5, 0x01, 0x9c,
+	0x55, 0x0a, 0xbe, 0x02, 0xf3, 0x5a, 0x2b, 0xe5, 0xbc, 0x52, 0x88, 0x9b, 0x4d, 0xb7, 0x8d, 0xbf,
+	0xfb, 0x0b, 0xa5, 0x10, 0x5f, 0xca, 0xbe, 0xa6, 0x04, 0x1e, 0x02, 0x23, 0xf4, 0xb5, 0x47, 0x9b,
+	0xbb, 0x7b, 0x45, 0x1f, 0x68, 0x9a, 0xa9, 0x7b, 0x17, 0xc0, 0xc4, 0x02, 0x82, 0x53, 0x4d, 0xff,
+	0x2f, 0x3c, 0xbf, 0xa8, 0x49, 0x06, 0x38, 0x55, 0xdc, 0xf0, 0x2d, 0xb8, 0x67, 0x68, 0x73, 0x2a,
+	0x68, 0xe9, 0xfc, 0xbf, 0xbd, 0x84, 0x52, 0x4d, 0xbd, 0x46, 0x4b, 0x57, 0xfa, 0x65, 0x61, 0xf7,
+	0xb3, 0x05, 0x1e, 0x5e, 0x7a, 0xa4, 0x90, 0x63, 0x9e, 0xb3, 0x23, 0xbd, 0x10, 0x86, 0xa0, 0x85,
+	0x2f, 0xd7, 0x83, 0xb0, 0x2d, 0x35, 0x94, 0x7b, 0xfb, 0x27, 0x70, 0xb5, 0x4f, 0xfa, 0x8d, 0x92,
+	0xcf, 0x9f, 0x6e, 0xf1, 0xf2, 0xd9, 0xf7, 0x6f, 0xeb, 0x5d, 0x23, 0x8a, 0xde, 0x9c, 0x95, 0x2a,
+	0xd7, 0x98, 0xfb, 0xfe, 0xc9, 0xb9, 0x63, 0x9d, 0x9e, 0x3b, 0xd6, 0xaf, 0x73, 0xc7, 0x3a, 0xbe,
+	0x70, 0x6a, 0xa7, 0x17, 0x4e, 0xed, 0xc7, 0x85, 0x53, 0x7b, 0xff, 0x62, 0x56, 0x30, 0x16, 0x92,
+	0xf5, 0x11, 0xf7, 0x26, 0xbd, 0x0d, 0x2f, 0xe1, 0x51, 0x11, 0x53, 0x51, 0xae, 0xeb, 0xa9, 0x35,
+	0xad, 0x64, 0x0c, 0xe7, 0x95, 0x24, 0xcf, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xda, 0x07, 0x44,
+	0xa3, 0xd0, 0x05, 0x00, 0x00,
 }
 
 func (m *Allocation) Marshal() (dAtA []byte, err error) {
@@ -217,6 +350,51 @@ func (m *Allocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Expiration != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintAuthz(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.AllowedDestinationClients) > 0 {
+		for iNdEx := len(m.AllowedDestinationClients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDestinationClients[iNdEx])
+			copy(dAtA[i:], m.AllowedDestinationClients[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedDestinationClients[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.MaxTransferAmount) > 0 {
+		for iNdEx := len(m.MaxTransferAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxTransferAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.PeriodicSpendLimit != nil {
+		{
+			size, err := m.PeriodicSpendLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.AllowedPacketData) > 0 {
 		for iNdEx := len(m.AllowedPacketData) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedPacketData[iNdEx])
@@ -266,6 +444,73 @@ func (m *Allocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PeriodicSpendLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeriodicSpendLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeriodicSpendLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodReset, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintAuthz(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if len(m.PeriodCanSpend) > 0 {
+		for iNdEx := len(m.PeriodCanSpend) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodCanSpend[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PeriodSpendLimit) > 0 {
+		for iNdEx := len(m.PeriodSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintAuthz(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *TransferAuthorization) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -346,6 +591,51 @@ func (m *Allocation) Size() (n int) {
 			n += 1 + l + sovAuthz(uint64(l))
 		}
 	}
+	if m.PeriodicSpendLimit != nil {
+		l = m.PeriodicSpendLimit.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.MaxTransferAmount) > 0 {
+		for _, e := range m.MaxTransferAmount {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.AllowedDestinationClients) > 0 {
+		for _, s := range m.AllowedDestinationClients {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func (m *PeriodicSpendLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovAuthz(uint64(l))
+	if len(m.PeriodSpendLimit) > 0 {
+		for _, e := range m.PeriodSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.PeriodCanSpend) > 0 {
+		for _, e := range m.PeriodCanSpend {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset)
+	n += 1 + l + sovAuthz(uint64(l))
 	return n
 }
 
@@ -561,6 +851,328 @@ func (m *Allocation) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowedPacketData = append(m.AllowedPacketData, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodicSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PeriodicSpendLimit == nil {
+				m.PeriodicSpendLimit = &PeriodicSpendLimit{}
+			}
+			if err := m.PeriodicSpendLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTransferAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxTransferAmount = append(m.MaxTransferAmount, types.Coin{})
+			if err := m.MaxTransferAmount[len(m.MaxTransferAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDestinationClients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDestinationClients = append(m.AllowedDestinationClients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeriodicSpendLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeriodicSpendLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeriodicSpendLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodSpendLimit = append(m.PeriodSpendLimit, types.Coin{})
+			if err := m.PeriodSpendLimit[len(m.PeriodSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodCanSpend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodCanSpend = append(m.PeriodCanSpend, types.Coin{})
+			if err := m.PeriodCanSpend[len(m.PeriodCanSpend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthz(dAtA[iNdEx:])
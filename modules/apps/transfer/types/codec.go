@@ -18,7 +18,14 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 // RegisterInterfaces register the ibc transfer module interfaces to protobuf
 // Any.
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
-	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgTransfer{}, &MsgUpdateParams{})
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgTransfer{},
+		&MsgUpdateParams{},
+		&MsgSetDenomTransferStatus{},
+		&MsgSetChannelTransferStatus{},
+		&MsgSetReceiverBlocked{},
+	)
 
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
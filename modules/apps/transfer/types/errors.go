@@ -21,4 +21,5 @@ var (
 	ErrAbiEncoding             = errorsmod.Register(ModuleName, 14, "encoding abi failed")
 	ErrAbiDecoding             = errorsmod.Register(ModuleName, 15, "decoding abi failed")
 	ErrReceiveFailed           = errorsmod.Register(ModuleName, 16, "receive packet failed")
+	ErrInvalidForwarding       = errorsmod.Register(ModuleName, 17, "invalid forwarding")
 )
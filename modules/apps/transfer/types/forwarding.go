@@ -0,0 +1,175 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+)
+
+const (
+	// ForwardingMemoKey is the key of the memo JSON object under which the
+	// hops of an IBC v2 transfer to be forwarded are specified.
+	ForwardingMemoKey = "forwarding"
+
+	// MaximumNumberOfForwardingHops is the maximum number of hops a transfer may be forwarded through.
+	MaximumNumberOfForwardingHops = 8
+
+	// DefaultForwardingTimeout is the timeout, relative to the block time of the
+	// intermediate chain, of a packet forwarded to the next hop.
+	DefaultForwardingTimeout = time.Hour
+
+	// forwardAddressVersion provides domain separation between forward and escrow addresses.
+	forwardAddressVersion = "ics20-forwarding"
+)
+
+// Forwarding defines the hops an IBC v2 transfer is forwarded through before
+// reaching its final receiver. Each hop is the client identifier on the
+// intermediate chain of the counterparty the tokens are forwarded to.
+type Forwarding struct {
+	// Hops are the client identifiers on each intermediate chain used to forward the tokens
+	Hops []string `json:"hops"`
+	// Memo is the memo delivered to the final receiver of the transfer
+	Memo string `json:"memo,omitempty"`
+}
+
+// NewForwarding creates a new Forwarding instance given the final memo and a list of hops.
+func NewForwarding(memo string, hops ...string) Forwarding {
+	return Forwarding{
+		Hops: hops,
+		Memo: memo,
+	}
+}
+
+// Validate performs a basic validation of the Forwarding fields.
+func (f Forwarding) Validate() error {
+	if len(f.Hops) == 0 {
+		return errorsmod.Wrap(ErrInvalidForwarding, "hops cannot be empty")
+	}
+
+	if len(f.Hops) > MaximumNumberOfForwardingHops {
+		return errorsmod.Wrapf(ErrInvalidForwarding, "number of hops cannot exceed %d", MaximumNumberOfForwardingHops)
+	}
+
+	for _, hop := range f.Hops {
+		if !clienttypes.IsValidClientID(hop) {
+			return errorsmod.Wrapf(ErrInvalidForwarding, "invalid hop client ID %s", hop)
+		}
+	}
+
+	if len(f.Memo) > MaximumMemoLength {
+		return errorsmod.Wrapf(ErrInvalidMemo, "memo must not exceed %d bytes", MaximumMemoLength)
+	}
+
+	return nil
+}
+
+// NextMemo returns the memo of the packet forwarded to the next hop. If the next hop
+// is the last one, the final memo is returned, otherwise the remaining hops are
+// encoded in the memo under the forwarding key.
+func (f Forwarding) NextMemo() (string, error) {
+	if len(f.Hops) <= 1 {
+		return f.Memo, nil
+	}
+
+	bz, err := json.Marshal(map[string]Forwarding{
+		ForwardingMemoKey: NewForwarding(f.Memo, f.Hops[1:]...),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return string(bz), nil
+}
+
+// GetForwarding interprets the memo as a JSON object and returns the forwarding
+// information stored under the forwarding key. A boolean is returned indicating
+// whether the memo contains forwarding information. An error is returned if the
+// forwarding information is present but malformed or invalid.
+func GetForwarding(memo string) (Forwarding, bool, error) {
+	if len(memo) == 0 {
+		return Forwarding{}, false, nil
+	}
+
+	jsonObject := make(map[string]json.RawMessage)
+	if err := json.Unmarshal([]byte(memo), &jsonObject); err != nil {
+		return Forwarding{}, false, nil
+	}
+
+	forwardingData, found := jsonObject[ForwardingMemoKey]
+	if !found {
+		return Forwarding{}, false, nil
+	}
+
+	var forwarding Forwarding
+	if err := json.Unmarshal(forwardingData, &forwarding); err != nil {
+		return Forwarding{}, true, errorsmod.Wrapf(ErrInvalidForwarding, "failed to unmarshal forwarding: %v", err)
+	}
+
+	if err := forwarding.Validate(); err != nil {
+		return Forwarding{}, true, err
+	}
+
+	return forwarding, true, nil
+}
+
+// GetForwardAddress returns the address holding the tokens received on the specified
+// port and client while they are forwarded to the next hop. The forward address
+// follows the same ADR 028 construction as the escrow address.
+func GetForwardAddress(portID, clientID string) sdk.AccAddress {
+	contents := fmt.Sprintf("%s/%s", portID, clientID)
+
+	preImage := []byte(forwardAddressVersion)
+	preImage = append(preImage, 0)
+	preImage = append(preImage, contents...)
+	hash := sha256.Sum256(preImage)
+	return hash[:20]
+}
+
+// NewForwardedPacket creates a new ForwardedPacket instance.
+func NewForwardedPacket(forwardClientID string, forwardSequence uint64, packet channeltypesv2.Packet) ForwardedPacket {
+	return ForwardedPacket{
+		ForwardClientId: forwardClientID,
+		ForwardSequence: forwardSequence,
+		Packet:          packet,
+	}
+}
+
+// Validate performs a basic validation of the ForwardedPacket fields.
+// NOTE: the timeout of the received packet is not known to the application and is
+// therefore not validated.
+func (fp ForwardedPacket) Validate() error {
+	if err := host.ClientIdentifierValidator(fp.ForwardClientId); err != nil {
+		return err
+	}
+
+	if fp.ForwardSequence == 0 {
+		return errorsmod.Wrap(ErrInvalidForwarding, "forward sequence cannot be 0")
+	}
+
+	if err := host.ClientIdentifierValidator(fp.Packet.SourceClient); err != nil {
+		return errorsmod.Wrap(err, "invalid packet source client ID")
+	}
+
+	if err := host.ClientIdentifierValidator(fp.Packet.DestinationClient); err != nil {
+		return errorsmod.Wrap(err, "invalid packet destination client ID")
+	}
+
+	if fp.Packet.Sequence == 0 {
+		return errorsmod.Wrap(channeltypesv2.ErrInvalidPacket, "packet sequence cannot be 0")
+	}
+
+	if len(fp.Packet.Payloads) != 1 {
+		return errorsmod.Wrapf(channeltypesv2.ErrInvalidPacket, "forwarded packet must contain exactly one payload, got %d", len(fp.Packet.Payloads))
+	}
+
+	return fp.Packet.Payloads[0].ValidateBasic()
+}
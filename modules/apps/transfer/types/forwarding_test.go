@@ -0,0 +1,121 @@
+package types_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+)
+
+func TestGetForwarding(t *testing.T) {
+	testCases := []struct {
+		name          string
+		memo          string
+		expForwarding types.Forwarding
+		expFound      bool
+		expErr        error
+	}{
+		{
+			"success: single hop",
+			`{"forwarding":{"hops":["07-tendermint-0"],"memo":"final"}}`,
+			types.NewForwarding("final", "07-tendermint-0"),
+			true,
+			nil,
+		},
+		{
+			"success: multiple hops",
+			`{"forwarding":{"hops":["07-tendermint-0","07-tendermint-1"]}}`,
+			types.NewForwarding("", "07-tendermint-0", "07-tendermint-1"),
+			true,
+			nil,
+		},
+		{
+			"success: empty memo",
+			"",
+			types.Forwarding{},
+			false,
+			nil,
+		},
+		{
+			"success: memo is not json",
+			"hello",
+			types.Forwarding{},
+			false,
+			nil,
+		},
+		{
+			"success: memo without forwarding key",
+			`{"wasm":{}}`,
+			types.Forwarding{},
+			false,
+			nil,
+		},
+		{
+			"failure: forwarding is not an object",
+			`{"forwarding":"07-tendermint-0"}`,
+			types.Forwarding{},
+			true,
+			types.ErrInvalidForwarding,
+		},
+		{
+			"failure: empty hops",
+			`{"forwarding":{"hops":[]}}`,
+			types.Forwarding{},
+			true,
+			types.ErrInvalidForwarding,
+		},
+		{
+			"failure: invalid hop",
+			`{"forwarding":{"hops":["channel"]}}`,
+			types.Forwarding{},
+			true,
+			types.ErrInvalidForwarding,
+		},
+		{
+			"failure: too many hops",
+			fmt.Sprintf(`{"forwarding":{"hops":[%s]}}`, strings.TrimSuffix(strings.Repeat(`"07-tendermint-0",`, types.MaximumNumberOfForwardingHops+1), ",")),
+			types.Forwarding{},
+			true,
+			types.ErrInvalidForwarding,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			forwarding, found, err := types.GetForwarding(tc.memo)
+			require.Equal(t, tc.expFound, found)
+
+			if tc.expErr == nil {
+				require.NoError(t, err)
+				require.Equal(t, tc.expForwarding, forwarding)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestForwardingNextMemo(t *testing.T) {
+	memo, err := types.NewForwarding("final", "07-tendermint-0").NextMemo()
+	require.NoError(t, err)
+	require.Equal(t, "final", memo)
+
+	memo, err = types.NewForwarding("final", "07-tendermint-0", "07-tendermint-1", "07-tendermint-2").NextMemo()
+	require.NoError(t, err)
+	require.Equal(t, `{"forwarding":{"hops":["07-tendermint-1","07-tendermint-2"],"memo":"final"}}`, memo)
+
+	forwarding, found, err := types.GetForwarding(memo)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, types.NewForwarding("final", "07-tendermint-1", "07-tendermint-2"), forwarding)
+}
+
+// Test that the forward address is distinct from the escrow address of the same port and client
+func TestGetForwardAddress(t *testing.T) {
+	forwardAddress := types.GetForwardAddress(types.PortID, "07-tendermint-0")
+	require.NotEqual(t, types.GetEscrowAddress(types.PortID, "07-tendermint-0"), forwardAddress)
+	require.NotEqual(t, types.GetForwardAddress(types.PortID, "07-tendermint-1"), forwardAddress)
+}
@@ -1,18 +1,22 @@
 package types
 
 import (
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
 )
 
 // NewGenesisState creates a new ibc-transfer GenesisState instance.
-func NewGenesisState(portID string, denoms Denoms, params Params, totalEscrowed sdk.Coins) *GenesisState {
+func NewGenesisState(portID string, denoms Denoms, params Params, totalEscrowed sdk.Coins, forwardedPackets []ForwardedPacket) *GenesisState {
 	return &GenesisState{
-		PortId:        portID,
-		Denoms:        denoms,
-		Params:        params,
-		TotalEscrowed: totalEscrowed,
+		PortId:           portID,
+		Denoms:           denoms,
+		Params:           params,
+		TotalEscrowed:    totalEscrowed,
+		ForwardedPackets: forwardedPackets,
 	}
 }
 
@@ -35,5 +39,58 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Denoms.Validate(); err != nil {
 		return err
 	}
-	return gs.TotalEscrowed.Validate() // will fail if there are duplicates for any denom
+	if err := gs.TotalEscrowed.Validate(); err != nil { // will fail if there are duplicates for any denom
+		return err
+	}
+
+	seenForwards := make(map[string]bool)
+	for _, forwardedPacket := range gs.ForwardedPackets {
+		if err := forwardedPacket.Validate(); err != nil {
+			return err
+		}
+
+		key := string(PacketForwardKey(forwardedPacket.ForwardClientId, forwardedPacket.ForwardSequence))
+		if seenForwards[key] {
+			return errorsmod.Wrapf(ErrInvalidForwarding, "duplicate forwarded packet for client %s and sequence %d", forwardedPacket.ForwardClientId, forwardedPacket.ForwardSequence)
+		}
+		seenForwards[key] = true
+	}
+
+	seenDenoms := make(map[string]bool)
+	for _, denomStatus := range gs.DenomTransferStatuses {
+		if err := denomStatus.Validate(); err != nil {
+			return err
+		}
+
+		if seenDenoms[denomStatus.Denom] {
+			return errorsmod.Wrapf(ErrInvalidDenomForTransfer, "duplicate transfer status for denom %s", denomStatus.Denom)
+		}
+		seenDenoms[denomStatus.Denom] = true
+	}
+
+	seenChannels := make(map[string]bool)
+	for _, channelStatus := range gs.ChannelTransferStatuses {
+		if err := channelStatus.Validate(); err != nil {
+			return err
+		}
+
+		if seenChannels[channelStatus.ChannelOrClientId] {
+			return errorsmod.Wrapf(host.ErrInvalidID, "duplicate transfer status for %s", channelStatus.ChannelOrClientId)
+		}
+		seenChannels[channelStatus.ChannelOrClientId] = true
+	}
+
+	seenReceivers := make(map[string]bool)
+	for _, receiver := range gs.BlockedReceivers {
+		if err := validateBlockedReceiver(receiver); err != nil {
+			return err
+		}
+
+		if seenReceivers[receiver] {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "duplicate blocked receiver %s", receiver)
+		}
+		seenReceivers[receiver] = true
+	}
+
+	return nil
 }
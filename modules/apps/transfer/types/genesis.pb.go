@@ -9,6 +9,7 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	types1 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -33,6 +34,15 @@ type GenesisState struct {
 	// total_escrowed contains the total amount of tokens escrowed
 	// by the transfer module
 	TotalEscrowed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=total_escrowed,json=totalEscrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_escrowed"`
+	// forwarded_packets contains the IBC v2 packets awaiting the acknowledgement of the
+	// packet forwarded to the next hop
+	ForwardedPackets []ForwardedPacket `protobuf:"bytes,5,rep,name=forwarded_packets,json=forwardedPackets,proto3" json:"forwarded_packets"`
+	// denom_transfer_statuses contains the denominations for which sending or receiving is disabled
+	DenomTransferStatuses []DenomTransferStatus `protobuf:"bytes,6,rep,name=denom_transfer_statuses,json=denomTransferStatuses,proto3" json:"denom_transfer_statuses"`
+	// channel_transfer_statuses contains the channels and clients for which sending or receiving is disabled
+	ChannelTransferStatuses []ChannelTransferStatus `protobuf:"bytes,7,rep,name=channel_transfer_statuses,json=channelTransferStatuses,proto3" json:"channel_transfer_statuses"`
+	// blocked_receivers contains the receiver addresses which are blocked from receiving transfers
+	BlockedReceivers []string `protobuf:"bytes,8,rep,name=blocked_receivers,json=blockedReceivers,proto3" json:"blocked_receivers,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -96,8 +106,103 @@ func (m *GenesisState) GetTotalEscrowed() github_com_cosmos_cosmos_sdk_types.Coi
 	return nil
 }
 
+func (m *GenesisState) GetForwardedPackets() []ForwardedPacket {
+	if m != nil {
+		return m.ForwardedPackets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenomTransferStatuses() []DenomTransferStatus {
+	if m != nil {
+		return m.DenomTransferStatuses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChannelTransferStatuses() []ChannelTransferStatus {
+	if m != nil {
+		return m.ChannelTransferStatuses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBlockedReceivers() []string {
+	if m != nil {
+		return m.BlockedReceivers
+	}
+	return nil
+}
+
+// ForwardedPacket defines an IBC v2 packet which was received and forwarded to the next hop.
+// The acknowledgement of the received packet is written once the forwarded packet is
+// acknowledged or times out.
+type ForwardedPacket struct {
+	// forward_client_id is the source client of the forwarded packet
+	ForwardClientId string `protobuf:"bytes,1,opt,name=forward_client_id,json=forwardClientId,proto3" json:"forward_client_id,omitempty"`
+	// forward_sequence is the sequence of the forwarded packet
+	ForwardSequence uint64 `protobuf:"varint,2,opt,name=forward_sequence,json=forwardSequence,proto3" json:"forward_sequence,omitempty"`
+	// packet is the received packet awaiting the acknowledgement of the forwarded packet
+	Packet types1.Packet `protobuf:"bytes,3,opt,name=packet,proto3" json:"packet"`
+}
+
+func (m *ForwardedPacket) Reset()         { *m = ForwardedPacket{} }
+func (m *ForwardedPacket) String() string { return proto.CompactTextString(m) }
+func (*ForwardedPacket) ProtoMessage()    {}
+func (*ForwardedPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4f788affd5bea89, []int{1}
+}
+func (m *ForwardedPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardedPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardedPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForwardedPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardedPacket.Merge(m, src)
+}
+func (m *ForwardedPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardedPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardedPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardedPacket proto.InternalMessageInfo
+
+func (m *ForwardedPacket) GetForwardClientId() string {
+	if m != nil {
+		return m.ForwardClientId
+	}
+	return ""
+}
+
+func (m *ForwardedPacket) GetForwardSequence() uint64 {
+	if m != nil {
+		return m.ForwardSequence
+	}
+	return 0
+}
+
+func (m *ForwardedPacket) GetPacket() types1.Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return types1.Packet{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.applications.transfer.v1.GenesisState")
+	proto.RegisterType((*ForwardedPacket)(nil), "ibc.applications.transfer.v1.ForwardedPacket")
 }
 
 func init() {
@@ -105,31 +210,45 @@ func init() {
 }
 
 var fileDescriptor_a4f788affd5bea89 = []byte{
-	// 374 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4e, 0xe3, 0x30,
-	0x10, 0x86, 0x93, 0xb6, 0xca, 0x6a, 0xd3, 0xdd, 0x1e, 0xa2, 0x95, 0x36, 0x5b, 0xad, 0xd2, 0x0a,
-	0x38, 0x44, 0xa0, 0xda, 0x4d, 0xb9, 0x70, 0x0e, 0x20, 0x84, 0xb8, 0xa0, 0x70, 0xe3, 0x52, 0x39,
-	0x8e, 0x09, 0x56, 0x9b, 0x4c, 0x14, 0xbb, 0x41, 0xbc, 0x05, 0xcf, 0x81, 0x78, 0x90, 0x1e, 0x7b,
-	0xe4, 0x04, 0xa8, 0x7d, 0x11, 0x14, 0x27, 0x45, 0x95, 0x90, 0x72, 0xf2, 0x8c, 0xfd, 0xcd, 0x3f,
-	0xe3, 0x7f, 0xcc, 0x43, 0x1e, 0x52, 0x4c, 0xb2, 0x6c, 0xce, 0x29, 0x91, 0x1c, 0x52, 0x81, 0x65,
-	0x4e, 0x52, 0x71, 0xc7, 0x72, 0x5c, 0x78, 0x38, 0x66, 0x29, 0x13, 0x5c, 0xa0, 0x2c, 0x07, 0x09,
-	0xd6, 0x7f, 0x1e, 0x52, 0xb4, 0xcb, 0xa2, 0x2d, 0x8b, 0x0a, 0xaf, 0x7f, 0xd4, 0xa8, 0xf4, 0x45,
-	0x2a, 0xa9, 0xbe, 0xdb, 0x0c, 0xc3, 0x8c, 0xa5, 0x35, 0xe9, 0x50, 0x10, 0x09, 0x08, 0x1c, 0x12,
-	0xc1, 0x70, 0xe1, 0x85, 0x4c, 0x12, 0x0f, 0x53, 0xe0, 0xdb, 0xf7, 0x3f, 0x31, 0xc4, 0xa0, 0x42,
-	0x5c, 0x46, 0xd5, 0xed, 0xde, 0x4b, 0xcb, 0xfc, 0x75, 0x51, 0x0d, 0x7f, 0x23, 0x89, 0x64, 0xd6,
-	0x5f, 0xf3, 0x47, 0x06, 0xb9, 0x9c, 0xf2, 0xc8, 0xd6, 0x87, 0xba, 0xfb, 0x33, 0x30, 0xca, 0xf4,
-	0x32, 0xb2, 0xae, 0x4c, 0x23, 0x62, 0x29, 0x24, 0xc2, 0x6e, 0x0d, 0xdb, 0x6e, 0x77, 0xb2, 0x8f,
-	0x9a, 0x7e, 0x89, 0xce, 0x4a, 0xd6, 0xef, 0x2d, 0xdf, 0x06, 0xda, 0xf3, 0xfb, 0xc0, 0x50, 0xa9,
-	0x08, 0x6a, 0x09, 0xcb, 0x37, 0x8d, 0x8c, 0xe4, 0x24, 0x11, 0x76, 0x7b, 0xa8, 0xbb, 0xdd, 0xc9,
-	0x41, 0xb3, 0xd8, 0xb5, 0x62, 0xfd, 0x4e, 0xa9, 0x16, 0xd4, 0x95, 0x56, 0x6e, 0xf6, 0x24, 0x48,
-	0x32, 0x9f, 0x32, 0x41, 0x73, 0x78, 0x60, 0x91, 0xdd, 0x51, 0x83, 0xfd, 0x43, 0x95, 0x13, 0xa8,
-	0x74, 0x02, 0xd5, 0x4e, 0xa0, 0x53, 0xe0, 0xa9, 0x3f, 0xae, 0xc7, 0x71, 0x63, 0x2e, 0xef, 0x17,
-	0x21, 0xa2, 0x90, 0xe0, 0xda, 0xb6, 0xea, 0x18, 0x89, 0x68, 0x86, 0xe5, 0x63, 0xc6, 0x84, 0x2a,
-	0x10, 0xc1, 0x6f, 0xd5, 0xe2, 0xbc, 0xee, 0xe0, 0x07, 0xcb, 0xb5, 0xa3, 0xaf, 0xd6, 0x8e, 0xfe,
-	0xb1, 0x76, 0xf4, 0xa7, 0x8d, 0xa3, 0xad, 0x36, 0x8e, 0xf6, 0xba, 0x71, 0xb4, 0xdb, 0x93, 0xef,
-	0x92, 0x3c, 0xa4, 0xa3, 0x18, 0x70, 0xe1, 0x8d, 0x71, 0x02, 0xd1, 0x62, 0xce, 0x44, 0xb9, 0xc9,
-	0x9d, 0x0d, 0xaa, 0x46, 0xa1, 0xa1, 0x36, 0x71, 0xfc, 0x19, 0x00, 0x00, 0xff, 0xff, 0x83, 0x55,
-	0x07, 0xb2, 0x62, 0x02, 0x00, 0x00,
+	// 594 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x4e, 0xdc, 0x3c,
+	0x14, 0x9d, 0xf0, 0x33, 0x7c, 0x84, 0xaf, 0xfc, 0x44, 0xad, 0x08, 0xb4, 0x0a, 0x23, 0xda, 0x45,
+	0x00, 0x61, 0x33, 0xc3, 0xa6, 0xdd, 0x0e, 0xfd, 0x11, 0xea, 0x06, 0x85, 0xae, 0xba, 0x49, 0x1d,
+	0xe7, 0x32, 0x58, 0x93, 0x89, 0x53, 0x5f, 0x4f, 0x10, 0x6f, 0xd1, 0x77, 0x60, 0xd7, 0x27, 0x61,
+	0xc9, 0xb2, 0xab, 0xb6, 0x82, 0x17, 0xa9, 0xe2, 0x71, 0x10, 0x14, 0x14, 0x75, 0x95, 0xf8, 0xde,
+	0x73, 0xee, 0xf1, 0x3d, 0xbe, 0xb6, 0xbb, 0x2d, 0x12, 0x4e, 0x59, 0x51, 0x64, 0x82, 0x33, 0x2d,
+	0x64, 0x8e, 0x54, 0x2b, 0x96, 0xe3, 0x09, 0x28, 0x5a, 0x76, 0xe9, 0x00, 0x72, 0x40, 0x81, 0xa4,
+	0x50, 0x52, 0x4b, 0xef, 0x85, 0x48, 0x38, 0xb9, 0x8b, 0x25, 0x35, 0x96, 0x94, 0xdd, 0xf5, 0x9d,
+	0xc6, 0x4a, 0xb7, 0x48, 0x53, 0x6a, 0x3d, 0x6c, 0x06, 0xcb, 0x21, 0xe4, 0x16, 0xb9, 0xd5, 0x88,
+	0x2c, 0x64, 0x26, 0xf8, 0xb9, 0x85, 0x76, 0x2a, 0x28, 0x97, 0x0a, 0x28, 0x3f, 0x65, 0x79, 0x0e,
+	0x19, 0x2d, 0x7b, 0xb4, 0x60, 0x7c, 0x08, 0xda, 0x22, 0x02, 0x2e, 0x71, 0x24, 0x91, 0x26, 0x0c,
+	0x81, 0x96, 0xdd, 0x04, 0x34, 0xeb, 0x52, 0x2e, 0x45, 0x2d, 0xf6, 0x74, 0x20, 0x07, 0xd2, 0xfc,
+	0xd2, 0xea, 0x6f, 0x12, 0xdd, 0xbc, 0x98, 0x75, 0xff, 0xff, 0x30, 0x71, 0xe2, 0x58, 0x33, 0x0d,
+	0xde, 0xaa, 0x3b, 0x57, 0x48, 0xa5, 0x63, 0x91, 0xfa, 0x4e, 0xc7, 0x09, 0xe7, 0xa3, 0x76, 0xb5,
+	0x3c, 0x4c, 0xbd, 0x8f, 0x6e, 0x3b, 0x85, 0x5c, 0x8e, 0xd0, 0x9f, 0xea, 0x4c, 0x87, 0x0b, 0xbd,
+	0x97, 0xa4, 0xc9, 0x32, 0xf2, 0xb6, 0xc2, 0xf6, 0x17, 0x2f, 0x7f, 0x6e, 0xb4, 0xbe, 0xff, 0xda,
+	0x68, 0x9b, 0x25, 0x46, 0xb6, 0x84, 0xd7, 0x77, 0xdb, 0x05, 0x53, 0x6c, 0x84, 0xfe, 0x74, 0xc7,
+	0x09, 0x17, 0x7a, 0xaf, 0x9a, 0x8b, 0x1d, 0x19, 0x6c, 0x7f, 0xa6, 0xaa, 0x16, 0x59, 0xa6, 0xa7,
+	0xdc, 0x45, 0x2d, 0x35, 0xcb, 0x62, 0x40, 0xae, 0xe4, 0x19, 0xa4, 0xfe, 0x8c, 0xd9, 0xd8, 0x1a,
+	0x99, 0x38, 0x41, 0x2a, 0x27, 0x88, 0x75, 0x82, 0x1c, 0x48, 0x91, 0xf7, 0xf7, 0xec, 0x76, 0xc2,
+	0x81, 0xd0, 0xa7, 0xe3, 0x84, 0x70, 0x39, 0xa2, 0xd6, 0xb6, 0xc9, 0x67, 0x17, 0xd3, 0x21, 0xd5,
+	0xe7, 0x05, 0xa0, 0x21, 0x60, 0xf4, 0xc4, 0x48, 0xbc, 0xb3, 0x0a, 0xde, 0x17, 0x77, 0xe5, 0x44,
+	0xaa, 0x33, 0xa6, 0x52, 0x48, 0xe3, 0x89, 0xfd, 0xe8, 0xcf, 0x1a, 0xd9, 0xdd, 0xe6, 0x16, 0xde,
+	0xd7, 0xb4, 0x23, 0xc3, 0xb2, 0xbd, 0x2c, 0x9f, 0xdc, 0x0f, 0xa3, 0x27, 0xdd, 0x55, 0xe3, 0x51,
+	0x5c, 0x93, 0x63, 0xd4, 0x4c, 0x8f, 0x11, 0xd0, 0x6f, 0x1b, 0x9d, 0xee, 0x3f, 0xf8, 0xfe, 0xc9,
+	0x06, 0x8e, 0x0d, 0xd5, 0x6a, 0x3d, 0x4b, 0x1f, 0xa6, 0x00, 0xbd, 0xb1, 0xbb, 0x66, 0x47, 0xea,
+	0x11, 0xc9, 0x39, 0x23, 0xb9, 0xdf, 0x2c, 0x79, 0x30, 0xa1, 0x3f, 0x2a, 0xba, 0xca, 0x1f, 0x4b,
+	0x02, 0x7a, 0x3b, 0xee, 0x4a, 0x92, 0x49, 0x3e, 0x84, 0x34, 0x56, 0xc0, 0x41, 0x94, 0xa0, 0xd0,
+	0xff, 0xaf, 0x33, 0x1d, 0xce, 0x47, 0xcb, 0x36, 0x11, 0xd5, 0xf1, 0xcd, 0x0b, 0xc7, 0x5d, 0xfa,
+	0xcb, 0x40, 0x6f, 0xfb, 0xf6, 0x28, 0x62, 0x9e, 0x09, 0xc8, 0xef, 0x8c, 0xec, 0x92, 0x4d, 0x1c,
+	0x98, 0xf8, 0x61, 0xea, 0x6d, 0xb9, 0xb5, 0xd1, 0x31, 0xc2, 0xd7, 0x31, 0xe4, 0x1c, 0xfc, 0xa9,
+	0x8e, 0x13, 0xce, 0xdc, 0x42, 0x8f, 0x6d, 0xd8, 0x7b, 0x53, 0x4d, 0x66, 0x25, 0x60, 0x27, 0xf3,
+	0xb9, 0xe9, 0xbd, 0xba, 0x79, 0xc4, 0xb6, 0x42, 0xca, 0x1e, 0xb9, 0x77, 0x88, 0x96, 0xd0, 0x8f,
+	0x2e, 0xaf, 0x03, 0xe7, 0xea, 0x3a, 0x70, 0x7e, 0x5f, 0x07, 0xce, 0xb7, 0x9b, 0xa0, 0x75, 0x75,
+	0x13, 0xb4, 0x7e, 0xdc, 0x04, 0xad, 0xcf, 0xaf, 0x1f, 0xce, 0x9b, 0x48, 0xf8, 0xee, 0x40, 0xd2,
+	0xb2, 0xbb, 0x47, 0x47, 0x32, 0x1d, 0x67, 0x80, 0xd5, 0x4b, 0x70, 0xe7, 0x05, 0x30, 0x53, 0x98,
+	0xb4, 0xcd, 0x35, 0xdd, 0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0xac, 0x0c, 0x0f, 0x59, 0xcc, 0x04,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -152,6 +271,57 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlockedReceivers) > 0 {
+		for iNdEx := len(m.BlockedReceivers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockedReceivers[iNdEx])
+			copy(dAtA[i:], m.BlockedReceivers[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.BlockedReceivers[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ChannelTransferStatuses) > 0 {
+		for iNdEx := len(m.ChannelTransferStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelTransferStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.DenomTransferStatuses) > 0 {
+		for iNdEx := len(m.DenomTransferStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomTransferStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ForwardedPackets) > 0 {
+		for iNdEx := len(m.ForwardedPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForwardedPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.TotalEscrowed) > 0 {
 		for iNdEx := len(m.TotalEscrowed) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -200,6 +370,51 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ForwardedPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardedPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardedPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ForwardSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ForwardSequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ForwardClientId) > 0 {
+		i -= len(m.ForwardClientId)
+		copy(dAtA[i:], m.ForwardClientId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ForwardClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -235,6 +450,48 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ForwardedPackets) > 0 {
+		for _, e := range m.ForwardedPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomTransferStatuses) > 0 {
+		for _, e := range m.DenomTransferStatuses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChannelTransferStatuses) > 0 {
+		for _, e := range m.ChannelTransferStatuses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BlockedReceivers) > 0 {
+		for _, s := range m.BlockedReceivers {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ForwardedPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ForwardClientId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.ForwardSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.ForwardSequence))
+	}
+	l = m.Packet.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -406,6 +663,274 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardedPackets = append(m.ForwardedPackets, ForwardedPacket{})
+			if err := m.ForwardedPackets[len(m.ForwardedPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomTransferStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomTransferStatuses = append(m.DenomTransferStatuses, DenomTransferStatus{})
+			if err := m.DenomTransferStatuses[len(m.DenomTransferStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelTransferStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelTransferStatuses = append(m.ChannelTransferStatuses, ChannelTransferStatus{})
+			if err := m.ChannelTransferStatuses[len(m.ChannelTransferStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedReceivers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedReceivers = append(m.BlockedReceivers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForwardedPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForwardedPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForwardedPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardSequence", wireType)
+			}
+			m.ForwardSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwardSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -6,10 +6,18 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
 	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
 )
 
 func TestValidateGenesis(t *testing.T) {
+	payload := channeltypesv2.NewPayload(types.PortID, types.PortID, types.V1, types.EncodingJSON, []byte("data"))
+	forwardedPacket := types.NewForwardedPacket("07-tendermint-1", 1, channeltypesv2.NewPacket(1, "07-tendermint-0", "07-tendermint-2", 0, payload))
+	denomStatus := types.NewDenomTransferStatus("uatom", types.NewTransferStatus(true, true))
+	channelStatus := types.NewChannelTransferStatus("07-tendermint-0", types.NewTransferStatus(false, true))
+
 	testCases := []struct {
 		name     string
 		genState *types.GenesisState
@@ -34,6 +42,88 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			host.ErrInvalidID,
 		},
+		{
+			"valid genesis with forwarded packets",
+			&types.GenesisState{
+				PortId:           types.PortID,
+				ForwardedPackets: []types.ForwardedPacket{forwardedPacket},
+			},
+			nil,
+		},
+		{
+			"invalid forwarded packet",
+			&types.GenesisState{
+				PortId:           types.PortID,
+				ForwardedPackets: []types.ForwardedPacket{types.NewForwardedPacket("07-tendermint-1", 0, forwardedPacket.Packet)},
+			},
+			types.ErrInvalidForwarding,
+		},
+		{
+			"duplicate forwarded packets",
+			&types.GenesisState{
+				PortId:           types.PortID,
+				ForwardedPackets: []types.ForwardedPacket{forwardedPacket, forwardedPacket},
+			},
+			types.ErrInvalidForwarding,
+		},
+		{
+			"valid genesis with transfer policies",
+			&types.GenesisState{
+				PortId:                  types.PortID,
+				DenomTransferStatuses:   []types.DenomTransferStatus{denomStatus},
+				ChannelTransferStatuses: []types.ChannelTransferStatus{channelStatus},
+				BlockedReceivers:        []string{ibctesting.TestAccAddress},
+			},
+			nil,
+		},
+		{
+			"invalid denom transfer status",
+			&types.GenesisState{
+				PortId:                types.PortID,
+				DenomTransferStatuses: []types.DenomTransferStatus{types.NewDenomTransferStatus("", denomStatus.Status)},
+			},
+			types.ErrInvalidDenomForTransfer,
+		},
+		{
+			"duplicate denom transfer statuses",
+			&types.GenesisState{
+				PortId:                types.PortID,
+				DenomTransferStatuses: []types.DenomTransferStatus{denomStatus, denomStatus},
+			},
+			types.ErrInvalidDenomForTransfer,
+		},
+		{
+			"invalid channel transfer status",
+			&types.GenesisState{
+				PortId:                  types.PortID,
+				ChannelTransferStatuses: []types.ChannelTransferStatus{types.NewChannelTransferStatus("(invalid)", channelStatus.Status)},
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"duplicate channel transfer statuses",
+			&types.GenesisState{
+				PortId:                  types.PortID,
+				ChannelTransferStatuses: []types.ChannelTransferStatus{channelStatus, channelStatus},
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid blocked receiver",
+			&types.GenesisState{
+				PortId:           types.PortID,
+				BlockedReceivers: []string{"invalid"},
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"duplicate blocked receivers",
+			&types.GenesisState{
+				PortId:           types.PortID,
+				BlockedReceivers: []string{ibctesting.TestAccAddress, ibctesting.TestAccAddress},
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -36,6 +36,10 @@ const (
 	// V1 defines first version of the IBC transfer module
 	V1 = "ics20-1"
 
+	// V2 defines the version of the IBC transfer module which transfers multiple tokens in a single packet.
+	// It is only supported for IBC v2 payloads.
+	V2 = "ics20-2"
+
 	// escrowAddressVersion should remain as ics20-1 to avoid the address changing.
 	// this address has been reasoned about to avoid collisions with other addresses
 	// https://github.com/cosmos/cosmos-sdk/issues/7737#issuecomment-735671951
@@ -49,6 +53,14 @@ var (
 	DenomTraceKey = []byte{0x02}
 	// DenomKey defines the key to store the token denomination in store
 	DenomKey = []byte{0x03}
+	// ForwardedPacketKey defines the key to store the IBC v2 packets awaiting the acknowledgement of a forwarded packet
+	ForwardedPacketKey = []byte{0x04}
+	// DenomTransferStatusKey defines the key to store the transfer status of a denomination
+	DenomTransferStatusKey = []byte{0x05}
+	// ChannelTransferStatusKey defines the key to store the transfer status of a channel or client
+	ChannelTransferStatusKey = []byte{0x06}
+	// BlockedReceiverKey defines the key to store the receiver addresses which are blocked from receiving transfers
+	BlockedReceiverKey = []byte{0x07}
 
 	// SupportedVersions defines all versions that are supported by the module
 	SupportedVersions = []string{V1}
@@ -75,3 +87,10 @@ func GetEscrowAddress(portID, channelID string) sdk.AccAddress {
 func TotalEscrowForDenomKey(denom string) []byte {
 	return fmt.Appendf(nil, "%s/%s", KeyTotalEscrowPrefix, denom)
 }
+
+// PacketForwardKey returns the key, relative to the ForwardedPacketKey prefix, under which
+// the packet awaiting the acknowledgement of the forwarded packet with the given client ID
+// and sequence is stored.
+func PacketForwardKey(clientID string, sequence uint64) []byte {
+	return fmt.Appendf(nil, "%s/%d", clientID, sequence)
+}
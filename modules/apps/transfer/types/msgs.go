@@ -15,13 +15,20 @@ import (
 const (
 	MaximumReceiverLength = 2048  // maximum length of the receiver address in bytes (value chosen arbitrarily)
 	MaximumMemoLength     = 32768 // maximum length of the memo in bytes (value chosen arbitrarily)
+	MaximumTokensLength   = 100   // maximum number of tokens that can be transferred in a single packet (value chosen arbitrarily)
 )
 
 var (
 	_ sdk.Msg              = (*MsgUpdateParams)(nil)
 	_ sdk.Msg              = (*MsgTransfer)(nil)
+	_ sdk.Msg              = (*MsgSetDenomTransferStatus)(nil)
+	_ sdk.Msg              = (*MsgSetChannelTransferStatus)(nil)
+	_ sdk.Msg              = (*MsgSetReceiverBlocked)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
 	_ sdk.HasValidateBasic = (*MsgTransfer)(nil)
+	_ sdk.HasValidateBasic = (*MsgSetDenomTransferStatus)(nil)
+	_ sdk.HasValidateBasic = (*MsgSetChannelTransferStatus)(nil)
+	_ sdk.HasValidateBasic = (*MsgSetReceiverBlocked)(nil)
 )
 
 // NewMsgUpdateParams creates a new MsgUpdateParams instance
@@ -42,6 +49,63 @@ func (msg MsgUpdateParams) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgSetDenomTransferStatus creates a new MsgSetDenomTransferStatus instance
+func NewMsgSetDenomTransferStatus(signer, denom string, status TransferStatus) *MsgSetDenomTransferStatus {
+	return &MsgSetDenomTransferStatus{
+		Signer: signer,
+		Denom:  denom,
+		Status: status,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgSetDenomTransferStatus) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return validateStatusDenom(msg.Denom)
+}
+
+// NewMsgSetChannelTransferStatus creates a new MsgSetChannelTransferStatus instance
+func NewMsgSetChannelTransferStatus(signer, channelOrClientID string, status TransferStatus) *MsgSetChannelTransferStatus {
+	return &MsgSetChannelTransferStatus{
+		Signer:            signer,
+		ChannelOrClientId: channelOrClientID,
+		Status:            status,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgSetChannelTransferStatus) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return validateStatusChannelOrClientID(msg.ChannelOrClientId)
+}
+
+// NewMsgSetReceiverBlocked creates a new MsgSetReceiverBlocked instance
+func NewMsgSetReceiverBlocked(signer, receiver string, blocked bool) *MsgSetReceiverBlocked {
+	return &MsgSetReceiverBlocked{
+		Signer:   signer,
+		Receiver: receiver,
+		Blocked:  blocked,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgSetReceiverBlocked) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return validateBlockedReceiver(msg.Receiver)
+}
+
 // NewMsgTransfer creates a new MsgTransfer instance
 func NewMsgTransfer(
 	sourcePort, sourceChannel string,
@@ -82,6 +146,26 @@ func NewMsgTransferWithEncoding(
 	}
 }
 
+// NewMsgTransferWithTokens creates a new MsgTransfer instance transferring multiple tokens in a single
+// ICS20 v2 packet with the provided encoding. Multiple tokens can only be transferred with the IBC v2 protocol.
+func NewMsgTransferWithTokens(
+	sourcePort, sourceClient string,
+	tokens []sdk.Coin, sender, receiver string,
+	timeoutTimestamp uint64,
+	memo string, encoding string,
+) *MsgTransfer {
+	return &MsgTransfer{
+		SourcePort:       sourcePort,
+		SourceChannel:    sourceClient,
+		Tokens:           tokens,
+		Sender:           sender,
+		Receiver:         receiver,
+		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             memo,
+		Encoding:         encoding,
+	}
+}
+
 // ValidateBasic performs a basic check of the MsgTransfer fields.
 // NOTE: If you are sending with V1 protocol, timeoutHeight or timeoutTimestamp must be non-zero,
 // if you are sending with V2 protocol, timeoutTimestamp must be non-zero and timeoutHeight must be zero
@@ -92,8 +176,8 @@ func (msg MsgTransfer) ValidateBasic() error {
 		return err
 	}
 
-	if !isValidIBCCoin(msg.Token) {
-		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, msg.Token.String())
+	if err := msg.validateCoins(); err != nil {
+		return err
 	}
 
 	_, err := sdk.AccAddressFromBech32(msg.Sender)
@@ -113,6 +197,48 @@ func (msg MsgTransfer) ValidateBasic() error {
 	return nil
 }
 
+// GetCoins returns the coins to be transferred: the tokens if they are set or the token otherwise.
+func (msg MsgTransfer) GetCoins() []sdk.Coin {
+	if len(msg.Tokens) > 0 {
+		return msg.Tokens
+	}
+
+	return []sdk.Coin{msg.Token}
+}
+
+// validateCoins checks that exactly one of token or tokens is set and that the coins to be transferred are valid.
+func (msg MsgTransfer) validateCoins() error {
+	if len(msg.Tokens) == 0 {
+		if !isValidIBCCoin(msg.Token) {
+			return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, msg.Token.String())
+		}
+
+		return nil
+	}
+
+	if msg.Token.Denom != "" || (!msg.Token.Amount.IsNil() && !msg.Token.Amount.IsZero()) {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, "only one of token or tokens can be set")
+	}
+
+	if len(msg.Tokens) > MaximumTokensLength {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "number of tokens must not exceed %d", MaximumTokensLength)
+	}
+
+	seen := make(map[string]bool, len(msg.Tokens))
+	for _, coin := range msg.Tokens {
+		if !isValidIBCCoin(coin) {
+			return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, coin.String())
+		}
+
+		if seen[coin.Denom] {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "duplicate token denomination %s", coin.Denom)
+		}
+		seen[coin.Denom] = true
+	}
+
+	return nil
+}
+
 // validateIdentifiers checks if the source port and channel identifiers are valid
 func (msg MsgTransfer) validateIdentifiers() error {
 	if err := host.PortIdentifierValidator(msg.SourcePort); err != nil {
@@ -121,6 +247,11 @@ func (msg MsgTransfer) validateIdentifiers() error {
 	if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
 		return errorsmod.Wrapf(err, "invalid source channel ID %s", msg.SourceChannel)
 	}
+	if msg.DestinationClient != "" {
+		if err := host.ClientIdentifierValidator(msg.DestinationClient); err != nil {
+			return errorsmod.Wrapf(err, "invalid destination client ID %s", msg.DestinationClient)
+		}
+	}
 
 	return nil
 }
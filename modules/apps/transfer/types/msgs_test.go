@@ -77,6 +77,14 @@ func TestMsgTransferValidation(t *testing.T) {
 		{"missing recipient address", types.NewMsgTransfer(validPort, validChannel, coin, sender, "", clienttypes.ZeroHeight(), 100, ""), ibcerrors.ErrInvalidAddress},
 		{"too long recipient address", types.NewMsgTransfer(validPort, validChannel, coin, sender, ibctesting.GenerateString(types.MaximumReceiverLength+1), clienttypes.ZeroHeight(), 100, ""), ibcerrors.ErrInvalidAddress},
 		{"empty coin", types.NewMsgTransfer(validPort, validChannel, sdk.Coin{}, sender, receiver, clienttypes.ZeroHeight(), 100, ""), ibcerrors.ErrInvalidCoins},
+		{"valid eureka msg with multiple tokens", types.NewMsgTransferWithTokens(validPort, eurekaClient, []sdk.Coin{coin, ibcCoin}, sender, receiver, 100, "", types.EncodingProtobuf), nil},
+		{"multiple tokens with invalid ibc denom", types.NewMsgTransferWithTokens(validPort, eurekaClient, []sdk.Coin{coin, invalidIBCCoin}, sender, receiver, 100, "", types.EncodingProtobuf), ibcerrors.ErrInvalidCoins},
+		{"multiple tokens with zero coin", types.NewMsgTransferWithTokens(validPort, eurekaClient, []sdk.Coin{coin, zeroCoin}, sender, receiver, 100, "", types.EncodingProtobuf), ibcerrors.ErrInvalidCoins},
+		{"multiple tokens with duplicate denom", types.NewMsgTransferWithTokens(validPort, eurekaClient, []sdk.Coin{coin, coin}, sender, receiver, 100, "", types.EncodingProtobuf), ibcerrors.ErrInvalidCoins},
+		{"too many tokens", types.NewMsgTransferWithTokens(validPort, eurekaClient, make([]sdk.Coin, types.MaximumTokensLength+1), sender, receiver, 100, "", types.EncodingProtobuf), ibcerrors.ErrInvalidCoins},
+		{"both token and tokens set", &types.MsgTransfer{SourcePort: validPort, SourceChannel: eurekaClient, Token: coin, Tokens: []sdk.Coin{ibcCoin}, Sender: sender, Receiver: receiver, TimeoutTimestamp: 100}, ibcerrors.ErrInvalidCoins},
+		{"valid eureka msg with destination client", &types.MsgTransfer{SourcePort: validPort, SourceChannel: eurekaClient, Token: coin, Sender: sender, Receiver: receiver, TimeoutTimestamp: 100, DestinationClient: eurekaClient}, nil},
+		{"invalid destination client id", &types.MsgTransfer{SourcePort: validPort, SourceChannel: eurekaClient, Token: coin, Sender: sender, Receiver: receiver, TimeoutTimestamp: 100, DestinationClient: invalidChannel}, host.ErrInvalidID},
 	}
 
 	for _, tc := range testCases {
@@ -158,3 +166,86 @@ func TestMsgUpdateParamsGetSigners(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSetDenomTransferStatusValidateBasic(t *testing.T) {
+	status := types.NewTransferStatus(true, false)
+	denom := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(types.PortID, ibctesting.FirstChannelID))
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgSetDenomTransferStatus
+		expError error
+	}{
+		{"success: base denom", types.NewMsgSetDenomTransferStatus(ibctesting.TestAccAddress, sdk.DefaultBondDenom, status), nil},
+		{"success: denom path", types.NewMsgSetDenomTransferStatus(ibctesting.TestAccAddress, denom.Path(), status), nil},
+		{"success: ibc denom", types.NewMsgSetDenomTransferStatus(ibctesting.TestAccAddress, denom.IBCDenom(), status), nil},
+		{"failure: invalid signer", types.NewMsgSetDenomTransferStatus(invalidAddress, sdk.DefaultBondDenom, status), ibcerrors.ErrInvalidAddress},
+		{"failure: empty denom", types.NewMsgSetDenomTransferStatus(ibctesting.TestAccAddress, "", status), types.ErrInvalidDenomForTransfer},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+func TestMsgSetChannelTransferStatusValidateBasic(t *testing.T) {
+	status := types.NewTransferStatus(false, true)
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgSetChannelTransferStatus
+		expError error
+	}{
+		{"success: channel ID", types.NewMsgSetChannelTransferStatus(ibctesting.TestAccAddress, ibctesting.FirstChannelID, status), nil},
+		{"success: client ID", types.NewMsgSetChannelTransferStatus(ibctesting.TestAccAddress, eurekaClient, status), nil},
+		{"failure: invalid signer", types.NewMsgSetChannelTransferStatus(invalidAddress, ibctesting.FirstChannelID, status), ibcerrors.ErrInvalidAddress},
+		{"failure: invalid identifier", types.NewMsgSetChannelTransferStatus(ibctesting.TestAccAddress, invalidChannel, status), host.ErrInvalidID},
+		{"failure: empty identifier", types.NewMsgSetChannelTransferStatus(ibctesting.TestAccAddress, "", status), host.ErrInvalidID},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+func TestMsgSetReceiverBlockedValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name     string
+		msg      *types.MsgSetReceiverBlocked
+		expError error
+	}{
+		{"success: block receiver", types.NewMsgSetReceiverBlocked(ibctesting.TestAccAddress, receiver, true), nil},
+		{"success: unblock receiver", types.NewMsgSetReceiverBlocked(ibctesting.TestAccAddress, receiver, false), nil},
+		{"failure: invalid signer", types.NewMsgSetReceiverBlocked(invalidAddress, receiver, true), ibcerrors.ErrInvalidAddress},
+		{"failure: invalid receiver", types.NewMsgSetReceiverBlocked(ibctesting.TestAccAddress, invalidAddress, true), ibcerrors.ErrInvalidAddress},
+		{"failure: empty receiver", types.NewMsgSetReceiverBlocked(ibctesting.TestAccAddress, emptyAddr, true), ibcerrors.ErrInvalidAddress},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
@@ -19,7 +19,7 @@ import (
 // InternalTransferRepresentation defines a struct used internally by the transfer application to represent a fungible token transfer
 type InternalTransferRepresentation struct {
 	// the tokens to be transferred
-	Token Token
+	Tokens Tokens
 	// the sender address
 	Sender string
 	// the recipient address on the destination chain
@@ -120,14 +120,35 @@ func (ftpd FungibleTokenPacketData) GetCustomPacketData(key string) any {
 	return memoData
 }
 
+// NewFungibleTokenPacketDataV2 constructs a new FungibleTokenPacketDataV2 instance
+func NewFungibleTokenPacketDataV2(
+	tokens Tokens,
+	sender, receiver string,
+	memo string,
+) FungibleTokenPacketDataV2 {
+	return FungibleTokenPacketDataV2{
+		Tokens:   tokens,
+		Sender:   sender,
+		Receiver: receiver,
+		Memo:     memo,
+	}
+}
+
+// ValidateBasic is used for validating the token transfer.
+// NOTE: The addresses formats are not validated as the sender and recipient can have different
+// formats defined by their corresponding chains that are not known to IBC.
+func (ftpd FungibleTokenPacketDataV2) ValidateBasic() error {
+	return NewInternalTransferRepresentation(ftpd.Tokens, ftpd.Sender, ftpd.Receiver, ftpd.Memo).ValidateBasic()
+}
+
 // NewInternalTransferRepresentation constructs a new InternalTransferRepresentation instance
 func NewInternalTransferRepresentation(
-	token Token,
+	tokens Tokens,
 	sender, receiver string,
 	memo string,
 ) InternalTransferRepresentation {
 	return InternalTransferRepresentation{
-		Token:    token,
+		Tokens:   tokens,
 		Sender:   sender,
 		Receiver: receiver,
 		Memo:     memo,
@@ -146,7 +167,7 @@ func (ftpd InternalTransferRepresentation) ValidateBasic() error {
 		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "receiver address cannot be blank")
 	}
 
-	if err := ftpd.Token.Validate(); err != nil {
+	if err := ftpd.Tokens.Validate(); err != nil {
 		return err
 	}
 
@@ -208,25 +229,47 @@ func MarshalPacketData(data FungibleTokenPacketData, ics20Version string, encodi
 	}
 }
 
+// MarshalPacketDataV2 attempts to marshal the provided FungibleTokenPacketDataV2 into bytes with the provided encoding.
+func MarshalPacketDataV2(data FungibleTokenPacketDataV2, encoding string) ([]byte, error) {
+	switch encoding {
+	case EncodingJSON:
+		return json.Marshal(data)
+	case EncodingProtobuf:
+		return proto.Marshal(&data)
+	case EncodingABI:
+		return EncodeABIFungibleTokenPacketDataV2(&data)
+	default:
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "invalid encoding provided, must be one of [%q, %q, %q], got %s", EncodingJSON, EncodingProtobuf, EncodingABI, encoding)
+	}
+}
+
 // UnmarshalPacketData attempts to unmarshal the provided packet data bytes into a InternalTransferRepresentation.
 func UnmarshalPacketData(bz []byte, ics20Version string, encoding string) (InternalTransferRepresentation, error) {
 	const failedUnmarshalingErrorMsg = "cannot unmarshal %s transfer packet data: %s"
 
 	// Depending on the ics20 version, we use a different default encoding (json for V1, proto for V2)
 	// and we have a different type to unmarshal the data into.
-	var data proto.Message
+	var (
+		data            proto.Message
+		errorMsgVersion string
+	)
 	switch ics20Version {
 	case V1:
 		if encoding == "" {
 			encoding = EncodingJSON
 		}
 		data = &FungibleTokenPacketData{}
+		errorMsgVersion = "ICS20-V1"
+	case V2:
+		if encoding == "" {
+			encoding = EncodingProtobuf
+		}
+		data = &FungibleTokenPacketDataV2{}
+		errorMsgVersion = "ICS20-V2"
 	default:
 		return InternalTransferRepresentation{}, errorsmod.Wrap(ErrInvalidVersion, ics20Version)
 	}
 
-	errorMsgVersion := "ICS20-V1"
-
 	// Here we perform the unmarshaling based on the specified encoding.
 	// The functions act on the generic "data" variable which is of type proto.Message (an interface).
 	switch encoding {
@@ -243,11 +286,12 @@ func UnmarshalPacketData(bz []byte, ics20Version string, encoding string) (Inter
 			return InternalTransferRepresentation{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, failedUnmarshalingErrorMsg, errorMsgVersion, err.Error())
 		}
 	case EncodingABI:
-		if ics20Version != V1 {
-			return InternalTransferRepresentation{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "encoding %s is only supported for ICS20-V1", EncodingABI)
-		}
 		var err error
-		data, err = DecodeABIFungibleTokenPacketData(bz)
+		if ics20Version == V1 {
+			data, err = DecodeABIFungibleTokenPacketData(bz)
+		} else {
+			data, err = DecodeABIFungibleTokenPacketDataV2(bz)
+		}
 		if err != nil {
 			return InternalTransferRepresentation{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, failedUnmarshalingErrorMsg, errorMsgVersion, err.Error())
 		}
@@ -255,16 +299,23 @@ func UnmarshalPacketData(bz []byte, ics20Version string, encoding string) (Inter
 		return InternalTransferRepresentation{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "invalid encoding provided, must be either empty or one of [%q, %q, %q], got %s", EncodingJSON, EncodingProtobuf, EncodingABI, encoding)
 	}
 
-	// When the unmarshaling is done, we want to retrieve the underlying data type based on the value of ics20Version
-	// Since it has to be v1, we convert the data to FungibleTokenPacketData and then call the conversion function to construct
-	// the v2 type.
-	datav1, ok := data.(*FungibleTokenPacketData)
-	if !ok {
+	// When the unmarshaling is done, we want to retrieve the underlying data type based on the value of ics20Version.
+	// V1 data is converted into the internal representation with PacketDataV1ToV2, V2 data already carries the
+	// full denomination of every token and is validated as is.
+	switch data := data.(type) {
+	case *FungibleTokenPacketData:
+		// The call to ValidateBasic for V1 is done inside PacketDataV1toV2.
+		return PacketDataV1ToV2(*data)
+	case *FungibleTokenPacketDataV2:
+		if err := data.ValidateBasic(); err != nil {
+			return InternalTransferRepresentation{}, errorsmod.Wrapf(err, "invalid packet data")
+		}
+
+		return NewInternalTransferRepresentation(data.Tokens, data.Sender, data.Receiver, data.Memo), nil
+	default:
 		// We should never get here, as we manually constructed the type at the beginning of the file
-		return InternalTransferRepresentation{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot convert proto message into FungibleTokenPacketData")
+		return InternalTransferRepresentation{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot convert proto message %T into the internal transfer representation", data)
 	}
-	// The call to ValidateBasic for V1 is done inside PacketDataV1toV2.
-	return PacketDataV1ToV2(*datav1)
 }
 
 // PacketDataV1ToV2 converts a v1 packet data to a v2 packet data. The packet data is validated
@@ -276,9 +327,11 @@ func PacketDataV1ToV2(packetData FungibleTokenPacketData) (InternalTransferRepre
 
 	denom := ExtractDenomFromPath(packetData.Denom)
 	return InternalTransferRepresentation{
-		Token: Token{
-			Denom:  denom,
-			Amount: packetData.Amount,
+		Tokens: Tokens{
+			{
+				Denom:  denom,
+				Amount: packetData.Amount,
+			},
 		},
 		Sender:   packetData.Sender,
 		Receiver: packetData.Receiver,
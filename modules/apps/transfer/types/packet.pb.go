@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -106,8 +107,83 @@ func (m *FungibleTokenPacketData) GetMemo() string {
 	return ""
 }
 
+// FungibleTokenPacketDataV2 defines a struct for the packet payload of ICS20 v2 transfers,
+// which carry multiple tokens with their full denomination traces in a single packet.
+type FungibleTokenPacketDataV2 struct {
+	// the tokens to be transferred
+	Tokens []Token `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens"`
+	// the sender address
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// the recipient address on the destination chain
+	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// optional memo
+	Memo string `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (m *FungibleTokenPacketDataV2) Reset()         { *m = FungibleTokenPacketDataV2{} }
+func (m *FungibleTokenPacketDataV2) String() string { return proto.CompactTextString(m) }
+func (*FungibleTokenPacketDataV2) ProtoMessage()    {}
+func (*FungibleTokenPacketDataV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8499af348a22cb56, []int{1}
+}
+func (m *FungibleTokenPacketDataV2) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FungibleTokenPacketDataV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FungibleTokenPacketDataV2.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FungibleTokenPacketDataV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FungibleTokenPacketDataV2.Merge(m, src)
+}
+func (m *FungibleTokenPacketDataV2) XXX_Size() int {
+	return m.Size()
+}
+func (m *FungibleTokenPacketDataV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_FungibleTokenPacketDataV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FungibleTokenPacketDataV2 proto.InternalMessageInfo
+
+func (m *FungibleTokenPacketDataV2) GetTokens() []Token {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+func (m *FungibleTokenPacketDataV2) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *FungibleTokenPacketDataV2) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *FungibleTokenPacketDataV2) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*FungibleTokenPacketData)(nil), "ibc.applications.transfer.v1.FungibleTokenPacketData")
+	proto.RegisterType((*FungibleTokenPacketDataV2)(nil), "ibc.applications.transfer.v1.FungibleTokenPacketDataV2")
 }
 
 func init() {
@@ -115,23 +191,28 @@ func init() {
 }
 
 var fileDescriptor_8499af348a22cb56 = []byte{
-	// 253 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0x31, 0x4b, 0xc4, 0x30,
-	0x18, 0x86, 0x1b, 0xbd, 0x3b, 0x34, 0x63, 0x10, 0x2d, 0x22, 0x41, 0x9c, 0x74, 0xb0, 0xb1, 0xb8,
-	0x38, 0x8b, 0x38, 0xcb, 0xe1, 0xe4, 0x96, 0xa4, 0x9f, 0x35, 0x5c, 0x93, 0xaf, 0x24, 0x69, 0xc1,
-	0x5f, 0xa1, 0x3f, 0xcb, 0xf1, 0x46, 0x47, 0x69, 0xff, 0x88, 0x5c, 0xaa, 0xc7, 0x6d, 0x79, 0x9e,
-	0xbc, 0xdf, 0xf2, 0xd0, 0x2b, 0xa3, 0xb4, 0x90, 0x6d, 0xdb, 0x18, 0x2d, 0xa3, 0x41, 0x17, 0x44,
-	0xf4, 0xd2, 0x85, 0x57, 0xf0, 0xa2, 0x2f, 0x45, 0x2b, 0xf5, 0x0a, 0x62, 0xd1, 0x7a, 0x8c, 0xc8,
-	0xce, 0x8c, 0xd2, 0xc5, 0xee, 0xb4, 0xf8, 0x9f, 0x16, 0x7d, 0x79, 0xf1, 0x41, 0xe8, 0xc9, 0x63,
-	0xe7, 0x6a, 0xa3, 0x1a, 0x78, 0xc6, 0x15, 0xb8, 0xa7, 0x74, 0xfb, 0x20, 0xa3, 0x64, 0x47, 0x74,
-	0x5e, 0x81, 0x43, 0x9b, 0x93, 0x73, 0x72, 0x79, 0xb8, 0x9c, 0x80, 0x1d, 0xd3, 0x85, 0xb4, 0xd8,
-	0xb9, 0x98, 0xef, 0x25, 0xfd, 0x47, 0x1b, 0x1f, 0xc0, 0x55, 0xe0, 0xf3, 0xfd, 0xc9, 0x4f, 0xc4,
-	0x4e, 0xe9, 0x81, 0x07, 0x0d, 0xa6, 0x07, 0x9f, 0xcf, 0xd2, 0xcf, 0x96, 0x19, 0xa3, 0x33, 0x0b,
-	0x16, 0xf3, 0x79, 0xf2, 0xe9, 0x7d, 0xbf, 0xfc, 0x1a, 0x38, 0x59, 0x0f, 0x9c, 0xfc, 0x0c, 0x9c,
-	0x7c, 0x8e, 0x3c, 0x5b, 0x8f, 0x3c, 0xfb, 0x1e, 0x79, 0xf6, 0x72, 0x57, 0x9b, 0xf8, 0xd6, 0xa9,
-	0x42, 0xa3, 0x15, 0x1a, 0x83, 0xc5, 0x20, 0x8c, 0xd2, 0xd7, 0x35, 0x8a, 0xbe, 0xbc, 0x11, 0x16,
-	0xab, 0xae, 0x81, 0xb0, 0xa9, 0xb2, 0x53, 0x23, 0xbe, 0xb7, 0x10, 0xd4, 0x22, 0xa5, 0xb8, 0xfd,
-	0x0d, 0x00, 0x00, 0xff, 0xff, 0x83, 0xa2, 0x8b, 0x97, 0x37, 0x01, 0x00, 0x00,
+	// 331 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xb1, 0x4e, 0xeb, 0x30,
+	0x14, 0x86, 0xe3, 0x36, 0xad, 0xee, 0xf5, 0xdd, 0xac, 0xea, 0x12, 0x2a, 0x14, 0xaa, 0xb2, 0x94,
+	0x01, 0x9b, 0x96, 0x85, 0x95, 0x0a, 0x31, 0xa3, 0x0a, 0x31, 0xb0, 0x39, 0xae, 0x09, 0x56, 0x6b,
+	0x9f, 0x28, 0x76, 0x22, 0xf1, 0x14, 0xf0, 0x14, 0x3c, 0x4b, 0xc7, 0x8e, 0x4c, 0x08, 0xb5, 0x2f,
+	0x82, 0xe2, 0x14, 0xe8, 0xd2, 0x6e, 0xe7, 0xff, 0xf3, 0x9f, 0x93, 0x4f, 0xfe, 0xf1, 0xa9, 0x4a,
+	0x04, 0xe3, 0x59, 0x36, 0x57, 0x82, 0x3b, 0x05, 0xc6, 0x32, 0x97, 0x73, 0x63, 0x1f, 0x65, 0xce,
+	0xca, 0x21, 0xcb, 0xb8, 0x98, 0x49, 0x47, 0xb3, 0x1c, 0x1c, 0x90, 0x23, 0x95, 0x08, 0xba, 0x1d,
+	0xa5, 0xdf, 0x51, 0x5a, 0x0e, 0xbb, 0x9d, 0x14, 0x52, 0xf0, 0x41, 0x56, 0x4d, 0xf5, 0x4e, 0x77,
+	0xb0, 0xf7, 0xbc, 0x83, 0x99, 0x34, 0x75, 0xb2, 0xff, 0x82, 0xf0, 0xc1, 0x4d, 0x61, 0x52, 0x95,
+	0xcc, 0xe5, 0x5d, 0xe5, 0xdf, 0xfa, 0x7f, 0x5f, 0x73, 0xc7, 0x49, 0x07, 0xb7, 0xa6, 0xd2, 0x80,
+	0x8e, 0x50, 0x0f, 0x0d, 0xfe, 0x4e, 0x6a, 0x41, 0xfe, 0xe3, 0x36, 0xd7, 0x50, 0x18, 0x17, 0x35,
+	0xbc, 0xbd, 0x51, 0x95, 0x6f, 0xa5, 0x99, 0xca, 0x3c, 0x6a, 0xd6, 0x7e, 0xad, 0x48, 0x17, 0xff,
+	0xc9, 0xa5, 0x90, 0xaa, 0x94, 0x79, 0x14, 0xfa, 0x2f, 0x3f, 0x9a, 0x10, 0x1c, 0x6a, 0xa9, 0x21,
+	0x6a, 0x79, 0xdf, 0xcf, 0xfd, 0x37, 0x84, 0x0f, 0x77, 0x10, 0xdd, 0x8f, 0xc8, 0x15, 0x6e, 0x7b,
+	0x7c, 0x1b, 0xa1, 0x5e, 0x73, 0xf0, 0x6f, 0x74, 0x42, 0xf7, 0x3d, 0x0f, 0xf5, 0x07, 0xc6, 0xe1,
+	0xe2, 0xe3, 0x38, 0x98, 0x6c, 0x16, 0xb7, 0x40, 0x1b, 0x3b, 0x41, 0x9b, 0x3b, 0x40, 0xc3, 0x5f,
+	0xd0, 0xf1, 0x64, 0xb1, 0x8a, 0xd1, 0x72, 0x15, 0xa3, 0xcf, 0x55, 0x8c, 0x5e, 0xd7, 0x71, 0xb0,
+	0x5c, 0xc7, 0xc1, 0xfb, 0x3a, 0x0e, 0x1e, 0x2e, 0x53, 0xe5, 0x9e, 0x8a, 0x84, 0x0a, 0xd0, 0x4c,
+	0x80, 0xd5, 0x60, 0x99, 0x4a, 0xc4, 0x59, 0x0a, 0xac, 0x1c, 0x9e, 0x33, 0x0d, 0xd3, 0x62, 0x2e,
+	0x6d, 0xd5, 0xcf, 0x56, 0x2f, 0xee, 0x39, 0x93, 0x36, 0x69, 0xfb, 0x56, 0x2e, 0xbe, 0x02, 0x00,
+	0x00, 0xff, 0xff, 0xa9, 0x8f, 0x77, 0xbb, 0x20, 0x02, 0x00, 0x00,
 }
 
 func (m *FungibleTokenPacketData) Marshal() (dAtA []byte, err error) {
@@ -192,6 +273,64 @@ func (m *FungibleTokenPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *FungibleTokenPacketDataV2) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FungibleTokenPacketDataV2) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FungibleTokenPacketDataV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPacket(v)
 	base := offset
@@ -232,6 +371,33 @@ func (m *FungibleTokenPacketData) Size() (n int) {
 	return n
 }
 
+func (m *FungibleTokenPacketDataV2) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
 func sovPacket(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -448,6 +614,186 @@ func (m *FungibleTokenPacketData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FungibleTokenPacketDataV2) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FungibleTokenPacketDataV2: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FungibleTokenPacketDataV2: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, Token{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPacket(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
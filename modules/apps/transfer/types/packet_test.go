@@ -174,9 +174,11 @@ func TestInternalTransferRepresentationValidateBasic(t *testing.T) {
 		{
 			"success: valid packet",
 			types.NewInternalTransferRepresentation(
-				types.Token{
-					Denom:  types.NewDenom(denom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
-					Amount: amount,
+				types.Tokens{
+					{
+						Denom:  types.NewDenom(denom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
+						Amount: amount,
+					},
 				},
 				sender,
 				receiver,
@@ -187,9 +189,11 @@ func TestInternalTransferRepresentationValidateBasic(t *testing.T) {
 		{
 			"success: valid packet with memo",
 			types.NewInternalTransferRepresentation(
-				types.Token{
-					Denom:  types.NewDenom(denom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
-					Amount: amount,
+				types.Tokens{
+					{
+						Denom:  types.NewDenom(denom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
+						Amount: amount,
+					},
 				},
 				sender,
 				receiver,
@@ -200,9 +204,11 @@ func TestInternalTransferRepresentationValidateBasic(t *testing.T) {
 		{
 			"success: valid packet with large amount",
 			types.NewInternalTransferRepresentation(
-				types.Token{
-					Denom:  types.NewDenom(denom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
-					Amount: largeAmount,
+				types.Tokens{
+					{
+						Denom:  types.NewDenom(denom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
+						Amount: largeAmount,
+					},
 				},
 				sender,
 				receiver,
@@ -213,9 +219,11 @@ func TestInternalTransferRepresentationValidateBasic(t *testing.T) {
 		{
 			"failure: invalid denom",
 			types.NewInternalTransferRepresentation(
-				types.Token{
-					Denom:  types.NewDenom("", types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
-					Amount: amount,
+				types.Tokens{
+					{
+						Denom:  types.NewDenom("", types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
+						Amount: amount,
+					},
 				},
 				sender,
 				receiver,
@@ -226,9 +234,11 @@ func TestInternalTransferRepresentationValidateBasic(t *testing.T) {
 		{
 			"failure: invalid empty amount",
 			types.NewInternalTransferRepresentation(
-				types.Token{
-					Denom:  types.NewDenom(denom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
-					Amount: "",
+				types.Tokens{
+					{
+						Denom:  types.NewDenom(denom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
+						Amount: "",
+					},
 				},
 				sender,
 				receiver,
@@ -239,9 +249,11 @@ func TestInternalTransferRepresentationValidateBasic(t *testing.T) {
 		{
 			"failure: invalid zero amount",
 			types.NewInternalTransferRepresentation(
-				types.Token{
-					Denom:  types.NewDenom(denom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
-					Amount: "0",
+				types.Tokens{
+					{
+						Denom:  types.NewDenom(denom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
+						Amount: "0",
+					},
 				},
 				sender,
 				receiver,
@@ -252,9 +264,11 @@ func TestInternalTransferRepresentationValidateBasic(t *testing.T) {
 		{
 			"failure: invalid negative amount",
 			types.NewInternalTransferRepresentation(
-				types.Token{
-					Denom:  types.NewDenom(denom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
-					Amount: "-100",
+				types.Tokens{
+					{
+						Denom:  types.NewDenom(denom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
+						Amount: "-100",
+					},
 				},
 				sender,
 				receiver,
@@ -265,9 +279,11 @@ func TestInternalTransferRepresentationValidateBasic(t *testing.T) {
 		{
 			"failure: invalid large amount",
 			types.NewInternalTransferRepresentation(
-				types.Token{
-					Denom:  types.NewDenom(denom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
-					Amount: invalidLargeAmount,
+				types.Tokens{
+					{
+						Denom:  types.NewDenom(denom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
+						Amount: invalidLargeAmount,
+					},
 				},
 				sender,
 				receiver,
@@ -278,9 +294,11 @@ func TestInternalTransferRepresentationValidateBasic(t *testing.T) {
 		{
 			"failure: missing sender address",
 			types.NewInternalTransferRepresentation(
-				types.Token{
-					Denom:  types.NewDenom(denom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
-					Amount: amount,
+				types.Tokens{
+					{
+						Denom:  types.NewDenom(denom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
+						Amount: amount,
+					},
 				},
 				"",
 				receiver,
@@ -291,9 +309,11 @@ func TestInternalTransferRepresentationValidateBasic(t *testing.T) {
 		{
 			"failure: missing recipient address",
 			types.NewInternalTransferRepresentation(
-				types.Token{
-					Denom:  types.NewDenom(denom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
-					Amount: amount,
+				types.Tokens{
+					{
+						Denom:  types.NewDenom(denom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
+						Amount: amount,
+					},
 				},
 				sender,
 				"",
@@ -304,9 +324,11 @@ func TestInternalTransferRepresentationValidateBasic(t *testing.T) {
 		{
 			"failure: memo field too large",
 			types.NewInternalTransferRepresentation(
-				types.Token{
-					Denom:  types.NewDenom(denom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
-					Amount: largeAmount,
+				types.Tokens{
+					{
+						Denom:  types.NewDenom(denom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
+						Amount: largeAmount,
+					},
 				},
 				sender,
 				receiver,
@@ -338,9 +360,11 @@ func TestGetPacketSender(t *testing.T) {
 		{
 			"non-empty sender field",
 			types.NewInternalTransferRepresentation(
-				types.Token{
-					Denom:  types.NewDenom(denom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
-					Amount: amount,
+				types.Tokens{
+					{
+						Denom:  types.NewDenom(denom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
+						Amount: amount,
+					},
 				},
 				sender,
 				receiver,
@@ -351,9 +375,11 @@ func TestGetPacketSender(t *testing.T) {
 		{
 			"empty sender field",
 			types.NewInternalTransferRepresentation(
-				types.Token{
-					Denom:  types.NewDenom(denom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
-					Amount: amount,
+				types.Tokens{
+					{
+						Denom:  types.NewDenom(denom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
+						Amount: amount,
+					},
 				},
 				"",
 				receiver,
@@ -379,9 +405,11 @@ func TestPacketDataProvider(t *testing.T) {
 		{
 			"success: src_callback key in memo",
 			types.NewInternalTransferRepresentation(
-				types.Token{
-					Denom:  types.NewDenom(denom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
-					Amount: amount,
+				types.Tokens{
+					{
+						Denom:  types.NewDenom(denom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
+						Amount: amount,
+					},
 				},
 				sender,
 				receiver,
@@ -395,9 +423,11 @@ func TestPacketDataProvider(t *testing.T) {
 		{
 			"success: src_callback key in memo with additional fields",
 			types.NewInternalTransferRepresentation(
-				types.Token{
-					Denom:  types.NewDenom(denom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
-					Amount: amount,
+				types.Tokens{
+					{
+						Denom:  types.NewDenom(denom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
+						Amount: amount,
+					},
 				},
 				sender,
 				receiver,
@@ -411,9 +441,11 @@ func TestPacketDataProvider(t *testing.T) {
 		{
 			"success: src_callback has string value",
 			types.NewInternalTransferRepresentation(
-				types.Token{
-					Denom:  types.NewDenom(denom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
-					Amount: amount,
+				types.Tokens{
+					{
+						Denom:  types.NewDenom(denom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
+						Amount: amount,
+					},
 				},
 				sender,
 				receiver,
@@ -424,9 +456,11 @@ func TestPacketDataProvider(t *testing.T) {
 		{
 			"failure: src_callback key not found memo",
 			types.NewInternalTransferRepresentation(
-				types.Token{
-					Denom:  types.NewDenom(denom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
-					Amount: amount,
+				types.Tokens{
+					{
+						Denom:  types.NewDenom(denom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
+						Amount: amount,
+					},
 				},
 				sender,
 				receiver,
@@ -437,9 +471,11 @@ func TestPacketDataProvider(t *testing.T) {
 		{
 			"failure: empty memo",
 			types.NewInternalTransferRepresentation(
-				types.Token{
-					Denom:  types.NewDenom(denom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
-					Amount: amount,
+				types.Tokens{
+					{
+						Denom:  types.NewDenom(denom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
+						Amount: amount,
+					},
 				},
 				sender,
 				receiver,
@@ -450,9 +486,11 @@ func TestPacketDataProvider(t *testing.T) {
 		{
 			"failure: non-json memo",
 			types.NewInternalTransferRepresentation(
-				types.Token{
-					Denom:  types.NewDenom(denom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
-					Amount: amount,
+				types.Tokens{
+					{
+						Denom:  types.NewDenom(denom, types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
+						Amount: amount,
+					},
 				},
 				sender,
 				receiver,
@@ -477,6 +515,14 @@ func TestUnmarshalPacketData(t *testing.T) {
 		encoding     string
 	)
 
+	packetDataV2 := types.NewFungibleTokenPacketDataV2(
+		types.Tokens{
+			{Denom: types.NewDenom("atom", types.NewHop("transfer", "07-tendermint-0")), Amount: "1000"},
+			{Denom: types.NewDenom("osmo"), Amount: "500"},
+		},
+		sender, receiver, "",
+	)
+
 	testCases := []struct {
 		name     string
 		malleate func()
@@ -522,6 +568,65 @@ func TestUnmarshalPacketData(t *testing.T) {
 			},
 			nil,
 		},
+		{
+			"success: v2 with empty encoding (protobuf)",
+			func() {
+				bz, err := types.MarshalPacketDataV2(packetDataV2, types.EncodingProtobuf)
+				require.NoError(t, err)
+
+				packetDataBz = bz
+				version = types.V2
+			},
+			nil,
+		},
+		{
+			"success: v2 with JSON encoding",
+			func() {
+				bz, err := types.MarshalPacketDataV2(packetDataV2, types.EncodingJSON)
+				require.NoError(t, err)
+
+				packetDataBz = bz
+				version = types.V2
+				encoding = types.EncodingJSON
+			},
+			nil,
+		},
+		{
+			"success: v2 with abi encoding",
+			func() {
+				bz, err := types.MarshalPacketDataV2(packetDataV2, types.EncodingABI)
+				require.NoError(t, err)
+
+				packetDataBz = bz
+				version = types.V2
+				encoding = types.EncodingABI
+			},
+			nil,
+		},
+		{
+			"failure: v2 with mismatched encoding",
+			func() {
+				bz, err := types.MarshalPacketDataV2(packetDataV2, types.EncodingJSON)
+				require.NoError(t, err)
+
+				packetDataBz = bz
+				version = types.V2
+				encoding = types.EncodingProtobuf
+			},
+			ibcerrors.ErrInvalidType,
+		},
+		{
+			"failure: v2 with duplicate tokens",
+			func() {
+				invalidPacketData := types.NewFungibleTokenPacketDataV2(types.Tokens{packetDataV2.Tokens[0], packetDataV2.Tokens[0]}, sender, receiver, "")
+				bz, err := types.MarshalPacketDataV2(invalidPacketData, types.EncodingProtobuf)
+				require.NoError(t, err)
+
+				packetDataBz = bz
+				version = types.V2
+			},
+			ibcerrors.ErrInvalidCoins,
+		},
 		{
 			"invalid version",
 			func() {
@@ -544,7 +649,7 @@ func TestUnmarshalPacketData(t *testing.T) {
 
 		if tc.expError == nil {
 			require.NoError(t, err)
-			require.NotEmpty(t, packetData.Token)
+			require.NotEmpty(t, packetData.Tokens[0])
 			require.NotEmpty(t, packetData.Sender)
 			require.NotEmpty(t, packetData.Receiver)
 			require.IsType(t, types.InternalTransferRepresentation{}, packetData)
@@ -570,9 +675,11 @@ func TestPacketV1ToPacketV2(t *testing.T) {
 			"success",
 			types.NewFungibleTokenPacketData("transfer/channel-0/atom", "1000", sender, receiver, ""),
 			types.NewInternalTransferRepresentation(
-				types.Token{
-					Denom:  types.NewDenom("atom", types.NewHop("transfer", "channel-0")),
-					Amount: "1000",
+				types.Tokens{
+					{
+						Denom:  types.NewDenom("atom", types.NewHop("transfer", "channel-0")),
+						Amount: "1000",
+					},
 				}, sender, receiver, ""),
 			nil,
 		},
@@ -580,9 +687,11 @@ func TestPacketV1ToPacketV2(t *testing.T) {
 			"success with empty trace",
 			types.NewFungibleTokenPacketData("atom", "1000", sender, receiver, ""),
 			types.NewInternalTransferRepresentation(
-				types.Token{
-					Denom:  types.NewDenom("atom"),
-					Amount: "1000",
+				types.Tokens{
+					{
+						Denom:  types.NewDenom("atom"),
+						Amount: "1000",
+					},
 				}, sender, receiver, ""),
 			nil,
 		},
@@ -590,9 +699,11 @@ func TestPacketV1ToPacketV2(t *testing.T) {
 			"success: base denom with '/'",
 			types.NewFungibleTokenPacketData("transfer/channel-0/atom/withslash", "1000", sender, receiver, ""),
 			types.NewInternalTransferRepresentation(
-				types.Token{
-					Denom:  types.NewDenom("atom/withslash", types.NewHop("transfer", "channel-0")),
-					Amount: "1000",
+				types.Tokens{
+					{
+						Denom:  types.NewDenom("atom/withslash", types.NewHop("transfer", "channel-0")),
+						Amount: "1000",
+					},
 				}, sender, receiver, ""),
 			nil,
 		},
@@ -600,9 +711,11 @@ func TestPacketV1ToPacketV2(t *testing.T) {
 			"success: base denom with '/' at the end",
 			types.NewFungibleTokenPacketData("transfer/channel-0/atom/", "1000", sender, receiver, ""),
 			types.NewInternalTransferRepresentation(
-				types.Token{
-					Denom:  types.NewDenom("atom/", types.NewHop("transfer", "channel-0")),
-					Amount: "1000",
+				types.Tokens{
+					{
+						Denom:  types.NewDenom("atom/", types.NewHop("transfer", "channel-0")),
+						Amount: "1000",
+					},
 				}, sender, receiver, ""),
 			nil,
 		},
@@ -610,9 +723,11 @@ func TestPacketV1ToPacketV2(t *testing.T) {
 			"success: longer trace base denom with '/'",
 			types.NewFungibleTokenPacketData("transfer/channel-0/transfer/channel-1/atom/pool", "1000", sender, receiver, ""),
 			types.NewInternalTransferRepresentation(
-				types.Token{
-					Denom:  types.NewDenom("atom/pool", types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
-					Amount: "1000",
+				types.Tokens{
+					{
+						Denom:  types.NewDenom("atom/pool", types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1")),
+						Amount: "1000",
+					},
 				}, sender, receiver, ""),
 			nil,
 		},
@@ -620,9 +735,11 @@ func TestPacketV1ToPacketV2(t *testing.T) {
 			"success: longer trace with non transfer port",
 			types.NewFungibleTokenPacketData("transfer/channel-0/transfer/channel-1/transfer-custom/channel-2/atom", "1000", sender, receiver, ""),
 			types.NewInternalTransferRepresentation(
-				types.Token{
-					Denom:  types.NewDenom("atom", types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1"), types.NewHop("transfer-custom", "channel-2")),
-					Amount: "1000",
+				types.Tokens{
+					{
+						Denom:  types.NewDenom("atom", types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1"), types.NewHop("transfer-custom", "channel-2")),
+						Amount: "1000",
+					},
 				}, sender, receiver, ""),
 			nil,
 		},
@@ -630,9 +747,11 @@ func TestPacketV1ToPacketV2(t *testing.T) {
 			"success: base denom with slash, trace with non transfer port",
 			types.NewFungibleTokenPacketData("transfer/channel-0/transfer/channel-1/transfer-custom/channel-2/atom/pool", "1000", sender, receiver, ""),
 			types.NewInternalTransferRepresentation(
-				types.Token{
-					Denom:  types.NewDenom("atom/pool", types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1"), types.NewHop("transfer-custom", "channel-2")),
-					Amount: "1000",
+				types.Tokens{
+					{
+						Denom:  types.NewDenom("atom/pool", types.NewHop("transfer", "channel-0"), types.NewHop("transfer", "channel-1"), types.NewHop("transfer-custom", "channel-2")),
+						Amount: "1000",
+					},
 				}, sender, receiver, ""),
 			nil,
 		},
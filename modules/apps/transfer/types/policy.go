@@ -0,0 +1,80 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+)
+
+// NewTransferStatus creates a new TransferStatus instance.
+func NewTransferStatus(sendDisabled, receiveDisabled bool) TransferStatus {
+	return TransferStatus{
+		SendDisabled:    sendDisabled,
+		ReceiveDisabled: receiveDisabled,
+	}
+}
+
+// IsEnabled returns true if neither sending nor receiving is disabled.
+func (s TransferStatus) IsEnabled() bool {
+	return !s.SendDisabled && !s.ReceiveDisabled
+}
+
+// NewDenomTransferStatus creates a new DenomTransferStatus instance.
+func NewDenomTransferStatus(denom string, status TransferStatus) DenomTransferStatus {
+	return DenomTransferStatus{
+		Denom:  denom,
+		Status: status,
+	}
+}
+
+// Validate performs a basic validation of the DenomTransferStatus fields.
+func (s DenomTransferStatus) Validate() error {
+	return validateStatusDenom(s.Denom)
+}
+
+// NewChannelTransferStatus creates a new ChannelTransferStatus instance.
+func NewChannelTransferStatus(channelOrClientID string, status TransferStatus) ChannelTransferStatus {
+	return ChannelTransferStatus{
+		ChannelOrClientId: channelOrClientID,
+		Status:            status,
+	}
+}
+
+// Validate performs a basic validation of the ChannelTransferStatus fields.
+func (s ChannelTransferStatus) Validate() error {
+	return validateStatusChannelOrClientID(s.ChannelOrClientId)
+}
+
+// validateStatusDenom validates the denomination of a transfer status. A base denomination,
+// a full denomination path or an ibc/{hash} denomination are all accepted.
+func validateStatusDenom(denom string) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidDenomForTransfer, "invalid denom %s: %v", denom, err)
+	}
+
+	return nil
+}
+
+// validateStatusChannelOrClientID validates the identifier of a transfer status, which must be
+// a valid channel identifier (IBC v1) or client identifier (IBC v2).
+func validateStatusChannelOrClientID(channelOrClientID string) error {
+	if !channeltypes.IsValidChannelID(channelOrClientID) && !clienttypes.IsValidClientID(channelOrClientID) {
+		return errorsmod.Wrapf(host.ErrInvalidID, "%s is neither a valid channel ID nor a valid client ID", channelOrClientID)
+	}
+
+	return nil
+}
+
+// validateBlockedReceiver validates a receiver address of the denylist.
+func validateBlockedReceiver(receiver string) error {
+	if _, err := sdk.AccAddressFromBech32(receiver); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,817 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/transfer/v1/policy.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TransferStatus defines whether sending or receiving ICS-20 transfers is disabled. It is applied
+// on top of the send_enabled and receive_enabled module parameters.
+type TransferStatus struct {
+	// send_disabled disables sending transfers
+	SendDisabled bool `protobuf:"varint,1,opt,name=send_disabled,json=sendDisabled,proto3" json:"send_disabled,omitempty"`
+	// receive_disabled disables receiving transfers
+	ReceiveDisabled bool `protobuf:"varint,2,opt,name=receive_disabled,json=receiveDisabled,proto3" json:"receive_disabled,omitempty"`
+}
+
+func (m *TransferStatus) Reset()         { *m = TransferStatus{} }
+func (m *TransferStatus) String() string { return proto.CompactTextString(m) }
+func (*TransferStatus) ProtoMessage()    {}
+func (*TransferStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f013609346c0554, []int{0}
+}
+func (m *TransferStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferStatus.Merge(m, src)
+}
+func (m *TransferStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferStatus proto.InternalMessageInfo
+
+func (m *TransferStatus) GetSendDisabled() bool {
+	if m != nil {
+		return m.SendDisabled
+	}
+	return false
+}
+
+func (m *TransferStatus) GetReceiveDisabled() bool {
+	if m != nil {
+		return m.ReceiveDisabled
+	}
+	return false
+}
+
+// DenomTransferStatus defines the transfer status of a denomination. The denomination is
+// matched against the base denomination, the full denomination path and the ibc/{hash}
+// denomination of the transferred tokens as represented on this chain.
+type DenomTransferStatus struct {
+	// denom is a base denomination, a full denomination path or an ibc/{hash} denomination
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// status is the transfer status of the denomination
+	Status TransferStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status"`
+}
+
+func (m *DenomTransferStatus) Reset()         { *m = DenomTransferStatus{} }
+func (m *DenomTransferStatus) String() string { return proto.CompactTextString(m) }
+func (*DenomTransferStatus) ProtoMessage()    {}
+func (*DenomTransferStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f013609346c0554, []int{1}
+}
+func (m *DenomTransferStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomTransferStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomTransferStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomTransferStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomTransferStatus.Merge(m, src)
+}
+func (m *DenomTransferStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomTransferStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomTransferStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomTransferStatus proto.InternalMessageInfo
+
+func (m *DenomTransferStatus) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomTransferStatus) GetStatus() TransferStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TransferStatus{}
+}
+
+// ChannelTransferStatus defines the transfer status of a local channel (IBC v1) or client (IBC v2).
+type ChannelTransferStatus struct {
+	// channel_or_client_id is the local channel ID (IBC v1) or client ID (IBC v2)
+	ChannelOrClientId string `protobuf:"bytes,1,opt,name=channel_or_client_id,json=channelOrClientId,proto3" json:"channel_or_client_id,omitempty"`
+	// status is the transfer status of the channel or client
+	Status TransferStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status"`
+}
+
+func (m *ChannelTransferStatus) Reset()         { *m = ChannelTransferStatus{} }
+func (m *ChannelTransferStatus) String() string { return proto.CompactTextString(m) }
+func (*ChannelTransferStatus) ProtoMessage()    {}
+func (*ChannelTransferStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f013609346c0554, []int{2}
+}
+func (m *ChannelTransferStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelTransferStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelTransferStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelTransferStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelTransferStatus.Merge(m, src)
+}
+func (m *ChannelTransferStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelTransferStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelTransferStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelTransferStatus proto.InternalMessageInfo
+
+func (m *ChannelTransferStatus) GetChannelOrClientId() string {
+	if m != nil {
+		return m.ChannelOrClientId
+	}
+	return ""
+}
+
+func (m *ChannelTransferStatus) GetStatus() TransferStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TransferStatus{}
+}
+
+func init() {
+	proto.RegisterType((*TransferStatus)(nil), "ibc.applications.transfer.v1.TransferStatus")
+	proto.RegisterType((*DenomTransferStatus)(nil), "ibc.applications.transfer.v1.DenomTransferStatus")
+	proto.RegisterType((*ChannelTransferStatus)(nil), "ibc.applications.transfer.v1.ChannelTransferStatus")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/transfer/v1/policy.proto", fileDescriptor_5f013609346c0554)
+}
+
+var fileDescriptor_5f013609346c0554 = []byte{
+	// 330 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xbf, 0x4e, 0xc3, 0x30,
+	0x10, 0xc6, 0x13, 0x04, 0x15, 0x98, 0xff, 0xa1, 0x48, 0x08, 0xa1, 0x80, 0xca, 0x42, 0x25, 0xb0,
+	0x29, 0x2c, 0xcc, 0x6d, 0x17, 0x58, 0x90, 0x02, 0x13, 0x4b, 0x48, 0x6c, 0x93, 0x5a, 0x4a, 0x7c,
+	0x51, 0xec, 0x06, 0xf5, 0x2d, 0x18, 0x78, 0xa8, 0x8e, 0x1d, 0x99, 0x10, 0x6a, 0x5f, 0x04, 0xc5,
+	0x75, 0x45, 0xe9, 0xc0, 0xc4, 0x66, 0x7f, 0xdf, 0xcf, 0xdf, 0x9d, 0x75, 0x87, 0x9a, 0x22, 0xa6,
+	0x24, 0xca, 0xf3, 0x54, 0xd0, 0x48, 0x0b, 0x90, 0x8a, 0xe8, 0x22, 0x92, 0xea, 0x85, 0x17, 0xa4,
+	0x6c, 0x91, 0x1c, 0x52, 0x41, 0x07, 0x38, 0x2f, 0x40, 0x83, 0x77, 0x24, 0x62, 0x8a, 0xe7, 0x51,
+	0x3c, 0x43, 0x71, 0xd9, 0x3a, 0xac, 0x27, 0x90, 0x80, 0x01, 0x49, 0x75, 0x9a, 0xbe, 0x69, 0x3c,
+	0xa3, 0xad, 0x47, 0x0b, 0x3d, 0xe8, 0x48, 0xf7, 0x95, 0x77, 0x8a, 0x36, 0x15, 0x97, 0x2c, 0x64,
+	0x42, 0x45, 0x71, 0xca, 0xd9, 0x81, 0x7b, 0xe2, 0x9e, 0xad, 0x06, 0x1b, 0x95, 0xd8, 0xb5, 0x9a,
+	0xd7, 0x44, 0x3b, 0x05, 0xa7, 0x5c, 0x94, 0xfc, 0x87, 0x5b, 0x32, 0xdc, 0xb6, 0xd5, 0x67, 0x68,
+	0xe3, 0x15, 0xed, 0x75, 0xb9, 0x84, 0x6c, 0xa1, 0x4c, 0x1d, 0xad, 0xb0, 0x4a, 0x36, 0xf1, 0x6b,
+	0xc1, 0xf4, 0xe2, 0xdd, 0xa1, 0x9a, 0x32, 0xbe, 0x49, 0x5b, 0xbf, 0x3a, 0xc7, 0x7f, 0xfd, 0x09,
+	0xff, 0xce, 0x6c, 0x2f, 0x0f, 0x3f, 0x8f, 0x9d, 0xc0, 0x26, 0x34, 0xde, 0x5d, 0xb4, 0xdf, 0xe9,
+	0x45, 0x52, 0xf2, 0x74, 0xa1, 0x36, 0x41, 0x75, 0x3a, 0x35, 0x42, 0x28, 0x42, 0x9a, 0x0a, 0x2e,
+	0x75, 0x28, 0x98, 0x6d, 0x65, 0xd7, 0x7a, 0xf7, 0x45, 0xc7, 0x38, 0xb7, 0xec, 0x3f, 0xdb, 0x6a,
+	0x07, 0xc3, 0xb1, 0xef, 0x8e, 0xc6, 0xbe, 0xfb, 0x35, 0xf6, 0xdd, 0xb7, 0x89, 0xef, 0x8c, 0x26,
+	0xbe, 0xf3, 0x31, 0xf1, 0x9d, 0xa7, 0x9b, 0x44, 0xe8, 0x5e, 0x3f, 0xc6, 0x14, 0x32, 0x42, 0x41,
+	0x65, 0xa0, 0x88, 0x88, 0xe9, 0x45, 0x02, 0xa4, 0x6c, 0x5d, 0x92, 0x0c, 0x58, 0x3f, 0xe5, 0xaa,
+	0xda, 0x85, 0xb9, 0x1d, 0xd0, 0x83, 0x9c, 0xab, 0xb8, 0x66, 0x86, 0x79, 0xfd, 0x1d, 0x00, 0x00,
+	0xff, 0xff, 0x7c, 0xd2, 0xdf, 0xde, 0x2d, 0x02, 0x00, 0x00,
+}
+
+func (m *TransferStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReceiveDisabled {
+		i--
+		if m.ReceiveDisabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SendDisabled {
+		i--
+		if m.SendDisabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomTransferStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomTransferStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomTransferStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintPolicy(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelTransferStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelTransferStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelTransferStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChannelOrClientId) > 0 {
+		i -= len(m.ChannelOrClientId)
+		copy(dAtA[i:], m.ChannelOrClientId)
+		i = encodeVarintPolicy(dAtA, i, uint64(len(m.ChannelOrClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPolicy(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPolicy(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TransferStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SendDisabled {
+		n += 2
+	}
+	if m.ReceiveDisabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *DenomTransferStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovPolicy(uint64(l))
+	}
+	l = m.Status.Size()
+	n += 1 + l + sovPolicy(uint64(l))
+	return n
+}
+
+func (m *ChannelTransferStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelOrClientId)
+	if l > 0 {
+		n += 1 + l + sovPolicy(uint64(l))
+	}
+	l = m.Status.Size()
+	n += 1 + l + sovPolicy(uint64(l))
+	return n
+}
+
+func sovPolicy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPolicy(x uint64) (n int) {
+	return sovPolicy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TransferStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendDisabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SendDisabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveDisabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReceiveDisabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomTransferStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomTransferStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomTransferStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelTransferStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelTransferStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelTransferStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelOrClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelOrClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPolicy(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPolicy
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPolicy
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPolicy
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPolicy
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPolicy        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPolicy          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPolicy = fmt.Errorf("proto: unexpected end of group")
+)
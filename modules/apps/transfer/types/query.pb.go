@@ -596,6 +596,309 @@ func (m *QueryTotalEscrowForDenomResponse) GetAmount() types.Coin {
 	return types.Coin{}
 }
 
+// QueryDenomTransferStatusesRequest is the request type for the Query/DenomTransferStatuses RPC method.
+type QueryDenomTransferStatusesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDenomTransferStatusesRequest) Reset()         { *m = QueryDenomTransferStatusesRequest{} }
+func (m *QueryDenomTransferStatusesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomTransferStatusesRequest) ProtoMessage()    {}
+func (*QueryDenomTransferStatusesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{12}
+}
+func (m *QueryDenomTransferStatusesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomTransferStatusesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomTransferStatusesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomTransferStatusesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomTransferStatusesRequest.Merge(m, src)
+}
+func (m *QueryDenomTransferStatusesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomTransferStatusesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomTransferStatusesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomTransferStatusesRequest proto.InternalMessageInfo
+
+func (m *QueryDenomTransferStatusesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDenomTransferStatusesResponse is the response type for the Query/DenomTransferStatuses RPC method.
+type QueryDenomTransferStatusesResponse struct {
+	// denom_transfer_statuses returns the transfer statuses of the denominations
+	DenomTransferStatuses []DenomTransferStatus `protobuf:"bytes,1,rep,name=denom_transfer_statuses,json=denomTransferStatuses,proto3" json:"denom_transfer_statuses"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDenomTransferStatusesResponse) Reset()         { *m = QueryDenomTransferStatusesResponse{} }
+func (m *QueryDenomTransferStatusesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomTransferStatusesResponse) ProtoMessage()    {}
+func (*QueryDenomTransferStatusesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{13}
+}
+func (m *QueryDenomTransferStatusesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomTransferStatusesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomTransferStatusesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomTransferStatusesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomTransferStatusesResponse.Merge(m, src)
+}
+func (m *QueryDenomTransferStatusesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomTransferStatusesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomTransferStatusesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomTransferStatusesResponse proto.InternalMessageInfo
+
+func (m *QueryDenomTransferStatusesResponse) GetDenomTransferStatuses() []DenomTransferStatus {
+	if m != nil {
+		return m.DenomTransferStatuses
+	}
+	return nil
+}
+
+func (m *QueryDenomTransferStatusesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryChannelTransferStatusesRequest is the request type for the Query/ChannelTransferStatuses RPC method.
+type QueryChannelTransferStatusesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryChannelTransferStatusesRequest) Reset()         { *m = QueryChannelTransferStatusesRequest{} }
+func (m *QueryChannelTransferStatusesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelTransferStatusesRequest) ProtoMessage()    {}
+func (*QueryChannelTransferStatusesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{14}
+}
+func (m *QueryChannelTransferStatusesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelTransferStatusesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelTransferStatusesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelTransferStatusesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelTransferStatusesRequest.Merge(m, src)
+}
+func (m *QueryChannelTransferStatusesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelTransferStatusesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelTransferStatusesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelTransferStatusesRequest proto.InternalMessageInfo
+
+func (m *QueryChannelTransferStatusesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryChannelTransferStatusesResponse is the response type for the Query/ChannelTransferStatuses RPC method.
+type QueryChannelTransferStatusesResponse struct {
+	// channel_transfer_statuses returns the transfer statuses of the channels and clients
+	ChannelTransferStatuses []ChannelTransferStatus `protobuf:"bytes,1,rep,name=channel_transfer_statuses,json=channelTransferStatuses,proto3" json:"channel_transfer_statuses"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryChannelTransferStatusesResponse) Reset()         { *m = QueryChannelTransferStatusesResponse{} }
+func (m *QueryChannelTransferStatusesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelTransferStatusesResponse) ProtoMessage()    {}
+func (*QueryChannelTransferStatusesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{15}
+}
+func (m *QueryChannelTransferStatusesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelTransferStatusesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelTransferStatusesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelTransferStatusesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelTransferStatusesResponse.Merge(m, src)
+}
+func (m *QueryChannelTransferStatusesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelTransferStatusesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelTransferStatusesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelTransferStatusesResponse proto.InternalMessageInfo
+
+func (m *QueryChannelTransferStatusesResponse) GetChannelTransferStatuses() []ChannelTransferStatus {
+	if m != nil {
+		return m.ChannelTransferStatuses
+	}
+	return nil
+}
+
+func (m *QueryChannelTransferStatusesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBlockedReceiversRequest is the request type for the Query/BlockedReceivers RPC method.
+type QueryBlockedReceiversRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBlockedReceiversRequest) Reset()         { *m = QueryBlockedReceiversRequest{} }
+func (m *QueryBlockedReceiversRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedReceiversRequest) ProtoMessage()    {}
+func (*QueryBlockedReceiversRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{16}
+}
+func (m *QueryBlockedReceiversRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockedReceiversRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockedReceiversRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockedReceiversRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedReceiversRequest.Merge(m, src)
+}
+func (m *QueryBlockedReceiversRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockedReceiversRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedReceiversRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockedReceiversRequest proto.InternalMessageInfo
+
+func (m *QueryBlockedReceiversRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBlockedReceiversResponse is the response type for the Query/BlockedReceivers RPC method.
+type QueryBlockedReceiversResponse struct {
+	// blocked_receivers returns the receiver addresses which are blocked from receiving transfers
+	BlockedReceivers []string `protobuf:"bytes,1,rep,name=blocked_receivers,json=blockedReceivers,proto3" json:"blocked_receivers,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBlockedReceiversResponse) Reset()         { *m = QueryBlockedReceiversResponse{} }
+func (m *QueryBlockedReceiversResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedReceiversResponse) ProtoMessage()    {}
+func (*QueryBlockedReceiversResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{17}
+}
+func (m *QueryBlockedReceiversResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockedReceiversResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockedReceiversResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockedReceiversResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedReceiversResponse.Merge(m, src)
+}
+func (m *QueryBlockedReceiversResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockedReceiversResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedReceiversResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockedReceiversResponse proto.InternalMessageInfo
+
+func (m *QueryBlockedReceiversResponse) GetBlockedReceivers() []string {
+	if m != nil {
+		return m.BlockedReceivers
+	}
+	return nil
+}
+
+func (m *QueryBlockedReceiversResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "ibc.applications.transfer.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ibc.applications.transfer.v1.QueryParamsResponse")
@@ -609,6 +912,12 @@ func init() {
 	proto.RegisterType((*QueryEscrowAddressResponse)(nil), "ibc.applications.transfer.v1.QueryEscrowAddressResponse")
 	proto.RegisterType((*QueryTotalEscrowForDenomRequest)(nil), "ibc.applications.transfer.v1.QueryTotalEscrowForDenomRequest")
 	proto.RegisterType((*QueryTotalEscrowForDenomResponse)(nil), "ibc.applications.transfer.v1.QueryTotalEscrowForDenomResponse")
+	proto.RegisterType((*QueryDenomTransferStatusesRequest)(nil), "ibc.applications.transfer.v1.QueryDenomTransferStatusesRequest")
+	proto.RegisterType((*QueryDenomTransferStatusesResponse)(nil), "ibc.applications.transfer.v1.QueryDenomTransferStatusesResponse")
+	proto.RegisterType((*QueryChannelTransferStatusesRequest)(nil), "ibc.applications.transfer.v1.QueryChannelTransferStatusesRequest")
+	proto.RegisterType((*QueryChannelTransferStatusesResponse)(nil), "ibc.applications.transfer.v1.QueryChannelTransferStatusesResponse")
+	proto.RegisterType((*QueryBlockedReceiversRequest)(nil), "ibc.applications.transfer.v1.QueryBlockedReceiversRequest")
+	proto.RegisterType((*QueryBlockedReceiversResponse)(nil), "ibc.applications.transfer.v1.QueryBlockedReceiversResponse")
 }
 
 func init() {
@@ -616,58 +925,73 @@ func init() {
 }
 
 var fileDescriptor_a638e2800a01538c = []byte{
-	// 804 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0x5d, 0x4f, 0x13, 0x4d,
-	0x14, 0xc7, 0xbb, 0x3c, 0xb4, 0xcf, 0xd3, 0x79, 0x02, 0x89, 0x43, 0x7d, 0xa1, 0xc1, 0x85, 0xac,
-	0x08, 0x04, 0x65, 0x87, 0x82, 0x06, 0x4c, 0xc4, 0x44, 0x50, 0x14, 0x35, 0x11, 0x8b, 0x57, 0x6a,
-	0xd2, 0x4c, 0xb7, 0xe3, 0x76, 0x63, 0xbb, 0xb3, 0xec, 0x6c, 0x6b, 0x48, 0xc3, 0x8d, 0x9f, 0xc0,
-	0x84, 0x3b, 0xbf, 0x80, 0x89, 0xc6, 0xef, 0x41, 0xe2, 0x0d, 0x89, 0x89, 0xf1, 0x4a, 0x0d, 0xf8,
-	0x41, 0xcc, 0xce, 0x9c, 0x2d, 0x5d, 0x2c, 0x65, 0xf1, 0xae, 0x9d, 0x39, 0xff, 0x73, 0x7e, 0x73,
-	0xde, 0xb2, 0x68, 0xca, 0x29, 0x5b, 0x84, 0x7a, 0x5e, 0xcd, 0xb1, 0x68, 0xe0, 0x70, 0x57, 0x90,
-	0xc0, 0xa7, 0xae, 0x78, 0xc9, 0x7c, 0xd2, 0x2c, 0x90, 0xcd, 0x06, 0xf3, 0xb7, 0x4c, 0xcf, 0xe7,
-	0x01, 0xc7, 0x23, 0x4e, 0xd9, 0x32, 0x3b, 0x2d, 0xcd, 0xc8, 0xd2, 0x6c, 0x16, 0xf2, 0x39, 0x9b,
-	0xdb, 0x5c, 0x1a, 0x92, 0xf0, 0x97, 0xd2, 0xe4, 0x75, 0x8b, 0x8b, 0x3a, 0x17, 0xa4, 0x4c, 0x05,
-	0x23, 0xcd, 0x42, 0x99, 0x05, 0xb4, 0x40, 0x2c, 0xee, 0xb8, 0x70, 0x7f, 0xa5, 0x67, 0xf4, 0xb6,
-	0x7f, 0x65, 0xdc, 0x1b, 0x35, 0xe0, 0xaf, 0x58, 0xe4, 0x76, 0xba, 0x33, 0xac, 0x7c, 0x43, 0x3b,
-	0xb8, 0x47, 0x6d, 0xc7, 0x95, 0x72, 0xb0, 0x1d, 0xb1, 0x39, 0xb7, 0x6b, 0x8c, 0x50, 0xcf, 0x21,
-	0xd4, 0x75, 0x79, 0x00, 0x8f, 0x93, 0xb7, 0x46, 0x0e, 0xe1, 0x27, 0xa1, 0x7e, 0x9d, 0xfa, 0xb4,
-	0x2e, 0x8a, 0x6c, 0xb3, 0xc1, 0x44, 0x60, 0x6c, 0xa0, 0xa1, 0xd8, 0xa9, 0xf0, 0xb8, 0x2b, 0x18,
-	0xbe, 0x89, 0x32, 0x9e, 0x3c, 0xb9, 0xa0, 0x8d, 0x69, 0x53, 0xff, 0xcf, 0x8d, 0x9b, 0xbd, 0x52,
-	0x66, 0x82, 0x1a, 0x34, 0xc6, 0x24, 0x3a, 0x23, 0x9d, 0xde, 0x61, 0x2e, 0xaf, 0x43, 0x24, 0x8c,
-	0x51, 0x7f, 0x95, 0x8a, 0xaa, 0x74, 0x98, 0x2d, 0xca, 0xdf, 0xc6, 0x63, 0x60, 0x02, 0x43, 0x08,
-	0x7e, 0x03, 0xa5, 0x2b, 0xe1, 0x01, 0xc4, 0xbe, 0xd4, 0x3b, 0xb6, 0xd2, 0x2a, 0x85, 0xf1, 0xa2,
-	0xd3, 0x61, 0xf4, 0x48, 0xbc, 0x8a, 0xd0, 0x61, 0xb2, 0xc0, 0xeb, 0x84, 0xa9, 0x32, 0x6b, 0x86,
-	0x99, 0x35, 0x55, 0x77, 0x40, 0x66, 0xcd, 0x75, 0x6a, 0x33, 0xd0, 0x16, 0x3b, 0x94, 0xc6, 0x47,
-	0x0d, 0xb2, 0x15, 0xb9, 0x07, 0xe0, 0x87, 0x28, 0x23, 0xc3, 0x87, 0xd9, 0xfa, 0x27, 0x21, 0xf1,
-	0xf2, 0xe0, 0xee, 0xf7, 0xd1, 0xd4, 0x87, 0x1f, 0xa3, 0x19, 0x70, 0x06, 0x2e, 0xf0, 0xbd, 0x18,
-	0x6c, 0x9f, 0x84, 0x9d, 0x3c, 0x11, 0x56, 0x91, 0xc4, 0x68, 0x67, 0xd0, 0xd9, 0x43, 0xd8, 0xfb,
-	0x54, 0x54, 0xa3, 0x74, 0xe4, 0x50, 0x3a, 0xf0, 0xa9, 0xc5, 0xa0, 0x14, 0xea, 0x8f, 0x71, 0x15,
-	0x9d, 0x3b, 0x6a, 0x0e, 0xcf, 0xeb, 0x56, 0xb9, 0x0d, 0x34, 0x2c, 0xad, 0xef, 0x0a, 0xcb, 0xe7,
-	0xaf, 0x6f, 0x57, 0x2a, 0x3e, 0x13, 0xed, 0x7c, 0x9f, 0x47, 0xff, 0x7a, 0xdc, 0x0f, 0x4a, 0x4e,
-	0x05, 0x34, 0x99, 0xf0, 0xef, 0x5a, 0x05, 0x5f, 0x44, 0xc8, 0xaa, 0x52, 0xd7, 0x65, 0xb5, 0xf0,
-	0xae, 0x4f, 0xde, 0x65, 0xe1, 0x64, 0xad, 0x62, 0xac, 0xa0, 0x7c, 0x37, 0xa7, 0x80, 0x71, 0x19,
-	0x0d, 0x32, 0x79, 0x51, 0xa2, 0xea, 0x06, 0x9c, 0x0f, 0xb0, 0x4e, 0x73, 0x63, 0x01, 0x8d, 0x4a,
-	0x27, 0x4f, 0x79, 0x40, 0x6b, 0xca, 0xd3, 0x2a, 0xf7, 0x63, 0xad, 0x98, 0xeb, 0x6c, 0xb0, 0x6c,
-	0xd4, 0x3b, 0xcf, 0xd1, 0xd8, 0xf1, 0x42, 0x60, 0x58, 0x40, 0x19, 0x5a, 0xe7, 0x0d, 0x37, 0x80,
-	0x2e, 0x1a, 0x8e, 0x15, 0x26, 0x2a, 0xc9, 0x0a, 0x77, 0xdc, 0xe5, 0xfe, 0xb0, 0xbe, 0x45, 0x30,
-	0x9f, 0x7b, 0xff, 0x1f, 0x4a, 0x4b, 0xef, 0x78, 0x47, 0x43, 0x19, 0x35, 0x2f, 0x78, 0xb6, 0x77,
-	0x9f, 0xfc, 0x39, 0xae, 0xf9, 0xc2, 0x29, 0x14, 0x0a, 0xd9, 0x18, 0x7f, 0xf3, 0xe5, 0xd7, 0x4e,
-	0x9f, 0x8e, 0x47, 0x08, 0x2c, 0x9d, 0xf8, 0xb2, 0x51, 0x23, 0x2b, 0xa9, 0x54, 0x23, 0x26, 0xa2,
-	0x8a, 0xcd, 0x57, 0x22, 0xaa, 0xf8, 0xc8, 0x9c, 0x44, 0x05, 0xb3, 0xf0, 0x4e, 0x43, 0x69, 0x29,
-	0xc4, 0x24, 0x69, 0x88, 0x88, 0x69, 0x36, 0xb9, 0x00, 0x90, 0x4c, 0x89, 0x34, 0x85, 0x27, 0x7a,
-	0x21, 0x91, 0x56, 0xd8, 0xfe, 0x4b, 0xd3, 0xd3, 0xdb, 0xf8, 0x93, 0x86, 0xb2, 0xed, 0x61, 0xc1,
-	0xf3, 0x49, 0xe3, 0x75, 0x4c, 0x62, 0xfe, 0xda, 0xe9, 0x44, 0x00, 0x7a, 0x5d, 0x82, 0x12, 0x3c,
-	0xd3, 0x03, 0xb4, 0x14, 0x62, 0x32, 0x41, 0x5a, 0x72, 0xb8, 0x25, 0xef, 0x57, 0x0d, 0x0d, 0xc4,
-	0x26, 0x0b, 0x2f, 0x24, 0x08, 0xdf, 0x6d, 0xc0, 0xf3, 0x8b, 0xa7, 0x17, 0x02, 0x7b, 0x51, 0xb2,
-	0x3f, 0xc2, 0x0f, 0xba, 0xb3, 0xc3, 0x2e, 0x10, 0xa4, 0x75, 0xb8, 0x27, 0xb6, 0x49, 0xb8, 0x3d,
-	0x04, 0x69, 0xc1, 0x4e, 0xd9, 0x26, 0xf1, 0x35, 0x80, 0x3f, 0x6b, 0x68, 0xa8, 0xcb, 0xd0, 0xe2,
-	0xa5, 0x04, 0x94, 0xc7, 0x6f, 0x89, 0xfc, 0xad, 0xbf, 0x95, 0x27, 0x2b, 0x53, 0x10, 0x4a, 0x4b,
-	0xea, 0x29, 0xa4, 0x25, 0x8b, 0x16, 0x96, 0x69, 0xb9, 0xb8, 0xbb, 0xaf, 0x6b, 0x7b, 0xfb, 0xba,
-	0xf6, 0x73, 0x5f, 0xd7, 0xde, 0x1e, 0xe8, 0xa9, 0xbd, 0x03, 0x3d, 0xf5, 0xed, 0x40, 0x4f, 0x3d,
-	0x5b, 0xb4, 0x9d, 0xa0, 0xda, 0x28, 0x9b, 0x16, 0xaf, 0x13, 0xf8, 0x2c, 0x70, 0xca, 0xd6, 0x8c,
-	0xcd, 0x49, 0xb3, 0x30, 0x4b, 0xea, 0xbc, 0xd2, 0xa8, 0x31, 0x71, 0x24, 0x50, 0xb0, 0xe5, 0x31,
-	0x51, 0xce, 0xc8, 0x4f, 0x80, 0xf9, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa4, 0x29, 0xac, 0x94,
-	0x23, 0x09, 0x00, 0x00,
+	// 1043 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x5f, 0x6f, 0xdb, 0x54,
+	0x14, 0xaf, 0xcb, 0x1a, 0x94, 0x83, 0x3a, 0x6d, 0x77, 0x2d, 0xa5, 0x56, 0x97, 0x0e, 0xaf, 0xac,
+	0x5d, 0x47, 0x7d, 0x9b, 0x15, 0xd4, 0xf1, 0x67, 0xc0, 0x52, 0x18, 0x0c, 0x90, 0x18, 0xe9, 0x9e,
+	0x00, 0x29, 0xba, 0xb6, 0xef, 0x12, 0xab, 0x89, 0xaf, 0xe7, 0xeb, 0x04, 0x4d, 0x51, 0x5f, 0xf8,
+	0x04, 0x48, 0x13, 0x3c, 0xf0, 0x11, 0x40, 0x7c, 0x03, 0x3e, 0xc0, 0x24, 0x5e, 0x86, 0x90, 0x10,
+	0xbc, 0x0c, 0xd4, 0x22, 0x3e, 0x07, 0xf2, 0xf5, 0x71, 0x1b, 0xa7, 0x8e, 0xeb, 0x96, 0xf0, 0x96,
+	0xf8, 0x9e, 0xdf, 0x39, 0xbf, 0xdf, 0xef, 0x1e, 0xe5, 0x17, 0xc3, 0x8a, 0x6b, 0xd9, 0x94, 0xf9,
+	0x7e, 0xdb, 0xb5, 0x59, 0xe8, 0x0a, 0x4f, 0xd2, 0x30, 0x60, 0x9e, 0xbc, 0xcf, 0x03, 0xda, 0xab,
+	0xd2, 0x07, 0x5d, 0x1e, 0x3c, 0x34, 0xfd, 0x40, 0x84, 0x82, 0x2c, 0xb8, 0x96, 0x6d, 0x0e, 0x56,
+	0x9a, 0x49, 0xa5, 0xd9, 0xab, 0xea, 0x33, 0x4d, 0xd1, 0x14, 0xaa, 0x90, 0x46, 0x9f, 0x62, 0x8c,
+	0x5e, 0xb1, 0x85, 0xec, 0x08, 0x49, 0x2d, 0x26, 0x39, 0xed, 0x55, 0x2d, 0x1e, 0xb2, 0x2a, 0xb5,
+	0x85, 0xeb, 0xe1, 0xf9, 0xb5, 0xdc, 0xe9, 0x07, 0xfd, 0xe3, 0xe2, 0x7c, 0xaa, 0xa1, 0xd8, 0xe1,
+	0x49, 0xdb, 0xab, 0xb9, 0x95, 0xbe, 0x68, 0xbb, 0x36, 0xaa, 0xd2, 0x57, 0x07, 0x19, 0x2a, 0xb9,
+	0x07, 0x3c, 0x7d, 0xd6, 0x74, 0x3d, 0x85, 0xc7, 0xda, 0x85, 0xa6, 0x10, 0xcd, 0x36, 0xa7, 0xcc,
+	0x77, 0x29, 0xf3, 0x3c, 0x11, 0xa2, 0x0f, 0xea, 0xd4, 0x98, 0x01, 0xf2, 0x69, 0x84, 0xbf, 0xcb,
+	0x02, 0xd6, 0x91, 0x75, 0xfe, 0xa0, 0xcb, 0x65, 0x68, 0x6c, 0xc3, 0x85, 0xd4, 0x53, 0xe9, 0x0b,
+	0x4f, 0x72, 0xf2, 0x26, 0x94, 0x7c, 0xf5, 0xe4, 0x05, 0xed, 0x92, 0xb6, 0xf2, 0xdc, 0xf5, 0x25,
+	0x33, 0xcf, 0x5d, 0x13, 0xd1, 0x88, 0x31, 0x96, 0xe1, 0xbc, 0x6a, 0xfa, 0x2e, 0xf7, 0x44, 0x07,
+	0x27, 0x11, 0x02, 0x67, 0x5a, 0x4c, 0xb6, 0x54, 0xc3, 0x72, 0x5d, 0x7d, 0x36, 0x3e, 0x41, 0x4e,
+	0x58, 0x88, 0xc3, 0x5f, 0x83, 0x29, 0x27, 0x7a, 0x80, 0xb3, 0x2f, 0xe7, 0xcf, 0x8e, 0xb1, 0x31,
+	0xc2, 0xf8, 0x62, 0xb0, 0x61, 0x22, 0x92, 0xdc, 0x06, 0x38, 0x34, 0x0b, 0xbb, 0x5e, 0x31, 0x63,
+	0x67, 0xcd, 0xc8, 0x59, 0x33, 0x5e, 0x24, 0x74, 0xd6, 0xbc, 0xcb, 0x9a, 0x1c, 0xb1, 0xf5, 0x01,
+	0xa4, 0xf1, 0x83, 0x86, 0x6e, 0x25, 0xed, 0x91, 0xf0, 0x47, 0x50, 0x52, 0xe3, 0x23, 0xb7, 0x9e,
+	0x29, 0xc8, 0xb8, 0x76, 0xf6, 0xf1, 0xd3, 0xc5, 0x89, 0xef, 0xff, 0x5c, 0x2c, 0x61, 0x33, 0x6c,
+	0x41, 0xde, 0x4f, 0x91, 0x9d, 0x54, 0x64, 0x97, 0x8f, 0x25, 0x1b, 0x33, 0x49, 0xb1, 0x5d, 0x83,
+	0xd9, 0x43, 0xb2, 0x1f, 0x30, 0xd9, 0x4a, 0xec, 0x98, 0x81, 0xa9, 0x30, 0x60, 0x36, 0xc7, 0xab,
+	0x88, 0xbf, 0x18, 0x2f, 0xc3, 0xf3, 0xc3, 0xe5, 0x28, 0x2f, 0xeb, 0xe6, 0xb6, 0x61, 0x5e, 0x55,
+	0xbf, 0x27, 0xed, 0x40, 0x7c, 0x79, 0xcb, 0x71, 0x02, 0x2e, 0x0f, 0xfc, 0x9e, 0x83, 0x67, 0x7d,
+	0x11, 0x84, 0x0d, 0xd7, 0x41, 0x4c, 0x29, 0xfa, 0x7a, 0xc7, 0x21, 0x17, 0x01, 0xec, 0x16, 0xf3,
+	0x3c, 0xde, 0x8e, 0xce, 0x26, 0xd5, 0x59, 0x19, 0x9f, 0xdc, 0x71, 0x8c, 0x2d, 0xd0, 0xb3, 0x9a,
+	0x22, 0x8d, 0x97, 0xe0, 0x2c, 0x57, 0x07, 0x0d, 0x16, 0x9f, 0x60, 0xf3, 0x69, 0x3e, 0x58, 0x6e,
+	0x6c, 0xc2, 0xa2, 0x6a, 0x72, 0x4f, 0x84, 0xac, 0x1d, 0x77, 0xba, 0x2d, 0x82, 0xd4, 0x2a, 0xce,
+	0x0c, 0x2e, 0x58, 0x39, 0xd9, 0x9d, 0xcf, 0xe1, 0xd2, 0x68, 0x20, 0x72, 0xd8, 0x84, 0x12, 0xeb,
+	0x88, 0xae, 0x17, 0xe2, 0x16, 0xcd, 0xa7, 0x2e, 0x26, 0xb9, 0x92, 0x2d, 0xe1, 0x7a, 0xb5, 0x33,
+	0xd1, 0xfd, 0xd6, 0xb1, 0xdc, 0xd8, 0x81, 0x17, 0x0f, 0xdd, 0xbd, 0x87, 0xcb, 0xb0, 0x1d, 0xb2,
+	0xb0, 0x2b, 0xf9, 0xd8, 0xf7, 0xf4, 0xa9, 0x06, 0x46, 0xde, 0x34, 0x14, 0x23, 0x60, 0x4e, 0x29,
+	0x6f, 0x24, 0xcb, 0xd9, 0x90, 0x58, 0x82, 0x7b, 0x5c, 0x2d, 0xb0, 0xc7, 0xe9, 0xee, 0xa8, 0x7a,
+	0xd6, 0xc9, 0x1a, 0x3c, 0xbe, 0xd5, 0xee, 0xc0, 0x65, 0xa5, 0x6f, 0x2b, 0x5e, 0x9d, 0xff, 0xdb,
+	0xcf, 0x7f, 0x34, 0x58, 0xca, 0x9f, 0x87, 0x8e, 0x76, 0x61, 0x3e, 0xd9, 0xef, 0x51, 0x9e, 0x6e,
+	0xe4, 0x7b, 0x9a, 0x39, 0x01, 0x5d, 0x9d, 0xb3, 0xb3, 0xc7, 0x8f, 0xcf, 0xd7, 0xfb, 0xb0, 0xa0,
+	0x74, 0xd6, 0xda, 0xc2, 0xde, 0xe1, 0x4e, 0x9d, 0xdb, 0xdc, 0xed, 0xf1, 0x60, 0xec, 0x86, 0x7e,
+	0xa3, 0xc1, 0xc5, 0x11, 0x83, 0xd0, 0xc9, 0x6b, 0x70, 0xde, 0x8a, 0xcf, 0x1a, 0x41, 0x72, 0xa8,
+	0x1c, 0x2c, 0xd7, 0xcf, 0x59, 0x43, 0xa0, 0xb1, 0xe9, 0xbf, 0xfe, 0xed, 0x34, 0x4c, 0x29, 0x5e,
+	0xe4, 0x91, 0x06, 0xa5, 0x38, 0xd5, 0xc8, 0x7a, 0xfe, 0x8d, 0x1d, 0x0d, 0x55, 0xbd, 0x7a, 0x02,
+	0x44, 0xcc, 0xc2, 0x58, 0xfa, 0xea, 0xd7, 0xbf, 0x1f, 0x4d, 0x56, 0xc8, 0x02, 0xc5, 0xff, 0x06,
+	0x43, 0xff, 0x09, 0x62, 0x2a, 0x11, 0xab, 0x38, 0x2e, 0x0a, 0xb1, 0x4a, 0xa5, 0x60, 0x21, 0x56,
+	0xe9, 0x60, 0x3b, 0x8e, 0x15, 0x26, 0xd6, 0x77, 0x1a, 0x4c, 0x29, 0x20, 0xa1, 0x45, 0x47, 0x24,
+	0x9c, 0xd6, 0x8b, 0x03, 0x90, 0x92, 0xa9, 0x28, 0xad, 0x90, 0x2b, 0x79, 0x94, 0x68, 0x3f, 0x0a,
+	0xa9, 0x9b, 0xab, 0xab, 0xbb, 0xe4, 0x47, 0x0d, 0xca, 0x07, 0x91, 0x46, 0x36, 0x8a, 0xce, 0x1b,
+	0xc8, 0x4b, 0xfd, 0x95, 0x93, 0x81, 0x90, 0xe8, 0xab, 0x8a, 0x28, 0x25, 0x6b, 0x39, 0x44, 0x1b,
+	0x11, 0x4d, 0x2e, 0x69, 0x5f, 0x45, 0xb0, 0xe2, 0xfb, 0x9b, 0x06, 0xd3, 0xa9, 0xfc, 0x23, 0x9b,
+	0x05, 0xc6, 0x67, 0xc5, 0xb0, 0x7e, 0xe3, 0xe4, 0x40, 0xe4, 0x5e, 0x57, 0xdc, 0x3f, 0x26, 0x1f,
+	0x66, 0x73, 0xc7, 0xdf, 0x21, 0x49, 0xfb, 0x87, 0x69, 0xbe, 0x4b, 0xa3, 0x8c, 0x97, 0xb4, 0x8f,
+	0xc9, 0xbf, 0x4b, 0xd3, 0x61, 0x4d, 0x7e, 0xd6, 0xe0, 0x42, 0x46, 0xb4, 0x92, 0x9b, 0x05, 0x58,
+	0x8e, 0xce, 0x72, 0xfd, 0xad, 0xd3, 0xc2, 0x8b, 0x5d, 0x53, 0x18, 0x41, 0x1b, 0xb1, 0x14, 0xda,
+	0x57, 0x97, 0xa6, 0xae, 0xe9, 0x17, 0x0d, 0x66, 0x33, 0xd3, 0x95, 0xbc, 0x5d, 0x74, 0x5b, 0x46,
+	0xa4, 0x96, 0xfe, 0xce, 0xe9, 0x1b, 0x9c, 0x64, 0xf5, 0x8e, 0x04, 0x14, 0xf9, 0x43, 0x83, 0xb9,
+	0x11, 0x09, 0x47, 0x6e, 0x15, 0x20, 0x95, 0x9f, 0xc6, 0x7a, 0xed, 0xbf, 0xb4, 0x40, 0x65, 0x9b,
+	0x4a, 0x59, 0x95, 0xd0, 0xdc, 0xc5, 0xcc, 0xd0, 0xf6, 0x93, 0x06, 0xe7, 0x86, 0xc3, 0x86, 0xbc,
+	0x5e, 0x80, 0xd1, 0x88, 0x28, 0xd4, 0xdf, 0x38, 0x15, 0x16, 0x65, 0x50, 0x25, 0xe3, 0x2a, 0x59,
+	0xce, 0x96, 0x71, 0x24, 0xf9, 0x6a, 0xf5, 0xc7, 0x7b, 0x15, 0xed, 0xc9, 0x5e, 0x45, 0xfb, 0x6b,
+	0xaf, 0xa2, 0x7d, 0xbd, 0x5f, 0x99, 0x78, 0xb2, 0x5f, 0x99, 0xf8, 0x7d, 0xbf, 0x32, 0xf1, 0xd9,
+	0x8d, 0xa6, 0x1b, 0xb6, 0xba, 0x96, 0x69, 0x8b, 0x0e, 0xc5, 0x77, 0x45, 0xd7, 0xb2, 0xd7, 0x9a,
+	0x82, 0xf6, 0xaa, 0xeb, 0xb4, 0x23, 0x9c, 0x6e, 0x9b, 0xcb, 0xa1, 0x11, 0xe1, 0x43, 0x9f, 0x4b,
+	0xab, 0xa4, 0xde, 0x0b, 0x37, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x80, 0xe2, 0x64, 0x28, 0x63,
+	0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -694,6 +1018,12 @@ type QueryClient interface {
 	EscrowAddress(ctx context.Context, in *QueryEscrowAddressRequest, opts ...grpc.CallOption) (*QueryEscrowAddressResponse, error)
 	// TotalEscrowForDenom returns the total amount of tokens in escrow based on the denom.
 	TotalEscrowForDenom(ctx context.Context, in *QueryTotalEscrowForDenomRequest, opts ...grpc.CallOption) (*QueryTotalEscrowForDenomResponse, error)
+	// DenomTransferStatuses queries the transfer statuses of all denominations.
+	DenomTransferStatuses(ctx context.Context, in *QueryDenomTransferStatusesRequest, opts ...grpc.CallOption) (*QueryDenomTransferStatusesResponse, error)
+	// ChannelTransferStatuses queries the transfer statuses of all channels and clients.
+	ChannelTransferStatuses(ctx context.Context, in *QueryChannelTransferStatusesRequest, opts ...grpc.CallOption) (*QueryChannelTransferStatusesResponse, error)
+	// BlockedReceivers queries all the receiver addresses which are blocked from receiving transfers.
+	BlockedReceivers(ctx context.Context, in *QueryBlockedReceiversRequest, opts ...grpc.CallOption) (*QueryBlockedReceiversResponse, error)
 }
 
 type queryClient struct {
@@ -758,6 +1088,33 @@ func (c *queryClient) TotalEscrowForDenom(ctx context.Context, in *QueryTotalEsc
 	return out, nil
 }
 
+func (c *queryClient) DenomTransferStatuses(ctx context.Context, in *QueryDenomTransferStatusesRequest, opts ...grpc.CallOption) (*QueryDenomTransferStatusesResponse, error) {
+	out := new(QueryDenomTransferStatusesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/DenomTransferStatuses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChannelTransferStatuses(ctx context.Context, in *QueryChannelTransferStatusesRequest, opts ...grpc.CallOption) (*QueryChannelTransferStatusesResponse, error) {
+	out := new(QueryChannelTransferStatusesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/ChannelTransferStatuses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockedReceivers(ctx context.Context, in *QueryBlockedReceiversRequest, opts ...grpc.CallOption) (*QueryBlockedReceiversResponse, error) {
+	out := new(QueryBlockedReceiversResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/BlockedReceivers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries all parameters of the ibc-transfer module.
@@ -772,6 +1129,12 @@ type QueryServer interface {
 	EscrowAddress(context.Context, *QueryEscrowAddressRequest) (*QueryEscrowAddressResponse, error)
 	// TotalEscrowForDenom returns the total amount of tokens in escrow based on the denom.
 	TotalEscrowForDenom(context.Context, *QueryTotalEscrowForDenomRequest) (*QueryTotalEscrowForDenomResponse, error)
+	// DenomTransferStatuses queries the transfer statuses of all denominations.
+	DenomTransferStatuses(context.Context, *QueryDenomTransferStatusesRequest) (*QueryDenomTransferStatusesResponse, error)
+	// ChannelTransferStatuses queries the transfer statuses of all channels and clients.
+	ChannelTransferStatuses(context.Context, *QueryChannelTransferStatusesRequest) (*QueryChannelTransferStatusesResponse, error)
+	// BlockedReceivers queries all the receiver addresses which are blocked from receiving transfers.
+	BlockedReceivers(context.Context, *QueryBlockedReceiversRequest) (*QueryBlockedReceiversResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -796,6 +1159,15 @@ func (*UnimplementedQueryServer) EscrowAddress(ctx context.Context, req *QueryEs
 func (*UnimplementedQueryServer) TotalEscrowForDenom(ctx context.Context, req *QueryTotalEscrowForDenomRequest) (*QueryTotalEscrowForDenomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalEscrowForDenom not implemented")
 }
+func (*UnimplementedQueryServer) DenomTransferStatuses(ctx context.Context, req *QueryDenomTransferStatusesRequest) (*QueryDenomTransferStatusesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomTransferStatuses not implemented")
+}
+func (*UnimplementedQueryServer) ChannelTransferStatuses(ctx context.Context, req *QueryChannelTransferStatusesRequest) (*QueryChannelTransferStatusesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelTransferStatuses not implemented")
+}
+func (*UnimplementedQueryServer) BlockedReceivers(ctx context.Context, req *QueryBlockedReceiversRequest) (*QueryBlockedReceiversResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockedReceivers not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -909,6 +1281,60 @@ func _Query_TotalEscrowForDenom_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DenomTransferStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomTransferStatusesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomTransferStatuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Query/DenomTransferStatuses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomTransferStatuses(ctx, req.(*QueryDenomTransferStatusesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelTransferStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelTransferStatusesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelTransferStatuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Query/ChannelTransferStatuses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelTransferStatuses(ctx, req.(*QueryChannelTransferStatusesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockedReceivers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockedReceiversRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockedReceivers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Query/BlockedReceivers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockedReceivers(ctx, req.(*QueryBlockedReceiversRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.transfer.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -937,6 +1363,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TotalEscrowForDenom",
 			Handler:    _Query_TotalEscrowForDenom_Handler,
 		},
+		{
+			MethodName: "DenomTransferStatuses",
+			Handler:    _Query_DenomTransferStatuses_Handler,
+		},
+		{
+			MethodName: "ChannelTransferStatuses",
+			Handler:    _Query_ChannelTransferStatuses_Handler,
+		},
+		{
+			MethodName: "BlockedReceivers",
+			Handler:    _Query_BlockedReceivers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/transfer/v1/query.proto",
@@ -1339,7 +1777,254 @@ func (m *QueryTotalEscrowForDenomResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+func (m *QueryDenomTransferStatusesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomTransferStatusesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomTransferStatusesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomTransferStatusesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomTransferStatusesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomTransferStatusesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomTransferStatuses) > 0 {
+		for iNdEx := len(m.DenomTransferStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomTransferStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelTransferStatusesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelTransferStatusesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelTransferStatusesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelTransferStatusesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelTransferStatusesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelTransferStatusesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelTransferStatuses) > 0 {
+		for iNdEx := len(m.ChannelTransferStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelTransferStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockedReceiversRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockedReceiversRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockedReceiversRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockedReceiversResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockedReceiversResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockedReceiversResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BlockedReceivers) > 0 {
+		for iNdEx := len(m.BlockedReceivers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockedReceivers[iNdEx])
+			copy(dAtA[i:], m.BlockedReceivers[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockedReceivers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
 	for v >= 1<<7 {
@@ -1510,20 +2195,116 @@ func (m *QueryTotalEscrowForDenomResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryDenomTransferStatusesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryDenomTransferStatusesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DenomTransferStatuses) > 0 {
+		for _, e := range m.DenomTransferStatuses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
+
+func (m *QueryChannelTransferStatusesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelTransferStatusesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChannelTransferStatuses) > 0 {
+		for _, e := range m.ChannelTransferStatuses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockedReceiversRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockedReceiversResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlockedReceivers) > 0 {
+		for _, s := range m.BlockedReceivers {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
 			if iNdEx >= l {
@@ -1627,9 +2408,743 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if m.Params == nil {
 				m.Params = &Params{}
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Denom == nil {
+				m.Denom = &Denom{}
+			}
+			if err := m.Denom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, Denom{})
+			if err := m.Denoms[len(m.Denoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomHashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomHashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomHashResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomHashResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.EscrowAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1652,7 +3167,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalEscrowForDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1675,15 +3190,15 @@ func (m *QueryDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalEscrowForDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalEscrowForDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1711,7 +3226,7 @@ func (m *QueryDenomRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1734,7 +3249,7 @@ func (m *QueryDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalEscrowForDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1757,15 +3272,15 @@ func (m *QueryDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalEscrowForDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalEscrowForDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1792,10 +3307,7 @@ func (m *QueryDenomResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Denom == nil {
-				m.Denom = &Denom{}
-			}
-			if err := m.Denom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1820,7 +3332,7 @@ func (m *QueryDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomTransferStatusesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1843,10 +3355,10 @@ func (m *QueryDenomsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomTransferStatusesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomTransferStatusesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1906,7 +3418,7 @@ func (m *QueryDenomsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomTransferStatusesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1929,15 +3441,15 @@ func (m *QueryDenomsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomTransferStatusesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomTransferStatusesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomTransferStatuses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1964,8 +3476,8 @@ func (m *QueryDenomsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denoms = append(m.Denoms, Denom{})
-			if err := m.Denoms[len(m.Denoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DenomTransferStatuses = append(m.DenomTransferStatuses, DenomTransferStatus{})
+			if err := m.DenomTransferStatuses[len(m.DenomTransferStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2026,7 +3538,7 @@ func (m *QueryDenomsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomHashRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryChannelTransferStatusesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2049,17 +3561,17 @@ func (m *QueryDenomHashRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomHashRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChannelTransferStatusesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChannelTransferStatusesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2069,105 +3581,27 @@ func (m *QueryDenomHashRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Trace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryDenomHashResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomHashResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2190,7 +3624,7 @@ func (m *QueryDenomHashResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEscrowAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryChannelTransferStatusesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2213,17 +3647,17 @@ func (m *QueryEscrowAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEscrowAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChannelTransferStatusesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEscrowAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChannelTransferStatusesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelTransferStatuses", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2233,29 +3667,31 @@ func (m *QueryEscrowAddressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			m.ChannelTransferStatuses = append(m.ChannelTransferStatuses, ChannelTransferStatus{})
+			if err := m.ChannelTransferStatuses[len(m.ChannelTransferStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2265,23 +3701,27 @@ func (m *QueryEscrowAddressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2304,7 +3744,7 @@ func (m *QueryEscrowAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEscrowAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBlockedReceiversRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2327,17 +3767,17 @@ func (m *QueryEscrowAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEscrowAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlockedReceiversRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEscrowAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlockedReceiversRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2347,23 +3787,27 @@ func (m *QueryEscrowAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EscrowAddress = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2386,7 +3830,7 @@ func (m *QueryEscrowAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalEscrowForDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBlockedReceiversResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2409,15 +3853,15 @@ func (m *QueryTotalEscrowForDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalEscrowForDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlockedReceiversResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalEscrowForDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlockedReceiversResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedReceivers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2445,61 +3889,11 @@ func (m *QueryTotalEscrowForDenomRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.BlockedReceivers = append(m.BlockedReceivers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTotalEscrowForDenomResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalEscrowForDenomResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalEscrowForDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2526,7 +3920,10 @@ func (m *QueryTotalEscrowForDenomResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
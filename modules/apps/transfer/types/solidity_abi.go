@@ -109,3 +109,170 @@ func EncodeABIFungibleTokenPacketData(data *FungibleTokenPacketData) ([]byte, er
 
 	return encodedData, nil
 }
+
+// abiFungibleTokenPacketDataV2 is the Go representation of the solidity ABI tuple of an ICS20 v2 packet.
+type abiFungibleTokenPacketDataV2 struct {
+	Tokens   []abiToken `abi:"tokens"`
+	Sender   string     `abi:"sender"`
+	Receiver string     `abi:"receiver"`
+	Memo     string     `abi:"memo"`
+}
+
+type abiToken struct {
+	Denom  abiDenom `abi:"denom"`
+	Amount *big.Int `abi:"amount"`
+}
+
+type abiDenom struct {
+	Base  string   `abi:"base"`
+	Trace []abiHop `abi:"trace"`
+}
+
+type abiHop struct {
+	PortId    string `abi:"portId"`    //nolint:revive // field name must match the solidity ABI
+	ChannelId string `abi:"channelId"` //nolint:revive // field name must match the solidity ABI
+}
+
+// getICS20V2ABI returns an abi.Arguments slice describing the Solidity types of the ICS20 v2 packet data.
+func getICS20V2ABI() abi.Arguments {
+	// The Solidity types used are:
+	// - tuple(string base, tuple(string portId, string channelId)[] trace) for the Denom of each token.
+	// - uint256 for the Amount of each token.
+	// - string for Sender, Receiver and Memo.
+	tupleType, err := abi.NewType("tuple", "", []abi.ArgumentMarshaling{
+		{
+			Name: "tokens",
+			Type: "tuple[]",
+			Components: []abi.ArgumentMarshaling{
+				{
+					Name: "denom",
+					Type: "tuple",
+					Components: []abi.ArgumentMarshaling{
+						{
+							Name: "base",
+							Type: "string",
+						},
+						{
+							Name: "trace",
+							Type: "tuple[]",
+							Components: []abi.ArgumentMarshaling{
+								{
+									Name: "portId",
+									Type: "string",
+								},
+								{
+									Name: "channelId",
+									Type: "string",
+								},
+							},
+						},
+					},
+				},
+				{
+					Name: "amount",
+					Type: "uint256",
+				},
+			},
+		},
+		{
+			Name: "sender",
+			Type: "string",
+		},
+		{
+			Name: "receiver",
+			Type: "string",
+		},
+		{
+			Name: "memo",
+			Type: "string",
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return abi.Arguments{
+		{
+			Type: tupleType,
+		},
+	}
+}
+
+// DecodeABIFungibleTokenPacketDataV2 decodes a solidity ABI encoded ICS20 v2 packet data
+// and converts it into an ibc-go FungibleTokenPacketDataV2.
+func DecodeABIFungibleTokenPacketDataV2(data []byte) (*FungibleTokenPacketDataV2, error) {
+	arguments := getICS20V2ABI()
+
+	packetDataI, err := arguments.Unpack(data)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrAbiDecoding, "failed to unpack data: %s", err)
+	}
+
+	// the packet data is a single tuple argument, so it is copied through a pointer
+	// to populate the struct fields rather than only its first field
+	packetData := &abiFungibleTokenPacketDataV2{}
+	if err := arguments.Copy(&packetData, packetDataI); err != nil {
+		return nil, errorsmod.Wrapf(ErrAbiDecoding, "failed to parse packet data: %s", err)
+	}
+
+	tokens := make([]Token, len(packetData.Tokens))
+	for i, token := range packetData.Tokens {
+		if token.Amount == nil {
+			return nil, errorsmod.Wrapf(ErrAbiDecoding, "token %d has no amount", i)
+		}
+
+		var trace []Hop
+		for _, hop := range token.Denom.Trace {
+			trace = append(trace, NewHop(hop.PortId, hop.ChannelId))
+		}
+
+		tokens[i] = Token{
+			Denom:  NewDenom(token.Denom.Base, trace...),
+			Amount: token.Amount.String(),
+		}
+	}
+
+	return &FungibleTokenPacketDataV2{
+		Tokens:   tokens,
+		Sender:   packetData.Sender,
+		Receiver: packetData.Receiver,
+		Memo:     packetData.Memo,
+	}, nil
+}
+
+// EncodeABIFungibleTokenPacketDataV2 encodes an ibc-go FungibleTokenPacketDataV2 into its solidity ABI representation.
+func EncodeABIFungibleTokenPacketDataV2(data *FungibleTokenPacketDataV2) ([]byte, error) {
+	tokens := make([]abiToken, len(data.Tokens))
+	for i, token := range data.Tokens {
+		amount, ok := new(big.Int).SetString(token.Amount, 10)
+		if !ok {
+			return nil, errorsmod.Wrapf(ErrAbiEncoding, "failed to parse amount: %s", token.Amount)
+		}
+
+		trace := make([]abiHop, len(token.Denom.Trace))
+		for j, hop := range token.Denom.Trace {
+			trace[j] = abiHop{PortId: hop.PortId, ChannelId: hop.ChannelId}
+		}
+
+		tokens[i] = abiToken{
+			Denom:  abiDenom{Base: token.Denom.Base, Trace: trace},
+			Amount: amount,
+		}
+	}
+
+	packetData := abiFungibleTokenPacketDataV2{
+		Tokens:   tokens,
+		Sender:   data.Sender,
+		Receiver: data.Receiver,
+		Memo:     data.Memo,
+	}
+
+	arguments := getICS20V2ABI()
+	// Pack the values in the order defined in the ABI.
+	encodedData, err := arguments.Pack(packetData)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrAbiEncoding, "failed to pack data: %s", err)
+	}
+
+	return encodedData, nil
+}
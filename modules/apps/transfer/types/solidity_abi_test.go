@@ -21,3 +21,29 @@ func (suite *TypesTestSuite) TestFTPD() {
 
 	suite.Require().Equal(packetData, *decodedPacketData)
 }
+
+func (suite *TypesTestSuite) TestFTPDV2() {
+	packetData := types.NewFungibleTokenPacketDataV2(
+		types.Tokens{
+			{
+				Denom:  types.NewDenom("uatom", types.NewHop("transfer", "07-tendermint-0"), types.NewHop("transfer", "07-tendermint-1")),
+				Amount: "1000000",
+			},
+			{
+				Denom:  types.NewDenom("uosmo"),
+				Amount: "100",
+			},
+		},
+		"sender",
+		"receiver",
+		"memo",
+	)
+
+	bz, err := types.EncodeABIFungibleTokenPacketDataV2(&packetData)
+	suite.Require().NoError(err)
+
+	decodedPacketData, err := types.DecodeABIFungibleTokenPacketDataV2(bz)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(packetData, *decodedPacketData)
+}
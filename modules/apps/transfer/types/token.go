@@ -7,6 +7,8 @@ import (
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
 )
 
 // maxUint256 is the maximum value for a 256 bit unsigned integer.
@@ -45,6 +47,36 @@ func (t Token) ToCoin() (sdk.Coin, error) {
 	return coin, nil
 }
 
+// Tokens is a slice of Token
+type Tokens []Token
+
+// Validate validates the tokens. At least one and at most MaximumTokensLength tokens must be provided,
+// every token must be valid and no denomination may be repeated.
+func (t Tokens) Validate() error {
+	if len(t) == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, "tokens cannot be empty")
+	}
+
+	if len(t) > MaximumTokensLength {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "number of tokens must not exceed %d", MaximumTokensLength)
+	}
+
+	seen := make(map[string]bool, len(t))
+	for _, token := range t {
+		if err := token.Validate(); err != nil {
+			return err
+		}
+
+		path := token.Denom.Path()
+		if seen[path] {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "duplicate token denomination %s", path)
+		}
+		seen[path] = true
+	}
+
+	return nil
+}
+
 // UnboundedSpendLimit returns the sentinel value that can be used
 // as the amount for a denomination's spend limit for which spend limit updating
 // should be disabled. Please note that using this sentinel value means that a grantee
@@ -9,6 +9,8 @@ import (
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
 )
 
 const (
@@ -153,6 +155,65 @@ func TestValidate(t *testing.T) {
 	}
 }
 
+func TestTokensValidate(t *testing.T) {
+	atom := Token{Denom: NewDenom("uatom", NewHop("transfer", "channel-0")), Amount: amount}
+	osmo := Token{Denom: NewDenom("uosmo"), Amount: amount}
+
+	testCases := []struct {
+		name     string
+		tokens   Tokens
+		expError error
+	}{
+		{
+			"success: single token",
+			Tokens{atom},
+			nil,
+		},
+		{
+			"success: multiple tokens",
+			Tokens{atom, osmo},
+			nil,
+		},
+		{
+			"success: same base denom with different traces",
+			Tokens{atom, {Denom: NewDenom("uatom"), Amount: amount}},
+			nil,
+		},
+		{
+			"failure: empty tokens",
+			Tokens{},
+			ibcerrors.ErrInvalidCoins,
+		},
+		{
+			"failure: too many tokens",
+			make(Tokens, MaximumTokensLength+1),
+			ibcerrors.ErrInvalidCoins,
+		},
+		{
+			"failure: invalid token",
+			Tokens{atom, {Denom: NewDenom("uosmo"), Amount: "0"}},
+			ErrInvalidAmount,
+		},
+		{
+			"failure: duplicate denom",
+			Tokens{atom, osmo, atom},
+			ibcerrors.ErrInvalidCoins,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.tokens.Validate()
+
+			if tc.expError == nil {
+				require.NoError(t, err, tc.name)
+			} else {
+				require.ErrorIs(t, err, tc.expError, tc.name)
+			}
+		})
+	}
+}
+
 func TestToCoin(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -4,6 +4,7 @@ import (
 	"context"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/cosmos/gogoproto/proto"
 
@@ -49,6 +50,10 @@ func (a TransferAuthorization) Accept(goCtx context.Context, msg proto.Message)
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if expiration := a.Allocations[index].Expiration; expiration != nil && !ctx.BlockTime().Before(*expiration) {
+		return authz.AcceptResponse{}, errorsmod.Wrapf(ErrInvalidAuthorization, "allocation expired at %s", expiration)
+	}
+
 	if !isAllowedAddress(ctx, msgTransfer.Receiver, a.Allocations[index].AllowList) {
 		return authz.AcceptResponse{}, errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "not allowed receiver address for transfer")
 	}
@@ -57,16 +62,47 @@ func (a TransferAuthorization) Accept(goCtx context.Context, msg proto.Message)
 		return authz.AcceptResponse{}, err
 	}
 
+	if err := validateDestinationClient(msgTransfer.DestinationClient, a.Allocations[index].AllowedDestinationClients); err != nil {
+		return authz.AcceptResponse{}, err
+	}
+
 	// bool flag to see if we have updated any of the allocations
 	allocationModified := false
 
-	// update spend limit the token token in the MsgTransfer
+	coins := msgTransfer.GetCoins()
+	if err := validateMaxTransferAmount(coins, a.Allocations[index].MaxTransferAmount); err != nil {
+		return authz.AcceptResponse{}, err
+	}
+
+	// update the periodic spend limit, resetting it first if the current period has ended
+	if a.Allocations[index].PeriodicSpendLimit != nil {
+		periodicSpendLimit := *a.Allocations[index].PeriodicSpendLimit
+		periodicSpendLimit.tryResetPeriod(ctx.BlockTime())
+
+		for _, coin := range coins {
+			canSpend, isNegative := periodicSpendLimit.PeriodCanSpend.SafeSub(coin)
+			if isNegative {
+				return authz.AcceptResponse{}, errorsmod.Wrapf(ibcerrors.ErrInsufficientFunds, "requested amount of token %s is more than periodic spend limit", coin.Denom)
+			}
+
+			periodicSpendLimit.PeriodCanSpend = canSpend
+		}
+
+		allocationModified = true
+		a.Allocations[index].PeriodicSpendLimit = &periodicSpendLimit
+	}
+
+	// update spend limit for each token in the MsgTransfer
 	// If the spend limit is set to the MaxUint256 sentinel value, do not subtract the amount from the spend limit.
 	// if there is no unlimited spend, then we need to subtract the amount from the spend limit to get the limit left
-	if !a.Allocations[index].SpendLimit.AmountOf(msgTransfer.Token.Denom).Equal(UnboundedSpendLimit()) {
-		limitLeft, isNegative := a.Allocations[index].SpendLimit.SafeSub(msgTransfer.Token)
+	for _, coin := range coins {
+		if a.Allocations[index].SpendLimit.AmountOf(coin.Denom).Equal(UnboundedSpendLimit()) {
+			continue
+		}
+
+		limitLeft, isNegative := a.Allocations[index].SpendLimit.SafeSub(coin)
 		if isNegative {
-			return authz.AcceptResponse{}, errorsmod.Wrapf(ibcerrors.ErrInsufficientFunds, "requested amount of token %s is more than spend limit", msgTransfer.Token.Denom)
+			return authz.AcceptResponse{}, errorsmod.Wrapf(ibcerrors.ErrInsufficientFunds, "requested amount of token %s is more than spend limit", coin.Denom)
 		}
 
 		allocationModified = true
@@ -126,6 +162,28 @@ func (a TransferAuthorization) ValidateBasic() error {
 			return errorsmod.Wrap(err, "invalid source channel ID")
 		}
 
+		foundClients := make(map[string]bool, 0)
+		for _, clientID := range allocation.AllowedDestinationClients {
+			if err := host.ClientIdentifierValidator(clientID); err != nil {
+				return errorsmod.Wrap(err, "invalid destination client ID")
+			}
+
+			if foundClients[clientID] {
+				return errorsmod.Wrapf(ErrInvalidAuthorization, "duplicate entry in allowed destination clients %s", clientID)
+			}
+			foundClients[clientID] = true
+		}
+
+		if err := allocation.MaxTransferAmount.Validate(); err != nil {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "invalid max transfer amount: %s", err.Error())
+		}
+
+		if allocation.PeriodicSpendLimit != nil {
+			if err := allocation.PeriodicSpendLimit.ValidateBasic(); err != nil {
+				return err
+			}
+		}
+
 		found := make(map[string]bool, 0)
 		for i := range allocation.AllowList {
 			if found[allocation.AllowList[i]] {
@@ -138,6 +196,78 @@ func (a TransferAuthorization) ValidateBasic() error {
 	return nil
 }
 
+// NewPeriodicSpendLimit creates a new PeriodicSpendLimit allowing up to periodSpendLimit to be
+// transferred every period. The first period starts when the allocation is first spent.
+func NewPeriodicSpendLimit(period time.Duration, periodSpendLimit sdk.Coins) *PeriodicSpendLimit {
+	return &PeriodicSpendLimit{
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+	}
+}
+
+// ValidateBasic performs a basic validation of the periodic spend limit.
+func (p PeriodicSpendLimit) ValidateBasic() error {
+	if p.Period <= 0 {
+		return errorsmod.Wrapf(ErrInvalidAuthorization, "period must be positive, got %s", p.Period)
+	}
+
+	if p.PeriodSpendLimit.Empty() {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, "period spend limit cannot be empty")
+	}
+
+	if err := p.PeriodSpendLimit.Validate(); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "invalid period spend limit: %s", err.Error())
+	}
+
+	if err := p.PeriodCanSpend.Validate(); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "invalid period can spend: %s", err.Error())
+	}
+
+	if !p.PeriodCanSpend.IsAllLTE(p.PeriodSpendLimit) {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "period can spend %s exceeds period spend limit %s", p.PeriodCanSpend, p.PeriodSpendLimit)
+	}
+
+	return nil
+}
+
+// tryResetPeriod replenishes the amount that can be spent in the current period if the period
+// has ended. The next period starts at the end of the previous one, unless more than a full period
+// has passed since, in which case it starts at the given block time.
+func (p *PeriodicSpendLimit) tryResetPeriod(blockTime time.Time) {
+	if blockTime.Before(p.PeriodReset) {
+		return
+	}
+
+	p.PeriodCanSpend = p.PeriodSpendLimit
+
+	p.PeriodReset = p.PeriodReset.Add(p.Period)
+	if blockTime.After(p.PeriodReset) {
+		p.PeriodReset = blockTime.Add(p.Period)
+	}
+}
+
+// validateMaxTransferAmount returns an error if the amount of any of the coins exceeds the maximum transfer
+// amount of its denomination. If a maximum transfer amount is set, denominations without a maximum cannot be
+// transferred, consistent with the spend limits.
+func validateMaxTransferAmount(coins []sdk.Coin, maxTransferAmount sdk.Coins) error {
+	if maxTransferAmount.Empty() {
+		return nil
+	}
+
+	for _, coin := range coins {
+		maxAmount := maxTransferAmount.AmountOf(coin.Denom)
+		if !maxAmount.IsPositive() {
+			return errorsmod.Wrapf(ErrInvalidAuthorization, "requested token %s is not in max transfer amount", coin.Denom)
+		}
+
+		if coin.Amount.GT(maxAmount) {
+			return errorsmod.Wrapf(ErrInvalidAuthorization, "requested amount %s of token %s exceeds max transfer amount %s", coin.Amount, coin.Denom, maxAmount)
+		}
+	}
+
+	return nil
+}
+
 // isAllowedAddress returns a boolean indicating if the receiver address is valid for transfer.
 // gasCostPerIteration gas is consumed for each iteration.
 func isAllowedAddress(ctx sdk.Context, receiver string, allowedAddrs []string) bool {
@@ -187,6 +317,21 @@ func validateMemo(ctx sdk.Context, memo string, allowedMemos []string) error {
 	return nil
 }
 
+// validateDestinationClient returns an error if an allow list of destination clients is set and the destination
+// client of the transfer is not in it. The destination client is verified against the counterparty of the source
+// client when the transfer is executed, transfers which do not set it are rejected by a non-empty allow list.
+func validateDestinationClient(destinationClient string, allowedDestinationClients []string) error {
+	if len(allowedDestinationClients) == 0 {
+		return nil
+	}
+
+	if !slices.Contains(allowedDestinationClients, destinationClient) {
+		return errorsmod.Wrapf(ErrInvalidAuthorization, "not allowed destination client: %q", destinationClient)
+	}
+
+	return nil
+}
+
 // getAllocationIndex ranges through a set of allocations, and returns the index of the allocation if found. If not, returns -1.
 func getAllocationIndex(msg MsgTransfer, allocations []Allocation) int {
 	for index, allocation := range allocations {
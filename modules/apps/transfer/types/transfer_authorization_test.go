@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"fmt"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -181,6 +182,52 @@ func (suite *TypesTestSuite) TestTransferAuthorizationAccept() {
 				suite.Require().True(sdkmath.NewInt(100).Equal(remainder))
 			},
 		},
+		{
+			"success: with multiple tokens spend limit updated",
+			func() {
+				transferAuthz.Allocations[0].SpendLimit = sdk.NewCoins(ibctesting.TestCoin, sdk.NewCoin("test-denom", sdkmath.NewInt(100)))
+				msgTransfer.Token = sdk.Coin{}
+				msgTransfer.Tokens = []sdk.Coin{
+					sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50)),
+					sdk.NewCoin("test-denom", sdkmath.NewInt(100)),
+				}
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+
+				suite.Require().True(res.Accept)
+				suite.Require().False(res.Delete)
+
+				updatedAuthz, ok := res.Updated.(*types.TransferAuthorization)
+				suite.Require().True(ok)
+
+				isEqual := updatedAuthz.Allocations[0].SpendLimit.Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50))))
+				suite.Require().True(isEqual)
+			},
+		},
+		{
+			"success: with multiple tokens and unlimited spend limit for one denom",
+			func() {
+				transferAuthz.Allocations[0].SpendLimit = sdk.NewCoins(ibctesting.TestCoin, sdk.NewCoin("test-denom", types.UnboundedSpendLimit()))
+				msgTransfer.Token = sdk.Coin{}
+				msgTransfer.Tokens = []sdk.Coin{
+					sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50)),
+					sdk.NewCoin("test-denom", sdkmath.NewInt(1000)),
+				}
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+
+				suite.Require().True(res.Accept)
+				suite.Require().False(res.Delete)
+
+				updatedAuthz, ok := res.Updated.(*types.TransferAuthorization)
+				suite.Require().True(ok)
+
+				suite.Require().True(sdkmath.NewInt(50).Equal(updatedAuthz.Allocations[0].SpendLimit.AmountOf(sdk.DefaultBondDenom)))
+				suite.Require().True(types.UnboundedSpendLimit().Equal(updatedAuthz.Allocations[0].SpendLimit.AmountOf("test-denom")))
+			},
+		},
 		{
 			"no spend limit set for MsgTransfer port/channel",
 			func() {
@@ -200,6 +247,199 @@ func (suite *TypesTestSuite) TestTransferAuthorizationAccept() {
 				suite.Require().Error(err)
 			},
 		},
+		{
+			"requested amount of one of multiple tokens is more than the spend limit",
+			func() {
+				transferAuthz.Allocations[0].SpendLimit = sdk.NewCoins(ibctesting.TestCoin, sdk.NewCoin("test-denom", sdkmath.NewInt(100)))
+				msgTransfer.Token = sdk.Coin{}
+				msgTransfer.Tokens = []sdk.Coin{
+					sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50)),
+					sdk.NewCoin("test-denom", sdkmath.NewInt(101)),
+				}
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().ErrorIs(err, ibcerrors.ErrInsufficientFunds)
+			},
+		},
+		{
+			"success: with periodic spend limit updated",
+			func() {
+				transferAuthz.Allocations[0].PeriodicSpendLimit = types.NewPeriodicSpendLimit(time.Hour, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(80))))
+				msgTransfer.Token = sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50))
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+
+				suite.Require().True(res.Accept)
+				suite.Require().False(res.Delete)
+
+				updatedAuthz, ok := res.Updated.(*types.TransferAuthorization)
+				suite.Require().True(ok)
+
+				periodicSpendLimit := updatedAuthz.Allocations[0].PeriodicSpendLimit
+				suite.Require().True(periodicSpendLimit.PeriodCanSpend.Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(30)))))
+				suite.Require().Equal(suite.chainA.GetContext().BlockTime().Add(time.Hour), periodicSpendLimit.PeriodReset)
+				suite.Require().True(updatedAuthz.Allocations[0].SpendLimit.Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50)))))
+			},
+		},
+		{
+			"success: periodic spend limit is reset after the period ends",
+			func() {
+				blockTime := suite.chainA.GetContext().BlockTime()
+				transferAuthz.Allocations[0].PeriodicSpendLimit = &types.PeriodicSpendLimit{
+					Period:           time.Hour,
+					PeriodSpendLimit: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(80))),
+					PeriodCanSpend:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(10))),
+					PeriodReset:      blockTime.Add(-time.Minute),
+				}
+				msgTransfer.Token = sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50))
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+
+				updatedAuthz, ok := res.Updated.(*types.TransferAuthorization)
+				suite.Require().True(ok)
+
+				periodicSpendLimit := updatedAuthz.Allocations[0].PeriodicSpendLimit
+				suite.Require().True(periodicSpendLimit.PeriodCanSpend.Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(30)))))
+				suite.Require().Equal(suite.chainA.GetContext().BlockTime().Add(time.Hour-time.Minute), periodicSpendLimit.PeriodReset)
+			},
+		},
+		{
+			"success: with unlimited spend limit and periodic spend limit",
+			func() {
+				transferAuthz.Allocations[0].SpendLimit = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, types.UnboundedSpendLimit()))
+				transferAuthz.Allocations[0].PeriodicSpendLimit = types.NewPeriodicSpendLimit(24*time.Hour, sdk.NewCoins(ibctesting.TestCoin))
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+
+				suite.Require().True(res.Accept)
+				suite.Require().False(res.Delete)
+
+				updatedAuthz, ok := res.Updated.(*types.TransferAuthorization)
+				suite.Require().True(ok)
+				suite.Require().True(updatedAuthz.Allocations[0].PeriodicSpendLimit.PeriodCanSpend.IsZero())
+			},
+		},
+		{
+			"success: transfer to an allowed destination client",
+			func() {
+				transferAuthz.Allocations[0].AllowedDestinationClients = []string{ibctesting.FirstClientID}
+				msgTransfer.DestinationClient = ibctesting.FirstClientID
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+
+				suite.Require().True(res.Accept)
+				suite.Require().True(res.Delete)
+			},
+		},
+		{
+			"success: amount within max transfer amount",
+			func() {
+				transferAuthz.Allocations[0].MaxTransferAmount = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50)))
+				msgTransfer.Token = sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50))
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+				suite.Require().True(res.Accept)
+			},
+		},
+		{
+			"success: allocation not yet expired",
+			func() {
+				expiration := suite.chainA.GetContext().BlockTime().Add(time.Second)
+				transferAuthz.Allocations[0].Expiration = &expiration
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+				suite.Require().True(res.Accept)
+			},
+		},
+		{
+			"requested amount is more than the periodic spend limit",
+			func() {
+				transferAuthz.Allocations[0].PeriodicSpendLimit = types.NewPeriodicSpendLimit(time.Hour, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(80))))
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().ErrorIs(err, ibcerrors.ErrInsufficientFunds)
+			},
+		},
+		{
+			"requested amount is more than the periodic spend limit left in the current period",
+			func() {
+				transferAuthz.Allocations[0].PeriodicSpendLimit = &types.PeriodicSpendLimit{
+					Period:           time.Hour,
+					PeriodSpendLimit: sdk.NewCoins(ibctesting.TestCoin),
+					PeriodCanSpend:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(10))),
+					PeriodReset:      suite.chainA.GetContext().BlockTime().Add(time.Minute),
+				}
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().ErrorIs(err, ibcerrors.ErrInsufficientFunds)
+			},
+		},
+		{
+			"requested denom is not in the periodic spend limit",
+			func() {
+				transferAuthz.Allocations[0].SpendLimit = sdk.NewCoins(ibctesting.TestCoin, ibctesting.SecondaryTestCoin)
+				transferAuthz.Allocations[0].PeriodicSpendLimit = types.NewPeriodicSpendLimit(time.Hour, sdk.NewCoins(ibctesting.TestCoin))
+				msgTransfer.Token = ibctesting.SecondaryTestCoin
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().ErrorIs(err, ibcerrors.ErrInsufficientFunds)
+			},
+		},
+		{
+			"requested amount is more than the max transfer amount",
+			func() {
+				transferAuthz.Allocations[0].MaxTransferAmount = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50)))
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().ErrorIs(err, types.ErrInvalidAuthorization)
+			},
+		},
+		{
+			"requested denom is not in the max transfer amount",
+			func() {
+				transferAuthz.Allocations[0].SpendLimit = sdk.NewCoins(ibctesting.TestCoin, ibctesting.SecondaryTestCoin)
+				transferAuthz.Allocations[0].MaxTransferAmount = sdk.NewCoins(ibctesting.TestCoin)
+				msgTransfer.Token = ibctesting.SecondaryTestCoin
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().ErrorIs(err, types.ErrInvalidAuthorization)
+			},
+		},
+		{
+			"transfer to a destination client which is not allowed",
+			func() {
+				transferAuthz.Allocations[0].AllowedDestinationClients = []string{ibctesting.FirstClientID}
+				msgTransfer.DestinationClient = ibctesting.SecondClientID
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().ErrorIs(err, types.ErrInvalidAuthorization)
+			},
+		},
+		{
+			"transfer without destination client is not allowed by destination client allow list",
+			func() {
+				transferAuthz.Allocations[0].AllowedDestinationClients = []string{ibctesting.FirstClientID}
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().ErrorIs(err, types.ErrInvalidAuthorization)
+			},
+		},
+		{
+			"allocation expired",
+			func() {
+				expiration := suite.chainA.GetContext().BlockTime()
+				transferAuthz.Allocations[0].Expiration = &expiration
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().ErrorIs(err, types.ErrInvalidAuthorization)
+			},
+		},
 		{
 			"receiver address not permitted via allow list",
 			func() {
@@ -301,6 +541,35 @@ func (suite *TypesTestSuite) TestTransferAuthorizationValidateBasic() {
 			},
 			nil,
 		},
+		{
+			"success: with periodic spend limit",
+			func() {
+				transferAuthz.Allocations[0].PeriodicSpendLimit = types.NewPeriodicSpendLimit(24*time.Hour, sdk.NewCoins(ibctesting.TestCoin))
+			},
+			nil,
+		},
+		{
+			"success: with max transfer amount",
+			func() {
+				transferAuthz.Allocations[0].MaxTransferAmount = sdk.NewCoins(ibctesting.TestCoin)
+			},
+			nil,
+		},
+		{
+			"success: with allowed destination clients",
+			func() {
+				transferAuthz.Allocations[0].AllowedDestinationClients = []string{ibctesting.FirstClientID, ibctesting.SecondClientID}
+			},
+			nil,
+		},
+		{
+			"success: with expiration",
+			func() {
+				expiration := time.Unix(1, 0)
+				transferAuthz.Allocations[0].Expiration = &expiration
+			},
+			nil,
+		},
 		{
 			"empty allocations",
 			func() {
@@ -364,6 +633,52 @@ func (suite *TypesTestSuite) TestTransferAuthorizationValidateBasic() {
 			},
 			channeltypes.ErrInvalidChannel,
 		},
+		{
+			"invalid destination client identifier",
+			func() {
+				transferAuthz.Allocations[0].AllowedDestinationClients = []string{""}
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"duplicate entry in allowed destination clients",
+			func() {
+				transferAuthz.Allocations[0].AllowedDestinationClients = []string{ibctesting.FirstClientID, ibctesting.FirstClientID}
+			},
+			types.ErrInvalidAuthorization,
+		},
+		{
+			"invalid max transfer amount",
+			func() {
+				transferAuthz.Allocations[0].MaxTransferAmount = sdk.Coins{sdk.Coin{Denom: ""}}
+			},
+			ibcerrors.ErrInvalidCoins,
+		},
+		{
+			"invalid periodic spend limit: zero period",
+			func() {
+				transferAuthz.Allocations[0].PeriodicSpendLimit = types.NewPeriodicSpendLimit(0, sdk.NewCoins(ibctesting.TestCoin))
+			},
+			types.ErrInvalidAuthorization,
+		},
+		{
+			"invalid periodic spend limit: empty period spend limit",
+			func() {
+				transferAuthz.Allocations[0].PeriodicSpendLimit = types.NewPeriodicSpendLimit(time.Hour, sdk.NewCoins())
+			},
+			ibcerrors.ErrInvalidCoins,
+		},
+		{
+			"invalid periodic spend limit: period can spend exceeds period spend limit",
+			func() {
+				transferAuthz.Allocations[0].PeriodicSpendLimit = &types.PeriodicSpendLimit{
+					Period:           time.Hour,
+					PeriodSpendLimit: sdk.NewCoins(ibctesting.TestCoin),
+					PeriodCanSpend:   sdk.NewCoins(ibctesting.TestCoin.AddAmount(sdkmath.NewInt(1))),
+				}
+			},
+			ibcerrors.ErrInvalidCoins,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -58,6 +58,12 @@ type MsgTransfer struct {
 	Memo string `protobuf:"bytes,8,opt,name=memo,proto3" json:"memo,omitempty"`
 	// optional encoding
 	Encoding string `protobuf:"bytes,9,opt,name=encoding,proto3" json:"encoding,omitempty"`
+	// tokens to be transferred in a single ICS20 v2 packet. Only one of token or tokens may be set.
+	// Multiple tokens can only be transferred with the IBC v2 protocol.
+	Tokens []types.Coin `protobuf:"bytes,10,rep,name=tokens,proto3" json:"tokens"`
+	// optional client ID on the destination chain of an IBC v2 transfer. If set, the transfer fails unless it is the
+	// counterparty client of the source client. It must not be set for IBC v1 transfers.
+	DestinationClient string `protobuf:"bytes,11,opt,name=destination_client,json=destinationClient,proto3" json:"destination_client,omitempty"`
 }
 
 func (m *MsgTransfer) Reset()         { *m = MsgTransfer{} }
@@ -213,11 +219,264 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgSetDenomTransferStatus is the Msg/SetDenomTransferStatus request type. Enabling
+// both sending and receiving removes the transfer status of the denomination.
+type MsgSetDenomTransferStatus struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// denom is a base denomination, a full denomination path or an ibc/{hash} denomination
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// status is the transfer status of the denomination
+	Status TransferStatus `protobuf:"bytes,3,opt,name=status,proto3" json:"status"`
+}
+
+func (m *MsgSetDenomTransferStatus) Reset()         { *m = MsgSetDenomTransferStatus{} }
+func (m *MsgSetDenomTransferStatus) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomTransferStatus) ProtoMessage()    {}
+func (*MsgSetDenomTransferStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{4}
+}
+func (m *MsgSetDenomTransferStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDenomTransferStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDenomTransferStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDenomTransferStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomTransferStatus.Merge(m, src)
+}
+func (m *MsgSetDenomTransferStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDenomTransferStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomTransferStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDenomTransferStatus proto.InternalMessageInfo
+
+// MsgSetDenomTransferStatusResponse defines the response structure for executing a
+// MsgSetDenomTransferStatus message.
+type MsgSetDenomTransferStatusResponse struct {
+}
+
+func (m *MsgSetDenomTransferStatusResponse) Reset()         { *m = MsgSetDenomTransferStatusResponse{} }
+func (m *MsgSetDenomTransferStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomTransferStatusResponse) ProtoMessage()    {}
+func (*MsgSetDenomTransferStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{5}
+}
+func (m *MsgSetDenomTransferStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDenomTransferStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDenomTransferStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDenomTransferStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomTransferStatusResponse.Merge(m, src)
+}
+func (m *MsgSetDenomTransferStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDenomTransferStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomTransferStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDenomTransferStatusResponse proto.InternalMessageInfo
+
+// MsgSetChannelTransferStatus is the Msg/SetChannelTransferStatus request type. Enabling
+// both sending and receiving removes the transfer status of the channel or client.
+type MsgSetChannelTransferStatus struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// channel_or_client_id is the local channel ID (IBC v1) or client ID (IBC v2)
+	ChannelOrClientId string `protobuf:"bytes,2,opt,name=channel_or_client_id,json=channelOrClientId,proto3" json:"channel_or_client_id,omitempty"`
+	// status is the transfer status of the channel or client
+	Status TransferStatus `protobuf:"bytes,3,opt,name=status,proto3" json:"status"`
+}
+
+func (m *MsgSetChannelTransferStatus) Reset()         { *m = MsgSetChannelTransferStatus{} }
+func (m *MsgSetChannelTransferStatus) String() string { return proto.CompactTextString(m) }
+func (*MsgSetChannelTransferStatus) ProtoMessage()    {}
+func (*MsgSetChannelTransferStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{6}
+}
+func (m *MsgSetChannelTransferStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetChannelTransferStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetChannelTransferStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetChannelTransferStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetChannelTransferStatus.Merge(m, src)
+}
+func (m *MsgSetChannelTransferStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetChannelTransferStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetChannelTransferStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetChannelTransferStatus proto.InternalMessageInfo
+
+// MsgSetChannelTransferStatusResponse defines the response structure for executing a
+// MsgSetChannelTransferStatus message.
+type MsgSetChannelTransferStatusResponse struct {
+}
+
+func (m *MsgSetChannelTransferStatusResponse) Reset()         { *m = MsgSetChannelTransferStatusResponse{} }
+func (m *MsgSetChannelTransferStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetChannelTransferStatusResponse) ProtoMessage()    {}
+func (*MsgSetChannelTransferStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{7}
+}
+func (m *MsgSetChannelTransferStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetChannelTransferStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetChannelTransferStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetChannelTransferStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetChannelTransferStatusResponse.Merge(m, src)
+}
+func (m *MsgSetChannelTransferStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetChannelTransferStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetChannelTransferStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetChannelTransferStatusResponse proto.InternalMessageInfo
+
+// MsgSetReceiverBlocked is the Msg/SetReceiverBlocked request type.
+type MsgSetReceiverBlocked struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// receiver is the address which is blocked from or allowed to receive transfers. The denylist is also
+	// applied to the bech32 encoded final receivers of forwarded IBC v2 transfers, by their address bytes
+	// regardless of their bech32 prefix, while final receivers which are not bech32 encoded are not checked.
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// blocked adds the receiver to the denylist if true and removes it otherwise
+	Blocked bool `protobuf:"varint,3,opt,name=blocked,proto3" json:"blocked,omitempty"`
+}
+
+func (m *MsgSetReceiverBlocked) Reset()         { *m = MsgSetReceiverBlocked{} }
+func (m *MsgSetReceiverBlocked) String() string { return proto.CompactTextString(m) }
+func (*MsgSetReceiverBlocked) ProtoMessage()    {}
+func (*MsgSetReceiverBlocked) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{8}
+}
+func (m *MsgSetReceiverBlocked) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetReceiverBlocked) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetReceiverBlocked.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetReceiverBlocked) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetReceiverBlocked.Merge(m, src)
+}
+func (m *MsgSetReceiverBlocked) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetReceiverBlocked) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetReceiverBlocked.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetReceiverBlocked proto.InternalMessageInfo
+
+// MsgSetReceiverBlockedResponse defines the response structure for executing a
+// MsgSetReceiverBlocked message.
+type MsgSetReceiverBlockedResponse struct {
+}
+
+func (m *MsgSetReceiverBlockedResponse) Reset()         { *m = MsgSetReceiverBlockedResponse{} }
+func (m *MsgSetReceiverBlockedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetReceiverBlockedResponse) ProtoMessage()    {}
+func (*MsgSetReceiverBlockedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{9}
+}
+func (m *MsgSetReceiverBlockedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetReceiverBlockedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetReceiverBlockedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetReceiverBlockedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetReceiverBlockedResponse.Merge(m, src)
+}
+func (m *MsgSetReceiverBlockedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetReceiverBlockedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetReceiverBlockedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetReceiverBlockedResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgTransfer)(nil), "ibc.applications.transfer.v1.MsgTransfer")
 	proto.RegisterType((*MsgTransferResponse)(nil), "ibc.applications.transfer.v1.MsgTransferResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "ibc.applications.transfer.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.applications.transfer.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetDenomTransferStatus)(nil), "ibc.applications.transfer.v1.MsgSetDenomTransferStatus")
+	proto.RegisterType((*MsgSetDenomTransferStatusResponse)(nil), "ibc.applications.transfer.v1.MsgSetDenomTransferStatusResponse")
+	proto.RegisterType((*MsgSetChannelTransferStatus)(nil), "ibc.applications.transfer.v1.MsgSetChannelTransferStatus")
+	proto.RegisterType((*MsgSetChannelTransferStatusResponse)(nil), "ibc.applications.transfer.v1.MsgSetChannelTransferStatusResponse")
+	proto.RegisterType((*MsgSetReceiverBlocked)(nil), "ibc.applications.transfer.v1.MsgSetReceiverBlocked")
+	proto.RegisterType((*MsgSetReceiverBlockedResponse)(nil), "ibc.applications.transfer.v1.MsgSetReceiverBlockedResponse")
 }
 
 func init() {
@@ -225,47 +484,63 @@ func init() {
 }
 
 var fileDescriptor_7401ed9bed2f8e09 = []byte{
-	// 629 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x31, 0x6f, 0xd4, 0x4a,
-	0x10, 0x3e, 0xbf, 0x5c, 0xee, 0x25, 0x7b, 0x2f, 0xc9, 0x8b, 0x41, 0x89, 0x63, 0x21, 0x5f, 0x74,
-	0x22, 0x52, 0xb8, 0x28, 0xbb, 0x38, 0x08, 0x81, 0xae, 0xbc, 0x34, 0x14, 0x44, 0x8a, 0xac, 0xd0,
-	0xd0, 0x44, 0xf6, 0xde, 0xe0, 0x5b, 0xe5, 0xbc, 0x6b, 0xbc, 0x7b, 0x27, 0x68, 0x10, 0xa2, 0x42,
-	0x54, 0xfc, 0x00, 0x0a, 0x4a, 0xca, 0xfc, 0x8c, 0x94, 0x29, 0xa9, 0x10, 0x4a, 0x8a, 0x34, 0xfc,
-	0x08, 0xb4, 0xeb, 0xf5, 0x61, 0x28, 0x02, 0x34, 0xf6, 0xce, 0xcc, 0x37, 0xdf, 0xcc, 0x7c, 0xb3,
-	0x8b, 0xb6, 0x58, 0x42, 0x49, 0x9c, 0xe7, 0x63, 0x46, 0x63, 0xc5, 0x04, 0x97, 0x44, 0x15, 0x31,
-	0x97, 0xcf, 0xa0, 0x20, 0xd3, 0x90, 0xa8, 0x17, 0x38, 0x2f, 0x84, 0x12, 0xee, 0x2d, 0x96, 0x50,
-	0x5c, 0x87, 0xe1, 0x0a, 0x86, 0xa7, 0xa1, 0xbf, 0x1a, 0x67, 0x8c, 0x0b, 0x62, 0xbe, 0x65, 0x82,
-	0x7f, 0x33, 0x15, 0xa9, 0x30, 0x47, 0xa2, 0x4f, 0xd6, 0xbb, 0x4e, 0x85, 0xcc, 0x84, 0x24, 0x99,
-	0x4c, 0x35, 0x7d, 0x26, 0x53, 0x1b, 0x08, 0x6c, 0x20, 0x89, 0x25, 0x90, 0x69, 0x98, 0x80, 0x8a,
-	0x43, 0x42, 0x05, 0xe3, 0x36, 0xde, 0xd1, 0x6d, 0x52, 0x51, 0x00, 0xa1, 0x63, 0x06, 0x5c, 0xe9,
-	0xec, 0xf2, 0x64, 0x01, 0x3b, 0xd7, 0xcf, 0x51, 0x35, 0x6b, 0xc0, 0xdd, 0x0f, 0x73, 0xa8, 0x7d,
-	0x20, 0xd3, 0x23, 0xeb, 0x75, 0x3b, 0xa8, 0x2d, 0xc5, 0xa4, 0xa0, 0x70, 0x9c, 0x8b, 0x42, 0x79,
-	0xce, 0xa6, 0xb3, 0xbd, 0x18, 0xa1, 0xd2, 0x75, 0x28, 0x0a, 0xe5, 0x6e, 0xa1, 0x65, 0x0b, 0xa0,
-	0xa3, 0x98, 0x73, 0x18, 0x7b, 0xff, 0x18, 0xcc, 0x52, 0xe9, 0xdd, 0x2f, 0x9d, 0x6e, 0x1f, 0xcd,
-	0x2b, 0x71, 0x02, 0xdc, 0x9b, 0xdb, 0x74, 0xb6, 0xdb, 0x7b, 0x1b, 0xb8, 0x9c, 0x0a, 0xeb, 0xa9,
-	0xb0, 0x9d, 0x0a, 0xef, 0x0b, 0xc6, 0x07, 0x8b, 0x67, 0x5f, 0x3a, 0x8d, 0x4f, 0x57, 0xa7, 0x3d,
-	0x27, 0x2a, 0x53, 0xdc, 0x35, 0xd4, 0x92, 0xc0, 0x87, 0x50, 0x78, 0x4d, 0x43, 0x6d, 0x2d, 0xd7,
-	0x47, 0x0b, 0x05, 0x50, 0x60, 0x53, 0x28, 0xbc, 0x79, 0x13, 0x99, 0xd9, 0xee, 0x63, 0xb4, 0xac,
-	0x58, 0x06, 0x62, 0xa2, 0x8e, 0x47, 0xc0, 0xd2, 0x91, 0xf2, 0x5a, 0xa6, 0xb0, 0x8f, 0xf5, 0xba,
-	0xb4, 0x5c, 0xd8, 0x8a, 0x34, 0x0d, 0xf1, 0x23, 0x83, 0xa8, 0x57, 0x5e, 0xb2, 0xc9, 0x65, 0xc4,
-	0xdd, 0x41, 0xab, 0x15, 0x9b, 0xfe, 0x4b, 0x15, 0x67, 0xb9, 0xf7, 0xef, 0xa6, 0xb3, 0xdd, 0x8c,
-	0xfe, 0xb7, 0x81, 0xa3, 0xca, 0xef, 0xba, 0xa8, 0x99, 0x41, 0x26, 0xbc, 0x05, 0xd3, 0x92, 0x39,
-	0xeb, 0x56, 0x81, 0x53, 0x31, 0x64, 0x3c, 0xf5, 0x16, 0xcb, 0x56, 0x2b, 0xbb, 0xdf, 0x7b, 0xfb,
-	0xb1, 0xd3, 0x78, 0x73, 0x75, 0xda, 0xb3, 0x73, 0xbd, 0xbb, 0x3a, 0xed, 0xad, 0x95, 0xf2, 0xec,
-	0xca, 0xe1, 0x09, 0xa9, 0xad, 0xa3, 0xfb, 0x00, 0xdd, 0xa8, 0x99, 0x11, 0xc8, 0x5c, 0x70, 0x09,
-	0x9a, 0x5e, 0xc2, 0xf3, 0x09, 0x70, 0x0a, 0x66, 0x45, 0xcd, 0x68, 0x66, 0xf7, 0x9b, 0x9a, 0xbe,
-	0xfb, 0x0a, 0xad, 0x1c, 0xc8, 0xf4, 0x49, 0x3e, 0x8c, 0x15, 0x1c, 0xc6, 0x45, 0x9c, 0x49, 0x23,
-	0x2b, 0x4b, 0x39, 0x14, 0x76, 0xab, 0xd6, 0x72, 0x07, 0xa8, 0x95, 0x1b, 0x84, 0xd9, 0x64, 0x7b,
-	0xef, 0x36, 0xbe, 0xee, 0x86, 0xe3, 0x92, 0x6d, 0xd0, 0xd4, 0xe2, 0x45, 0x36, 0xb3, 0xbf, 0xf2,
-	0x63, 0x26, 0x43, 0xda, 0xdd, 0x40, 0xeb, 0xbf, 0xd4, 0xaf, 0x9a, 0xdf, 0xfb, 0xe6, 0xa0, 0xb9,
-	0x03, 0x99, 0xba, 0x23, 0xb4, 0x30, 0xbb, 0x76, 0x77, 0xae, 0xaf, 0x59, 0xd3, 0xc0, 0x0f, 0xff,
-	0x18, 0x3a, 0x93, 0x4b, 0xa1, 0xff, 0x7e, 0x52, 0x62, 0xf7, 0xb7, 0x14, 0x75, 0xb8, 0x7f, 0xff,
-	0xaf, 0xe0, 0x55, 0x55, 0x7f, 0xfe, 0xb5, 0xbe, 0x5a, 0x83, 0xe8, 0xec, 0x22, 0x70, 0xce, 0x2f,
-	0x02, 0xe7, 0xeb, 0x45, 0xe0, 0xbc, 0xbf, 0x0c, 0x1a, 0xe7, 0x97, 0x41, 0xe3, 0xf3, 0x65, 0xd0,
-	0x78, 0xfa, 0x30, 0x65, 0x6a, 0x34, 0x49, 0x30, 0x15, 0x19, 0xb1, 0x8f, 0x9e, 0x25, 0x74, 0x37,
-	0x15, 0x64, 0x1a, 0xde, 0x25, 0x99, 0x18, 0x4e, 0xc6, 0x20, 0xf5, 0x4b, 0xae, 0xbd, 0x60, 0xf5,
-	0x32, 0x07, 0x99, 0xb4, 0xcc, 0xe3, 0xbd, 0xf7, 0x3d, 0x00, 0x00, 0xff, 0xff, 0xc9, 0x87, 0xc2,
-	0x5c, 0xb3, 0x04, 0x00, 0x00,
+	// 886 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x36, 0xb6, 0x9b, 0x3c, 0xd3, 0x96, 0x0c, 0x21, 0xdd, 0x2c, 0x60, 0x07, 0x97, 0x48,
+	0x69, 0x4a, 0x76, 0x71, 0x2a, 0x54, 0x08, 0x48, 0x08, 0x87, 0x03, 0x20, 0x22, 0xaa, 0x6d, 0xb9,
+	0x70, 0xb1, 0x76, 0x67, 0x87, 0xf5, 0x28, 0xde, 0x99, 0x65, 0x67, 0x6c, 0xd1, 0x0b, 0x42, 0x1c,
+	0x10, 0x70, 0x42, 0x9c, 0x38, 0x72, 0x01, 0x71, 0xcc, 0x7f, 0xe0, 0xd2, 0x63, 0x8f, 0x9c, 0x10,
+	0x4a, 0x0e, 0xf9, 0x1b, 0x68, 0x67, 0x66, 0x97, 0x6d, 0x6a, 0xbb, 0x26, 0x52, 0x2f, 0xc9, 0xbe,
+	0x79, 0xdf, 0x7b, 0xef, 0xfb, 0xde, 0xbe, 0xb7, 0x1e, 0xd8, 0xa2, 0x21, 0xf6, 0x82, 0x34, 0x1d,
+	0x51, 0x1c, 0x48, 0xca, 0x99, 0xf0, 0x64, 0x16, 0x30, 0xf1, 0x05, 0xc9, 0xbc, 0x49, 0xcf, 0x93,
+	0x5f, 0xb9, 0x69, 0xc6, 0x25, 0x47, 0x2f, 0xd3, 0x10, 0xbb, 0x55, 0x98, 0x5b, 0xc0, 0xdc, 0x49,
+	0xcf, 0x59, 0x0d, 0x12, 0xca, 0xb8, 0xa7, 0xfe, 0xea, 0x00, 0x67, 0x2d, 0xe6, 0x31, 0x57, 0x8f,
+	0x5e, 0xfe, 0x64, 0x4e, 0xaf, 0x63, 0x2e, 0x12, 0x2e, 0xbc, 0x44, 0xc4, 0x79, 0xfa, 0x44, 0xc4,
+	0xc6, 0xd1, 0x36, 0x8e, 0x30, 0x10, 0xc4, 0x9b, 0xf4, 0x42, 0x22, 0x83, 0x9e, 0x87, 0x39, 0x65,
+	0xc6, 0xdf, 0xc9, 0x69, 0x62, 0x9e, 0x11, 0x0f, 0x8f, 0x28, 0x61, 0x32, 0x8f, 0xd6, 0x4f, 0x06,
+	0x70, 0x6b, 0xbe, 0x8e, 0x82, 0xac, 0x06, 0xdf, 0x9c, 0x0b, 0x4e, 0xf9, 0x88, 0xe2, 0x07, 0x1a,
+	0xda, 0xfd, 0xa1, 0x0e, 0xad, 0x43, 0x11, 0xdf, 0x37, 0x7e, 0xd4, 0x81, 0x96, 0xe0, 0xe3, 0x0c,
+	0x93, 0x41, 0xca, 0x33, 0x69, 0x5b, 0x9b, 0xd6, 0xf6, 0x8a, 0x0f, 0xfa, 0xe8, 0x2e, 0xcf, 0x24,
+	0xda, 0x82, 0xab, 0x06, 0x80, 0x87, 0x01, 0x63, 0x64, 0x64, 0x5f, 0x52, 0x98, 0x2b, 0xfa, 0xf4,
+	0x40, 0x1f, 0xa2, 0x7d, 0x68, 0x48, 0x7e, 0x44, 0x98, 0xbd, 0xb4, 0x69, 0x6d, 0xb7, 0xf6, 0x36,
+	0x5c, 0xdd, 0x00, 0x37, 0x6f, 0x80, 0x6b, 0x1a, 0xe0, 0x1e, 0x70, 0xca, 0xfa, 0x2b, 0x0f, 0xff,
+	0xee, 0xd4, 0xfe, 0x38, 0x3b, 0xde, 0xb1, 0x7c, 0x1d, 0x82, 0xd6, 0xa1, 0x29, 0x08, 0x8b, 0x48,
+	0x66, 0xd7, 0x55, 0x6a, 0x63, 0x21, 0x07, 0x96, 0x33, 0x82, 0x09, 0x9d, 0x90, 0xcc, 0x6e, 0x28,
+	0x4f, 0x69, 0xa3, 0x4f, 0xe0, 0xaa, 0xa4, 0x09, 0xe1, 0x63, 0x39, 0x18, 0x12, 0x1a, 0x0f, 0xa5,
+	0xdd, 0x54, 0x85, 0x1d, 0x37, 0x7f, 0xb3, 0x79, 0x67, 0x5d, 0xd3, 0xcf, 0x49, 0xcf, 0xfd, 0x50,
+	0x21, 0xaa, 0x95, 0xaf, 0x98, 0x60, 0xed, 0x41, 0xb7, 0x60, 0xb5, 0xc8, 0x96, 0xff, 0x17, 0x32,
+	0x48, 0x52, 0xfb, 0xf2, 0xa6, 0xb5, 0x5d, 0xf7, 0x9f, 0x37, 0x8e, 0xfb, 0xc5, 0x39, 0x42, 0x50,
+	0x4f, 0x48, 0xc2, 0xed, 0x65, 0x45, 0x49, 0x3d, 0xe7, 0x54, 0x09, 0xc3, 0x3c, 0xa2, 0x2c, 0xb6,
+	0x57, 0x34, 0xd5, 0xc2, 0x46, 0xef, 0x42, 0x53, 0xe9, 0x14, 0x36, 0x6c, 0x2e, 0x2d, 0xdc, 0x1b,
+	0x13, 0x83, 0x76, 0x01, 0x45, 0x44, 0x48, 0xca, 0xd4, 0x9b, 0x1d, 0x68, 0x51, 0x76, 0x4b, 0xd5,
+	0x58, 0xad, 0x78, 0x0e, 0x94, 0x63, 0x7f, 0xe7, 0xfb, 0x5f, 0x3b, 0xb5, 0x6f, 0xcf, 0x8e, 0x77,
+	0x4c, 0x13, 0x7f, 0x3c, 0x3b, 0xde, 0x59, 0xd7, 0xf5, 0x76, 0x45, 0x74, 0xe4, 0x55, 0xde, 0x7d,
+	0xf7, 0x0e, 0xbc, 0x50, 0x31, 0x7d, 0x22, 0x52, 0xce, 0x04, 0xc9, 0xb5, 0x08, 0xf2, 0xe5, 0x98,
+	0x30, 0x4c, 0xd4, 0x3c, 0xd4, 0xfd, 0xd2, 0xde, 0xaf, 0xe7, 0xe9, 0xbb, 0x5f, 0xc3, 0xb5, 0x43,
+	0x11, 0x7f, 0x96, 0x46, 0x81, 0x24, 0x77, 0x83, 0x2c, 0x48, 0x84, 0x7a, 0x87, 0x34, 0x66, 0x24,
+	0x33, 0x23, 0x64, 0x2c, 0xd4, 0x87, 0x66, 0xaa, 0x10, 0x6a, 0x6c, 0x5a, 0x7b, 0xaf, 0xb9, 0xf3,
+	0x36, 0xcf, 0xd5, 0xd9, 0xfa, 0xf5, 0xbc, 0x0f, 0xbe, 0x89, 0xdc, 0xbf, 0xf6, 0x9f, 0x26, 0x95,
+	0xb4, 0xbb, 0x01, 0xd7, 0xcf, 0xd5, 0x2f, 0xc8, 0x77, 0x7f, 0xb7, 0x60, 0xe3, 0x50, 0xc4, 0xf7,
+	0x88, 0xfc, 0x80, 0x30, 0x9e, 0x14, 0xe2, 0xee, 0xc9, 0x40, 0x8e, 0x67, 0xb3, 0x5c, 0x83, 0x46,
+	0x94, 0xc3, 0xcd, 0x6c, 0x6b, 0x03, 0x7d, 0x0c, 0x4d, 0xa1, 0xe2, 0xcc, 0x50, 0xbf, 0x3e, 0x9f,
+	0xfb, 0xe3, 0xb5, 0x0a, 0x0d, 0x3a, 0xc3, 0x93, 0x1a, 0x6e, 0xc0, 0xab, 0x33, 0x79, 0x96, 0x6a,
+	0xfe, 0xb4, 0xe0, 0x25, 0x8d, 0x32, 0x7b, 0xb6, 0xa0, 0x1e, 0x0f, 0xd6, 0xcc, 0xb6, 0x0e, 0x78,
+	0x66, 0x66, 0x66, 0x40, 0x23, 0x23, 0x6f, 0xd5, 0xf8, 0x3e, 0xcd, 0xf4, 0xd0, 0x7c, 0x14, 0x3d,
+	0x5b, 0xa9, 0x5b, 0x70, 0x63, 0x8e, 0x88, 0x52, 0xec, 0x04, 0x5e, 0xd4, 0x30, 0xdf, 0x2c, 0x79,
+	0x7f, 0xc4, 0xf1, 0x11, 0x89, 0x66, 0xaa, 0xac, 0x7e, 0x1f, 0x2e, 0x9d, 0xfb, 0x3e, 0xd8, 0x70,
+	0x39, 0xd4, 0xe1, 0x4a, 0xd1, 0xb2, 0x5f, 0x98, 0x4f, 0xd2, 0xeb, 0xc0, 0x2b, 0x53, 0xeb, 0x16,
+	0xc4, 0xf6, 0x7e, 0x6b, 0xc0, 0xd2, 0xa1, 0x88, 0xd1, 0x10, 0x96, 0xcb, 0xef, 0xe6, 0xcd, 0xf9,
+	0x0d, 0xaa, 0xec, 0x95, 0xd3, 0x5b, 0x18, 0x5a, 0xae, 0xa0, 0x84, 0xe7, 0x1e, 0xdb, 0xae, 0xdd,
+	0xa7, 0xa6, 0xa8, 0xc2, 0x9d, 0x37, 0xff, 0x17, 0xbc, 0xac, 0xfa, 0xb3, 0x05, 0xeb, 0x33, 0x16,
+	0xe7, 0xce, 0x53, 0x33, 0x4e, 0x0f, 0x74, 0xde, 0xbb, 0x60, 0x60, 0x49, 0xea, 0x17, 0x0b, 0xec,
+	0x99, 0xf3, 0xff, 0xf6, 0x22, 0xd9, 0xa7, 0x86, 0x3a, 0xef, 0x5f, 0x38, 0xb4, 0xa4, 0xf6, 0x9d,
+	0x05, 0x68, 0xca, 0xb8, 0xde, 0x5e, 0x24, 0xf3, 0xb9, 0x20, 0xe7, 0x9d, 0x0b, 0x04, 0x15, 0x44,
+	0x9c, 0xc6, 0x37, 0xf9, 0x4f, 0x46, 0xdf, 0x7f, 0x78, 0xd2, 0xb6, 0x1e, 0x9d, 0xb4, 0xad, 0x7f,
+	0x4e, 0xda, 0xd6, 0x4f, 0xa7, 0xed, 0xda, 0xa3, 0xd3, 0x76, 0xed, 0xaf, 0xd3, 0x76, 0xed, 0xf3,
+	0xb7, 0x62, 0x2a, 0x87, 0xe3, 0xd0, 0xc5, 0x3c, 0xf1, 0xcc, 0xcd, 0x84, 0x86, 0x78, 0x37, 0xe6,
+	0xde, 0xa4, 0xf7, 0x86, 0x97, 0xf0, 0x68, 0x3c, 0x22, 0x22, 0xbf, 0x41, 0x54, 0x6e, 0x0e, 0xf2,
+	0x41, 0x4a, 0x44, 0xd8, 0x54, 0xd7, 0x86, 0xdb, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xc7, 0x3c,
+	0xdc, 0x05, 0x58, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -284,6 +559,12 @@ type MsgClient interface {
 	Transfer(ctx context.Context, in *MsgTransfer, opts ...grpc.CallOption) (*MsgTransferResponse, error)
 	// UpdateParams defines a rpc handler for MsgUpdateParams.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetDenomTransferStatus defines a rpc handler for MsgSetDenomTransferStatus.
+	SetDenomTransferStatus(ctx context.Context, in *MsgSetDenomTransferStatus, opts ...grpc.CallOption) (*MsgSetDenomTransferStatusResponse, error)
+	// SetChannelTransferStatus defines a rpc handler for MsgSetChannelTransferStatus.
+	SetChannelTransferStatus(ctx context.Context, in *MsgSetChannelTransferStatus, opts ...grpc.CallOption) (*MsgSetChannelTransferStatusResponse, error)
+	// SetReceiverBlocked defines a rpc handler for MsgSetReceiverBlocked.
+	SetReceiverBlocked(ctx context.Context, in *MsgSetReceiverBlocked, opts ...grpc.CallOption) (*MsgSetReceiverBlockedResponse, error)
 }
 
 type msgClient struct {
@@ -312,12 +593,45 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetDenomTransferStatus(ctx context.Context, in *MsgSetDenomTransferStatus, opts ...grpc.CallOption) (*MsgSetDenomTransferStatusResponse, error) {
+	out := new(MsgSetDenomTransferStatusResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Msg/SetDenomTransferStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetChannelTransferStatus(ctx context.Context, in *MsgSetChannelTransferStatus, opts ...grpc.CallOption) (*MsgSetChannelTransferStatusResponse, error) {
+	out := new(MsgSetChannelTransferStatusResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Msg/SetChannelTransferStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetReceiverBlocked(ctx context.Context, in *MsgSetReceiverBlocked, opts ...grpc.CallOption) (*MsgSetReceiverBlockedResponse, error) {
+	out := new(MsgSetReceiverBlockedResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Msg/SetReceiverBlocked", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Transfer defines a rpc handler method for MsgTransfer.
 	Transfer(context.Context, *MsgTransfer) (*MsgTransferResponse, error)
 	// UpdateParams defines a rpc handler for MsgUpdateParams.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetDenomTransferStatus defines a rpc handler for MsgSetDenomTransferStatus.
+	SetDenomTransferStatus(context.Context, *MsgSetDenomTransferStatus) (*MsgSetDenomTransferStatusResponse, error)
+	// SetChannelTransferStatus defines a rpc handler for MsgSetChannelTransferStatus.
+	SetChannelTransferStatus(context.Context, *MsgSetChannelTransferStatus) (*MsgSetChannelTransferStatusResponse, error)
+	// SetReceiverBlocked defines a rpc handler for MsgSetReceiverBlocked.
+	SetReceiverBlocked(context.Context, *MsgSetReceiverBlocked) (*MsgSetReceiverBlockedResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -330,6 +644,15 @@ func (*UnimplementedMsgServer) Transfer(ctx context.Context, req *MsgTransfer) (
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SetDenomTransferStatus(ctx context.Context, req *MsgSetDenomTransferStatus) (*MsgSetDenomTransferStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomTransferStatus not implemented")
+}
+func (*UnimplementedMsgServer) SetChannelTransferStatus(ctx context.Context, req *MsgSetChannelTransferStatus) (*MsgSetChannelTransferStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChannelTransferStatus not implemented")
+}
+func (*UnimplementedMsgServer) SetReceiverBlocked(ctx context.Context, req *MsgSetReceiverBlocked) (*MsgSetReceiverBlockedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReceiverBlocked not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -371,6 +694,60 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetDenomTransferStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomTransferStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomTransferStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Msg/SetDenomTransferStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomTransferStatus(ctx, req.(*MsgSetDenomTransferStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetChannelTransferStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetChannelTransferStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetChannelTransferStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Msg/SetChannelTransferStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetChannelTransferStatus(ctx, req.(*MsgSetChannelTransferStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetReceiverBlocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetReceiverBlocked)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetReceiverBlocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Msg/SetReceiverBlocked",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetReceiverBlocked(ctx, req.(*MsgSetReceiverBlocked))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.transfer.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -383,6 +760,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetDenomTransferStatus",
+			Handler:    _Msg_SetDenomTransferStatus_Handler,
+		},
+		{
+			MethodName: "SetChannelTransferStatus",
+			Handler:    _Msg_SetChannelTransferStatus_Handler,
+		},
+		{
+			MethodName: "SetReceiverBlocked",
+			Handler:    _Msg_SetReceiverBlocked_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/transfer/v1/tx.proto",
@@ -408,6 +797,27 @@ func (m *MsgTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DestinationClient) > 0 {
+		i -= len(m.DestinationClient)
+		copy(dAtA[i:], m.DestinationClient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DestinationClient)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.Encoding) > 0 {
 		i -= len(m.Encoding)
 		copy(dAtA[i:], m.Encoding)
@@ -569,40 +979,250 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetDenomTransferStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgTransfer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetDenomTransferStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomTransferStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SourcePort)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.SourceChannel)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Token.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomTransferStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomTransferStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomTransferStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetChannelTransferStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetChannelTransferStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetChannelTransferStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChannelOrClientId) > 0 {
+		i -= len(m.ChannelOrClientId)
+		copy(dAtA[i:], m.ChannelOrClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelOrClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetChannelTransferStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetChannelTransferStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetChannelTransferStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetReceiverBlocked) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetReceiverBlocked) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetReceiverBlocked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blocked {
+		i--
+		if m.Blocked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetReceiverBlockedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetReceiverBlockedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetReceiverBlockedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourcePort)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceChannel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Token.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	l = m.TimeoutHeight.Size()
 	n += 1 + l + sovTx(uint64(l))
@@ -617,6 +1237,16 @@ func (m *MsgTransfer) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.DestinationClient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -656,13 +1286,725 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgSetDenomTransferStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Status.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgSetDenomTransferStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
+
+func (m *MsgSetChannelTransferStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelOrClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Status.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetChannelTransferStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetReceiverBlocked) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Blocked {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetReceiverBlockedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourcePort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Encoding = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, types.Coin{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationClient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationClient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDenomTransferStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -685,15 +2027,15 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransfer: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDenomTransferStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDenomTransferStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourcePort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -721,11 +2063,11 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourcePort = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -753,11 +2095,11 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceChannel = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -784,79 +2126,115 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDenomTransferStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDenomTransferStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDenomTransferStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetChannelTransferStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetChannelTransferStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetChannelTransferStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -866,47 +2244,27 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
-			}
-			m.TimeoutTimestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelOrClientId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -934,13 +2292,13 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Memo = string(dAtA[iNdEx:postIndex])
+			m.ChannelOrClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Encoding", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -950,23 +2308,24 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Encoding = string(dAtA[iNdEx:postIndex])
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -989,7 +2348,7 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetChannelTransferStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1012,31 +2371,12 @@ func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetChannelTransferStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetChannelTransferStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
-			}
-			m.Sequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1058,7 +2398,7 @@ func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgSetReceiverBlocked) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1081,10 +2421,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetReceiverBlocked: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetReceiverBlocked: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1121,9 +2461,9 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1133,25 +2473,44 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Blocked = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1173,7 +2532,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetReceiverBlockedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1196,10 +2555,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetReceiverBlockedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetReceiverBlockedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
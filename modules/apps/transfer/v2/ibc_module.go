@@ -31,6 +31,21 @@ func NewIBCModule(k keeper.Keeper) *IBCModule {
 
 type IBCModule struct {
 	keeper keeper.Keeper
+
+	// writeAckWrapper is used to asynchronously write the acknowledgement of a
+	// received packet once the packet forwarded to the next hop is acknowledged or times out.
+	writeAckWrapper api.WriteAcknowledgementWrapper
+}
+
+// WithWriteAckWrapper sets the WriteAcknowledgementWrapper used to write the acknowledgements
+// of forwarded packets. Packet forwarding is disabled if it is not set.
+func (im *IBCModule) WithWriteAckWrapper(writeAckWrapper api.WriteAcknowledgementWrapper) {
+	im.writeAckWrapper = writeAckWrapper
+}
+
+// GetWriteAckWrapper returns the WriteAcknowledgementWrapper
+func (im *IBCModule) GetWriteAckWrapper() api.WriteAcknowledgementWrapper {
+	return im.writeAckWrapper
 }
 
 func (im *IBCModule) OnSendPacket(ctx sdk.Context, sourceChannel string, destinationChannel string, sequence uint64, payload channeltypesv2.Payload, signer sdk.AccAddress) error {
@@ -68,17 +83,24 @@ func (im *IBCModule) OnSendPacket(ctx sdk.Context, sourceChannel string, destina
 	// This prevents such denominations from being sent with IBCV v2 packets, however we can still support them in IBC v1 packets
 	// If we enforce that IBC v2 packets are sent with ICS20 v2 and above versions that separate the trace from the base denomination
 	// in the packet data, then we can remove this restriction.
-	if strings.Contains(data.Token.Denom.Base, "/") {
-		return errorsmod.Wrapf(types.ErrInvalidDenomForTransfer, "base denomination %s cannot contain slashes for IBC v2 packet", data.Token.Denom.Base)
+	for _, token := range data.Tokens {
+		if strings.Contains(token.Denom.Base, "/") {
+			return errorsmod.Wrapf(types.ErrInvalidDenomForTransfer, "base denomination %s cannot contain slashes for IBC v2 packet", token.Denom.Base)
+		}
 	}
 
-	if err := im.keeper.SendTransfer(ctx, payload.SourcePort, sourceChannel, data.Token, signer); err != nil {
+	// reject malformed forwarding information before the tokens leave the sender
+	if _, _, err := types.GetForwarding(data.Memo); err != nil {
 		return err
 	}
 
-	events.EmitTransferEvent(ctx, sender.String(), data.Receiver, data.Token, data.Memo)
+	if err := im.keeper.SendTransfer(ctx, payload.SourcePort, sourceChannel, data.Tokens, signer); err != nil {
+		return err
+	}
 
-	telemetry.ReportTransfer(payload.SourcePort, sourceChannel, payload.DestinationPort, destinationChannel, data.Token)
+	events.EmitTransferEvent(ctx, sender.String(), data.Receiver, data.Tokens, data.Memo)
+
+	telemetry.ReportTransfer(payload.SourcePort, sourceChannel, payload.DestinationPort, destinationChannel, data.Tokens)
 
 	return nil
 }
@@ -125,6 +147,41 @@ func (im *IBCModule) OnRecvPacket(ctx sdk.Context, sourceChannel string, destina
 		}
 	}
 
+	forwarding, isForwarded, ackErr := types.GetForwarding(data.Memo)
+	if ackErr != nil {
+		im.keeper.Logger(ctx).Error(fmt.Sprintf("%s sequence %d", ackErr.Error(), sequence))
+		return channeltypesv2.RecvPacketResult{
+			Status: channeltypesv2.PacketStatus_Failure,
+		}
+	}
+
+	if isForwarded {
+		if im.writeAckWrapper == nil {
+			ackErr = errorsmod.Wrap(types.ErrInvalidForwarding, "packet forwarding is not enabled")
+			im.keeper.Logger(ctx).Error(fmt.Sprintf("%s sequence %d", ackErr.Error(), sequence))
+			return channeltypesv2.RecvPacketResult{
+				Status: channeltypesv2.PacketStatus_Failure,
+			}
+		}
+
+		// NOTE: the timeout of the received packet is not known to the application and is not needed
+		// to write its acknowledgement or to revert its receipt.
+		packet := channeltypesv2.NewPacket(sequence, sourceChannel, destinationChannel, 0, payload)
+		if ackErr = im.keeper.ForwardPacket(ctx, packet, data, forwarding); ackErr != nil {
+			im.keeper.Logger(ctx).Error(fmt.Sprintf("%s sequence %d", ackErr.Error(), sequence))
+			return channeltypesv2.RecvPacketResult{
+				Status: channeltypesv2.PacketStatus_Failure,
+			}
+		}
+
+		telemetry.ReportOnRecvPacket(payload.SourcePort, sourceChannel, payload.DestinationPort, destinationChannel, data.Tokens)
+
+		// NOTE: acknowledgement will be written asynchronously once the forwarded packet is acknowledged or times out.
+		return channeltypesv2.RecvPacketResult{
+			Status: channeltypesv2.PacketStatus_Async,
+		}
+	}
+
 	if ackErr = im.keeper.OnRecvPacket(
 		ctx,
 		data,
@@ -141,7 +198,7 @@ func (im *IBCModule) OnRecvPacket(ctx sdk.Context, sourceChannel string, destina
 
 	im.keeper.Logger(ctx).Info("successfully handled ICS-20 packet", "sequence", sequence)
 
-	telemetry.ReportOnRecvPacket(payload.SourcePort, sourceChannel, payload.DestinationPort, destinationChannel, data.Token)
+	telemetry.ReportOnRecvPacket(payload.SourcePort, sourceChannel, payload.DestinationPort, destinationChannel, data.Tokens)
 
 	// NOTE: acknowledgement will be written synchronously during IBC handler execution.
 	return recvResult
@@ -158,6 +215,12 @@ func (im *IBCModule) OnTimeoutPacket(ctx sdk.Context, sourceChannel string, dest
 		return err
 	}
 
+	if forwardedPacket, found := im.keeper.GetForwardedPacket(ctx, sourceChannel, sequence); found {
+		if err := im.acknowledgeForwardedPacket(ctx, forwardedPacket, false); err != nil {
+			return err
+		}
+	}
+
 	events.EmitOnTimeoutEvent(ctx, data)
 
 	return nil
@@ -187,11 +250,40 @@ func (im *IBCModule) OnAcknowledgementPacket(ctx sdk.Context, sourceChannel stri
 		return err
 	}
 
+	if forwardedPacket, found := im.keeper.GetForwardedPacket(ctx, sourceChannel, sequence); found {
+		if err := im.acknowledgeForwardedPacket(ctx, forwardedPacket, ack.Success()); err != nil {
+			return err
+		}
+	}
+
 	events.EmitOnAcknowledgementPacketEvent(ctx, data, ack)
 
 	return nil
 }
 
+// acknowledgeForwardedPacket writes the acknowledgement of a packet which was forwarded to the
+// next hop. If the forwarded packet failed or timed out, the receipt of the packet is reverted
+// and an error acknowledgement is written so that the refund unwinds through the previous hops.
+func (im *IBCModule) acknowledgeForwardedPacket(ctx sdk.Context, forwardedPacket types.ForwardedPacket, success bool) error {
+	im.keeper.DeleteForwardedPacket(ctx, forwardedPacket.ForwardClientId, forwardedPacket.ForwardSequence)
+
+	packet := forwardedPacket.Packet
+	ack := channeltypesv2.NewAcknowledgement(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement())
+	if !success {
+		if err := im.keeper.RevertForwardedPacket(ctx, packet); err != nil {
+			return err
+		}
+
+		ack = channeltypesv2.NewErrorAcknowledgement()
+	}
+
+	if im.writeAckWrapper == nil {
+		return errorsmod.Wrap(types.ErrInvalidForwarding, "write acknowledgement wrapper is not set")
+	}
+
+	return im.writeAckWrapper.WriteAcknowledgement(ctx, packet.DestinationClient, packet.Sequence, ack)
+}
+
 // UnmarshalPacketData unmarshals the ICS20 packet data based on the version and encoding
 // it implements the PacketDataUnmarshaler interface
 func (*IBCModule) UnmarshalPacketData(payload channeltypesv2.Payload) (any, error) {
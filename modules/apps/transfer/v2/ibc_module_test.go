@@ -2,6 +2,7 @@ package v2_test
 
 import (
 	"crypto/sha256"
+	"encoding/json"
 	"fmt"
 	"testing"
 	"time"
@@ -476,3 +477,281 @@ func (suite *TransferTestSuite) TestOnTimeoutPacket() {
 		})
 	}
 }
+
+func (suite *TransferTestSuite) TestForwarding() {
+	var forwardedAck channeltypesv2.Acknowledgement
+
+	successAck := channeltypesv2.NewAcknowledgement(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement())
+
+	testCases := []struct {
+		name          string
+		malleate      func()
+		timeout       bool
+		expAck        channeltypesv2.Acknowledgement
+		expFinalFunds bool
+	}{
+		{
+			"success: forwarded packet is acknowledged",
+			func() {},
+			false,
+			successAck,
+			true,
+		},
+		{
+			"failure: forwarded packet fails on the final hop",
+			func() {
+				suite.chainC.GetSimApp().TransferKeeper.SetParams(suite.chainC.GetContext(), types.NewParams(true, false))
+				forwardedAck = channeltypesv2.NewErrorAcknowledgement()
+			},
+			false,
+			channeltypesv2.NewErrorAcknowledgement(),
+			false,
+		},
+		{
+			"failure: forwarded packet times out",
+			func() {},
+			true,
+			channeltypesv2.NewErrorAcknowledgement(),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			forwardedAck = successAck
+
+			tc.malleate()
+
+			sender := suite.chainA.SenderAccount.GetAddress()
+			receiver := suite.chainC.SenderAccount.GetAddress()
+			originalBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+			amount := sdkmath.NewInt(100)
+
+			forwarding := types.NewForwarding("final memo", suite.pathBToC.EndpointA.ClientID)
+			memo, err := json.Marshal(map[string]types.Forwarding{types.ForwardingMemoKey: forwarding})
+			suite.Require().NoError(err)
+
+			transferData := types.NewFungibleTokenPacketData(sdk.DefaultBondDenom, amount.String(), sender.String(), receiver.String(), string(memo))
+			bz := suite.chainA.Codec.MustMarshal(&transferData)
+			payload := channeltypesv2.NewPayload(types.PortID, types.PortID, types.V1, types.EncodingProtobuf, bz)
+
+			packet, err := suite.pathAToB.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), payload)
+			suite.Require().NoError(err)
+
+			// the packet is received on chainB and forwarded to chainC, its acknowledgement is written asynchronously
+			forwardTimeout := uint64(suite.chainB.ProposedHeader.GetTime().Add(types.DefaultForwardingTimeout).Unix())
+			err = suite.pathAToB.EndpointB.MsgRecvPacket(packet)
+			suite.Require().NoError(err)
+
+			commitment := suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.DestinationClient, packet.Sequence)
+			suite.Require().Empty(commitment)
+
+			forwardedPackets := suite.chainB.GetSimApp().TransferKeeper.GetAllForwardedPackets(suite.chainB.GetContext())
+			suite.Require().Len(forwardedPackets, 1)
+			suite.Require().Equal(suite.pathBToC.EndpointA.ClientID, forwardedPackets[0].ForwardClientId)
+
+			forwardAddress := types.GetForwardAddress(types.PortID, suite.pathAToB.EndpointB.ClientID)
+			denomOnB := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(types.PortID, suite.pathAToB.EndpointB.ClientID))
+			forwardedData := types.NewFungibleTokenPacketData(denomOnB.Path(), amount.String(), forwardAddress.String(), receiver.String(), forwarding.Memo)
+			bz = suite.chainB.Codec.MustMarshal(&forwardedData)
+			forwardedPacket := channeltypesv2.NewPacket(
+				forwardedPackets[0].ForwardSequence, suite.pathBToC.EndpointA.ClientID, suite.pathBToC.EndpointB.ClientID, forwardTimeout,
+				channeltypesv2.NewPayload(types.PortID, types.PortID, types.V1, types.EncodingProtobuf, bz),
+			)
+
+			if tc.timeout {
+				suite.coordinator.IncrementTimeBy(types.DefaultForwardingTimeout + time.Minute)
+				suite.Require().NoError(suite.pathBToC.EndpointA.UpdateClient())
+
+				err = suite.pathBToC.EndpointA.MsgTimeoutPacket(forwardedPacket)
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().NoError(suite.pathBToC.EndpointB.UpdateClient())
+
+				err = suite.pathBToC.EndpointB.MsgRecvPacket(forwardedPacket)
+				suite.Require().NoError(err)
+
+				err = suite.pathBToC.EndpointA.MsgAcknowledgePacket(forwardedPacket, forwardedAck)
+				suite.Require().NoError(err)
+			}
+
+			// the acknowledgement of the original packet has been written on chainB
+			suite.Require().Empty(suite.chainB.GetSimApp().TransferKeeper.GetAllForwardedPackets(suite.chainB.GetContext()))
+			commitment = suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.DestinationClient, packet.Sequence)
+			suite.Require().Equal(channeltypesv2.CommitAcknowledgement(tc.expAck), commitment)
+
+			suite.Require().NoError(suite.pathAToB.EndpointA.UpdateClient())
+
+			err = suite.pathAToB.EndpointA.MsgAcknowledgePacket(packet, tc.expAck)
+			suite.Require().NoError(err)
+
+			// no funds are left in the forward address on chainB
+			forwardBalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), forwardAddress, denomOnB.IBCDenom())
+			suite.Require().True(forwardBalance.IsZero())
+
+			denomOnC := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(types.PortID, suite.pathBToC.EndpointB.ClientID), types.NewHop(types.PortID, suite.pathAToB.EndpointB.ClientID))
+			receiverBalance := suite.chainC.GetSimApp().BankKeeper.GetBalance(suite.chainC.GetContext(), receiver, denomOnC.IBCDenom())
+			senderBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+			voucherSupplyOnB := suite.chainB.GetSimApp().BankKeeper.GetSupply(suite.chainB.GetContext(), denomOnB.IBCDenom())
+
+			if tc.expFinalFunds {
+				suite.Require().Equal(amount, receiverBalance.Amount)
+				suite.Require().Equal(originalBalance.Amount.Sub(amount), senderBalance.Amount)
+				suite.Require().Equal(amount, voucherSupplyOnB.Amount)
+			} else {
+				// the refund has unwound through every hop
+				suite.Require().True(receiverBalance.IsZero())
+				suite.Require().Equal(originalBalance.Amount, senderBalance.Amount)
+				suite.Require().True(voucherSupplyOnB.IsZero())
+			}
+		})
+	}
+}
+
+func (suite *TransferTestSuite) TestForwardingBlockedReceiver() {
+	sender := suite.chainA.SenderAccount.GetAddress()
+	receiver := suite.chainC.SenderAccount.GetAddress()
+	originalBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+	// the receiver on the final hop is blocked on the intermediate chain
+	suite.chainB.GetSimApp().TransferKeeper.SetBlockedReceiver(suite.chainB.GetContext(), receiver)
+
+	forwarding := types.NewForwarding("", suite.pathBToC.EndpointA.ClientID)
+	memo, err := json.Marshal(map[string]types.Forwarding{types.ForwardingMemoKey: forwarding})
+	suite.Require().NoError(err)
+
+	transferData := types.NewFungibleTokenPacketData(sdk.DefaultBondDenom, "100", sender.String(), receiver.String(), string(memo))
+	bz := suite.chainA.Codec.MustMarshal(&transferData)
+	payload := channeltypesv2.NewPayload(types.PortID, types.PortID, types.V1, types.EncodingProtobuf, bz)
+
+	packet, err := suite.pathAToB.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), payload)
+	suite.Require().NoError(err)
+
+	err = suite.pathAToB.EndpointB.MsgRecvPacket(packet)
+	suite.Require().NoError(err)
+
+	// the packet is not forwarded and its error acknowledgement is written synchronously
+	suite.Require().Empty(suite.chainB.GetSimApp().TransferKeeper.GetAllForwardedPackets(suite.chainB.GetContext()))
+	commitment := suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.DestinationClient, packet.Sequence)
+	suite.Require().Equal(channeltypesv2.CommitAcknowledgement(channeltypesv2.NewErrorAcknowledgement()), commitment)
+
+	suite.Require().NoError(suite.pathAToB.EndpointA.UpdateClient())
+
+	err = suite.pathAToB.EndpointA.MsgAcknowledgePacket(packet, channeltypesv2.NewErrorAcknowledgement())
+	suite.Require().NoError(err)
+
+	senderBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+	suite.Require().Equal(originalBalance.Amount, senderBalance.Amount)
+}
+
+func (suite *TransferTestSuite) TestMultiTokenTransfer() {
+	successAck := channeltypesv2.NewAcknowledgement(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement())
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		timeout  bool
+		expAck   channeltypesv2.Acknowledgement
+	}{
+		{
+			"success: all tokens are received",
+			func() {},
+			false,
+			successAck,
+		},
+		{
+			"failure: receive disabled, all tokens are refunded",
+			func() {
+				suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, false))
+			},
+			false,
+			channeltypesv2.NewErrorAcknowledgement(),
+		},
+		{
+			"failure: packet times out, all tokens are refunded",
+			func() {},
+			true,
+			channeltypesv2.Acknowledgement{},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+
+			sender := suite.chainA.SenderAccount.GetAddress()
+			receiver := suite.chainB.SenderAccount.GetAddress()
+			amount := sdkmath.NewInt(100)
+			coins := []sdk.Coin{sdk.NewCoin(sdk.DefaultBondDenom, amount), sdk.NewCoin(ibctesting.SecondaryDenom, amount)}
+
+			originalBalances := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), sender)
+
+			timeoutTimestamp := suite.chainA.GetTimeoutTimestampSecs()
+			msg := types.NewMsgTransferWithTokens(
+				types.PortID, suite.pathAToB.EndpointA.ClientID,
+				coins, sender.String(), receiver.String(),
+				timeoutTimestamp, "", types.EncodingProtobuf,
+			)
+			_, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err) // message committed
+
+			// all tokens are escrowed on chainA
+			escrowAddress := types.GetEscrowAddress(types.PortID, suite.pathAToB.EndpointA.ClientID)
+			for _, coin := range coins {
+				escrowBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, coin.Denom)
+				suite.Require().Equal(coin, escrowBalance)
+			}
+
+			tokens := types.Tokens{
+				{Denom: types.NewDenom(sdk.DefaultBondDenom), Amount: amount.String()},
+				{Denom: types.NewDenom(ibctesting.SecondaryDenom), Amount: amount.String()},
+			}
+			transferData := types.NewFungibleTokenPacketDataV2(tokens, sender.String(), receiver.String(), "")
+			bz, err := types.MarshalPacketDataV2(transferData, types.EncodingProtobuf)
+			suite.Require().NoError(err)
+			packet := channeltypesv2.NewPacket(
+				1, suite.pathAToB.EndpointA.ClientID, suite.pathAToB.EndpointB.ClientID, timeoutTimestamp,
+				channeltypesv2.NewPayload(types.PortID, types.PortID, types.V2, types.EncodingProtobuf, bz),
+			)
+
+			if tc.timeout {
+				suite.coordinator.IncrementTimeBy(2 * time.Hour)
+				suite.Require().NoError(suite.pathAToB.EndpointA.UpdateClient())
+
+				err = suite.pathAToB.EndpointA.MsgTimeoutPacket(packet)
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().NoError(suite.pathAToB.EndpointB.UpdateClient())
+
+				err = suite.pathAToB.EndpointB.MsgRecvPacket(packet)
+				suite.Require().NoError(err)
+
+				commitment := suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.DestinationClient, packet.Sequence)
+				suite.Require().Equal(channeltypesv2.CommitAcknowledgement(tc.expAck), commitment)
+
+				err = suite.pathAToB.EndpointA.MsgAcknowledgePacket(packet, tc.expAck)
+				suite.Require().NoError(err)
+			}
+
+			for _, coin := range coins {
+				denomOnB := types.NewDenom(coin.Denom, types.NewHop(types.PortID, suite.pathAToB.EndpointB.ClientID))
+				receiverBalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, denomOnB.IBCDenom())
+				senderBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, coin.Denom)
+				escrowBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, coin.Denom)
+
+				if !tc.timeout && tc.expAck.Success() {
+					suite.Require().Equal(amount, receiverBalance.Amount)
+					suite.Require().Equal(originalBalances.AmountOf(coin.Denom).Sub(amount), senderBalance.Amount)
+					suite.Require().Equal(amount, escrowBalance.Amount)
+				} else {
+					suite.Require().True(receiverBalance.IsZero())
+					suite.Require().Equal(originalBalances.AmountOf(coin.Denom), senderBalance.Amount)
+					suite.Require().True(escrowBalance.IsZero())
+				}
+			}
+		})
+	}
+}
@@ -33,3 +33,8 @@ func BeginBlocker(ctx sdk.Context, k *keeper.Keeper) {
 		}
 	}
 }
+
+// EndBlocker is used to prune the consensus states of clients according to their retention policies
+func EndBlocker(ctx sdk.Context, k *keeper.Keeper) {
+	k.PruneConsensusStates(ctx)
+}
@@ -32,6 +32,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdClientParams(),
 		GetCmdQueryClientCreator(),
 		GetCmdQueryClientConfig(),
+		GetCmdQueryClientStorage(),
+		GetCmdQueryClientMisbehaviours(),
 	)
 
 	return queryCmd
@@ -54,6 +56,8 @@ func NewTxCmd() *cobra.Command {
 		newSubmitMisbehaviourCmd(), // Deprecated
 		newUpgradeClientCmd(),
 		newSubmitRecoverClientProposalCmd(),
+		newSubmitFreezeClientProposalCmd(),
+		newSubmitUnfreezeClientProposalCmd(),
 		newScheduleIBCUpgradeProposalCmd(),
 		newUpdateClientConfigCmd(),
 		newDeleteClientCreatorCmd(),
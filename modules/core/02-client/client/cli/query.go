@@ -158,6 +158,80 @@ func GetCmdQueryClientConfig() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryClientStorage defines the command to query the storage footprint of a client
+func GetCmdQueryClientStorage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "storage [client-id]",
+		Short:   "Query a client's storage footprint",
+		Long:    "Query the number of consensus states, keys and bytes stored for a client along with its retention policy",
+		Example: fmt.Sprintf("%s query %s %s storage 07-tendermint-0", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			clientID := args[0]
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryClientStorageRequest{
+				ClientId: clientID,
+			}
+			res, err := queryClient.ClientStorage(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryClientMisbehaviours defines the command to query the misbehaviour evidence recorded for a client.
+func GetCmdQueryClientMisbehaviours() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "misbehaviours [client-id]",
+		Short:   "Query the misbehaviour evidence of a client",
+		Long:    "Query the misbehaviour evidence recorded for a client, including the conflicting header heights and hashes, the submitter and the block height of submission",
+		Example: fmt.Sprintf("%s query %s %s misbehaviours 07-tendermint-0", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			clientID := args[0]
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryClientMisbehavioursRequest{
+				ClientId:   clientID,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ClientMisbehaviours(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "client misbehaviours")
+
+	return cmd
+}
+
 // GetCmdQueryClientState defines the command to query the state of a client with
 // a given id as defined in https://github.com/cosmos/ibc/tree/master/spec/core/ics-002-client-semantics#query
 func GetCmdQueryClientState() *cobra.Command {
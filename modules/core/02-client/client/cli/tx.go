@@ -25,7 +25,12 @@ import (
 	"github.com/cosmos/ibc-go/v10/modules/core/exported"
 )
 
-const FlagAuthority = "authority"
+const (
+	FlagAuthority             = "authority"
+	FlagMaxConsensusStates    = "max-consensus-states"
+	FlagMaxConsensusStatesAge = "max-consensus-states-age"
+	FlagLivenessPeriod        = "liveness-period"
+)
 
 // newCreateClientCmd defines the command to create a new IBC light client.
 func newCreateClientCmd() *cobra.Command {
@@ -81,10 +86,26 @@ func newCreateClientCmd() *cobra.Command {
 				return err
 			}
 
+			retentionPolicy, err := retentionPolicyFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !retentionPolicy.IsEmpty() {
+				msg.RetentionPolicy = &retentionPolicy
+			}
+
+			msg.LivenessPeriod, err = cmd.Flags().GetDuration(FlagLivenessPeriod)
+			if err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	addRetentionPolicyFlags(cmd)
+	addLivenessPeriodFlag(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -152,7 +173,7 @@ func newDeleteClientCreatorCmd() *cobra.Command {
 func newUpdateClientConfigCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "update-client-config client-id [allowed-relayer-addresses...]",
-		Short:   "update allowed relayers for a client (replaces existing list, and no addresses means empty list and permissionless relaying)",
+		Short:   "update allowed relayers, the retention policy and the liveness period for a client (replaces existing config, and no addresses means empty list and permissionless relaying)",
 		Example: fmt.Sprintf("%s tx ibc %s update-client-params 08-wasm-0 cosmos123... cosmos456...", version.AppName, types.SubModuleName),
 		Args:    cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -171,16 +192,56 @@ func newUpdateClientConfigCmd() *cobra.Command {
 				allowedRelayers = append(allowedRelayers, relayerAddress)
 			}
 
-			msg := clienttypesv2.NewMsgUpdateClientConfig(clientID, clientCtx.GetFromAddress().String(), clienttypesv2.NewConfig(allowedRelayers...))
+			config := clienttypesv2.NewConfig(allowedRelayers...)
+			config.RetentionPolicy, err = retentionPolicyFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			config.LivenessPeriod, err = cmd.Flags().GetDuration(FlagLivenessPeriod)
+			if err != nil {
+				return err
+			}
+
+			msg := clienttypesv2.NewMsgUpdateClientConfig(clientID, clientCtx.GetFromAddress().String(), config)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	addRetentionPolicyFlags(cmd)
+	addLivenessPeriodFlag(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
+// addRetentionPolicyFlags adds the flags defining the retention policy of the consensus states of a client.
+func addRetentionPolicyFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64(FlagMaxConsensusStates, 0, "maximum number of consensus states retained for the client, 0 disables the limit")
+	cmd.Flags().Duration(FlagMaxConsensusStatesAge, 0, "maximum age of a consensus state retained for the client, 0 disables the limit")
+}
+
+// retentionPolicyFromFlags returns the retention policy defined by the retention policy flags.
+func retentionPolicyFromFlags(cmd *cobra.Command) (types.RetentionPolicy, error) {
+	maxConsensusStates, err := cmd.Flags().GetUint64(FlagMaxConsensusStates)
+	if err != nil {
+		return types.RetentionPolicy{}, err
+	}
+
+	maxAge, err := cmd.Flags().GetDuration(FlagMaxConsensusStatesAge)
+	if err != nil {
+		return types.RetentionPolicy{}, err
+	}
+
+	retentionPolicy := types.NewRetentionPolicy(maxConsensusStates, maxAge)
+	return retentionPolicy, retentionPolicy.Validate()
+}
+
+// addLivenessPeriodFlag adds the flag defining the liveness period of a client.
+func addLivenessPeriodFlag(cmd *cobra.Command) {
+	cmd.Flags().Duration(FlagLivenessPeriod, 0, "duration after which the client is inactive if it has not been updated, 0 disables the liveness check")
+}
+
 // newUpdateClientCmd defines the command to update an IBC client.
 func newUpdateClientCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -396,6 +457,114 @@ func newSubmitRecoverClientProposalCmd() *cobra.Command {
 	return cmd
 }
 
+// newSubmitFreezeClientProposalCmd defines the command to freeze an IBC light client.
+func newSubmitFreezeClientProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze-client [client-id] [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "freeze an IBC client",
+		Long: `Submit a freeze IBC client proposal along with an initial deposit
+		Please specify the identifier of the client you want to freeze.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := govcli.ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			authority, _ := cmd.Flags().GetString(FlagAuthority)
+			if authority != "" {
+				if _, err = sdk.AccAddressFromBech32(authority); err != nil {
+					return fmt.Errorf("invalid authority address: %w", err)
+				}
+			} else {
+				authority = sdk.AccAddress(address.Module(govtypes.ModuleName)).String()
+			}
+
+			msg := types.NewMsgFreezeClient(args[0], authority)
+
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("error validating %T: %w", types.MsgFreezeClient{}, err)
+			}
+
+			if err := proposal.SetMsgs([]sdk.Msg{msg}); err != nil {
+				return fmt.Errorf("failed to create freeze client proposal message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	cmd.Flags().String(FlagAuthority, "", "The address of the client module authority (defaults to gov)")
+
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	err := cmd.MarkFlagRequired(govcli.FlagTitle)
+	if err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// newSubmitUnfreezeClientProposalCmd defines the command to unfreeze an IBC light client.
+func newSubmitUnfreezeClientProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze-client [client-id] [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "unfreeze an IBC client",
+		Long: `Submit an unfreeze IBC client proposal along with an initial deposit
+		Please specify the identifier of the frozen client you want to unfreeze.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := govcli.ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			authority, _ := cmd.Flags().GetString(FlagAuthority)
+			if authority != "" {
+				if _, err = sdk.AccAddressFromBech32(authority); err != nil {
+					return fmt.Errorf("invalid authority address: %w", err)
+				}
+			} else {
+				authority = sdk.AccAddress(address.Module(govtypes.ModuleName)).String()
+			}
+
+			msg := types.NewMsgUnfreezeClient(args[0], authority)
+
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("error validating %T: %w", types.MsgUnfreezeClient{}, err)
+			}
+
+			if err := proposal.SetMsgs([]sdk.Msg{msg}); err != nil {
+				return fmt.Errorf("failed to create unfreeze client proposal message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	cmd.Flags().String(FlagAuthority, "", "The address of the client module authority (defaults to gov)")
+
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	err := cmd.MarkFlagRequired(govcli.FlagTitle)
+	if err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
 // newScheduleIBCUpgradeProposalCmd defines the command for submitting an IBC software upgrade proposal.
 func newScheduleIBCUpgradeProposalCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -36,6 +36,11 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, gs types.GenesisState) {
 		}
 
 		k.SetClientState(ctx, client.ClientId, cs)
+
+		// retention policies are imported as client metadata, index them so that they are enforced
+		if policy := k.GetRetentionPolicy(ctx, client.ClientId); !policy.IsEmpty() {
+			k.SetRetentionPolicy(ctx, client.ClientId, policy)
+		}
 	}
 
 	for _, cs := range gs.ClientsConsensus {
@@ -49,6 +54,10 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, gs types.GenesisState) {
 		}
 	}
 
+	for _, misbehaviour := range gs.Misbehaviours {
+		k.SetClientMisbehaviour(ctx, misbehaviour)
+	}
+
 	k.SetNextClientSequence(ctx, gs.NextClientSequence)
 }
 
@@ -69,5 +78,6 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) types.GenesisState {
 		// Warning: CreateLocalhost is deprecated
 		CreateLocalhost:    false,
 		NextClientSequence: k.GetNextClientSequence(ctx),
+		Misbehaviours:      k.GetAllClientMisbehaviours(ctx),
 	}
 }
@@ -31,7 +31,9 @@ func (k *Keeper) CreateClient(ctx sdk.Context, clientType string, clientState, c
 		return "", err
 	}
 
-	if status := clientModule.Status(ctx, clientID); status != exported.Active {
+	k.setLastUpdateTime(ctx, clientID)
+
+	if status := k.clientStatus(ctx, clientModule, clientID); status != exported.Active {
 		return "", errorsmod.Wrapf(types.ErrClientNotActive, "cannot create client (%s) with status %s", clientID, status)
 	}
 
@@ -44,14 +46,23 @@ func (k *Keeper) CreateClient(ctx sdk.Context, clientType string, clientState, c
 	return clientID, nil
 }
 
-// UpdateClient updates the consensus state and the state root from a provided header.
+// UpdateClient updates the consensus state and the state root from a provided header. If the client message
+// constitutes misbehaviour, the client is frozen and the misbehaviour evidence is recorded without a submitter.
 func (k *Keeper) UpdateClient(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) error {
+	return k.UpdateClientWithSubmitter(ctx, clientID, clientMsg, "")
+}
+
+// UpdateClientWithSubmitter updates the consensus state and the state root from a provided header. If the client
+// message constitutes misbehaviour, the client is frozen and the misbehaviour evidence is recorded along with the
+// address of the submitter.
+func (k *Keeper) UpdateClientWithSubmitter(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage, submitter string) error {
 	clientModule, err := k.Route(ctx, clientID)
 	if err != nil {
 		return err
 	}
 
-	if status := clientModule.Status(ctx, clientID); status != exported.Active {
+	// inactive clients may be updated in order to become active again
+	if status := k.clientStatus(ctx, clientModule, clientID); status != exported.Active && status != exported.Inactive {
 		return errorsmod.Wrapf(types.ErrClientNotActive, "cannot update client (%s) with status %s", clientID, status)
 	}
 
@@ -63,6 +74,8 @@ func (k *Keeper) UpdateClient(ctx sdk.Context, clientID string, clientMsg export
 	if foundMisbehaviour {
 		clientModule.UpdateStateOnMisbehaviour(ctx, clientID, clientMsg)
 
+		k.recordMisbehaviour(ctx, clientID, clientMsg, submitter)
+
 		k.Logger(ctx).Info("client frozen due to misbehaviour", "client-id", clientID)
 
 		clientType := types.MustParseClientIdentifier(clientID)
@@ -72,8 +85,15 @@ func (k *Keeper) UpdateClient(ctx sdk.Context, clientID string, clientMsg export
 		return nil
 	}
 
+	latestHeight := clientModule.LatestHeight(ctx, clientID)
 	consensusHeights := clientModule.UpdateState(ctx, clientID, clientMsg)
 
+	// only updates which advance the latest height of the client are recorded for the liveness period, such that
+	// a client cannot be kept alive by resubmitting headers it has already verified
+	if clientModule.LatestHeight(ctx, clientID).GT(latestHeight) {
+		k.setLastUpdateTime(ctx, clientID)
+	}
+
 	k.Logger(ctx).Info("client state updated", "client-id", clientID, "heights", consensusHeights)
 
 	clientType := types.MustParseClientIdentifier(clientID)
@@ -95,7 +115,7 @@ func (k *Keeper) UpgradeClient(
 		return err
 	}
 
-	if status := clientModule.Status(ctx, clientID); status != exported.Active {
+	if status := k.clientStatus(ctx, clientModule, clientID); status != exported.Active {
 		return errorsmod.Wrapf(types.ErrClientNotActive, "cannot upgrade client (%s) with status %s", clientID, status)
 	}
 
@@ -103,6 +123,8 @@ func (k *Keeper) UpgradeClient(
 		return errorsmod.Wrapf(err, "cannot upgrade client with ID %s", clientID)
 	}
 
+	k.setLastUpdateTime(ctx, clientID)
+
 	latestHeight := clientModule.LatestHeight(ctx, clientID)
 	k.Logger(ctx).Info("client state upgraded", "client-id", clientID, "height", latestHeight.String())
 
@@ -117,18 +139,18 @@ func (k *Keeper) UpgradeClient(
 // recover the subject client given a substitute client identifier. The light client implementation
 // is responsible for validating the parameters of the substitute (ensuring they match the subject's parameters)
 // as well as copying the necessary consensus states from the substitute to the subject client store.
-// The substitute must be Active and the subject must not be Active.
+// The substitute must be Active and the subject must not be Active. Any freeze of the subject by the authority is lifted.
 func (k *Keeper) RecoverClient(ctx sdk.Context, subjectClientID, substituteClientID string) error {
 	clientModule, err := k.Route(ctx, subjectClientID)
 	if err != nil {
 		return errorsmod.Wrap(types.ErrRouteNotFound, subjectClientID)
 	}
 
-	if status := clientModule.Status(ctx, subjectClientID); status == exported.Active {
+	if status := k.clientStatus(ctx, clientModule, subjectClientID); status == exported.Active {
 		return errorsmod.Wrapf(types.ErrInvalidRecoveryClient, "cannot recover subject client (%s) with status %s", subjectClientID, status)
 	}
 
-	if status := clientModule.Status(ctx, substituteClientID); status != exported.Active {
+	if status := k.clientStatus(ctx, clientModule, substituteClientID); status != exported.Active {
 		return errorsmod.Wrapf(types.ErrClientNotActive, "cannot recover client using substitute client (%s) with status %s", substituteClientID, status)
 	}
 
@@ -142,6 +164,10 @@ func (k *Keeper) RecoverClient(ctx sdk.Context, subjectClientID, substituteClien
 		return err
 	}
 
+	// a client frozen by the authority is unfrozen once it has been recovered
+	k.ClientStore(ctx, subjectClientID).Delete(types.FrozenByAuthorityKey())
+	k.setLastUpdateTime(ctx, subjectClientID)
+
 	k.Logger(ctx).Info("client recovered", "client-id", subjectClientID)
 
 	clientType := types.MustParseClientIdentifier(subjectClientID)
@@ -150,3 +176,70 @@ func (k *Keeper) RecoverClient(ctx sdk.Context, subjectClientID, substituteClien
 
 	return nil
 }
+
+// FreezeClient freezes a client on request of the authority, such that it can no longer be used or updated until it
+// is unfrozen or recovered. Light client modules implementing the exported.ClientFreezer interface additionally freeze
+// the client natively. The client is marked as frozen by the authority in the client store, such that only clients
+// frozen by the authority can be unfrozen.
+func (k *Keeper) FreezeClient(ctx sdk.Context, clientID string) error {
+	clientModule, err := k.Route(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	if _, found := k.GetClientState(ctx, clientID); !found {
+		return errorsmod.Wrap(types.ErrClientNotFound, clientID)
+	}
+
+	if status := k.clientStatus(ctx, clientModule, clientID); status == exported.Frozen {
+		return errorsmod.Wrapf(types.ErrClientFrozen, "cannot freeze client (%s) with status %s", clientID, status)
+	}
+
+	if freezer, ok := clientModule.(exported.ClientFreezer); ok {
+		if err := freezer.FreezeClient(ctx, clientID); err != nil {
+			return err
+		}
+	}
+
+	k.ClientStore(ctx, clientID).Set(types.FrozenByAuthorityKey(), []byte{1})
+
+	k.Logger(ctx).Info("client frozen by authority", "client-id", clientID)
+
+	emitFreezeClientEvent(ctx, clientID, types.MustParseClientIdentifier(clientID))
+
+	return nil
+}
+
+// UnfreezeClient unfreezes a client frozen by the authority on request of the authority. Clients which have not been
+// frozen by the authority, for example clients frozen due to misbehaviour, must be recovered instead.
+func (k *Keeper) UnfreezeClient(ctx sdk.Context, clientID string) error {
+	clientModule, err := k.Route(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	clientStore := k.ClientStore(ctx, clientID)
+	if !clientStore.Has(types.FrozenByAuthorityKey()) {
+		status := k.clientStatus(ctx, clientModule, clientID)
+		return errorsmod.Wrapf(types.ErrClientNotFrozen, "cannot unfreeze client (%s) with status %s which has not been frozen by the authority", clientID, status)
+	}
+
+	clientStore.Delete(types.FrozenByAuthorityKey())
+
+	if status := clientModule.Status(ctx, clientID); status == exported.Frozen {
+		freezer, ok := clientModule.(exported.ClientFreezer)
+		if !ok {
+			return errorsmod.Wrapf(types.ErrClientTypeNotSupported, "light client module of client (%s) cannot unfreeze clients, the client must be recovered", clientID)
+		}
+
+		if err := freezer.UnfreezeClient(ctx, clientID); err != nil {
+			return err
+		}
+	}
+
+	k.Logger(ctx).Info("client unfrozen by authority", "client-id", clientID)
+
+	emitUnfreezeClientEvent(ctx, clientID, types.MustParseClientIdentifier(clientID))
+
+	return nil
+}
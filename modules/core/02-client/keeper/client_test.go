@@ -296,6 +296,9 @@ func (suite *KeeperTestSuite) TestUpdateClientTendermint() {
 
 				if tc.expFreeze {
 					suite.Require().True(!newClientState.FrozenHeight.IsZero(), "client did not freeze after conflicting header was submitted to UpdateClient")
+
+					_, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientMisbehaviour(suite.chainA.GetContext(), path.EndpointA.ClientID, 0)
+					suite.Require().True(found, "misbehaviour evidence was not recorded")
 				} else {
 					expConsensusState := &ibctm.ConsensusState{
 						Timestamp:          updateHeader.GetTime(),
@@ -691,3 +694,188 @@ func (suite *KeeperTestSuite) TestRecoverClient() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestFreezeClient() {
+	var clientID string
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: solomachine client",
+			func() {
+				solomachine := ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, "solomachine", "", 1)
+				clientID = solomachine.CreateClient(suite.chainA)
+			},
+			nil,
+		},
+		{
+			"success: inactive client",
+			func() {
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetLivenessPeriod(suite.chainA.GetContext(), clientID, time.Hour)
+				suite.coordinator.IncrementTimeBy(2 * time.Hour)
+			},
+			nil,
+		},
+		{
+			"client not found",
+			func() {
+				clientID = clienttypes.FormatClientIdentifier(exported.Tendermint, 100)
+			},
+			clienttypes.ErrClientNotFound,
+		},
+		{
+			"invalid client identifier",
+			func() {
+				clientID = ibctesting.InvalidID
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"client is already frozen",
+			func() {
+				err := suite.chainA.App.GetIBCKeeper().ClientKeeper.FreezeClient(suite.chainA.GetContext(), clientID)
+				suite.Require().NoError(err)
+			},
+			clienttypes.ErrClientFrozen,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+			clientID = path.EndpointA.ClientID
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			err := suite.chainA.App.GetIBCKeeper().ClientKeeper.FreezeClient(ctx, clientID)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				expectedEvents := sdk.Events{
+					sdk.NewEvent(
+						clienttypes.EventTypeFreezeClient,
+						sdk.NewAttribute(clienttypes.AttributeKeyClientID, clientID),
+						sdk.NewAttribute(clienttypes.AttributeKeyClientType, clienttypes.MustParseClientIdentifier(clientID)),
+					),
+				}.ToABCIEvents()
+
+				expectedEvents = sdk.MarkEventsToIndex(expectedEvents, map[string]struct{}{})
+				ibctesting.AssertEvents(&suite.Suite, expectedEvents, ctx.EventManager().Events().ToABCIEvents())
+
+				// the client is marked as frozen by the authority in addition to being frozen by the light client module
+				suite.Require().Equal(exported.Frozen, suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientStatus(ctx, clientID))
+				suite.Require().True(suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, clientID).Has(clienttypes.FrozenByAuthorityKey()))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUnfreezeClient() {
+	var clientID string
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: client frozen by the authority",
+			func() {
+				err := suite.chainA.App.GetIBCKeeper().ClientKeeper.FreezeClient(suite.chainA.GetContext(), clientID)
+				suite.Require().NoError(err)
+			},
+			nil,
+		},
+		{
+			"success: client marked as frozen in the client store",
+			func() {
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), clientID).Set(clienttypes.FrozenByAuthorityKey(), []byte{1})
+			},
+			nil,
+		},
+		{
+			"invalid client identifier",
+			func() {
+				clientID = ibctesting.InvalidID
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"client is not frozen",
+			func() {},
+			clienttypes.ErrClientNotFrozen,
+		},
+		{
+			"client frozen due to misbehaviour",
+			func() {
+				clientState, ok := suite.chainA.GetClientState(clientID).(*ibctm.ClientState)
+				suite.Require().True(ok)
+				clientState.FrozenHeight = ibctm.FrozenHeight
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), clientID, clientState)
+			},
+			clienttypes.ErrClientNotFrozen,
+		},
+		{
+			"client frozen by the light client module without the authority marker",
+			func() {
+				err := suite.chainA.App.GetIBCKeeper().ClientKeeper.FreezeClient(suite.chainA.GetContext(), clientID)
+				suite.Require().NoError(err)
+
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), clientID).Delete(clienttypes.FrozenByAuthorityKey())
+			},
+			clienttypes.ErrClientNotFrozen,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+			clientID = path.EndpointA.ClientID
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			err := suite.chainA.App.GetIBCKeeper().ClientKeeper.UnfreezeClient(ctx, clientID)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				expectedEvents := sdk.Events{
+					sdk.NewEvent(
+						clienttypes.EventTypeUnfreezeClient,
+						sdk.NewAttribute(clienttypes.AttributeKeyClientID, clientID),
+						sdk.NewAttribute(clienttypes.AttributeKeyClientType, exported.Tendermint),
+					),
+				}.ToABCIEvents()
+
+				expectedEvents = sdk.MarkEventsToIndex(expectedEvents, map[string]struct{}{})
+				ibctesting.AssertEvents(&suite.Suite, expectedEvents, ctx.EventManager().Events().ToABCIEvents())
+
+				suite.Require().Equal(exported.Active, suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientStatus(ctx, clientID))
+
+				err = path.EndpointA.UpdateClient()
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
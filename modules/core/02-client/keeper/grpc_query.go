@@ -279,6 +279,34 @@ func (q *queryServer) ClientCreator(goCtx context.Context, req *types.QueryClien
 	}, nil
 }
 
+// ClientStorage implements the Query/ClientStorage gRPC method
+func (q *queryServer) ClientStorage(goCtx context.Context, req *types.QueryClientStorageRequest) (*types.QueryClientStorageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := q.GetClientState(ctx, req.ClientId); !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrap(types.ErrClientNotFound, req.ClientId).Error(),
+		)
+	}
+
+	consensusStates, totalKeys, totalBytes := q.GetClientStorage(ctx, req.ClientId)
+
+	return &types.QueryClientStorageResponse{
+		ConsensusStates: consensusStates,
+		TotalKeys:       totalKeys,
+		TotalBytes:      totalBytes,
+		RetentionPolicy: q.GetRetentionPolicy(ctx, req.ClientId),
+	}, nil
+}
+
 // ClientParams implements the Query/ClientParams gRPC method
 func (q *queryServer) ClientParams(goCtx context.Context, _ *types.QueryClientParamsRequest) (*types.QueryClientParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -289,6 +317,40 @@ func (q *queryServer) ClientParams(goCtx context.Context, _ *types.QueryClientPa
 	}, nil
 }
 
+// ClientMisbehaviours implements the Query/ClientMisbehaviours gRPC method
+func (q *queryServer) ClientMisbehaviours(goCtx context.Context, req *types.QueryClientMisbehavioursRequest) (*types.QueryClientMisbehavioursResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var misbehaviours []types.ClientMisbehaviour
+	store := prefix.NewStore(runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx)), types.ClientMisbehaviourPrefixKey(req.ClientId))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var misbehaviour types.ClientMisbehaviour
+		if err := q.cdc.Unmarshal(value, &misbehaviour); err != nil {
+			return err
+		}
+
+		misbehaviours = append(misbehaviours, misbehaviour)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryClientMisbehavioursResponse{
+		Misbehaviours: misbehaviours,
+		Pagination:    pageRes,
+	}, nil
+}
+
 // UpgradedClientState implements the Query/UpgradedClientState gRPC method
 func (q *queryServer) UpgradedClientState(goCtx context.Context, req *types.QueryUpgradedClientStateRequest) (*types.QueryUpgradedClientStateResponse, error) {
 	if req == nil {
@@ -366,14 +428,8 @@ func (q *queryServer) VerifyMembership(goCtx context.Context, req *types.QueryVe
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	clientType, _, err := types.ParseClientIdentifier(req.ClientId)
-	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
-
-	denyClients := []string{exported.Localhost, exported.Solomachine}
-	if slices.Contains(denyClients, clientType) {
-		return nil, status.Error(codes.InvalidArgument, errorsmod.Wrapf(types.ErrInvalidClientType, "verify membership is disabled for client types %s", denyClients).Error())
+	if err := validateProofVerificationClient(req.ClientId, "verify membership"); err != nil {
+		return nil, err
 	}
 
 	if len(req.Proof) == 0 {
@@ -429,3 +485,168 @@ func (q *queryServer) VerifyMembership(goCtx context.Context, req *types.QueryVe
 		Success: true,
 	}, nil
 }
+
+// VerifyNonMembership implements the Query/VerifyNonMembership gRPC method
+// NOTE: Any state changes made within this handler are discarded by leveraging a cached context. Gas is consumed for underlying state access.
+// This gRPC method is intended to be used within the context of the state machine and delegates to light clients to verify proofs.
+func (q *queryServer) VerifyNonMembership(goCtx context.Context, req *types.QueryVerifyNonMembershipRequest) (*types.QueryVerifyNonMembershipResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := validateProofVerificationClient(req.ClientId, "verify non membership"); err != nil {
+		return nil, err
+	}
+
+	if len(req.Proof) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty proof")
+	}
+
+	if req.ProofHeight.IsZero() {
+		return nil, status.Error(codes.InvalidArgument, "proof height must be non-zero")
+	}
+
+	if req.MerklePath.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "empty merkle path")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// cache the context to ensure clientState.VerifyNonMembership does not change state
+	cachedCtx, _ := ctx.CacheContext()
+
+	// make sure we charge the higher level context even on panic
+	defer func() {
+		ctx.GasMeter().ConsumeGas(cachedCtx.GasMeter().GasConsumed(), "verify non membership query")
+	}()
+
+	clientModule, err := q.Route(ctx, req.ClientId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if clientStatus := q.GetClientStatus(ctx, req.ClientId); clientStatus != exported.Active {
+		return nil, status.Error(codes.FailedPrecondition, errorsmod.Wrapf(types.ErrClientNotActive, "cannot verify non membership using client (%s) with status %s", req.ClientId, clientStatus).Error())
+	}
+
+	// consume flat gas fee for proof verification queries.
+	// NOTE: consuming gas prior to method invocation also provides protection against recursive calls reaching stack overflow
+	ctx.GasMeter().ConsumeGas(
+		3*ctx.KVGasConfig().ReadCostPerByte*uint64(len(req.Proof)),
+		"verify non membership query",
+	)
+
+	if err := clientModule.VerifyNonMembership(cachedCtx, req.ClientId, req.ProofHeight, req.TimeDelay, req.BlockDelay, req.Proof, req.MerklePath); err != nil {
+		q.Logger(ctx).Debug("proof verification failed", "key", req.MerklePath, "error", err)
+		return &types.QueryVerifyNonMembershipResponse{
+			Success: false,
+		}, nil
+	}
+
+	return &types.QueryVerifyNonMembershipResponse{
+		Success: true,
+	}, nil
+}
+
+// VerifyMembershipBatch implements the Query/VerifyMembershipBatch gRPC method
+// NOTE: Any state changes made within this handler are discarded by leveraging a cached context. Gas is consumed for underlying state access.
+// This gRPC method is intended to be used within the context of the state machine and delegates to light clients to verify proofs.
+// A flat gas fee is charged for each proof of the batch, and the result of the verification of each proof is returned in the order of the request.
+func (q *queryServer) VerifyMembershipBatch(goCtx context.Context, req *types.QueryVerifyMembershipBatchRequest) (*types.QueryVerifyMembershipBatchResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := validateProofVerificationClient(req.ClientId, "verify membership batch"); err != nil {
+		return nil, err
+	}
+
+	if req.ProofHeight.IsZero() {
+		return nil, status.Error(codes.InvalidArgument, "proof height must be non-zero")
+	}
+
+	if len(req.Proofs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty proofs")
+	}
+
+	for i, proof := range req.Proofs {
+		if len(proof.Proof) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "empty proof at index %d", i)
+		}
+
+		if proof.MerklePath.Empty() {
+			return nil, status.Errorf(codes.InvalidArgument, "empty merkle path at index %d", i)
+		}
+
+		if proof.NonMembership && len(proof.Value) != 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "non-membership proof with a value at index %d", i)
+		}
+
+		if !proof.NonMembership && len(proof.Value) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "empty value at index %d", i)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// cache the context to ensure light client proof verification does not change state
+	cachedCtx, _ := ctx.CacheContext()
+
+	// make sure we charge the higher level context even on panic
+	defer func() {
+		ctx.GasMeter().ConsumeGas(cachedCtx.GasMeter().GasConsumed(), "verify membership batch query")
+	}()
+
+	clientModule, err := q.Route(ctx, req.ClientId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if clientStatus := q.GetClientStatus(ctx, req.ClientId); clientStatus != exported.Active {
+		return nil, status.Error(codes.FailedPrecondition, errorsmod.Wrapf(types.ErrClientNotActive, "cannot verify membership batch using client (%s) with status %s", req.ClientId, clientStatus).Error())
+	}
+
+	results := make([]bool, len(req.Proofs))
+	for i, proof := range req.Proofs {
+		// consume flat gas fee for each proof of the batch.
+		// NOTE: consuming gas prior to method invocation also provides protection against recursive calls reaching stack overflow
+		ctx.GasMeter().ConsumeGas(
+			3*ctx.KVGasConfig().ReadCostPerByte*uint64(len(proof.Proof)),
+			"verify membership batch query",
+		)
+
+		if err := verifyMembershipProof(cachedCtx, clientModule, req.ClientId, req.ProofHeight, req.TimeDelay, req.BlockDelay, proof); err != nil {
+			q.Logger(ctx).Debug("proof verification failed", "index", i, "key", proof.MerklePath, "error", err)
+			continue
+		}
+
+		results[i] = true
+	}
+
+	return &types.QueryVerifyMembershipBatchResponse{
+		Results: results,
+	}, nil
+}
+
+// validateProofVerificationClient returns an error if proof verification queries are disabled for the type of the given client.
+func validateProofVerificationClient(clientID, method string) error {
+	clientType, _, err := types.ParseClientIdentifier(clientID)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	denyClients := []string{exported.Localhost, exported.Solomachine}
+	if slices.Contains(denyClients, clientType) {
+		return status.Error(codes.InvalidArgument, errorsmod.Wrapf(types.ErrInvalidClientType, "%s is disabled for client types %s", method, denyClients).Error())
+	}
+
+	return nil
+}
@@ -17,6 +17,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v10/modules/core/exported"
 	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
@@ -340,7 +341,7 @@ func (k *Keeper) VerifyMembership(ctx sdk.Context, clientID string, height expor
 		return err
 	}
 
-	if status := clientModule.Status(ctx, clientID); status != exported.Active {
+	if status := k.clientStatus(ctx, clientModule, clientID); status != exported.Active {
 		return errorsmod.Wrapf(types.ErrClientNotActive, "cannot call verify membership on client (%s) with status %s", clientID, status)
 	}
 
@@ -354,13 +355,79 @@ func (k *Keeper) VerifyNonMembership(ctx sdk.Context, clientID string, height ex
 		return err
 	}
 
-	if status := clientModule.Status(ctx, clientID); status != exported.Active {
+	if status := k.clientStatus(ctx, clientModule, clientID); status != exported.Active {
 		return errorsmod.Wrapf(types.ErrClientNotActive, "cannot call verify non membership on client (%s) with status %s", clientID, status)
 	}
 
 	return clientModule.VerifyNonMembership(ctx, clientID, height, delayTimePeriod, delayBlockPeriod, proof, path)
 }
 
+// VerifyMembershipBatch retrieves the light client module for the clientID and verifies a batch of proofs at a specified height.
+// Proofs with the non-membership flag set are verified as proofs of the absence of the key path. A flat gas fee is charged for
+// each proof of the batch and an error is returned for the first proof which fails verification.
+func (k *Keeper) VerifyMembershipBatch(ctx sdk.Context, clientID string, height exported.Height, delayTimePeriod uint64, delayBlockPeriod uint64, proofs []types.MembershipProof) error {
+	clientModule, err := k.Route(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	if status := k.clientStatus(ctx, clientModule, clientID); status != exported.Active {
+		return errorsmod.Wrapf(types.ErrClientNotActive, "cannot call verify membership batch on client (%s) with status %s", clientID, status)
+	}
+
+	for i, proof := range proofs {
+		// consume flat gas fee for each proof of the batch, as charged by the proof verification queries.
+		ctx.GasMeter().ConsumeGas(
+			3*ctx.KVGasConfig().ReadCostPerByte*uint64(len(proof.Proof)),
+			"verify membership batch",
+		)
+
+		if err := verifyMembershipProof(ctx, clientModule, clientID, height, delayTimePeriod, delayBlockPeriod, proof); err != nil {
+			return errorsmod.Wrapf(err, "failed to verify proof at index %d", i)
+		}
+	}
+
+	return nil
+}
+
+// VerifyMultiProof retrieves the light client module for the clientID and verifies a single proof of the existence of each value
+// at the corresponding path, or of the absence of each path of which the value is empty, at a specified height. The light client
+// module must implement the exported.MultiProofVerifier interface.
+func (k *Keeper) VerifyMultiProof(ctx sdk.Context, clientID string, height exported.Height, delayTimePeriod uint64, delayBlockPeriod uint64, proof []byte, paths []exported.Path, values [][]byte) error {
+	clientModule, err := k.Route(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	verifier, ok := clientModule.(exported.MultiProofVerifier)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrClientTypeNotSupported, "light client module of client (%s) does not support multi-proof verification", clientID)
+	}
+
+	if status := k.clientStatus(ctx, clientModule, clientID); status != exported.Active {
+		return errorsmod.Wrapf(types.ErrClientNotActive, "cannot call verify multi proof on client (%s) with status %s", clientID, status)
+	}
+
+	return verifier.VerifyMultiProof(ctx, clientID, height, delayTimePeriod, delayBlockPeriod, proof, paths, values)
+}
+
+// verifyMembershipProof verifies a single proof of a batch using the given light client module.
+func verifyMembershipProof(ctx sdk.Context, clientModule exported.LightClientModule, clientID string, height exported.Height, delayTimePeriod uint64, delayBlockPeriod uint64, proof types.MembershipProof) error {
+	if proof.NonMembership {
+		if len(proof.Value) != 0 {
+			return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "non-membership proof must not have a value")
+		}
+
+		return clientModule.VerifyNonMembership(ctx, clientID, height, delayTimePeriod, delayBlockPeriod, proof.Proof, proof.MerklePath)
+	}
+
+	if len(proof.Value) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "membership proof must have a value")
+	}
+
+	return clientModule.VerifyMembership(ctx, clientID, height, delayTimePeriod, delayBlockPeriod, proof.Proof, proof.MerklePath, proof.Value)
+}
+
 // GetUpgradePlan executes the upgrade keeper GetUpgradePlan function.
 func (k *Keeper) GetUpgradePlan(ctx sdk.Context) (upgradetypes.Plan, error) {
 	return k.upgradeKeeper.GetUpgradePlan(ctx)
@@ -432,7 +499,22 @@ func (k *Keeper) GetClientStatus(ctx sdk.Context, clientID string) exported.Stat
 		return exported.Unauthorized
 	}
 
-	return clientModule.Status(ctx, clientID)
+	return k.clientStatus(ctx, clientModule, clientID)
+}
+
+// clientStatus returns the status of a client reported by its light client module. Clients frozen by the authority
+// are Frozen and active clients which have not been updated within their liveness period are Inactive.
+func (k *Keeper) clientStatus(ctx sdk.Context, clientModule exported.LightClientModule, clientID string) exported.Status {
+	if k.ClientStore(ctx, clientID).Has(types.FrozenByAuthorityKey()) {
+		return exported.Frozen
+	}
+
+	status := clientModule.Status(ctx, clientID)
+	if status == exported.Active && !k.isLive(ctx, clientID) {
+		return exported.Inactive
+	}
+
+	return status
 }
 
 // GetClientLatestHeight returns the latest height of a client state for a given client identifier. If the client type is not in the allowed
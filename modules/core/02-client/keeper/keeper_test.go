@@ -602,6 +602,115 @@ func (suite *KeeperTestSuite) TestVerifyNonMembership() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestVerifyMembershipBatch() {
+	var (
+		path   *ibctesting.Path
+		proofs []types.MembershipProof
+		value  []byte
+	)
+
+	cases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"invalid client id",
+			func() {
+				path.EndpointA.ClientID = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"failure: client is frozen",
+			func() {
+				clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+				clientState.FrozenHeight = types.NewHeight(0, 1)
+				path.EndpointA.SetClientState(clientState)
+			},
+			types.ErrClientNotActive,
+		},
+		{
+			"failure: membership proof verified as non-membership proof",
+			func() {
+				proofs[0].Value = nil
+				proofs[0].NonMembership = true
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: membership proof without a value",
+			func() {
+				proofs[0].Value = nil
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: non-membership proof with a value",
+			func() {
+				proofs[1].Value = value
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			// create a membership proof of the client state and a non-membership proof of an unknown client state
+			key := host.FullClientStateKey(path.EndpointB.ClientID)
+			merklePrefixPath, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath(key))
+			suite.Require().NoError(err)
+
+			proof, proofHeight := suite.chainB.QueryProof(key)
+
+			clientState, ok := path.EndpointB.GetClientState().(*ibctm.ClientState)
+			suite.Require().True(ok)
+			value, err = suite.chainB.Codec.MarshalInterface(clientState)
+			suite.Require().NoError(err)
+
+			absentKey := host.FullClientStateKey("invalid-client-id")
+			absentMerklePrefixPath, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath(absentKey))
+			suite.Require().NoError(err)
+
+			absenceProof, absenceProofHeight := suite.chainB.QueryProof(absentKey)
+			suite.Require().Equal(proofHeight, absenceProofHeight)
+
+			proofs = []types.MembershipProof{
+				{Proof: proof, MerklePath: merklePrefixPath, Value: value},
+				{Proof: absenceProof, MerklePath: absentMerklePrefixPath, NonMembership: true},
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			initialGas := ctx.GasMeter().GasConsumed()
+			err = suite.chainA.App.GetIBCKeeper().ClientKeeper.VerifyMembershipBatch(ctx, path.EndpointA.ClientID, proofHeight, 0, 0, proofs)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+
+				// a flat gas fee is charged for each proof of the batch
+				proofGas := 3 * ctx.KVGasConfig().ReadCostPerByte * uint64(len(proof)+len(absenceProof))
+				suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed()-initialGas, proofGas)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
 // TestDefaultSetParams tests the default params set are what is expected
 func (suite *KeeperTestSuite) TestDefaultSetParams() {
 	expParams := types.DefaultParams()
@@ -779,3 +888,89 @@ func (suite *KeeperTestSuite) TestIBCSoftwareUpgrade() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestVerifyMultiProof() {
+	var (
+		path     *ibctesting.Path
+		clientID string
+		paths    []exported.Path
+		values   [][]byte
+	)
+
+	cases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: client is frozen",
+			func() {
+				clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+				clientState.FrozenHeight = types.NewHeight(0, 1)
+				path.EndpointA.SetClientState(clientState)
+			},
+			types.ErrClientNotActive,
+		},
+		{
+			"failure: client type does not support multi-proofs",
+			func() {
+				clientID = exported.LocalhostClientID
+			},
+			types.ErrClientTypeNotSupported,
+		},
+		{
+			"failure: membership proven as non-membership",
+			func() {
+				values[0] = nil
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			clientID = path.EndpointA.ClientID
+
+			// create a multi-proof of the client state and of the absence of an unknown client state
+			key := host.FullClientStateKey(path.EndpointB.ClientID)
+			merklePrefixPath, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath(key))
+			suite.Require().NoError(err)
+
+			clientState, ok := path.EndpointB.GetClientState().(*ibctm.ClientState)
+			suite.Require().True(ok)
+			value, err := suite.chainB.Codec.MarshalInterface(clientState)
+			suite.Require().NoError(err)
+
+			absentKey := host.FullClientStateKey("invalid-client-id")
+			absentMerklePrefixPath, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath(absentKey))
+			suite.Require().NoError(err)
+
+			proof, proofHeight := path.EndpointB.QueryMultiProof(key, absentKey)
+
+			paths = []exported.Path{merklePrefixPath, absentMerklePrefixPath}
+			values = [][]byte{value, nil}
+
+			tc.malleate()
+
+			err = suite.chainA.App.GetIBCKeeper().ClientKeeper.VerifyMultiProof(suite.chainA.GetContext(), clientID, proofHeight, 0, 0, proof, paths, values)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
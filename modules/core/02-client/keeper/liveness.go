@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+)
+
+// GetLivenessPeriod returns the liveness period of a client. A zero liveness period is returned if none is set.
+func (k *Keeper) GetLivenessPeriod(ctx sdk.Context, clientID string) time.Duration {
+	store := k.ClientStore(ctx, clientID)
+	bz := store.Get(types.LivenessPeriodKey())
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return time.Duration(sdk.BigEndianToUint64(bz))
+}
+
+// SetLivenessPeriod sets the liveness period of a client. A client which has not been updated within its
+// liveness period is Inactive. If no update of the client has been recorded, the current block time is
+// recorded as the time of the last update. A zero liveness period removes any existing liveness period.
+func (k *Keeper) SetLivenessPeriod(ctx sdk.Context, clientID string, livenessPeriod time.Duration) {
+	store := k.ClientStore(ctx, clientID)
+	if livenessPeriod == 0 {
+		store.Delete(types.LivenessPeriodKey())
+		return
+	}
+
+	store.Set(types.LivenessPeriodKey(), sdk.Uint64ToBigEndian(uint64(livenessPeriod)))
+
+	if !store.Has(types.LastUpdateTimeKey()) {
+		k.setLastUpdateTime(ctx, clientID)
+	}
+}
+
+// GetLastUpdateTime returns the block time at which the client was last created, updated to a greater height, upgraded
+// or recovered.
+func (k *Keeper) GetLastUpdateTime(ctx sdk.Context, clientID string) (time.Time, bool) {
+	store := k.ClientStore(ctx, clientID)
+	bz := store.Get(types.LastUpdateTimeKey())
+	if len(bz) == 0 {
+		return time.Time{}, false
+	}
+
+	return time.Unix(0, int64(sdk.BigEndianToUint64(bz))).UTC(), true
+}
+
+// setLastUpdateTime records the current block time as the time of the last update of the client.
+func (k *Keeper) setLastUpdateTime(ctx sdk.Context, clientID string) {
+	store := k.ClientStore(ctx, clientID)
+	store.Set(types.LastUpdateTimeKey(), sdk.Uint64ToBigEndian(uint64(ctx.BlockTime().UnixNano())))
+}
+
+// isLive returns false if the client has a liveness period and has not been updated within it.
+func (k *Keeper) isLive(ctx sdk.Context, clientID string) bool {
+	livenessPeriod := k.GetLivenessPeriod(ctx, clientID)
+	if livenessPeriod == 0 {
+		return true
+	}
+
+	lastUpdateTime, found := k.GetLastUpdateTime(ctx, clientID)
+	if !found {
+		return true
+	}
+
+	return !ctx.BlockTime().After(lastUpdateTime.Add(livenessPeriod))
+}
@@ -0,0 +1,153 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+func (suite *KeeperTestSuite) TestSetLivenessPeriod() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+
+	ctx := suite.chainA.GetContext()
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+	clientStore := clientKeeper.ClientStore(ctx, path.EndpointA.ClientID)
+
+	suite.Require().Zero(clientKeeper.GetLivenessPeriod(ctx, path.EndpointA.ClientID))
+
+	clientKeeper.SetLivenessPeriod(ctx, path.EndpointA.ClientID, time.Hour)
+	suite.Require().Equal(time.Hour, clientKeeper.GetLivenessPeriod(ctx, path.EndpointA.ClientID))
+
+	// the liveness period is exported as client metadata
+	genMetadata, err := clientKeeper.GetAllClientMetadata(ctx, clientKeeper.GetAllGenesisClients(ctx))
+	suite.Require().NoError(err)
+	suite.Require().Contains(genMetadata[0].ClientMetadata, types.NewGenesisMetadata(types.LivenessPeriodKey(), sdk.Uint64ToBigEndian(uint64(time.Hour))))
+
+	// a zero liveness period removes the liveness period
+	clientKeeper.SetLivenessPeriod(ctx, path.EndpointA.ClientID, 0)
+	suite.Require().Zero(clientKeeper.GetLivenessPeriod(ctx, path.EndpointA.ClientID))
+	suite.Require().False(clientStore.Has(types.LivenessPeriodKey()))
+}
+
+func (suite *KeeperTestSuite) TestLastUpdateTime() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+
+	// the creation of the client is recorded as an update
+	lastUpdateTime, found := clientKeeper.GetLastUpdateTime(suite.chainA.GetContext(), path.EndpointA.ClientID)
+	suite.Require().True(found)
+	suite.Require().False(lastUpdateTime.IsZero())
+
+	trustedHeight, ok := path.EndpointA.GetClientLatestHeight().(types.Height)
+	suite.Require().True(ok)
+
+	suite.coordinator.IncrementTimeBy(time.Minute)
+	err := path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	updateTime, found := clientKeeper.GetLastUpdateTime(suite.chainA.GetContext(), path.EndpointA.ClientID)
+	suite.Require().True(found)
+	suite.Require().True(updateTime.After(lastUpdateTime))
+
+	// resubmitting a header the client has already been updated with does not record an update
+	header, err := suite.chainB.IBCClientHeader(suite.chainB.LatestCommittedHeader, trustedHeight)
+	suite.Require().NoError(err)
+	suite.Require().Equal(path.EndpointA.GetClientLatestHeight(), header.GetHeight())
+
+	msg, err := types.NewMsgUpdateClient(path.EndpointA.ClientID, header, suite.chainA.SenderAccount.GetAddress().String())
+	suite.Require().NoError(err)
+
+	suite.coordinator.IncrementTimeBy(time.Minute)
+	_, err = suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	resubmitTime, found := clientKeeper.GetLastUpdateTime(suite.chainA.GetContext(), path.EndpointA.ClientID)
+	suite.Require().True(found)
+	suite.Require().Equal(updateTime, resubmitTime)
+
+	// if no update was recorded, setting the liveness period records the current block time
+	ctx := suite.chainA.GetContext()
+	clientKeeper.ClientStore(ctx, path.EndpointA.ClientID).Delete(types.LastUpdateTimeKey())
+	clientKeeper.SetLivenessPeriod(ctx, path.EndpointA.ClientID, time.Hour)
+
+	lastUpdateTime, found = clientKeeper.GetLastUpdateTime(ctx, path.EndpointA.ClientID)
+	suite.Require().True(found)
+	suite.Require().Equal(ctx.BlockTime().UTC(), lastUpdateTime)
+}
+
+func (suite *KeeperTestSuite) TestLivenessPeriodClientStatus() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expStatus exported.Status
+	}{
+		{
+			"active: updated within liveness period",
+			func() {
+				suite.coordinator.IncrementTimeBy(time.Minute)
+			},
+			exported.Active,
+		},
+		{
+			"active: liveness period not set",
+			func() {
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetLivenessPeriod(suite.chainA.GetContext(), path.EndpointA.ClientID, 0)
+				suite.coordinator.IncrementTimeBy(2 * time.Hour)
+			},
+			exported.Active,
+		},
+		{
+			"active: updated after becoming inactive",
+			func() {
+				suite.coordinator.IncrementTimeBy(2 * time.Hour)
+				suite.Require().Equal(exported.Inactive, suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientStatus(suite.chainA.GetContext(), path.EndpointA.ClientID))
+
+				err := path.EndpointA.UpdateClient()
+				suite.Require().NoError(err)
+			},
+			exported.Active,
+		},
+		{
+			"inactive: not updated within liveness period",
+			func() {
+				suite.coordinator.IncrementTimeBy(2 * time.Hour)
+			},
+			exported.Inactive,
+		},
+		{
+			"frozen: frozen clients are not reported as inactive",
+			func() {
+				suite.coordinator.IncrementTimeBy(2 * time.Hour)
+
+				err := suite.chainA.App.GetIBCKeeper().ClientKeeper.FreezeClient(suite.chainA.GetContext(), path.EndpointA.ClientID)
+				suite.Require().NoError(err)
+			},
+			exported.Frozen,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetLivenessPeriod(suite.chainA.GetContext(), path.EndpointA.ClientID, time.Hour)
+
+			tc.malleate()
+
+			status := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientStatus(suite.chainA.GetContext(), path.EndpointA.ClientID)
+			suite.Require().Equal(tc.expStatus, status)
+		})
+	}
+}
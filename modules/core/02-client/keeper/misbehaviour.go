@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+// GetClientMisbehaviour returns the misbehaviour evidence of a client with the given sequence.
+func (k *Keeper) GetClientMisbehaviour(ctx sdk.Context, clientID string, sequence uint64) (types.ClientMisbehaviour, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.ClientMisbehaviourKey(clientID, sequence))
+	if len(bz) == 0 {
+		return types.ClientMisbehaviour{}, false
+	}
+
+	var misbehaviour types.ClientMisbehaviour
+	k.cdc.MustUnmarshal(bz, &misbehaviour)
+	return misbehaviour, true
+}
+
+// SetClientMisbehaviour stores the misbehaviour evidence of a client under its sequence.
+func (k *Keeper) SetClientMisbehaviour(ctx sdk.Context, misbehaviour types.ClientMisbehaviour) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.ClientMisbehaviourKey(misbehaviour.ClientId, misbehaviour.Sequence), k.cdc.MustMarshal(&misbehaviour))
+}
+
+// GetAllClientMisbehaviours returns the misbehaviour evidence of all clients, ordered by client identifier
+// and sequence.
+func (k *Keeper) GetAllClientMisbehaviours(ctx sdk.Context) []types.ClientMisbehaviour {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.KeyClientMisbehaviourPrefix+"/"))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	misbehaviours := make([]types.ClientMisbehaviour, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var misbehaviour types.ClientMisbehaviour
+		k.cdc.MustUnmarshal(iterator.Value(), &misbehaviour)
+		misbehaviours = append(misbehaviours, misbehaviour)
+	}
+
+	return misbehaviours
+}
+
+// getNextClientMisbehaviourSequence returns the sequence under which the next misbehaviour evidence of a
+// client is stored, which is one greater than the sequence of the most recently stored evidence.
+func (k *Keeper) getNextClientMisbehaviourSequence(ctx sdk.Context, clientID string) uint64 {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStoreReversePrefixIterator(store, types.ClientMisbehaviourPrefixKey(clientID))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	if !iterator.Valid() {
+		return 0
+	}
+
+	var misbehaviour types.ClientMisbehaviour
+	k.cdc.MustUnmarshal(iterator.Value(), &misbehaviour)
+	return misbehaviour.Sequence + 1
+}
+
+// recordMisbehaviour stores the misbehaviour evidence of a client submitted by the given submitter. The heights,
+// hashes and timestamps of the conflicting headers are recorded if the client message implements the
+// exported.MisbehaviourEvidence interface, the client message itself is not stored. At most
+// types.MaxClientMisbehaviours evidence entries are kept per client, the oldest entry is deleted once the
+// maximum is exceeded.
+func (k *Keeper) recordMisbehaviour(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage, submitter string) {
+	misbehaviour := types.ClientMisbehaviour{
+		ClientId:    clientID,
+		Sequence:    k.getNextClientMisbehaviourSequence(ctx, clientID),
+		Submitter:   submitter,
+		BlockHeight: uint64(ctx.BlockHeight()),
+	}
+
+	if evidence, ok := clientMsg.(exported.MisbehaviourEvidence); ok {
+		heights, hashes, timestamps := evidence.ConflictingHeaders()
+		for _, height := range heights {
+			misbehaviour.Heights = append(misbehaviour.Heights, types.NewHeight(height.GetRevisionNumber(), height.GetRevisionHeight()))
+		}
+		misbehaviour.HeaderHashes = hashes
+		misbehaviour.HeaderTimestamps = timestamps
+	}
+
+	k.SetClientMisbehaviour(ctx, misbehaviour)
+
+	// sequences are assigned contiguously, the evidence stored types.MaxClientMisbehaviours sequences
+	// before the newly stored evidence is therefore the one which exceeds the maximum
+	if misbehaviour.Sequence >= types.MaxClientMisbehaviours {
+		store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+		store.Delete(types.ClientMisbehaviourKey(clientID, misbehaviour.Sequence-types.MaxClientMisbehaviours))
+	}
+}
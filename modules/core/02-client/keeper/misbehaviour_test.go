@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+func (suite *KeeperTestSuite) TestRecordMisbehaviour() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+
+	trustedHeight, ok := path.EndpointA.GetClientLatestHeight().(clienttypes.Height)
+	suite.Require().True(ok)
+
+	trustedVals, ok := suite.chainB.TrustedValidators[trustedHeight.RevisionHeight]
+	suite.Require().True(ok)
+
+	err := path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	height, ok := path.EndpointA.GetClientLatestHeight().(clienttypes.Height)
+	suite.Require().True(ok)
+
+	misbehaviour := &ibctm.Misbehaviour{
+		Header1: suite.chainB.CreateTMClientHeader(suite.chainB.ChainID, int64(height.RevisionHeight), trustedHeight, suite.chainB.ProposedHeader.Time.Add(time.Minute), suite.chainB.Vals, suite.chainB.NextVals, trustedVals, suite.chainB.Signers),
+		Header2: suite.chainB.CreateTMClientHeader(suite.chainB.ChainID, int64(height.RevisionHeight), trustedHeight, suite.chainB.ProposedHeader.Time, suite.chainB.Vals, suite.chainB.NextVals, trustedVals, suite.chainB.Signers),
+	}
+
+	ctx := suite.chainA.GetContext()
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+	submitter := suite.chainA.SenderAccount.GetAddress().String()
+
+	err = clientKeeper.UpdateClientWithSubmitter(ctx, path.EndpointA.ClientID, misbehaviour, submitter)
+	suite.Require().NoError(err)
+	suite.Require().Equal(exported.Frozen, clientKeeper.GetClientStatus(ctx, path.EndpointA.ClientID))
+
+	evidence, found := clientKeeper.GetClientMisbehaviour(ctx, path.EndpointA.ClientID, 0)
+	suite.Require().True(found)
+	suite.Require().Equal(path.EndpointA.ClientID, evidence.ClientId)
+	suite.Require().Equal(uint64(0), evidence.Sequence)
+	suite.Require().Equal([]clienttypes.Height{height, height}, evidence.Heights)
+	suite.Require().Equal([][]byte{misbehaviour.Header1.Commit.BlockID.Hash, misbehaviour.Header2.Commit.BlockID.Hash}, evidence.HeaderHashes)
+	suite.Require().Equal([]uint64{uint64(misbehaviour.Header1.GetTime().UnixNano()), uint64(misbehaviour.Header2.GetTime().UnixNano())}, evidence.HeaderTimestamps)
+	suite.Require().Equal(submitter, evidence.Submitter)
+	suite.Require().Equal(uint64(ctx.BlockHeight()), evidence.BlockHeight)
+
+	// unfreeze resets the frozen height of the client so that the misbehaviour can be submitted again
+	unfreeze := func() {
+		clientState, ok := clientKeeper.GetClientState(ctx, path.EndpointA.ClientID)
+		suite.Require().True(ok)
+		tmClientState, ok := clientState.(*ibctm.ClientState)
+		suite.Require().True(ok)
+		tmClientState.FrozenHeight = clienttypes.ZeroHeight()
+		clientKeeper.SetClientState(ctx, path.EndpointA.ClientID, tmClientState)
+	}
+
+	// subsequent evidence of the same client is stored under the next sequence
+	unfreeze()
+	err = clientKeeper.UpdateClientWithSubmitter(ctx, path.EndpointA.ClientID, misbehaviour, submitter)
+	suite.Require().NoError(err)
+
+	_, found = clientKeeper.GetClientMisbehaviour(ctx, path.EndpointA.ClientID, 1)
+	suite.Require().True(found)
+	suite.Require().Len(clientKeeper.GetAllClientMisbehaviours(ctx), 2)
+
+	// the oldest evidence is deleted once the maximum number of evidence entries of the client is exceeded
+	for range clienttypes.MaxClientMisbehaviours - 1 {
+		unfreeze()
+		err = clientKeeper.UpdateClientWithSubmitter(ctx, path.EndpointA.ClientID, misbehaviour, submitter)
+		suite.Require().NoError(err)
+	}
+
+	_, found = clientKeeper.GetClientMisbehaviour(ctx, path.EndpointA.ClientID, 0)
+	suite.Require().False(found)
+	_, found = clientKeeper.GetClientMisbehaviour(ctx, path.EndpointA.ClientID, 1)
+	suite.Require().True(found)
+	_, found = clientKeeper.GetClientMisbehaviour(ctx, path.EndpointA.ClientID, clienttypes.MaxClientMisbehaviours)
+	suite.Require().True(found)
+	suite.Require().Len(clientKeeper.GetAllClientMisbehaviours(ctx), int(clienttypes.MaxClientMisbehaviours))
+}
@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"sort"
+	"strings"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+// GetRetentionPolicy returns the retention policy of the consensus states of a client. An empty retention
+// policy is returned if none is set.
+func (k *Keeper) GetRetentionPolicy(ctx sdk.Context, clientID string) types.RetentionPolicy {
+	store := k.ClientStore(ctx, clientID)
+	bz := store.Get(types.RetentionPolicyKey())
+	if len(bz) == 0 {
+		return types.RetentionPolicy{}
+	}
+
+	var policy types.RetentionPolicy
+	k.cdc.MustUnmarshal(bz, &policy)
+	return policy
+}
+
+// SetRetentionPolicy sets the retention policy of the consensus states of a client and indexes the client
+// so that its consensus states are pruned at the end of every block. An empty retention policy removes
+// any existing retention policy.
+func (k *Keeper) SetRetentionPolicy(ctx sdk.Context, clientID string, policy types.RetentionPolicy) {
+	clientStore := k.ClientStore(ctx, clientID)
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	if policy.IsEmpty() {
+		clientStore.Delete(types.RetentionPolicyKey())
+		store.Delete(types.RetentionPolicyIndexKey(clientID))
+		return
+	}
+
+	clientStore.Set(types.RetentionPolicyKey(), k.cdc.MustMarshal(&policy))
+	store.Set(types.RetentionPolicyIndexKey(clientID), []byte{1})
+}
+
+// getRetentionPolicyClientIDs returns the identifiers of all clients with a retention policy in
+// ascending order.
+func (k *Keeper) getRetentionPolicyClientIDs(ctx sdk.Context) []string {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.KeyRetentionPolicyIndexPrefix+"/"))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var clientIDs []string
+	for ; iterator.Valid(); iterator.Next() {
+		clientIDs = append(clientIDs, strings.TrimPrefix(string(iterator.Key()), types.KeyRetentionPolicyIndexPrefix+"/"))
+	}
+
+	return clientIDs
+}
+
+// PruneConsensusStates prunes the consensus states of clients which fall outside of their retention policies.
+// At most MaxPrunedConsensusStatesPerBlock consensus states are pruned per call. Clients are visited in
+// round-robin order, starting from the client that was not visited when the limit was reached in the
+// previous call, such that a single client with a large number of consensus states cannot starve others.
+func (k *Keeper) PruneConsensusStates(ctx sdk.Context) {
+	clientIDs := k.getRetentionPolicyClientIDs(ctx)
+	if len(clientIDs) == 0 {
+		return
+	}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	cursor := string(store.Get([]byte(types.KeyRetentionPolicyCursor)))
+	start := sort.SearchStrings(clientIDs, cursor)
+
+	remaining := types.MaxPrunedConsensusStatesPerBlock
+	for i := range clientIDs {
+		clientID := clientIDs[(start+i)%len(clientIDs)]
+		if remaining == 0 {
+			store.Set([]byte(types.KeyRetentionPolicyCursor), []byte(clientID))
+			return
+		}
+
+		remaining -= k.pruneClientConsensusStates(ctx, clientID, remaining)
+	}
+
+	store.Delete([]byte(types.KeyRetentionPolicyCursor))
+}
+
+// pruneClientConsensusStates prunes at most limit consensus states of the given client according to its
+// retention policy and returns the number of pruned consensus states. Light client modules which do not
+// implement the exported.ConsensusStatePruner interface are skipped. Any error is logged and discards
+// the state changes made by the light client module.
+func (k *Keeper) pruneClientConsensusStates(ctx sdk.Context, clientID string, limit uint64) uint64 {
+	clientModule, err := k.Route(ctx, clientID)
+	if err != nil {
+		return 0
+	}
+
+	pruner, ok := clientModule.(exported.ConsensusStatePruner)
+	if !ok {
+		return 0
+	}
+
+	policy := k.GetRetentionPolicy(ctx, clientID)
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	pruned, err := pruner.PruneConsensusStates(cacheCtx, clientID, policy.MaxConsensusStates, policy.MaxAge, limit)
+	if err != nil {
+		k.Logger(ctx).Error("failed to prune consensus states", "client-id", clientID, "error", err)
+		return 0
+	}
+
+	writeFn()
+
+	pruned = min(pruned, limit)
+	if pruned != 0 {
+		emitPruneConsensusStatesEvent(ctx, clientID, types.MustParseClientIdentifier(clientID), pruned)
+	}
+
+	return pruned
+}
+
+// GetClientStorage returns the number of consensus states, the number of keys and the total size in bytes of
+// the keys and values stored in the client store of the given client.
+func (k *Keeper) GetClientStorage(ctx sdk.Context, clientID string) (consensusStates, totalKeys, totalBytes uint64) {
+	store := k.ClientStore(ctx, clientID)
+	iterator := store.Iterator(nil, nil)
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	consensusStatePrefix := string(host.KeyConsensusStatePrefix) + "/"
+	for ; iterator.Valid(); iterator.Next() {
+		key := string(iterator.Key())
+
+		// consensus state metadata is stored under keys in the format "consensusStates/<height>/<suffix>"
+		if height, found := strings.CutPrefix(key, consensusStatePrefix); found && !strings.Contains(height, "/") {
+			consensusStates++
+		}
+
+		totalKeys++
+		totalBytes += uint64(len(iterator.Key()) + len(iterator.Value()))
+	}
+
+	return consensusStates, totalKeys, totalBytes
+}
@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+func (suite *KeeperTestSuite) TestSetRetentionPolicy() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+
+	ctx := suite.chainA.GetContext()
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+	clientStore := clientKeeper.ClientStore(ctx, path.EndpointA.ClientID)
+
+	suite.Require().Equal(types.RetentionPolicy{}, clientKeeper.GetRetentionPolicy(ctx, path.EndpointA.ClientID))
+
+	policy := types.NewRetentionPolicy(10, time.Hour)
+	clientKeeper.SetRetentionPolicy(ctx, path.EndpointA.ClientID, policy)
+	suite.Require().Equal(policy, clientKeeper.GetRetentionPolicy(ctx, path.EndpointA.ClientID))
+	suite.Require().True(clientStore.Has(types.RetentionPolicyKey()))
+
+	// the retention policy is exported as client metadata
+	genMetadata, err := clientKeeper.GetAllClientMetadata(ctx, clientKeeper.GetAllGenesisClients(ctx))
+	suite.Require().NoError(err)
+	suite.Require().Contains(genMetadata[0].ClientMetadata, types.NewGenesisMetadata(types.RetentionPolicyKey(), suite.chainA.Codec.MustMarshal(&policy)))
+
+	// an empty retention policy removes the retention policy
+	clientKeeper.SetRetentionPolicy(ctx, path.EndpointA.ClientID, types.RetentionPolicy{})
+	suite.Require().Equal(types.RetentionPolicy{}, clientKeeper.GetRetentionPolicy(ctx, path.EndpointA.ClientID))
+	suite.Require().False(clientStore.Has(types.RetentionPolicyKey()))
+}
+
+func (suite *KeeperTestSuite) TestPruneConsensusStates() {
+	// storeConsensusStates stores n consensus states for the given client at heights of revision 0,
+	// which are lower than the heights of the consensus states created by the counterparty chain.
+	storeConsensusStates := func(endpoint *ibctesting.Endpoint, n uint64) {
+		ctx := endpoint.Chain.GetContext()
+		clientKeeper := endpoint.Chain.App.GetIBCKeeper().ClientKeeper
+		clientStore := clientKeeper.ClientStore(ctx, endpoint.ClientID)
+
+		for i := range n {
+			height := types.NewHeight(0, i+1)
+			consensusState := ibctm.NewConsensusState(ctx.BlockTime(), commitmenttypes.NewMerkleRoot([]byte("hash")), suite.valSetHash)
+			clientKeeper.SetClientConsensusState(ctx, endpoint.ClientID, height, consensusState)
+			ibctm.SetIterationKey(clientStore, height)
+		}
+	}
+
+	consensusStates := func(endpoint *ibctesting.Endpoint) uint64 {
+		consensusStates, _, _ := endpoint.Chain.App.GetIBCKeeper().ClientKeeper.GetClientStorage(endpoint.Chain.GetContext(), endpoint.ClientID)
+		return consensusStates
+	}
+
+	pathA := ibctesting.NewPath(suite.chainA, suite.chainB)
+	pathA.SetupClients()
+	pathB := ibctesting.NewPath(suite.chainA, suite.chainB)
+	pathB.SetupClients()
+	pathC := ibctesting.NewPath(suite.chainA, suite.chainB)
+	pathC.SetupClients()
+
+	// clients are visited in the order of their identifiers
+	suite.Require().Less(pathA.EndpointA.ClientID, pathB.EndpointA.ClientID)
+
+	storeConsensusStates(pathA.EndpointA, 150)
+	storeConsensusStates(pathB.EndpointA, 10)
+	storeConsensusStates(pathC.EndpointA, 10)
+
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+	clientKeeper.SetRetentionPolicy(suite.chainA.GetContext(), pathA.EndpointA.ClientID, types.NewRetentionPolicy(1, 0))
+	clientKeeper.SetRetentionPolicy(suite.chainA.GetContext(), pathB.EndpointA.ClientID, types.NewRetentionPolicy(1, 0))
+
+	// the per block limit is reached while pruning the first client
+	ctx := suite.chainA.GetContext().WithEventManager(sdk.NewEventManager())
+	clientKeeper.PruneConsensusStates(ctx)
+
+	suite.Require().Equal(151-types.MaxPrunedConsensusStatesPerBlock, consensusStates(pathA.EndpointA))
+	suite.Require().Equal(uint64(11), consensusStates(pathB.EndpointA))
+	suite.Require().Equal(uint64(11), consensusStates(pathC.EndpointA))
+	suite.assertPruneEvents(ctx, map[string]uint64{pathA.EndpointA.ClientID: types.MaxPrunedConsensusStatesPerBlock})
+
+	// pruning resumes from the second client before returning to the first client
+	ctx = suite.chainA.GetContext().WithEventManager(sdk.NewEventManager())
+	clientKeeper.PruneConsensusStates(ctx)
+
+	suite.Require().Equal(uint64(1), consensusStates(pathA.EndpointA))
+	suite.Require().Equal(uint64(1), consensusStates(pathB.EndpointA))
+	suite.Require().Equal(uint64(11), consensusStates(pathC.EndpointA))
+	suite.assertPruneEvents(ctx, map[string]uint64{pathB.EndpointA.ClientID: 10, pathA.EndpointA.ClientID: 50})
+
+	// the consensus state at the latest height of the client is retained
+	_, found := clientKeeper.GetClientConsensusState(suite.chainA.GetContext(), pathA.EndpointA.ClientID, pathA.EndpointA.GetClientLatestHeight())
+	suite.Require().True(found)
+
+	// nothing is left to prune
+	ctx = suite.chainA.GetContext().WithEventManager(sdk.NewEventManager())
+	clientKeeper.PruneConsensusStates(ctx)
+	suite.assertPruneEvents(ctx, map[string]uint64{})
+}
+
+// assertPruneEvents asserts that the prune consensus states events emitted in the given context match the
+// expected number of pruned consensus states for each client.
+func (suite *KeeperTestSuite) assertPruneEvents(ctx sdk.Context, expPruned map[string]uint64) {
+	pruned := make(map[string]uint64)
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypePruneConsensusStates {
+			continue
+		}
+
+		clientID, found := event.GetAttribute(types.AttributeKeyClientID)
+		suite.Require().True(found)
+		count, found := event.GetAttribute(types.AttributeKeyPrunedCount)
+		suite.Require().True(found)
+
+		n, err := strconv.ParseUint(count.Value, 10, 64)
+		suite.Require().NoError(err)
+		pruned[clientID.Value] = n
+	}
+
+	suite.Require().Equal(expPruned, pruned)
+}
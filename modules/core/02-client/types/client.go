@@ -16,6 +16,10 @@ import (
 	"github.com/cosmos/ibc-go/v10/modules/core/exported"
 )
 
+// MaxClientMisbehaviours is the maximum number of misbehaviour evidence entries kept per client. The oldest
+// evidence of a client is deleted once a new evidence would exceed the maximum.
+const MaxClientMisbehaviours uint64 = 10
+
 var (
 	_ codectypes.UnpackInterfacesMessage = (*IdentifiedClientState)(nil)
 	_ codectypes.UnpackInterfacesMessage = (*ConsensusStateWithHeight)(nil)
@@ -87,6 +91,27 @@ func (cswh ConsensusStateWithHeight) UnpackInterfaces(unpacker codectypes.AnyUnp
 	return unpacker.UnpackAny(cswh.ConsensusState, new(exported.ConsensusState))
 }
 
+// Validate performs a basic validation of the misbehaviour evidence of a client.
+func (cm ClientMisbehaviour) Validate() error {
+	if err := host.ClientIdentifierValidator(cm.ClientId); err != nil {
+		return err
+	}
+
+	if len(cm.Heights) != len(cm.HeaderHashes) {
+		return errorsmod.Wrapf(ErrInvalidMisbehaviour, "number of heights (%d) does not match number of header hashes (%d)", len(cm.Heights), len(cm.HeaderHashes))
+	}
+
+	if len(cm.HeaderTimestamps) != 0 && len(cm.HeaderTimestamps) != len(cm.Heights) {
+		return errorsmod.Wrapf(ErrInvalidMisbehaviour, "number of header timestamps (%d) does not match number of heights (%d)", len(cm.HeaderTimestamps), len(cm.Heights))
+	}
+
+	if strings.TrimSpace(cm.Submitter) == "" {
+		return errorsmod.Wrap(ErrInvalidMisbehaviour, "submitter cannot be empty")
+	}
+
+	return nil
+}
+
 // ValidateClientType validates the client type. It cannot be blank or empty. It must be a valid
 // client identifier when used with '0' or the maximum uint64 as the sequence.
 func ValidateClientType(clientType string) error {
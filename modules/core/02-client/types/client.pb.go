@@ -8,15 +8,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -292,47 +296,221 @@ func (m *Params) GetAllowedClients() []string {
 	return nil
 }
 
+// RetentionPolicy defines which consensus states of a client are retained. Consensus states which
+// fall outside of the policy are pruned in bounded batches at the end of every block. The consensus
+// state at the latest height of the client is always retained. A zero value disables the
+// corresponding limit.
+type RetentionPolicy struct {
+	// maximum number of consensus states retained, the consensus states at the lowest heights are pruned first
+	MaxConsensusStates uint64 `protobuf:"varint,1,opt,name=max_consensus_states,json=maxConsensusStates,proto3" json:"max_consensus_states,omitempty"`
+	// maximum age of a retained consensus state, measured from its timestamp to the current block time
+	MaxAge time.Duration `protobuf:"bytes,2,opt,name=max_age,json=maxAge,proto3,stdduration" json:"max_age"`
+}
+
+func (m *RetentionPolicy) Reset()         { *m = RetentionPolicy{} }
+func (m *RetentionPolicy) String() string { return proto.CompactTextString(m) }
+func (*RetentionPolicy) ProtoMessage()    {}
+func (*RetentionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6bc4c8185546947, []int{5}
+}
+func (m *RetentionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetentionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetentionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetentionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetentionPolicy.Merge(m, src)
+}
+func (m *RetentionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetentionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetentionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetentionPolicy proto.InternalMessageInfo
+
+func (m *RetentionPolicy) GetMaxConsensusStates() uint64 {
+	if m != nil {
+		return m.MaxConsensusStates
+	}
+	return 0
+}
+
+func (m *RetentionPolicy) GetMaxAge() time.Duration {
+	if m != nil {
+		return m.MaxAge
+	}
+	return 0
+}
+
+// ClientMisbehaviour defines the evidence of misbehaviour which was submitted for a client and
+// which caused the client to be frozen. Only the heights, hashes and timestamps of the conflicting
+// headers are stored, not the submitted client message.
+type ClientMisbehaviour struct {
+	// client identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// sequence of the misbehaviour among the misbehaviours submitted for the client
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// heights of the conflicting headers, if reported by the client message
+	Heights []Height `protobuf:"bytes,3,rep,name=heights,proto3" json:"heights"`
+	// hashes of the conflicting headers, if reported by the client message
+	HeaderHashes [][]byte `protobuf:"bytes,4,rep,name=header_hashes,json=headerHashes,proto3" json:"header_hashes,omitempty"`
+	// address of the submitter of the misbehaviour
+	Submitter string `protobuf:"bytes,5,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// height of the block in which the misbehaviour was submitted
+	BlockHeight uint64 `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// timestamps of the conflicting headers in unix nanoseconds, if reported by the client message
+	HeaderTimestamps []uint64 `protobuf:"varint,7,rep,packed,name=header_timestamps,json=headerTimestamps,proto3" json:"header_timestamps,omitempty"`
+}
+
+func (m *ClientMisbehaviour) Reset()         { *m = ClientMisbehaviour{} }
+func (m *ClientMisbehaviour) String() string { return proto.CompactTextString(m) }
+func (*ClientMisbehaviour) ProtoMessage()    {}
+func (*ClientMisbehaviour) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6bc4c8185546947, []int{6}
+}
+func (m *ClientMisbehaviour) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientMisbehaviour) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientMisbehaviour.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClientMisbehaviour) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientMisbehaviour.Merge(m, src)
+}
+func (m *ClientMisbehaviour) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientMisbehaviour) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientMisbehaviour.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientMisbehaviour proto.InternalMessageInfo
+
+func (m *ClientMisbehaviour) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *ClientMisbehaviour) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *ClientMisbehaviour) GetHeights() []Height {
+	if m != nil {
+		return m.Heights
+	}
+	return nil
+}
+
+func (m *ClientMisbehaviour) GetHeaderHashes() [][]byte {
+	if m != nil {
+		return m.HeaderHashes
+	}
+	return nil
+}
+
+func (m *ClientMisbehaviour) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *ClientMisbehaviour) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *ClientMisbehaviour) GetHeaderTimestamps() []uint64 {
+	if m != nil {
+		return m.HeaderTimestamps
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*IdentifiedClientState)(nil), "ibc.core.client.v1.IdentifiedClientState")
 	proto.RegisterType((*ConsensusStateWithHeight)(nil), "ibc.core.client.v1.ConsensusStateWithHeight")
 	proto.RegisterType((*ClientConsensusStates)(nil), "ibc.core.client.v1.ClientConsensusStates")
 	proto.RegisterType((*Height)(nil), "ibc.core.client.v1.Height")
 	proto.RegisterType((*Params)(nil), "ibc.core.client.v1.Params")
+	proto.RegisterType((*RetentionPolicy)(nil), "ibc.core.client.v1.RetentionPolicy")
+	proto.RegisterType((*ClientMisbehaviour)(nil), "ibc.core.client.v1.ClientMisbehaviour")
 }
 
 func init() { proto.RegisterFile("ibc/core/client/v1/client.proto", fileDescriptor_b6bc4c8185546947) }
 
 var fileDescriptor_b6bc4c8185546947 = []byte{
-	// 458 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xf6, 0xa5, 0x55, 0xd4, 0x5c, 0x50, 0x82, 0x4c, 0x2b, 0x99, 0x20, 0xd9, 0x51, 0x16, 0x32,
-	0xd0, 0xbb, 0x26, 0x0c, 0xfc, 0x10, 0x0c, 0xa4, 0x0b, 0x5d, 0x10, 0x72, 0x07, 0x24, 0x24, 0x14,
-	0xd9, 0xe7, 0xab, 0x73, 0x92, 0x7d, 0x57, 0xf9, 0xce, 0x46, 0xf9, 0x0f, 0x98, 0x10, 0x12, 0x0b,
-	0x63, 0xff, 0x9c, 0x8e, 0x1d, 0x99, 0x2a, 0x94, 0x6c, 0xfc, 0x15, 0xc8, 0x77, 0x17, 0x55, 0x2e,
-	0x01, 0x75, 0x7b, 0xf7, 0xbe, 0xef, 0xbd, 0xef, 0xfb, 0x5e, 0x62, 0x18, 0xb0, 0x98, 0x60, 0x22,
-	0x0a, 0x8a, 0x49, 0xc6, 0x28, 0x57, 0xb8, 0x9a, 0xd8, 0x0a, 0x9d, 0x17, 0x42, 0x09, 0xd7, 0x65,
-	0x31, 0x41, 0x35, 0x01, 0xd9, 0x76, 0x35, 0x19, 0xec, 0xa7, 0x22, 0x15, 0x1a, 0xc6, 0x75, 0x65,
-	0x98, 0x83, 0x87, 0xa9, 0x10, 0x69, 0x46, 0xb1, 0x7e, 0xc5, 0xe5, 0x19, 0x8e, 0xf8, 0xd2, 0x40,
-	0xa3, 0x1c, 0x1e, 0x9c, 0x24, 0x94, 0x2b, 0x76, 0xc6, 0x68, 0x72, 0xac, 0xf7, 0x9c, 0xaa, 0x48,
-	0x51, 0xf7, 0x11, 0xec, 0x98, 0xb5, 0x73, 0x96, 0x78, 0x60, 0x08, 0xc6, 0x9d, 0x70, 0xcf, 0x34,
-	0x4e, 0x12, 0xf7, 0x19, 0xbc, 0x67, 0x41, 0x59, 0x93, 0xbd, 0xd6, 0x10, 0x8c, 0xbb, 0xd3, 0x7d,
-	0x64, 0x74, 0xd0, 0x46, 0x07, 0xbd, 0xe1, 0xcb, 0xb0, 0x4b, 0x6e, 0xb6, 0x8e, 0xbe, 0x03, 0xe8,
-	0x1d, 0x0b, 0x2e, 0x29, 0x97, 0xa5, 0xd4, 0xad, 0x0f, 0x4c, 0x2d, 0xde, 0x52, 0x96, 0x2e, 0x94,
-	0xfb, 0x1c, 0xb6, 0x17, 0xba, 0xd2, 0x7a, 0xdd, 0xe9, 0x00, 0xfd, 0x9d, 0x10, 0x19, 0xee, 0x6c,
-	0xf7, 0xf2, 0x3a, 0x70, 0x42, 0xcb, 0x77, 0x5f, 0xc3, 0x3e, 0xd9, 0x6c, 0xbd, 0x83, 0xa5, 0x1e,
-	0x69, 0x58, 0xa8, 0x5d, 0x1d, 0x98, 0xec, 0x4d, 0x6f, 0xf2, 0xff, 0x57, 0xf8, 0x04, 0xef, 0xdf,
-	0x52, 0x95, 0x5e, 0x6b, 0xb8, 0x33, 0xee, 0x4e, 0x9f, 0x6c, 0x73, 0xfe, 0xaf, 0xdc, 0x36, 0x4b,
-	0xbf, 0x69, 0x4a, 0x8e, 0xbe, 0x02, 0xd8, 0xb6, 0x97, 0x79, 0x05, 0xfb, 0x05, 0xad, 0x98, 0x64,
-	0x82, 0xcf, 0x79, 0x99, 0xc7, 0xb4, 0xd0, 0x66, 0x76, 0x67, 0x0f, 0x7e, 0x5f, 0x07, 0xb7, 0xa1,
-	0xb0, 0xb7, 0x69, 0xbc, 0xd3, 0xef, 0xc6, 0xb4, 0x3d, 0x70, 0x6b, 0xcb, 0xb4, 0x81, 0x6e, 0xa6,
-	0x8d, 0xf6, 0xcb, 0xbd, 0x2f, 0x17, 0x81, 0xf3, 0xe3, 0x22, 0x70, 0x46, 0x13, 0xd8, 0x7e, 0x1f,
-	0x15, 0x51, 0x2e, 0xdd, 0xc7, 0xb0, 0x1f, 0x65, 0x99, 0xf8, 0x4c, 0x93, 0xb9, 0xc9, 0x27, 0x3d,
-	0x30, 0xdc, 0x19, 0x77, 0xc2, 0x9e, 0x6d, 0x9b, 0x6b, 0xca, 0xd9, 0xe9, 0xe5, 0xca, 0x07, 0x57,
-	0x2b, 0x1f, 0xfc, 0x5a, 0xf9, 0xe0, 0xdb, 0xda, 0x77, 0xae, 0xd6, 0xbe, 0xf3, 0x73, 0xed, 0x3b,
-	0x1f, 0x5f, 0xa4, 0x4c, 0x2d, 0xca, 0x18, 0x11, 0x91, 0x63, 0x22, 0x64, 0x2e, 0x24, 0x66, 0x31,
-	0x39, 0x4c, 0x05, 0xae, 0x26, 0x47, 0x38, 0x17, 0x49, 0x99, 0x51, 0x69, 0xfe, 0xff, 0x47, 0xd3,
-	0x43, 0xfb, 0x09, 0xa8, 0xe5, 0x39, 0x95, 0x71, 0x5b, 0xff, 0x98, 0x4f, 0xff, 0x04, 0x00, 0x00,
-	0xff, 0xff, 0xf3, 0x47, 0x85, 0x95, 0x22, 0x03, 0x00, 0x00,
+	// 656 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x31, 0x6f, 0xd3, 0x40,
+	0x18, 0x8d, 0x93, 0x90, 0x26, 0x97, 0xd0, 0x94, 0xa3, 0x95, 0xdc, 0x80, 0x9c, 0x10, 0x06, 0x22,
+	0x41, 0xed, 0x26, 0x0c, 0x40, 0x55, 0x86, 0xa6, 0x0c, 0xed, 0x00, 0xaa, 0x5c, 0x24, 0x24, 0x24,
+	0x14, 0x9d, 0xed, 0xab, 0x7d, 0xc2, 0xf6, 0x15, 0xdf, 0x39, 0x34, 0x23, 0x1b, 0x13, 0x42, 0x62,
+	0xe9, 0xc0, 0xd0, 0x9f, 0xd3, 0xb1, 0x23, 0x53, 0x41, 0xed, 0xc6, 0xaf, 0x40, 0xbe, 0xbb, 0xb4,
+	0x4a, 0x52, 0x0a, 0xdb, 0xdd, 0x7b, 0xdf, 0xe7, 0xf7, 0xbe, 0xf7, 0x9d, 0x0c, 0x9a, 0xc4, 0x71,
+	0x2d, 0x97, 0x26, 0xd8, 0x72, 0x43, 0x82, 0x63, 0x6e, 0x0d, 0xbb, 0xea, 0x64, 0xee, 0x27, 0x94,
+	0x53, 0x08, 0x89, 0xe3, 0x9a, 0x59, 0x81, 0xa9, 0xe0, 0x61, 0xb7, 0xb1, 0xe8, 0x53, 0x9f, 0x0a,
+	0xda, 0xca, 0x4e, 0xb2, 0xb2, 0xb1, 0xec, 0x53, 0xea, 0x87, 0xd8, 0x12, 0x37, 0x27, 0xdd, 0xb3,
+	0x50, 0x3c, 0x52, 0x94, 0x31, 0x4d, 0x79, 0x69, 0x82, 0x38, 0xa1, 0xb1, 0xe4, 0xdb, 0x11, 0x58,
+	0xda, 0xf6, 0x70, 0xcc, 0xc9, 0x1e, 0xc1, 0xde, 0xa6, 0xd0, 0xd9, 0xe5, 0x88, 0x63, 0x78, 0x07,
+	0x54, 0xa4, 0xec, 0x80, 0x78, 0xba, 0xd6, 0xd2, 0x3a, 0x15, 0xbb, 0x2c, 0x81, 0x6d, 0x0f, 0x3e,
+	0x01, 0x35, 0x45, 0xb2, 0xac, 0x58, 0xcf, 0xb7, 0xb4, 0x4e, 0xb5, 0xb7, 0x68, 0x4a, 0x31, 0x73,
+	0x2c, 0x66, 0x6e, 0xc4, 0x23, 0xbb, 0xea, 0x5e, 0x7e, 0xb5, 0xfd, 0x4d, 0x03, 0xfa, 0x26, 0x8d,
+	0x19, 0x8e, 0x59, 0xca, 0x04, 0xf4, 0x86, 0xf0, 0x60, 0x0b, 0x13, 0x3f, 0xe0, 0xf0, 0x29, 0x28,
+	0x05, 0xe2, 0x24, 0xf4, 0xaa, 0xbd, 0x86, 0x39, 0x9b, 0x80, 0x29, 0x6b, 0xfb, 0xc5, 0xe3, 0xd3,
+	0x66, 0xce, 0x56, 0xf5, 0xf0, 0x39, 0xa8, 0xbb, 0xe3, 0xaf, 0xfe, 0x87, 0xa5, 0x79, 0x77, 0xc2,
+	0x42, 0xe6, 0x6a, 0x49, 0xce, 0x3e, 0xe9, 0x8d, 0x5d, 0x9f, 0xc2, 0x3b, 0xb0, 0x30, 0xa5, 0xca,
+	0xf4, 0x7c, 0xab, 0xd0, 0xa9, 0xf6, 0x1e, 0x5d, 0xe5, 0xfc, 0x6f, 0x73, 0xab, 0x59, 0xea, 0x93,
+	0xa6, 0x58, 0xfb, 0x8b, 0x06, 0x4a, 0x2a, 0x99, 0x75, 0x50, 0x4f, 0xf0, 0x90, 0x30, 0x42, 0xe3,
+	0x41, 0x9c, 0x46, 0x0e, 0x4e, 0x84, 0x99, 0x62, 0xff, 0xf6, 0xef, 0xd3, 0xe6, 0x34, 0x65, 0xcf,
+	0x8f, 0x81, 0x57, 0xe2, 0x3e, 0xd1, 0xad, 0x02, 0xce, 0x5f, 0xd1, 0x2d, 0xa9, 0xcb, 0x6e, 0xa9,
+	0xbd, 0x56, 0xfe, 0x7c, 0xd4, 0xcc, 0x1d, 0x1e, 0x35, 0x73, 0xed, 0x2e, 0x28, 0xed, 0xa0, 0x04,
+	0x45, 0x0c, 0x3e, 0x00, 0x75, 0x14, 0x86, 0xf4, 0x23, 0xf6, 0x06, 0x72, 0x3e, 0xa6, 0x6b, 0xad,
+	0x42, 0xa7, 0x62, 0xcf, 0x2b, 0x58, 0xa6, 0xc9, 0xda, 0x9f, 0x34, 0x50, 0xb7, 0x31, 0xcf, 0x1e,
+	0x18, 0x8d, 0x77, 0x68, 0x48, 0xdc, 0x11, 0x5c, 0x05, 0x8b, 0x11, 0x3a, 0x18, 0xcc, 0x44, 0x27,
+	0x26, 0xb2, 0x61, 0x84, 0x0e, 0xa6, 0xb7, 0xb0, 0x0e, 0xe6, 0xb2, 0x0e, 0xe4, 0x8f, 0xd7, 0xba,
+	0x3c, 0xb3, 0xd6, 0x17, 0xea, 0x59, 0xf7, 0xcb, 0x59, 0x98, 0x87, 0x3f, 0x9b, 0x9a, 0x5d, 0x8a,
+	0xd0, 0xc1, 0x86, 0x8f, 0xdb, 0xdf, 0xf3, 0x00, 0x4a, 0x3f, 0x2f, 0x09, 0x73, 0x70, 0x80, 0x86,
+	0x84, 0xa6, 0xc9, 0xf5, 0xab, 0x6d, 0x80, 0x32, 0xc3, 0x1f, 0x52, 0x1c, 0xbb, 0x52, 0xb2, 0x68,
+	0x5f, 0xdc, 0xe1, 0x1a, 0x98, 0x93, 0x51, 0x31, 0xbd, 0x20, 0xb6, 0xfd, 0xef, 0x77, 0x3a, 0x6e,
+	0x80, 0xf7, 0xc1, 0xcd, 0x00, 0x23, 0x0f, 0x27, 0x83, 0x00, 0xb1, 0x00, 0x33, 0xbd, 0xd8, 0x2a,
+	0x74, 0x6a, 0x76, 0x4d, 0x82, 0x5b, 0x02, 0x83, 0x77, 0x41, 0x85, 0xa5, 0x4e, 0x44, 0x38, 0xc7,
+	0x89, 0x7e, 0x43, 0x38, 0xbb, 0x04, 0xe0, 0x3d, 0x50, 0x73, 0x42, 0xea, 0xbe, 0x1f, 0xaf, 0xb2,
+	0x24, 0xec, 0x55, 0x05, 0xa6, 0x9e, 0xcb, 0x43, 0x70, 0x4b, 0xa9, 0x70, 0x12, 0x61, 0xc6, 0x51,
+	0xb4, 0xcf, 0xf4, 0xb9, 0x56, 0xa1, 0x53, 0xb4, 0x17, 0x24, 0xf1, 0xfa, 0x02, 0xef, 0xef, 0x1e,
+	0x9f, 0x19, 0xda, 0xc9, 0x99, 0xa1, 0xfd, 0x3a, 0x33, 0xb4, 0xaf, 0xe7, 0x46, 0xee, 0xe4, 0xdc,
+	0xc8, 0xfd, 0x38, 0x37, 0x72, 0x6f, 0x9f, 0xf9, 0x84, 0x07, 0xa9, 0x63, 0xba, 0x34, 0xb2, 0x5c,
+	0xca, 0x22, 0xca, 0x2c, 0xe2, 0xb8, 0x2b, 0x3e, 0xb5, 0x86, 0xdd, 0x55, 0x2b, 0xa2, 0x5e, 0x1a,
+	0x62, 0x26, 0x7f, 0x61, 0xab, 0xbd, 0x15, 0xf5, 0x17, 0xe3, 0xa3, 0x7d, 0xcc, 0x9c, 0x92, 0x58,
+	0xcc, 0xe3, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xce, 0xe8, 0x22, 0x18, 0xe5, 0x04, 0x00, 0x00,
 }
 
 func (m *IdentifiedClientState) Marshal() (dAtA []byte, err error) {
@@ -531,6 +709,130 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RetentionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetentionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetentionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxAge, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAge):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintClient(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if m.MaxConsensusStates != 0 {
+		i = encodeVarintClient(dAtA, i, uint64(m.MaxConsensusStates))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClientMisbehaviour) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientMisbehaviour) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientMisbehaviour) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HeaderTimestamps) > 0 {
+		dAtA6 := make([]byte, len(m.HeaderTimestamps)*10)
+		var j5 int
+		for _, num := range m.HeaderTimestamps {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintClient(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintClient(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.HeaderHashes) > 0 {
+		for iNdEx := len(m.HeaderHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.HeaderHashes[iNdEx])
+			copy(dAtA[i:], m.HeaderHashes[iNdEx])
+			i = encodeVarintClient(dAtA, i, uint64(len(m.HeaderHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Heights) > 0 {
+		for iNdEx := len(m.Heights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Heights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClient(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintClient(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintClient(dAtA []byte, offset int, v uint64) int {
 	offset -= sovClient(v)
 	base := offset
@@ -623,6 +925,62 @@ func (m *Params) Size() (n int) {
 	return n
 }
 
+func (m *RetentionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxConsensusStates != 0 {
+		n += 1 + sovClient(uint64(m.MaxConsensusStates))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAge)
+	n += 1 + l + sovClient(uint64(l))
+	return n
+}
+
+func (m *ClientMisbehaviour) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovClient(uint64(m.Sequence))
+	}
+	if len(m.Heights) > 0 {
+		for _, e := range m.Heights {
+			l = e.Size()
+			n += 1 + l + sovClient(uint64(l))
+		}
+	}
+	if len(m.HeaderHashes) > 0 {
+		for _, b := range m.HeaderHashes {
+			l = len(b)
+			n += 1 + l + sovClient(uint64(l))
+		}
+	}
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovClient(uint64(m.BlockHeight))
+	}
+	if len(m.HeaderTimestamps) > 0 {
+		l = 0
+		for _, e := range m.HeaderTimestamps {
+			l += sovClient(uint64(e))
+		}
+		n += 1 + sovClient(uint64(l)) + l
+	}
+	return n
+}
+
 func sovClient(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1152,6 +1510,402 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RetentionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetentionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetentionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConsensusStates", wireType)
+			}
+			m.MaxConsensusStates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConsensusStates |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClientMisbehaviour) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientMisbehaviour: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientMisbehaviour: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Heights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Heights = append(m.Heights, Height{})
+			if err := m.Heights[len(m.Heights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderHashes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeaderHashes = append(m.HeaderHashes, make([]byte, postIndex-iNdEx))
+			copy(m.HeaderHashes[len(m.HeaderHashes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClient
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.HeaderTimestamps = append(m.HeaderTimestamps, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClient
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthClient
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthClient
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.HeaderTimestamps) == 0 {
+					m.HeaderTimestamps = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowClient
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.HeaderTimestamps = append(m.HeaderTimestamps, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderTimestamps", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipClient(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -38,4 +38,7 @@ var (
 	ErrFailedNonMembershipVerification        = errorsmod.Register(SubModuleName, 31, "non-membership verification failed")
 	ErrRouteNotFound                          = errorsmod.Register(SubModuleName, 32, "light client module route not found")
 	ErrClientTypeNotSupported                 = errorsmod.Register(SubModuleName, 33, "client type not supported")
+	ErrInvalidRetentionPolicy                 = errorsmod.Register(SubModuleName, 34, "invalid retention policy")
+	ErrClientNotFrozen                        = errorsmod.Register(SubModuleName, 35, "client is not frozen")
+	ErrInvalidLivenessPeriod                  = errorsmod.Register(SubModuleName, 36, "invalid liveness period")
 )
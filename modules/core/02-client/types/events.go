@@ -16,6 +16,7 @@ const (
 	AttributeKeyUpgradeStore      = "upgrade_store"
 	AttributeKeyUpgradePlanHeight = "upgrade_plan_height"
 	AttributeKeyUpgradePlanTitle  = "title"
+	AttributeKeyPrunedCount       = "pruned_count"
 )
 
 // IBC client events vars
@@ -27,6 +28,9 @@ var (
 	EventTypeRecoverClient              = "recover_client"
 	EventTypeScheduleIBCSoftwareUpgrade = "schedule_ibc_software_upgrade"
 	EventTypeUpgradeChain               = "upgrade_chain"
+	EventTypePruneConsensusStates       = "prune_consensus_states"
+	EventTypeFreezeClient               = "freeze_client"
+	EventTypeUnfreezeClient             = "unfreeze_client"
 
 	AttributeValueCategory = fmt.Sprintf("%s_%s", ibcexported.ModuleName, SubModuleName)
 )
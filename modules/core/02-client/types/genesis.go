@@ -198,6 +198,28 @@ func (gs GenesisState) Validate() error {
 
 	}
 
+	type misbehaviourID struct {
+		clientID string
+		sequence uint64
+	}
+	misbehaviours := make(map[misbehaviourID]bool)
+	for i, misbehaviour := range gs.Misbehaviours {
+		// check that misbehaviour is for a client in the genesis clients list
+		if _, ok := validClients[misbehaviour.ClientId]; !ok {
+			return fmt.Errorf("misbehaviour in genesis has a client id %s that does not map to a genesis client", misbehaviour.ClientId)
+		}
+
+		if err := misbehaviour.Validate(); err != nil {
+			return fmt.Errorf("invalid misbehaviour clientID %s index %d: %w", misbehaviour.ClientId, i, err)
+		}
+
+		id := misbehaviourID{clientID: misbehaviour.ClientId, sequence: misbehaviour.Sequence}
+		if misbehaviours[id] {
+			return fmt.Errorf("duplicate misbehaviour with client ID %s and sequence %d", misbehaviour.ClientId, misbehaviour.Sequence)
+		}
+		misbehaviours[id] = true
+	}
+
 	if maxSequence != 0 && maxSequence >= gs.NextClientSequence {
 		return fmt.Errorf("next client identifier sequence %d must be greater than the maximum sequence used in the provided client identifiers %d", gs.NextClientSequence, maxSequence)
 	}
@@ -37,6 +37,8 @@ type GenesisState struct {
 	CreateLocalhost bool `protobuf:"varint,5,opt,name=create_localhost,json=createLocalhost,proto3" json:"create_localhost,omitempty"` // Deprecated: Do not use.
 	// the sequence for the next generated client identifier
 	NextClientSequence uint64 `protobuf:"varint,6,opt,name=next_client_sequence,json=nextClientSequence,proto3" json:"next_client_sequence,omitempty"`
+	// misbehaviours submitted for each client
+	Misbehaviours []ClientMisbehaviour `protobuf:"bytes,7,rep,name=misbehaviours,proto3" json:"misbehaviours"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -115,6 +117,13 @@ func (m *GenesisState) GetNextClientSequence() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetMisbehaviours() []ClientMisbehaviour {
+	if m != nil {
+		return m.Misbehaviours
+	}
+	return nil
+}
+
 // GenesisMetadata defines the genesis type for metadata that will be used
 // to export all client store keys that are not client or consensus states.
 type GenesisMetadata struct {
@@ -220,38 +229,39 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/client/v1/genesis.proto", fileDescriptor_bcd0c0f1f2e6a91a) }
 
 var fileDescriptor_bcd0c0f1f2e6a91a = []byte{
-	// 482 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xb1, 0x8e, 0xd3, 0x4c,
-	0x18, 0xf4, 0x26, 0xb9, 0xfc, 0x77, 0x7b, 0xa7, 0x3f, 0x61, 0x15, 0x21, 0x13, 0x24, 0xc7, 0x0a,
-	0x8d, 0x29, 0x62, 0x27, 0xa1, 0x01, 0x1a, 0xa4, 0x5c, 0x81, 0x4e, 0x02, 0x09, 0xf9, 0x3a, 0x0a,
-	0x2c, 0x7b, 0xfd, 0xe1, 0xb3, 0xb0, 0xbd, 0x21, 0xbb, 0xb6, 0xb8, 0x37, 0xa0, 0xa0, 0xe0, 0x11,
-	0xa8, 0x79, 0x92, 0x2b, 0xaf, 0xa4, 0x02, 0x94, 0x48, 0x3c, 0x07, 0xf2, 0xee, 0x9a, 0x93, 0x82,
-	0x8f, 0xee, 0xcb, 0xcc, 0x7c, 0x33, 0xd9, 0xb1, 0x3e, 0x6c, 0xa7, 0x11, 0xf5, 0x28, 0xdb, 0x80,
-	0x47, 0xb3, 0x14, 0x0a, 0xe1, 0x55, 0x0b, 0x2f, 0x81, 0x02, 0x78, 0xca, 0xdd, 0xf5, 0x86, 0x09,
-	0x46, 0x48, 0x1a, 0x51, 0xb7, 0x56, 0xb8, 0x4a, 0xe1, 0x56, 0x8b, 0xf1, 0xa4, 0x65, 0x4b, 0xb3,
-	0x72, 0x69, 0x3c, 0x4a, 0x58, 0xc2, 0xe4, 0xe8, 0xd5, 0x93, 0x42, 0xa7, 0xbf, 0xba, 0xf8, 0xe4,
-	0xb9, 0x32, 0x3f, 0x17, 0xa1, 0x00, 0x42, 0xf1, 0x7f, 0x6a, 0x8d, 0x9b, 0xc8, 0xee, 0x3a, 0xc7,
-	0xcb, 0x87, 0xee, 0xdf, 0x69, 0xee, 0x59, 0x0c, 0x85, 0x48, 0xdf, 0xa6, 0x10, 0x9f, 0x4a, 0x4c,
-	0xee, 0xae, 0xac, 0xab, 0xef, 0x13, 0xe3, 0xeb, 0x8f, 0xc9, 0xdd, 0x56, 0x9a, 0xfb, 0x8d, 0x33,
-	0xa9, 0xf0, 0x1d, 0x3d, 0x06, 0x94, 0x15, 0x1c, 0x0a, 0x5e, 0x72, 0xb3, 0x73, 0x7b, 0x9c, 0x72,
-	0x39, 0x6d, 0xa4, 0xca, 0xee, 0x26, 0x4e, 0xd1, 0x7c, 0x8f, 0xf7, 0x87, 0x74, 0x0f, 0x27, 0x6f,
-	0x70, 0x83, 0x05, 0x39, 0x88, 0x30, 0x0e, 0x45, 0x68, 0x76, 0x65, 0xec, 0xec, 0xdf, 0xaf, 0xd4,
-	0x15, 0xbd, 0xd4, 0x4b, 0xab, 0x5e, 0x1d, 0xed, 0x0f, 0xb4, 0x59, 0x03, 0x93, 0xc7, 0xb8, 0xbf,
-	0x0e, 0x37, 0x61, 0xce, 0xcd, 0x9e, 0x8d, 0x9c, 0xe3, 0xe5, 0xb8, 0xcd, 0xf5, 0x95, 0x54, 0x68,
-	0x0b, 0xad, 0x27, 0x33, 0x3c, 0xa4, 0x1b, 0x08, 0x05, 0x04, 0x19, 0xa3, 0x61, 0x76, 0xc1, 0xb8,
-	0x30, 0x0f, 0x6c, 0xe4, 0x1c, 0xae, 0x3a, 0x26, 0xf2, 0x07, 0x8a, 0x7b, 0xd1, 0x50, 0x64, 0x8e,
-	0x47, 0x05, 0x7c, 0x10, 0x81, 0x72, 0x0d, 0x38, 0xbc, 0x2f, 0xa1, 0xa0, 0x60, 0xf6, 0x6d, 0xe4,
-	0xf4, 0x7c, 0x52, 0x73, 0xba, 0x79, 0xcd, 0x4c, 0x9f, 0xe1, 0xc1, 0xde, 0x23, 0xc8, 0x10, 0x77,
-	0xdf, 0xc1, 0xa5, 0x89, 0x6c, 0xe4, 0x9c, 0xf8, 0xf5, 0x48, 0x46, 0xf8, 0xa0, 0x0a, 0xb3, 0x12,
-	0xcc, 0x8e, 0xc4, 0xd4, 0x8f, 0xa7, 0xbd, 0x8f, 0x5f, 0x26, 0xc6, 0xf4, 0x13, 0xc2, 0xf7, 0x6e,
-	0x2d, 0x84, 0xdc, 0xc7, 0x47, 0xfa, 0xbf, 0xa4, 0xb1, 0x74, 0x3c, 0xf2, 0x0f, 0x15, 0x70, 0x16,
-	0x13, 0x1f, 0xeb, 0xa6, 0x6e, 0x5a, 0x57, 0x1f, 0xfb, 0x41, 0x5b, 0x3f, 0xed, 0x5d, 0xff, 0xaf,
-	0x04, 0x7f, 0xd0, 0xf3, 0xab, 0xad, 0x85, 0xae, 0xb7, 0x16, 0xfa, 0xb9, 0xb5, 0xd0, 0xe7, 0x9d,
-	0x65, 0x5c, 0xef, 0x2c, 0xe3, 0xdb, 0xce, 0x32, 0x5e, 0x3f, 0x49, 0x52, 0x71, 0x51, 0x46, 0x2e,
-	0x65, 0xb9, 0x47, 0x19, 0xcf, 0x19, 0xf7, 0xd2, 0x88, 0xce, 0x12, 0xe6, 0x55, 0x8b, 0xb9, 0x97,
-	0xb3, 0xb8, 0xcc, 0x80, 0xab, 0x53, 0x99, 0x2f, 0x67, 0xfa, 0x5a, 0xc4, 0xe5, 0x1a, 0x78, 0xd4,
-	0x97, 0x47, 0xf1, 0xe8, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9c, 0x86, 0x76, 0xc7, 0x83, 0x03,
-	0x00, 0x00,
+	// 510 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xc1, 0x8e, 0x12, 0x41,
+	0x10, 0xa5, 0x81, 0x65, 0x77, 0x7b, 0x57, 0xc1, 0x0e, 0x31, 0x23, 0x26, 0xc3, 0x04, 0x13, 0x83,
+	0x07, 0x66, 0x00, 0x2f, 0xea, 0xc5, 0x84, 0x3d, 0x98, 0x4d, 0xdc, 0xc4, 0xcc, 0xde, 0x3c, 0x48,
+	0x7a, 0x7a, 0x4a, 0xe8, 0xc8, 0x4c, 0x23, 0xdd, 0x33, 0x71, 0xff, 0xc0, 0x83, 0x07, 0x3f, 0xc1,
+	0xb3, 0x9f, 0xe0, 0x17, 0xec, 0x71, 0x8f, 0x9e, 0xd4, 0xc0, 0x8f, 0x18, 0xba, 0x1b, 0x57, 0x71,
+	0xd8, 0x5b, 0xf1, 0xde, 0xab, 0x57, 0xd4, 0xeb, 0x29, 0xec, 0xf1, 0x88, 0x05, 0x4c, 0x2c, 0x20,
+	0x60, 0x33, 0x0e, 0xa9, 0x0a, 0xf2, 0x41, 0x30, 0x81, 0x14, 0x24, 0x97, 0xfe, 0x7c, 0x21, 0x94,
+	0x20, 0x84, 0x47, 0xcc, 0x5f, 0x2b, 0x7c, 0xa3, 0xf0, 0xf3, 0x41, 0xab, 0x5d, 0xd0, 0x65, 0x59,
+	0xdd, 0xd4, 0x6a, 0x4e, 0xc4, 0x44, 0xe8, 0x32, 0x58, 0x57, 0x06, 0xed, 0x7c, 0xab, 0xe2, 0xe3,
+	0x17, 0xc6, 0xfc, 0x5c, 0x51, 0x05, 0x84, 0xe1, 0x7d, 0xd3, 0x26, 0x1d, 0xe4, 0x55, 0xba, 0x47,
+	0xc3, 0x47, 0xfe, 0xff, 0xd3, 0xfc, 0xd3, 0x18, 0x52, 0xc5, 0xdf, 0x72, 0x88, 0x4f, 0x34, 0xa6,
+	0x7b, 0x47, 0xee, 0xe5, 0x8f, 0x76, 0xe9, 0xeb, 0xcf, 0xf6, 0xdd, 0x42, 0x5a, 0x86, 0x1b, 0x67,
+	0x92, 0xe3, 0x3b, 0xb6, 0x1c, 0x33, 0x91, 0x4a, 0x48, 0x65, 0x26, 0x9d, 0xf2, 0xee, 0x71, 0xc6,
+	0xe5, 0x64, 0x23, 0x35, 0x76, 0xd7, 0xe3, 0x0c, 0x2d, 0xb7, 0xf8, 0xb0, 0xc1, 0xb6, 0x70, 0xf2,
+	0x06, 0x6f, 0xb0, 0x71, 0x02, 0x8a, 0xc6, 0x54, 0x51, 0xa7, 0xa2, 0xc7, 0xf6, 0x6e, 0xde, 0xd2,
+	0x46, 0x74, 0x66, 0x9b, 0x46, 0xd5, 0xf5, 0xe8, 0xb0, 0x6e, 0xcd, 0x36, 0x30, 0x79, 0x82, 0x6b,
+	0x73, 0xba, 0xa0, 0x89, 0x74, 0xaa, 0x1e, 0xea, 0x1e, 0x0d, 0x5b, 0x45, 0xae, 0xaf, 0xb4, 0xc2,
+	0x5a, 0x58, 0x3d, 0xe9, 0xe1, 0x06, 0x5b, 0x00, 0x55, 0x30, 0x9e, 0x09, 0x46, 0x67, 0x53, 0x21,
+	0x95, 0xb3, 0xe7, 0xa1, 0xee, 0xc1, 0xa8, 0xec, 0xa0, 0xb0, 0x6e, 0xb8, 0x97, 0x1b, 0x8a, 0xf4,
+	0x71, 0x33, 0x85, 0x0f, 0x6a, 0x6c, 0x5c, 0xc7, 0x12, 0xde, 0x67, 0x90, 0x32, 0x70, 0x6a, 0x1e,
+	0xea, 0x56, 0x43, 0xb2, 0xe6, 0x6c, 0xf2, 0x96, 0x21, 0x21, 0xbe, 0x95, 0x70, 0x19, 0xc1, 0x94,
+	0xe6, 0x5c, 0x64, 0x0b, 0xe9, 0xec, 0xeb, 0xbd, 0x1f, 0xee, 0x8e, 0xfb, 0xec, 0x2f, 0xb9, 0xfd,
+	0xb7, 0xff, 0x5a, 0x74, 0x9e, 0xe3, 0xfa, 0x56, 0x30, 0xa4, 0x81, 0x2b, 0xef, 0xe0, 0xc2, 0x41,
+	0x1e, 0xea, 0x1e, 0x87, 0xeb, 0x92, 0x34, 0xf1, 0x5e, 0x4e, 0x67, 0x19, 0x38, 0x65, 0x8d, 0x99,
+	0x1f, 0xcf, 0xaa, 0x1f, 0xbf, 0xb4, 0x4b, 0x9d, 0x4f, 0x08, 0xdf, 0xdb, 0x19, 0x32, 0xb9, 0x8f,
+	0x0f, 0xed, 0x7e, 0x3c, 0xd6, 0x8e, 0x87, 0xe1, 0x81, 0x01, 0x4e, 0x63, 0x12, 0x62, 0x9b, 0xfe,
+	0xf5, 0x4b, 0x9a, 0x0f, 0xe8, 0x41, 0xd1, 0x46, 0xc5, 0xef, 0x77, 0xdb, 0x08, 0xfe, 0xa0, 0xe7,
+	0x97, 0x4b, 0x17, 0x5d, 0x2d, 0x5d, 0xf4, 0x6b, 0xe9, 0xa2, 0xcf, 0x2b, 0xb7, 0x74, 0xb5, 0x72,
+	0x4b, 0xdf, 0x57, 0x6e, 0xe9, 0xf5, 0xd3, 0x09, 0x57, 0xd3, 0x2c, 0xf2, 0x99, 0x48, 0x02, 0x26,
+	0x64, 0x22, 0x64, 0xc0, 0x23, 0xd6, 0x9b, 0x88, 0x20, 0x1f, 0xf4, 0x83, 0x44, 0xc4, 0xd9, 0x0c,
+	0xa4, 0x39, 0xbf, 0xfe, 0xb0, 0x67, 0x2f, 0x50, 0x5d, 0xcc, 0x41, 0x46, 0x35, 0x7d, 0x68, 0x8f,
+	0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x2a, 0x7b, 0x9b, 0xc6, 0xd7, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -274,6 +284,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Misbehaviours) > 0 {
+		for iNdEx := len(m.Misbehaviours) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Misbehaviours[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.NextClientSequence != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextClientSequence))
 		i--
@@ -468,6 +492,12 @@ func (m *GenesisState) Size() (n int) {
 	if m.NextClientSequence != 0 {
 		n += 1 + sovGenesis(uint64(m.NextClientSequence))
 	}
+	if len(m.Misbehaviours) > 0 {
+		for _, e := range m.Misbehaviours {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -716,6 +746,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Misbehaviours", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Misbehaviours = append(m.Misbehaviours, ClientMisbehaviour{})
+			if err := m.Misbehaviours[len(m.Misbehaviours)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
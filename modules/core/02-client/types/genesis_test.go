@@ -33,6 +33,11 @@ func (suite *TypesTestSuite) TestMarshalGenesisState() {
 	err := path.EndpointA.UpdateClient()
 	suite.Require().NoError(err)
 
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientMisbehaviour(suite.chainA.GetContext(), types.ClientMisbehaviour{
+		ClientId:  path.EndpointA.ClientID,
+		Submitter: suite.chainA.SenderAccount.GetAddress().String(),
+	})
+
 	genesis := client.ExportGenesis(suite.chainA.GetContext(), suite.chainA.App.GetIBCKeeper().ClientKeeper)
 
 	bz, err := cdc.MarshalJSON(&genesis)
@@ -42,6 +47,8 @@ func (suite *TypesTestSuite) TestMarshalGenesisState() {
 	var gs types.GenesisState
 	err = cdc.UnmarshalJSON(bz, &gs)
 	suite.Require().NoError(err)
+	suite.Require().Len(gs.Misbehaviours, 1)
+	suite.Require().NoError(gs.Validate())
 }
 
 func (suite *TypesTestSuite) TestValidateGenesis() {
@@ -60,6 +67,37 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 	heightMinus1 := types.NewHeight(1, height-1)
 	header := suite.chainA.CreateTMClientHeader(suite.chainA.ChainID, int64(clientHeight.RevisionHeight), heightMinus1, now, valSet, valSet, valSet, signers)
 
+	// newMisbehaviour returns misbehaviour evidence of the given client with the given sequence
+	newMisbehaviour := func(clientID string, sequence uint64) types.ClientMisbehaviour {
+		return types.ClientMisbehaviour{
+			ClientId:         clientID,
+			Sequence:         sequence,
+			Heights:          []types.Height{header.GetHeight().(types.Height)},
+			HeaderHashes:     [][]byte{header.Commit.BlockID.Hash},
+			HeaderTimestamps: []uint64{uint64(header.GetTime().UnixNano())},
+			Submitter:        suite.chainA.SenderAccount.GetAddress().String(),
+			BlockHeight:      10,
+		}
+	}
+
+	// genStateWithMisbehaviours returns a valid genesis state of a single tendermint client with the given misbehaviour evidence
+	genStateWithMisbehaviours := func(misbehaviours ...types.ClientMisbehaviour) types.GenesisState {
+		genState := types.NewGenesisState(
+			[]types.IdentifiedClientState{
+				types.NewIdentifiedClientState(
+					tmClientID0, ibctm.NewClientState(suite.chainA.ChainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift, clientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath),
+				),
+			},
+			nil,
+			nil,
+			types.NewParams(exported.Tendermint),
+			false,
+			2,
+		)
+		genState.Misbehaviours = misbehaviours
+		return genState
+	}
+
 	testCases := []struct {
 		name     string
 		genState types.GenesisState
@@ -474,6 +512,39 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 			),
 			expError: errors.New("consensus state in genesis has a client id 07-tendermint-0 that does not map to a genesis client"),
 		},
+		{
+			name:     "valid misbehaviours",
+			genState: genStateWithMisbehaviours(newMisbehaviour(tmClientID0, 0), newMisbehaviour(tmClientID0, 1)),
+			expError: nil,
+		},
+		{
+			name:     "misbehaviour client-id does not match a genesis client",
+			genState: genStateWithMisbehaviours(newMisbehaviour(tmClientID1, 0)),
+			expError: errors.New("misbehaviour in genesis has a client id 07-tendermint-1 that does not map to a genesis client"),
+		},
+		{
+			name: "invalid misbehaviour",
+			genState: func() types.GenesisState {
+				misbehaviour := newMisbehaviour(tmClientID0, 0)
+				misbehaviour.HeaderHashes = nil
+				return genStateWithMisbehaviours(misbehaviour)
+			}(),
+			expError: types.ErrInvalidMisbehaviour,
+		},
+		{
+			name: "invalid misbehaviour header timestamps",
+			genState: func() types.GenesisState {
+				misbehaviour := newMisbehaviour(tmClientID0, 0)
+				misbehaviour.HeaderTimestamps = []uint64{1, 2}
+				return genStateWithMisbehaviours(misbehaviour)
+			}(),
+			expError: types.ErrInvalidMisbehaviour,
+		},
+		{
+			name:     "duplicate misbehaviour",
+			genState: genStateWithMisbehaviours(newMisbehaviour(tmClientID0, 0), newMisbehaviour(tmClientID0, 0)),
+			expError: errors.New("duplicate misbehaviour with client ID 07-tendermint-0 and sequence 0"),
+		},
 	}
 
 	for _, tc := range testCases {
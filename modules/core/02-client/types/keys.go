@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/binary"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -32,6 +33,29 @@ const (
 	// KeyCreator is the key for the creator in the client-specific store
 	KeyCreator = "creator"
 
+	// KeyRetentionPolicy is the key for the retention policy in the client-specific store
+	KeyRetentionPolicy = "retentionPolicy"
+
+	// KeyRetentionPolicyIndexPrefix is the key prefix under which the identifiers of clients with a
+	// retention policy are indexed
+	KeyRetentionPolicyIndexPrefix = "retentionPolicies"
+
+	// KeyRetentionPolicyCursor is the key for the identifier of the client from which pruning resumes
+	// at the end of the next block
+	KeyRetentionPolicyCursor = "retentionPolicyCursor"
+
+	// KeyFrozenByAuthority is the key in the client-specific store which marks a client as frozen by the authority
+	KeyFrozenByAuthority = "frozenByAuthority"
+
+	// KeyLivenessPeriod is the key for the liveness period in the client-specific store
+	KeyLivenessPeriod = "livenessPeriod"
+
+	// KeyLastUpdateTime is the key for the block time of the last update in the client-specific store
+	KeyLastUpdateTime = "lastUpdateTime"
+
+	// KeyClientMisbehaviourPrefix is the key prefix under which the misbehaviour evidence of clients is stored
+	KeyClientMisbehaviourPrefix = "clientMisbehaviours"
+
 	// AllowAllClients is the value that if set in AllowedClients param
 	// would allow any wired up light client modules to be allowed
 	AllowAllClients = "*"
@@ -99,3 +123,39 @@ func MustParseClientIdentifier(clientID string) string {
 func CreatorKey() []byte {
 	return []byte(KeyCreator)
 }
+
+// RetentionPolicyKey returns the key under which the retention policy is stored in the client store
+func RetentionPolicyKey() []byte {
+	return []byte(KeyRetentionPolicy)
+}
+
+// RetentionPolicyIndexKey returns the key under which a client with a retention policy is indexed
+func RetentionPolicyIndexKey(clientID string) []byte {
+	return fmt.Appendf(nil, "%s/%s", KeyRetentionPolicyIndexPrefix, clientID)
+}
+
+// FrozenByAuthorityKey returns the key which marks a client as frozen by the authority in the client store
+func FrozenByAuthorityKey() []byte {
+	return []byte(KeyFrozenByAuthority)
+}
+
+// LivenessPeriodKey returns the key under which the liveness period is stored in the client store
+func LivenessPeriodKey() []byte {
+	return []byte(KeyLivenessPeriod)
+}
+
+// LastUpdateTimeKey returns the key under which the block time of the last update is stored in the client store
+func LastUpdateTimeKey() []byte {
+	return []byte(KeyLastUpdateTime)
+}
+
+// ClientMisbehaviourPrefixKey returns the key prefix under which the misbehaviour evidence of a client is stored
+func ClientMisbehaviourPrefixKey(clientID string) []byte {
+	return fmt.Appendf(nil, "%s/%s/", KeyClientMisbehaviourPrefix, clientID)
+}
+
+// ClientMisbehaviourKey returns the key under which the misbehaviour evidence of a client with the given
+// sequence is stored. The sequence is big endian encoded such that evidence is iterated in submission order.
+func ClientMisbehaviourKey(clientID string, sequence uint64) []byte {
+	return binary.BigEndian.AppendUint64(ClientMisbehaviourPrefixKey(clientID), sequence)
+}
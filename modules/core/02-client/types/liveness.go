@@ -0,0 +1,17 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// ValidateLivenessPeriod performs a basic validation of the liveness period of a client. A zero liveness
+// period disables the liveness check.
+func ValidateLivenessPeriod(livenessPeriod time.Duration) error {
+	if livenessPeriod < 0 {
+		return errorsmod.Wrapf(ErrInvalidLivenessPeriod, "liveness period cannot be negative: %s", livenessPeriod)
+	}
+
+	return nil
+}
@@ -21,6 +21,8 @@ var (
 	_ sdk.Msg = (*MsgIBCSoftwareUpgrade)(nil)
 	_ sdk.Msg = (*MsgRecoverClient)(nil)
 	_ sdk.Msg = (*MsgDeleteClientCreator)(nil)
+	_ sdk.Msg = (*MsgFreezeClient)(nil)
+	_ sdk.Msg = (*MsgUnfreezeClient)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgCreateClient)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateClient)(nil)
@@ -30,6 +32,8 @@ var (
 	_ sdk.HasValidateBasic = (*MsgIBCSoftwareUpgrade)(nil)
 	_ sdk.HasValidateBasic = (*MsgRecoverClient)(nil)
 	_ sdk.HasValidateBasic = (*MsgDeleteClientCreator)(nil)
+	_ sdk.HasValidateBasic = (*MsgFreezeClient)(nil)
+	_ sdk.HasValidateBasic = (*MsgUnfreezeClient)(nil)
 
 	_ codectypes.UnpackInterfacesMessage = (*MsgCreateClient)(nil)
 	_ codectypes.UnpackInterfacesMessage = (*MsgUpdateClient)(nil)
@@ -82,6 +86,14 @@ func (msg MsgCreateClient) ValidateBasic() error {
 	if err := ValidateClientType(clientState.ClientType()); err != nil {
 		return errorsmod.Wrap(err, "client type does not meet naming constraints")
 	}
+	if msg.RetentionPolicy != nil {
+		if err := msg.RetentionPolicy.Validate(); err != nil {
+			return err
+		}
+	}
+	if err := ValidateLivenessPeriod(msg.LivenessPeriod); err != nil {
+		return err
+	}
 	return consensusState.ValidateBasic()
 }
 
@@ -342,3 +354,47 @@ func (msg *MsgDeleteClientCreator) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewMsgFreezeClient creates a new instance of MsgFreezeClient.
+func NewMsgFreezeClient(clientID string, signer string) *MsgFreezeClient {
+	return &MsgFreezeClient{
+		ClientId: clientID,
+		Signer:   signer,
+	}
+}
+
+// ValidateBasic performs basic validation of the MsgFreezeClient fields.
+func (msg *MsgFreezeClient) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if err := host.ClientIdentifierValidator(msg.ClientId); err != nil {
+		return err
+	}
+	if !IsValidClientID(msg.ClientId) {
+		return errorsmod.Wrapf(host.ErrInvalidID, "client ID %s must be in valid format: {string}-{number}", msg.ClientId)
+	}
+	return nil
+}
+
+// NewMsgUnfreezeClient creates a new instance of MsgUnfreezeClient.
+func NewMsgUnfreezeClient(clientID string, signer string) *MsgUnfreezeClient {
+	return &MsgUnfreezeClient{
+		ClientId: clientID,
+		Signer:   signer,
+	}
+}
+
+// ValidateBasic performs basic validation of the MsgUnfreezeClient fields.
+func (msg *MsgUnfreezeClient) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if err := host.ClientIdentifierValidator(msg.ClientId); err != nil {
+		return err
+	}
+	if !IsValidClientID(msg.ClientId) {
+		return errorsmod.Wrapf(host.ErrInvalidID, "client ID %s must be in valid format: {string}-{number}", msg.ClientId)
+	}
+	return nil
+}
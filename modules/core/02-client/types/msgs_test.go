@@ -188,6 +188,41 @@ func (suite *TypesTestSuite) TestMsgCreateClient_ValidateBasic() {
 			},
 			errorsmod.Wrap(types.ErrInvalidClientType, "client type for client state and consensus state do not match"),
 		},
+		{
+			"valid - retention policy",
+			func() {
+				tendermintClient := ibctm.NewClientState(suite.chainA.ChainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift, clientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath)
+				msg, err = types.NewMsgCreateClient(tendermintClient, suite.chainA.CurrentTMClientHeader().ConsensusState(), suite.chainA.SenderAccount.GetAddress().String())
+				suite.Require().NoError(err)
+
+				retentionPolicy := types.NewRetentionPolicy(10, time.Hour)
+				msg.RetentionPolicy = &retentionPolicy
+			},
+			nil,
+		},
+		{
+			"invalid - retention policy with negative max age",
+			func() {
+				tendermintClient := ibctm.NewClientState(suite.chainA.ChainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift, clientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath)
+				msg, err = types.NewMsgCreateClient(tendermintClient, suite.chainA.CurrentTMClientHeader().ConsensusState(), suite.chainA.SenderAccount.GetAddress().String())
+				suite.Require().NoError(err)
+
+				retentionPolicy := types.NewRetentionPolicy(10, -time.Hour)
+				msg.RetentionPolicy = &retentionPolicy
+			},
+			types.ErrInvalidRetentionPolicy,
+		},
+		{
+			"invalid - negative liveness period",
+			func() {
+				tendermintClient := ibctm.NewClientState(suite.chainA.ChainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift, clientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath)
+				msg, err = types.NewMsgCreateClient(tendermintClient, suite.chainA.CurrentTMClientHeader().ConsensusState(), suite.chainA.SenderAccount.GetAddress().String())
+				suite.Require().NoError(err)
+
+				msg.LivenessPeriod = -time.Hour
+			},
+			types.ErrInvalidLivenessPeriod,
+		},
 	}
 
 	for _, tc := range cases {
@@ -966,3 +1001,103 @@ func TestMsgUpdateParamsGetSigners(t *testing.T) {
 		}
 	}
 }
+
+func (suite *TypesTestSuite) TestMsgFreezeClientValidateBasic() {
+	var msg *types.MsgFreezeClient
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: valid signer and client identifier",
+			func() {},
+			nil,
+		},
+		{
+			"failure: invalid signer address",
+			func() {
+				msg.Signer = "invalid"
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: empty client ID",
+			func() {
+				msg.ClientId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"failure: invalid client ID format",
+			func() {
+				msg.ClientId = "invalid-client"
+			},
+			host.ErrInvalidID,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg = types.NewMsgFreezeClient(ibctesting.FirstClientID, ibctesting.TestAccAddress)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expError == nil {
+			suite.Require().NoError(err, "valid case %s failed", tc.name)
+		} else {
+			suite.Require().ErrorIs(err, tc.expError, "invalid case %s passed", tc.name)
+		}
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgUnfreezeClientValidateBasic() {
+	var msg *types.MsgUnfreezeClient
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: valid signer and client identifier",
+			func() {},
+			nil,
+		},
+		{
+			"failure: invalid signer address",
+			func() {
+				msg.Signer = "invalid"
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: empty client ID",
+			func() {
+				msg.ClientId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"failure: invalid client ID format",
+			func() {
+				msg.ClientId = "invalid-client"
+			},
+			host.ErrInvalidID,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg = types.NewMsgUnfreezeClient(ibctesting.FirstClientID, ibctesting.TestAccAddress)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expError == nil {
+			suite.Require().NoError(err, "valid case %s failed", tc.name)
+		} else {
+			suite.Require().ErrorIs(err, tc.expError, "invalid case %s passed", tc.name)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// MaxPrunedConsensusStatesPerBlock is the maximum number of consensus states pruned across all clients
+// at the end of a block in order to enforce their retention policies.
+const MaxPrunedConsensusStatesPerBlock uint64 = 100
+
+// NewRetentionPolicy creates a new RetentionPolicy instance.
+func NewRetentionPolicy(maxConsensusStates uint64, maxAge time.Duration) RetentionPolicy {
+	return RetentionPolicy{
+		MaxConsensusStates: maxConsensusStates,
+		MaxAge:             maxAge,
+	}
+}
+
+// Validate performs a basic validation of the retention policy.
+func (rp RetentionPolicy) Validate() error {
+	if rp.MaxAge < 0 {
+		return errorsmod.Wrapf(ErrInvalidRetentionPolicy, "max age cannot be negative: %s", rp.MaxAge)
+	}
+
+	return nil
+}
+
+// IsEmpty returns true if the retention policy does not limit the consensus states of a client.
+func (rp RetentionPolicy) IsEmpty() bool {
+	return rp.MaxConsensusStates == 0 && rp.MaxAge == 0
+}
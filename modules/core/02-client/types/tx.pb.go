@@ -12,18 +12,22 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -40,6 +44,11 @@ type MsgCreateClient struct {
 	ConsensusState *types.Any `protobuf:"bytes,2,opt,name=consensus_state,json=consensusState,proto3" json:"consensus_state,omitempty"`
 	// signer address
 	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+	// optional retention policy of the consensus states of the client
+	RetentionPolicy *RetentionPolicy `protobuf:"bytes,4,opt,name=retention_policy,json=retentionPolicy,proto3" json:"retention_policy,omitempty"`
+	// optional duration after which the client is considered inactive if it has not been updated,
+	// a zero duration disables the liveness check
+	LivenessPeriod time.Duration `protobuf:"bytes,5,opt,name=liveness_period,json=livenessPeriod,proto3,stdduration" json:"liveness_period"`
 }
 
 func (m *MsgCreateClient) Reset()         { *m = MsgCreateClient{} }
@@ -713,6 +722,162 @@ func (m *MsgDeleteClientCreatorResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteClientCreatorResponse proto.InternalMessageInfo
 
+// MsgFreezeClient defines the message used by the authority to freeze a client.
+type MsgFreezeClient struct {
+	// client identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// signer address
+	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgFreezeClient) Reset()         { *m = MsgFreezeClient{} }
+func (m *MsgFreezeClient) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeClient) ProtoMessage()    {}
+func (*MsgFreezeClient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5dc4651eb49a04, []int{16}
+}
+func (m *MsgFreezeClient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeClient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeClient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFreezeClient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeClient.Merge(m, src)
+}
+func (m *MsgFreezeClient) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeClient) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeClient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFreezeClient proto.InternalMessageInfo
+
+// MsgFreezeClientResponse defines the Msg/FreezeClient response type.
+type MsgFreezeClientResponse struct {
+}
+
+func (m *MsgFreezeClientResponse) Reset()         { *m = MsgFreezeClientResponse{} }
+func (m *MsgFreezeClientResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeClientResponse) ProtoMessage()    {}
+func (*MsgFreezeClientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5dc4651eb49a04, []int{17}
+}
+func (m *MsgFreezeClientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeClientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeClientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFreezeClientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeClientResponse.Merge(m, src)
+}
+func (m *MsgFreezeClientResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeClientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeClientResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFreezeClientResponse proto.InternalMessageInfo
+
+// MsgUnfreezeClient defines the message used by the authority to unfreeze a client.
+type MsgUnfreezeClient struct {
+	// client identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// signer address
+	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgUnfreezeClient) Reset()         { *m = MsgUnfreezeClient{} }
+func (m *MsgUnfreezeClient) String() string { return proto.CompactTextString(m) }
+func (*MsgUnfreezeClient) ProtoMessage()    {}
+func (*MsgUnfreezeClient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5dc4651eb49a04, []int{18}
+}
+func (m *MsgUnfreezeClient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnfreezeClient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnfreezeClient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnfreezeClient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnfreezeClient.Merge(m, src)
+}
+func (m *MsgUnfreezeClient) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnfreezeClient) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnfreezeClient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnfreezeClient proto.InternalMessageInfo
+
+// MsgUnfreezeClientResponse defines the Msg/UnfreezeClient response type.
+type MsgUnfreezeClientResponse struct {
+}
+
+func (m *MsgUnfreezeClientResponse) Reset()         { *m = MsgUnfreezeClientResponse{} }
+func (m *MsgUnfreezeClientResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnfreezeClientResponse) ProtoMessage()    {}
+func (*MsgUnfreezeClientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5dc4651eb49a04, []int{19}
+}
+func (m *MsgUnfreezeClientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnfreezeClientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnfreezeClientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnfreezeClientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnfreezeClientResponse.Merge(m, src)
+}
+func (m *MsgUnfreezeClientResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnfreezeClientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnfreezeClientResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnfreezeClientResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateClient)(nil), "ibc.core.client.v1.MsgCreateClient")
 	proto.RegisterType((*MsgCreateClientResponse)(nil), "ibc.core.client.v1.MsgCreateClientResponse")
@@ -730,67 +895,80 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.core.client.v1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgDeleteClientCreator)(nil), "ibc.core.client.v1.MsgDeleteClientCreator")
 	proto.RegisterType((*MsgDeleteClientCreatorResponse)(nil), "ibc.core.client.v1.MsgDeleteClientCreatorResponse")
+	proto.RegisterType((*MsgFreezeClient)(nil), "ibc.core.client.v1.MsgFreezeClient")
+	proto.RegisterType((*MsgFreezeClientResponse)(nil), "ibc.core.client.v1.MsgFreezeClientResponse")
+	proto.RegisterType((*MsgUnfreezeClient)(nil), "ibc.core.client.v1.MsgUnfreezeClient")
+	proto.RegisterType((*MsgUnfreezeClientResponse)(nil), "ibc.core.client.v1.MsgUnfreezeClientResponse")
 }
 
 func init() { proto.RegisterFile("ibc/core/client/v1/tx.proto", fileDescriptor_cb5dc4651eb49a04) }
 
 var fileDescriptor_cb5dc4651eb49a04 = []byte{
-	// 868 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x3f, 0x73, 0xe3, 0x44,
-	0x14, 0xb7, 0x1c, 0x9f, 0x87, 0x6c, 0x7c, 0x67, 0x6e, 0xcf, 0x77, 0xe7, 0x28, 0xc4, 0xf6, 0x98,
-	0x14, 0xc1, 0x10, 0xc9, 0x36, 0x33, 0x10, 0x02, 0x14, 0x89, 0x29, 0x48, 0xe1, 0x99, 0x8c, 0x3d,
-	0x34, 0x14, 0x38, 0x92, 0xbc, 0x56, 0xc4, 0x58, 0x5a, 0x8d, 0x76, 0x65, 0x48, 0xc7, 0x50, 0x51,
-	0x52, 0xd0, 0xd0, 0xf1, 0x11, 0x32, 0x7c, 0x00, 0x3a, 0x66, 0x52, 0xa6, 0xa4, 0x62, 0x20, 0x29,
-	0xf2, 0x35, 0x18, 0x69, 0x57, 0x8a, 0x24, 0x4b, 0x8a, 0x98, 0xeb, 0x24, 0xbd, 0xdf, 0x7b, 0xfb,
-	0xfb, 0xbd, 0x7f, 0x5a, 0xb0, 0x63, 0xa8, 0x9a, 0xac, 0x61, 0x07, 0xc9, 0xda, 0xd2, 0x40, 0x16,
-	0x95, 0x57, 0x03, 0x99, 0x7e, 0x2f, 0xd9, 0x0e, 0xa6, 0x18, 0x42, 0x43, 0xd5, 0x24, 0xcf, 0x28,
-	0x31, 0xa3, 0xb4, 0x1a, 0x88, 0xaf, 0x35, 0x4c, 0x4c, 0x4c, 0x64, 0x93, 0xe8, 0x1e, 0xd6, 0x24,
-	0x3a, 0x03, 0x8b, 0x7b, 0xdc, 0xe0, 0xda, 0xba, 0xa3, 0xcc, 0x91, 0xbc, 0x1a, 0xa8, 0x88, 0x2a,
-	0x83, 0xe0, 0x9d, 0xa3, 0x1a, 0x3a, 0xd6, 0xb1, 0xff, 0x28, 0x7b, 0x4f, 0xfc, 0xeb, 0xb6, 0x8e,
-	0xb1, 0xbe, 0x44, 0xb2, 0xff, 0xa6, 0xba, 0x0b, 0x59, 0xb1, 0x2e, 0xb9, 0xa9, 0x9d, 0x42, 0x90,
-	0xb3, 0xf1, 0x01, 0xdd, 0xdf, 0x05, 0x50, 0x1f, 0x13, 0x7d, 0xe4, 0x20, 0x85, 0xa2, 0x91, 0x6f,
-	0x81, 0x1f, 0x83, 0x1a, 0xc3, 0xcc, 0x08, 0x55, 0x28, 0x6a, 0x0a, 0x1d, 0x61, 0x7f, 0x6b, 0xd8,
-	0x90, 0xd8, 0x31, 0x52, 0x70, 0x8c, 0x74, 0x6c, 0x5d, 0x4e, 0xb6, 0x18, 0x72, 0xea, 0x01, 0xe1,
-	0xe7, 0xa0, 0xae, 0x61, 0x8b, 0x20, 0x8b, 0xb8, 0x84, 0xfb, 0x96, 0x73, 0x7c, 0x9f, 0x85, 0x60,
-	0xe6, 0xfe, 0x0a, 0x54, 0x89, 0xa1, 0x5b, 0xc8, 0x69, 0x6e, 0x74, 0x84, 0xfd, 0xcd, 0x09, 0x7f,
-	0x3b, 0xaa, 0xff, 0xf4, 0x5b, 0xbb, 0xf4, 0xe3, 0xfd, 0x55, 0x8f, 0x7f, 0xe8, 0x7e, 0x06, 0x5e,
-	0x27, 0x38, 0x4f, 0x10, 0xb1, 0xbd, 0x60, 0x70, 0x07, 0x6c, 0x72, 0xee, 0xc6, 0xdc, 0x27, 0xbe,
-	0x39, 0x79, 0x8b, 0x7d, 0x38, 0x9d, 0x1f, 0x55, 0xbc, 0x40, 0xdd, 0x5f, 0x98, 0xe4, 0xaf, 0xec,
-	0xf9, 0x83, 0xe4, 0x3c, 0x37, 0xf8, 0x29, 0x78, 0xc6, 0x8d, 0x26, 0x22, 0x44, 0xd1, 0xf3, 0x55,
-	0x3d, 0x65, 0xd8, 0x31, 0x83, 0x16, 0x17, 0xb5, 0xed, 0x8b, 0x8a, 0xb2, 0x0a, 0x44, 0x75, 0xff,
-	0x2c, 0x83, 0xb7, 0x7d, 0x9b, 0xdf, 0x0b, 0x45, 0x28, 0x27, 0x4b, 0x58, 0x7e, 0x83, 0x12, 0x6e,
-	0xfc, 0x8f, 0x12, 0xf6, 0x41, 0xc3, 0x76, 0x30, 0x5e, 0xcc, 0x78, 0xdf, 0xce, 0x58, 0xec, 0x66,
-	0xa5, 0x23, 0xec, 0xd7, 0x26, 0xd0, 0xb7, 0xc5, 0x65, 0x1c, 0x83, 0xdd, 0x84, 0x47, 0xe2, 0xf8,
-	0x27, 0xbe, 0xab, 0x18, 0x73, 0xcd, 0xea, 0x9b, 0x6a, 0x7e, 0x8a, 0x45, 0xd0, 0x4c, 0xa6, 0x31,
-	0xcc, 0xf1, 0xaf, 0x02, 0x78, 0x39, 0x26, 0xfa, 0xd4, 0x55, 0x4d, 0x83, 0x8e, 0x0d, 0xa2, 0xa2,
-	0x0b, 0x65, 0x65, 0x60, 0xd7, 0xc9, 0x4f, 0xf4, 0x21, 0xa8, 0x99, 0x11, 0x70, 0x6e, 0xa2, 0x63,
-	0xc8, 0xcc, 0xc6, 0x78, 0x9e, 0x60, 0xdd, 0x14, 0xba, 0x6d, 0xb0, 0x9b, 0x4a, 0x2d, 0x4a, 0xde,
-	0x6b, 0x90, 0x09, 0xd2, 0xf0, 0x0a, 0x39, 0x3c, 0xb3, 0x3d, 0xf0, 0x9c, 0xb8, 0xea, 0xb7, 0x48,
-	0xa3, 0xb3, 0x24, 0xff, 0x3a, 0x37, 0x8c, 0x02, 0x19, 0x7d, 0xd0, 0x20, 0xae, 0x4a, 0xa8, 0x41,
-	0x5d, 0x8a, 0x22, 0xf0, 0xb2, 0x0f, 0x87, 0x0f, 0xb6, 0xd0, 0xa3, 0x70, 0x5f, 0xb3, 0xa4, 0xc7,
-	0xa8, 0x85, 0xbc, 0xff, 0x60, 0x49, 0x3f, 0x3d, 0x19, 0x4d, 0xf1, 0x82, 0x7e, 0xa7, 0x38, 0x88,
-	0x17, 0x07, 0x7e, 0x04, 0x2a, 0xf6, 0x52, 0xb1, 0xf8, 0xee, 0x79, 0x47, 0x62, 0xeb, 0x51, 0x0a,
-	0xd6, 0x21, 0x5f, 0x8f, 0xd2, 0xd9, 0x52, 0xb1, 0x4e, 0x2a, 0xd7, 0x7f, 0xb7, 0x4b, 0x13, 0x1f,
-	0x0f, 0xbf, 0x04, 0x2f, 0x39, 0x66, 0x3e, 0x2b, 0x3c, 0x01, 0x2f, 0x02, 0x97, 0x51, 0x64, 0x12,
-	0xb2, 0x04, 0x6e, 0x45, 0xc5, 0xb1, 0xca, 0xac, 0xf3, 0x0f, 0x15, 0xd2, 0xc8, 0xae, 0x39, 0x53,
-	0x1c, 0xc5, 0x24, 0x91, 0xc0, 0x42, 0x34, 0x30, 0x3c, 0x04, 0x55, 0xdb, 0x47, 0x70, 0xae, 0xa2,
-	0xb4, 0xfe, 0x03, 0x91, 0x58, 0x0c, 0x2e, 0x99, 0xe3, 0xf3, 0x77, 0x09, 0xf3, 0x08, 0x09, 0x7d,
-	0x03, 0x5e, 0x8d, 0x89, 0xfe, 0x05, 0x5a, 0xa2, 0xa0, 0x98, 0xfe, 0x1e, 0xc5, 0x8f, 0xf4, 0xf9,
-	0x03, 0xe9, 0x72, 0x7e, 0xb9, 0x3b, 0xa0, 0x95, 0x1e, 0x3f, 0x60, 0x30, 0xfc, 0xb7, 0x0a, 0x36,
-	0xc6, 0x44, 0x87, 0xe7, 0xa0, 0x16, 0xfb, 0xed, 0xbc, 0x9b, 0xa6, 0x37, 0xb1, 0xe7, 0xc5, 0xf7,
-	0x0b, 0x80, 0xc2, 0x9f, 0xc1, 0x39, 0xa8, 0xc5, 0xb6, 0x7c, 0xd6, 0x09, 0x51, 0x50, 0xe6, 0x09,
-	0x69, 0x9b, 0x19, 0x6a, 0xe0, 0x69, 0x7c, 0x9d, 0xed, 0x65, 0x7a, 0x47, 0x50, 0xe2, 0x07, 0x45,
-	0x50, 0xe1, 0x21, 0x0e, 0x80, 0x29, 0x6b, 0xe9, 0xbd, 0x8c, 0x18, 0xeb, 0x50, 0x71, 0x50, 0x18,
-	0x1a, 0x15, 0x16, 0xdf, 0x26, 0x59, 0xc2, 0x62, 0xa8, 0x4c, 0x61, 0xa9, 0xe3, 0xef, 0x09, 0x4b,
-	0x19, 0xfd, 0x2c, 0x61, 0xeb, 0xd0, 0x4c, 0x61, 0xd9, 0x03, 0x09, 0x17, 0x00, 0x46, 0x2b, 0xc9,
-	0x67, 0x32, 0xbf, 0x33, 0x18, 0xe8, 0x91, 0xce, 0x88, 0xcf, 0x19, 0x74, 0xc1, 0x8b, 0xb4, 0x21,
-	0xeb, 0x65, 0xc4, 0x48, 0xc1, 0x8a, 0xc3, 0xe2, 0xd8, 0xe0, 0x58, 0xf1, 0xc9, 0x0f, 0xf7, 0x57,
-	0x3d, 0xe1, 0x64, 0x7a, 0x7d, 0xdb, 0x12, 0x6e, 0x6e, 0x5b, 0xc2, 0x3f, 0xb7, 0x2d, 0xe1, 0xe7,
-	0xbb, 0x56, 0xe9, 0xe6, 0xae, 0x55, 0xfa, 0xeb, 0xae, 0x55, 0xfa, 0xfa, 0x13, 0xdd, 0xa0, 0x17,
-	0xae, 0x2a, 0x69, 0xd8, 0x94, 0xf9, 0x9d, 0xd3, 0x50, 0xb5, 0x03, 0x1d, 0xcb, 0xab, 0x41, 0x5f,
-	0x36, 0xf1, 0xdc, 0x5d, 0x22, 0xc2, 0xae, 0x8c, 0xfd, 0xe1, 0x01, 0xbf, 0x35, 0xd2, 0x4b, 0x1b,
-	0x11, 0xb5, 0xea, 0x2f, 0xcd, 0x0f, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x85, 0x70, 0xb2, 0x01,
-	0xf6, 0x0a, 0x00, 0x00,
+	// 1019 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4f, 0x6f, 0xdc, 0xc4,
+	0x1b, 0x5e, 0x6f, 0xfe, 0x28, 0x99, 0x6c, 0xb3, 0xcd, 0x34, 0x6d, 0x37, 0xce, 0xaf, 0xbb, 0xd1,
+	0xfe, 0x8a, 0x54, 0x02, 0xb1, 0xb3, 0x41, 0x82, 0x52, 0xe0, 0xd0, 0x6c, 0x85, 0xa8, 0xc4, 0xa2,
+	0xc8, 0x11, 0x42, 0x70, 0x60, 0x6b, 0x7b, 0x67, 0x5d, 0xa3, 0xb5, 0xc7, 0xf2, 0x8c, 0x17, 0xc2,
+	0x09, 0x71, 0xe2, 0xc8, 0x81, 0x03, 0xdc, 0xb8, 0x73, 0xe9, 0x27, 0xe0, 0x86, 0xd4, 0x63, 0x8e,
+	0x9c, 0x00, 0x25, 0x87, 0x7e, 0x0d, 0xe4, 0x99, 0xb1, 0x63, 0x7b, 0x3d, 0xae, 0x11, 0xdc, 0xb2,
+	0x7e, 0x9f, 0xf7, 0xcf, 0xf3, 0xcc, 0xeb, 0x67, 0x62, 0xb0, 0xeb, 0x5a, 0xb6, 0x6e, 0xe3, 0x10,
+	0xe9, 0xf6, 0xcc, 0x45, 0x3e, 0xd5, 0xe7, 0x03, 0x9d, 0x7e, 0xa5, 0x05, 0x21, 0xa6, 0x18, 0x42,
+	0xd7, 0xb2, 0xb5, 0x38, 0xa8, 0xf1, 0xa0, 0x36, 0x1f, 0xa8, 0xb7, 0x6d, 0x4c, 0x3c, 0x4c, 0x74,
+	0x8f, 0x38, 0x31, 0xd6, 0x23, 0x0e, 0x07, 0xab, 0x77, 0x45, 0x20, 0x0a, 0x9c, 0xd0, 0x9c, 0x20,
+	0x7d, 0x3e, 0xb0, 0x10, 0x35, 0x07, 0xc9, 0x6f, 0x81, 0xda, 0x76, 0xb0, 0x83, 0xd9, 0x9f, 0x7a,
+	0xfc, 0x97, 0x78, 0xba, 0xe3, 0x60, 0xec, 0xcc, 0x90, 0xce, 0x7e, 0x59, 0xd1, 0x54, 0x37, 0xfd,
+	0x33, 0x11, 0xea, 0x16, 0x43, 0x93, 0x28, 0x34, 0xa9, 0x8b, 0x7d, 0x11, 0xef, 0x95, 0x10, 0x10,
+	0xd3, 0x32, 0x40, 0xff, 0xbc, 0x09, 0xda, 0x23, 0xe2, 0x0c, 0x43, 0x64, 0x52, 0x34, 0x64, 0x11,
+	0xf8, 0x16, 0x68, 0x71, 0xcc, 0x98, 0x50, 0x93, 0xa2, 0x8e, 0xb2, 0xa7, 0xdc, 0xdb, 0x38, 0xda,
+	0xd6, 0x78, 0x2f, 0x2d, 0xe9, 0xa5, 0x3d, 0xf4, 0xcf, 0x8c, 0x0d, 0x8e, 0x3c, 0x8d, 0x81, 0xf0,
+	0x3d, 0xd0, 0xb6, 0xb1, 0x4f, 0x90, 0x4f, 0x22, 0x22, 0x72, 0x9b, 0x15, 0xb9, 0x9b, 0x29, 0x98,
+	0xa7, 0xdf, 0x02, 0xab, 0xc4, 0x75, 0x7c, 0x14, 0x76, 0x96, 0xf6, 0x94, 0x7b, 0xeb, 0x86, 0xf8,
+	0x05, 0x3f, 0x02, 0xd7, 0x43, 0x44, 0x91, 0x1f, 0xf3, 0x1a, 0x07, 0x78, 0xe6, 0xda, 0x67, 0x9d,
+	0x65, 0x56, 0xf7, 0xff, 0xda, 0xe2, 0x19, 0x68, 0x46, 0x82, 0x3d, 0x61, 0x50, 0xa3, 0x1d, 0xe6,
+	0x1f, 0xc0, 0x0f, 0x41, 0x7b, 0xe6, 0xce, 0x91, 0x8f, 0x08, 0x19, 0x07, 0x28, 0x74, 0xf1, 0xa4,
+	0xb3, 0xc2, 0xca, 0xed, 0x2c, 0x8c, 0xf9, 0x48, 0xc8, 0x79, 0xbc, 0xf6, 0xfc, 0x8f, 0x5e, 0xe3,
+	0xc7, 0x3f, 0x7b, 0x8a, 0xb1, 0x99, 0xe4, 0x9e, 0xb0, 0xd4, 0x07, 0xed, 0xef, 0x7e, 0xee, 0x35,
+	0xbe, 0x7d, 0xf1, 0x6c, 0x5f, 0x8c, 0xdb, 0x7f, 0x17, 0xdc, 0x2e, 0x28, 0x6a, 0x20, 0x12, 0xc4,
+	0x54, 0xe1, 0x2e, 0x58, 0x17, 0xca, 0xba, 0x13, 0x26, 0xeb, 0xba, 0xb1, 0xc6, 0x1f, 0x3c, 0x9e,
+	0x3c, 0x58, 0x8e, 0x0b, 0xf5, 0x7f, 0x50, 0xd8, 0x81, 0x7c, 0x1c, 0x4c, 0xae, 0x0e, 0xa4, 0x2a,
+	0x0d, 0xbe, 0x03, 0x36, 0x45, 0xd0, 0x43, 0x84, 0x98, 0x4e, 0xb5, 0xe6, 0xd7, 0x38, 0x76, 0xc4,
+	0xa1, 0x32, 0xc9, 0x17, 0x49, 0xed, 0x30, 0x52, 0xd9, 0xa9, 0x12, 0x52, 0xfd, 0xdf, 0x9a, 0xe0,
+	0x3a, 0x8b, 0xb1, 0x4d, 0xae, 0x33, 0x72, 0x71, 0xc1, 0x9a, 0xff, 0x62, 0xc1, 0x96, 0xfe, 0xc1,
+	0x82, 0x1d, 0x82, 0xed, 0x20, 0xc4, 0x78, 0x3a, 0x16, 0x6f, 0xdd, 0x98, 0xd7, 0x66, 0xcb, 0xd4,
+	0x32, 0x20, 0x8b, 0xe5, 0x69, 0x3c, 0x04, 0x77, 0x0a, 0x19, 0x85, 0xf6, 0x2b, 0x2c, 0x55, 0xcd,
+	0xa5, 0xca, 0xb6, 0x7a, 0xb5, 0x5a, 0x62, 0x15, 0x74, 0x8a, 0x32, 0xa6, 0x1a, 0xff, 0xa4, 0x80,
+	0x9b, 0x23, 0xe2, 0x9c, 0x46, 0x96, 0xe7, 0xd2, 0x91, 0x4b, 0x2c, 0xf4, 0xd4, 0x9c, 0xbb, 0x38,
+	0x0a, 0xab, 0x85, 0xbe, 0x0f, 0x5a, 0x5e, 0x06, 0x5c, 0x29, 0x74, 0x0e, 0x29, 0x5d, 0x8c, 0xad,
+	0xc2, 0xd4, 0x1d, 0xa5, 0xdf, 0x03, 0x77, 0x4a, 0x47, 0xcb, 0x0e, 0x1f, 0x2f, 0x88, 0x81, 0x6c,
+	0x3c, 0x47, 0xa1, 0x50, 0x76, 0x1f, 0x6c, 0x91, 0xc8, 0xfa, 0x02, 0xd9, 0x74, 0x5c, 0x9c, 0xbf,
+	0x2d, 0x02, 0xc3, 0x84, 0xc6, 0x21, 0xd8, 0x26, 0x91, 0x45, 0xa8, 0x4b, 0x23, 0x8a, 0x32, 0xf0,
+	0x26, 0x83, 0xc3, 0xab, 0x58, 0x9a, 0x51, 0x7b, 0xaf, 0xb9, 0xe8, 0xb9, 0xd1, 0xd2, 0xb9, 0x7f,
+	0xe5, 0xa2, 0x3f, 0x3e, 0x1e, 0x9e, 0xe2, 0x29, 0xfd, 0xd2, 0x0c, 0x91, 0x38, 0x1c, 0xf8, 0x26,
+	0x58, 0x0e, 0x66, 0xa6, 0x2f, 0x9c, 0xf1, 0x7f, 0x1a, 0x37, 0x77, 0x2d, 0x31, 0x73, 0x61, 0xee,
+	0xda, 0xc9, 0xcc, 0xf4, 0x8f, 0x97, 0x63, 0xe7, 0x30, 0x18, 0x1e, 0x7e, 0x00, 0x6e, 0x0a, 0xcc,
+	0x64, 0x5c, 0xfb, 0x0d, 0xb8, 0x91, 0xa4, 0x0c, 0x33, 0x6f, 0x82, 0x8c, 0xe0, 0x46, 0x96, 0x1c,
+	0x3f, 0x99, 0xc5, 0xf9, 0x53, 0x86, 0x34, 0xe3, 0x35, 0x27, 0x66, 0x68, 0x7a, 0x24, 0x53, 0x58,
+	0xc9, 0x99, 0xf0, 0x7d, 0xb0, 0x1a, 0x30, 0x84, 0x98, 0x55, 0x2d, 0xb3, 0x5e, 0x5e, 0x43, 0x50,
+	0x16, 0xf8, 0x6a, 0x2f, 0xe1, 0x19, 0xe9, 0x40, 0x9f, 0x83, 0x5b, 0x23, 0xe2, 0x3c, 0x42, 0x33,
+	0x94, 0x1c, 0x26, 0xf3, 0x51, 0xfc, 0x92, 0x3d, 0xbf, 0x1a, 0xba, 0x59, 0x7d, 0xdc, 0x7b, 0xa0,
+	0x5b, 0x5e, 0x3f, 0x9d, 0xe0, 0x13, 0x26, 0xc9, 0xfb, 0x21, 0x42, 0x5f, 0xd7, 0xf2, 0xb2, 0xda,
+	0xad, 0x39, 0xeb, 0x6c, 0xe1, 0xb4, 0xe7, 0xa7, 0x60, 0x2b, 0x16, 0xc4, 0x9f, 0xfe, 0xf7, 0x5d,
+	0x77, 0xc1, 0xce, 0x42, 0xe9, 0xa4, 0xef, 0xd1, 0x2f, 0x6b, 0x60, 0x69, 0x44, 0x1c, 0xf8, 0x04,
+	0xb4, 0x72, 0xff, 0x00, 0x94, 0x5e, 0xab, 0x85, 0x3b, 0x4d, 0x7d, 0xad, 0x06, 0x28, 0xbd, 0xf8,
+	0x9e, 0x80, 0x56, 0xee, 0x46, 0x93, 0x75, 0xc8, 0x82, 0xa4, 0x1d, 0xca, 0x6e, 0x21, 0x68, 0x83,
+	0x6b, 0x79, 0xeb, 0xbe, 0x2b, 0xcd, 0xce, 0xa0, 0xd4, 0xd7, 0xeb, 0xa0, 0xd2, 0x26, 0x21, 0x80,
+	0x25, 0x16, 0xfc, 0xaa, 0xa4, 0xc6, 0x22, 0x54, 0x1d, 0xd4, 0x86, 0x66, 0x89, 0xe5, 0x9d, 0x53,
+	0x46, 0x2c, 0x87, 0x92, 0x12, 0x2b, 0xb5, 0xba, 0x98, 0x58, 0x89, 0xcd, 0xc9, 0x88, 0x2d, 0x42,
+	0xa5, 0xc4, 0xe4, 0xe6, 0x03, 0xa7, 0x00, 0x66, 0x4f, 0x52, 0xf8, 0x4f, 0xf5, 0x66, 0x70, 0xd0,
+	0x4b, 0x36, 0x23, 0xef, 0x29, 0x30, 0x02, 0x37, 0xca, 0x0c, 0x65, 0x5f, 0x52, 0xa3, 0x04, 0xab,
+	0x1e, 0xd5, 0xc7, 0x66, 0x57, 0x3e, 0xe7, 0x22, 0x32, 0x62, 0x59, 0x90, 0x94, 0x58, 0x99, 0x6d,
+	0xc0, 0x29, 0xd8, 0x2c, 0x78, 0xc6, 0x2b, 0x32, 0x5d, 0x72, 0x30, 0xf5, 0xa0, 0x16, 0x2c, 0xe9,
+	0xa3, 0xae, 0x7c, 0xf3, 0xe2, 0xd9, 0xbe, 0x72, 0x7c, 0xfa, 0xfc, 0xa2, 0xab, 0x9c, 0x5f, 0x74,
+	0x95, 0xbf, 0x2e, 0xba, 0xca, 0xf7, 0x97, 0xdd, 0xc6, 0xf9, 0x65, 0xb7, 0xf1, 0xfb, 0x65, 0xb7,
+	0xf1, 0xd9, 0xdb, 0x8e, 0x4b, 0x9f, 0x46, 0x96, 0x66, 0x63, 0x4f, 0x17, 0xdf, 0x39, 0xae, 0x65,
+	0x1f, 0x38, 0x58, 0x9f, 0x0f, 0x0e, 0x75, 0x0f, 0x4f, 0xa2, 0x19, 0x22, 0xfc, 0x33, 0xe4, 0xf0,
+	0xe8, 0x40, 0x7c, 0x89, 0xd0, 0xb3, 0x00, 0x11, 0x6b, 0x95, 0x5d, 0x75, 0x6f, 0xfc, 0x1d, 0x00,
+	0x00, 0xff, 0xff, 0xb0, 0x87, 0x13, 0x29, 0x6a, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -821,6 +999,10 @@ type MsgClient interface {
 	UpdateClientParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	// DeleteClientCreator defines a rpc handler method for MsgDeleteClientCreator.
 	DeleteClientCreator(ctx context.Context, in *MsgDeleteClientCreator, opts ...grpc.CallOption) (*MsgDeleteClientCreatorResponse, error)
+	// FreezeClient defines a rpc handler method for MsgFreezeClient.
+	FreezeClient(ctx context.Context, in *MsgFreezeClient, opts ...grpc.CallOption) (*MsgFreezeClientResponse, error)
+	// UnfreezeClient defines a rpc handler method for MsgUnfreezeClient.
+	UnfreezeClient(ctx context.Context, in *MsgUnfreezeClient, opts ...grpc.CallOption) (*MsgUnfreezeClientResponse, error)
 }
 
 type msgClient struct {
@@ -903,6 +1085,24 @@ func (c *msgClient) DeleteClientCreator(ctx context.Context, in *MsgDeleteClient
 	return out, nil
 }
 
+func (c *msgClient) FreezeClient(ctx context.Context, in *MsgFreezeClient, opts ...grpc.CallOption) (*MsgFreezeClientResponse, error) {
+	out := new(MsgFreezeClientResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Msg/FreezeClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnfreezeClient(ctx context.Context, in *MsgUnfreezeClient, opts ...grpc.CallOption) (*MsgUnfreezeClientResponse, error) {
+	out := new(MsgUnfreezeClientResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Msg/UnfreezeClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateClient defines a rpc handler method for MsgCreateClient.
@@ -921,6 +1121,10 @@ type MsgServer interface {
 	UpdateClientParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	// DeleteClientCreator defines a rpc handler method for MsgDeleteClientCreator.
 	DeleteClientCreator(context.Context, *MsgDeleteClientCreator) (*MsgDeleteClientCreatorResponse, error)
+	// FreezeClient defines a rpc handler method for MsgFreezeClient.
+	FreezeClient(context.Context, *MsgFreezeClient) (*MsgFreezeClientResponse, error)
+	// UnfreezeClient defines a rpc handler method for MsgUnfreezeClient.
+	UnfreezeClient(context.Context, *MsgUnfreezeClient) (*MsgUnfreezeClientResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -951,6 +1155,12 @@ func (*UnimplementedMsgServer) UpdateClientParams(ctx context.Context, req *MsgU
 func (*UnimplementedMsgServer) DeleteClientCreator(ctx context.Context, req *MsgDeleteClientCreator) (*MsgDeleteClientCreatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteClientCreator not implemented")
 }
+func (*UnimplementedMsgServer) FreezeClient(ctx context.Context, req *MsgFreezeClient) (*MsgFreezeClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeClient not implemented")
+}
+func (*UnimplementedMsgServer) UnfreezeClient(ctx context.Context, req *MsgUnfreezeClient) (*MsgUnfreezeClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeClient not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1100,6 +1310,42 @@ func _Msg_DeleteClientCreator_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_FreezeClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreezeClient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FreezeClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.client.v1.Msg/FreezeClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FreezeClient(ctx, req.(*MsgFreezeClient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnfreezeClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnfreezeClient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnfreezeClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.client.v1.Msg/UnfreezeClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnfreezeClient(ctx, req.(*MsgUnfreezeClient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.core.client.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1136,6 +1382,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteClientCreator",
 			Handler:    _Msg_DeleteClientCreator_Handler,
 		},
+		{
+			MethodName: "FreezeClient",
+			Handler:    _Msg_FreezeClient_Handler,
+		},
+		{
+			MethodName: "UnfreezeClient",
+			Handler:    _Msg_UnfreezeClient_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/core/client/v1/tx.proto",
@@ -1161,6 +1415,26 @@ func (m *MsgCreateClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.LivenessPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LivenessPeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if m.RetentionPolicy != nil {
+		{
+			size, err := m.RetentionPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Signer) > 0 {
 		i -= len(m.Signer)
 		copy(dAtA[i:], m.Signer)
@@ -1732,6 +2006,126 @@ func (m *MsgDeleteClientCreatorResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgFreezeClient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeClient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezeClientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeClientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnfreezeClient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnfreezeClient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnfreezeClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnfreezeClientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnfreezeClientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnfreezeClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1761,6 +2155,12 @@ func (m *MsgCreateClient) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.RetentionPolicy != nil {
+		l = m.RetentionPolicy.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LivenessPeriod)
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -1987,6 +2387,58 @@ func (m *MsgDeleteClientCreatorResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgFreezeClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFreezeClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnfreezeClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnfreezeClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2126,6 +2578,75 @@ func (m *MsgCreateClient) Unmarshal(dAtA []byte) error {
 			}
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetentionPolicy == nil {
+				m.RetentionPolicy = &RetentionPolicy{}
+			}
+			if err := m.RetentionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LivenessPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.LivenessPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3659,6 +4180,334 @@ func (m *MsgDeleteClientCreatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgFreezeClient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFreezeClient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFreezeClient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFreezeClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFreezeClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFreezeClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnfreezeClient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnfreezeClient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnfreezeClient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnfreezeClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnfreezeClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnfreezeClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,6 +5,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	clientv1keeper "github.com/cosmos/ibc-go/v10/modules/core/02-client/keeper"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v10/modules/core/02-client/v2/types"
 )
 
@@ -44,20 +45,29 @@ func (k *Keeper) GetClientCounterparty(ctx sdk.Context, clientID string) (types.
 }
 
 // GetConfig returns the ibc-client v2 configuration for the given clientID.
+// The retention policy and liveness period are read from the 02-client keeper which enforces them.
 func (k *Keeper) GetConfig(ctx sdk.Context, clientID string) types.Config {
+	config := types.NewConfig()
+
 	store := k.ClientV1Keeper.ClientStore(ctx, clientID)
-	bz := store.Get(types.ConfigKey())
-	if len(bz) == 0 {
-		return types.NewConfig()
+	if bz := store.Get(types.ConfigKey()); len(bz) != 0 {
+		k.cdc.MustUnmarshal(bz, &config)
 	}
 
-	var config types.Config
-	k.cdc.MustUnmarshal(bz, &config)
+	config.RetentionPolicy = k.ClientV1Keeper.GetRetentionPolicy(ctx, clientID)
+	config.LivenessPeriod = k.ClientV1Keeper.GetLivenessPeriod(ctx, clientID)
 	return config
 }
 
 // SetConfig sets ibc-client v2 configuration for the given clientID.
+// The retention policy and liveness period are stored by the 02-client keeper which enforces them.
 func (k *Keeper) SetConfig(ctx sdk.Context, clientID string, config types.Config) {
+	k.ClientV1Keeper.SetRetentionPolicy(ctx, clientID, config.RetentionPolicy)
+	config.RetentionPolicy = clienttypes.RetentionPolicy{}
+
+	k.ClientV1Keeper.SetLivenessPeriod(ctx, clientID, config.LivenessPeriod)
+	config.LivenessPeriod = 0
+
 	store := k.ClientV1Keeper.ClientStore(ctx, clientID)
 	bz := k.cdc.MustMarshal(&config)
 	store.Set(types.ConfigKey(), bz)
@@ -2,12 +2,14 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	testifysuite "github.com/stretchr/testify/suite"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v10/modules/core/02-client/v2/keeper"
 	"github.com/cosmos/ibc-go/v10/modules/core/02-client/v2/types"
 	ibctesting "github.com/cosmos/ibc-go/v10/testing"
@@ -84,3 +86,44 @@ func (suite *KeeperTestSuite) TestSetConfig() {
 	config = suite.keeper.GetConfig(suite.ctx, testClientID)
 	suite.Require().Equal(newConfig, config, "config not set correctly for original clientID")
 }
+
+func (suite *KeeperTestSuite) TestSetConfigRetentionPolicy() {
+	retentionPolicy := clienttypes.NewRetentionPolicy(10, time.Hour)
+	newConfig := types.NewConfig(ibctesting.TestAccAddress)
+	newConfig.RetentionPolicy = retentionPolicy
+	suite.keeper.SetConfig(suite.ctx, testClientID, newConfig)
+
+	config := suite.keeper.GetConfig(suite.ctx, testClientID)
+	suite.Require().Equal(newConfig, config, "config not set correctly")
+
+	// the retention policy is stored by the client keeper
+	suite.Require().Equal(retentionPolicy, suite.keeper.ClientV1Keeper.GetRetentionPolicy(suite.ctx, testClientID))
+
+	// setting a config without a retention policy removes the retention policy of the client
+	newConfig = types.NewConfig(ibctesting.TestAccAddress)
+	suite.keeper.SetConfig(suite.ctx, testClientID, newConfig)
+
+	config = suite.keeper.GetConfig(suite.ctx, testClientID)
+	suite.Require().Equal(newConfig, config, "config not set correctly")
+	suite.Require().True(suite.keeper.ClientV1Keeper.GetRetentionPolicy(suite.ctx, testClientID).IsEmpty())
+}
+
+func (suite *KeeperTestSuite) TestSetConfigLivenessPeriod() {
+	newConfig := types.NewConfig(ibctesting.TestAccAddress)
+	newConfig.LivenessPeriod = time.Hour
+	suite.keeper.SetConfig(suite.ctx, testClientID, newConfig)
+
+	config := suite.keeper.GetConfig(suite.ctx, testClientID)
+	suite.Require().Equal(newConfig, config, "config not set correctly")
+
+	// the liveness period is stored by the client keeper
+	suite.Require().Equal(time.Hour, suite.keeper.ClientV1Keeper.GetLivenessPeriod(suite.ctx, testClientID))
+
+	// setting a config without a liveness period removes the liveness period of the client
+	newConfig = types.NewConfig(ibctesting.TestAccAddress)
+	suite.keeper.SetConfig(suite.ctx, testClientID, newConfig)
+
+	config = suite.keeper.GetConfig(suite.ctx, testClientID)
+	suite.Require().Equal(newConfig, config, "config not set correctly")
+	suite.Require().Zero(suite.keeper.ClientV1Keeper.GetLivenessPeriod(suite.ctx, testClientID))
+}
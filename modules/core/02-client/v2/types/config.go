@@ -2,8 +2,12 @@ package types
 
 import (
 	fmt "fmt"
+	"slices"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
 )
 
 // Maximum length of the allowed relayers list
@@ -21,9 +25,22 @@ func DefaultConfig() Config {
 	return NewConfig()
 }
 
-// Validate ensures all provided addresses are valid sdk Addresses
+// Validate ensures all provided addresses are valid sdk Addresses, that the ordered ports are valid and unique
+// port identifiers and that the retention policy and liveness period are valid
 func (c Config) Validate() error {
-	return validateRelayers(c.AllowedRelayers)
+	if err := validateRelayers(c.AllowedRelayers); err != nil {
+		return err
+	}
+
+	if err := validateOrderedPorts(c.OrderedPorts); err != nil {
+		return err
+	}
+
+	if err := c.RetentionPolicy.Validate(); err != nil {
+		return err
+	}
+
+	return clienttypes.ValidateLivenessPeriod(c.LivenessPeriod)
 }
 
 // IsAllowedRelayer checks if the given address is registered on the allowlist.
@@ -39,6 +56,12 @@ func (c Config) IsAllowedRelayer(relayer sdk.AccAddress) bool {
 	return false
 }
 
+// IsOrderedPort checks if packets received on the given port are delivered in order and if packets sent to the
+// given port are assigned a port sequence.
+func (c Config) IsOrderedPort(portID string) bool {
+	return slices.Contains(c.OrderedPorts, portID)
+}
+
 func validateRelayers(allowedRelayers []string) error {
 	if len(allowedRelayers) > MaxAllowedRelayersLength {
 		return fmt.Errorf("allowed relayers length must not exceed %d items", MaxAllowedRelayersLength)
@@ -51,3 +74,18 @@ func validateRelayers(allowedRelayers []string) error {
 	}
 	return nil
 }
+
+func validateOrderedPorts(orderedPorts []string) error {
+	seen := make(map[string]struct{}, len(orderedPorts))
+	for _, portID := range orderedPorts {
+		if err := host.PortIdentifierValidator(portID); err != nil {
+			return fmt.Errorf("invalid ordered port %s: %w", portID, err)
+		}
+
+		if _, found := seen[portID]; found {
+			return fmt.Errorf("duplicate ordered port: %s", portID)
+		}
+		seen[portID] = struct{}{}
+	}
+	return nil
+}
@@ -5,16 +5,22 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	types "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -29,6 +35,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Config struct {
 	// allowed_relayers defines the set of allowed relayers for IBC V2 protocol for the given client
 	AllowedRelayers []string `protobuf:"bytes,1,rep,name=allowed_relayers,json=allowedRelayers,proto3" json:"allowed_relayers,omitempty"`
+	// retention_policy defines which consensus states of the client are retained
+	RetentionPolicy types.RetentionPolicy `protobuf:"bytes,2,opt,name=retention_policy,json=retentionPolicy,proto3" json:"retention_policy"`
+	// liveness_period defines the duration after which the client is considered inactive if it has not been updated
+	LivenessPeriod time.Duration `protobuf:"bytes,3,opt,name=liveness_period,json=livenessPeriod,proto3,stdduration" json:"liveness_period"`
+	// ordered_ports defines the ports of the client on which IBC v2 packets are received in increasing sequence order.
+	// Packets sent over the client to one of these ports are assigned a port sequence, the port must therefore be
+	// ordered on the clients of both chains before packets are sent to it.
+	OrderedPorts []string `protobuf:"bytes,4,rep,name=ordered_ports,json=orderedPorts,proto3" json:"ordered_ports,omitempty"`
 }
 
 func (m *Config) Reset()         { *m = Config{} }
@@ -71,6 +85,27 @@ func (m *Config) GetAllowedRelayers() []string {
 	return nil
 }
 
+func (m *Config) GetRetentionPolicy() types.RetentionPolicy {
+	if m != nil {
+		return m.RetentionPolicy
+	}
+	return types.RetentionPolicy{}
+}
+
+func (m *Config) GetLivenessPeriod() time.Duration {
+	if m != nil {
+		return m.LivenessPeriod
+	}
+	return 0
+}
+
+func (m *Config) GetOrderedPorts() []string {
+	if m != nil {
+		return m.OrderedPorts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Config)(nil), "ibc.core.client.v2.Config")
 }
@@ -78,20 +113,29 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/client/v2/config.proto", fileDescriptor_e89b8f1b1dcb51cb) }
 
 var fileDescriptor_e89b8f1b1dcb51cb = []byte{
-	// 194 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0x4c, 0x4a, 0xd6,
-	0x4f, 0xce, 0x2f, 0x4a, 0xd5, 0x4f, 0xce, 0xc9, 0x4c, 0xcd, 0x2b, 0xd1, 0x2f, 0x33, 0xd2, 0x4f,
-	0xce, 0xcf, 0x4b, 0xcb, 0x4c, 0xd7, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xca, 0x4c, 0x4a,
-	0xd6, 0x03, 0x29, 0xd0, 0x83, 0x28, 0xd0, 0x2b, 0x33, 0x52, 0x32, 0xe6, 0x62, 0x73, 0x06, 0xab,
-	0x11, 0xd2, 0xe4, 0x12, 0x48, 0xcc, 0xc9, 0xc9, 0x2f, 0x4f, 0x4d, 0x89, 0x2f, 0x4a, 0xcd, 0x49,
-	0xac, 0x4c, 0x2d, 0x2a, 0x96, 0x60, 0x54, 0x60, 0xd6, 0xe0, 0x0c, 0xe2, 0x87, 0x8a, 0x07, 0x41,
-	0x85, 0x9d, 0xc2, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6,
-	0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x26, 0x3d,
-	0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x3f, 0x39, 0xbf, 0x38, 0x37, 0xbf, 0x58,
-	0x3f, 0x33, 0x29, 0x59, 0x37, 0x3d, 0x5f, 0xbf, 0xcc, 0xd0, 0x40, 0x3f, 0x37, 0x3f, 0xa5, 0x34,
-	0x27, 0xb5, 0x18, 0xe2, 0x48, 0x03, 0x23, 0x5d, 0x84, 0x3b, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93,
-	0xd8, 0xc0, 0xee, 0x34, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xd4, 0x51, 0xba, 0xd2, 0xca, 0x00,
-	0x00, 0x00,
+	// 350 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xbd, 0x4e, 0xeb, 0x40,
+	0x10, 0x85, 0xed, 0x9b, 0x28, 0xba, 0x98, 0x9f, 0x44, 0x16, 0x85, 0x49, 0xe1, 0x44, 0xa4, 0x09,
+	0x45, 0x76, 0x13, 0xd3, 0x52, 0x05, 0x4a, 0x8a, 0xc8, 0x42, 0x14, 0x34, 0x56, 0xbc, 0x9e, 0x98,
+	0x95, 0x36, 0x1e, 0x6b, 0xd7, 0x36, 0xca, 0x5b, 0x50, 0xf2, 0x48, 0x29, 0x53, 0x52, 0x01, 0x4a,
+	0x5e, 0x83, 0x02, 0x79, 0xed, 0x08, 0xa1, 0x74, 0xe3, 0x33, 0xdf, 0xcc, 0x99, 0xe3, 0xb5, 0x7a,
+	0x3c, 0x64, 0x94, 0xa1, 0x04, 0xca, 0x04, 0x87, 0x24, 0xa3, 0x85, 0x47, 0x19, 0x26, 0x0b, 0x1e,
+	0x93, 0x54, 0x62, 0x86, 0xb6, 0xcd, 0x43, 0x46, 0x4a, 0x80, 0x54, 0x00, 0x29, 0xbc, 0xee, 0x79,
+	0x8c, 0x31, 0xea, 0x36, 0x2d, 0xab, 0x8a, 0xec, 0xba, 0x31, 0x62, 0x2c, 0x80, 0xea, 0xaf, 0x30,
+	0x5f, 0xd0, 0x28, 0x97, 0xf3, 0x8c, 0x63, 0x52, 0xf7, 0x0f, 0xad, 0x26, 0x75, 0x55, 0x01, 0x97,
+	0xdf, 0xa6, 0xd5, 0xba, 0xd5, 0xde, 0xf6, 0x95, 0xd5, 0x99, 0x0b, 0x81, 0x2f, 0x10, 0x05, 0x12,
+	0xc4, 0x7c, 0x05, 0x52, 0x39, 0x66, 0xbf, 0x31, 0x3c, 0xf2, 0xdb, 0xb5, 0xee, 0xd7, 0xb2, 0xfd,
+	0x60, 0x75, 0x24, 0x64, 0x90, 0x94, 0x4e, 0x41, 0x8a, 0x82, 0xb3, 0x95, 0xf3, 0xaf, 0x6f, 0x0e,
+	0x8f, 0xbd, 0x01, 0x39, 0xb8, 0x7d, 0x42, 0xfc, 0x3d, 0x3b, 0xd3, 0xe8, 0xb4, 0xb9, 0xfe, 0xe8,
+	0x19, 0x7e, 0x5b, 0xfe, 0x95, 0xed, 0x7b, 0xab, 0x2d, 0x78, 0x01, 0x09, 0x28, 0x15, 0xa4, 0x20,
+	0x39, 0x46, 0x4e, 0x43, 0x2f, 0xbd, 0x20, 0x55, 0x4c, 0xb2, 0x8f, 0x49, 0xee, 0xea, 0x98, 0xd3,
+	0xff, 0xe5, 0xaa, 0xb7, 0xcf, 0x9e, 0xe9, 0x9f, 0xed, 0x67, 0x67, 0x7a, 0xd4, 0x1e, 0x58, 0xa7,
+	0x28, 0x23, 0x90, 0x10, 0x05, 0x29, 0xca, 0x4c, 0x39, 0x4d, 0x9d, 0xe5, 0xa4, 0x16, 0x67, 0xa5,
+	0x36, 0x7d, 0x5c, 0x6f, 0x5d, 0x73, 0xb3, 0x75, 0xcd, 0xaf, 0xad, 0x6b, 0xbe, 0xee, 0x5c, 0x63,
+	0xb3, 0x73, 0x8d, 0xf7, 0x9d, 0x6b, 0x3c, 0xdd, 0xc4, 0x3c, 0x7b, 0xce, 0x43, 0xc2, 0x70, 0x49,
+	0x19, 0xaa, 0x25, 0x2a, 0xca, 0x43, 0x36, 0x8a, 0x91, 0x16, 0x93, 0x31, 0x5d, 0x62, 0x94, 0x0b,
+	0x50, 0xd5, 0xaf, 0x1d, 0x7b, 0xa3, 0xdf, 0x87, 0xcc, 0x56, 0x29, 0xa8, 0xb0, 0xa5, 0x2f, 0xbd,
+	0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0xcf, 0x9e, 0x7d, 0xc3, 0xeb, 0x01, 0x00, 0x00,
 }
 
 func (m *Config) Marshal() (dAtA []byte, err error) {
@@ -114,6 +158,33 @@ func (m *Config) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OrderedPorts) > 0 {
+		for iNdEx := len(m.OrderedPorts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OrderedPorts[iNdEx])
+			copy(dAtA[i:], m.OrderedPorts[iNdEx])
+			i = encodeVarintConfig(dAtA, i, uint64(len(m.OrderedPorts[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.LivenessPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LivenessPeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintConfig(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.RetentionPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintConfig(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.AllowedRelayers) > 0 {
 		for iNdEx := len(m.AllowedRelayers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedRelayers[iNdEx])
@@ -149,6 +220,16 @@ func (m *Config) Size() (n int) {
 			n += 1 + l + sovConfig(uint64(l))
 		}
 	}
+	l = m.RetentionPolicy.Size()
+	n += 1 + l + sovConfig(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LivenessPeriod)
+	n += 1 + l + sovConfig(uint64(l))
+	if len(m.OrderedPorts) > 0 {
+		for _, s := range m.OrderedPorts {
+			l = len(s)
+			n += 1 + l + sovConfig(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -219,6 +300,104 @@ func (m *Config) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowedRelayers = append(m.AllowedRelayers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RetentionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LivenessPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.LivenessPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderedPorts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderedPorts = append(m.OrderedPorts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConfig(dAtA[iNdEx:])
@@ -3,12 +3,15 @@ package types_test
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v10/modules/core/02-client/v2/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
 	ibctesting "github.com/cosmos/ibc-go/v10/testing"
 )
 
@@ -70,6 +73,36 @@ func TestValidateConfig(t *testing.T) {
 			config: types.NewConfig("invalidAddress", ibctesting.TestAccAddress),
 			expErr: errors.New("invalid relayer address"),
 		},
+		{
+			name:   "config with retention policy",
+			config: types.Config{RetentionPolicy: clienttypes.NewRetentionPolicy(10, time.Hour)},
+			expErr: nil,
+		},
+		{
+			name:   "invalid retention policy",
+			config: types.Config{RetentionPolicy: clienttypes.NewRetentionPolicy(10, -time.Hour)},
+			expErr: clienttypes.ErrInvalidRetentionPolicy,
+		},
+		{
+			name:   "config with ordered ports",
+			config: types.Config{OrderedPorts: []string{ibctesting.MockPort, ibctesting.TransferPort}},
+			expErr: nil,
+		},
+		{
+			name:   "invalid ordered port",
+			config: types.Config{OrderedPorts: []string{"(invalidport)"}},
+			expErr: host.ErrInvalidID,
+		},
+		{
+			name:   "duplicate ordered port",
+			config: types.Config{OrderedPorts: []string{ibctesting.MockPort, ibctesting.MockPort}},
+			expErr: errors.New("duplicate ordered port"),
+		},
+		{
+			name:   "invalid liveness period",
+			config: types.Config{LivenessPeriod: -time.Hour},
+			expErr: clienttypes.ErrInvalidLivenessPeriod,
+		},
 	}
 
 	for _, tc := range testCases {
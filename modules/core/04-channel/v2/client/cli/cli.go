@@ -20,6 +20,7 @@ func GetQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(
 		getCmdQueryNextSequenceSend(),
+		getCmdQueryNextSequenceReceive(),
 		getCmdQueryPacketCommitment(),
 		getCmdQueryPacketCommitments(),
 		getCmdQueryPacketAcknowledgement(),
@@ -64,6 +64,53 @@ func getCmdQueryNextSequenceSend() *cobra.Command {
 	return cmd
 }
 
+// getCmdQueryNextSequenceReceive defines the command to query a next receive sequence for a given client and port
+func getCmdQueryNextSequenceReceive() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "next-sequence-receive [client-id] [port-id]",
+		Short: "Query a next receive sequence",
+		Long:  "Query the next sequence receive for a given client and ordered port",
+		Example: fmt.Sprintf(
+			"%s query %s %s next-sequence-receive [client-id] [port-id]", version.AppName, exported.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			clientID := args[0]
+			portID := args[1]
+			prove, err := cmd.Flags().GetBool(flags.FlagProve)
+			if err != nil {
+				return err
+			}
+
+			if prove {
+				res, err := queryNextSequenceReceiveABCI(clientCtx, clientID, portID)
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.NextSequenceReceive(cmd.Context(), types.NewQueryNextSequenceReceiveRequest(clientID, portID))
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(flags.FlagProve, true, "show proofs for the query results")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func getCmdQueryPacketCommitment() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "packet-commitment [client-id] [sequence]",
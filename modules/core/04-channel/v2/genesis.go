@@ -39,16 +39,28 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, gs types.GenesisState) {
 	for _, seq := range gs.SendSequences {
 		k.SetNextSequenceSend(ctx, seq.ClientId, seq.Sequence)
 	}
+
+	// set recv sequences of ordered ports
+	for _, seq := range gs.RecvSequences {
+		k.SetNextSequenceRecv(ctx, seq.ClientId, seq.PortId, seq.Sequence)
+	}
+
+	// set port send sequences
+	for _, seq := range gs.PortSendSequences {
+		k.SetNextPortSequenceSend(ctx, seq.ClientId, seq.PortId, seq.Sequence)
+	}
 }
 
 func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) types.GenesisState {
 	clientStates := k.ClientKeeper.GetAllGenesisClients(ctx)
 	gs := types.GenesisState{
-		Acknowledgements: make([]types.PacketState, 0),
-		Commitments:      make([]types.PacketState, 0),
-		Receipts:         make([]types.PacketState, 0),
-		AsyncPackets:     make([]types.PacketState, 0),
-		SendSequences:    make([]types.PacketSequence, 0),
+		Acknowledgements:  make([]types.PacketState, 0),
+		Commitments:       make([]types.PacketState, 0),
+		Receipts:          make([]types.PacketState, 0),
+		AsyncPackets:      make([]types.PacketState, 0),
+		SendSequences:     make([]types.PacketSequence, 0),
+		RecvSequences:     make([]types.PortSequence, 0),
+		PortSendSequences: make([]types.PortSequence, 0),
 	}
 	for _, clientState := range clientStates {
 		acks := k.GetAllPacketAcknowledgementsForClient(ctx, clientState.ClientId)
@@ -67,6 +79,12 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) types.GenesisState {
 		if ok {
 			gs.SendSequences = append(gs.SendSequences, types.NewPacketSequence(clientState.ClientId, seq))
 		}
+
+		recvSeqs := k.GetAllNextSequenceRecvsForClient(ctx, clientState.ClientId)
+		gs.RecvSequences = append(gs.RecvSequences, recvSeqs...)
+
+		portSendSeqs := k.GetAllNextPortSequenceSendsForClient(ctx, clientState.ClientId)
+		gs.PortSendSequences = append(gs.PortSendSequences, portSendSeqs...)
 	}
 
 	return gs
@@ -29,6 +29,8 @@ func (suite *ModuleTestSuite) TestInitExportGenesis() {
 		receipt := types.NewPacketState(clientState.ClientId, uint64(i+1), []byte{byte(0x2)})
 		commitment := types.NewPacketState(clientState.ClientId, uint64(i+1), []byte("commit_hash"))
 		seq := types.NewPacketSequence(clientState.ClientId, uint64(i+1))
+		recvSeq := types.NewPortSequence(clientState.ClientId, mockv2.ModuleNameB, uint64(i+1))
+		portSendSeq := types.NewPortSequence(clientState.ClientId, mockv2.ModuleNameB, uint64(i+1))
 
 		packet := types.NewPacket(
 			uint64(i+1),
@@ -45,6 +47,8 @@ func (suite *ModuleTestSuite) TestInitExportGenesis() {
 		validGs.Receipts = append(validGs.Receipts, receipt)
 		validGs.Commitments = append(validGs.Commitments, commitment)
 		validGs.SendSequences = append(validGs.SendSequences, seq)
+		validGs.RecvSequences = append(validGs.RecvSequences, recvSeq)
+		validGs.PortSendSequences = append(validGs.PortSendSequences, portSendSeq)
 		validGs.AsyncPackets = append(validGs.AsyncPackets, asyncPacket)
 		emptyGenesis.SendSequences = append(emptyGenesis.SendSequences, seq)
 	}
@@ -17,12 +17,13 @@ func (k *Keeper) SendPacketTest(
 	timeoutTimestamp uint64,
 	payloads []types.Payload,
 ) (uint64, string, error) {
-	return k.sendPacket(
+	packet, err := k.sendPacket(
 		ctx,
 		sourceChannel,
 		timeoutTimestamp,
 		payloads,
 	)
+	return packet.Sequence, packet.DestinationClient, err
 }
 
 func (k *Keeper) RecvPacketTest(
@@ -31,12 +32,14 @@ func (k *Keeper) RecvPacketTest(
 	proof []byte,
 	proofHeight exported.Height,
 ) error {
-	return k.recvPacket(
+	_, err := k.recvPacket(
 		ctx,
+		k.clientV2Keeper.GetConfig(ctx, packet.DestinationClient),
 		packet,
 		proof,
 		proofHeight,
 	)
+	return err
 }
 
 func (k *Keeper) AcknowledgePacketTest(
@@ -60,11 +63,13 @@ func (k *Keeper) TimeoutPacketTest(
 	packet types.Packet,
 	proof []byte,
 	proofHeight exported.Height,
+	nextSequenceRecv uint64,
 ) error {
 	return k.timeoutPacket(
 		ctx,
 		packet,
 		proof,
 		proofHeight,
+		nextSequenceRecv,
 	)
 }
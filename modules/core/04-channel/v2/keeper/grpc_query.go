@@ -56,6 +56,32 @@ func (q *queryServer) NextSequenceSend(goCtx context.Context, req *types.QueryNe
 	return types.NewQueryNextSequenceSendResponse(sequence, nil, clienttypes.GetSelfHeight(ctx)), nil
 }
 
+// NextSequenceReceive implements the Query/NextSequenceReceive gRPC method
+func (q *queryServer) NextSequenceReceive(goCtx context.Context, req *types.QueryNextSequenceReceiveRequest) (*types.QueryNextSequenceReceiveResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sequence, found := q.GetNextSequenceRecv(ctx, req.ClientId, req.PortId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrSequenceReceiveNotFound, "client-id %s, port-id %s", req.ClientId, req.PortId).Error(),
+		)
+	}
+	return types.NewQueryNextSequenceReceiveResponse(sequence, nil, clienttypes.GetSelfHeight(ctx)), nil
+}
+
 // PacketCommitment implements the Query/PacketCommitment gRPC method.
 func (q *queryServer) PacketCommitment(goCtx context.Context, req *types.QueryPacketCommitmentRequest) (*types.QueryPacketCommitmentResponse, error) {
 	if req == nil {
@@ -564,6 +564,83 @@ func (suite *KeeperTestSuite) TestQueryNextSequenceSend() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryNextSequenceReceive() {
+	var (
+		req    *types.QueryNextSequenceReceiveRequest
+		expSeq uint64
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.Setup()
+
+				expSeq = 42
+				seq := uint64(42)
+				suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.SetNextSequenceRecv(suite.chainA.GetContext(), path.EndpointA.ClientID, ibctesting.MockPort, seq)
+				req = types.NewQueryNextSequenceReceiveRequest(path.EndpointA.ClientID, ibctesting.MockPort)
+			},
+			nil,
+		},
+		{
+			"req is nil",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"invalid client ID",
+			func() {
+				req = types.NewQueryNextSequenceReceiveRequest("", ibctesting.MockPort)
+			},
+			status.Error(codes.InvalidArgument, "identifier cannot be blank: invalid identifier"),
+		},
+		{
+			"invalid port ID",
+			func() {
+				req = types.NewQueryNextSequenceReceiveRequest(ibctesting.FirstClientID, "")
+			},
+			status.Error(codes.InvalidArgument, "identifier cannot be blank: invalid identifier"),
+		},
+		{
+			"sequence receive not found",
+			func() {
+				req = types.NewQueryNextSequenceReceiveRequest(ibctesting.FirstClientID, ibctesting.MockPort)
+			},
+			status.Error(codes.NotFound, fmt.Sprintf("client-id %s, port-id %s: sequence receive not found", ibctesting.FirstClientID, ibctesting.MockPort)),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			queryServer := keeper.NewQueryServer(suite.chainA.App.GetIBCKeeper().ChannelKeeperV2)
+			res, err := queryServer.NextSequenceReceive(ctx, req)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expSeq, res.NextSequenceReceive)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryUnreceivedPackets() {
 	var (
 		expSeq []uint64
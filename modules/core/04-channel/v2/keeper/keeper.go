@@ -169,6 +169,51 @@ func (k *Keeper) SetNextSequenceSend(ctx sdk.Context, clientID string, sequence
 	}
 }
 
+// GetNextPortSequenceSend returns the next port send sequence of a destination port of a client from the sequence path
+func (k *Keeper) GetNextPortSequenceSend(ctx sdk.Context, clientID, portID string) (uint64, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(hostv2.NextPortSequenceSendKey(clientID, portID))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// SetNextPortSequenceSend writes the next port send sequence of a destination port of a client under the sequence path
+func (k *Keeper) SetNextPortSequenceSend(ctx sdk.Context, clientID, portID string, sequence uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	bigEndianBz := sdk.Uint64ToBigEndian(sequence)
+	if err := store.Set(hostv2.NextPortSequenceSendKey(clientID, portID), bigEndianBz); err != nil {
+		panic(err)
+	}
+}
+
+// GetNextSequenceRecv returns the next receive sequence of an ordered port of a client from the sequence path
+func (k *Keeper) GetNextSequenceRecv(ctx sdk.Context, clientID, portID string) (uint64, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(hostv2.NextSequenceRecvKey(clientID, portID))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// SetNextSequenceRecv writes the next receive sequence of an ordered port of a client under the sequence path
+// This is a public path that is proven by the counterparty to time out packets sent to an ordered port.
+func (k *Keeper) SetNextSequenceRecv(ctx sdk.Context, clientID, portID string, sequence uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	bigEndianBz := sdk.Uint64ToBigEndian(sequence)
+	if err := store.Set(hostv2.NextSequenceRecvKey(clientID, portID), bigEndianBz); err != nil {
+		panic(err)
+	}
+}
+
 // SetAsyncPacket writes the packet under the async path
 func (k *Keeper) SetAsyncPacket(ctx sdk.Context, clientID string, sequence uint64, packet types.Packet) {
 	store := k.storeService.OpenKVStore(ctx)
@@ -238,6 +283,33 @@ func (k *Keeper) GetAllAsyncPacketsForClient(ctx sdk.Context, clientID string) [
 	return k.getAllPacketStateForClient(ctx, clientID, types.AsyncPacketPrefixKey)
 }
 
+// GetAllNextSequenceRecvsForClient returns the next receive sequences of all ordered ports of a specified
+// client ID.
+func (k *Keeper) GetAllNextSequenceRecvsForClient(ctx sdk.Context, clientID string) []types.PortSequence {
+	return k.getAllPortSequencesForClient(ctx, clientID, hostv2.NextSequenceRecvPrefixKey)
+}
+
+// GetAllNextPortSequenceSendsForClient returns the next port send sequences of all destination ports of a specified
+// client ID.
+func (k *Keeper) GetAllNextPortSequenceSendsForClient(ctx sdk.Context, clientID string) []types.PortSequence {
+	return k.getAllPortSequencesForClient(ctx, clientID, hostv2.NextPortSequenceSendPrefixKey)
+}
+
+// getAllPortSequencesForClient returns the port sequences stored under the prefix of the specified client ID.
+func (k *Keeper) getAllPortSequencesForClient(ctx sdk.Context, clientID string, prefixFn prefixKeyConstructor) []types.PortSequence {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	storePrefix := prefixFn(clientID)
+	iterator := storetypes.KVStorePrefixIterator(store, storePrefix)
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var sequences []types.PortSequence
+	for ; iterator.Valid(); iterator.Next() {
+		portID := string(bytes.TrimPrefix(iterator.Key(), storePrefix))
+		sequences = append(sequences, types.NewPortSequence(clientID, portID, sdk.BigEndianToUint64(iterator.Value())))
+	}
+	return sequences
+}
+
 // prefixKeyConstructor is a function that constructs a store key for a specific packet store using the provided
 // clientID.
 type prefixKeyConstructor func(clientID string) []byte
@@ -26,7 +26,7 @@ func (k *Keeper) SendPacket(goCtx context.Context, msg *types.MsgSendPacket) (*t
 		return nil, errorsmod.Wrap(err, "invalid address for msg Signer")
 	}
 
-	sequence, destChannel, err := k.sendPacket(ctx, msg.SourceClient, msg.TimeoutTimestamp, msg.Payloads)
+	packet, err := k.sendPacket(ctx, msg.SourceClient, msg.TimeoutTimestamp, msg.Payloads)
 	if err != nil {
 		ctx.Logger().Error("send packet failed", "source-client", msg.SourceClient, "error", errorsmod.Wrap(err, "send packet failed"))
 		return nil, errorsmod.Wrapf(err, "send packet failed for source id: %s", msg.SourceClient)
@@ -34,13 +34,13 @@ func (k *Keeper) SendPacket(goCtx context.Context, msg *types.MsgSendPacket) (*t
 
 	for _, pd := range msg.Payloads {
 		cbs := k.Router.Route(pd.SourcePort)
-		err := cbs.OnSendPacket(ctx, msg.SourceClient, destChannel, sequence, pd, signer)
+		err := cbs.OnSendPacket(ctx, msg.SourceClient, packet.DestinationClient, packet.Sequence, pd, signer)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	return &types.MsgSendPacketResponse{Sequence: sequence}, nil
+	return &types.MsgSendPacketResponse{Sequence: packet.Sequence, PortSequence: packet.PortSequence}, nil
 }
 
 // RecvPacket implements the PacketMsgServer RecvPacket method.
@@ -64,7 +64,7 @@ func (k *Keeper) RecvPacket(goCtx context.Context, msg *types.MsgRecvPacket) (*t
 	// If the packet was already received, perform a no-op
 	// Use a cached context to prevent accidental state changes
 	cacheCtx, writeFn := ctx.CacheContext()
-	err = k.recvPacket(cacheCtx, msg.Packet, msg.ProofCommitment, msg.ProofHeight)
+	skipped, err := k.recvPacket(cacheCtx, config, msg.Packet, msg.ProofCommitment, msg.ProofHeight)
 
 	switch err {
 	case nil:
@@ -78,71 +78,13 @@ func (k *Keeper) RecvPacket(goCtx context.Context, msg *types.MsgRecvPacket) (*t
 		return nil, errorsmod.Wrap(err, "receive packet verification failed")
 	}
 
-	// build up the recv results for each application callback.
-	ack := types.Acknowledgement{
-		AppAcknowledgements: [][]byte{},
+	// the application callbacks are not executed for expired packets of ordered ports which have been skipped
+	if skipped {
+		return &types.MsgRecvPacketResponse{Result: types.NOOP}, nil
 	}
 
-	var isAsync bool
-	isSuccess := true
-	for _, pd := range msg.Packet.Payloads {
-		// Cache context so that we may discard state changes from callback if the acknowledgement is unsuccessful.
-		cacheCtx, writeFn = ctx.CacheContext()
-		cb := k.Router.Route(pd.DestinationPort)
-		res := cb.OnRecvPacket(cacheCtx, msg.Packet.SourceClient, msg.Packet.DestinationClient, msg.Packet.Sequence, pd, signer)
-
-		if res.Status != types.PacketStatus_Failure {
-			// successful app acknowledgement cannot equal sentinel error acknowledgement
-			if bytes.Equal(res.GetAcknowledgement(), types.ErrorAcknowledgement[:]) {
-				return nil, errorsmod.Wrapf(types.ErrInvalidAcknowledgement, "application acknowledgement cannot be sentinel error acknowledgement")
-			}
-			// write application state changes for asynchronous and successful acknowledgements
-			writeFn()
-			// append app acknowledgement to the overall acknowledgement
-			ack.AppAcknowledgements = append(ack.AppAcknowledgements, res.Acknowledgement)
-		} else {
-			isSuccess = false
-			// construct acknowledgement with single app acknowledgement that is the sentinel error acknowledgement
-			ack = types.Acknowledgement{
-				AppAcknowledgements: [][]byte{types.ErrorAcknowledgement[:]},
-			}
-			// Modify events in cached context to reflect unsuccessful acknowledgement
-			ctx.EventManager().EmitEvents(internalerrors.ConvertToErrorEvents(cacheCtx.EventManager().Events()))
-			break
-		}
-
-		if res.Status == types.PacketStatus_Async {
-			// Set packet acknowledgement to async if any of the acknowledgements are async.
-			isAsync = true
-			// Return error if there is more than 1 payload
-			// TODO: Handle case where there are multiple payloads
-			if len(msg.Packet.Payloads) > 1 {
-				return nil, errorsmod.Wrapf(types.ErrInvalidPacket, "packet with multiple payloads cannot have async acknowledgement")
-			}
-		}
-	}
-
-	if !isAsync {
-		// If the application callback was successful, the acknowledgement must have the same number of app acknowledgements as the packet payloads.
-		if isSuccess {
-			if len(ack.AppAcknowledgements) != len(msg.Packet.Payloads) {
-				return nil, errorsmod.Wrapf(types.ErrInvalidAcknowledgement, "length of app acknowledgement %d does not match length of app payload %d", len(ack.AppAcknowledgements), len(msg.Packet.Payloads))
-			}
-		}
-
-		// Validate ack before forwarding to WriteAcknowledgement.
-		if err := ack.Validate(); err != nil {
-			return nil, err
-		}
-		// Set packet acknowledgement only if the acknowledgement is not async.
-		// NOTE: IBC applications modules may call the WriteAcknowledgement asynchronously if the
-		// acknowledgement is async.
-		if err := k.writeAcknowledgement(ctx, msg.Packet, ack); err != nil {
-			return nil, err
-		}
-	} else {
-		// store the packet temporarily until the application returns an acknowledgement
-		k.SetAsyncPacket(ctx, msg.Packet.DestinationClient, msg.Packet.Sequence, msg.Packet)
+	if err := k.onRecvPacket(ctx, msg.Packet, signer); err != nil {
+		return nil, err
 	}
 
 	// TODO: store the packet for async applications to access if required.
@@ -181,23 +123,8 @@ func (k *Keeper) Acknowledgement(goCtx context.Context, msg *types.MsgAcknowledg
 		return nil, errorsmod.Wrap(err, "acknowledge packet verification failed")
 	}
 
-	recvSuccess := !bytes.Equal(msg.Acknowledgement.AppAcknowledgements[0], types.ErrorAcknowledgement[:])
-	for i, pd := range msg.Packet.Payloads {
-		cbs := k.Router.Route(pd.SourcePort)
-		var ack []byte
-		// if recv was successful, each payload should have its own acknowledgement so we send each individual acknowledgment to the application
-		// otherwise, the acknowledgement only contains the sentinel error acknowledgement which we send to the application. The application is responsible
-		// for knowing that this is an error acknowledgement and executing the appropriate logic.
-		if recvSuccess {
-			ack = msg.Acknowledgement.AppAcknowledgements[i]
-		} else {
-			ack = types.ErrorAcknowledgement[:]
-		}
-		err := cbs.OnAcknowledgementPacket(ctx, msg.Packet.SourceClient, msg.Packet.DestinationClient,
-			msg.Packet.Sequence, ack, pd, relayer)
-		if err != nil {
-			return nil, errorsmod.Wrapf(err, "failed OnAcknowledgementPacket for source port %s, source client %s, destination client %s", pd.SourcePort, msg.Packet.SourceClient, msg.Packet.DestinationClient)
-		}
+	if err := k.onAcknowledgementPacket(ctx, msg.Packet, msg.Acknowledgement, relayer); err != nil {
+		return nil, err
 	}
 
 	defer telemetry.ReportAcknowledgePacket(msg.Packet)
@@ -222,7 +149,7 @@ func (k *Keeper) Timeout(goCtx context.Context, timeout *types.MsgTimeout) (*typ
 	}
 
 	cacheCtx, writeFn := ctx.CacheContext()
-	err = k.timeoutPacket(cacheCtx, timeout.Packet, timeout.ProofUnreceived, timeout.ProofHeight)
+	err = k.timeoutPacket(cacheCtx, timeout.Packet, timeout.ProofUnreceived, timeout.ProofHeight, timeout.NextSequenceRecv)
 
 	switch err {
 	case nil:
@@ -235,16 +162,309 @@ func (k *Keeper) Timeout(goCtx context.Context, timeout *types.MsgTimeout) (*typ
 		return nil, errorsmod.Wrap(err, "timeout packet verification failed")
 	}
 
-	for _, pd := range timeout.Packet.Payloads {
+	if err := k.onTimeoutPacket(ctx, timeout.Packet, signer); err != nil {
+		return nil, err
+	}
+
+	defer telemetry.ReportTimeoutPacket(timeout.Packet)
+
+	return &types.MsgTimeoutResponse{Result: types.SUCCESS}, nil
+}
+
+// RecvPackets implements the PacketMsgServer RecvPackets method.
+func (k *Keeper) RecvPackets(goCtx context.Context, msg *types.MsgRecvPackets) (*types.MsgRecvPacketsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		ctx.Logger().Error("receive packets failed", "error", errorsmod.Wrap(err, "invalid address for msg Signer"))
+		return nil, errorsmod.Wrap(err, "invalid address for msg Signer")
+	}
+
+	// all packets within a batch are received on the same client
+	destinationClient := msg.Packets[0].DestinationClient
+
+	// check if this client is allowed to update if v2 config are set
+	config := k.clientV2Keeper.GetConfig(ctx, destinationClient)
+	if !config.IsAllowedRelayer(signer) {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "relayer %s is not authorized to update client %s", msg.Signer, destinationClient)
+	}
+
+	results := make([]types.ResponseResultType, len(msg.Packets))
+	expired := make([]bool, len(msg.Packets))
+	batch := newPacketBatch(len(msg.Packets))
+
+	// the next sequence receive of ordered ports is advanced within a discarded cached context while the packets
+	// are prepared, so that the packets of an ordered port are only accepted in strictly ascending order
+	prepareCtx, _ := ctx.CacheContext()
+	for i, packet := range msg.Packets {
+		merklePath, isExpired, err := k.prepareRecvPacket(prepareCtx, config, packet)
+		expired[i] = isExpired
+		if err == nil {
+			k.advanceNextSequenceRecv(prepareCtx, config, packet)
+		}
+		results[i] = batch.add(ctx, i, packet, merklePath, types.CommitPacket(packet), err)
+	}
+
+	if err := batch.verify(ctx, k.ClientKeeper, destinationClient, msg.ProofHeight, msg.ProofCommitments); err != nil {
+		ctx.Logger().Error("receive packets failed", "dest-client", destinationClient, "error", errorsmod.Wrap(err, "receive packets verification failed"))
+		return nil, errorsmod.Wrap(err, "receive packets verification failed")
+	}
+
+	for _, i := range batch.indices {
+		packet := msg.Packets[i]
+
+		// the order is checked again as a preceding packet of the same ordered port may have failed
+		if err := k.validatePacketOrder(ctx, config, packet); err != nil {
+			ctx.Logger().Error("receive packet failed", "source-client", packet.SourceClient, "sequence", packet.Sequence, "error", err)
+			results[i] = types.FAILURE
+			continue
+		}
+
+		// the application callbacks are not executed for expired packets of ordered ports which are skipped
+		if expired[i] {
+			k.markPacketSkipped(ctx, config, packet)
+			results[i] = types.NOOP
+			continue
+		}
+
+		// each packet is finalized within its own cached context so that a failing packet
+		// does not revert the packets of the batch which were received successfully
+		cacheCtx, writeFn := ctx.CacheContext()
+		k.markPacketReceived(cacheCtx, config, packet)
+		if err := k.onRecvPacket(cacheCtx, packet, signer); err != nil {
+			ctx.Logger().Error("receive packet failed", "source-client", packet.SourceClient, "sequence", packet.Sequence, "error", err)
+			results[i] = types.FAILURE
+			continue
+		}
+
+		writeFn()
+		results[i] = types.SUCCESS
+		telemetry.ReportRecvPacket(packet)
+	}
+
+	return &types.MsgRecvPacketsResponse{Results: results}, nil
+}
+
+// Acknowledgements defines an rpc handler method for MsgAcknowledgements.
+func (k *Keeper) Acknowledgements(goCtx context.Context, msg *types.MsgAcknowledgements) (*types.MsgAcknowledgementsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	relayer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		ctx.Logger().Error("acknowledgements failed", "error", errorsmod.Wrap(err, "Invalid address for msg Signer"))
+		return nil, errorsmod.Wrap(err, "Invalid address for msg Signer")
+	}
+
+	// all packets within a batch are sent from the same client
+	sourceClient := msg.Packets[0].SourceClient
+
+	// check if this client is allowed to update if v2 config are set
+	config := k.clientV2Keeper.GetConfig(ctx, sourceClient)
+	if !config.IsAllowedRelayer(relayer) {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "relayer %s is not authorized to update client %s", msg.Signer, sourceClient)
+	}
+
+	results := make([]types.ResponseResultType, len(msg.Packets))
+	batch := newPacketBatch(len(msg.Packets))
+	for i, packet := range msg.Packets {
+		merklePath, err := k.prepareAcknowledgePacket(ctx, packet)
+		results[i] = batch.add(ctx, i, packet, merklePath, types.CommitAcknowledgement(msg.Acknowledgements[i]), err)
+	}
+
+	if err := batch.verify(ctx, k.ClientKeeper, sourceClient, msg.ProofHeight, msg.ProofAcked); err != nil {
+		ctx.Logger().Error("acknowledgements failed", "source-client", sourceClient, "error", errorsmod.Wrap(err, "acknowledge packets verification failed"))
+		return nil, errorsmod.Wrap(err, "acknowledge packets verification failed")
+	}
+
+	for _, i := range batch.indices {
+		packet := msg.Packets[i]
+
+		cacheCtx, writeFn := ctx.CacheContext()
+		k.markPacketAcknowledged(cacheCtx, packet)
+		if err := k.onAcknowledgementPacket(cacheCtx, packet, msg.Acknowledgements[i], relayer); err != nil {
+			ctx.Logger().Error("acknowledgement failed", "source-client", packet.SourceClient, "sequence", packet.Sequence, "error", err)
+			results[i] = types.FAILURE
+			continue
+		}
+
+		writeFn()
+		results[i] = types.SUCCESS
+		telemetry.ReportAcknowledgePacket(packet)
+	}
+
+	return &types.MsgAcknowledgementsResponse{Results: results}, nil
+}
+
+// Timeouts implements the PacketMsgServer Timeouts method.
+func (k *Keeper) Timeouts(goCtx context.Context, msg *types.MsgTimeouts) (*types.MsgTimeoutsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		ctx.Logger().Error("timeout packets failed", "error", errorsmod.Wrap(err, "invalid address for msg Signer"))
+		return nil, errorsmod.Wrap(err, "invalid address for msg Signer")
+	}
+
+	// all packets within a batch are sent from the same client
+	sourceClient := msg.Packets[0].SourceClient
+
+	// check if this client is allowed to update if v2 config are set
+	config := k.clientV2Keeper.GetConfig(ctx, sourceClient)
+	if !config.IsAllowedRelayer(signer) {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "relayer %s is not authorized to update client %s", msg.Signer, sourceClient)
+	}
+
+	results := make([]types.ResponseResultType, len(msg.Packets))
+	batch := newPacketBatch(len(msg.Packets))
+	for i, packet := range msg.Packets {
+		merklePath, err := k.prepareTimeoutPacket(ctx, packet, msg.ProofHeight, 0)
+		// an empty value indicates that the absence of the packet receipt must be proven
+		results[i] = batch.add(ctx, i, packet, merklePath, nil, err)
+	}
+
+	if err := batch.verify(ctx, k.ClientKeeper, sourceClient, msg.ProofHeight, msg.ProofUnreceived); err != nil {
+		ctx.Logger().Error("timeout packets failed", "source-client", sourceClient, "error", errorsmod.Wrap(err, "timeout packets verification failed"))
+		return nil, errorsmod.Wrap(err, "timeout packets verification failed")
+	}
+
+	for _, i := range batch.indices {
+		packet := msg.Packets[i]
+
+		cacheCtx, writeFn := ctx.CacheContext()
+		k.markPacketTimedOut(cacheCtx, packet)
+		if err := k.onTimeoutPacket(cacheCtx, packet, signer); err != nil {
+			ctx.Logger().Error("timeout failed", "source-client", packet.SourceClient, "sequence", packet.Sequence, "error", err)
+			results[i] = types.FAILURE
+			continue
+		}
+
+		writeFn()
+		results[i] = types.SUCCESS
+		telemetry.ReportTimeoutPacket(packet)
+	}
+
+	return &types.MsgTimeoutsResponse{Results: results}, nil
+}
+
+// onRecvPacket executes the OnRecvPacket application callbacks for each payload of a received packet and writes
+// the resulting acknowledgement. If any acknowledgement is asynchronous the packet is stored until the application
+// writes the acknowledgement.
+func (k *Keeper) onRecvPacket(ctx sdk.Context, packet types.Packet, signer sdk.AccAddress) error {
+	// build up the recv results for each application callback.
+	ack := types.Acknowledgement{
+		AppAcknowledgements: [][]byte{},
+	}
+
+	// Cache context so that we may discard state changes from all callbacks if any acknowledgement is unsuccessful.
+	// Payloads are delivered in order and received atomically: either every application callback succeeds and
+	// its state changes are written, or the state changes of every payload are reverted.
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	var isAsync bool
+	isSuccess := true
+	for _, pd := range packet.Payloads {
+		cb := k.Router.Route(pd.DestinationPort)
+		res := cb.OnRecvPacket(cacheCtx, packet.SourceClient, packet.DestinationClient, packet.Sequence, pd, signer)
+
+		if res.Status == types.PacketStatus_Failure {
+			isSuccess = false
+			break
+		}
+
+		// successful app acknowledgement cannot equal sentinel error acknowledgement
+		if bytes.Equal(res.GetAcknowledgement(), types.ErrorAcknowledgement[:]) {
+			return errorsmod.Wrapf(types.ErrInvalidAcknowledgement, "application acknowledgement cannot be sentinel error acknowledgement")
+		}
+
+		// append app acknowledgement to the overall acknowledgement
+		ack.AppAcknowledgements = append(ack.AppAcknowledgements, res.Acknowledgement)
+
+		if res.Status == types.PacketStatus_Async {
+			// Set packet acknowledgement to async if any of the acknowledgements are async.
+			isAsync = true
+			// Return error if there is more than 1 payload
+			if len(packet.Payloads) > 1 {
+				return errorsmod.Wrapf(types.ErrInvalidPacket, "packet with multiple payloads cannot have async acknowledgement")
+			}
+		}
+	}
+
+	if isSuccess {
+		// write application state changes for asynchronous and successful acknowledgements
+		writeFn()
+	} else {
+		// construct acknowledgement with single app acknowledgement that is the sentinel error acknowledgement
+		ack = types.NewErrorAcknowledgement()
+		// Modify events in cached context to reflect unsuccessful acknowledgement
+		ctx.EventManager().EmitEvents(internalerrors.ConvertToErrorEvents(cacheCtx.EventManager().Events()))
+	}
+
+	if !isAsync {
+		// If the application callback was successful, the acknowledgement must have the same number of app acknowledgements as the packet payloads.
+		if isSuccess {
+			if len(ack.AppAcknowledgements) != len(packet.Payloads) {
+				return errorsmod.Wrapf(types.ErrInvalidAcknowledgement, "length of app acknowledgement %d does not match length of app payload %d", len(ack.AppAcknowledgements), len(packet.Payloads))
+			}
+		}
+
+		// Validate ack before forwarding to WriteAcknowledgement.
+		if err := ack.Validate(); err != nil {
+			return err
+		}
+		// Set packet acknowledgement only if the acknowledgement is not async.
+		// NOTE: IBC applications modules may call the WriteAcknowledgement asynchronously if the
+		// acknowledgement is async.
+		if err := k.writeAcknowledgement(ctx, packet, ack); err != nil {
+			return err
+		}
+	} else {
+		// store the packet temporarily until the application returns an acknowledgement
+		k.SetAsyncPacket(ctx, packet.DestinationClient, packet.Sequence, packet)
+	}
+
+	return nil
+}
+
+// onAcknowledgementPacket executes the OnAcknowledgementPacket application callbacks for each payload of an
+// acknowledged packet.
+func (k *Keeper) onAcknowledgementPacket(ctx sdk.Context, packet types.Packet, acknowledgement types.Acknowledgement, relayer sdk.AccAddress) error {
+	recvSuccess := acknowledgement.Success()
+	if recvSuccess && len(acknowledgement.AppAcknowledgements) != len(packet.Payloads) {
+		return errorsmod.Wrapf(types.ErrInvalidAcknowledgement, "length of app acknowledgement %d does not match length of app payload %d", len(acknowledgement.AppAcknowledgements), len(packet.Payloads))
+	}
+
+	for i, pd := range packet.Payloads {
 		cbs := k.Router.Route(pd.SourcePort)
-		err := cbs.OnTimeoutPacket(ctx, timeout.Packet.SourceClient, timeout.Packet.DestinationClient,
-			timeout.Packet.Sequence, pd, signer)
+		var ack []byte
+		// if recv was successful, each payload should have its own acknowledgement so we send each individual acknowledgment to the application
+		// otherwise, the acknowledgement only contains the sentinel error acknowledgement which we send to the application. The application is responsible
+		// for knowing that this is an error acknowledgement and executing the appropriate logic.
+		if recvSuccess {
+			ack = acknowledgement.AppAcknowledgements[i]
+		} else {
+			ack = types.ErrorAcknowledgement[:]
+		}
+		err := cbs.OnAcknowledgementPacket(ctx, packet.SourceClient, packet.DestinationClient,
+			packet.Sequence, ack, pd, relayer)
 		if err != nil {
-			return nil, errorsmod.Wrapf(err, "failed OnTimeoutPacket for source port %s, source client %s, destination client %s", pd.SourcePort, timeout.Packet.SourceClient, timeout.Packet.DestinationClient)
+			return errorsmod.Wrapf(err, "failed OnAcknowledgementPacket for source port %s, source client %s, destination client %s", pd.SourcePort, packet.SourceClient, packet.DestinationClient)
 		}
 	}
 
-	defer telemetry.ReportTimeoutPacket(timeout.Packet)
+	return nil
+}
 
-	return &types.MsgTimeoutResponse{Result: types.SUCCESS}, nil
+// onTimeoutPacket executes the OnTimeoutPacket application callbacks for each payload of a timed out packet.
+func (k *Keeper) onTimeoutPacket(ctx sdk.Context, packet types.Packet, relayer sdk.AccAddress) error {
+	for _, pd := range packet.Payloads {
+		cbs := k.Router.Route(pd.SourcePort)
+		err := cbs.OnTimeoutPacket(ctx, packet.SourceClient, packet.DestinationClient,
+			packet.Sequence, pd, relayer)
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed OnTimeoutPacket for source port %s, source client %s, destination client %s", pd.SourcePort, packet.SourceClient, packet.DestinationClient)
+		}
+	}
+
+	return nil
 }
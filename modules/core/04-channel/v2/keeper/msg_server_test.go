@@ -10,6 +10,7 @@ import (
 	clientv2types "github.com/cosmos/ibc-go/v10/modules/core/02-client/v2/types"
 	"github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	hostv2 "github.com/cosmos/ibc-go/v10/modules/core/24-host/v2"
 	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
 	ibctesting "github.com/cosmos/ibc-go/v10/testing"
 	"github.com/cosmos/ibc-go/v10/testing/mock"
@@ -43,6 +44,16 @@ func (suite *KeeperTestSuite) TestMsgSendPacket() {
 			},
 			expError: nil,
 		},
+		{
+			name: "success: packet sent to ordered port is assigned a port sequence",
+			malleate: func() {
+				config := clientv2types.DefaultConfig()
+				config.OrderedPorts = []string{mockv2.ModuleNameB}
+				suite.chainA.App.GetIBCKeeper().ClientV2Keeper.SetConfig(suite.chainA.GetContext(), path.EndpointA.ClientID, config)
+				expectedPacket.PortSequence = 1
+			},
+			expError: nil,
+		},
 		{
 			name: "failure: timeout elapsed",
 			malleate: func() {
@@ -302,6 +313,227 @@ func (suite *KeeperTestSuite) TestMsgRecvPacket() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgRecvPacketMultiplePayloads() {
+	var (
+		path       *ibctesting.Path
+		expRecvRes types.RecvPacketResult
+	)
+
+	// markerClientID is used by the first application callback to write state, allowing
+	// the test to assert whether the state changes of the first payload were reverted.
+	const markerClientID = "marker-client"
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		expError     error
+		expAck       func() types.Acknowledgement
+		expStateKept bool
+	}{
+		{
+			name:     "success: all payloads succeed",
+			malleate: func() {},
+			expAck: func() types.Acknowledgement {
+				return types.NewAcknowledgement(mockv2.MockRecvPacketResult.Acknowledgement, mockv2.MockRecvPacketResult.Acknowledgement)
+			},
+			expStateKept: true,
+		},
+		{
+			name: "success: failed recv result on second payload reverts every payload",
+			malleate: func() {
+				expRecvRes = types.RecvPacketResult{
+					Status: types.PacketStatus_Failure,
+				}
+			},
+			expAck:       types.NewErrorAcknowledgement,
+			expStateKept: false,
+		},
+		{
+			name: "failure: async recv result with multiple payloads",
+			malleate: func() {
+				expRecvRes = types.RecvPacketResult{
+					Status: types.PacketStatus_Async,
+				}
+			},
+			expError: types.ErrInvalidPacket,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupV2()
+
+			timeoutTimestamp := suite.chainA.GetTimeoutTimestampSecs()
+
+			// the first payload is routed to moduleB on chainB and the second payload is routed to moduleA on chainB.
+			packet, err := path.EndpointA.MsgSendPacketWithPayloads(
+				timeoutTimestamp,
+				mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB),
+				mockv2.NewMockPayload(mockv2.ModuleNameB, mockv2.ModuleNameA),
+			)
+			suite.Require().NoError(err)
+			suite.Require().Len(packet.Payloads, 2)
+
+			expRecvRes = mockv2.MockRecvPacketResult
+
+			tc.malleate()
+
+			ck := path.EndpointB.Chain.GetSimApp().IBCKeeper.ChannelKeeperV2
+
+			path.EndpointB.Chain.GetSimApp().MockModuleV2B.IBCApp.OnRecvPacket = func(ctx sdk.Context, sourceChannel string, destinationChannel string, sequence uint64, data types.Payload, relayer sdk.AccAddress) types.RecvPacketResult {
+				ck.SetPacketCommitment(ctx, markerClientID, sequence, []byte("marker"))
+				return mockv2.MockRecvPacketResult
+			}
+			path.EndpointB.Chain.GetSimApp().MockModuleV2A.IBCApp.OnRecvPacket = func(ctx sdk.Context, sourceChannel string, destinationChannel string, sequence uint64, data types.Payload, relayer sdk.AccAddress) types.RecvPacketResult {
+				return expRecvRes
+			}
+
+			err = path.EndpointB.MsgRecvPacket(packet)
+
+			if tc.expError != nil {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expError)
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			ctx := path.EndpointB.Chain.GetContext()
+			_, ok := ck.GetPacketReceipt(ctx, packet.DestinationClient, packet.Sequence)
+			suite.Require().True(ok)
+
+			actualAckBz := ck.GetPacketAcknowledgement(ctx, packet.DestinationClient, packet.Sequence)
+			suite.Require().Equal(types.CommitAcknowledgement(tc.expAck()), actualAckBz)
+
+			suite.Require().Equal(tc.expStateKept, len(ck.GetPacketCommitment(ctx, markerClientID, packet.Sequence)) != 0)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgRecvPacketOrdered() {
+	var (
+		path    *ibctesting.Path
+		packets []types.Packet
+		packet  types.Packet
+	)
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expError   error
+		expSkipped bool
+		expNextSeq uint64
+	}{
+		{
+			name: "success: packets received in order",
+			malleate: func() {
+				suite.Require().NoError(path.EndpointB.MsgRecvPacket(packets[0]))
+				packet = packets[1]
+			},
+			expNextSeq: 3,
+		},
+		{
+			name: "success: expired packet is skipped",
+			malleate: func() {
+				var err error
+				packet, err = path.EndpointA.MsgSendPacket(uint64(suite.chainA.GetContext().BlockTime().Add(time.Second).Unix()), mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+				suite.Require().NoError(err)
+
+				suite.Require().NoError(path.EndpointB.MsgRecvPacket(packets[0]))
+				suite.Require().NoError(path.EndpointB.MsgRecvPacket(packets[1]))
+				suite.coordinator.IncrementTimeBy(time.Minute)
+				suite.Require().NoError(path.EndpointB.UpdateClient())
+			},
+			expSkipped: true,
+			expNextSeq: 4,
+		},
+		{
+			name: "failure: later packet received first",
+			malleate: func() {
+				packet = packets[1]
+			},
+			expError: types.ErrPacketSequenceOutOfOrder,
+		},
+		{
+			name: "failure: packet sequence lower than next sequence receive",
+			malleate: func() {
+				suite.Require().NoError(path.EndpointB.MsgRecvPacket(packets[0]))
+				suite.Require().NoError(path.EndpointB.MsgRecvPacket(packets[1]))
+				suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetNextSequenceRecv(suite.chainB.GetContext(), path.EndpointB.ClientID, mockv2.ModuleNameB, 4)
+				packet = packets[1]
+				packet.Sequence = 10
+				packet.PortSequence = 3
+			},
+			expError: types.ErrPacketSequenceOutOfOrder,
+		},
+		{
+			name: "failure: next sequence receive enforced after port is no longer ordered",
+			malleate: func() {
+				suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetNextSequenceRecv(suite.chainB.GetContext(), path.EndpointB.ClientID, mockv2.ModuleNameB, 3)
+				suite.chainB.App.GetIBCKeeper().ClientV2Keeper.SetConfig(suite.chainB.GetContext(), path.EndpointB.ClientID, clientv2types.DefaultConfig())
+				packet = packets[0]
+			},
+			expError: types.ErrPacketSequenceOutOfOrder,
+		},
+		{
+			name: "failure: packet with multiple payloads on ordered port",
+			malleate: func() {
+				var err error
+				packet, err = path.EndpointA.MsgSendPacketWithPayloads(
+					suite.chainA.GetTimeoutTimestampSecs(),
+					mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB),
+					mockv2.NewMockPayload(mockv2.ModuleNameB, mockv2.ModuleNameA),
+				)
+				suite.Require().NoError(err)
+			},
+			expError: types.ErrInvalidPacket,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupV2()
+
+			config := clientv2types.DefaultConfig()
+			config.OrderedPorts = []string{mockv2.ModuleNameB}
+			suite.Require().NoError(path.EndpointA.UpdateClientConfig(config))
+			suite.Require().NoError(path.EndpointB.UpdateClientConfig(config))
+
+			packets = make([]types.Packet, 2)
+			for i := range packets {
+				var err error
+				packets[i], err = path.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(i+1), packets[i].PortSequence)
+			}
+
+			tc.malleate()
+
+			err := path.EndpointB.MsgRecvPacket(packet)
+
+			if tc.expError != nil {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expError)
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			nextSequenceRecv, found := suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.GetNextSequenceRecv(suite.chainB.GetContext(), path.EndpointB.ClientID, mockv2.ModuleNameB)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expNextSeq, nextSequenceRecv)
+
+			// skipped packets are not received
+			hasReceipt := suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.HasPacketReceipt(suite.chainB.GetContext(), packet.DestinationClient, packet.Sequence)
+			suite.Require().Equal(!tc.expSkipped, hasReceipt)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestMsgAcknowledgement() {
 	var (
 		path   *ibctesting.Path
@@ -551,3 +783,496 @@ func (suite *KeeperTestSuite) TestMsgTimeout() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgTimeoutOrdered() {
+	var (
+		path    *ibctesting.Path
+		packets []types.Packet
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			name:     "success",
+			malleate: func() {},
+		},
+		{
+			name: "failure: next sequence receive not greater than packet port sequence",
+			malleate: func() {
+				suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetNextSequenceRecv(suite.chainB.GetContext(), path.EndpointB.ClientID, mockv2.ModuleNameB, packets[0].PortSequence)
+				suite.Require().NoError(path.EndpointB.UpdateClient())
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+			},
+			expError: types.ErrTimeoutNotReached,
+		},
+		{
+			name: "failure: unable to timeout if packet has been received",
+			malleate: func() {
+				suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetPacketReceipt(suite.chainB.GetContext(), packets[0].DestinationClient, packets[0].Sequence)
+				suite.Require().NoError(path.EndpointB.UpdateClient())
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+			},
+			expError: commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupV2()
+
+			config := clientv2types.DefaultConfig()
+			config.OrderedPorts = []string{mockv2.ModuleNameB}
+			suite.Require().NoError(path.EndpointA.UpdateClientConfig(config))
+			suite.Require().NoError(path.EndpointB.UpdateClientConfig(config))
+
+			packets = make([]types.Packet, 2)
+			for i, timeoutTimestamp := range []uint64{
+				uint64(suite.chainA.GetContext().BlockTime().Add(time.Second).Unix()),
+				suite.chainA.GetTimeoutTimestampSecs(),
+			} {
+				var err error
+				packets[i], err = path.EndpointA.MsgSendPacket(timeoutTimestamp, mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+				suite.Require().NoError(err)
+			}
+
+			// skipping the expired first packet on the ordered port allows the second packet to be received and
+			// the first packet to be timed out against the next sequence receive of the port
+			suite.coordinator.IncrementTimeBy(time.Minute)
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+			suite.Require().NoError(path.EndpointB.MsgRecvPacket(packets[0]))
+			suite.Require().NoError(path.EndpointB.MsgRecvPacket(packets[1]))
+
+			tc.malleate()
+
+			err := path.EndpointA.MsgTimeoutOrderedPacket(packets[0])
+
+			if tc.expError != nil {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expError)
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.GetPacketCommitment(suite.chainA.GetContext(), packets[0].SourceClient, packets[0].Sequence)
+			suite.Require().Empty(commitment)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgRecvPacketsOrdered() {
+	var (
+		path    *ibctesting.Path
+		packets []types.Packet
+	)
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expResults []types.ResponseResultType
+		expNextSeq uint64
+	}{
+		{
+			name:       "success: packets in ascending order",
+			malleate:   func() {},
+			expResults: []types.ResponseResultType{types.SUCCESS, types.SUCCESS},
+			expNextSeq: 3,
+		},
+		{
+			name: "failure: packets in descending order",
+			malleate: func() {
+				packets[0], packets[1] = packets[1], packets[0]
+			},
+			expResults: []types.ResponseResultType{types.FAILURE, types.SUCCESS},
+			expNextSeq: 2,
+		},
+		{
+			name: "failure: packet following a failed packet of the same port",
+			malleate: func() {
+				path.EndpointB.Chain.GetSimApp().MockModuleV2B.IBCApp.OnRecvPacket = func(ctx sdk.Context, sourceChannel string, destinationChannel string, sequence uint64, data types.Payload, relayer sdk.AccAddress) types.RecvPacketResult {
+					if sequence == packets[0].Sequence {
+						return types.RecvPacketResult{Status: types.PacketStatus_Success, Acknowledgement: types.ErrorAcknowledgement[:]}
+					}
+					return mockv2.MockRecvPacketResult
+				}
+			},
+			expResults: []types.ResponseResultType{types.FAILURE, types.FAILURE},
+			expNextSeq: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupV2()
+
+			config := clientv2types.DefaultConfig()
+			config.OrderedPorts = []string{mockv2.ModuleNameB}
+			suite.Require().NoError(path.EndpointA.UpdateClientConfig(config))
+			suite.Require().NoError(path.EndpointB.UpdateClientConfig(config))
+
+			packets = make([]types.Packet, 2)
+			keys := make([][]byte, len(packets))
+			for i := range packets {
+				var err error
+				packets[i], err = path.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+				suite.Require().NoError(err)
+
+				keys[i] = hostv2.PacketCommitmentKey(packets[i].SourceClient, packets[i].Sequence)
+			}
+
+			proof, proofHeight := path.EndpointA.QueryMultiProof(keys...)
+
+			tc.malleate()
+
+			msg := types.NewMsgRecvPackets(packets, proof, proofHeight, suite.chainB.SenderAccount.GetAddress().String())
+			res, err := suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.RecvPackets(suite.chainB.GetContext(), msg)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expResults, res.Results)
+
+			nextSequenceRecv, _ := suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.GetNextSequenceRecv(suite.chainB.GetContext(), path.EndpointB.ClientID, mockv2.ModuleNameB)
+			suite.Require().Equal(tc.expNextSeq, nextSequenceRecv)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgRecvPackets() {
+	var (
+		path       *ibctesting.Path
+		packets    []types.Packet
+		expResults []types.ResponseResultType
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			name:     "success",
+			malleate: func() {},
+		},
+		{
+			name: "success: redundant packet is a no-op",
+			malleate: func() {
+				suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetPacketReceipt(suite.chainB.GetContext(), packets[0].DestinationClient, packets[0].Sequence)
+				expResults = []types.ResponseResultType{types.NOOP, types.SUCCESS}
+			},
+		},
+		{
+			name: "success: all packets are redundant",
+			malleate: func() {
+				for _, packet := range packets {
+					suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.SetPacketReceipt(suite.chainB.GetContext(), packet.DestinationClient, packet.Sequence)
+				}
+				expResults = []types.ResponseResultType{types.NOOP, types.NOOP}
+			},
+		},
+		{
+			name: "success: invalid acknowledgement fails a single packet",
+			malleate: func() {
+				path.EndpointB.Chain.GetSimApp().MockModuleV2B.IBCApp.OnRecvPacket = func(ctx sdk.Context, sourceChannel string, destinationChannel string, sequence uint64, data types.Payload, relayer sdk.AccAddress) types.RecvPacketResult {
+					if sequence == packets[0].Sequence {
+						return types.RecvPacketResult{Status: types.PacketStatus_Success, Acknowledgement: types.ErrorAcknowledgement[:]}
+					}
+					return mockv2.MockRecvPacketResult
+				}
+				expResults = []types.ResponseResultType{types.FAILURE, types.SUCCESS}
+			},
+		},
+		{
+			name: "success: counterparty not found fails a single packet",
+			malleate: func() {
+				packets[1].DestinationClient = ibctesting.InvalidID
+				expResults = []types.ResponseResultType{types.SUCCESS, types.FAILURE}
+			},
+		},
+		{
+			name: "failure: relayer not permissioned",
+			malleate: func() {
+				creator := suite.chainB.SenderAccount.GetAddress()
+				msg := clientv2types.NewMsgUpdateClientConfig(path.EndpointB.ClientID, creator.String(), clientv2types.NewConfig(suite.chainA.SenderAccount.GetAddress().String()))
+				_, err := suite.chainB.App.GetIBCKeeper().UpdateClientConfig(suite.chainB.GetContext(), msg)
+				suite.Require().NoError(err)
+			},
+			expError: ibcerrors.ErrUnauthorized,
+		},
+		{
+			name: "failure: invalid multi-proof",
+			malleate: func() {
+				// proof verification fails because the packet commitment is different due to a different sequence.
+				packets[1].Sequence = 10
+			},
+			expError: commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupV2()
+
+			timeoutTimestamp := suite.chainA.GetTimeoutTimestampSecs()
+
+			packets = make([]types.Packet, 2)
+			keys := make([][]byte, len(packets))
+			for i := range packets {
+				var err error
+				packets[i], err = path.EndpointA.MsgSendPacket(timeoutTimestamp, mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+				suite.Require().NoError(err)
+
+				keys[i] = hostv2.PacketCommitmentKey(packets[i].SourceClient, packets[i].Sequence)
+			}
+
+			proof, proofHeight := path.EndpointA.QueryMultiProof(keys...)
+
+			expResults = []types.ResponseResultType{types.SUCCESS, types.SUCCESS}
+
+			tc.malleate()
+
+			msg := types.NewMsgRecvPackets(packets, proof, proofHeight, suite.chainB.SenderAccount.GetAddress().String())
+			res, err := suite.chainB.App.GetIBCKeeper().ChannelKeeperV2.RecvPackets(suite.chainB.GetContext(), msg)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expResults, res.Results)
+
+				ck := suite.chainB.App.GetIBCKeeper().ChannelKeeperV2
+				for i, packet := range packets {
+					if expResults[i] != types.SUCCESS {
+						continue
+					}
+
+					// packet receipt and acknowledgement should be written for successfully received packets
+					_, ok := ck.GetPacketReceipt(suite.chainB.GetContext(), packet.DestinationClient, packet.Sequence)
+					suite.Require().True(ok)
+					suite.Require().True(ck.HasPacketAcknowledgement(suite.chainB.GetContext(), packet.DestinationClient, packet.Sequence))
+				}
+
+				// packets which failed must not have a packet receipt written
+				for i, packet := range packets {
+					if expResults[i] == types.FAILURE && packet.DestinationClient == path.EndpointB.ClientID {
+						suite.Require().False(ck.HasPacketReceipt(suite.chainB.GetContext(), packet.DestinationClient, packet.Sequence))
+					}
+				}
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expError, "expected error %q, got %q instead", tc.expError, err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgAcknowledgements() {
+	var (
+		path       *ibctesting.Path
+		packets    []types.Packet
+		acks       []types.Acknowledgement
+		expResults []types.ResponseResultType
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			name:     "success",
+			malleate: func() {},
+		},
+		{
+			name: "success: redundant packet is a no-op",
+			malleate: func() {
+				suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.DeletePacketCommitment(suite.chainA.GetContext(), packets[1].SourceClient, packets[1].Sequence)
+				expResults = []types.ResponseResultType{types.SUCCESS, types.NOOP}
+			},
+		},
+		{
+			name: "success: callback fails a single packet",
+			malleate: func() {
+				path.EndpointA.Chain.GetSimApp().MockModuleV2A.IBCApp.OnAcknowledgementPacket = func(_ sdk.Context, _, _ string, sequence uint64, _ types.Payload, _ []byte, _ sdk.AccAddress) error {
+					if sequence == packets[0].Sequence {
+						return mock.MockApplicationCallbackError
+					}
+					return nil
+				}
+				expResults = []types.ResponseResultType{types.FAILURE, types.SUCCESS}
+			},
+		},
+		{
+			name: "failure: relayer not permissioned",
+			malleate: func() {
+				creator := suite.chainA.SenderAccount.GetAddress()
+				msg := clientv2types.NewMsgUpdateClientConfig(path.EndpointA.ClientID, creator.String(), clientv2types.NewConfig(suite.chainB.SenderAccount.GetAddress().String()))
+				_, err := suite.chainA.App.GetIBCKeeper().UpdateClientConfig(suite.chainA.GetContext(), msg)
+				suite.Require().NoError(err)
+			},
+			expError: ibcerrors.ErrUnauthorized,
+		},
+		{
+			name: "failure: invalid multi-proof",
+			malleate: func() {
+				acks[1].AppAcknowledgements[0] = mock.MockFailPacketData
+			},
+			expError: commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupV2()
+
+			timeoutTimestamp := suite.chainA.GetTimeoutTimestampSecs()
+
+			packets = make([]types.Packet, 2)
+			acks = make([]types.Acknowledgement, len(packets))
+			keys := make([][]byte, len(packets))
+			for i := range packets {
+				var err error
+				packets[i], err = path.EndpointA.MsgSendPacket(timeoutTimestamp, mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+				suite.Require().NoError(err)
+
+				acks[i] = types.Acknowledgement{AppAcknowledgements: [][]byte{mockv2.MockRecvPacketResult.Acknowledgement}}
+				keys[i] = hostv2.PacketAcknowledgementKey(packets[i].DestinationClient, packets[i].Sequence)
+			}
+
+			suite.Require().NoError(path.EndpointB.MsgRecvPackets(packets...))
+
+			proof, proofHeight := path.EndpointB.QueryMultiProof(keys...)
+
+			expResults = []types.ResponseResultType{types.SUCCESS, types.SUCCESS}
+
+			tc.malleate()
+
+			msg := types.NewMsgAcknowledgements(packets, acks, proof, proofHeight, suite.chainA.SenderAccount.GetAddress().String())
+			res, err := suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.Acknowledgements(suite.chainA.GetContext(), msg)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expResults, res.Results)
+
+				// packet commitments are only cleared for successfully acknowledged packets
+				ck := suite.chainA.App.GetIBCKeeper().ChannelKeeperV2
+				for i, packet := range packets {
+					hasCommitment := len(ck.GetPacketCommitment(suite.chainA.GetContext(), packet.SourceClient, packet.Sequence)) > 0
+					suite.Require().Equal(expResults[i] == types.FAILURE, hasCommitment)
+				}
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expError, "expected error %q, got %q instead", tc.expError, err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgTimeouts() {
+	var (
+		path       *ibctesting.Path
+		packets    []types.Packet
+		expResults []types.ResponseResultType
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			name:     "success",
+			malleate: func() {},
+		},
+		{
+			name: "success: redundant packet is a no-op",
+			malleate: func() {
+				suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.DeletePacketCommitment(suite.chainA.GetContext(), packets[0].SourceClient, packets[0].Sequence)
+				expResults = []types.ResponseResultType{types.NOOP, types.SUCCESS}
+			},
+		},
+		{
+			name: "success: callback fails a single packet",
+			malleate: func() {
+				path.EndpointA.Chain.GetSimApp().MockModuleV2A.IBCApp.OnTimeoutPacket = func(_ sdk.Context, _, _ string, sequence uint64, _ types.Payload, _ sdk.AccAddress) error {
+					if sequence == packets[1].Sequence {
+						return mock.MockApplicationCallbackError
+					}
+					return nil
+				}
+				expResults = []types.ResponseResultType{types.SUCCESS, types.FAILURE}
+			},
+		},
+		{
+			name: "failure: relayer not permissioned",
+			malleate: func() {
+				creator := suite.chainA.SenderAccount.GetAddress()
+				msg := clientv2types.NewMsgUpdateClientConfig(path.EndpointA.ClientID, creator.String(), clientv2types.NewConfig(suite.chainB.SenderAccount.GetAddress().String()))
+				_, err := suite.chainA.App.GetIBCKeeper().UpdateClientConfig(suite.chainA.GetContext(), msg)
+				suite.Require().NoError(err)
+			},
+			expError: ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupV2()
+
+			timeoutTimestamp := uint64(suite.chainA.GetContext().BlockTime().Add(time.Minute).Unix())
+
+			packets = make([]types.Packet, 2)
+			keys := make([][]byte, len(packets))
+			for i := range packets {
+				var err error
+				packets[i], err = path.EndpointA.MsgSendPacket(timeoutTimestamp, mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB))
+				suite.Require().NoError(err)
+
+				keys[i] = hostv2.PacketReceiptKey(packets[i].DestinationClient, packets[i].Sequence)
+			}
+
+			// elapse the timeout of all packets on the counterparty
+			suite.coordinator.IncrementTimeBy(time.Minute)
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+
+			proof, proofHeight := path.EndpointB.QueryMultiProof(keys...)
+
+			expResults = []types.ResponseResultType{types.SUCCESS, types.SUCCESS}
+
+			tc.malleate()
+
+			msg := types.NewMsgTimeouts(packets, proof, proofHeight, suite.chainA.SenderAccount.GetAddress().String())
+			res, err := suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.Timeouts(suite.chainA.GetContext(), msg)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expResults, res.Results)
+
+				// packet commitments are only cleared for successfully timed out packets
+				ck := suite.chainA.App.GetIBCKeeper().ChannelKeeperV2
+				for i, packet := range packets {
+					hasCommitment := len(ck.GetPacketCommitment(suite.chainA.GetContext(), packet.SourceClient, packet.Sequence)) > 0
+					suite.Require().Equal(expResults[i] == types.FAILURE, hasCommitment)
+				}
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expError, "expected error %q, got %q instead", tc.expError, err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	clientv2types "github.com/cosmos/ibc-go/v10/modules/core/02-client/v2/types"
 	"github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	commitmenttypesv2 "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types/v2"
 	hostv2 "github.com/cosmos/ibc-go/v10/modules/core/24-host/v2"
 	"github.com/cosmos/ibc-go/v10/modules/core/exported"
 )
@@ -23,46 +24,55 @@ func (k *Keeper) sendPacket(
 	sourceClient string,
 	timeoutTimestamp uint64,
 	payloads []types.Payload,
-) (uint64, string, error) {
+) (types.Packet, error) {
 	// lookup counterparty from client identifiers
 	counterparty, ok := k.clientV2Keeper.GetClientCounterparty(ctx, sourceClient)
 	if !ok {
-		return 0, "", errorsmod.Wrapf(clientv2types.ErrCounterpartyNotFound, "counterparty not found for client: %s", sourceClient)
+		return types.Packet{}, errorsmod.Wrapf(clientv2types.ErrCounterpartyNotFound, "counterparty not found for client: %s", sourceClient)
 	}
 
 	// Note, the validate basic function in sendPacket does the timeoutTimestamp != 0 check and other stateless checks on the packet.
 	// timeoutTimestamp must be greater than current block time
 	timeout := time.Unix(int64(timeoutTimestamp), 0)
 	if !timeout.After(ctx.BlockTime()) {
-		return 0, "", errorsmod.Wrapf(types.ErrTimeoutElapsed, "timeout is less than or equal the current block timestamp, %d <= %d", timeoutTimestamp, ctx.BlockTime().Unix())
+		return types.Packet{}, errorsmod.Wrapf(types.ErrTimeoutElapsed, "timeout is less than or equal the current block timestamp, %d <= %d", timeoutTimestamp, ctx.BlockTime().Unix())
 	}
 
 	// timeoutTimestamp must be less than current block time + MaxTimeoutDelta
 	if timeout.After(ctx.BlockTime().Add(types.MaxTimeoutDelta)) {
-		return 0, "", errorsmod.Wrap(types.ErrInvalidTimeout, "timeout exceeds the maximum expected value")
+		return types.Packet{}, errorsmod.Wrap(types.ErrInvalidTimeout, "timeout exceeds the maximum expected value")
 	}
 
 	sequence, found := k.GetNextSequenceSend(ctx, sourceClient)
 	if !found {
-		return 0, "", errorsmod.Wrapf(types.ErrSequenceSendNotFound, "source client: %s", sourceClient)
+		return types.Packet{}, errorsmod.Wrapf(types.ErrSequenceSendNotFound, "source client: %s", sourceClient)
 	}
 
 	// construct packet from given fields and channel state
 	packet := types.NewPacket(sequence, sourceClient, counterparty.ClientId, timeoutTimestamp, payloads...)
 
+	// packets with a single payload sent to a port which is configured as ordered are sequenced per destination
+	// port, so that they can be received in order by the counterparty
+	if len(payloads) == 1 && k.clientV2Keeper.GetConfig(ctx, sourceClient).IsOrderedPort(payloads[0].DestinationPort) {
+		packet.PortSequence, found = k.GetNextPortSequenceSend(ctx, sourceClient, payloads[0].DestinationPort)
+		if !found {
+			packet.PortSequence = 1
+		}
+	}
+
 	if err := packet.ValidateBasic(); err != nil {
-		return 0, "", errorsmod.Wrapf(types.ErrInvalidPacket, "constructed packet failed basic validation: %v", err)
+		return types.Packet{}, errorsmod.Wrapf(types.ErrInvalidPacket, "constructed packet failed basic validation: %v", err)
 	}
 
 	// check that the client of counterparty chain is still active
 	if status := k.ClientKeeper.GetClientStatus(ctx, sourceClient); status != exported.Active {
-		return 0, "", errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", sourceClient, status)
+		return types.Packet{}, errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", sourceClient, status)
 	}
 
 	// retrieve latest height and timestamp of the client of counterparty chain
 	latestHeight := k.ClientKeeper.GetClientLatestHeight(ctx, sourceClient)
 	if latestHeight.IsZero() {
-		return 0, "", errorsmod.Wrapf(clienttypes.ErrInvalidHeight, "cannot send packet using client (%s) with zero height", sourceClient)
+		return types.Packet{}, errorsmod.Wrapf(clienttypes.ErrInvalidHeight, "cannot send packet using client (%s) with zero height", sourceClient)
 	}
 
 	// client timestamps are in nanoseconds while packet timeouts are in seconds
@@ -70,18 +80,21 @@ func (k *Keeper) sendPacket(
 	// to be consistent with IBC V2 specified timeout behaviour
 	latestTimestampNano, err := k.ClientKeeper.GetClientTimestampAtHeight(ctx, sourceClient, latestHeight)
 	if err != nil {
-		return 0, "", err
+		return types.Packet{}, err
 	}
 	latestTimestamp := uint64(time.Unix(0, int64(latestTimestampNano)).Unix())
 
 	if latestTimestamp >= packet.TimeoutTimestamp {
-		return 0, "", errorsmod.Wrapf(types.ErrTimeoutElapsed, "latest timestamp: %d, timeout timestamp: %d", latestTimestamp, packet.TimeoutTimestamp)
+		return types.Packet{}, errorsmod.Wrapf(types.ErrTimeoutElapsed, "latest timestamp: %d, timeout timestamp: %d", latestTimestamp, packet.TimeoutTimestamp)
 	}
 
 	commitment := types.CommitPacket(packet)
 
 	// bump the sequence and set the packet commitment, so it is provable by the counterparty
 	k.SetNextSequenceSend(ctx, sourceClient, sequence+1)
+	if packet.PortSequence != 0 {
+		k.SetNextPortSequenceSend(ctx, sourceClient, payloads[0].DestinationPort, packet.PortSequence+1)
+	}
 	k.SetPacketCommitment(ctx, sourceClient, packet.GetSequence(), commitment)
 
 	k.Logger(ctx).Info("packet sent", "sequence", strconv.FormatUint(packet.Sequence, 10), "dst_client_id",
@@ -89,7 +102,7 @@ func (k *Keeper) sendPacket(
 
 	emitSendPacketEvents(ctx, packet)
 
-	return sequence, counterparty.ClientId, nil
+	return packet, nil
 }
 
 // recvPacket implements the packet receiving logic required by a packet handler.￼
@@ -99,25 +112,67 @@ func (k *Keeper) sendPacket(
 // The packet handler will verify that the packet has not timed out and that the
 // counterparty stored a packet commitment. If successful, a packet receipt is stored
 // to indicate to the counterparty successful delivery.
+// If the timeout of a packet sent to an ordered port has elapsed, the packet is skipped
+// instead of being received and true is returned: no packet receipt is stored and the next
+// sequence receive of the port is advanced, so that later packets can be received and the
+// packet can be timed out on the counterparty.
 func (k *Keeper) recvPacket(
 	ctx sdk.Context,
+	config clientv2types.Config,
 	packet types.Packet,
 	proof []byte,
 	proofHeight exported.Height,
-) error {
+) (bool, error) {
+	merklePath, expired, err := k.prepareRecvPacket(ctx, config, packet)
+	if err != nil {
+		return false, err
+	}
+
+	commitment := types.CommitPacket(packet)
+
+	if err := k.ClientKeeper.VerifyMembership(
+		ctx,
+		packet.DestinationClient,
+		proofHeight,
+		0, 0,
+		proof,
+		merklePath,
+		commitment,
+	); err != nil {
+		return false, errorsmod.Wrapf(err, "failed packet commitment verification for client (%s)", packet.DestinationClient)
+	}
+
+	if expired {
+		k.markPacketSkipped(ctx, config, packet)
+		return true, nil
+	}
+
+	k.markPacketReceived(ctx, config, packet)
+
+	return false, nil
+}
+
+// prepareRecvPacket performs the checks of the packet receiving logic which precede proof verification and
+// returns the merkle path of the packet commitment which must be proven. If the packet has already been
+// received a no-op error is returned. Packets sent to an ordered port are prepared to be skipped once their
+// timeout has elapsed, in which case true is returned. The config is the v2 config of the destination client.
+func (k *Keeper) prepareRecvPacket(ctx sdk.Context, config clientv2types.Config, packet types.Packet) (commitmenttypesv2.MerklePath, bool, error) {
 	// lookup counterparty from client identifiers
 	counterparty, ok := k.clientV2Keeper.GetClientCounterparty(ctx, packet.DestinationClient)
 	if !ok {
-		return errorsmod.Wrapf(clientv2types.ErrCounterpartyNotFound, "counterparty not found for client: %s", packet.DestinationClient)
+		return commitmenttypesv2.MerklePath{}, false, errorsmod.Wrapf(clientv2types.ErrCounterpartyNotFound, "counterparty not found for client: %s", packet.DestinationClient)
 	}
 
 	if counterparty.ClientId != packet.SourceClient {
-		return errorsmod.Wrapf(clientv2types.ErrInvalidCounterparty, "counterparty id (%s) does not match packet source id (%s)", counterparty.ClientId, packet.SourceClient)
+		return commitmenttypesv2.MerklePath{}, false, errorsmod.Wrapf(clientv2types.ErrInvalidCounterparty, "counterparty id (%s) does not match packet source id (%s)", counterparty.ClientId, packet.SourceClient)
 	}
 
+	// packets sent to an ordered port are skipped once their timeout has elapsed, as the packets following them
+	// could otherwise never be received
 	currentTimestamp := uint64(ctx.BlockTime().Unix())
-	if currentTimestamp >= packet.TimeoutTimestamp {
-		return errorsmod.Wrapf(types.ErrTimeoutElapsed, "current timestamp: %d, timeout timestamp: %d", currentTimestamp, packet.TimeoutTimestamp)
+	expired := currentTimestamp >= packet.TimeoutTimestamp
+	if expired && !isOrderedPacket(config, packet) {
+		return commitmenttypesv2.MerklePath{}, false, errorsmod.Wrapf(types.ErrTimeoutElapsed, "current timestamp: %d, timeout timestamp: %d", currentTimestamp, packet.TimeoutTimestamp)
 	}
 
 	// REPLAY PROTECTION: Packet receipts will indicate that a packet has already been received
@@ -127,36 +182,100 @@ func (k *Keeper) recvPacket(
 		// This error indicates that the packet has already been relayed. Core IBC will
 		// treat this error as a no-op in order to prevent an entire relay transaction
 		// from failing and consuming unnecessary fees.
-		return types.ErrNoOpMsg
+		return commitmenttypesv2.MerklePath{}, false, types.ErrNoOpMsg
 	}
 
-	path := hostv2.PacketCommitmentKey(packet.SourceClient, packet.Sequence)
-	merklePath := types.BuildMerklePath(counterparty.MerklePrefix, path)
-
-	commitment := types.CommitPacket(packet)
-
-	if err := k.ClientKeeper.VerifyMembership(
-		ctx,
-		packet.DestinationClient,
-		proofHeight,
-		0, 0,
-		proof,
-		merklePath,
-		commitment,
-	); err != nil {
-		return errorsmod.Wrapf(err, "failed packet commitment verification for client (%s)", packet.DestinationClient)
+	if err := k.validatePacketOrder(ctx, config, packet); err != nil {
+		return commitmenttypesv2.MerklePath{}, false, err
 	}
 
+	path := hostv2.PacketCommitmentKey(packet.SourceClient, packet.Sequence)
+	return types.BuildMerklePath(counterparty.MerklePrefix, path), expired, nil
+}
+
+// markPacketReceived stores the packet receipt of a packet of which the commitment has been verified
+// and emits the receive packet events.
+func (k *Keeper) markPacketReceived(ctx sdk.Context, config clientv2types.Config, packet types.Packet) {
 	// Set Packet Receipt to prevent timeout from occurring on counterparty
 	k.SetPacketReceipt(ctx, packet.DestinationClient, packet.Sequence)
 
+	k.advanceNextSequenceRecv(ctx, config, packet)
+
 	k.Logger(ctx).Info("packet received", "sequence", strconv.FormatUint(packet.Sequence, 10), "src_client_id", packet.SourceClient, "dst_client_id", packet.DestinationClient)
 
 	emitRecvPacketEvents(ctx, packet)
+}
+
+// validatePacketOrder checks that the packet can be received with respect to the next sequence receive of the
+// destination ports of its payloads, given the v2 config of the destination client.
+// ORDERING: packets received on an ordered port must have a port sequence equal to the next sequence receive of the
+// port, which is initialised to 1 when the port becomes ordered. Ports must therefore be ordered on both ends before
+// packets are sent to them.
+// Packets with a port sequence lower than the next sequence receive are rejected even if the port is no longer
+// ordered so that packets which have been timed out against it on the counterparty can never be received.
+func (k *Keeper) validatePacketOrder(ctx sdk.Context, config clientv2types.Config, packet types.Packet) error {
+	for _, payload := range packet.Payloads {
+		nextSequenceRecv, found := k.GetNextSequenceRecv(ctx, packet.DestinationClient, payload.DestinationPort)
+
+		if !config.IsOrderedPort(payload.DestinationPort) {
+			if found && packet.PortSequence != 0 && packet.PortSequence < nextSequenceRecv {
+				return errorsmod.Wrapf(types.ErrPacketSequenceOutOfOrder, "packet port sequence %d is lower than the next sequence receive %d of port %s", packet.PortSequence, nextSequenceRecv, payload.DestinationPort)
+			}
+			continue
+		}
+
+		if len(packet.Payloads) > 1 {
+			return errorsmod.Wrapf(types.ErrInvalidPacket, "packet with multiple payloads cannot be received on ordered port %s", payload.DestinationPort)
+		}
+
+		if packet.PortSequence == 0 {
+			return errorsmod.Wrapf(types.ErrInvalidPacket, "packet without port sequence cannot be received on ordered port %s", payload.DestinationPort)
+		}
+
+		if !found {
+			return errorsmod.Wrapf(types.ErrSequenceReceiveNotFound, "client: %s, port: %s", packet.DestinationClient, payload.DestinationPort)
+		}
+
+		if packet.PortSequence != nextSequenceRecv {
+			return errorsmod.Wrapf(types.ErrPacketSequenceOutOfOrder, "packet port sequence %d does not match the next sequence receive %d of port %s", packet.PortSequence, nextSequenceRecv, payload.DestinationPort)
+		}
+	}
 
 	return nil
 }
 
+// isOrderedPacket returns true if the packet has a single payload which is sent to a port ordered by the given config.
+func isOrderedPacket(config clientv2types.Config, packet types.Packet) bool {
+	return len(packet.Payloads) == 1 && config.IsOrderedPort(packet.Payloads[0].DestinationPort)
+}
+
+// advanceNextSequenceRecv advances the next sequence receive of the ordered destination ports of the packet past
+// its port sequence. The next sequence receive is never decreased.
+func (k *Keeper) advanceNextSequenceRecv(ctx sdk.Context, config clientv2types.Config, packet types.Packet) {
+	if packet.PortSequence == 0 {
+		return
+	}
+
+	for _, payload := range packet.Payloads {
+		if !config.IsOrderedPort(payload.DestinationPort) {
+			continue
+		}
+
+		nextSequenceRecv, found := k.GetNextSequenceRecv(ctx, packet.DestinationClient, payload.DestinationPort)
+		if !found || packet.PortSequence >= nextSequenceRecv {
+			k.SetNextSequenceRecv(ctx, packet.DestinationClient, payload.DestinationPort, packet.PortSequence+1)
+		}
+	}
+}
+
+// markPacketSkipped advances the next sequence receive of the ordered port of an expired packet of which the
+// commitment has been verified. The packet is not received, so that it can be timed out on the counterparty.
+func (k *Keeper) markPacketSkipped(ctx sdk.Context, config clientv2types.Config, packet types.Packet) {
+	k.advanceNextSequenceRecv(ctx, config, packet)
+
+	k.Logger(ctx).Info("expired packet skipped", "sequence", strconv.FormatUint(packet.Sequence, 10), "port_sequence", strconv.FormatUint(packet.PortSequence, 10), "src_client_id", packet.SourceClient, "dst_client_id", packet.DestinationClient)
+}
+
 // writeAcknowledgement writes the acknowledgement to the store and emits the packet and acknowledgement
 // for relayers to relay the acknowledgement to the counterparty chain.
 func (k Keeper) writeAcknowledgement(
@@ -225,14 +344,40 @@ func (k *Keeper) WriteAcknowledgement(ctx sdk.Context, clientID string, sequence
 }
 
 func (k *Keeper) acknowledgePacket(ctx sdk.Context, packet types.Packet, acknowledgement types.Acknowledgement, proof []byte, proofHeight exported.Height) error {
+	merklePath, err := k.prepareAcknowledgePacket(ctx, packet)
+	if err != nil {
+		return err
+	}
+
+	if err := k.ClientKeeper.VerifyMembership(
+		ctx,
+		packet.SourceClient,
+		proofHeight,
+		0, 0,
+		proof,
+		merklePath,
+		types.CommitAcknowledgement(acknowledgement),
+	); err != nil {
+		return errorsmod.Wrapf(err, "failed packet acknowledgement verification for client (%s)", packet.SourceClient)
+	}
+
+	k.markPacketAcknowledged(ctx, packet)
+
+	return nil
+}
+
+// prepareAcknowledgePacket performs the checks of the packet acknowledgement logic which precede proof verification
+// and returns the merkle path of the acknowledgement commitment which must be proven. If the packet commitment has
+// already been cleared a no-op error is returned.
+func (k *Keeper) prepareAcknowledgePacket(ctx sdk.Context, packet types.Packet) (commitmenttypesv2.MerklePath, error) {
 	// lookup counterparty from client identifiers
 	counterparty, ok := k.clientV2Keeper.GetClientCounterparty(ctx, packet.SourceClient)
 	if !ok {
-		return errorsmod.Wrapf(clientv2types.ErrCounterpartyNotFound, "counterparty not found for client: %s", packet.SourceClient)
+		return commitmenttypesv2.MerklePath{}, errorsmod.Wrapf(clientv2types.ErrCounterpartyNotFound, "counterparty not found for client: %s", packet.SourceClient)
 	}
 
 	if counterparty.ClientId != packet.DestinationClient {
-		return errorsmod.Wrapf(clientv2types.ErrInvalidCounterparty, "counterparty id (%s) does not match packet destination id (%s)", counterparty.ClientId, packet.DestinationClient)
+		return commitmenttypesv2.MerklePath{}, errorsmod.Wrapf(clientv2types.ErrInvalidCounterparty, "counterparty id (%s) does not match packet destination id (%s)", counterparty.ClientId, packet.DestinationClient)
 	}
 
 	commitment := k.GetPacketCommitment(ctx, packet.SourceClient, packet.Sequence)
@@ -241,38 +386,28 @@ func (k *Keeper) acknowledgePacket(ctx sdk.Context, packet types.Packet, acknowl
 		// or there is a misconfigured relayer attempting to prove an acknowledgement
 		// for a packet never sent. Core IBC will treat this error as a no-op in order to
 		// prevent an entire relay transaction from failing and consuming unnecessary fees.
-		return types.ErrNoOpMsg
+		return commitmenttypesv2.MerklePath{}, types.ErrNoOpMsg
 	}
 
 	packetCommitment := types.CommitPacket(packet)
 
 	// verify we sent the packet and haven't cleared it out yet
 	if !bytes.Equal(commitment, packetCommitment) {
-		return errorsmod.Wrapf(types.ErrInvalidPacket, "commitment bytes are not equal: got (%v), expected (%v)", packetCommitment, commitment)
+		return commitmenttypesv2.MerklePath{}, errorsmod.Wrapf(types.ErrInvalidPacket, "commitment bytes are not equal: got (%v), expected (%v)", packetCommitment, commitment)
 	}
 
 	path := hostv2.PacketAcknowledgementKey(packet.DestinationClient, packet.Sequence)
-	merklePath := types.BuildMerklePath(counterparty.MerklePrefix, path)
-
-	if err := k.ClientKeeper.VerifyMembership(
-		ctx,
-		packet.SourceClient,
-		proofHeight,
-		0, 0,
-		proof,
-		merklePath,
-		types.CommitAcknowledgement(acknowledgement),
-	); err != nil {
-		return errorsmod.Wrapf(err, "failed packet acknowledgement verification for client (%s)", packet.SourceClient)
-	}
+	return types.BuildMerklePath(counterparty.MerklePrefix, path), nil
+}
 
+// markPacketAcknowledged deletes the packet commitment of a packet of which the acknowledgement has been verified
+// and emits the acknowledge packet events.
+func (k *Keeper) markPacketAcknowledged(ctx sdk.Context, packet types.Packet) {
 	k.DeletePacketCommitment(ctx, packet.SourceClient, packet.Sequence)
 
 	k.Logger(ctx).Info("packet acknowledged", "sequence", strconv.FormatUint(packet.GetSequence(), 10), "src_client_id", packet.GetSourceClient(), "dst_client_id", packet.GetDestinationClient())
 
 	emitAcknowledgePacketEvents(ctx, packet)
-
-	return nil
 }
 
 // timeoutPacket implements the timeout logic required by a packet handler.
@@ -280,36 +415,96 @@ func (k *Keeper) acknowledgePacket(ctx sdk.Context, packet types.Packet, acknowl
 // sent and received on clients which are counterparties for one another.
 // If no packet commitment exists, a no-op error is returned, otherwise
 // an absence proof of the packet receipt is performed to ensure that the packet
-// was never delivered to the counterparty. If successful, the packet commitment
-// is deleted and the packet has completed its lifecycle.
+// was never delivered to the counterparty. If a next sequence receive is provided,
+// the packet is sent to an ordered port and the next sequence receive of the port is
+// proven along with the absence of the packet receipt. If successful, the packet
+// commitment is deleted and the packet has completed its lifecycle.
 func (k *Keeper) timeoutPacket(
 	ctx sdk.Context,
 	packet types.Packet,
 	proof []byte,
 	proofHeight exported.Height,
+	nextSequenceRecv uint64,
 ) error {
+	merklePath, err := k.prepareTimeoutPacket(ctx, packet, proofHeight, nextSequenceRecv)
+	if err != nil {
+		return err
+	}
+
+	if nextSequenceRecv == 0 {
+		if err := k.ClientKeeper.VerifyNonMembership(
+			ctx,
+			packet.SourceClient,
+			proofHeight,
+			0, 0,
+			proof,
+			merklePath,
+		); err != nil {
+			return errorsmod.Wrapf(err, "failed packet receipt absence verification for client (%s)", packet.SourceClient)
+		}
+	} else {
+		// the counterparty is known to exist as it has been looked up in prepareTimeoutPacket
+		counterparty, _ := k.clientV2Keeper.GetClientCounterparty(ctx, packet.SourceClient)
+		nextSequenceRecvPath := types.BuildMerklePath(counterparty.MerklePrefix, hostv2.NextSequenceRecvKey(packet.DestinationClient, packet.Payloads[0].DestinationPort))
+
+		// the absence of the packet receipt and the next sequence receive are proven by a single multi-proof
+		if err := k.ClientKeeper.VerifyMultiProof(
+			ctx,
+			packet.SourceClient,
+			proofHeight,
+			0, 0,
+			proof,
+			[]exported.Path{merklePath, nextSequenceRecvPath},
+			[][]byte{nil, sdk.Uint64ToBigEndian(nextSequenceRecv)},
+		); err != nil {
+			return errorsmod.Wrapf(err, "failed packet receipt absence and next sequence receive verification for client (%s)", packet.SourceClient)
+		}
+	}
+
+	k.markPacketTimedOut(ctx, packet)
+
+	return nil
+}
+
+// prepareTimeoutPacket performs the checks of the timeout logic which precede proof verification and returns
+// the merkle path of the packet receipt of which the absence must be proven. If the packet commitment has already
+// been cleared a no-op error is returned. If a next sequence receive is provided, it must be greater than the packet
+// port sequence in place of the timeout timestamp having elapsed.
+func (k *Keeper) prepareTimeoutPacket(ctx sdk.Context, packet types.Packet, proofHeight exported.Height, nextSequenceRecv uint64) (commitmenttypesv2.MerklePath, error) {
 	// lookup counterparty from client identifiers
 	counterparty, ok := k.clientV2Keeper.GetClientCounterparty(ctx, packet.SourceClient)
 	if !ok {
-		return errorsmod.Wrapf(clientv2types.ErrCounterpartyNotFound, "counterparty not found for client: %s", packet.SourceClient)
+		return commitmenttypesv2.MerklePath{}, errorsmod.Wrapf(clientv2types.ErrCounterpartyNotFound, "counterparty not found for client: %s", packet.SourceClient)
 	}
 
 	if counterparty.ClientId != packet.DestinationClient {
-		return errorsmod.Wrapf(clientv2types.ErrInvalidCounterparty, "counterparty id (%s) does not match packet destination id (%s)", counterparty.ClientId, packet.DestinationClient)
-	}
-
-	// check that timeout timestamp has passed on the other end
-	// client timestamps are in nanoseconds while packet timeouts are in seconds
-	// so we convert client timestamp to seconds in uint64 to be consistent
-	// with IBC V2 timeout behaviour
-	proofTimestampNano, err := k.ClientKeeper.GetClientTimestampAtHeight(ctx, packet.SourceClient, proofHeight)
-	if err != nil {
-		return err
-	}
-	proofTimestamp := uint64(time.Unix(0, int64(proofTimestampNano)).Unix())
-
-	if proofTimestamp < packet.TimeoutTimestamp {
-		return errorsmod.Wrapf(types.ErrTimeoutNotReached, "proof timestamp: %d, timeout timestamp: %d", proofTimestamp, packet.TimeoutTimestamp)
+		return commitmenttypesv2.MerklePath{}, errorsmod.Wrapf(clientv2types.ErrInvalidCounterparty, "counterparty id (%s) does not match packet destination id (%s)", counterparty.ClientId, packet.DestinationClient)
+	}
+
+	if nextSequenceRecv == 0 {
+		// check that timeout timestamp has passed on the other end
+		// client timestamps are in nanoseconds while packet timeouts are in seconds
+		// so we convert client timestamp to seconds in uint64 to be consistent
+		// with IBC V2 timeout behaviour
+		proofTimestampNano, err := k.ClientKeeper.GetClientTimestampAtHeight(ctx, packet.SourceClient, proofHeight)
+		if err != nil {
+			return commitmenttypesv2.MerklePath{}, err
+		}
+		proofTimestamp := uint64(time.Unix(0, int64(proofTimestampNano)).Unix())
+
+		if proofTimestamp < packet.TimeoutTimestamp {
+			return commitmenttypesv2.MerklePath{}, errorsmod.Wrapf(types.ErrTimeoutNotReached, "proof timestamp: %d, timeout timestamp: %d", proofTimestamp, packet.TimeoutTimestamp)
+		}
+	} else {
+		// a packet sent to an ordered port can no longer be received once the next sequence receive
+		// of the port on the counterparty is greater than the packet port sequence
+		if len(packet.Payloads) != 1 || packet.PortSequence == 0 {
+			return commitmenttypesv2.MerklePath{}, errorsmod.Wrap(types.ErrInvalidPacket, "packet timed out against a next sequence receive must have a single payload and a port sequence")
+		}
+
+		if nextSequenceRecv <= packet.PortSequence {
+			return commitmenttypesv2.MerklePath{}, errorsmod.Wrapf(types.ErrTimeoutNotReached, "next sequence receive: %d, packet port sequence: %d", nextSequenceRecv, packet.PortSequence)
+		}
 	}
 
 	// check that the commitment has not been cleared and that it matches the packet sent by relayer
@@ -319,36 +514,27 @@ func (k *Keeper) timeoutPacket(
 		// or there is a misconfigured relayer attempting to prove a timeout
 		// for a packet never sent. Core IBC will treat this error as a no-op in order to
 		// prevent an entire relay transaction from failing and consuming unnecessary fees.
-		return types.ErrNoOpMsg
+		return commitmenttypesv2.MerklePath{}, types.ErrNoOpMsg
 	}
 
 	packetCommitment := types.CommitPacket(packet)
 	// verify we sent the packet and haven't cleared it out yet
 	if !bytes.Equal(commitment, packetCommitment) {
-		return errorsmod.Wrapf(types.ErrInvalidPacket, "packet commitment bytes are not equal: got (%v), expected (%v)", commitment, packetCommitment)
+		return commitmenttypesv2.MerklePath{}, errorsmod.Wrapf(types.ErrInvalidPacket, "packet commitment bytes are not equal: got (%v), expected (%v)", commitment, packetCommitment)
 	}
 
 	// verify packet receipt absence
 	path := hostv2.PacketReceiptKey(packet.DestinationClient, packet.Sequence)
-	merklePath := types.BuildMerklePath(counterparty.MerklePrefix, path)
-
-	if err := k.ClientKeeper.VerifyNonMembership(
-		ctx,
-		packet.SourceClient,
-		proofHeight,
-		0, 0,
-		proof,
-		merklePath,
-	); err != nil {
-		return errorsmod.Wrapf(err, "failed packet receipt absence verification for client (%s)", packet.SourceClient)
-	}
+	return types.BuildMerklePath(counterparty.MerklePrefix, path), nil
+}
 
+// markPacketTimedOut deletes the packet commitment of a packet of which the receipt absence has been verified
+// and emits the timeout packet events.
+func (k *Keeper) markPacketTimedOut(ctx sdk.Context, packet types.Packet) {
 	// delete packet commitment to prevent replay
 	k.DeletePacketCommitment(ctx, packet.SourceClient, packet.Sequence)
 
 	k.Logger(ctx).Info("packet timed out", "sequence", strconv.FormatUint(packet.Sequence, 10), "src_client_id", packet.SourceClient, "dst_client_id", packet.DestinationClient)
 
 	emitTimeoutPacketEvents(ctx, packet)
-
-	return nil
 }
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	commitmenttypesv2 "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types/v2"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+// packetBatch collects the merkle paths and values of the packets within a batched relay message
+// which must be proven by a single multi-proof.
+type packetBatch struct {
+	// indices of the packets within the message which are verified by the multi-proof
+	indices []int
+	paths   []exported.Path
+	values  [][]byte
+}
+
+// newPacketBatch returns an empty packetBatch with capacity for the given number of packets.
+func newPacketBatch(size int) *packetBatch {
+	return &packetBatch{
+		indices: make([]int, 0, size),
+		paths:   make([]exported.Path, 0, size),
+		values:  make([][]byte, 0, size),
+	}
+}
+
+// add records the outcome of the checks performed on the packet at the given index prior to proof verification.
+// Packets which passed the checks are added to the batch and an unspecified result is returned as their
+// result is only known once they have been processed. Redundant packets result in a no-op and packets which
+// failed the checks result in a failure, neither of which are added to the batch.
+func (b *packetBatch) add(ctx sdk.Context, index int, packet types.Packet, merklePath commitmenttypesv2.MerklePath, value []byte, err error) types.ResponseResultType {
+	switch err {
+	case nil:
+		b.indices = append(b.indices, index)
+		b.paths = append(b.paths, merklePath)
+		b.values = append(b.values, value)
+		return types.UNSPECIFIED
+	case types.ErrNoOpMsg:
+		// no-ops do not need event emission as they will be ignored
+		ctx.Logger().Debug("no-op on redundant relay", "source-client", packet.SourceClient, "sequence", packet.Sequence)
+		return types.NOOP
+	default:
+		ctx.Logger().Error("packet verification failed", "source-client", packet.SourceClient, "sequence", packet.Sequence, "error", err)
+		return types.FAILURE
+	}
+}
+
+// verify verifies the multi-proof against the merkle paths and values of all packets within the batch.
+// Verification is skipped if no packets have been added to the batch.
+func (b *packetBatch) verify(ctx sdk.Context, clientKeeper types.ClientKeeper, clientID string, proofHeight exported.Height, proof []byte) error {
+	if len(b.indices) == 0 {
+		return nil
+	}
+
+	if err := clientKeeper.VerifyMultiProof(ctx, clientID, proofHeight, 0, 0, proof, b.paths, b.values); err != nil {
+		return errorsmod.Wrapf(err, "failed multi-proof verification for client (%s)", clientID)
+	}
+
+	return nil
+}
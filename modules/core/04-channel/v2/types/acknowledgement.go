@@ -21,16 +21,27 @@ func NewAcknowledgement(appAcknowledgements ...[]byte) Acknowledgement {
 	return Acknowledgement{AppAcknowledgements: appAcknowledgements}
 }
 
+// NewErrorAcknowledgement returns the acknowledgement written for a packet whose receipt failed.
+// A failed receive reverts every payload of the packet, so the error acknowledgement contains
+// only the sentinel error acknowledgement regardless of the number of payloads.
+func NewErrorAcknowledgement() Acknowledgement {
+	return NewAcknowledgement(ErrorAcknowledgement[:])
+}
+
 // Validate performs a basic validation of the acknowledgement
 func (ack Acknowledgement) Validate() error {
-	if len(ack.AppAcknowledgements) != 1 {
-		return errorsmod.Wrap(ErrInvalidAcknowledgement, "app acknowledgements must be of length one")
+	if len(ack.AppAcknowledgements) == 0 {
+		return errorsmod.Wrap(ErrInvalidAcknowledgement, "app acknowledgements cannot be empty")
 	}
 
-	for _, ack := range ack.AppAcknowledgements {
-		if len(ack) == 0 {
+	for _, appAck := range ack.AppAcknowledgements {
+		if len(appAck) == 0 {
 			return errorsmod.Wrap(ErrInvalidAcknowledgement, "app acknowledgement cannot be empty")
 		}
+
+		if len(ack.AppAcknowledgements) > 1 && bytes.Equal(appAck, ErrorAcknowledgement[:]) {
+			return errorsmod.Wrap(ErrInvalidAcknowledgement, "error acknowledgement must be the only app acknowledgement")
+		}
 	}
 
 	return nil
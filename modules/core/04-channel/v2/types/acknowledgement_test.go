@@ -22,8 +22,18 @@ func (s *TypesTestSuite) Test_ValidateAcknowledgement() {
 			nil,
 		},
 		{
-			"failure: more than one app acknowledgements",
+			"success: valid successful ack with multiple app acknowledgements",
 			types.NewAcknowledgement([]byte("appAck1"), []byte("appAck2")),
+			nil,
+		},
+		{
+			"failure: no app acknowledgements",
+			types.NewAcknowledgement(),
+			types.ErrInvalidAcknowledgement,
+		},
+		{
+			"failure: error acknowledgement with other app acknowledgements",
+			types.NewAcknowledgement([]byte("appAck1"), types.ErrorAcknowledgement[:]),
 			types.ErrInvalidAcknowledgement,
 		},
 		{
@@ -8,7 +8,9 @@ import (
 
 // CommitPacket returns the V2 packet commitment bytes. The commitment consists of:
 // ha256_hash(0x02 + sha256_hash(destinationClient) + sha256_hash(timeout) + sha256_hash(payload)) from a given packet.
-// This results in a fixed length preimage of 32 bytes.
+// If the port sequence of the packet is set, sha256_hash(portSequence) is appended after sha256_hash(payload). The commitment
+// of a packet without a port sequence is thus byte-identical to the commitment of the same packet without this field.
+// Every field is hashed to 32 bytes, which results in a fixed length preimage for packets with and without a port sequence respectively.
 // NOTE: A fixed length preimage is ESSENTIAL to prevent relayers from being able
 // to malleate the packet fields and create a commitment hash that matches the original packet.
 func CommitPacket(packet Packet) []byte {
@@ -27,6 +29,11 @@ func CommitPacket(packet Packet) []byte {
 	appHash := sha256.Sum256(appBytes)
 	buf = append(buf, appHash[:]...)
 
+	if packet.PortSequence != 0 {
+		portSequenceHash := sha256.Sum256(sdk.Uint64ToBigEndian(packet.PortSequence))
+		buf = append(buf, portSequenceHash[:]...)
+	}
+
 	buf = append([]byte{byte(2)}, buf...)
 
 	hash := sha256.Sum256(buf)
@@ -42,6 +42,13 @@ func TestCommitPacket(t *testing.T) {
 			},
 			"d408dca5088b9b375edb3c4df6bae0e18084fc0dbd90fcd0d028506553c81b25",
 		},
+		{
+			"json packet with port sequence",
+			func() {
+				packet.PortSequence = 1
+			},
+			"80101d14b1fdd24210a8518689274d073db681efad3d84f8e61a76637ba4d503",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -16,4 +16,6 @@ var (
 	ErrTimeoutNotReached        = errorsmod.Register(SubModuleName, 10, "timeout not reached")
 	ErrAcknowledgementExists    = errorsmod.Register(SubModuleName, 11, "acknowledgement for packet already exists")
 	ErrNoOpMsg                  = errorsmod.Register(SubModuleName, 12, "message is redundant, no-op will be performed")
+	ErrPacketSequenceOutOfOrder = errorsmod.Register(SubModuleName, 13, "packet sequence is out of order")
+	ErrSequenceReceiveNotFound  = errorsmod.Register(SubModuleName, 14, "sequence receive not found")
 )
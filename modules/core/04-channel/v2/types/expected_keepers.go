@@ -12,6 +12,9 @@ type ClientKeeper interface {
 	VerifyMembership(ctx sdk.Context, clientID string, height exported.Height, delayTimePeriod uint64, delayBlockPeriod uint64, proof []byte, path exported.Path, value []byte) error
 	// VerifyNonMembership retrieves the light client module for the clientID and verifies the absence of a given key at a specified height.
 	VerifyNonMembership(ctx sdk.Context, clientID string, height exported.Height, delayTimePeriod uint64, delayBlockPeriod uint64, proof []byte, path exported.Path) error
+	// VerifyMultiProof retrieves the light client module for the clientID and verifies a single proof of the existence of the
+	// given key-value pairs, or of the absence of the keys with empty values, at a specified height.
+	VerifyMultiProof(ctx sdk.Context, clientID string, height exported.Height, delayTimePeriod uint64, delayBlockPeriod uint64, proof []byte, paths []exported.Path, values [][]byte) error
 	// GetClientStatus returns the status of a client given the client ID
 	GetClientStatus(ctx sdk.Context, clientID string) exported.Status
 	// GetClientLatestHeight returns the latest height of a client given the client ID
@@ -37,28 +37,49 @@ func (ps PacketSequence) Validate() error {
 	return validateGenFields(ps.ClientId, ps.Sequence)
 }
 
+// NewPortSequence creates a new PortSequence instance.
+func NewPortSequence(clientID, portID string, sequence uint64) PortSequence {
+	return PortSequence{
+		ClientId: clientID,
+		PortId:   portID,
+		Sequence: sequence,
+	}
+}
+
+// Validate performs basic validation of fields returning an error upon any failure.
+func (ps PortSequence) Validate() error {
+	if err := host.PortIdentifierValidator(ps.PortId); err != nil {
+		return fmt.Errorf("invalid port Id: %w", err)
+	}
+	return validateGenFields(ps.ClientId, ps.Sequence)
+}
+
 // NewGenesisState creates a GenesisState instance.
 func NewGenesisState(
 	acks, receipts, commitments, asyncPackets []PacketState,
-	sendSeqs []PacketSequence,
+	sendSeqs []PacketSequence, recvSeqs, portSendSeqs []PortSequence,
 ) GenesisState {
 	return GenesisState{
-		Acknowledgements: acks,
-		Receipts:         receipts,
-		Commitments:      commitments,
-		AsyncPackets:     asyncPackets,
-		SendSequences:    sendSeqs,
+		Acknowledgements:  acks,
+		Receipts:          receipts,
+		Commitments:       commitments,
+		AsyncPackets:      asyncPackets,
+		SendSequences:     sendSeqs,
+		RecvSequences:     recvSeqs,
+		PortSendSequences: portSendSeqs,
 	}
 }
 
 // DefaultGenesisState returns the ibc channel v2 submodule's default genesis state.
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Acknowledgements: []PacketState{},
-		Receipts:         []PacketState{},
-		Commitments:      []PacketState{},
-		AsyncPackets:     []PacketState{},
-		SendSequences:    []PacketSequence{},
+		Acknowledgements:  []PacketState{},
+		Receipts:          []PacketState{},
+		Commitments:       []PacketState{},
+		AsyncPackets:      []PacketState{},
+		SendSequences:     []PacketSequence{},
+		RecvSequences:     []PortSequence{},
+		PortSendSequences: []PortSequence{},
 	}
 }
 
@@ -103,6 +124,18 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for i, rs := range gs.RecvSequences {
+		if err := rs.Validate(); err != nil {
+			return fmt.Errorf("invalid recv sequence %v index %d: %w", rs, i, err)
+		}
+	}
+
+	for i, ps := range gs.PortSendSequences {
+		if err := ps.Validate(); err != nil {
+			return fmt.Errorf("invalid port send sequence %v index %d: %w", ps, i, err)
+		}
+	}
+
 	return nil
 }
 
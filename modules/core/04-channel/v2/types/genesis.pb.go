@@ -25,11 +25,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the ibc channel/v2 submodule's genesis state.
 type GenesisState struct {
-	Acknowledgements []PacketState    `protobuf:"bytes,2,rep,name=acknowledgements,proto3" json:"acknowledgements"`
-	Commitments      []PacketState    `protobuf:"bytes,3,rep,name=commitments,proto3" json:"commitments"`
-	Receipts         []PacketState    `protobuf:"bytes,4,rep,name=receipts,proto3" json:"receipts"`
-	AsyncPackets     []PacketState    `protobuf:"bytes,5,rep,name=async_packets,json=asyncPackets,proto3" json:"async_packets"`
-	SendSequences    []PacketSequence `protobuf:"bytes,6,rep,name=send_sequences,json=sendSequences,proto3" json:"send_sequences"`
+	Acknowledgements  []PacketState    `protobuf:"bytes,2,rep,name=acknowledgements,proto3" json:"acknowledgements"`
+	Commitments       []PacketState    `protobuf:"bytes,3,rep,name=commitments,proto3" json:"commitments"`
+	Receipts          []PacketState    `protobuf:"bytes,4,rep,name=receipts,proto3" json:"receipts"`
+	AsyncPackets      []PacketState    `protobuf:"bytes,5,rep,name=async_packets,json=asyncPackets,proto3" json:"async_packets"`
+	SendSequences     []PacketSequence `protobuf:"bytes,6,rep,name=send_sequences,json=sendSequences,proto3" json:"send_sequences"`
+	RecvSequences     []PortSequence   `protobuf:"bytes,7,rep,name=recv_sequences,json=recvSequences,proto3" json:"recv_sequences"`
+	PortSendSequences []PortSequence   `protobuf:"bytes,8,rep,name=port_send_sequences,json=portSendSequences,proto3" json:"port_send_sequences"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -100,6 +102,20 @@ func (m *GenesisState) GetSendSequences() []PacketSequence {
 	return nil
 }
 
+func (m *GenesisState) GetRecvSequences() []PortSequence {
+	if m != nil {
+		return m.RecvSequences
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPortSendSequences() []PortSequence {
+	if m != nil {
+		return m.PortSendSequences
+	}
+	return nil
+}
+
 // PacketState defines the generic type necessary to retrieve and store
 // packet commitments, acknowledgements, and receipts.
 // Caller is responsible for knowing the context necessary to interpret this
@@ -201,41 +217,110 @@ func (m *PacketSequence) GetSequence() uint64 {
 	return 0
 }
 
+// PortSequence defines the genesis type necessary to retrieve and store the next send and receive sequences of ports.
+type PortSequence struct {
+	// client unique identifier.
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// port unique identifier.
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// packet sequence
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *PortSequence) Reset()         { *m = PortSequence{} }
+func (m *PortSequence) String() string { return proto.CompactTextString(m) }
+func (*PortSequence) ProtoMessage()    {}
+func (*PortSequence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5d374f126f051c3, []int{3}
+}
+func (m *PortSequence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PortSequence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PortSequence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PortSequence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortSequence.Merge(m, src)
+}
+func (m *PortSequence) XXX_Size() int {
+	return m.Size()
+}
+func (m *PortSequence) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortSequence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortSequence proto.InternalMessageInfo
+
+func (m *PortSequence) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *PortSequence) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *PortSequence) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.core.channel.v2.GenesisState")
 	proto.RegisterType((*PacketState)(nil), "ibc.core.channel.v2.PacketState")
 	proto.RegisterType((*PacketSequence)(nil), "ibc.core.channel.v2.PacketSequence")
+	proto.RegisterType((*PortSequence)(nil), "ibc.core.channel.v2.PortSequence")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v2/genesis.proto", fileDescriptor_b5d374f126f051c3) }
 
 var fileDescriptor_b5d374f126f051c3 = []byte{
-	// 394 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x3f, 0x6f, 0xda, 0x40,
-	0x18, 0xc6, 0x6d, 0xec, 0x22, 0x38, 0xfe, 0xa8, 0xba, 0x76, 0xb0, 0xa8, 0x64, 0x5c, 0xba, 0x78,
-	0xc1, 0x47, 0x69, 0xa7, 0x4a, 0x5d, 0x58, 0x5a, 0xd4, 0x05, 0xb9, 0x43, 0xa5, 0x2e, 0xd4, 0x3e,
-	0xbf, 0x32, 0x27, 0xec, 0x3b, 0x97, 0x3b, 0x88, 0xf8, 0x06, 0xd9, 0x92, 0x8f, 0x90, 0x8f, 0xc3,
-	0xc8, 0x98, 0x29, 0x8a, 0xe0, 0x8b, 0x44, 0xd8, 0x80, 0x88, 0x12, 0x45, 0x22, 0xdb, 0xeb, 0xd7,
-	0xcf, 0xef, 0xf7, 0xde, 0xf0, 0xa0, 0x8f, 0x2c, 0xa4, 0x84, 0x8a, 0x19, 0x10, 0x3a, 0x09, 0x38,
-	0x87, 0x84, 0x2c, 0xfa, 0x24, 0x06, 0x0e, 0x92, 0x49, 0x2f, 0x9b, 0x09, 0x25, 0xf0, 0x3b, 0x16,
-	0x52, 0x6f, 0x17, 0xf1, 0xf6, 0x11, 0x6f, 0xd1, 0x6f, 0xbd, 0x8f, 0x45, 0x2c, 0xf2, 0xff, 0x64,
-	0x37, 0x15, 0xd1, 0xce, 0x95, 0x81, 0xea, 0x3f, 0x0a, 0xf8, 0xb7, 0x0a, 0x14, 0x60, 0x1f, 0xbd,
-	0x0d, 0xe8, 0x94, 0x8b, 0x8b, 0x04, 0xa2, 0x18, 0x52, 0xe0, 0x4a, 0x5a, 0x25, 0xc7, 0x70, 0x6b,
-	0x7d, 0xc7, 0x7b, 0x46, 0xeb, 0x8d, 0x02, 0x3a, 0x05, 0x95, 0xb3, 0x03, 0x73, 0x75, 0xd7, 0xd6,
-	0xfc, 0x27, 0x3c, 0xfe, 0x89, 0x6a, 0x54, 0xa4, 0x29, 0x53, 0x85, 0xce, 0x38, 0x4b, 0x77, 0x8a,
-	0xe2, 0x01, 0xaa, 0xcc, 0x80, 0x02, 0xcb, 0x94, 0xb4, 0xcc, 0xb3, 0x34, 0x47, 0x0e, 0xff, 0x42,
-	0x8d, 0x40, 0x2e, 0x39, 0x1d, 0x67, 0x79, 0x48, 0x5a, 0x6f, 0xce, 0x12, 0xd5, 0x73, 0xb8, 0xd8,
-	0x4b, 0x3c, 0x42, 0x4d, 0x09, 0x3c, 0x1a, 0x4b, 0xf8, 0x3f, 0x07, 0x4e, 0x41, 0x5a, 0xe5, 0xdc,
-	0xf6, 0xe9, 0x25, 0xdb, 0x3e, 0xbb, 0x17, 0x36, 0x76, 0x82, 0xc3, 0x4e, 0x76, 0xfe, 0xa1, 0xda,
-	0xc9, 0x51, 0xfc, 0x01, 0x55, 0x69, 0xc2, 0x80, 0xab, 0x31, 0x8b, 0x2c, 0xdd, 0xd1, 0xdd, 0xaa,
-	0x5f, 0x29, 0x16, 0xc3, 0x08, 0xb7, 0x50, 0xe5, 0x70, 0xd8, 0x2a, 0x39, 0xba, 0x6b, 0xfa, 0xc7,
-	0x6f, 0x8c, 0x91, 0x19, 0x05, 0x2a, 0xb0, 0x0c, 0x47, 0x77, 0xeb, 0x7e, 0x3e, 0x7f, 0x33, 0x2f,
-	0x6f, 0xda, 0x5a, 0x67, 0x88, 0x9a, 0x8f, 0x1f, 0xf2, 0xea, 0x23, 0x83, 0x3f, 0xab, 0x8d, 0xad,
-	0xaf, 0x37, 0xb6, 0x7e, 0xbf, 0xb1, 0xf5, 0xeb, 0xad, 0xad, 0xad, 0xb7, 0xb6, 0x76, 0xbb, 0xb5,
-	0xb5, 0xbf, 0xdf, 0x63, 0xa6, 0x26, 0xf3, 0xd0, 0xa3, 0x22, 0x25, 0x54, 0xc8, 0x54, 0x48, 0xc2,
-	0x42, 0xda, 0x8d, 0x05, 0x59, 0x7c, 0xee, 0x91, 0x54, 0x44, 0xf3, 0x04, 0x64, 0xd1, 0xe3, 0xde,
-	0xd7, 0xee, 0x49, 0x95, 0xd5, 0x32, 0x03, 0x19, 0x96, 0xf3, 0x7a, 0x7e, 0x79, 0x08, 0x00, 0x00,
-	0xff, 0xff, 0x9c, 0x0e, 0x36, 0xab, 0xee, 0x02, 0x00, 0x00,
+	// 463 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
+	0x14, 0x85, 0xed, 0xc6, 0xa4, 0xc9, 0x4d, 0x1a, 0xc1, 0x14, 0x09, 0xab, 0x48, 0xae, 0x1b, 0x36,
+	0xd9, 0xd4, 0x53, 0x02, 0x2b, 0x24, 0x36, 0xd9, 0x40, 0x85, 0x84, 0x2a, 0xb3, 0xa8, 0xc4, 0x26,
+	0xb5, 0xc7, 0x57, 0xee, 0xa8, 0xf1, 0x8c, 0xf1, 0x4c, 0x82, 0xfa, 0x06, 0x2c, 0x79, 0x04, 0x1e,
+	0x83, 0x47, 0xe8, 0xb2, 0x4b, 0x56, 0x08, 0x25, 0x2f, 0x82, 0x3c, 0x4e, 0x8b, 0xfb, 0xa3, 0x4a,
+	0x66, 0x37, 0x73, 0x7c, 0xce, 0x77, 0xc6, 0x57, 0xba, 0xb0, 0xc7, 0x63, 0x46, 0x99, 0x2c, 0x90,
+	0xb2, 0xd3, 0x48, 0x08, 0x9c, 0xd1, 0xc5, 0x98, 0xa6, 0x28, 0x50, 0x71, 0x15, 0xe4, 0x85, 0xd4,
+	0x92, 0x6c, 0xf3, 0x98, 0x05, 0xa5, 0x25, 0x58, 0x5b, 0x82, 0xc5, 0x78, 0xe7, 0x69, 0x2a, 0x53,
+	0x69, 0xbe, 0xd3, 0xf2, 0x54, 0x59, 0x87, 0x3f, 0x1d, 0xe8, 0xbf, 0xab, 0xc2, 0x9f, 0x74, 0xa4,
+	0x91, 0x84, 0xf0, 0x38, 0x62, 0x67, 0x42, 0x7e, 0x9d, 0x61, 0x92, 0x62, 0x86, 0x42, 0x2b, 0x77,
+	0xc3, 0x6f, 0x8d, 0x7a, 0x63, 0x3f, 0xb8, 0x07, 0x1b, 0x1c, 0x45, 0xec, 0x0c, 0xb5, 0xc9, 0x4e,
+	0x9c, 0x8b, 0xdf, 0xbb, 0x56, 0x78, 0x27, 0x4f, 0xde, 0x43, 0x8f, 0xc9, 0x2c, 0xe3, 0xba, 0xc2,
+	0xb5, 0x1a, 0xe1, 0xea, 0x51, 0x32, 0x81, 0x4e, 0x81, 0x0c, 0x79, 0xae, 0x95, 0xeb, 0x34, 0xc2,
+	0x5c, 0xe7, 0xc8, 0x07, 0xd8, 0x8a, 0xd4, 0xb9, 0x60, 0xd3, 0xdc, 0x98, 0x94, 0xfb, 0xa8, 0x11,
+	0xa8, 0x6f, 0xc2, 0x95, 0xae, 0xc8, 0x11, 0x0c, 0x14, 0x8a, 0x64, 0xaa, 0xf0, 0xcb, 0x1c, 0x05,
+	0x43, 0xe5, 0xb6, 0x0d, 0xed, 0xc5, 0x43, 0xb4, 0xb5, 0x77, 0x0d, 0xdc, 0x2a, 0x01, 0x57, 0x9a,
+	0x22, 0x1f, 0x61, 0x50, 0x20, 0x5b, 0xd4, 0x88, 0x9b, 0x86, 0xb8, 0x77, 0x3f, 0x51, 0x16, 0x77,
+	0x78, 0x65, 0xfc, 0x1f, 0xef, 0x18, 0xb6, 0x73, 0x59, 0xe8, 0xe9, 0xad, 0x67, 0x76, 0x9a, 0x41,
+	0x9f, 0xe4, 0x46, 0xab, 0x3d, 0x74, 0x78, 0x02, 0xbd, 0xda, 0x74, 0xc8, 0x73, 0xe8, 0xb2, 0x19,
+	0x47, 0xa1, 0xa7, 0x3c, 0x71, 0x6d, 0xdf, 0x1e, 0x75, 0xc3, 0x4e, 0x25, 0x1c, 0x26, 0x64, 0x07,
+	0x3a, 0x57, 0xd5, 0xee, 0x86, 0x6f, 0x8f, 0x9c, 0xf0, 0xfa, 0x4e, 0x08, 0x38, 0x49, 0xa4, 0x23,
+	0xb7, 0xe5, 0xdb, 0xa3, 0x7e, 0x68, 0xce, 0x6f, 0x9c, 0x6f, 0x3f, 0x76, 0xad, 0xe1, 0x21, 0x0c,
+	0x6e, 0x4e, 0xec, 0xbf, 0x4b, 0x86, 0x27, 0xd0, 0xaf, 0xff, 0xd5, 0xc3, 0xa0, 0x67, 0xb0, 0x69,
+	0x46, 0xc6, 0x13, 0xc3, 0xe9, 0x86, 0xed, 0xf2, 0x7a, 0xab, 0xa1, 0x75, 0xb3, 0x61, 0x72, 0x7c,
+	0xb1, 0xf4, 0xec, 0xcb, 0xa5, 0x67, 0xff, 0x59, 0x7a, 0xf6, 0xf7, 0x95, 0x67, 0x5d, 0xae, 0x3c,
+	0xeb, 0xd7, 0xca, 0xb3, 0x3e, 0xbf, 0x4d, 0xb9, 0x3e, 0x9d, 0xc7, 0x01, 0x93, 0x19, 0x65, 0x52,
+	0x65, 0x52, 0x51, 0x1e, 0xb3, 0xfd, 0x54, 0xd2, 0xc5, 0xcb, 0x03, 0x9a, 0xc9, 0x64, 0x3e, 0x43,
+	0x55, 0xad, 0xf4, 0xc1, 0xeb, 0xfd, 0xda, 0x56, 0xeb, 0xf3, 0x1c, 0x55, 0xdc, 0x36, 0x9b, 0xfa,
+	0xea, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xac, 0x07, 0x0b, 0xd6, 0xf9, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -258,6 +343,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PortSendSequences) > 0 {
+		for iNdEx := len(m.PortSendSequences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PortSendSequences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.RecvSequences) > 0 {
+		for iNdEx := len(m.RecvSequences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecvSequences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.SendSequences) > 0 {
 		for iNdEx := len(m.SendSequences) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -408,6 +521,48 @@ func (m *PacketSequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PortSequence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PortSequence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PortSequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -455,6 +610,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RecvSequences) > 0 {
+		for _, e := range m.RecvSequences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PortSendSequences) > 0 {
+		for _, e := range m.PortSendSequences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -494,6 +661,26 @@ func (m *PacketSequence) Size() (n int) {
 	return n
 }
 
+func (m *PortSequence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.Sequence))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -699,6 +886,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvSequences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecvSequences = append(m.RecvSequences, PortSequence{})
+			if err := m.RecvSequences[len(m.RecvSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortSendSequences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortSendSequences = append(m.PortSendSequences, PortSequence{})
+			if err := m.PortSendSequences[len(m.PortSendSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -956,6 +1211,139 @@ func (m *PacketSequence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PortSequence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PortSequence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PortSequence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
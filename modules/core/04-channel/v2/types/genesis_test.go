@@ -29,6 +29,8 @@ func TestValidateGenesis(t *testing.T) {
 				[]types.PacketState{types.NewPacketState(ibctesting.FirstChannelID, 1, []byte("commit_hash"))},
 				[]types.PacketState{types.NewPacketState(ibctesting.SecondChannelID, 1, []byte("async_packet"))},
 				[]types.PacketSequence{types.NewPacketSequence(ibctesting.SecondChannelID, 1)},
+				[]types.PortSequence{types.NewPortSequence(ibctesting.SecondChannelID, ibctesting.MockPort, 1)},
+				[]types.PortSequence{types.NewPortSequence(ibctesting.FirstChannelID, ibctesting.MockPort, 1)},
 			),
 			nil,
 		},
@@ -68,6 +70,24 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			errors.New("sequence cannot be 0"),
 		},
+		{
+			"invalid recv seq",
+			types.GenesisState{
+				RecvSequences: []types.PortSequence{
+					types.NewPortSequence(ibctesting.FirstChannelID, ibctesting.MockPort, 0),
+				},
+			},
+			errors.New("sequence cannot be 0"),
+		},
+		{
+			"invalid recv seq port",
+			types.GenesisState{
+				RecvSequences: []types.PortSequence{
+					types.NewPortSequence(ibctesting.FirstChannelID, "", 1),
+				},
+			},
+			errors.New("invalid port Id"),
+		},
 	}
 
 	for _, tc := range testCases {
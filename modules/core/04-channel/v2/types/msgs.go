@@ -27,6 +27,15 @@ var (
 
 	_ sdk.Msg              = (*MsgAcknowledgement)(nil)
 	_ sdk.HasValidateBasic = (*MsgAcknowledgement)(nil)
+
+	_ sdk.Msg              = (*MsgRecvPackets)(nil)
+	_ sdk.HasValidateBasic = (*MsgRecvPackets)(nil)
+
+	_ sdk.Msg              = (*MsgTimeouts)(nil)
+	_ sdk.HasValidateBasic = (*MsgTimeouts)(nil)
+
+	_ sdk.Msg              = (*MsgAcknowledgements)(nil)
+	_ sdk.HasValidateBasic = (*MsgAcknowledgements)(nil)
 )
 
 // NewMsgSendPacket creates a new MsgSendPacket instance.
@@ -49,8 +58,8 @@ func (msg *MsgSendPacket) ValidateBasic() error {
 		return errorsmod.Wrap(ErrInvalidTimeout, "timeout must not be 0")
 	}
 
-	if len(msg.Payloads) != 1 {
-		return errorsmod.Wrapf(ErrInvalidPayload, "payloads must be of length 1, got %d instead", len(msg.Payloads))
+	if len(msg.Payloads) == 0 {
+		return errorsmod.Wrap(ErrInvalidPayload, "payloads must not be empty")
 	}
 
 	for _, pd := range msg.Payloads {
@@ -143,3 +152,112 @@ func (msg *MsgTimeout) ValidateBasic() error {
 
 	return msg.Packet.ValidateBasic()
 }
+
+// NewMsgRecvPackets creates a new MsgRecvPackets instance.
+func NewMsgRecvPackets(packets []Packet, proofCommitments []byte, proofHeight clienttypes.Height, signer string) *MsgRecvPackets {
+	return &MsgRecvPackets{
+		Packets:          packets,
+		ProofCommitments: proofCommitments,
+		ProofHeight:      proofHeight,
+		Signer:           signer,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgRecvPackets.
+func (msg *MsgRecvPackets) ValidateBasic() error {
+	if len(msg.ProofCommitments) == 0 {
+		return errorsmod.Wrap(commitmenttypesv1.ErrInvalidProof, "proof commitments can not be empty")
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return validatePacketBatch(msg.Packets)
+}
+
+// NewMsgTimeouts creates a new MsgTimeouts instance
+func NewMsgTimeouts(packets []Packet, proofUnreceived []byte, proofHeight clienttypes.Height, signer string) *MsgTimeouts {
+	return &MsgTimeouts{
+		Packets:         packets,
+		ProofUnreceived: proofUnreceived,
+		ProofHeight:     proofHeight,
+		Signer:          signer,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgTimeouts
+func (msg *MsgTimeouts) ValidateBasic() error {
+	if len(msg.ProofUnreceived) == 0 {
+		return errorsmod.Wrap(commitmenttypesv1.ErrInvalidProof, "proof unreceived can not be empty")
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return validatePacketBatch(msg.Packets)
+}
+
+// NewMsgAcknowledgements creates a new MsgAcknowledgements instance
+func NewMsgAcknowledgements(packets []Packet, acknowledgements []Acknowledgement, proofAcked []byte, proofHeight clienttypes.Height, signer string) *MsgAcknowledgements {
+	return &MsgAcknowledgements{
+		Packets:          packets,
+		Acknowledgements: acknowledgements,
+		ProofAcked:       proofAcked,
+		ProofHeight:      proofHeight,
+		Signer:           signer,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgAcknowledgements.
+func (msg *MsgAcknowledgements) ValidateBasic() error {
+	if len(msg.ProofAcked) == 0 {
+		return errorsmod.Wrap(commitmenttypesv1.ErrInvalidProof, "cannot submit an empty acknowledgement proof")
+	}
+
+	if len(msg.Acknowledgements) != len(msg.Packets) {
+		return errorsmod.Wrapf(ErrInvalidAcknowledgement, "number of acknowledgements (%d) does not match number of packets (%d)", len(msg.Acknowledgements), len(msg.Packets))
+	}
+
+	for _, ack := range msg.Acknowledgements {
+		if err := ack.Validate(); err != nil {
+			return err
+		}
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return validatePacketBatch(msg.Packets)
+}
+
+// validatePacketBatch performs basic checks on a batch of packets. The batch must not be empty, all packets
+// must be sent and received on the same clients and the sequence of each packet must be unique.
+func validatePacketBatch(packets []Packet) error {
+	if len(packets) == 0 {
+		return errorsmod.Wrap(ErrInvalidPacket, "packets must not be empty")
+	}
+
+	sequences := make(map[uint64]struct{}, len(packets))
+	for i, packet := range packets {
+		if err := packet.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "invalid packet at index %d", i)
+		}
+
+		if packet.SourceClient != packets[0].SourceClient || packet.DestinationClient != packets[0].DestinationClient {
+			return errorsmod.Wrapf(ErrInvalidPacket, "packet at index %d is not sent on the clients of the first packet (%s, %s)", i, packets[0].SourceClient, packets[0].DestinationClient)
+		}
+
+		if _, found := sequences[packet.Sequence]; found {
+			return errorsmod.Wrapf(ErrInvalidPacket, "duplicate packet sequence %d", packet.Sequence)
+		}
+		sequences[packet.Sequence] = struct{}{}
+	}
+
+	return nil
+}
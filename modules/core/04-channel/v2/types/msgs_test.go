@@ -61,11 +61,10 @@ func (s *TypesTestSuite) TestMsgSendPacketValidateBasic() {
 			expError: types.ErrInvalidTimeout,
 		},
 		{
-			name: "failure: invalid length for payload",
+			name: "success, multiple payloads",
 			malleate: func() {
-				msg.Payloads = []types.Payload{{}, {}}
+				msg.Payloads = append(msg.Payloads, msg.Payloads[0])
 			},
-			expError: types.ErrInvalidPayload,
 		},
 		{
 			name: "failure: invalid packetdata",
@@ -136,11 +135,10 @@ func (s *TypesTestSuite) TestMsgRecvPacketValidateBasic() {
 			expError: commitmenttypes.ErrInvalidProof,
 		},
 		{
-			name: "failure: invalid length for packet payloads",
+			name: "success, multiple packet payloads",
 			malleate: func() {
-				msg.Packet.Payloads = []types.Payload{{}, {}}
+				msg.Packet.Payloads = append(msg.Packet.Payloads, msg.Packet.Payloads[0])
 			},
-			expError: types.ErrInvalidPacket,
 		},
 		{
 			name: "failure: invalid signer",
@@ -190,11 +188,10 @@ func (s *TypesTestSuite) TestMsgAcknowledge_ValidateBasic() {
 			expError: commitmenttypes.ErrInvalidProof,
 		},
 		{
-			name: "failure: invalid length for packet payloads",
+			name: "success, multiple packet payloads",
 			malleate: func() {
-				msg.Packet.Payloads = []types.Payload{{}, {}}
+				msg.Packet.Payloads = append(msg.Packet.Payloads, msg.Packet.Payloads[0])
 			},
-			expError: types.ErrInvalidPacket,
 		},
 		{
 			name: "failure: invalid signer",
@@ -261,11 +258,10 @@ func (s *TypesTestSuite) TestMsgTimeoutValidateBasic() {
 			expError: ibcerrors.ErrInvalidAddress,
 		},
 		{
-			name: "failure: invalid length for packet payloads",
+			name: "success, multiple packet payloads",
 			malleate: func() {
-				msg.Packet.Payloads = []types.Payload{{}, {}}
+				msg.Packet.Payloads = append(msg.Packet.Payloads, msg.Packet.Payloads[0])
 			},
-			expError: types.ErrInvalidPacket,
 		},
 		{
 			name: "failure: invalid packet",
@@ -303,3 +299,228 @@ func (s *TypesTestSuite) TestMsgTimeoutValidateBasic() {
 		})
 	}
 }
+
+func (s *TypesTestSuite) TestMsgRecvPacketsValidateBasic() {
+	var msg *types.MsgRecvPackets
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			name:     "success",
+			malleate: func() {},
+		},
+		{
+			name: "failure: empty packets",
+			malleate: func() {
+				msg.Packets = []types.Packet{}
+			},
+			expError: types.ErrInvalidPacket,
+		},
+		{
+			name: "failure: invalid packet",
+			malleate: func() {
+				msg.Packets[1].Payloads = []types.Payload{}
+			},
+			expError: types.ErrInvalidPacket,
+		},
+		{
+			name: "failure: packets received on different clients",
+			malleate: func() {
+				msg.Packets[1].DestinationClient = ibctesting.FirstClientID
+			},
+			expError: types.ErrInvalidPacket,
+		},
+		{
+			name: "failure: duplicate packet sequence",
+			malleate: func() {
+				msg.Packets[1].Sequence = msg.Packets[0].Sequence
+			},
+			expError: types.ErrInvalidPacket,
+		},
+		{
+			name: "failure: invalid proof commitments",
+			malleate: func() {
+				msg.ProofCommitments = []byte{}
+			},
+			expError: commitmenttypes.ErrInvalidProof,
+		},
+		{
+			name: "failure: invalid signer",
+			malleate: func() {
+				msg.Signer = ""
+			},
+			expError: ibcerrors.ErrInvalidAddress,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			packets := []types.Packet{
+				types.NewPacket(1, ibctesting.FirstChannelID, ibctesting.SecondChannelID, s.chainA.GetTimeoutTimestamp(), mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)),
+				types.NewPacket(2, ibctesting.FirstChannelID, ibctesting.SecondChannelID, s.chainA.GetTimeoutTimestamp(), mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)),
+			}
+
+			msg = types.NewMsgRecvPackets(packets, testProof, s.chainA.GetTimeoutHeight(), s.chainA.SenderAccount.GetAddress().String())
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+
+			expPass := tc.expError == nil
+
+			if expPass {
+				s.Require().NoError(err)
+			} else {
+				ibctesting.RequireErrorIsOrContains(s.T(), err, tc.expError)
+			}
+		})
+	}
+}
+
+func (s *TypesTestSuite) TestMsgAcknowledgementsValidateBasic() {
+	var msg *types.MsgAcknowledgements
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			name:     "success",
+			malleate: func() {},
+		},
+		{
+			name: "failure: empty packets",
+			malleate: func() {
+				msg.Packets = []types.Packet{}
+				msg.Acknowledgements = []types.Acknowledgement{}
+			},
+			expError: types.ErrInvalidPacket,
+		},
+		{
+			name: "failure: packets sent on different clients",
+			malleate: func() {
+				msg.Packets[1].SourceClient = ibctesting.FirstClientID
+			},
+			expError: types.ErrInvalidPacket,
+		},
+		{
+			name: "failure: number of acknowledgements does not match number of packets",
+			malleate: func() {
+				msg.Acknowledgements = msg.Acknowledgements[:1]
+			},
+			expError: types.ErrInvalidAcknowledgement,
+		},
+		{
+			name: "failure: invalid acknowledgement",
+			malleate: func() {
+				msg.Acknowledgements[1] = types.NewAcknowledgement([]byte{})
+			},
+			expError: types.ErrInvalidAcknowledgement,
+		},
+		{
+			name: "failure: invalid proof acked",
+			malleate: func() {
+				msg.ProofAcked = []byte{}
+			},
+			expError: commitmenttypes.ErrInvalidProof,
+		},
+		{
+			name: "failure: invalid signer",
+			malleate: func() {
+				msg.Signer = ""
+			},
+			expError: ibcerrors.ErrInvalidAddress,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			packets := []types.Packet{
+				types.NewPacket(1, ibctesting.FirstChannelID, ibctesting.SecondChannelID, s.chainA.GetTimeoutTimestamp(), mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)),
+				types.NewPacket(2, ibctesting.FirstChannelID, ibctesting.SecondChannelID, s.chainA.GetTimeoutTimestamp(), mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)),
+			}
+			acks := []types.Acknowledgement{
+				types.NewAcknowledgement([]byte("appAck1")),
+				types.NewAcknowledgement([]byte("appAck2")),
+			}
+
+			msg = types.NewMsgAcknowledgements(packets, acks, testProof, clienttypes.ZeroHeight(), s.chainA.SenderAccount.GetAddress().String())
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+
+			expPass := tc.expError == nil
+
+			if expPass {
+				s.Require().NoError(err)
+			} else {
+				ibctesting.RequireErrorIsOrContains(s.T(), err, tc.expError)
+			}
+		})
+	}
+}
+
+func (s *TypesTestSuite) TestMsgTimeoutsValidateBasic() {
+	var msg *types.MsgTimeouts
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			name:     "success",
+			malleate: func() {},
+		},
+		{
+			name: "failure: empty packets",
+			malleate: func() {
+				msg.Packets = []types.Packet{}
+			},
+			expError: types.ErrInvalidPacket,
+		},
+		{
+			name: "failure: duplicate packet sequence",
+			malleate: func() {
+				msg.Packets[1].Sequence = msg.Packets[0].Sequence
+			},
+			expError: types.ErrInvalidPacket,
+		},
+		{
+			name: "failure: invalid proof unreceived",
+			malleate: func() {
+				msg.ProofUnreceived = []byte{}
+			},
+			expError: commitmenttypes.ErrInvalidProof,
+		},
+		{
+			name: "failure: invalid signer",
+			malleate: func() {
+				msg.Signer = ""
+			},
+			expError: ibcerrors.ErrInvalidAddress,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			packets := []types.Packet{
+				types.NewPacket(1, ibctesting.FirstChannelID, ibctesting.SecondChannelID, s.chainA.GetTimeoutTimestamp(), mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)),
+				types.NewPacket(2, ibctesting.FirstChannelID, ibctesting.SecondChannelID, s.chainA.GetTimeoutTimestamp(), mockv2.NewMockPayload(mockv2.ModuleNameA, mockv2.ModuleNameB)),
+			}
+
+			msg = types.NewMsgTimeouts(packets, testProof, s.chainA.GetTimeoutHeight(), s.chainA.SenderAccount.GetAddress().String())
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+
+			expPass := tc.expError == nil
+
+			if expPass {
+				s.Require().NoError(err)
+			} else {
+				ibctesting.RequireErrorIsOrContains(s.T(), err, tc.expError)
+			}
+		})
+	}
+}
@@ -33,8 +33,8 @@ func NewPayload(sourcePort, destPort, version, encoding string, value []byte) Pa
 
 // ValidateBasic validates that a Packet satisfies the basic requirements.
 func (p Packet) ValidateBasic() error {
-	if len(p.Payloads) != 1 {
-		return errorsmod.Wrap(ErrInvalidPacket, "payloads must contain exactly one payload")
+	if len(p.Payloads) == 0 {
+		return errorsmod.Wrap(ErrInvalidPacket, "payloads must not be empty")
 	}
 
 	totalPayloadsSize := 0
@@ -62,6 +62,9 @@ func (p Packet) ValidateBasic() error {
 	if p.TimeoutTimestamp == 0 {
 		return errorsmod.Wrap(ErrInvalidPacket, "packet timeout timestamp cannot be 0")
 	}
+	if p.PortSequence != 0 && len(p.Payloads) != 1 {
+		return errorsmod.Wrap(ErrInvalidPacket, "packet port sequence can only be set for packets with a single payload")
+	}
 
 	return nil
 }
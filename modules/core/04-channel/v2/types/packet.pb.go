@@ -73,6 +73,9 @@ type Packet struct {
 	TimeoutTimestamp uint64 `protobuf:"varint,4,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
 	// a list of payloads, each one for a specific application.
 	Payloads []Payload `protobuf:"bytes,5,rep,name=payloads,proto3" json:"payloads"`
+	// sequence of the packet among the packets sent by the sending client to the destination port of its payload.
+	// It is only set for packets with a single payload and is used to receive packets in order on ordered ports.
+	PortSequence uint64 `protobuf:"varint,6,opt,name=port_sequence,json=portSequence,proto3" json:"port_sequence,omitempty"`
 }
 
 func (m *Packet) Reset()         { *m = Packet{} }
@@ -143,6 +146,13 @@ func (m *Packet) GetPayloads() []Payload {
 	return nil
 }
 
+func (m *Packet) GetPortSequence() uint64 {
+	if m != nil {
+		return m.PortSequence
+	}
+	return 0
+}
+
 // Payload contains the source and destination ports and payload for the application (version, encoding, raw bytes)
 type Payload struct {
 	// specifies the source port of the packet.
@@ -341,44 +351,45 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/channel/v2/packet.proto", fileDescriptor_2f814aba9ca97169) }
 
 var fileDescriptor_2f814aba9ca97169 = []byte{
-	// 591 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xc1, 0x6e, 0xd3, 0x4c,
-	0x14, 0x85, 0x33, 0x4d, 0xd2, 0x36, 0xd3, 0xfc, 0x7f, 0xdd, 0x69, 0x91, 0x4c, 0x84, 0x52, 0x13,
-	0x24, 0x08, 0xa0, 0xda, 0x6d, 0x60, 0xc3, 0x02, 0xa4, 0xd4, 0x75, 0xa5, 0x0a, 0x14, 0xa2, 0x71,
-	0x22, 0x04, 0x9b, 0x68, 0x32, 0x19, 0xb9, 0x56, 0x6d, 0x8f, 0xf1, 0x8c, 0x5d, 0xf5, 0x15, 0xba,
-	0xe2, 0x05, 0xba, 0x60, 0xcd, 0x8b, 0x74, 0xd9, 0x25, 0x2b, 0x84, 0x5a, 0xf1, 0x1e, 0xc8, 0x63,
-	0xb7, 0x4a, 0x0b, 0xac, 0xec, 0x7b, 0xee, 0x77, 0x7c, 0x75, 0xae, 0x75, 0xa1, 0xe1, 0x4f, 0xa9,
-	0x45, 0x79, 0xc2, 0x2c, 0x7a, 0x48, 0xa2, 0x88, 0x05, 0x56, 0xd6, 0xb3, 0x62, 0x42, 0x8f, 0x98,
-	0x34, 0xe3, 0x84, 0x4b, 0x8e, 0xd6, 0xfd, 0x29, 0x35, 0x73, 0xc2, 0x2c, 0x09, 0x33, 0xeb, 0xb5,
-	0x36, 0x3c, 0xee, 0x71, 0xd5, 0xb7, 0xf2, 0xb7, 0x02, 0xed, 0xfc, 0x02, 0x70, 0x71, 0xa8, 0xbc,
-	0xa8, 0x05, 0x97, 0x05, 0xfb, 0x9c, 0xb2, 0x88, 0x32, 0x1d, 0x18, 0xa0, 0x5b, 0xc3, 0x37, 0x35,
-	0x7a, 0x04, 0xff, 0x13, 0x3c, 0x4d, 0x28, 0x9b, 0xd0, 0xc0, 0x67, 0x91, 0xd4, 0x17, 0x0c, 0xd0,
-	0x6d, 0xe0, 0x66, 0x21, 0xda, 0x4a, 0x43, 0x5b, 0x10, 0xcd, 0x98, 0x90, 0x7e, 0x44, 0xa4, 0xcf,
-	0xa3, 0x6b, 0xb2, 0xaa, 0xc8, 0xb5, 0xb9, 0x4e, 0x89, 0x3f, 0x87, 0x6b, 0xd2, 0x0f, 0x19, 0x4f,
-	0xe5, 0x24, 0x7f, 0x0a, 0x49, 0xc2, 0x58, 0xaf, 0xa9, 0xc1, 0x5a, 0xd9, 0x18, 0x5d, 0xeb, 0xe8,
-	0x0d, 0x5c, 0x8e, 0xc9, 0x49, 0xc0, 0xc9, 0x4c, 0xe8, 0x75, 0xa3, 0xda, 0x5d, 0xe9, 0x3d, 0x30,
-	0xff, 0x92, 0xd2, 0x1c, 0x16, 0xd0, 0x6e, 0xed, 0xfc, 0xc7, 0x66, 0x05, 0xdf, 0x78, 0x3a, 0x5f,
-	0x01, 0x5c, 0x2a, 0x7b, 0x68, 0x13, 0xae, 0x94, 0x61, 0x62, 0x9e, 0x48, 0x95, 0xb5, 0x81, 0x61,
-	0x21, 0x0d, 0x79, 0x22, 0xd1, 0x53, 0xa8, 0xcd, 0x07, 0x51, 0x54, 0x11, 0x78, 0x75, 0x4e, 0x57,
-	0xa8, 0x0e, 0x97, 0x32, 0x96, 0x08, 0x9f, 0x47, 0x65, 0xd0, 0xeb, 0x32, 0x5f, 0x27, 0x8b, 0x28,
-	0x9f, 0xf9, 0x91, 0xa7, 0x52, 0x35, 0xf0, 0x4d, 0x8d, 0x36, 0x60, 0x3d, 0x23, 0x41, 0xca, 0xf4,
-	0xba, 0x01, 0xba, 0x4d, 0x5c, 0x14, 0x9d, 0x3d, 0xb8, 0xda, 0xa7, 0x47, 0x11, 0x3f, 0x0e, 0xd8,
-	0xcc, 0x63, 0x61, 0xbe, 0xa3, 0x1d, 0xb8, 0x41, 0xe2, 0x78, 0x42, 0x6e, 0xcb, 0x42, 0x07, 0x46,
-	0xb5, 0xdb, 0xc4, 0xeb, 0x24, 0x8e, 0xef, 0x38, 0x44, 0xe7, 0x18, 0x6a, 0x98, 0xd1, 0xac, 0xf8,
-	0xa9, 0x98, 0x89, 0x34, 0x90, 0xe8, 0x15, 0x5c, 0x14, 0x92, 0xc8, 0x54, 0xa8, 0xb0, 0xff, 0xf7,
-	0x1e, 0xfe, 0x63, 0x77, 0xb9, 0xc5, 0x55, 0x20, 0x2e, 0x0d, 0xa8, 0x0b, 0x57, 0xef, 0x4c, 0x57,
-	0xab, 0x68, 0xe2, 0xbb, 0xf2, 0xb3, 0x6f, 0x00, 0x36, 0xe7, 0x3f, 0x81, 0x9e, 0xc0, 0xfb, 0xc3,
-	0xbe, 0xfd, 0xd6, 0x19, 0x4d, 0xdc, 0x51, 0x7f, 0x34, 0x76, 0x27, 0xe3, 0x81, 0x3b, 0x74, 0xec,
-	0x83, 0xfd, 0x03, 0x67, 0x4f, 0xab, 0xb4, 0x96, 0x4f, 0xcf, 0x8c, 0xda, 0xe0, 0xfd, 0xc0, 0x41,
-	0x8f, 0xe1, 0xbd, 0xdb, 0xa0, 0x3b, 0xb6, 0x6d, 0xc7, 0x75, 0x35, 0xd0, 0x5a, 0x39, 0x3d, 0x33,
-	0x96, 0xdc, 0x94, 0x52, 0x26, 0xc4, 0x9f, 0xdc, 0x7e, 0xff, 0xe0, 0xdd, 0x18, 0x3b, 0xda, 0x42,
-	0xc1, 0xed, 0x13, 0x3f, 0x48, 0x13, 0x86, 0x3a, 0x70, 0xfd, 0x36, 0xd7, 0x77, 0x3f, 0x0e, 0x6c,
-	0xad, 0xda, 0x6a, 0x9c, 0x9e, 0x19, 0xf5, 0xbe, 0x38, 0x89, 0xe8, 0xee, 0x87, 0xf3, 0xcb, 0x36,
-	0xb8, 0xb8, 0x6c, 0x83, 0x9f, 0x97, 0x6d, 0xf0, 0xe5, 0xaa, 0x5d, 0xb9, 0xb8, 0x6a, 0x57, 0xbe,
-	0x5f, 0xb5, 0x2b, 0x9f, 0x5e, 0x7b, 0xbe, 0x3c, 0x4c, 0xa7, 0x26, 0xe5, 0xa1, 0x45, 0xb9, 0x08,
-	0xb9, 0xb0, 0xfc, 0x29, 0xdd, 0xf2, 0xb8, 0x95, 0xed, 0x6c, 0x5b, 0x21, 0x9f, 0xa5, 0x01, 0x13,
-	0xc5, 0x01, 0x6e, 0xbf, 0xdc, 0x9a, 0xbb, 0x41, 0x79, 0x12, 0x33, 0x31, 0x5d, 0x54, 0x87, 0xf5,
-	0xe2, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x31, 0xab, 0x02, 0xa6, 0xa7, 0x03, 0x00, 0x00,
+	// 603 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xe3, 0xe6, 0x4f, 0x9b, 0x6d, 0xa0, 0xee, 0xb6, 0x48, 0x26, 0x42, 0xa9, 0x09, 0x12,
+	0x04, 0x50, 0xed, 0x36, 0x70, 0xe1, 0x00, 0x52, 0x9a, 0xba, 0x52, 0x05, 0x0a, 0xd1, 0x3a, 0x11,
+	0x82, 0x8b, 0xb5, 0xd9, 0xac, 0x5c, 0xab, 0xb6, 0xd7, 0x78, 0xd7, 0xae, 0xfa, 0x0a, 0x39, 0xf1,
+	0x02, 0x3d, 0x70, 0xe6, 0x45, 0x7a, 0xec, 0x91, 0x13, 0x42, 0xed, 0x8b, 0x20, 0xaf, 0xdd, 0x28,
+	0x0d, 0x70, 0x4a, 0xe6, 0x9b, 0xdf, 0xec, 0xe8, 0xfb, 0xac, 0x01, 0xba, 0x37, 0x21, 0x26, 0x61,
+	0x31, 0x35, 0xc9, 0x09, 0x0e, 0x43, 0xea, 0x9b, 0x69, 0xd7, 0x8c, 0x30, 0x39, 0xa5, 0xc2, 0x88,
+	0x62, 0x26, 0x18, 0xdc, 0xf2, 0x26, 0xc4, 0xc8, 0x08, 0xa3, 0x20, 0x8c, 0xb4, 0xdb, 0xdc, 0x76,
+	0x99, 0xcb, 0x64, 0xdf, 0xcc, 0xfe, 0xe5, 0x68, 0x7b, 0xb6, 0x02, 0x6a, 0x43, 0x39, 0x0b, 0x9b,
+	0x60, 0x8d, 0xd3, 0xaf, 0x09, 0x0d, 0x09, 0xd5, 0x14, 0x5d, 0xe9, 0x54, 0xd0, 0xbc, 0x86, 0x4f,
+	0xc0, 0x3d, 0xce, 0x92, 0x98, 0x50, 0x87, 0xf8, 0x1e, 0x0d, 0x85, 0xb6, 0xa2, 0x2b, 0x9d, 0x3a,
+	0x6a, 0xe4, 0x62, 0x5f, 0x6a, 0x70, 0x17, 0xc0, 0x29, 0xe5, 0xc2, 0x0b, 0xb1, 0xf0, 0x58, 0x78,
+	0x4b, 0x96, 0x25, 0xb9, 0xb9, 0xd0, 0x29, 0xf0, 0x97, 0x60, 0x53, 0x78, 0x01, 0x65, 0x89, 0x70,
+	0xb2, 0x5f, 0x2e, 0x70, 0x10, 0x69, 0x15, 0xb9, 0x58, 0x2d, 0x1a, 0xa3, 0x5b, 0x1d, 0xbe, 0x03,
+	0x6b, 0x11, 0x3e, 0xf7, 0x19, 0x9e, 0x72, 0xad, 0xaa, 0x97, 0x3b, 0xeb, 0xdd, 0x47, 0xc6, 0x3f,
+	0x5c, 0x1a, 0xc3, 0x1c, 0x3a, 0xa8, 0x5c, 0xfe, 0xda, 0x29, 0xa1, 0xf9, 0x4c, 0x66, 0x20, 0x62,
+	0xb1, 0x70, 0xe6, 0x0e, 0x6b, 0x72, 0x51, 0x23, 0x13, 0xed, 0x42, 0x6b, 0x7f, 0x57, 0xc0, 0x6a,
+	0xf1, 0x00, 0xdc, 0x01, 0xeb, 0x85, 0xe3, 0x0c, 0x91, 0x81, 0xd4, 0x11, 0xc8, 0xa5, 0x21, 0x8b,
+	0x05, 0x7c, 0x0e, 0xd4, 0x45, 0xb7, 0x92, 0xca, 0x53, 0xd9, 0x58, 0xd0, 0x25, 0xaa, 0x81, 0xd5,
+	0x94, 0xc6, 0xdc, 0x63, 0x61, 0x91, 0xc6, 0x6d, 0x99, 0x65, 0x4e, 0x43, 0xc2, 0xa6, 0x5e, 0xe8,
+	0x4a, 0xeb, 0x75, 0x34, 0xaf, 0xe1, 0x36, 0xa8, 0xa6, 0xd8, 0x4f, 0xa8, 0x56, 0xd5, 0x95, 0x4e,
+	0x03, 0xe5, 0x45, 0xfb, 0x10, 0x6c, 0xf4, 0xc8, 0x69, 0xc8, 0xce, 0x7c, 0x3a, 0x75, 0x69, 0x90,
+	0x05, 0xb9, 0x0f, 0xb6, 0x71, 0x14, 0x39, 0xf8, 0xae, 0xcc, 0x35, 0x45, 0x2f, 0x77, 0x1a, 0x68,
+	0x0b, 0x47, 0xd1, 0xd2, 0x04, 0x6f, 0x9f, 0x01, 0x15, 0x51, 0x92, 0xe6, 0x5f, 0x1e, 0x51, 0x9e,
+	0xf8, 0x02, 0xbe, 0x01, 0x35, 0x2e, 0xb0, 0x48, 0xb8, 0x34, 0x7b, 0xbf, 0xfb, 0xf8, 0x3f, 0x01,
+	0x67, 0x23, 0xb6, 0x04, 0x51, 0x31, 0x00, 0x3b, 0x60, 0x63, 0x69, 0xbb, 0x8c, 0xa2, 0x81, 0x96,
+	0xe5, 0x17, 0x3f, 0x14, 0xd0, 0x58, 0x7c, 0x02, 0x3e, 0x03, 0x0f, 0x87, 0xbd, 0xfe, 0x7b, 0x6b,
+	0xe4, 0xd8, 0xa3, 0xde, 0x68, 0x6c, 0x3b, 0xe3, 0x81, 0x3d, 0xb4, 0xfa, 0xc7, 0x47, 0xc7, 0xd6,
+	0xa1, 0x5a, 0x6a, 0xae, 0xcd, 0x2e, 0xf4, 0xca, 0xe0, 0xe3, 0xc0, 0x82, 0x4f, 0xc1, 0x83, 0xbb,
+	0xa0, 0x3d, 0xee, 0xf7, 0x2d, 0xdb, 0x56, 0x95, 0xe6, 0xfa, 0xec, 0x42, 0x5f, 0xb5, 0x13, 0x42,
+	0x28, 0xe7, 0x7f, 0x73, 0x47, 0xbd, 0xe3, 0x0f, 0x63, 0x64, 0xa9, 0x2b, 0x39, 0x77, 0x84, 0x3d,
+	0x3f, 0x89, 0x29, 0x6c, 0x83, 0xad, 0xbb, 0x5c, 0xcf, 0xfe, 0x3c, 0xe8, 0xab, 0xe5, 0x66, 0x7d,
+	0x76, 0xa1, 0x57, 0x7b, 0xfc, 0x3c, 0x24, 0x07, 0x9f, 0x2e, 0xaf, 0x5b, 0xca, 0xd5, 0x75, 0x4b,
+	0xf9, 0x7d, 0xdd, 0x52, 0xbe, 0xdd, 0xb4, 0x4a, 0x57, 0x37, 0xad, 0xd2, 0xcf, 0x9b, 0x56, 0xe9,
+	0xcb, 0x5b, 0xd7, 0x13, 0x27, 0xc9, 0xc4, 0x20, 0x2c, 0x30, 0x09, 0xe3, 0x01, 0xe3, 0xa6, 0x37,
+	0x21, 0xbb, 0x2e, 0x33, 0xd3, 0xfd, 0x3d, 0x33, 0x60, 0xd3, 0xc4, 0xa7, 0x3c, 0xbf, 0xd2, 0xbd,
+	0xd7, 0xbb, 0x0b, 0x87, 0x2a, 0xce, 0x23, 0xca, 0x27, 0x35, 0x79, 0x7d, 0xaf, 0xfe, 0x04, 0x00,
+	0x00, 0xff, 0xff, 0x82, 0x4d, 0x54, 0xc3, 0xcc, 0x03, 0x00, 0x00,
 }
 
 func (m *Packet) Marshal() (dAtA []byte, err error) {
@@ -401,6 +412,11 @@ func (m *Packet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PortSequence != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.PortSequence))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Payloads) > 0 {
 		for iNdEx := len(m.Payloads) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -604,6 +620,9 @@ func (m *Packet) Size() (n int) {
 			n += 1 + l + sovPacket(uint64(l))
 		}
 	}
+	if m.PortSequence != 0 {
+		n += 1 + sovPacket(uint64(m.PortSequence))
+	}
 	return n
 }
 
@@ -838,6 +857,25 @@ func (m *Packet) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortSequence", wireType)
+			}
+			m.PortSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PortSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
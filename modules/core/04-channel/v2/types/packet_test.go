@@ -42,7 +42,43 @@ func TestValidateBasic(t *testing.T) {
 			},
 			types.ErrInvalidPacket,
 		},
-		// TODO: add test cases for multiple payloads when enabled (#7008)
+		{
+			"success, multiple payloads",
+			func() {
+				packet.Payloads = append(packet.Payloads, packet.Payloads[0])
+			},
+			nil,
+		},
+		{
+			"success, single payload with port sequence",
+			func() {
+				packet.PortSequence = 1
+			},
+			nil,
+		},
+		{
+			"failure: multiple payloads with port sequence",
+			func() {
+				packet.Payloads = append(packet.Payloads, packet.Payloads[0])
+				packet.PortSequence = 1
+			},
+			types.ErrInvalidPacket,
+		},
+		{
+			"failure: invalid multiple payloads size",
+			func() {
+				packet.Payloads[0].Value = make([]byte, channeltypesv1.MaximumPayloadsSize/2+1)
+				packet.Payloads = append(packet.Payloads, packet.Payloads[0])
+			},
+			types.ErrInvalidPacket,
+		},
+		{
+			"failure: invalid second payload",
+			func() {
+				packet.Payloads = append(packet.Payloads, types.Payload{})
+			},
+			host.ErrInvalidID,
+		},
 		{
 			"failure: payloads is nil",
 			func() {
@@ -22,6 +22,25 @@ func NewQueryNextSequenceSendResponse(
 	}
 }
 
+// NewQueryNextSequenceReceiveRequest creates a new next sequence receive query.
+func NewQueryNextSequenceReceiveRequest(clientID, portID string) *QueryNextSequenceReceiveRequest {
+	return &QueryNextSequenceReceiveRequest{
+		ClientId: clientID,
+		PortId:   portID,
+	}
+}
+
+// NewQueryNextSequenceReceiveResponse creates a new QueryNextSequenceReceiveResponse instance
+func NewQueryNextSequenceReceiveResponse(
+	sequence uint64, proof []byte, height clienttypes.Height,
+) *QueryNextSequenceReceiveResponse {
+	return &QueryNextSequenceReceiveResponse{
+		NextSequenceReceive: sequence,
+		Proof:               proof,
+		ProofHeight:         height,
+	}
+}
+
 // NewQueryPacketCommitmentRequest creates and returns a new packet commitment query request.
 func NewQueryPacketCommitmentRequest(clientID string, sequence uint64) *QueryPacketCommitmentRequest {
 	return &QueryPacketCommitmentRequest{
@@ -141,6 +141,125 @@ func (m *QueryNextSequenceSendResponse) GetProofHeight() types.Height {
 	return types.Height{}
 }
 
+// QueryNextSequenceReceiveRequest is the request type for the Query/QueryNextSequenceReceive RPC method
+type QueryNextSequenceReceiveRequest struct {
+	// client unique identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// port unique identifier
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+}
+
+func (m *QueryNextSequenceReceiveRequest) Reset()         { *m = QueryNextSequenceReceiveRequest{} }
+func (m *QueryNextSequenceReceiveRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNextSequenceReceiveRequest) ProtoMessage()    {}
+func (*QueryNextSequenceReceiveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a328cba4986edcab, []int{2}
+}
+func (m *QueryNextSequenceReceiveRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextSequenceReceiveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextSequenceReceiveRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextSequenceReceiveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextSequenceReceiveRequest.Merge(m, src)
+}
+func (m *QueryNextSequenceReceiveRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextSequenceReceiveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextSequenceReceiveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextSequenceReceiveRequest proto.InternalMessageInfo
+
+func (m *QueryNextSequenceReceiveRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *QueryNextSequenceReceiveRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+// QueryNextSequenceReceiveResponse is the response type for the Query/QueryNextSequenceReceive RPC method
+type QueryNextSequenceReceiveResponse struct {
+	// next sequence receive number
+	NextSequenceReceive uint64 `protobuf:"varint,1,opt,name=next_sequence_receive,json=nextSequenceReceive,proto3" json:"next_sequence_receive,omitempty"`
+	// merkle proof of existence
+	Proof []byte `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	// height at which the proof was retrieved
+	ProofHeight types.Height `protobuf:"bytes,3,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+}
+
+func (m *QueryNextSequenceReceiveResponse) Reset()         { *m = QueryNextSequenceReceiveResponse{} }
+func (m *QueryNextSequenceReceiveResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNextSequenceReceiveResponse) ProtoMessage()    {}
+func (*QueryNextSequenceReceiveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a328cba4986edcab, []int{3}
+}
+func (m *QueryNextSequenceReceiveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextSequenceReceiveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextSequenceReceiveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextSequenceReceiveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextSequenceReceiveResponse.Merge(m, src)
+}
+func (m *QueryNextSequenceReceiveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextSequenceReceiveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextSequenceReceiveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextSequenceReceiveResponse proto.InternalMessageInfo
+
+func (m *QueryNextSequenceReceiveResponse) GetNextSequenceReceive() uint64 {
+	if m != nil {
+		return m.NextSequenceReceive
+	}
+	return 0
+}
+
+func (m *QueryNextSequenceReceiveResponse) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *QueryNextSequenceReceiveResponse) GetProofHeight() types.Height {
+	if m != nil {
+		return m.ProofHeight
+	}
+	return types.Height{}
+}
+
 // QueryPacketCommitmentRequest is the request type for the Query/PacketCommitment RPC method.
 type QueryPacketCommitmentRequest struct {
 	// client unique identifier
@@ -153,7 +272,7 @@ func (m *QueryPacketCommitmentRequest) Reset()         { *m = QueryPacketCommitm
 func (m *QueryPacketCommitmentRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketCommitmentRequest) ProtoMessage()    {}
 func (*QueryPacketCommitmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{2}
+	return fileDescriptor_a328cba4986edcab, []int{4}
 }
 func (m *QueryPacketCommitmentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -210,7 +329,7 @@ func (m *QueryPacketCommitmentResponse) Reset()         { *m = QueryPacketCommit
 func (m *QueryPacketCommitmentResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketCommitmentResponse) ProtoMessage()    {}
 func (*QueryPacketCommitmentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{3}
+	return fileDescriptor_a328cba4986edcab, []int{5}
 }
 func (m *QueryPacketCommitmentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -272,7 +391,7 @@ func (m *QueryPacketCommitmentsRequest) Reset()         { *m = QueryPacketCommit
 func (m *QueryPacketCommitmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketCommitmentsRequest) ProtoMessage()    {}
 func (*QueryPacketCommitmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{4}
+	return fileDescriptor_a328cba4986edcab, []int{6}
 }
 func (m *QueryPacketCommitmentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -329,7 +448,7 @@ func (m *QueryPacketCommitmentsResponse) Reset()         { *m = QueryPacketCommi
 func (m *QueryPacketCommitmentsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketCommitmentsResponse) ProtoMessage()    {}
 func (*QueryPacketCommitmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{5}
+	return fileDescriptor_a328cba4986edcab, []int{7}
 }
 func (m *QueryPacketCommitmentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -391,7 +510,7 @@ func (m *QueryPacketAcknowledgementRequest) Reset()         { *m = QueryPacketAc
 func (m *QueryPacketAcknowledgementRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketAcknowledgementRequest) ProtoMessage()    {}
 func (*QueryPacketAcknowledgementRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{6}
+	return fileDescriptor_a328cba4986edcab, []int{8}
 }
 func (m *QueryPacketAcknowledgementRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -448,7 +567,7 @@ func (m *QueryPacketAcknowledgementResponse) Reset()         { *m = QueryPacketA
 func (m *QueryPacketAcknowledgementResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketAcknowledgementResponse) ProtoMessage()    {}
 func (*QueryPacketAcknowledgementResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{7}
+	return fileDescriptor_a328cba4986edcab, []int{9}
 }
 func (m *QueryPacketAcknowledgementResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -513,7 +632,7 @@ func (m *QueryPacketAcknowledgementsRequest) Reset()         { *m = QueryPacketA
 func (m *QueryPacketAcknowledgementsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketAcknowledgementsRequest) ProtoMessage()    {}
 func (*QueryPacketAcknowledgementsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{8}
+	return fileDescriptor_a328cba4986edcab, []int{10}
 }
 func (m *QueryPacketAcknowledgementsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -577,7 +696,7 @@ func (m *QueryPacketAcknowledgementsResponse) Reset()         { *m = QueryPacket
 func (m *QueryPacketAcknowledgementsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketAcknowledgementsResponse) ProtoMessage()    {}
 func (*QueryPacketAcknowledgementsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{9}
+	return fileDescriptor_a328cba4986edcab, []int{11}
 }
 func (m *QueryPacketAcknowledgementsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -639,7 +758,7 @@ func (m *QueryPacketReceiptRequest) Reset()         { *m = QueryPacketReceiptReq
 func (m *QueryPacketReceiptRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketReceiptRequest) ProtoMessage()    {}
 func (*QueryPacketReceiptRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{10}
+	return fileDescriptor_a328cba4986edcab, []int{12}
 }
 func (m *QueryPacketReceiptRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -696,7 +815,7 @@ func (m *QueryPacketReceiptResponse) Reset()         { *m = QueryPacketReceiptRe
 func (m *QueryPacketReceiptResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketReceiptResponse) ProtoMessage()    {}
 func (*QueryPacketReceiptResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{11}
+	return fileDescriptor_a328cba4986edcab, []int{13}
 }
 func (m *QueryPacketReceiptResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -758,7 +877,7 @@ func (m *QueryUnreceivedPacketsRequest) Reset()         { *m = QueryUnreceivedPa
 func (m *QueryUnreceivedPacketsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUnreceivedPacketsRequest) ProtoMessage()    {}
 func (*QueryUnreceivedPacketsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{12}
+	return fileDescriptor_a328cba4986edcab, []int{14}
 }
 func (m *QueryUnreceivedPacketsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -813,7 +932,7 @@ func (m *QueryUnreceivedPacketsResponse) Reset()         { *m = QueryUnreceivedP
 func (m *QueryUnreceivedPacketsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryUnreceivedPacketsResponse) ProtoMessage()    {}
 func (*QueryUnreceivedPacketsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{13}
+	return fileDescriptor_a328cba4986edcab, []int{15}
 }
 func (m *QueryUnreceivedPacketsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -869,7 +988,7 @@ func (m *QueryUnreceivedAcksRequest) Reset()         { *m = QueryUnreceivedAcksR
 func (m *QueryUnreceivedAcksRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUnreceivedAcksRequest) ProtoMessage()    {}
 func (*QueryUnreceivedAcksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{14}
+	return fileDescriptor_a328cba4986edcab, []int{16}
 }
 func (m *QueryUnreceivedAcksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -925,7 +1044,7 @@ func (m *QueryUnreceivedAcksResponse) Reset()         { *m = QueryUnreceivedAcks
 func (m *QueryUnreceivedAcksResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryUnreceivedAcksResponse) ProtoMessage()    {}
 func (*QueryUnreceivedAcksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a328cba4986edcab, []int{15}
+	return fileDescriptor_a328cba4986edcab, []int{17}
 }
 func (m *QueryUnreceivedAcksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -971,6 +1090,8 @@ func (m *QueryUnreceivedAcksResponse) GetHeight() types.Height {
 func init() {
 	proto.RegisterType((*QueryNextSequenceSendRequest)(nil), "ibc.core.channel.v2.QueryNextSequenceSendRequest")
 	proto.RegisterType((*QueryNextSequenceSendResponse)(nil), "ibc.core.channel.v2.QueryNextSequenceSendResponse")
+	proto.RegisterType((*QueryNextSequenceReceiveRequest)(nil), "ibc.core.channel.v2.QueryNextSequenceReceiveRequest")
+	proto.RegisterType((*QueryNextSequenceReceiveResponse)(nil), "ibc.core.channel.v2.QueryNextSequenceReceiveResponse")
 	proto.RegisterType((*QueryPacketCommitmentRequest)(nil), "ibc.core.channel.v2.QueryPacketCommitmentRequest")
 	proto.RegisterType((*QueryPacketCommitmentResponse)(nil), "ibc.core.channel.v2.QueryPacketCommitmentResponse")
 	proto.RegisterType((*QueryPacketCommitmentsRequest)(nil), "ibc.core.channel.v2.QueryPacketCommitmentsRequest")
@@ -990,72 +1111,78 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/channel/v2/query.proto", fileDescriptor_a328cba4986edcab) }
 
 var fileDescriptor_a328cba4986edcab = []byte{
-	// 1033 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4f, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xce, 0xc4, 0x6e, 0x95, 0xbc, 0x4e, 0x7f, 0xbf, 0x74, 0x08, 0xc8, 0xdd, 0x04, 0xd7, 0x5d,
-	0x24, 0xb0, 0x10, 0xdd, 0x89, 0x5d, 0x04, 0x95, 0xaa, 0x02, 0x49, 0x44, 0x1b, 0x04, 0xaa, 0xca,
-	0x06, 0x54, 0x29, 0xaa, 0x64, 0xad, 0xd7, 0xc3, 0x66, 0xb1, 0xbd, 0xb3, 0xf5, 0xac, 0x4d, 0xaa,
-	0x2a, 0x17, 0xc4, 0x85, 0x1b, 0x52, 0x6f, 0x7c, 0x02, 0xf8, 0x10, 0x20, 0x71, 0x6b, 0x6f, 0x45,
-	0x08, 0x89, 0x13, 0x54, 0x09, 0x12, 0xdf, 0x80, 0x33, 0xf2, 0xcc, 0xd8, 0xde, 0xb5, 0xd7, 0x9b,
-	0xdd, 0xb4, 0x41, 0xdc, 0x66, 0xc7, 0xef, 0x9f, 0xe7, 0x79, 0xe7, 0x79, 0xe7, 0x9d, 0x04, 0x2e,
-	0xba, 0x0d, 0x9b, 0xd8, 0xac, 0x4b, 0x89, 0xbd, 0x67, 0x79, 0x1e, 0x6d, 0x93, 0x7e, 0x8d, 0xdc,
-	0xeb, 0xd1, 0xee, 0x7d, 0xc3, 0xef, 0xb2, 0x80, 0xe1, 0x17, 0xdc, 0x86, 0x6d, 0x0c, 0x0c, 0x0c,
-	0x65, 0x60, 0xf4, 0x6b, 0xda, 0xeb, 0x36, 0xe3, 0x1d, 0xc6, 0x49, 0xc3, 0xe2, 0x54, 0x5a, 0x93,
-	0x7e, 0xb5, 0x41, 0x03, 0xab, 0x4a, 0x7c, 0xcb, 0x71, 0x3d, 0x2b, 0x70, 0x99, 0x27, 0x03, 0x68,
-	0x97, 0xe2, 0x32, 0x38, 0xd4, 0xa3, 0xdc, 0xe5, 0xca, 0x24, 0x04, 0xa2, 0xed, 0x52, 0x2f, 0x20,
-	0xfd, 0xaa, 0x5a, 0x29, 0x83, 0x35, 0x87, 0x31, 0xa7, 0x4d, 0x89, 0xe5, 0xbb, 0xc4, 0xf2, 0x3c,
-	0x16, 0x88, 0x04, 0x43, 0xf7, 0x15, 0x87, 0x39, 0x4c, 0x2c, 0xc9, 0x60, 0x25, 0x77, 0xf5, 0x6b,
-	0xb0, 0xf6, 0xf1, 0x00, 0xd9, 0x2d, 0xba, 0x1f, 0xec, 0xd0, 0x7b, 0x3d, 0xea, 0xd9, 0x74, 0x87,
-	0x7a, 0x4d, 0x73, 0xb0, 0xe6, 0x01, 0x5e, 0x85, 0x45, 0x99, 0xa3, 0xee, 0x36, 0x8b, 0xa8, 0x8c,
-	0x2a, 0x8b, 0xe6, 0x82, 0xdc, 0xf8, 0xa0, 0xa9, 0x7f, 0x87, 0xe0, 0xe5, 0x19, 0xde, 0xdc, 0x67,
-	0x1e, 0xa7, 0xf8, 0x0d, 0xc0, 0x1e, 0xdd, 0x0f, 0xea, 0x5c, 0xfd, 0x58, 0xe7, 0xd4, 0x93, 0x71,
-	0xf2, 0xe6, 0xb2, 0x37, 0xe1, 0x85, 0x57, 0xe0, 0x8c, 0xdf, 0x65, 0xec, 0xb3, 0xe2, 0x7c, 0x19,
-	0x55, 0x96, 0x4c, 0xf9, 0x81, 0xb7, 0x60, 0x49, 0x2c, 0xea, 0x7b, 0xd4, 0x75, 0xf6, 0x82, 0x62,
-	0xae, 0x8c, 0x2a, 0x85, 0x9a, 0x66, 0x8c, 0x4b, 0x2e, 0x8b, 0xd0, 0xaf, 0x1a, 0xdb, 0xc2, 0x62,
-	0x33, 0xff, 0xe8, 0xf7, 0x8b, 0x73, 0x66, 0x41, 0x78, 0xc9, 0x2d, 0xfd, 0x8e, 0xe2, 0x79, 0xdb,
-	0xb2, 0x5b, 0x34, 0xd8, 0x62, 0x9d, 0x8e, 0x1b, 0x74, 0xa8, 0x17, 0xa4, 0xe1, 0x89, 0x35, 0x58,
-	0x18, 0x12, 0x10, 0xd0, 0xf2, 0xe6, 0xe8, 0x5b, 0xff, 0x76, 0x58, 0x83, 0xe9, 0xc8, 0xaa, 0x06,
-	0x25, 0x00, 0x7b, 0xb4, 0x2b, 0x62, 0x2f, 0x99, 0xa1, 0x9d, 0xd3, 0x64, 0xfd, 0xd5, 0x2c, 0x70,
-	0x3c, 0x15, 0xef, 0x1b, 0x00, 0x63, 0xa1, 0x0a, 0x78, 0x85, 0xda, 0xab, 0x86, 0x54, 0xb5, 0x31,
-	0x50, 0xb5, 0x21, 0x7b, 0x40, 0xa9, 0xda, 0xb8, 0x6d, 0x39, 0x54, 0x05, 0x36, 0x43, 0x9e, 0xfa,
-	0x5f, 0x08, 0x4a, 0xb3, 0x60, 0xa8, 0x22, 0x6d, 0x42, 0x61, 0x5c, 0x12, 0x5e, 0x44, 0xe5, 0x5c,
-	0xa5, 0x50, 0x2b, 0x1b, 0x31, 0x6d, 0x65, 0xc8, 0x20, 0x3b, 0x81, 0x15, 0x50, 0x33, 0xec, 0x84,
-	0x6f, 0xc6, 0xc0, 0x7d, 0xed, 0x58, 0xb8, 0x12, 0x40, 0x18, 0x2f, 0xbe, 0x0a, 0x67, 0x33, 0x56,
-	0x5d, 0xd9, 0xeb, 0x77, 0xe1, 0x52, 0x88, 0xe8, 0x86, 0xdd, 0xf2, 0xd8, 0x17, 0x6d, 0xda, 0x74,
-	0xe8, 0x73, 0xd1, 0xda, 0xf7, 0x08, 0xf4, 0xa4, 0xf0, 0xaa, 0x96, 0x15, 0xf8, 0xbf, 0x15, 0xfd,
-	0x49, 0xa9, 0x6e, 0x72, 0xfb, 0x34, 0xa5, 0xf7, 0x38, 0x11, 0xeb, 0xbf, 0xaa, 0x3f, 0xfc, 0x0e,
-	0xac, 0xfa, 0x02, 0x45, 0x7d, 0x2c, 0x97, 0xd1, 0x95, 0xc4, 0x8b, 0xb9, 0x72, 0xae, 0x92, 0x37,
-	0x2f, 0xf8, 0x13, 0xe2, 0x1c, 0x5e, 0x4d, 0x5c, 0xff, 0x1b, 0xc1, 0x2b, 0x89, 0x5c, 0x54, 0xe1,
-	0x3f, 0x82, 0xe5, 0x89, 0x0a, 0xa7, 0x57, 0xf2, 0x94, 0xe7, 0x7f, 0x41, 0xce, 0x9f, 0xc0, 0x85,
-	0x10, 0x6f, 0x93, 0xda, 0xd4, 0xf5, 0x9f, 0x5d, 0xc6, 0x0f, 0x11, 0x68, 0x71, 0x61, 0x55, 0x15,
-	0x35, 0x58, 0xe8, 0x0e, 0xb6, 0xfa, 0xb4, 0x29, 0x5c, 0x17, 0xcc, 0xd1, 0xf7, 0x58, 0xb0, 0xb9,
-	0x24, 0xc1, 0xe6, 0x4f, 0x22, 0xd8, 0x5d, 0x75, 0x55, 0x7e, 0xea, 0x0d, 0xb3, 0x49, 0x78, 0xe9,
-	0xa4, 0xba, 0x06, 0x8b, 0x63, 0x41, 0xcd, 0x0b, 0x41, 0x8d, 0x37, 0xf4, 0x7d, 0x75, 0xff, 0xc5,
-	0xc4, 0x56, 0xa4, 0x23, 0xfe, 0x68, 0xc2, 0x3f, 0x74, 0x82, 0xf3, 0x19, 0x4f, 0xb0, 0xa5, 0x4a,
-	0x3d, 0xce, 0xbc, 0x61, 0xb7, 0xd2, 0x51, 0x5a, 0x87, 0x15, 0xd5, 0x35, 0x96, 0xdd, 0xaa, 0x4f,
-	0xb2, 0xc3, 0xfe, 0xb0, 0x17, 0xc6, 0x7d, 0xd2, 0x83, 0xd5, 0xd8, 0x64, 0xa7, 0xcb, 0xb1, 0xf6,
-	0xf5, 0x39, 0x38, 0x23, 0xf2, 0xe2, 0x1f, 0x11, 0x2c, 0x4f, 0xbe, 0x45, 0x70, 0x35, 0xb6, 0xf7,
-	0x92, 0x5e, 0x3d, 0x5a, 0x2d, 0x8b, 0x8b, 0x64, 0xa7, 0x6f, 0x7d, 0xf9, 0xcb, 0x9f, 0x0f, 0xe7,
-	0xaf, 0xe3, 0x6b, 0x24, 0xee, 0x29, 0x27, 0x29, 0x70, 0xf2, 0x60, 0x54, 0xef, 0x03, 0x32, 0xfd,
-	0x32, 0xc2, 0x8f, 0x11, 0x2c, 0x4f, 0x0e, 0xc9, 0x24, 0x02, 0x33, 0x9e, 0x33, 0x49, 0x04, 0x66,
-	0xbd, 0x53, 0xf4, 0x5b, 0x82, 0xc0, 0x36, 0xbe, 0x91, 0x9a, 0xc0, 0xd4, 0xa5, 0xca, 0xc9, 0x83,
-	0x21, 0x9f, 0x03, 0xfc, 0x13, 0x82, 0xf3, 0x53, 0x03, 0x1f, 0x67, 0x40, 0x36, 0x94, 0xa9, 0x76,
-	0x25, 0x93, 0xcf, 0x89, 0xcf, 0x63, 0x9a, 0x0e, 0xfe, 0x19, 0xc1, 0x8b, 0xb1, 0x97, 0x3e, 0x7e,
-	0xeb, 0x38, 0x4c, 0xf1, 0xc3, 0x5f, 0x7b, 0x3b, 0xb3, 0x9f, 0xe2, 0x73, 0x53, 0xf0, 0xd9, 0xc0,
-	0xef, 0x66, 0xe5, 0x63, 0xd9, 0xad, 0xc8, 0xb9, 0xfc, 0x8a, 0xe0, 0xa5, 0xf8, 0x41, 0x86, 0xb3,
-	0x82, 0x1b, 0x9d, 0xd0, 0xd5, 0xec, 0x8e, 0x8a, 0xd6, 0xb6, 0xa0, 0xb5, 0x89, 0xdf, 0x3b, 0x01,
-	0xad, 0x28, 0xf8, 0x1f, 0x10, 0x9c, 0x8b, 0x4c, 0x14, 0x6c, 0x1c, 0x87, 0x2a, 0x3a, 0xd1, 0x34,
-	0x92, 0xda, 0x5e, 0x81, 0xff, 0x50, 0x80, 0x7f, 0x1f, 0x6f, 0x65, 0x05, 0xdf, 0x95, 0x81, 0x22,
-	0xe7, 0xf2, 0x14, 0xc1, 0xf9, 0xa9, 0x01, 0x91, 0xd4, 0x2f, 0xb3, 0x26, 0x55, 0x52, 0xbf, 0xcc,
-	0x9c, 0x40, 0x7a, 0x43, 0x70, 0xb9, 0x8b, 0x77, 0x9f, 0x4b, 0xfb, 0xf3, 0x03, 0xd2, 0x1b, 0xa5,
-	0xaa, 0xfb, 0x8a, 0xcc, 0x1f, 0x08, 0xfe, 0x17, 0x1d, 0x0e, 0x98, 0xa4, 0xc1, 0x1a, 0x9a, 0x59,
-	0xda, 0x7a, 0x7a, 0x07, 0xc5, 0xec, 0x73, 0xc1, 0xac, 0x89, 0x1b, 0xcf, 0xc4, 0x2c, 0x6e, 0x16,
-	0x46, 0x48, 0x0e, 0xfa, 0x6c, 0xf3, 0xce, 0xa3, 0xc3, 0x12, 0x7a, 0x72, 0x58, 0x42, 0x4f, 0x0f,
-	0x4b, 0xe8, 0x9b, 0xa3, 0xd2, 0xdc, 0x93, 0xa3, 0xd2, 0xdc, 0x6f, 0x47, 0xa5, 0xb9, 0xdd, 0xeb,
-	0x8e, 0x1b, 0xec, 0xf5, 0x1a, 0x86, 0xcd, 0x3a, 0x44, 0xfd, 0x63, 0xc0, 0x6d, 0xd8, 0x97, 0x1d,
-	0x46, 0xfa, 0xd5, 0x75, 0xd2, 0x61, 0xcd, 0x5e, 0x9b, 0x72, 0x89, 0x6e, 0xfd, 0xcd, 0xcb, 0x21,
-	0x80, 0xc1, 0x7d, 0x9f, 0xf2, 0xc6, 0x59, 0xf1, 0xf7, 0xfa, 0x95, 0x7f, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x2d, 0xe8, 0xdc, 0xcf, 0x8b, 0x10, 0x00, 0x00,
+	// 1122 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0xcf, 0x24, 0x69, 0x48, 0x5e, 0x42, 0x49, 0x27, 0x29, 0xa4, 0x4e, 0xd8, 0x6c, 0x8d, 0x04,
+	0x2b, 0x44, 0x3d, 0xd9, 0x6d, 0x81, 0x4a, 0x55, 0x81, 0x24, 0xa2, 0x4d, 0x05, 0x8a, 0x82, 0x03,
+	0x54, 0x8a, 0x2a, 0xad, 0xbc, 0xde, 0xc1, 0x31, 0xbb, 0xeb, 0x71, 0x77, 0xbc, 0x4b, 0xaa, 0x28,
+	0x17, 0xc4, 0x07, 0x40, 0xea, 0x8d, 0x4f, 0x00, 0x47, 0x3e, 0x00, 0x48, 0xdc, 0xda, 0x5b, 0x11,
+	0x42, 0x42, 0x1c, 0xa0, 0x4a, 0x10, 0x7c, 0x03, 0xce, 0x68, 0x67, 0x66, 0xff, 0xd8, 0xeb, 0xdd,
+	0xd8, 0x69, 0x83, 0x7a, 0xb3, 0xc7, 0xef, 0xbd, 0xf9, 0xfd, 0xde, 0xfc, 0xde, 0xbc, 0x27, 0xc3,
+	0xb2, 0x5b, 0xb2, 0x89, 0xcd, 0xea, 0x94, 0xd8, 0xbb, 0x96, 0xe7, 0xd1, 0x2a, 0x69, 0x16, 0xc8,
+	0xdd, 0x06, 0xad, 0xdf, 0x33, 0xfc, 0x3a, 0x0b, 0x18, 0x9e, 0x73, 0x4b, 0xb6, 0xd1, 0x32, 0x30,
+	0x94, 0x81, 0xd1, 0x2c, 0x68, 0xaf, 0xdb, 0x8c, 0xd7, 0x18, 0x27, 0x25, 0x8b, 0x53, 0x69, 0x4d,
+	0x9a, 0xf9, 0x12, 0x0d, 0xac, 0x3c, 0xf1, 0x2d, 0xc7, 0xf5, 0xac, 0xc0, 0x65, 0x9e, 0x0c, 0xa0,
+	0x5d, 0x8c, 0xdb, 0xc1, 0xa1, 0x1e, 0xe5, 0x2e, 0x57, 0x26, 0x3d, 0x20, 0xaa, 0x2e, 0xf5, 0x02,
+	0xd2, 0xcc, 0xab, 0x27, 0x65, 0xb0, 0xe4, 0x30, 0xe6, 0x54, 0x29, 0xb1, 0x7c, 0x97, 0x58, 0x9e,
+	0xc7, 0x02, 0xb1, 0x41, 0xdb, 0x7d, 0xde, 0x61, 0x0e, 0x13, 0x8f, 0xa4, 0xf5, 0x24, 0x57, 0xf5,
+	0x6b, 0xb0, 0xf4, 0x51, 0x0b, 0xd9, 0x26, 0xdd, 0x0b, 0xb6, 0xe9, 0xdd, 0x06, 0xf5, 0x6c, 0xba,
+	0x4d, 0xbd, 0xb2, 0xd9, 0x7a, 0xe6, 0x01, 0x5e, 0x84, 0x29, 0xb9, 0x47, 0xd1, 0x2d, 0x2f, 0xa0,
+	0x2c, 0xca, 0x4d, 0x99, 0x93, 0x72, 0xe1, 0x56, 0x59, 0xff, 0x16, 0xc1, 0xcb, 0x03, 0xbc, 0xb9,
+	0xcf, 0x3c, 0x4e, 0xf1, 0x1b, 0x80, 0x3d, 0xba, 0x17, 0x14, 0xb9, 0xfa, 0x58, 0xe4, 0xd4, 0x93,
+	0x71, 0xc6, 0xcd, 0x59, 0x2f, 0xe2, 0x85, 0xe7, 0xe1, 0x8c, 0x5f, 0x67, 0xec, 0xb3, 0x85, 0xd1,
+	0x2c, 0xca, 0xcd, 0x98, 0xf2, 0x05, 0xaf, 0xc3, 0x8c, 0x78, 0x28, 0xee, 0x52, 0xd7, 0xd9, 0x0d,
+	0x16, 0xc6, 0xb2, 0x28, 0x37, 0x5d, 0xd0, 0x8c, 0x6e, 0xca, 0x65, 0x12, 0x9a, 0x79, 0x63, 0x43,
+	0x58, 0xac, 0x8d, 0x3f, 0xf8, 0x63, 0x79, 0xc4, 0x9c, 0x16, 0x5e, 0x72, 0x49, 0xbf, 0x0d, 0xcb,
+	0x7d, 0x48, 0x4d, 0x6a, 0x53, 0xb7, 0x49, 0x93, 0x50, 0xc5, 0x2f, 0xc1, 0x73, 0x3e, 0xab, 0x8b,
+	0x4f, 0xa3, 0xe2, 0xd3, 0x44, 0xeb, 0xf5, 0x56, 0x59, 0xff, 0x1e, 0x41, 0x76, 0x70, 0x64, 0x95,
+	0x86, 0x02, 0x9c, 0x0f, 0xa7, 0xa1, 0x2e, 0x0d, 0x54, 0x26, 0xe6, 0xbc, 0x7e, 0xdf, 0xd3, 0x4d,
+	0x86, 0x3c, 0xf4, 0x2d, 0xcb, 0xae, 0xd0, 0x60, 0x9d, 0xd5, 0x6a, 0x6e, 0x50, 0xa3, 0x5e, 0x90,
+	0x28, 0x13, 0x1a, 0x4c, 0xb6, 0x69, 0x08, 0x68, 0xe3, 0x66, 0xe7, 0x5d, 0xff, 0xa6, 0x2d, 0x88,
+	0xfe, 0xc8, 0x2a, 0x13, 0x19, 0x00, 0xbb, 0xb3, 0x2a, 0x62, 0xcf, 0x98, 0x3d, 0x2b, 0xa7, 0xc9,
+	0xfa, 0xab, 0x41, 0xe0, 0x78, 0x22, 0xde, 0x37, 0x00, 0xba, 0x55, 0x2b, 0xe0, 0x4d, 0x17, 0x5e,
+	0x35, 0x64, 0x89, 0x1b, 0xad, 0x12, 0x37, 0xe4, 0x85, 0xa0, 0x4a, 0xdc, 0xd8, 0xb2, 0x9c, 0xb6,
+	0xb4, 0xcc, 0x1e, 0x4f, 0xfd, 0x1f, 0x04, 0x99, 0x41, 0x30, 0x54, 0x92, 0xd6, 0x60, 0xba, 0x9b,
+	0x12, 0xbe, 0x80, 0xb2, 0x63, 0xb9, 0xe9, 0x42, 0xd6, 0x88, 0xb9, 0x63, 0x0c, 0x19, 0x64, 0x3b,
+	0xb0, 0x02, 0x6a, 0xf6, 0x3a, 0xe1, 0x9b, 0x31, 0x70, 0x5f, 0x3b, 0x16, 0xae, 0x04, 0xd0, 0x8b,
+	0x17, 0x5f, 0x85, 0x89, 0x94, 0x59, 0x57, 0xf6, 0xfa, 0x1d, 0xb8, 0xd8, 0x43, 0x74, 0xd5, 0xae,
+	0x78, 0xec, 0x8b, 0x2a, 0x2d, 0x3b, 0xf4, 0xa9, 0x68, 0xed, 0x3b, 0x04, 0xfa, 0xb0, 0xf0, 0x2a,
+	0x97, 0x39, 0x78, 0xc1, 0x0a, 0x7f, 0x52, 0xaa, 0x8b, 0x2e, 0x9f, 0xa6, 0xf4, 0x1e, 0x0e, 0xc5,
+	0xfa, 0xbf, 0xea, 0x0f, 0xbf, 0x03, 0x8b, 0xbe, 0x40, 0x51, 0xec, 0xca, 0xa5, 0x73, 0x31, 0xf1,
+	0x85, 0xb1, 0xec, 0x58, 0x6e, 0xdc, 0xbc, 0xe0, 0x47, 0xc4, 0xd9, 0xbe, 0x9d, 0xb8, 0xfe, 0x2f,
+	0x82, 0x57, 0x86, 0x72, 0x51, 0x89, 0xff, 0x10, 0x66, 0x23, 0x19, 0x4e, 0xae, 0xe4, 0x3e, 0xcf,
+	0x67, 0x41, 0xce, 0x1f, 0xc3, 0x85, 0x1e, 0xde, 0xe2, 0x9a, 0xf6, 0x9f, 0x5c, 0xc6, 0xf7, 0x11,
+	0x68, 0x71, 0x61, 0x55, 0x16, 0x35, 0x98, 0x54, 0xbd, 0x42, 0x36, 0x9e, 0x49, 0xb3, 0xf3, 0xde,
+	0x15, 0xec, 0xd8, 0x30, 0xc1, 0x8e, 0x9f, 0x44, 0xb0, 0x3b, 0xea, 0xaa, 0xfc, 0xc4, 0x6b, 0xef,
+	0x26, 0xe1, 0x25, 0x93, 0xea, 0x12, 0x4c, 0x75, 0x05, 0x35, 0x2a, 0x04, 0xd5, 0x5d, 0xd0, 0xf7,
+	0xd4, 0xfd, 0x17, 0x13, 0x5b, 0x91, 0x0e, 0xf9, 0xa3, 0x88, 0x7f, 0xcf, 0x09, 0x8e, 0xa6, 0x3c,
+	0xc1, 0x8a, 0x4a, 0x75, 0x77, 0xe7, 0x55, 0xbb, 0x92, 0x8c, 0xd2, 0x0a, 0xcc, 0xab, 0xaa, 0xb1,
+	0xec, 0x4a, 0x31, 0xca, 0x0e, 0xfb, 0xed, 0x5a, 0xe8, 0xd6, 0x49, 0x03, 0x16, 0x63, 0x37, 0x3b,
+	0x5d, 0x8e, 0x85, 0xbf, 0xcf, 0xc2, 0x19, 0xb1, 0x2f, 0xfe, 0x11, 0xc1, 0x6c, 0x74, 0x30, 0xc3,
+	0xf9, 0xd8, 0xda, 0x1b, 0x36, 0x02, 0x6a, 0x85, 0x34, 0x2e, 0x92, 0x9d, 0xbe, 0xfe, 0xe5, 0x2f,
+	0x7f, 0xdd, 0x1f, 0xbd, 0x8e, 0xaf, 0x91, 0xb8, 0xb9, 0x56, 0x52, 0xe0, 0x64, 0xbf, 0x93, 0xef,
+	0x03, 0xd2, 0x3f, 0x26, 0xe2, 0xdf, 0x11, 0xcc, 0xc5, 0x4c, 0x55, 0xf8, 0x4a, 0x32, 0x40, 0xe1,
+	0xf1, 0x4e, 0x7b, 0x33, 0xa5, 0x97, 0x62, 0xf2, 0xa9, 0x60, 0xb2, 0x85, 0x37, 0x13, 0x33, 0x69,
+	0x0d, 0x86, 0x9c, 0xec, 0xab, 0x71, 0x31, 0xca, 0x4c, 0x89, 0x01, 0x3f, 0x44, 0x30, 0x1b, 0x9d,
+	0x00, 0x86, 0x9d, 0xce, 0x80, 0x59, 0x6d, 0xd8, 0xe9, 0x0c, 0x1a, 0xc2, 0xf4, 0x4d, 0xc1, 0x69,
+	0x03, 0xdf, 0x48, 0xce, 0x29, 0xda, 0x31, 0x38, 0xd9, 0x6f, 0x53, 0x3a, 0xc0, 0x3f, 0x21, 0x38,
+	0xd7, 0x37, 0xcd, 0xe0, 0x14, 0xc8, 0xda, 0x35, 0xa8, 0x5d, 0x4e, 0xe5, 0x73, 0x62, 0xb1, 0xf5,
+	0xd3, 0xc1, 0x3f, 0x23, 0x38, 0x1f, 0xdb, 0xd1, 0xf0, 0x5b, 0xc7, 0x61, 0x8a, 0x9f, 0x6c, 0xb4,
+	0xb7, 0x53, 0xfb, 0x29, 0x3e, 0x37, 0x05, 0x9f, 0x55, 0xfc, 0x6e, 0x5a, 0x3e, 0x96, 0x5d, 0x09,
+	0x9d, 0xcb, 0xaf, 0x08, 0x5e, 0x8c, 0xef, 0xd2, 0x38, 0x2d, 0xb8, 0xce, 0x09, 0x5d, 0x4d, 0xef,
+	0xa8, 0x68, 0x6d, 0x08, 0x5a, 0x6b, 0xf8, 0xbd, 0x13, 0xd0, 0x0a, 0x83, 0xff, 0x01, 0xc1, 0xf3,
+	0xa1, 0x76, 0x89, 0x8d, 0xe3, 0x50, 0x85, 0xdb, 0xb5, 0x46, 0x12, 0xdb, 0x2b, 0xf0, 0x1f, 0x08,
+	0xf0, 0xef, 0xe3, 0xf5, 0xb4, 0xe0, 0xeb, 0x32, 0x50, 0xe8, 0x5c, 0x1e, 0x23, 0x38, 0xd7, 0xd7,
+	0xfd, 0x86, 0xd5, 0xcb, 0xa0, 0x36, 0x3c, 0xac, 0x5e, 0x06, 0xb6, 0x57, 0xbd, 0x24, 0xb8, 0xdc,
+	0xc1, 0x3b, 0x4f, 0xa5, 0xfc, 0xf9, 0x01, 0x69, 0x74, 0xb6, 0x2a, 0xfa, 0x8a, 0xcc, 0x9f, 0x08,
+	0xce, 0x86, 0x3b, 0x1f, 0x26, 0x49, 0xb0, 0xf6, 0x34, 0x64, 0x6d, 0x25, 0xb9, 0x83, 0x62, 0xf6,
+	0xb9, 0x60, 0x56, 0xc6, 0xa5, 0x27, 0x62, 0x16, 0xd7, 0xe8, 0x43, 0x24, 0x5b, 0x75, 0xb6, 0x76,
+	0xfb, 0xc1, 0x61, 0x06, 0x3d, 0x3a, 0xcc, 0xa0, 0xc7, 0x87, 0x19, 0xf4, 0xf5, 0x51, 0x66, 0xe4,
+	0xd1, 0x51, 0x66, 0xe4, 0xb7, 0xa3, 0xcc, 0xc8, 0xce, 0x75, 0xc7, 0x0d, 0x76, 0x1b, 0x25, 0xc3,
+	0x66, 0x35, 0xa2, 0x7e, 0x01, 0xb9, 0x25, 0xfb, 0x92, 0xc3, 0x48, 0x33, 0xbf, 0x42, 0x6a, 0xac,
+	0xdc, 0xa8, 0x52, 0x2e, 0xd1, 0xad, 0x5c, 0xb9, 0xd4, 0x03, 0x30, 0xb8, 0xe7, 0x53, 0x5e, 0x9a,
+	0x10, 0x7f, 0x66, 0x2e, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x20, 0xaf, 0x9b, 0x75, 0x12,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1072,6 +1199,8 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// NextSequenceSend returns the next send sequence for a given channel.
 	NextSequenceSend(ctx context.Context, in *QueryNextSequenceSendRequest, opts ...grpc.CallOption) (*QueryNextSequenceSendResponse, error)
+	// NextSequenceReceive returns the next receive sequence for a given ordered port of a client.
+	NextSequenceReceive(ctx context.Context, in *QueryNextSequenceReceiveRequest, opts ...grpc.CallOption) (*QueryNextSequenceReceiveResponse, error)
 	// PacketCommitment queries a stored packet commitment hash.
 	PacketCommitment(ctx context.Context, in *QueryPacketCommitmentRequest, opts ...grpc.CallOption) (*QueryPacketCommitmentResponse, error)
 	// PacketCommitments queries a stored packet commitment hash.
@@ -1105,6 +1234,15 @@ func (c *queryClient) NextSequenceSend(ctx context.Context, in *QueryNextSequenc
 	return out, nil
 }
 
+func (c *queryClient) NextSequenceReceive(ctx context.Context, in *QueryNextSequenceReceiveRequest, opts ...grpc.CallOption) (*QueryNextSequenceReceiveResponse, error) {
+	out := new(QueryNextSequenceReceiveResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v2.Query/NextSequenceReceive", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) PacketCommitment(ctx context.Context, in *QueryPacketCommitmentRequest, opts ...grpc.CallOption) (*QueryPacketCommitmentResponse, error) {
 	out := new(QueryPacketCommitmentResponse)
 	err := c.cc.Invoke(ctx, "/ibc.core.channel.v2.Query/PacketCommitment", in, out, opts...)
@@ -1172,6 +1310,8 @@ func (c *queryClient) UnreceivedAcks(ctx context.Context, in *QueryUnreceivedAck
 type QueryServer interface {
 	// NextSequenceSend returns the next send sequence for a given channel.
 	NextSequenceSend(context.Context, *QueryNextSequenceSendRequest) (*QueryNextSequenceSendResponse, error)
+	// NextSequenceReceive returns the next receive sequence for a given ordered port of a client.
+	NextSequenceReceive(context.Context, *QueryNextSequenceReceiveRequest) (*QueryNextSequenceReceiveResponse, error)
 	// PacketCommitment queries a stored packet commitment hash.
 	PacketCommitment(context.Context, *QueryPacketCommitmentRequest) (*QueryPacketCommitmentResponse, error)
 	// PacketCommitments queries a stored packet commitment hash.
@@ -1195,6 +1335,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) NextSequenceSend(ctx context.Context, req *QueryNextSequenceSendRequest) (*QueryNextSequenceSendResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NextSequenceSend not implemented")
 }
+func (*UnimplementedQueryServer) NextSequenceReceive(ctx context.Context, req *QueryNextSequenceReceiveRequest) (*QueryNextSequenceReceiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextSequenceReceive not implemented")
+}
 func (*UnimplementedQueryServer) PacketCommitment(ctx context.Context, req *QueryPacketCommitmentRequest) (*QueryPacketCommitmentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PacketCommitment not implemented")
 }
@@ -1239,6 +1382,24 @@ func _Query_NextSequenceSend_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_NextSequenceReceive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextSequenceReceiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextSequenceReceive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v2.Query/NextSequenceReceive",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextSequenceReceive(ctx, req.(*QueryNextSequenceReceiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_PacketCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryPacketCommitmentRequest)
 	if err := dec(in); err != nil {
@@ -1373,6 +1534,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NextSequenceSend",
 			Handler:    _Query_NextSequenceSend_Handler,
 		},
+		{
+			MethodName: "NextSequenceReceive",
+			Handler:    _Query_NextSequenceReceive_Handler,
+		},
 		{
 			MethodName: "PacketCommitment",
 			Handler:    _Query_PacketCommitment_Handler,
@@ -1481,6 +1646,88 @@ func (m *QueryNextSequenceSendResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryNextSequenceReceiveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextSequenceReceiveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextSequenceReceiveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextSequenceReceiveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextSequenceReceiveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextSequenceReceiveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.NextSequenceReceive != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextSequenceReceive))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryPacketCommitmentRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1767,20 +2014,20 @@ func (m *QueryPacketAcknowledgementsRequest) MarshalToSizedBuffer(dAtA []byte) (
 	var l int
 	_ = l
 	if len(m.PacketCommitmentSequences) > 0 {
-		dAtA8 := make([]byte, len(m.PacketCommitmentSequences)*10)
-		var j7 int
+		dAtA9 := make([]byte, len(m.PacketCommitmentSequences)*10)
+		var j8 int
 		for _, num := range m.PacketCommitmentSequences {
 			for num >= 1<<7 {
-				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j7++
+				j8++
 			}
-			dAtA8[j7] = uint8(num)
-			j7++
+			dAtA9[j8] = uint8(num)
+			j8++
 		}
-		i -= j7
-		copy(dAtA[i:], dAtA8[:j7])
-		i = encodeVarintQuery(dAtA, i, uint64(j7))
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintQuery(dAtA, i, uint64(j8))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -1971,20 +2218,20 @@ func (m *QueryUnreceivedPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	var l int
 	_ = l
 	if len(m.Sequences) > 0 {
-		dAtA14 := make([]byte, len(m.Sequences)*10)
-		var j13 int
+		dAtA15 := make([]byte, len(m.Sequences)*10)
+		var j14 int
 		for _, num := range m.Sequences {
 			for num >= 1<<7 {
-				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j13++
+				j14++
 			}
-			dAtA14[j13] = uint8(num)
-			j13++
+			dAtA15[j14] = uint8(num)
+			j14++
 		}
-		i -= j13
-		copy(dAtA[i:], dAtA14[:j13])
-		i = encodeVarintQuery(dAtA, i, uint64(j13))
+		i -= j14
+		copy(dAtA[i:], dAtA15[:j14])
+		i = encodeVarintQuery(dAtA, i, uint64(j14))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -2029,20 +2276,20 @@ func (m *QueryUnreceivedPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	i--
 	dAtA[i] = 0x12
 	if len(m.Sequences) > 0 {
-		dAtA17 := make([]byte, len(m.Sequences)*10)
-		var j16 int
+		dAtA18 := make([]byte, len(m.Sequences)*10)
+		var j17 int
 		for _, num := range m.Sequences {
 			for num >= 1<<7 {
-				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA18[j17] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j16++
+				j17++
 			}
-			dAtA17[j16] = uint8(num)
-			j16++
+			dAtA18[j17] = uint8(num)
+			j17++
 		}
-		i -= j16
-		copy(dAtA[i:], dAtA17[:j16])
-		i = encodeVarintQuery(dAtA, i, uint64(j16))
+		i -= j17
+		copy(dAtA[i:], dAtA18[:j17])
+		i = encodeVarintQuery(dAtA, i, uint64(j17))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -2070,20 +2317,20 @@ func (m *QueryUnreceivedAcksRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	var l int
 	_ = l
 	if len(m.PacketAckSequences) > 0 {
-		dAtA19 := make([]byte, len(m.PacketAckSequences)*10)
-		var j18 int
+		dAtA20 := make([]byte, len(m.PacketAckSequences)*10)
+		var j19 int
 		for _, num := range m.PacketAckSequences {
 			for num >= 1<<7 {
-				dAtA19[j18] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j18++
+				j19++
 			}
-			dAtA19[j18] = uint8(num)
-			j18++
+			dAtA20[j19] = uint8(num)
+			j19++
 		}
-		i -= j18
-		copy(dAtA[i:], dAtA19[:j18])
-		i = encodeVarintQuery(dAtA, i, uint64(j18))
+		i -= j19
+		copy(dAtA[i:], dAtA20[:j19])
+		i = encodeVarintQuery(dAtA, i, uint64(j19))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -2128,20 +2375,20 @@ func (m *QueryUnreceivedAcksResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	i--
 	dAtA[i] = 0x12
 	if len(m.Sequences) > 0 {
-		dAtA22 := make([]byte, len(m.Sequences)*10)
-		var j21 int
+		dAtA23 := make([]byte, len(m.Sequences)*10)
+		var j22 int
 		for _, num := range m.Sequences {
 			for num >= 1<<7 {
-				dAtA22[j21] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA23[j22] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j21++
+				j22++
 			}
-			dAtA22[j21] = uint8(num)
-			j21++
+			dAtA23[j22] = uint8(num)
+			j22++
 		}
-		i -= j21
-		copy(dAtA[i:], dAtA22[:j21])
-		i = encodeVarintQuery(dAtA, i, uint64(j21))
+		i -= j22
+		copy(dAtA[i:], dAtA23[:j22])
+		i = encodeVarintQuery(dAtA, i, uint64(j22))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -2190,6 +2437,41 @@ func (m *QueryNextSequenceSendResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryNextSequenceReceiveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNextSequenceReceiveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NextSequenceReceive != 0 {
+		n += 1 + sovQuery(uint64(m.NextSequenceReceive))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryPacketCommitmentRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2677,6 +2959,256 @@ func (m *QueryNextSequenceSendResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryNextSequenceReceiveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextSequenceReceiveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextSequenceReceiveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextSequenceReceiveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextSequenceReceiveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextSequenceReceiveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceReceive", wireType)
+			}
+			m.NextSequenceReceive = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequenceReceive |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryPacketCommitmentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"slices"
 
 	ics23 "github.com/cosmos/ics23/go"
 
@@ -140,6 +141,72 @@ func (proof MerkleProof) VerifyNonMembership(specs []*ics23.ProofSpec, root expo
 	return verifyChainedMembershipProof(root.GetHash(), specs, proof.Proofs, mpath, subroot, 1)
 }
 
+// VerifyBatchMembership verifies a merkle proof for many paths at once. The proof of the lowest subtree must be an ICS 23
+// batch or compressed batch proof which proves the existence of each value, or the absence of each path of which the value
+// is empty, and the remaining proofs prove the inclusion of the root of the lowest subtree up to the final root. All paths
+// must share every key but the key of the lowest subtree.
+func (proof MerkleProof) VerifyBatchMembership(specs []*ics23.ProofSpec, root exported.Root, paths []exported.Path, values [][]byte) error {
+	if len(paths) == 0 {
+		return errorsmod.Wrap(ErrInvalidProof, "paths cannot be empty")
+	}
+
+	if len(paths) != len(values) {
+		return errorsmod.Wrapf(ErrInvalidProof, "number of paths (%d) does not match number of values (%d)", len(paths), len(values))
+	}
+
+	mpaths := make([]v2.MerklePath, len(paths))
+	for i, path := range paths {
+		mpath, ok := path.(v2.MerklePath)
+		if !ok {
+			return errorsmod.Wrapf(ErrInvalidProof, "path %v is not of type MerklePath", path)
+		}
+
+		if err := validateVerificationArgs(proof, mpath, specs, root); err != nil {
+			return err
+		}
+
+		// all paths must be proven by the same chain of subtree proofs
+		if i > 0 && !slices.EqualFunc(mpath.KeyPath[:len(mpath.KeyPath)-1], mpaths[0].KeyPath[:len(mpaths[0].KeyPath)-1], bytes.Equal) {
+			return errorsmod.Wrapf(ErrInvalidProof, "path at index %d does not share the subtree keys of the path at index 0", i)
+		}
+
+		mpaths[i] = mpath
+	}
+
+	if proof.Proofs[0].GetBatch() == nil && proof.Proofs[0].GetCompressed() == nil {
+		return errorsmod.Wrapf(ErrInvalidProof, "commitment proof must be batch or compressed batch proof. got: %T", proof.Proofs[0].GetProof())
+	}
+
+	// decompress the batch proof once for all paths
+	batchProof := ics23.Decompress(proof.Proofs[0])
+
+	subroot, err := batchProof.Calculate()
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidProof, "could not calculate root for batch proof, merkle tree is likely empty. %v", err)
+	}
+
+	for i, mpath := range mpaths {
+		key, err := mpath.GetKey(uint64(len(mpath.KeyPath) - 1))
+		if err != nil {
+			return errorsmod.Wrapf(ErrInvalidProof, "could not retrieve key bytes for key: %s", mpath.KeyPath[len(mpath.KeyPath)-1])
+		}
+
+		if len(values[i]) == 0 {
+			if !ics23.VerifyNonMembership(specs[0], subroot, batchProof, key) {
+				return errorsmod.Wrapf(ErrInvalidProof, "failed to verify non-membership of key %s in batch proof at index %d", string(key), i)
+			}
+			continue
+		}
+
+		if !ics23.VerifyMembership(specs[0], subroot, batchProof, key, values[i]) {
+			return errorsmod.Wrapf(ErrInvalidProof, "failed to verify membership of key %s in batch proof at index %d", string(key), i)
+		}
+	}
+
+	// Verify chained membership proof starting from index 1 with value = subroot
+	return verifyChainedMembershipProof(root.GetHash(), specs, proof.Proofs, mpaths[0], subroot, 1)
+}
+
 // verifyChainedMembershipProof takes a list of proofs and specs and verifies each proof sequentially ensuring that the value is committed to
 // by first proof and each subsequent subroot is committed to by the next subroot and checking that the final calculated root is equal to the given roothash.
 // The proofs and specs are passed in from lowest subtree to the highest subtree, but the keys are passed in from highest subtree to lowest.
@@ -10,6 +10,7 @@ import (
 
 	"github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
 	"github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types/v2"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
 )
 
 func (suite *MerkleTestSuite) TestVerifyMembership() {
@@ -133,6 +134,105 @@ func (suite *MerkleTestSuite) TestVerifyNonMembership() {
 	}
 }
 
+func (suite *MerkleTestSuite) TestVerifyBatchMembership() {
+	suite.iavlStore.Set([]byte("MYKEY"), []byte("MYVALUE"))
+	suite.iavlStore.Set([]byte("MYOTHERKEY"), []byte("MYOTHERVALUE"))
+	cid := suite.store.Commit()
+
+	// queryProof returns the merkle proof of the given key in the iavl store
+	queryProof := func(key string) types.MerkleProof {
+		res, err := suite.store.Query(&storetypes.RequestQuery{
+			Path:  fmt.Sprintf("/%s/key", suite.storeKey.Name()), // required path to get key/value+proof
+			Data:  []byte(key),
+			Prove: true,
+		})
+		suite.Require().NoError(err)
+		suite.Require().NotNil(res.ProofOps)
+
+		proof, err := types.ConvertProofs(res.ProofOps)
+		suite.Require().NoError(err)
+		return proof
+	}
+
+	singleProof := queryProof("MYKEY")
+	batchProof, err := types.CombineMerkleProofs([]types.MerkleProof{singleProof, queryProof("MYOTHERKEY"), queryProof("MYABSENTKEY")})
+	suite.Require().NoError(err)
+
+	var (
+		proof  types.MerkleProof
+		root   []byte
+		paths  []exported.Path
+		values [][]byte
+	)
+
+	cases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{"valid proof", func() {}, nil},
+		{"valid proof: subset of batch", func() {
+			paths, values = paths[1:], values[1:]
+		}, nil},
+		{"empty paths", func() {
+			paths, values = nil, nil
+		}, types.ErrInvalidProof},
+		{"number of paths does not match number of values", func() {
+			values = values[1:]
+		}, types.ErrInvalidProof},
+		{"wrong value", func() {
+			values[0] = []byte("WRONGVALUE")
+		}, types.ErrInvalidProof},
+		{"existent key proven absent", func() {
+			values[1] = nil
+		}, types.ErrInvalidProof},
+		{"absent key proven existent", func() {
+			values[2] = []byte("MYVALUE")
+		}, types.ErrInvalidProof},
+		{"key not in batch", func() {
+			paths[0] = types.NewMerklePath([]byte(suite.storeKey.Name()), []byte("NOTMYKEY"))
+		}, types.ErrInvalidProof},
+		{"paths with different store keys", func() {
+			paths[1] = types.NewMerklePath([]byte("otherStoreKey"), []byte("MYOTHERKEY"))
+		}, types.ErrInvalidProof},
+		{"wrong root", func() {
+			root = []byte("WRONGROOT")
+		}, types.ErrInvalidProof},
+		{"lowest proof is not a batch proof", func() {
+			proof = singleProof
+		}, types.ErrInvalidProof},
+		{"proof is wrong length", func() {
+			proof = types.MerkleProof{
+				Proofs: proof.Proofs[1:],
+			}
+		}, types.ErrInvalidMerkleProof},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			proof = batchProof
+			root = cid.Hash
+			paths = []exported.Path{
+				types.NewMerklePath([]byte(suite.storeKey.Name()), []byte("MYKEY")),
+				types.NewMerklePath([]byte(suite.storeKey.Name()), []byte("MYOTHERKEY")),
+				types.NewMerklePath([]byte(suite.storeKey.Name()), []byte("MYABSENTKEY")),
+			}
+			values = [][]byte{[]byte("MYVALUE"), []byte("MYOTHERVALUE"), nil}
+
+			tc.malleate()
+
+			merkleRoot := types.NewMerkleRoot(root)
+			err := proof.VerifyBatchMembership(types.GetSDKSpecs(), &merkleRoot, paths, values)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
 func TestApplyPrefix(t *testing.T) {
 	prefix := types.NewMerklePrefix([]byte("storePrefixKey"))
 
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"bytes"
+
 	ics23 "github.com/cosmos/ics23/go"
 
 	errorsmod "cosmossdk.io/errors"
@@ -27,3 +29,50 @@ func ConvertProofs(tmProof *crypto.ProofOps) (MerkleProof, error) {
 		Proofs: proofs,
 	}, nil
 }
+
+// CombineMerkleProofs combines merkle proofs of paths within the same lowest subtree, queried at the same height,
+// into a single merkle proof verifiable with VerifyBatchMembership. The proofs of the lowest subtree are combined
+// into an ICS 23 compressed batch proof, while the proofs of the remaining subtrees must be equal for all proofs.
+func CombineMerkleProofs(proofs []MerkleProof) (MerkleProof, error) {
+	if len(proofs) == 0 {
+		return MerkleProof{}, errorsmod.Wrap(ErrInvalidMerkleProof, "proofs cannot be empty")
+	}
+
+	lowestProofs := make([]*ics23.CommitmentProof, len(proofs))
+	for i, proof := range proofs {
+		if len(proof.Proofs) != len(proofs[0].Proofs) {
+			return MerkleProof{}, errorsmod.Wrapf(ErrInvalidMerkleProof, "length of proof at index %d (%d) does not match length of proof at index 0 (%d)", i, len(proof.Proofs), len(proofs[0].Proofs))
+		}
+
+		if len(proof.Proofs) == 0 {
+			return MerkleProof{}, errorsmod.Wrapf(ErrInvalidMerkleProof, "proof at index %d cannot be empty", i)
+		}
+
+		for j := 1; j < len(proof.Proofs); j++ {
+			bz, err := proof.Proofs[j].Marshal()
+			if err != nil {
+				return MerkleProof{}, errorsmod.Wrapf(ErrInvalidMerkleProof, "could not marshal subtree proof %d of proof at index %d: %v", j, i, err)
+			}
+
+			expBz, err := proofs[0].Proofs[j].Marshal()
+			if err != nil {
+				return MerkleProof{}, errorsmod.Wrapf(ErrInvalidMerkleProof, "could not marshal subtree proof %d of proof at index 0: %v", j, err)
+			}
+
+			if !bytes.Equal(bz, expBz) {
+				return MerkleProof{}, errorsmod.Wrapf(ErrInvalidMerkleProof, "subtree proof %d of proof at index %d does not match proof at index 0", j, i)
+			}
+		}
+
+		lowestProofs[i] = proof.Proofs[0]
+	}
+
+	batchProof, err := ics23.CombineProofs(lowestProofs)
+	if err != nil {
+		return MerkleProof{}, errorsmod.Wrapf(ErrInvalidMerkleProof, "could not combine proofs: %v", err)
+	}
+
+	return MerkleProof{
+		Proofs: append([]*ics23.CommitmentProof{batchProof}, proofs[0].Proofs[1:]...),
+	}, nil
+}
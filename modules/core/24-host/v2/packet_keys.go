@@ -10,6 +10,8 @@ const (
 	PacketCommitmentBasePrefix      = byte(1)
 	PacketReceiptBasePrefix         = byte(2)
 	PacketAcknowledgementBasePrefix = byte(3)
+	NextSequenceRecvBasePrefix      = byte(4)
+	NextPortSequenceSendBasePrefix  = byte(5)
 )
 
 // PacketCommitmentPrefixKey returns the store key prefix under which packet commitments for a particular channel are stored.
@@ -48,6 +50,33 @@ func PacketAcknowledgementKey(channelID string, sequence uint64) []byte {
 	return append(PacketAcknowledgementPrefixKey(channelID), sdk.Uint64ToBigEndian(sequence)...)
 }
 
+// NextSequenceRecvPrefixKey returns the store key prefix under which the next receive sequences of the ordered ports
+// of a particular client are stored.
+// clientID must be a generated identifier, not provided externally so key collisions are not possible.
+func NextSequenceRecvPrefixKey(clientID string) []byte {
+	return append([]byte(clientID), NextSequenceRecvBasePrefix)
+}
+
+// NextSequenceRecvKey returns the store key under which the next receive sequence of an ordered port of a client is stored.
+// clientID must be a generated identifier, not provided externally so key collisions are not possible.
+func NextSequenceRecvKey(clientID, portID string) []byte {
+	return append(NextSequenceRecvPrefixKey(clientID), portID...)
+}
+
+// NextPortSequenceSendPrefixKey returns the store key prefix under which the next port send sequences of the
+// destination ports of a particular client are stored.
+// clientID must be a generated identifier, not provided externally so key collisions are not possible.
+func NextPortSequenceSendPrefixKey(clientID string) []byte {
+	return append([]byte(clientID), NextPortSequenceSendBasePrefix)
+}
+
+// NextPortSequenceSendKey returns the store key under which the next port send sequence of a destination port of a
+// client is stored.
+// clientID must be a generated identifier, not provided externally so key collisions are not possible.
+func NextPortSequenceSendKey(clientID, portID string) []byte {
+	return append(NextPortSequenceSendPrefixKey(clientID), portID...)
+}
+
 // NextSequenceSendKey returns the store key for the next sequence send of a given channelID.
 func NextSequenceSendKey(channelID string) []byte {
 	return fmt.Appendf(nil, "nextSequenceSend/%s", channelID)
@@ -120,6 +120,43 @@ func (rrd RedundantRelayDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 					redundancies++
 				}
 				packetMsgs++
+			case *channeltypesv2.MsgTimeouts:
+				response, err := rrd.k.ChannelKeeperV2.Timeouts(ctx, msg)
+				if err != nil {
+					return ctx, err
+				}
+
+				// each packet of a batched message is accounted for individually
+				for _, result := range response.Results {
+					if result == channeltypesv2.NOOP {
+						redundancies++
+					}
+					packetMsgs++
+				}
+			case *channeltypesv2.MsgAcknowledgements:
+				response, err := rrd.k.ChannelKeeperV2.Acknowledgements(ctx, msg)
+				if err != nil {
+					return ctx, err
+				}
+
+				for _, result := range response.Results {
+					if result == channeltypesv2.NOOP {
+						redundancies++
+					}
+					packetMsgs++
+				}
+			case *channeltypesv2.MsgRecvPackets:
+				response, err := rrd.k.ChannelKeeperV2.RecvPackets(ctx, msg)
+				if err != nil {
+					return ctx, err
+				}
+
+				for _, result := range response.Results {
+					if result == channeltypesv2.NOOP {
+						redundancies++
+					}
+					packetMsgs++
+				}
 			default:
 				// if the multiMsg tx has a msg that is not a packet msg or update msg, then we will not return error
 				// regardless of if all packet messages are redundant. This ensures that non-packet messages get processed
@@ -185,7 +222,8 @@ func (rrd RedundantRelayDecorator) updateClientCheckTx(ctx sdk.Context, msg *cli
 		return err
 	}
 
-	if status := rrd.k.ClientKeeper.GetClientStatus(ctx, msg.ClientId); status != exported.Active {
+	// inactive clients may be updated in order to become active again
+	if status := rrd.k.ClientKeeper.GetClientStatus(ctx, msg.ClientId); status != exported.Active && status != exported.Inactive {
 		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "cannot update client (%s) with status %s", msg.ClientId, status)
 	}
 
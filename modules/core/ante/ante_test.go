@@ -96,6 +96,31 @@ func (suite *AnteTestSuite) createRecvPacketMessageV2(isRedundant bool) *channel
 	return channeltypesv2.NewMsgRecvPacket(packet, proof, proofHeight, suite.path.EndpointA.Chain.SenderAccount.GetAddress().String())
 }
 
+// createRecvPacketsMessageV2 creates a V2 RecvPackets message for two packets sent from chain A to chain B.
+func (suite *AnteTestSuite) createRecvPacketsMessageV2(isRedundant bool) *channeltypesv2.MsgRecvPackets {
+	packets := make([]channeltypesv2.Packet, 2)
+	keys := make([][]byte, len(packets))
+	for i := range packets {
+		var err error
+		packets[i], err = suite.path.EndpointA.MsgSendPacket(suite.chainA.GetTimeoutTimestampSecs(), mock.NewMockPayload(mock.ModuleNameA, mock.ModuleNameB))
+		suite.Require().NoError(err)
+
+		keys[i] = hostv2.PacketCommitmentKey(packets[i].SourceClient, packets[i].Sequence)
+	}
+
+	if isRedundant {
+		err := suite.path.EndpointB.MsgRecvPackets(packets...)
+		suite.Require().NoError(err)
+	}
+
+	err := suite.path.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	proof, proofHeight := suite.path.EndpointA.QueryMultiProof(keys...)
+
+	return channeltypesv2.NewMsgRecvPackets(packets, proof, proofHeight, suite.path.EndpointA.Chain.SenderAccount.GetAddress().String())
+}
+
 // createAcknowledgementMessage creates an Acknowledgement message for a packet sent from chain B to chain A.
 func (suite *AnteTestSuite) createAcknowledgementMessage(isRedundant bool) sdk.Msg {
 	sequence, err := suite.path.EndpointB.SendPacket(clienttypes.NewHeight(2, 0), 0, ibctesting.MockPacketData)
@@ -265,6 +290,15 @@ func (suite *AnteTestSuite) TestAnteDecoratorCheckTx() {
 			},
 			nil,
 		},
+		{
+			"success on one new V2 RecvPackets message",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				suite.path.SetupV2()
+				// the RecvPackets message has not been submitted to the chain yet, so it will succeed
+				return []sdk.Msg{suite.createRecvPacketsMessageV2(false)}
+			},
+			nil,
+		},
 		{
 			"success on one new Acknowledgement message",
 			func(suite *AnteTestSuite) []sdk.Msg {
@@ -403,6 +437,17 @@ func (suite *AnteTestSuite) TestAnteDecoratorCheckTx() {
 			},
 			nil,
 		},
+		{
+			"success on one new UpdateClient message: inactive client",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				suite.chainB.App.GetIBCKeeper().ClientKeeper.SetLivenessPeriod(suite.chainB.GetContext(), suite.path.EndpointB.ClientID, time.Hour)
+				suite.coordinator.IncrementTimeBy(2 * time.Hour)
+				suite.Require().Equal(exported.Inactive, suite.chainB.App.GetIBCKeeper().ClientKeeper.GetClientStatus(suite.chainB.GetContext(), suite.path.EndpointB.ClientID))
+
+				return []sdk.Msg{suite.createUpdateClientMessage()}
+			},
+			nil,
+		},
 		{
 			"success on three new UpdateClient messages",
 			func(suite *AnteTestSuite) []sdk.Msg {
@@ -466,6 +511,14 @@ func (suite *AnteTestSuite) TestAnteDecoratorCheckTx() {
 			},
 			channeltypes.ErrRedundantTx,
 		},
+		{
+			"no success on one redundant V2 RecvPackets message",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				suite.path.SetupV2()
+				return []sdk.Msg{suite.createRecvPacketsMessageV2(true)}
+			},
+			channeltypes.ErrRedundantTx,
+		},
 		{
 			"no success on three redundant messages of each type",
 			func(suite *AnteTestSuite) []sdk.Msg {
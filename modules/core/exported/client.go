@@ -1,6 +1,8 @@
 package exported
 
 import (
+	"time"
+
 	"github.com/cosmos/gogoproto/proto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -31,6 +33,10 @@ const (
 	// Expired is a status type of a client. An expired client is not allowed to be used.
 	Expired Status = "Expired"
 
+	// Inactive is a status type of a client. An inactive client has not been updated within its liveness period
+	// and is not allowed to be used until it is updated.
+	Inactive Status = "Inactive"
+
 	// Unknown indicates there was an error in determining the status of a client.
 	Unknown Status = "Unknown"
 
@@ -122,6 +128,47 @@ type LightClientModule interface {
 	) error
 }
 
+// ConsensusStatePruner is an optional interface which light client modules may implement in order to
+// enforce the retention policy of a client. Core IBC prunes the consensus states of clients with a
+// retention policy in bounded batches at the end of every block.
+type ConsensusStatePruner interface {
+	// PruneConsensusStates must delete at most limit consensus states of the client, along with any associated
+	// metadata, which fall outside of the retention policy. Consensus states beyond the most recent maxConsensusStates
+	// are pruned first, followed by consensus states whose timestamp is older than maxAge relative to the block time.
+	// A zero value disables the corresponding limit. The consensus state at the latest height must never be pruned.
+	// The number of pruned consensus states is returned.
+	PruneConsensusStates(ctx sdk.Context, clientID string, maxConsensusStates uint64, maxAge time.Duration, limit uint64) (uint64, error)
+}
+
+// ClientFreezer is an optional interface which light client modules may implement in order to natively freeze
+// and unfreeze clients on request of the authority. Core IBC marks the clients of light client modules which do
+// not implement this interface as frozen in the client store instead.
+type ClientFreezer interface {
+	// FreezeClient must freeze the client such that its status is Frozen.
+	FreezeClient(ctx sdk.Context, clientID string) error
+
+	// UnfreezeClient must unfreeze a frozen client such that its status is no longer Frozen.
+	UnfreezeClient(ctx sdk.Context, clientID string) error
+}
+
+// MultiProofVerifier is an optional interface which light client modules may implement in order to verify a single
+// proof of many key paths at a specified height. Core IBC requires light client modules to implement this interface
+// in order to relay batches of packets with a single proof.
+type MultiProofVerifier interface {
+	// VerifyMultiProof must verify a single proof of the existence of each value at the corresponding path, or of the
+	// absence of each path of which the value is empty, at the specified height.
+	VerifyMultiProof(
+		ctx sdk.Context,
+		clientID string,
+		height Height,
+		delayTimePeriod uint64,
+		delayBlockPeriod uint64,
+		proof []byte,
+		paths []Path,
+		values [][]byte,
+	) error
+}
+
 // ClientState defines the required common functions for light clients.
 type ClientState interface {
 	proto.Message
@@ -155,6 +202,15 @@ type ClientMessage interface {
 	ValidateBasic() error
 }
 
+// MisbehaviourEvidence is an optional interface which client messages may implement in order to describe
+// the conflicting headers which constitute misbehaviour. Core IBC records the reported heights, hashes and
+// timestamps in the misbehaviour evidence stored for the client.
+type MisbehaviourEvidence interface {
+	// ConflictingHeaders returns the heights, hashes and timestamps in unix nanoseconds of the conflicting
+	// headers of the client message.
+	ConflictingHeaders() ([]Height, [][]byte, []uint64)
+}
+
 // Height is a wrapper interface over clienttypes.Height
 // all clients must use the concrete implementation in types
 type Height interface {
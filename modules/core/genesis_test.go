@@ -176,6 +176,12 @@ func (suite *IBCTestSuite) TestValidateGenesis() {
 					[]channelv2types.PacketSequence{
 						channelv2types.NewPacketSequence(channel1, 1),
 					},
+					[]channelv2types.PortSequence{
+						channelv2types.NewPortSequence(channel2, ibctesting.MockPort, 1),
+					},
+					[]channelv2types.PortSequence{
+						channelv2types.NewPortSequence(channel1, ibctesting.MockPort, 1),
+					},
 				),
 			},
 			expError: nil,
@@ -412,6 +418,12 @@ func (suite *IBCTestSuite) TestInitGenesis() {
 					[]channelv2types.PacketSequence{
 						channelv2types.NewPacketSequence(channel1, 1),
 					},
+					[]channelv2types.PortSequence{
+						channelv2types.NewPortSequence(channel2, ibctesting.MockPort, 1),
+					},
+					[]channelv2types.PortSequence{
+						channelv2types.NewPortSequence(channel1, ibctesting.MockPort, 1),
+					},
 				),
 			},
 		},
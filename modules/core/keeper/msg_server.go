@@ -46,6 +46,14 @@ func (k *Keeper) CreateClient(goCtx context.Context, msg *clienttypes.MsgCreateC
 	// set the client creator so that IBC v2 counterparty can be set by same relayer
 	k.ClientKeeper.SetClientCreator(ctx, clientID, sdk.MustAccAddressFromBech32(msg.Signer))
 
+	if msg.RetentionPolicy != nil {
+		k.ClientKeeper.SetRetentionPolicy(ctx, clientID, *msg.RetentionPolicy)
+	}
+
+	if msg.LivenessPeriod != 0 {
+		k.ClientKeeper.SetLivenessPeriod(ctx, clientID, msg.LivenessPeriod)
+	}
+
 	return &clienttypes.MsgCreateClientResponse{ClientId: clientID}, nil
 }
 
@@ -92,7 +100,7 @@ func (k *Keeper) UpdateClient(goCtx context.Context, msg *clienttypes.MsgUpdateC
 		}
 	}
 
-	if err = k.ClientKeeper.UpdateClient(ctx, msg.ClientId, clientMsg); err != nil {
+	if err = k.ClientKeeper.UpdateClientWithSubmitter(ctx, msg.ClientId, clientMsg, msg.Signer); err != nil {
 		return nil, err
 	}
 
@@ -132,7 +140,7 @@ func (k *Keeper) SubmitMisbehaviour(goCtx context.Context, msg *clienttypes.MsgS
 		return nil, err
 	}
 
-	if err = k.ClientKeeper.UpdateClient(ctx, msg.ClientId, misbehaviour); err != nil {
+	if err = k.ClientKeeper.UpdateClientWithSubmitter(ctx, msg.ClientId, misbehaviour, msg.Signer); err != nil {
 		return nil, err
 	}
 
@@ -676,6 +684,14 @@ func (k *Keeper) UpdateClientConfig(goCtx context.Context, msg *clientv2types.Ms
 		)
 	}
 
+	// the next sequence receive of ports which become ordered is initialised, ports which have been ordered
+	// before resume from their existing next sequence receive
+	for _, portID := range msg.Config.OrderedPorts {
+		if _, found := k.ChannelKeeperV2.GetNextSequenceRecv(ctx, msg.ClientId, portID); !found {
+			k.ChannelKeeperV2.SetNextSequenceRecv(ctx, msg.ClientId, portID, 1)
+		}
+	}
+
 	k.ClientV2Keeper.SetConfig(ctx, msg.ClientId, msg.Config)
 	return &clientv2types.MsgUpdateClientConfigResponse{}, nil
 }
@@ -699,3 +715,31 @@ func (k *Keeper) DeleteClientCreator(goCtx context.Context, msg *clienttypes.Msg
 	k.ClientKeeper.DeleteClientCreator(ctx, msg.ClientId)
 	return &clienttypes.MsgDeleteClientCreatorResponse{}, nil
 }
+
+// FreezeClient defines a rpc handler method for MsgFreezeClient.
+func (k *Keeper) FreezeClient(goCtx context.Context, msg *clienttypes.MsgFreezeClient) (*clienttypes.MsgFreezeClientResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.ClientKeeper.FreezeClient(ctx, msg.ClientId); err != nil {
+		return nil, errorsmod.Wrap(err, "client freeze failed")
+	}
+
+	return &clienttypes.MsgFreezeClientResponse{}, nil
+}
+
+// UnfreezeClient defines a rpc handler method for MsgUnfreezeClient.
+func (k *Keeper) UnfreezeClient(goCtx context.Context, msg *clienttypes.MsgUnfreezeClient) (*clienttypes.MsgUnfreezeClientResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.ClientKeeper.UnfreezeClient(ctx, msg.ClientId); err != nil {
+		return nil, errorsmod.Wrap(err, "client unfreeze failed")
+	}
+
+	return &clienttypes.MsgUnfreezeClientResponse{}, nil
+}
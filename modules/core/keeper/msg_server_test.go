@@ -403,6 +403,118 @@ func (suite *KeeperTestSuite) TestRecoverClient() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestFreezeClient() {
+	var msg *clienttypes.MsgFreezeClient
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: freeze client",
+			func() {},
+			nil,
+		},
+		{
+			"signer doesn't match authority",
+			func() {
+				msg.Signer = ibctesting.InvalidID
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"client not found",
+			func() {
+				msg.ClientId = clienttypes.FormatClientIdentifier(exported.Tendermint, 100)
+			},
+			clienttypes.ErrClientNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			msg = clienttypes.NewMsgFreezeClient(path.EndpointA.ClientID, suite.chainA.App.GetIBCKeeper().GetAuthority())
+
+			tc.malleate()
+
+			_, err := suite.chainA.App.GetIBCKeeper().FreezeClient(suite.chainA.GetContext(), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				status := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientStatus(suite.chainA.GetContext(), path.EndpointA.ClientID)
+				suite.Require().Equal(exported.Frozen, status)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUnfreezeClient() {
+	var msg *clienttypes.MsgUnfreezeClient
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: unfreeze client",
+			func() {},
+			nil,
+		},
+		{
+			"signer doesn't match authority",
+			func() {
+				msg.Signer = ibctesting.InvalidID
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"client is not frozen",
+			func() {
+				err := suite.chainA.App.GetIBCKeeper().ClientKeeper.UnfreezeClient(suite.chainA.GetContext(), msg.ClientId)
+				suite.Require().NoError(err)
+			},
+			clienttypes.ErrClientNotFrozen,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			err := suite.chainA.App.GetIBCKeeper().ClientKeeper.FreezeClient(suite.chainA.GetContext(), path.EndpointA.ClientID)
+			suite.Require().NoError(err)
+
+			msg = clienttypes.NewMsgUnfreezeClient(path.EndpointA.ClientID, suite.chainA.App.GetIBCKeeper().GetAuthority())
+
+			tc.malleate()
+
+			_, err = suite.chainA.App.GetIBCKeeper().UnfreezeClient(suite.chainA.GetContext(), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				status := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientStatus(suite.chainA.GetContext(), path.EndpointA.ClientID)
+				suite.Require().Equal(exported.Active, status)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
 // tests the IBC handler acknowledgement of a packet on ordered and unordered
 // channels. It verifies that the deletion of packet commitments from state
 // occurs. It test high level properties like ordering and basic sanity
@@ -1294,6 +1406,51 @@ func (suite *KeeperTestSuite) TestUpdateClientConfig() {
 	}
 }
 
+// TestUpdateClientConfigOrderedPorts tests that the next sequence receive of ports which become ordered is initialised
+func (suite *KeeperTestSuite) TestUpdateClientConfigOrderedPorts() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expNextSeq uint64
+	}{
+		{
+			"success: next sequence receive is initialised",
+			func() {},
+			1,
+		},
+		{
+			"success: existing next sequence receive is kept",
+			func() {
+				suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.SetNextSequenceRecv(suite.chainA.GetContext(), path.EndpointA.ClientID, ibctesting.MockPort, 5)
+			},
+			5,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			tc.malleate()
+
+			config := clientv2types.DefaultConfig()
+			config.OrderedPorts = []string{ibctesting.MockPort}
+			signer := suite.chainA.App.GetIBCKeeper().GetAuthority()
+
+			_, err := suite.chainA.App.GetIBCKeeper().UpdateClientConfig(suite.chainA.GetContext(), clientv2types.NewMsgUpdateClientConfig(path.EndpointA.ClientID, signer, config))
+			suite.Require().NoError(err)
+
+			nextSequenceRecv, found := suite.chainA.App.GetIBCKeeper().ChannelKeeperV2.GetNextSequenceRecv(suite.chainA.GetContext(), path.EndpointA.ClientID, ibctesting.MockPort)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expNextSeq, nextSequenceRecv)
+		})
+	}
+}
+
 // TestDeleteClientCreator tests the DeleteClientCreator message handler
 func (suite *KeeperTestSuite) TestDeleteClientCreator() {
 	var (
@@ -46,6 +46,7 @@ var (
 	_ module.HasProposalMsgs     = (*AppModule)(nil)
 	_ appmodule.AppModule        = (*AppModule)(nil)
 	_ appmodule.HasBeginBlocker  = (*AppModule)(nil)
+	_ appmodule.HasEndBlocker    = (*AppModule)(nil)
 )
 
 // AppModuleBasic defines the basic application module used by the ibc module.
@@ -212,6 +213,12 @@ func (am AppModule) BeginBlock(goCtx context.Context) error {
 	return nil
 }
 
+// EndBlock returns the end blocker for the ibc module.
+func (am AppModule) EndBlock(goCtx context.Context) error {
+	ibcclient.EndBlocker(sdk.UnwrapSDKContext(goCtx), am.keeper.ClientKeeper)
+	return nil
+}
+
 // AppModuleSimulation functions
 
 // GenerateGenesisState creates a randomized GenState of the ibc module.
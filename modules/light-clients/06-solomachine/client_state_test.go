@@ -17,7 +17,7 @@ const (
 
 func (suite *SoloMachineTestSuite) TestClientStateValidate() {
 	// test singlesig and multisig public keys
-	for _, sm := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+	for _, sm := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti, suite.solomachineWeighted} {
 
 		testCases := []struct {
 			name        string
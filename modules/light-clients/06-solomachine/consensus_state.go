@@ -53,5 +53,11 @@ func (cs ConsensusState) ValidateBasic() error {
 		return errorsmod.Wrap(clienttypes.ErrInvalidConsensus, "public key cannot be empty")
 	}
 
+	if weightedPubKey, ok := publicKey.(*WeightedMultisigPubKey); ok {
+		if err := weightedPubKey.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(clienttypes.ErrInvalidConsensus, "invalid weighted public key: %s", err)
+		}
+	}
+
 	return nil
 }
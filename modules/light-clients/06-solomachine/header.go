@@ -57,5 +57,11 @@ func (h Header) ValidateBasic() error {
 		return errorsmod.Wrap(clienttypes.ErrInvalidHeader, "new public key cannot be empty")
 	}
 
+	if weightedPubKey, ok := newPublicKey.(*WeightedMultisigPubKey); ok {
+		if err := weightedPubKey.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(clienttypes.ErrInvalidHeader, "invalid weighted new public key: %s", err)
+		}
+	}
+
 	return nil
 }
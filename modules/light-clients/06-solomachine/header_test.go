@@ -10,7 +10,7 @@ import (
 
 func (suite *SoloMachineTestSuite) TestHeaderValidateBasic() {
 	// test singlesig and multisig public keys
-	for _, sm := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+	for _, sm := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti, suite.solomachineWeighted} {
 
 		header := sm.CreateHeader(sm.Diversifier)
 
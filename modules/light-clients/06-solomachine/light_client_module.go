@@ -2,6 +2,7 @@ package solomachine
 
 import (
 	"reflect"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -12,7 +13,11 @@ import (
 	"github.com/cosmos/ibc-go/v10/modules/core/exported"
 )
 
-var _ exported.LightClientModule = (*LightClientModule)(nil)
+var (
+	_ exported.LightClientModule    = (*LightClientModule)(nil)
+	_ exported.ConsensusStatePruner = (*LightClientModule)(nil)
+	_ exported.ClientFreezer        = (*LightClientModule)(nil)
+)
 
 // LightClientModule implements the core IBC api.LightClientModule interface
 type LightClientModule struct {
@@ -222,3 +227,46 @@ func (l LightClientModule) RecoverClient(ctx sdk.Context, clientID, substituteCl
 func (LightClientModule) VerifyUpgradeAndUpdateState(ctx sdk.Context, clientID string, newClient, newConsState, upgradeClientProof, upgradeConsensusStateProof []byte) error {
 	return errorsmod.Wrap(clienttypes.ErrInvalidUpgradeClient, "cannot upgrade solomachine client")
 }
+
+// PruneConsensusStates never prunes a consensus state since a solomachine client only stores its current
+// consensus state within its client state, which is always retained.
+func (l LightClientModule) PruneConsensusStates(ctx sdk.Context, clientID string, _ uint64, _ time.Duration, _ uint64) (uint64, error) {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	if _, found := getClientState(clientStore, l.cdc); !found {
+		return 0, errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	return 0, nil
+}
+
+// FreezeClient freezes the client by setting its frozen flag.
+func (l LightClientModule) FreezeClient(ctx sdk.Context, clientID string) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	clientState.IsFrozen = true
+	setClientState(clientStore, l.cdc, clientState)
+
+	return nil
+}
+
+// UnfreezeClient unfreezes a frozen client by resetting its frozen flag.
+func (l LightClientModule) UnfreezeClient(ctx sdk.Context, clientID string) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	if !clientState.IsFrozen {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFrozen, clientID)
+	}
+
+	clientState.IsFrozen = false
+	setClientState(clientStore, l.cdc, clientState)
+
+	return nil
+}
@@ -135,7 +135,7 @@ func (suite *SoloMachineTestSuite) TestGetTimestampAtHeight() {
 
 func (suite *SoloMachineTestSuite) TestInitialize() {
 	// test singlesig and multisig public keys
-	for _, sm := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+	for _, sm := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti, suite.solomachineWeighted} {
 		malleatedConsensus := sm.ClientState().ConsensusState
 		malleatedConsensus.Timestamp += 10
 
@@ -221,7 +221,7 @@ func (suite *SoloMachineTestSuite) TestVerifyMembership() {
 	)
 
 	// test singlesig and multisig public keys
-	for _, sm := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+	for _, sm := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti, suite.solomachineWeighted} {
 
 		testCases := []struct {
 			name     string
@@ -700,7 +700,7 @@ func (suite *SoloMachineTestSuite) TestVerifyNonMembership() {
 	)
 
 	// test singlesig and multisig public keys
-	for _, sm := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+	for _, sm := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti, suite.solomachineWeighted} {
 		testCases := []struct {
 			name     string
 			malleate func()
@@ -1015,7 +1015,7 @@ func (suite *SoloMachineTestSuite) TestUpdateState() {
 	)
 
 	// test singlesig and multisig public keys
-	for _, sm := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+	for _, sm := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti, suite.solomachineWeighted} {
 
 		testCases := []struct {
 			name     string
@@ -1103,7 +1103,7 @@ func (suite *SoloMachineTestSuite) TestCheckForMisbehaviour() {
 	)
 
 	// test singlesig and multisig public keys
-	for _, sm := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+	for _, sm := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti, suite.solomachineWeighted} {
 		testCases := []struct {
 			name              string
 			malleate          func()
@@ -1171,7 +1171,7 @@ func (suite *SoloMachineTestSuite) TestUpdateStateOnMisbehaviour() {
 	var clientID string
 
 	// test singlesig and multisig public keys
-	for _, sm := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+	for _, sm := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti, suite.solomachineWeighted} {
 		testCases := []struct {
 			name     string
 			malleate func()
@@ -1233,7 +1233,7 @@ func (suite *SoloMachineTestSuite) TestVerifyClientMessageHeader() {
 	)
 
 	// test singlesig and multisig public keys
-	for _, sm := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+	for _, sm := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti, suite.solomachineWeighted} {
 
 		testCases := []struct {
 			name     string
@@ -1398,7 +1398,7 @@ func (suite *SoloMachineTestSuite) TestVerifyClientMessageMisbehaviour() {
 	)
 
 	// test singlesig and multisig public keys
-	for _, sm := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+	for _, sm := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti, suite.solomachineWeighted} {
 
 		testCases := []struct {
 			name     string
@@ -1685,3 +1685,110 @@ func (suite *SoloMachineTestSuite) TestLatestHeight() {
 		})
 	}
 }
+
+func (suite *SoloMachineTestSuite) TestFreezeClient() {
+	var clientID string
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: cannot find client state",
+			func() {
+				clientID = unusedSmClientID
+			},
+			clienttypes.ErrClientNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			clientID = suite.solomachine.ClientID
+
+			lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), clientID)
+			suite.Require().NoError(err)
+
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), clientID, suite.solomachine.ClientState())
+
+			tc.malleate()
+
+			freezer, ok := lightClientModule.(exported.ClientFreezer)
+			suite.Require().True(ok)
+
+			err = freezer.FreezeClient(suite.chainA.GetContext(), clientID)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(exported.Frozen, lightClientModule.Status(suite.chainA.GetContext(), clientID))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *SoloMachineTestSuite) TestUnfreezeClient() {
+	var clientID string
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: cannot find client state",
+			func() {
+				clientID = unusedSmClientID
+			},
+			clienttypes.ErrClientNotFound,
+		},
+		{
+			"failure: client is not frozen",
+			func() {
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), clientID, suite.solomachine.ClientState())
+			},
+			clienttypes.ErrClientNotFrozen,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			clientID = suite.solomachine.ClientID
+
+			lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), clientID)
+			suite.Require().NoError(err)
+
+			clientState := suite.solomachine.ClientState()
+			clientState.IsFrozen = true
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), clientID, clientState)
+
+			tc.malleate()
+
+			freezer, ok := lightClientModule.(exported.ClientFreezer)
+			suite.Require().True(ok)
+
+			err = freezer.UnfreezeClient(suite.chainA.GetContext(), clientID)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(exported.Active, lightClientModule.Status(suite.chainA.GetContext(), clientID))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
@@ -16,7 +16,7 @@ func (suite *SoloMachineTestSuite) TestMisbehaviour() {
 
 func (suite *SoloMachineTestSuite) TestMisbehaviourValidateBasic() {
 	// test singlesig and multisig public keys
-	for _, sm := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+	for _, sm := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti, suite.solomachineWeighted} {
 
 		testCases := []struct {
 			name                 string
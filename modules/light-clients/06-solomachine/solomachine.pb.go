@@ -365,6 +365,89 @@ func (m *HeaderData) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HeaderData proto.InternalMessageInfo
 
+// WeightedMultisigPubKey defines a weighted threshold public key which allows a solo machine
+// to be operated by a committee. A multi-signature is valid if the summed weight of the public
+// keys which signed is at least the threshold. Each public key may be any single or
+// multi-signature public key registered on the application codec.
+type WeightedMultisigPubKey struct {
+	// minimum summed weight of the signers
+	Threshold uint64 `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// weighted public keys of the committee members
+	PublicKeys []WeightedPubKey `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys"`
+}
+
+func (m *WeightedMultisigPubKey) Reset()         { *m = WeightedMultisigPubKey{} }
+func (m *WeightedMultisigPubKey) String() string { return proto.CompactTextString(m) }
+func (*WeightedMultisigPubKey) ProtoMessage()    {}
+func (*WeightedMultisigPubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_264187157b9220a4, []int{8}
+}
+func (m *WeightedMultisigPubKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedMultisigPubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedMultisigPubKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedMultisigPubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedMultisigPubKey.Merge(m, src)
+}
+func (m *WeightedMultisigPubKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedMultisigPubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedMultisigPubKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedMultisigPubKey proto.InternalMessageInfo
+
+// WeightedPubKey defines a public key and its voting weight in a WeightedMultisigPubKey.
+type WeightedPubKey struct {
+	PublicKey *types.Any `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Weight    uint64     `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *WeightedPubKey) Reset()         { *m = WeightedPubKey{} }
+func (m *WeightedPubKey) String() string { return proto.CompactTextString(m) }
+func (*WeightedPubKey) ProtoMessage()    {}
+func (*WeightedPubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_264187157b9220a4, []int{9}
+}
+func (m *WeightedPubKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedPubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedPubKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedPubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedPubKey.Merge(m, src)
+}
+func (m *WeightedPubKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedPubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedPubKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedPubKey proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ClientState)(nil), "ibc.lightclients.solomachine.v3.ClientState")
 	proto.RegisterType((*ConsensusState)(nil), "ibc.lightclients.solomachine.v3.ConsensusState")
@@ -374,6 +457,8 @@ func init() {
 	proto.RegisterType((*TimestampedSignatureData)(nil), "ibc.lightclients.solomachine.v3.TimestampedSignatureData")
 	proto.RegisterType((*SignBytes)(nil), "ibc.lightclients.solomachine.v3.SignBytes")
 	proto.RegisterType((*HeaderData)(nil), "ibc.lightclients.solomachine.v3.HeaderData")
+	proto.RegisterType((*WeightedMultisigPubKey)(nil), "ibc.lightclients.solomachine.v3.WeightedMultisigPubKey")
+	proto.RegisterType((*WeightedPubKey)(nil), "ibc.lightclients.solomachine.v3.WeightedPubKey")
 }
 
 func init() {
@@ -381,46 +466,51 @@ func init() {
 }
 
 var fileDescriptor_264187157b9220a4 = []byte{
-	// 620 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xbf, 0x6f, 0xd3, 0x4e,
-	0x14, 0xcf, 0xb5, 0xfe, 0x56, 0xcd, 0x39, 0xdf, 0x14, 0x59, 0x1d, 0x42, 0x41, 0x6e, 0x54, 0x09,
-	0xd1, 0xa5, 0x76, 0x93, 0x20, 0x86, 0x32, 0xb5, 0x8d, 0x10, 0x12, 0xaa, 0x40, 0x6e, 0x85, 0x10,
-	0x4b, 0x74, 0xb6, 0x2f, 0xce, 0x09, 0xfb, 0x2e, 0xf5, 0x9d, 0x13, 0x05, 0xf1, 0x07, 0x20, 0xb1,
-	0xb0, 0xb0, 0x33, 0xb1, 0xf2, 0x6f, 0x30, 0x76, 0x64, 0xac, 0x92, 0x7f, 0x04, 0xf9, 0x6c, 0xc7,
-	0x8e, 0x9b, 0x26, 0x03, 0xdb, 0x7b, 0xcf, 0xef, 0x7d, 0xee, 0xf3, 0x79, 0x3f, 0x0c, 0x5b, 0xc4,
-	0x76, 0x4c, 0x9f, 0x78, 0x03, 0xe1, 0xf8, 0x04, 0x53, 0xc1, 0x4d, 0xce, 0x7c, 0x16, 0x20, 0x67,
-	0x40, 0x28, 0x36, 0x47, 0x9d, 0xa2, 0x6b, 0x0c, 0x43, 0x26, 0x98, 0xb6, 0x4f, 0x6c, 0xc7, 0x28,
-	0x96, 0x18, 0xc5, 0x9c, 0x51, 0x67, 0x6f, 0xd7, 0x63, 0x1e, 0x93, 0xb9, 0x66, 0x6c, 0x25, 0x65,
-	0x7b, 0x0f, 0x3d, 0xc6, 0x3c, 0x1f, 0x9b, 0xd2, 0xb3, 0xa3, 0xbe, 0x89, 0xe8, 0x24, 0xf9, 0x74,
-	0xf0, 0x13, 0x40, 0xf5, 0x5c, 0x62, 0x5d, 0x0a, 0x24, 0xb0, 0xb6, 0x07, 0xb7, 0x39, 0xbe, 0x8e,
-	0x30, 0x75, 0x70, 0x03, 0x34, 0xc1, 0xa1, 0x62, 0xcd, 0x7d, 0xed, 0x11, 0xac, 0x12, 0xde, 0xeb,
-	0x87, 0xec, 0x13, 0xa6, 0x8d, 0x8d, 0x26, 0x38, 0xdc, 0xb6, 0xb6, 0x09, 0x7f, 0x29, 0x7d, 0xed,
-	0x3d, 0xdc, 0x71, 0x18, 0xe5, 0x98, 0xf2, 0x88, 0xf7, 0x78, 0x8c, 0xd5, 0xd8, 0x6c, 0x82, 0x43,
-	0xb5, 0x6d, 0x1a, 0x6b, 0x48, 0x1b, 0xe7, 0x59, 0x9d, 0xa4, 0x60, 0xd5, 0x9d, 0x05, 0xff, 0x44,
-	0xf9, 0xf2, 0x63, 0xbf, 0x72, 0xf0, 0x15, 0xc0, 0xfa, 0x62, 0xa2, 0xd6, 0x81, 0x70, 0x18, 0xd9,
-	0x3e, 0x71, 0x7a, 0x1f, 0xf1, 0x44, 0xb2, 0x55, 0xdb, 0xbb, 0x46, 0xa2, 0xd5, 0xc8, 0xb4, 0x1a,
-	0xa7, 0x74, 0x62, 0x55, 0x93, 0xbc, 0xd7, 0x78, 0xa2, 0x35, 0xa1, 0xea, 0x92, 0x11, 0x0e, 0x39,
-	0xe9, 0x13, 0x1c, 0x4a, 0x19, 0x55, 0xab, 0x18, 0xd2, 0x1e, 0xc3, 0xaa, 0x20, 0x01, 0xe6, 0x02,
-	0x05, 0x43, 0xa9, 0x41, 0xb1, 0xf2, 0x40, 0xca, 0xe6, 0x17, 0x80, 0x5b, 0xaf, 0x30, 0x72, 0xcb,
-	0xe9, 0xa0, 0x94, 0x1e, 0x7f, 0xe5, 0xc4, 0xa3, 0x48, 0x44, 0x21, 0x96, 0x8f, 0xd5, 0xac, 0x3c,
-	0xa0, 0x9d, 0xc0, 0x3a, 0xc5, 0xe3, 0x5e, 0x41, 0xc5, 0xe6, 0x0a, 0x15, 0x35, 0x8a, 0xc7, 0x6f,
-	0xe7, 0x42, 0x9e, 0xc2, 0x9d, 0xb8, 0xb6, 0x28, 0x46, 0x91, 0x62, 0x62, 0xc8, 0x6e, 0x1e, 0x4d,
-	0x19, 0xdf, 0x02, 0x58, 0xbb, 0x20, 0xdc, 0xc6, 0x03, 0x34, 0x22, 0x2c, 0x0a, 0x57, 0x4e, 0xfa,
-	0x1d, 0xfc, 0x7f, 0x4e, 0xb2, 0xc7, 0x68, 0xc2, 0x5c, 0x6d, 0xb7, 0xd6, 0x8e, 0xf2, 0x32, 0xab,
-	0x3a, 0xa5, 0x6e, 0x17, 0x09, 0x64, 0xd5, 0xe6, 0x38, 0x6f, 0x68, 0x09, 0x57, 0x8c, 0x59, 0x2a,
-	0xf7, 0x9f, 0x70, 0xaf, 0xc6, 0x2c, 0x95, 0xf8, 0x19, 0x3e, 0x28, 0xe7, 0x2d, 0xf6, 0x1f, 0x94,
-	0xfb, 0xaf, 0x41, 0x65, 0x88, 0xc4, 0x20, 0x1d, 0x8c, 0xb4, 0xe3, 0x98, 0x8b, 0x04, 0x92, 0xd4,
-	0x6a, 0x96, 0xb4, 0x17, 0x67, 0xac, 0x2c, 0x5f, 0x09, 0x0c, 0x1b, 0x57, 0x59, 0x08, 0xbb, 0x73,
-	0x22, 0x92, 0xc5, 0x13, 0x58, 0xcf, 0x75, 0x4b, 0xf4, 0x84, 0x4a, 0xde, 0x8d, 0xee, 0x9d, 0x67,
-	0x36, 0x96, 0x3f, 0xf3, 0x1d, 0xc0, 0x6a, 0x0c, 0x7e, 0x36, 0x11, 0x98, 0xaf, 0x1c, 0xe2, 0x4a,
-	0xb4, 0xf2, 0x1d, 0x6c, 0xde, 0xbd, 0x83, 0xac, 0x39, 0xca, 0x92, 0xe6, 0xfc, 0x97, 0x37, 0x27,
-	0xe5, 0x75, 0x0d, 0x61, 0x72, 0x10, 0x52, 0xc9, 0x33, 0xa8, 0xa6, 0x8b, 0xbd, 0xfe, 0x36, 0x93,
-	0xad, 0xbe, 0x67, 0xa5, 0x37, 0xee, 0x5f, 0xe9, 0x33, 0xef, 0xf7, 0x54, 0x07, 0x37, 0x53, 0x1d,
-	0xdc, 0x4e, 0x75, 0xf0, 0x6d, 0xa6, 0x57, 0x6e, 0x66, 0x7a, 0xe5, 0xcf, 0x4c, 0xaf, 0x7c, 0xb8,
-	0xf0, 0x88, 0x18, 0x44, 0xb6, 0xe1, 0xb0, 0xc0, 0x74, 0x18, 0x0f, 0x18, 0x37, 0x89, 0xed, 0x1c,
-	0x79, 0xcc, 0x1c, 0xb5, 0x8e, 0xcd, 0x80, 0xb9, 0x91, 0x8f, 0x79, 0xf2, 0xef, 0x3d, 0xca, 0x7e,
-	0xbe, 0xc7, 0xcf, 0x8f, 0x0a, 0x4b, 0xf7, 0xa2, 0x60, 0xdb, 0x5b, 0x92, 0x70, 0xe7, 0x6f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x8f, 0xab, 0xf8, 0x82, 0xb2, 0x05, 0x00, 0x00,
+	// 696 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcb, 0x6e, 0xd3, 0x4c,
+	0x14, 0xce, 0x24, 0xfe, 0xab, 0xe6, 0x38, 0x7f, 0x8a, 0xac, 0xaa, 0x0a, 0x05, 0xa5, 0x51, 0x25,
+	0x44, 0x37, 0xb5, 0xdb, 0x06, 0xb1, 0x28, 0xab, 0x5e, 0x84, 0x90, 0x50, 0x05, 0x72, 0xab, 0x82,
+	0xd8, 0x44, 0xbe, 0x4c, 0xed, 0x11, 0xce, 0x4c, 0xea, 0x19, 0x27, 0x0a, 0xe2, 0x01, 0x90, 0xd8,
+	0xb0, 0x80, 0x3d, 0x2b, 0xb6, 0xbc, 0x46, 0x97, 0x5d, 0xb2, 0x42, 0x55, 0xfb, 0x22, 0xc8, 0xe3,
+	0x6b, 0xd2, 0x92, 0x22, 0xd8, 0xcd, 0x39, 0x3e, 0xf3, 0x9d, 0xef, 0x3b, 0x97, 0x31, 0x6c, 0x12,
+	0xdb, 0x31, 0x02, 0xe2, 0xf9, 0xc2, 0x09, 0x08, 0xa6, 0x82, 0x1b, 0x9c, 0x05, 0xac, 0x6f, 0x39,
+	0x3e, 0xa1, 0xd8, 0x18, 0x76, 0xcb, 0xa6, 0x3e, 0x08, 0x99, 0x60, 0xda, 0x0a, 0xb1, 0x1d, 0xbd,
+	0x7c, 0x45, 0x2f, 0xc7, 0x0c, 0xbb, 0xcb, 0x8b, 0x1e, 0xf3, 0x98, 0x8c, 0x35, 0xe2, 0x53, 0x72,
+	0x6d, 0xf9, 0xae, 0xc7, 0x98, 0x17, 0x60, 0x43, 0x5a, 0x76, 0x74, 0x62, 0x58, 0x74, 0x9c, 0x7c,
+	0x5a, 0xfd, 0x86, 0x40, 0xdd, 0x93, 0x58, 0x87, 0xc2, 0x12, 0x58, 0x5b, 0x86, 0x79, 0x8e, 0x4f,
+	0x23, 0x4c, 0x1d, 0xdc, 0x42, 0x1d, 0xb4, 0xa6, 0x98, 0xb9, 0xad, 0xdd, 0x83, 0x3a, 0xe1, 0xbd,
+	0x93, 0x90, 0xbd, 0xc3, 0xb4, 0x55, 0xed, 0xa0, 0xb5, 0x79, 0x73, 0x9e, 0xf0, 0xa7, 0xd2, 0xd6,
+	0x5e, 0xc3, 0x82, 0xc3, 0x28, 0xc7, 0x94, 0x47, 0xbc, 0xc7, 0x63, 0xac, 0x56, 0xad, 0x83, 0xd6,
+	0xd4, 0x2d, 0x43, 0xbf, 0x85, 0xb4, 0xbe, 0x97, 0xdd, 0x93, 0x14, 0xcc, 0xa6, 0x33, 0x61, 0x6f,
+	0x2b, 0x1f, 0xbe, 0xae, 0x54, 0x56, 0x3f, 0x22, 0x68, 0x4e, 0x06, 0x6a, 0x5d, 0x80, 0x41, 0x64,
+	0x07, 0xc4, 0xe9, 0xbd, 0xc5, 0x63, 0xc9, 0x56, 0xdd, 0x5a, 0xd4, 0x13, 0xad, 0x7a, 0xa6, 0x55,
+	0xdf, 0xa1, 0x63, 0xb3, 0x9e, 0xc4, 0x3d, 0xc7, 0x63, 0xad, 0x03, 0xaa, 0x4b, 0x86, 0x38, 0xe4,
+	0xe4, 0x84, 0xe0, 0x50, 0xca, 0xa8, 0x9b, 0x65, 0x97, 0x76, 0x1f, 0xea, 0x82, 0xf4, 0x31, 0x17,
+	0x56, 0x7f, 0x20, 0x35, 0x28, 0x66, 0xe1, 0x48, 0xd9, 0x7c, 0x47, 0x30, 0xf7, 0x0c, 0x5b, 0xee,
+	0x74, 0x38, 0x9a, 0x0a, 0x8f, 0xbf, 0x72, 0xe2, 0x51, 0x4b, 0x44, 0x21, 0x96, 0xc9, 0x1a, 0x66,
+	0xe1, 0xd0, 0xb6, 0xa1, 0x49, 0xf1, 0xa8, 0x57, 0x52, 0x51, 0x9b, 0xa1, 0xa2, 0x41, 0xf1, 0xe8,
+	0x65, 0x2e, 0xe4, 0x21, 0x2c, 0xc4, 0x77, 0xcb, 0x62, 0x14, 0x29, 0x26, 0x86, 0xdc, 0x2f, 0xbc,
+	0x29, 0xe3, 0x0b, 0x04, 0x8d, 0x03, 0xc2, 0x6d, 0xec, 0x5b, 0x43, 0xc2, 0xa2, 0x70, 0x66, 0xa7,
+	0x8f, 0xe1, 0xff, 0x9c, 0x64, 0x8f, 0xd1, 0x84, 0xb9, 0xba, 0xb5, 0x79, 0x6b, 0x2b, 0x0f, 0xb3,
+	0x5b, 0x3b, 0xd4, 0xdd, 0xb7, 0x84, 0x65, 0x36, 0x72, 0x9c, 0x17, 0x74, 0x0a, 0x57, 0x8c, 0x58,
+	0x2a, 0xf7, 0x9f, 0x70, 0x8f, 0x46, 0x2c, 0x95, 0xf8, 0x1e, 0xee, 0x4c, 0xc7, 0x4d, 0xd6, 0x1f,
+	0x4d, 0xd7, 0x5f, 0x03, 0x65, 0x60, 0x09, 0x3f, 0x6d, 0x8c, 0x3c, 0xc7, 0x3e, 0xd7, 0x12, 0x96,
+	0xa4, 0xd6, 0x30, 0xe5, 0x79, 0xb2, 0xc7, 0xca, 0xcd, 0x23, 0x81, 0xa1, 0x75, 0x94, 0xb9, 0xb0,
+	0x9b, 0x13, 0x91, 0x2c, 0x1e, 0x40, 0xb3, 0xd0, 0x2d, 0xd1, 0x13, 0x2a, 0x45, 0x35, 0xf6, 0xaf,
+	0xa5, 0xa9, 0xde, 0x9c, 0xe6, 0x0b, 0x82, 0x7a, 0x0c, 0xbe, 0x3b, 0x16, 0x98, 0xcf, 0x6c, 0xe2,
+	0x4c, 0xb4, 0xe9, 0x3d, 0xa8, 0x5d, 0xdf, 0x83, 0xac, 0x38, 0xca, 0x0d, 0xc5, 0xf9, 0xaf, 0x28,
+	0x4e, 0xca, 0xeb, 0x14, 0x20, 0x59, 0x08, 0xa9, 0xe4, 0x11, 0xa8, 0xe9, 0x60, 0xdf, 0xbe, 0x9b,
+	0xc9, 0x54, 0xff, 0x66, 0xa4, 0xab, 0x33, 0x46, 0xfa, 0x33, 0x82, 0xa5, 0x57, 0x38, 0x9e, 0x1a,
+	0xec, 0x1e, 0x44, 0x81, 0x20, 0x9c, 0x78, 0x29, 0x52, 0xac, 0xdd, 0x0f, 0x31, 0xf7, 0x59, 0xe0,
+	0xe6, 0x4b, 0x99, 0x39, 0xb4, 0x63, 0x50, 0x8b, 0x95, 0xe3, 0xad, 0x6a, 0xa7, 0xf6, 0x47, 0xef,
+	0x54, 0x96, 0x2b, 0xc9, 0xb1, 0xab, 0x9c, 0xfd, 0x5c, 0xa9, 0x98, 0x90, 0x3f, 0x2d, 0x3c, 0xa5,
+	0xe5, 0x40, 0x73, 0x32, 0xf2, 0xef, 0x1e, 0xaa, 0x25, 0x98, 0x1b, 0x49, 0x98, 0xb4, 0x77, 0xa9,
+	0x95, 0x24, 0xd9, 0xf5, 0xce, 0x2e, 0xdb, 0xe8, 0xfc, 0xb2, 0x8d, 0x2e, 0x2e, 0xdb, 0xe8, 0xd3,
+	0x55, 0xbb, 0x72, 0x7e, 0xd5, 0xae, 0xfc, 0xb8, 0x6a, 0x57, 0xde, 0x1c, 0x78, 0x44, 0xf8, 0x91,
+	0xad, 0x3b, 0xac, 0x6f, 0x38, 0x8c, 0xf7, 0x19, 0x37, 0x88, 0xed, 0xac, 0x7b, 0xcc, 0x18, 0x6e,
+	0x6e, 0x18, 0x7d, 0xe6, 0x46, 0x01, 0xe6, 0xc9, 0x7f, 0x67, 0x3d, 0xfb, 0xf1, 0x6c, 0x3c, 0x5e,
+	0x2f, 0x69, 0x7d, 0x52, 0x3a, 0xdb, 0x73, 0x92, 0x5f, 0xf7, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff,
+	0xfc, 0xdf, 0x90, 0x14, 0xae, 0x06, 0x00, 0x00,
 }
 
 func (m *ClientState) Marshal() (dAtA []byte, err error) {
@@ -806,6 +896,88 @@ func (m *HeaderData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WeightedMultisigPubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedMultisigPubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedMultisigPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PublicKeys) > 0 {
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PublicKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSolomachine(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintSolomachine(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WeightedPubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedPubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintSolomachine(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PublicKey != nil {
+		{
+			size, err := m.PublicKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSolomachine(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSolomachine(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSolomachine(v)
 	base := offset
@@ -984,6 +1156,40 @@ func (m *HeaderData) Size() (n int) {
 	return n
 }
 
+func (m *WeightedMultisigPubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		n += 1 + sovSolomachine(uint64(m.Threshold))
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, e := range m.PublicKeys {
+			l = e.Size()
+			n += 1 + l + sovSolomachine(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WeightedPubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PublicKey != nil {
+		l = m.PublicKey.Size()
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovSolomachine(uint64(m.Weight))
+	}
+	return n
+}
+
 func sovSolomachine(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2144,6 +2350,214 @@ func (m *HeaderData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WeightedMultisigPubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSolomachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedMultisigPubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedMultisigPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = append(m.PublicKeys, WeightedPubKey{})
+			if err := m.PublicKeys[len(m.PublicKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSolomachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightedPubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSolomachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedPubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PublicKey == nil {
+				m.PublicKey = &types.Any{}
+			}
+			if err := m.PublicKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSolomachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSolomachine(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -29,9 +29,10 @@ var channelIDSolomachine = "channel-on-solomachine" // channelID generated on so
 type SoloMachineTestSuite struct {
 	testifysuite.Suite
 
-	solomachine      *ibctesting.Solomachine // singlesig public key
-	solomachineMulti *ibctesting.Solomachine // multisig public key
-	coordinator      *ibctesting.Coordinator
+	solomachine         *ibctesting.Solomachine // singlesig public key
+	solomachineMulti    *ibctesting.Solomachine // multisig public key
+	solomachineWeighted *ibctesting.Solomachine // weighted multisig public key
+	coordinator         *ibctesting.Coordinator
 
 	// testing chain used for convenience and readability
 	chainA *ibctesting.TestChain
@@ -47,6 +48,7 @@ func (suite *SoloMachineTestSuite) SetupTest() {
 
 	suite.solomachine = ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, "06-solomachine-0", "testing", 1)
 	suite.solomachineMulti = ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, "06-solomachine-1", "testing", 4)
+	suite.solomachineWeighted = ibctesting.NewWeightedSolomachine(suite.T(), suite.chainA.Codec, "06-solomachine-2", "testing", 5, []uint64{1, 2, 3, 1, 1})
 
 	suite.store = suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), exported.Solomachine)
 }
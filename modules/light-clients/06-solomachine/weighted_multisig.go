@@ -0,0 +1,244 @@
+package solomachine
+
+import (
+	"fmt"
+
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// WeightedMultisigPubKeyType is the public key type of a WeightedMultisigPubKey.
+const WeightedMultisigPubKeyType = "weighted-multisig"
+
+var (
+	_ multisig.PubKey                    = (*WeightedMultisigPubKey)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*WeightedMultisigPubKey)(nil)
+)
+
+// NewWeightedMultisigPubKey creates a new WeightedMultisigPubKey instance. The weight at each index
+// is assigned to the public key at the same index. An error is returned if the resulting public key
+// is invalid.
+func NewWeightedMultisigPubKey(threshold uint64, pubKeys []cryptotypes.PubKey, weights []uint64) (*WeightedMultisigPubKey, error) {
+	if len(pubKeys) != len(weights) {
+		return nil, errorsmod.Wrapf(ErrInvalidPublicKey, "number of public keys and weights must be equal (%d != %d)", len(pubKeys), len(weights))
+	}
+
+	weightedPubKeys := make([]WeightedPubKey, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		anyPubKey, err := codectypes.NewAnyWithValue(pubKey)
+		if err != nil {
+			return nil, errorsmod.Wrapf(ErrInvalidPublicKey, "failed to pack public key at index %d: %s", i, err)
+		}
+
+		weightedPubKeys[i] = WeightedPubKey{PublicKey: anyPubKey, Weight: weights[i]}
+	}
+
+	pubKey := &WeightedMultisigPubKey{
+		Threshold:  threshold,
+		PublicKeys: weightedPubKeys,
+	}
+	if err := pubKey.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return pubKey, nil
+}
+
+// ValidateBasic performs a basic validation of the weighted public key. The threshold must be
+// positive and reachable by the summed weight of all public keys, every public key must have a
+// positive weight and public keys may not be repeated.
+func (m *WeightedMultisigPubKey) ValidateBasic() error {
+	if m.Threshold == 0 {
+		return errorsmod.Wrap(ErrInvalidPublicKey, "threshold cannot be zero")
+	}
+	if len(m.PublicKeys) == 0 {
+		return errorsmod.Wrap(ErrInvalidPublicKey, "public keys cannot be empty")
+	}
+
+	var totalWeight uint64
+	pubKeys := m.GetPubKeys()
+	seen := make(map[string]struct{}, len(m.PublicKeys))
+	for i, weightedPubKey := range m.PublicKeys {
+		pubKey := pubKeys[i]
+		if pubKey == nil || len(pubKey.Bytes()) == 0 {
+			return errorsmod.Wrapf(ErrInvalidPublicKey, "public key at index %d cannot be empty", i)
+		}
+
+		// a repeated public key would allow a single signer to contribute its weight more than once
+		key := string(pubKey.Bytes())
+		if _, found := seen[key]; found {
+			return errorsmod.Wrapf(ErrInvalidPublicKey, "duplicate public key at index %d", i)
+		}
+		seen[key] = struct{}{}
+
+		if weightedPubKey.Weight == 0 {
+			return errorsmod.Wrapf(ErrInvalidPublicKey, "weight of public key at index %d cannot be zero", i)
+		}
+		if totalWeight+weightedPubKey.Weight < totalWeight {
+			return errorsmod.Wrap(ErrInvalidPublicKey, "total weight overflows uint64")
+		}
+		totalWeight += weightedPubKey.Weight
+	}
+
+	if totalWeight < m.Threshold {
+		return errorsmod.Wrapf(ErrInvalidPublicKey, "threshold %d cannot be greater than the total weight %d", m.Threshold, totalWeight)
+	}
+
+	return nil
+}
+
+// Address implements cryptotypes.PubKey Address method
+func (m *WeightedMultisigPubKey) Address() cryptotypes.Address {
+	return cmtcrypto.AddressHash(m.Bytes())
+}
+
+// Bytes returns the proto encoded version of the WeightedMultisigPubKey
+func (m *WeightedMultisigPubKey) Bytes() []byte {
+	bz, err := m.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+// VerifySignature implements cryptotypes.PubKey VerifySignature method. It always returns false
+// since a weighted public key can only verify MultiSignatureData using VerifyMultisignature.
+func (*WeightedMultisigPubKey) VerifySignature(_, _ []byte) bool {
+	return false
+}
+
+// VerifyMultisignature implements the multisig.PubKey VerifyMultisignature method. The bit array
+// of the signature data must have a bit for every public key, and the signatures must be provided
+// in the order of the public keys whose bits are set. The summed weight of the signers must be
+// at least the threshold.
+func (m *WeightedMultisigPubKey) VerifyMultisignature(getSignBytes multisig.GetSignBytesFunc, sig *signing.MultiSignatureData) error {
+	if sig == nil || sig.BitArray == nil {
+		return fmt.Errorf("bit array cannot be nil")
+	}
+
+	pubKeys := m.GetPubKeys()
+	size := sig.BitArray.Count()
+	if len(pubKeys) != size {
+		return fmt.Errorf("bit array size is incorrect, expecting: %d, got: %d", len(pubKeys), size)
+	}
+
+	if numSigners := sig.BitArray.NumTrueBitsBefore(size); len(sig.Signatures) != numSigners {
+		return fmt.Errorf("number of signatures %d does not match the number of signers %d", len(sig.Signatures), numSigners)
+	}
+
+	var weight uint64
+	sigIndex := 0
+	for i := range size {
+		if !sig.BitArray.GetIndex(i) {
+			continue
+		}
+		if pubKeys[i] == nil {
+			return fmt.Errorf("public key at index %d cannot be empty", i)
+		}
+
+		switch si := sig.Signatures[sigIndex].(type) {
+		case *signing.SingleSignatureData:
+			// multisig public keys may panic when verifying a single signature
+			if _, ok := pubKeys[i].(multisig.PubKey); ok {
+				return fmt.Errorf("single signature provided for multisig public key at index %d", i)
+			}
+
+			msg, err := getSignBytes(si.SignMode)
+			if err != nil {
+				return err
+			}
+			if !pubKeys[i].VerifySignature(msg, si.Signature) {
+				return fmt.Errorf("unable to verify signature at index %d", i)
+			}
+		case *signing.MultiSignatureData:
+			nestedPubKey, ok := pubKeys[i].(multisig.PubKey)
+			if !ok {
+				return fmt.Errorf("public key at index %d is not a multisig public key", i)
+			}
+			if err := nestedPubKey.VerifyMultisignature(getSignBytes, si); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("improper signature data type for index %d", sigIndex)
+		}
+
+		// the total weight is bounded by ValidateBasic, but the public key may not have been validated
+		if weight+m.PublicKeys[i].Weight < weight {
+			return fmt.Errorf("signer weight overflows uint64")
+		}
+		weight += m.PublicKeys[i].Weight
+		sigIndex++
+	}
+
+	if weight < m.Threshold {
+		return fmt.Errorf("insufficient signer weight, have %d, expected at least %d", weight, m.Threshold)
+	}
+
+	return nil
+}
+
+// GetPubKeys implements the multisig.PubKey GetPubKeys method
+func (m *WeightedMultisigPubKey) GetPubKeys() []cryptotypes.PubKey {
+	if m == nil {
+		return nil
+	}
+
+	pubKeys := make([]cryptotypes.PubKey, len(m.PublicKeys))
+	for i, weightedPubKey := range m.PublicKeys {
+		if weightedPubKey.PublicKey != nil {
+			pubKeys[i], _ = weightedPubKey.PublicKey.GetCachedValue().(cryptotypes.PubKey)
+		}
+	}
+
+	return pubKeys
+}
+
+// GetThreshold implements the multisig.PubKey GetThreshold method. The threshold of a
+// weighted public key is a weight rather than a number of signatures.
+func (m *WeightedMultisigPubKey) GetThreshold() uint {
+	return uint(m.Threshold)
+}
+
+// Equals returns true if the other public key is a weighted public key with the same
+// threshold, public keys and weights in the same order.
+func (m *WeightedMultisigPubKey) Equals(key cryptotypes.PubKey) bool {
+	other, ok := key.(*WeightedMultisigPubKey)
+	if !ok || m.Threshold != other.Threshold || len(m.PublicKeys) != len(other.PublicKeys) {
+		return false
+	}
+
+	pubKeys, otherPubKeys := m.GetPubKeys(), other.GetPubKeys()
+	for i := range pubKeys {
+		if m.PublicKeys[i].Weight != other.PublicKeys[i].Weight {
+			return false
+		}
+		if pubKeys[i] == nil || otherPubKeys[i] == nil || !pubKeys[i].Equals(otherPubKeys[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Type returns the weighted multisig public key type
+func (*WeightedMultisigPubKey) Type() string {
+	return WeightedMultisigPubKeyType
+}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (m *WeightedMultisigPubKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, weightedPubKey := range m.PublicKeys {
+		if err := unpacker.UnpackAny(weightedPubKey.PublicKey, new(cryptotypes.PubKey)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
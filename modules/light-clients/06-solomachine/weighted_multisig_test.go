@@ -0,0 +1,422 @@
+package solomachine_test
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+	solomachine "github.com/cosmos/ibc-go/v10/modules/light-clients/06-solomachine"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+func (suite *SoloMachineTestSuite) TestNewWeightedMultisigPubKey() {
+	var (
+		threshold uint64
+		pubKeys   []cryptotypes.PubKey
+		weights   []uint64
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: threshold equals the total weight",
+			func() {
+				threshold = 8
+			},
+			nil,
+		},
+		{
+			"success: single public key",
+			func() {
+				pubKeys = pubKeys[:1]
+				weights = weights[:1]
+				threshold = 1
+			},
+			nil,
+		},
+		{
+			"number of public keys and weights do not match",
+			func() {
+				weights = weights[1:]
+			},
+			solomachine.ErrInvalidPublicKey,
+		},
+		{
+			"zero threshold",
+			func() {
+				threshold = 0
+			},
+			solomachine.ErrInvalidPublicKey,
+		},
+		{
+			"empty public keys",
+			func() {
+				pubKeys = nil
+				weights = nil
+			},
+			solomachine.ErrInvalidPublicKey,
+		},
+		{
+			"zero weight",
+			func() {
+				weights[2] = 0
+			},
+			solomachine.ErrInvalidPublicKey,
+		},
+		{
+			"duplicate public key",
+			func() {
+				pubKeys[3] = pubKeys[0]
+			},
+			solomachine.ErrInvalidPublicKey,
+		},
+		{
+			"threshold is greater than the total weight",
+			func() {
+				threshold = 9
+			},
+			solomachine.ErrInvalidPublicKey,
+		},
+		{
+			"total weight overflows",
+			func() {
+				weights[0] = ^uint64(0)
+			},
+			solomachine.ErrInvalidPublicKey,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			threshold = 5
+			pubKeys, weights = suite.mixedCommittee()
+
+			tc.malleate()
+
+			pubKey, err := solomachine.NewWeightedMultisigPubKey(threshold, pubKeys, weights)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint(threshold), pubKey.GetThreshold())
+				suite.Require().Len(pubKey.GetPubKeys(), len(pubKeys))
+				suite.Require().Equal(solomachine.WeightedMultisigPubKeyType, pubKey.Type())
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *SoloMachineTestSuite) TestWeightedMultisigVerifyMultisignature() {
+	signBytes := []byte("sign bytes")
+
+	// the committee consists of two secp256k1 keys, an ed25519 key and a 2-of-2 multisig with
+	// weights 1, 2, 3 and 2, and a threshold of 5
+	secpKeyOne, secpKeyTwo := secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+	edKey := ed25519.GenPrivKey()
+	nestedKeys := []cryptotypes.PrivKey{secp256k1.GenPrivKey(), ed25519.GenPrivKey()}
+	nestedPubKey := kmultisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{nestedKeys[0].PubKey(), nestedKeys[1].PubKey()})
+
+	pubKey, err := solomachine.NewWeightedMultisigPubKey(
+		5,
+		[]cryptotypes.PubKey{secpKeyOne.PubKey(), secpKeyTwo.PubKey(), edKey.PubKey(), nestedPubKey},
+		[]uint64{1, 2, 3, 2},
+	)
+	suite.Require().NoError(err)
+
+	sign := func(key cryptotypes.PrivKey) signing.SignatureData {
+		sig, err := key.Sign(signBytes)
+		suite.Require().NoError(err)
+		return &signing.SingleSignatureData{Signature: sig}
+	}
+
+	nestedSig := multisig.NewMultisig(2)
+	multisig.AddSignature(nestedSig, sign(nestedKeys[0]), 0)
+	multisig.AddSignature(nestedSig, sign(nestedKeys[1]), 1)
+
+	var signatures map[int]signing.SignatureData
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"all members sign",
+			func() {},
+			true,
+		},
+		{
+			"signers meet the threshold exactly",
+			func() {
+				delete(signatures, 0)
+				delete(signatures, 3)
+			},
+			true,
+		},
+		{
+			"signers meet the threshold with a nested multisig",
+			func() {
+				delete(signatures, 1)
+			},
+			true,
+		},
+		{
+			"signers below the threshold",
+			func() {
+				delete(signatures, 2)
+				delete(signatures, 3)
+			},
+			false,
+		},
+		{
+			"a single member with the highest weight cannot sign alone",
+			func() {
+				signatures = map[int]signing.SignatureData{2: signatures[2]}
+			},
+			false,
+		},
+		{
+			"invalid signature",
+			func() {
+				signatures[1] = sign(secpKeyOne)
+			},
+			false,
+		},
+		{
+			"single signature for nested multisig public key",
+			func() {
+				signatures[3] = sign(nestedKeys[0])
+			},
+			false,
+		},
+		{
+			"multi signature for single public key",
+			func() {
+				signatures[2] = nestedSig
+			},
+			false,
+		},
+		{
+			"nested multisig below its own threshold",
+			func() {
+				partialSig := multisig.NewMultisig(2)
+				multisig.AddSignature(partialSig, sign(nestedKeys[0]), 0)
+				signatures[3] = partialSig
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			signatures = map[int]signing.SignatureData{
+				0: sign(secpKeyOne),
+				1: sign(secpKeyTwo),
+				2: sign(edKey),
+				3: nestedSig,
+			}
+
+			tc.malleate()
+
+			sigData := multisig.NewMultisig(len(pubKey.PublicKeys))
+			for i := range pubKey.PublicKeys {
+				if sig, ok := signatures[i]; ok {
+					multisig.AddSignature(sigData, sig, i)
+				}
+			}
+
+			err := solomachine.VerifySignature(pubKey, signBytes, sigData)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, solomachine.ErrSignatureVerificationFailed)
+			}
+		})
+	}
+
+	suite.Run("bit array size does not match the number of public keys", func() {
+		sigData := multisig.NewMultisig(len(pubKey.PublicKeys) + 1)
+		multisig.AddSignature(sigData, sign(edKey), 2)
+		multisig.AddSignature(sigData, nestedSig, 3)
+
+		err := solomachine.VerifySignature(pubKey, signBytes, sigData)
+		suite.Require().ErrorIs(err, solomachine.ErrSignatureVerificationFailed)
+	})
+
+	suite.Run("single signature data for weighted public key", func() {
+		err := solomachine.VerifySignature(pubKey, signBytes, sign(edKey))
+		suite.Require().ErrorIs(err, solomachine.ErrSignatureVerificationFailed)
+		suite.Require().False(pubKey.VerifySignature(signBytes, sign(edKey).(*signing.SingleSignatureData).Signature))
+	})
+}
+
+func (suite *SoloMachineTestSuite) TestWeightedMultisigCodec() {
+	pubKeys, weights := suite.mixedCommittee()
+	pubKey, err := solomachine.NewWeightedMultisigPubKey(5, pubKeys, weights)
+	suite.Require().NoError(err)
+
+	anyPubKey, err := codectypes.NewAnyWithValue(pubKey)
+	suite.Require().NoError(err)
+
+	consensusState := &solomachine.ConsensusState{PublicKey: anyPubKey, Diversifier: "testing", Timestamp: 10}
+	bz, err := suite.chainA.Codec.MarshalInterface(consensusState)
+	suite.Require().NoError(err)
+
+	var decoded exported.ConsensusState
+	suite.Require().NoError(suite.chainA.Codec.UnmarshalInterface(bz, &decoded))
+	suite.Require().NoError(decoded.ValidateBasic())
+
+	decodedPubKey, err := decoded.(*solomachine.ConsensusState).GetPubKey()
+	suite.Require().NoError(err)
+	suite.Require().True(pubKey.Equals(decodedPubKey))
+	suite.Require().Equal(pubKey.Address(), decodedPubKey.Address())
+
+	otherPubKey, err := solomachine.NewWeightedMultisigPubKey(4, pubKeys, weights)
+	suite.Require().NoError(err)
+	suite.Require().False(pubKey.Equals(otherPubKey))
+	suite.Require().False(pubKey.Equals(pubKeys[0]))
+}
+
+func (suite *SoloMachineTestSuite) TestWeightedMultisigUpdateAndMisbehaviour() {
+	var (
+		sm       *ibctesting.Solomachine
+		clientID string
+	)
+
+	testCases := []struct {
+		name     string
+		signers  []int
+		expError bool
+	}{
+		{"signers meet the threshold", []int{1, 2}, false},
+		{"signers exceed the threshold", []int{0, 2, 3, 4}, false},
+		{"signers below the threshold", []int{0, 1, 3}, true},
+		{"no signers", []int{}, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run("update: "+tc.name, func() {
+			suite.SetupTest()
+
+			sm = ibctesting.NewWeightedSolomachine(suite.T(), suite.chainA.Codec, "06-solomachine-2", "testing", 5, []uint64{1, 2, 3, 1, 1})
+			clientID = sm.CreateClient(suite.chainA)
+
+			sequence, diversifier, privKeys := sm.Sequence, sm.Diversifier, sm.PrivateKeys
+
+			// the header rotates the key set and is signed by the signers of the previous key set
+			header := sm.CreateHeader("rotated")
+
+			dataBz, err := suite.chainA.Codec.Marshal(&solomachine.HeaderData{NewPubKey: header.NewPublicKey, NewDiversifier: header.NewDiversifier})
+			suite.Require().NoError(err)
+
+			header.Signature = suite.signWeighted(privKeys, tc.signers, &solomachine.SignBytes{
+				Sequence:    sequence,
+				Timestamp:   header.Timestamp,
+				Diversifier: diversifier,
+				Path:        []byte(solomachine.SentinelHeaderPath),
+				Data:        dataBz,
+			})
+
+			err = suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(suite.chainA.GetContext(), clientID, header)
+
+			if tc.expError {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			clientState, ok := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientState(suite.chainA.GetContext(), clientID)
+			suite.Require().True(ok)
+
+			publicKey, err := clientState.(*solomachine.ClientState).ConsensusState.GetPubKey()
+			suite.Require().NoError(err)
+			suite.Require().True(sm.PublicKey.Equals(publicKey))
+			suite.Require().Equal("rotated", clientState.(*solomachine.ClientState).ConsensusState.Diversifier)
+
+			// the rotated key set can sign further updates
+			sm.UpdateClient(suite.chainA, clientID)
+		})
+	}
+
+	for _, tc := range testCases {
+		suite.Run("misbehaviour: "+tc.name, func() {
+			suite.SetupTest()
+
+			sm = ibctesting.NewWeightedSolomachine(suite.T(), suite.chainA.Codec, "06-solomachine-2", "testing", 5, []uint64{1, 2, 3, 1, 1})
+			clientID = sm.CreateClient(suite.chainA)
+
+			// the first message is signed by the complete committee, while the conflicting message
+			// is signed by the signers of the test case
+			misbehaviour := sm.CreateMisbehaviour()
+			misbehaviour.SignatureTwo.Signature = suite.signWeighted(sm.PrivateKeys, tc.signers, &solomachine.SignBytes{
+				Sequence:    misbehaviour.Sequence,
+				Timestamp:   misbehaviour.SignatureTwo.Timestamp,
+				Diversifier: sm.Diversifier,
+				Path:        misbehaviour.SignatureTwo.Path,
+				Data:        misbehaviour.SignatureTwo.Data,
+			})
+
+			err := suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(suite.chainA.GetContext(), clientID, misbehaviour)
+
+			status := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientStatus(suite.chainA.GetContext(), clientID)
+			if tc.expError {
+				suite.Require().Error(err)
+				suite.Require().Equal(exported.Active, status)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(exported.Frozen, status)
+			}
+		})
+	}
+}
+
+// mixedCommittee returns the public keys of a committee mixing secp256k1, ed25519 and multisig
+// public keys, along with their weights which sum up to 8.
+func (suite *SoloMachineTestSuite) mixedCommittee() ([]cryptotypes.PubKey, []uint64) {
+	nestedPubKey := kmultisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{secp256k1.GenPrivKey().PubKey(), ed25519.GenPrivKey().PubKey()})
+
+	pubKeys := []cryptotypes.PubKey{
+		secp256k1.GenPrivKey().PubKey(),
+		secp256k1.GenPrivKey().PubKey(),
+		ed25519.GenPrivKey().PubKey(),
+		ed25519.GenPrivKey().PubKey(),
+		nestedPubKey,
+	}
+
+	return pubKeys, []uint64{1, 2, 3, 1, 1}
+}
+
+// signWeighted returns the marshaled multi-signature data of the given signers over the sign bytes.
+func (suite *SoloMachineTestSuite) signWeighted(privKeys []cryptotypes.PrivKey, signers []int, signBytes *solomachine.SignBytes) []byte {
+	bz, err := suite.chainA.Codec.Marshal(signBytes)
+	suite.Require().NoError(err)
+
+	sigData := multisig.NewMultisig(len(privKeys))
+	for _, i := range signers {
+		sig, err := privKeys[i].Sign(bz)
+		suite.Require().NoError(err)
+
+		multisig.AddSignature(sigData, &signing.SingleSignatureData{Signature: sig}, i)
+	}
+
+	sigBz, err := suite.chainA.Codec.Marshal(signing.SignatureDataToProto(sigData))
+	suite.Require().NoError(err)
+
+	return sigBz
+}
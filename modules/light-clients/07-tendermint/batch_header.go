@@ -0,0 +1,116 @@
+package tendermint
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+// MaxBatchHeaderLength is the maximum number of headers in a BatchHeader. Signature verification
+// is not metered by gas, so the number of headers verified in a single update is bounded.
+const MaxBatchHeaderLength = 32
+
+var (
+	_ exported.ClientMessage        = (*BatchHeader)(nil)
+	_ exported.MisbehaviourEvidence = (*BatchHeader)(nil)
+)
+
+// NewBatchHeader creates a new BatchHeader instance.
+func NewBatchHeader(headers []*Header, storeLast uint64, storeHeights []clienttypes.Height) *BatchHeader {
+	return &BatchHeader{
+		Headers:      headers,
+		StoreLast:    storeLast,
+		StoreHeights: storeHeights,
+	}
+}
+
+// ClientType defines that the BatchHeader is a Tendermint consensus algorithm
+func (BatchHeader) ClientType() string {
+	return exported.Tendermint
+}
+
+// ConflictingHeaders returns the heights, block hashes and times of the headers of the batch. A batch
+// constitutes misbehaviour if any of its headers conflicts with a consensus state already stored for the
+// client, the conflicting header is therefore one of the returned headers.
+func (bh BatchHeader) ConflictingHeaders() ([]exported.Height, [][]byte, []uint64) {
+	heights := make([]exported.Height, len(bh.Headers))
+	hashes := make([][]byte, len(bh.Headers))
+	timestamps := make([]uint64, len(bh.Headers))
+	for i, header := range bh.Headers {
+		heights[i] = header.GetHeight()
+		hashes[i] = header.Commit.BlockID.Hash
+		timestamps[i] = uint64(header.GetTime().UnixNano())
+	}
+
+	return heights, hashes, timestamps
+}
+
+// ValidateBasic ensures that the batch is non-empty, that every header is valid and trusts the
+// previous header of the batch, and that the requested consensus states refer to headers of the batch.
+func (bh BatchHeader) ValidateBasic() error {
+	if len(bh.Headers) == 0 {
+		return errorsmod.Wrap(clienttypes.ErrInvalidHeader, "batch header cannot be empty")
+	}
+	if len(bh.Headers) > MaxBatchHeaderLength {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidHeader, "batch header cannot contain more than %d headers, got %d", MaxBatchHeaderLength, len(bh.Headers))
+	}
+
+	heights := make(map[clienttypes.Height]struct{}, len(bh.Headers))
+	for i, header := range bh.Headers {
+		if header == nil {
+			return errorsmod.Wrapf(clienttypes.ErrInvalidHeader, "header at index %d cannot be nil", i)
+		}
+		if err := header.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "invalid header at index %d", i)
+		}
+
+		if i > 0 {
+			// every header must be verified against the previous header of the batch
+			previousHeight := bh.Headers[i-1].GetHeight()
+			if !header.TrustedHeight.EQ(previousHeight) {
+				return errorsmod.Wrapf(ErrInvalidHeaderHeight, "trusted height of header at index %d must equal the height of the previous header (%s != %s)", i, header.TrustedHeight, previousHeight)
+			}
+		}
+
+		heights[header.GetHeight().(clienttypes.Height)] = struct{}{}
+	}
+
+	if bh.StoreLast > uint64(len(bh.Headers)) {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidHeader, "number of stored trailing headers %d cannot exceed the number of headers %d", bh.StoreLast, len(bh.Headers))
+	}
+
+	seen := make(map[clienttypes.Height]struct{}, len(bh.StoreHeights))
+	for _, height := range bh.StoreHeights {
+		if _, found := heights[height]; !found {
+			return errorsmod.Wrapf(ErrInvalidHeaderHeight, "stored height %s does not match the height of a header in the batch", height)
+		}
+		if _, found := seen[height]; found {
+			return errorsmod.Wrapf(ErrInvalidHeaderHeight, "duplicate stored height %s", height)
+		}
+		seen[height] = struct{}{}
+	}
+
+	return nil
+}
+
+// headersToStore returns the headers of the batch whose consensus states are stored, in the
+// order of the batch. The last header is always stored.
+func (bh BatchHeader) headersToStore() []*Header {
+	storeHeights := make(map[clienttypes.Height]struct{}, len(bh.StoreHeights))
+	for _, height := range bh.StoreHeights {
+		storeHeights[height] = struct{}{}
+	}
+
+	storeLast := max(bh.StoreLast, 1)
+
+	var headers []*Header
+	for i, header := range bh.Headers {
+		_, requested := storeHeights[header.GetHeight().(clienttypes.Height)]
+		if requested || uint64(len(bh.Headers)-i) <= storeLast {
+			headers = append(headers, header)
+		}
+	}
+
+	return headers
+}
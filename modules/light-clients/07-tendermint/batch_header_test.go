@@ -0,0 +1,393 @@
+package tendermint_test
+
+import (
+	"errors"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+func (suite *TendermintTestSuite) TestBatchHeaderValidateBasic() {
+	var batchHeader *ibctm.BatchHeader
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: store trailing headers and explicit heights",
+			func() {
+				batchHeader.StoreLast = 3
+				batchHeader.StoreHeights = []clienttypes.Height{batchHeader.Headers[0].GetHeight().(clienttypes.Height)}
+			},
+			nil,
+		},
+		{
+			"empty batch",
+			func() {
+				batchHeader.Headers = nil
+			},
+			errors.New("batch header cannot be empty"),
+		},
+		{
+			"too many headers",
+			func() {
+				for len(batchHeader.Headers) <= ibctm.MaxBatchHeaderLength {
+					batchHeader.Headers = append(batchHeader.Headers, batchHeader.Headers[0])
+				}
+			},
+			errors.New("batch header cannot contain more than"),
+		},
+		{
+			"nil header",
+			func() {
+				batchHeader.Headers[1] = nil
+			},
+			errors.New("header at index 1 cannot be nil"),
+		},
+		{
+			"invalid header",
+			func() {
+				batchHeader.Headers[2].ValidatorSet = nil
+			},
+			errors.New("invalid header at index 2"),
+		},
+		{
+			"header does not trust the previous header",
+			func() {
+				batchHeader.Headers[2].TrustedHeight = batchHeader.Headers[0].GetHeight().(clienttypes.Height)
+			},
+			ibctm.ErrInvalidHeaderHeight,
+		},
+		{
+			"number of stored trailing headers exceeds the number of headers",
+			func() {
+				batchHeader.StoreLast = 4
+			},
+			clienttypes.ErrInvalidHeader,
+		},
+		{
+			"stored height does not match a header",
+			func() {
+				batchHeader.StoreHeights = []clienttypes.Height{batchHeader.Headers[0].TrustedHeight}
+			},
+			ibctm.ErrInvalidHeaderHeight,
+		},
+		{
+			"duplicate stored height",
+			func() {
+				height := batchHeader.Headers[1].GetHeight().(clienttypes.Height)
+				batchHeader.StoreHeights = []clienttypes.Height{height, height}
+			},
+			ibctm.ErrInvalidHeaderHeight,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			batchHeader = ibctm.NewBatchHeader(suite.createBatchHeaders(path, 3, 1), 0, nil)
+			suite.Require().Equal(exported.Tendermint, batchHeader.ClientType())
+
+			tc.malleate()
+
+			err := batchHeader.ValidateBasic()
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorContains(err, tc.expErr.Error())
+			}
+		})
+	}
+}
+
+func (suite *TendermintTestSuite) TestVerifyBatchHeader() {
+	var (
+		path        *ibctesting.Path
+		batchHeader *ibctm.BatchHeader
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: single header",
+			func() {
+				batchHeader.Headers = batchHeader.Headers[:1]
+			},
+			nil,
+		},
+		{
+			"trusted consensus state of the first header not found",
+			func() {
+				batchHeader.Headers[0].TrustedHeight = batchHeader.Headers[0].TrustedHeight.Increment().(clienttypes.Height)
+			},
+			clienttypes.ErrConsensusStateNotFound,
+		},
+		{
+			"header does not trust the previous header",
+			func() {
+				batchHeader.Headers[2].TrustedHeight = batchHeader.Headers[0].GetHeight().(clienttypes.Height)
+			},
+			ibctm.ErrInvalidHeaderHeight,
+		},
+		{
+			"trusted validators do not match the next validators of the previous header",
+			func() {
+				altPrivVal := cmttypes.NewMockPV()
+				altPubKey, err := altPrivVal.GetPubKey()
+				suite.Require().NoError(err)
+
+				altValSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(altPubKey, 100)})
+				altTrustedVals, err := altValSet.ToProto()
+				suite.Require().NoError(err)
+
+				batchHeader.Headers[1].TrustedValidators = altTrustedVals
+			},
+			ibctm.ErrInvalidValidatorSet,
+		},
+		{
+			"header is not signed by the trusted validators",
+			func() {
+				altPrivVal := cmttypes.NewMockPV()
+				altPubKey, err := altPrivVal.GetPubKey()
+				suite.Require().NoError(err)
+
+				altVal := cmttypes.NewValidator(altPubKey, 100)
+				altValSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{altVal})
+
+				header := batchHeader.Headers[2]
+				batchHeader.Headers[2] = suite.chainB.CreateTMClientHeader(
+					suite.chainB.ChainID, header.Header.Height, header.TrustedHeight, header.Header.Time,
+					altValSet, altValSet, suite.chainB.Vals, getAltSigners(altVal, altPrivVal),
+				)
+			},
+			errors.New("failed to verify header at index 2"),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			// skip blocks between the headers so that skipping verification is used
+			batchHeader = ibctm.NewBatchHeader(suite.createBatchHeaders(path, 3, 3), 0, nil)
+
+			lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), path.EndpointA.ClientID)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			err = lightClientModule.VerifyClientMessage(suite.chainA.GetContext(), path.EndpointA.ClientID, batchHeader)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorContains(err, tc.expErr.Error())
+			}
+		})
+	}
+}
+
+func (suite *TendermintTestSuite) TestUpdateClientWithBatchHeader() {
+	var (
+		path        *ibctesting.Path
+		batchHeader *ibctm.BatchHeader
+		expStored   []int
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"only the last header is stored by default",
+			func() {
+				expStored = []int{3}
+			},
+		},
+		{
+			"trailing headers are stored",
+			func() {
+				batchHeader.StoreLast = 2
+				expStored = []int{2, 3}
+			},
+		},
+		{
+			"explicit heights are stored",
+			func() {
+				batchHeader.StoreHeights = []clienttypes.Height{
+					batchHeader.Headers[1].GetHeight().(clienttypes.Height),
+					batchHeader.Headers[0].GetHeight().(clienttypes.Height),
+				}
+				expStored = []int{0, 1, 3}
+			},
+		},
+		{
+			"trailing headers and explicit heights are stored",
+			func() {
+				batchHeader.StoreLast = 2
+				batchHeader.StoreHeights = []clienttypes.Height{
+					batchHeader.Headers[0].GetHeight().(clienttypes.Height),
+					batchHeader.Headers[2].GetHeight().(clienttypes.Height),
+				}
+				expStored = []int{0, 2, 3}
+			},
+		},
+		{
+			"headers already stored by the client are not updated",
+			func() {
+				err := suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(suite.chainA.GetContext(), path.EndpointA.ClientID, ibctm.NewBatchHeader(batchHeader.Headers[:2], 0, nil))
+				suite.Require().NoError(err)
+
+				batchHeader.StoreLast = 3
+				expStored = []int{1, 2, 3}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			batchHeader = ibctm.NewBatchHeader(suite.createBatchHeaders(path, 4, 2), 0, nil)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext().WithEventManager(sdk.NewEventManager())
+			err := batchHeader.ValidateBasic()
+			suite.Require().NoError(err)
+
+			err = suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(ctx, path.EndpointA.ClientID, batchHeader)
+			suite.Require().NoError(err)
+
+			clientState, ok := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientState(ctx, path.EndpointA.ClientID)
+			suite.Require().True(ok)
+
+			lastHeader := batchHeader.Headers[len(batchHeader.Headers)-1]
+			suite.Require().Equal(lastHeader.GetHeight(), clientState.(*ibctm.ClientState).LatestHeight)
+
+			expHeights := make([]string, len(expStored))
+			for i, header := range batchHeader.Headers {
+				consensusState, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientConsensusState(ctx, path.EndpointA.ClientID, header.GetHeight())
+
+				stored := false
+				for j, index := range expStored {
+					if index == i {
+						stored = true
+						expHeights[j] = header.GetHeight().String()
+					}
+				}
+
+				suite.Require().Equal(stored, found, "header at index %d", i)
+				if stored {
+					suite.Require().Equal(header.ConsensusState(), consensusState)
+				}
+			}
+
+			// a single update event lists all heights of the stored consensus states
+			var updateEvents []sdk.Event
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == clienttypes.EventTypeUpdateClient {
+					updateEvents = append(updateEvents, event)
+				}
+			}
+			suite.Require().Len(updateEvents, 1)
+
+			attribute, found := updateEvents[0].GetAttribute(clienttypes.AttributeKeyConsensusHeights)
+			suite.Require().True(found)
+			suite.Require().Equal(strings.Join(expHeights, ","), attribute.Value)
+		})
+	}
+}
+
+func (suite *TendermintTestSuite) TestCheckForMisbehaviourBatchHeader() {
+	suite.SetupTest()
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+
+	headers := suite.createBatchHeaders(path, 3, 1)
+
+	lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), path.EndpointA.ClientID)
+	suite.Require().NoError(err)
+
+	foundMisbehaviour := lightClientModule.CheckForMisbehaviour(suite.chainA.GetContext(), path.EndpointA.ClientID, ibctm.NewBatchHeader(headers, 0, nil))
+	suite.Require().False(foundMisbehaviour)
+
+	// store a conflicting consensus state at the height of an intermediate header
+	conflictingConsensusState := headers[1].ConsensusState()
+	conflictingConsensusState.NextValidatorsHash = suite.chainB.Vals.Hash()[:31]
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientConsensusState(suite.chainA.GetContext(), path.EndpointA.ClientID, headers[1].GetHeight(), conflictingConsensusState)
+
+	foundMisbehaviour = lightClientModule.CheckForMisbehaviour(suite.chainA.GetContext(), path.EndpointA.ClientID, ibctm.NewBatchHeader(headers, 0, nil))
+	suite.Require().True(foundMisbehaviour)
+
+	// the misbehaviour evidence records the headers of the batch, including the conflicting header
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+	submitter := suite.chainA.SenderAccount.GetAddress().String()
+	err = clientKeeper.UpdateClientWithSubmitter(suite.chainA.GetContext(), path.EndpointA.ClientID, ibctm.NewBatchHeader(headers, 0, nil), submitter)
+	suite.Require().NoError(err)
+	suite.Require().Equal(exported.Frozen, clientKeeper.GetClientStatus(suite.chainA.GetContext(), path.EndpointA.ClientID))
+
+	evidence, found := clientKeeper.GetClientMisbehaviour(suite.chainA.GetContext(), path.EndpointA.ClientID, 0)
+	suite.Require().True(found)
+	suite.Require().Equal(submitter, evidence.Submitter)
+	suite.Require().Len(evidence.Heights, len(headers))
+	for i, header := range headers {
+		suite.Require().Equal(header.GetHeight(), evidence.Heights[i])
+		suite.Require().Equal(header.Commit.BlockID.Hash, evidence.HeaderHashes[i])
+	}
+}
+
+// createBatchHeaders returns n headers of chainB, each committed skip blocks after the previous
+// header. The first header trusts the latest height of the client on chainA and every following
+// header trusts the previous header.
+func (suite *TendermintTestSuite) createBatchHeaders(path *ibctesting.Path, n, skip int) []*ibctm.Header {
+	trustedHeight, ok := path.EndpointA.GetClientLatestHeight().(clienttypes.Height)
+	suite.Require().True(ok)
+
+	headers := make([]*ibctm.Header, n)
+	for i := range headers {
+		suite.coordinator.CommitNBlocks(suite.chainB, uint64(skip))
+
+		header, err := suite.chainB.IBCClientHeader(suite.chainB.LatestCommittedHeader, trustedHeight)
+		suite.Require().NoError(err)
+
+		headers[i] = header
+		trustedHeight = header.GetHeight().(clienttypes.Height)
+	}
+
+	return headers
+}
@@ -21,6 +21,10 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*exported.ClientMessage)(nil),
 		&Header{},
 	)
+	registry.RegisterImplementations(
+		(*exported.ClientMessage)(nil),
+		&BatchHeader{},
+	)
 	registry.RegisterImplementations(
 		(*exported.ClientMessage)(nil),
 		&Misbehaviour{},
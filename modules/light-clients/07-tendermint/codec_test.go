@@ -33,6 +33,11 @@ func TestCodecTypeRegistration(t *testing.T) {
 			sdk.MsgTypeURL(&tendermint.Header{}),
 			nil,
 		},
+		{
+			"success: BatchHeader",
+			sdk.MsgTypeURL(&tendermint.BatchHeader{}),
+			nil,
+		},
 		{
 			"success: Misbehaviour",
 			sdk.MsgTypeURL(&tendermint.Misbehaviour{}),
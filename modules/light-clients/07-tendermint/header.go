@@ -13,7 +13,10 @@ import (
 	"github.com/cosmos/ibc-go/v10/modules/core/exported"
 )
 
-var _ exported.ClientMessage = (*Header)(nil)
+var (
+	_ exported.ClientMessage        = (*Header)(nil)
+	_ exported.MisbehaviourEvidence = (*Header)(nil)
+)
 
 // ConsensusState returns the updated consensus state associated with the header
 func (h Header) ConsensusState() *ConsensusState {
@@ -37,6 +40,12 @@ func (h Header) GetHeight() exported.Height {
 	return clienttypes.NewHeight(revision, uint64(h.Header.Height))
 }
 
+// ConflictingHeaders returns the height and time of the header along with the hash of the block it commits to.
+// A single header constitutes misbehaviour if it conflicts with a consensus state already stored for the client.
+func (h Header) ConflictingHeaders() ([]exported.Height, [][]byte, []uint64) {
+	return []exported.Height{h.GetHeight()}, [][]byte{h.Commit.BlockID.Hash}, []uint64{uint64(h.GetTime().UnixNano())}
+}
+
 // GetTime returns the current block timestamp. It returns a zero time if
 // the tendermint header is nil.
 // NOTE: the header.Header is checked to be non nil in ValidateBasic.
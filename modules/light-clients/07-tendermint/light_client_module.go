@@ -2,6 +2,7 @@ package tendermint
 
 import (
 	"fmt"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -13,7 +14,12 @@ import (
 	"github.com/cosmos/ibc-go/v10/modules/core/exported"
 )
 
-var _ exported.LightClientModule = (*LightClientModule)(nil)
+var (
+	_ exported.LightClientModule    = (*LightClientModule)(nil)
+	_ exported.ConsensusStatePruner = (*LightClientModule)(nil)
+	_ exported.ClientFreezer        = (*LightClientModule)(nil)
+	_ exported.MultiProofVerifier   = (*LightClientModule)(nil)
+)
 
 // LightClientModule implements the core IBC api.LightClientModule interface.
 type LightClientModule struct {
@@ -138,6 +144,26 @@ func (l LightClientModule) VerifyNonMembership(
 	return clientState.verifyNonMembership(ctx, clientStore, l.cdc, height, delayTimePeriod, delayBlockPeriod, proof, path)
 }
 
+// VerifyMultiProof obtains the client state associated with the client identifier and calls into the clientState.verifyMultiProof method.
+func (l LightClientModule) VerifyMultiProof(
+	ctx sdk.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	paths []exported.Path,
+	values [][]byte,
+) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	return clientState.verifyMultiProof(ctx, clientStore, l.cdc, height, delayTimePeriod, delayBlockPeriod, proof, paths, values)
+}
+
 // Status obtains the client state associated with the client identifier and calls into the clientState.status method.
 func (l LightClientModule) Status(ctx sdk.Context, clientID string) exported.Status {
 	clientStore := l.storeProvider.ClientStore(ctx, clientID)
@@ -238,3 +264,46 @@ func (l LightClientModule) VerifyUpgradeAndUpdateState(
 
 	return clientState.VerifyUpgradeAndUpdateState(ctx, l.cdc, clientStore, &newClientState, &newConsensusState, upgradeClientProof, upgradeConsensusStateProof)
 }
+
+// PruneConsensusStates prunes at most limit consensus states of the client, along with their metadata, which fall
+// outside of the given retention limits. The number of pruned consensus states is returned.
+func (l LightClientModule) PruneConsensusStates(ctx sdk.Context, clientID string, maxConsensusStates uint64, maxAge time.Duration, limit uint64) (uint64, error) {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	if _, found := getClientState(clientStore, l.cdc); !found {
+		return 0, errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	return pruneConsensusStates(ctx, clientStore, l.cdc, maxConsensusStates, maxAge, limit), nil
+}
+
+// FreezeClient freezes the client by setting its frozen height.
+func (l LightClientModule) FreezeClient(ctx sdk.Context, clientID string) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	clientState.FrozenHeight = FrozenHeight
+	setClientState(clientStore, l.cdc, clientState)
+
+	return nil
+}
+
+// UnfreezeClient unfreezes a frozen client by resetting its frozen height.
+func (l LightClientModule) UnfreezeClient(ctx sdk.Context, clientID string) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	clientState, found := getClientState(clientStore, l.cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	if clientState.FrozenHeight.IsZero() {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFrozen, clientID)
+	}
+
+	clientState.FrozenHeight = clienttypes.ZeroHeight()
+	setClientState(clientStore, l.cdc, clientState)
+
+	return nil
+}
@@ -1185,3 +1185,270 @@ func (suite *TendermintTestSuite) TestVerifyUpgradeAndUpdateState() {
 		})
 	}
 }
+
+func (suite *TendermintTestSuite) TestPruneConsensusStates() {
+	var (
+		path               *ibctesting.Path
+		heights            []exported.Height
+		maxConsensusStates uint64
+		maxAge             time.Duration
+		limit              uint64
+	)
+
+	// ageOf returns the age of the consensus state at the given height relative to the block time
+	ageOf := func(height exported.Height) time.Duration {
+		consensusState, ok := path.EndpointA.GetConsensusState(height).(*ibctm.ConsensusState)
+		suite.Require().True(ok)
+
+		return suite.chainA.GetContext().BlockTime().Sub(consensusState.Timestamp)
+	}
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expPruned int
+		expErr    error
+	}{
+		{
+			"success: no limits",
+			func() {},
+			0,
+			nil,
+		},
+		{
+			"success: max consensus states",
+			func() {
+				maxConsensusStates = 2
+			},
+			3,
+			nil,
+		},
+		{
+			"success: max consensus states exceeding the number of consensus states",
+			func() {
+				maxConsensusStates = 10
+			},
+			0,
+			nil,
+		},
+		{
+			"success: limit is reached",
+			func() {
+				maxConsensusStates = 1
+				limit = 2
+			},
+			2,
+			nil,
+		},
+		{
+			"success: max age",
+			func() {
+				maxAge = ageOf(heights[1])
+			},
+			1,
+			nil,
+		},
+		{
+			"success: consensus state at the latest height is retained",
+			func() {
+				maxAge = time.Nanosecond
+			},
+			4,
+			nil,
+		},
+		{
+			"success: max consensus states and max age",
+			func() {
+				maxConsensusStates = 4
+				maxAge = ageOf(heights[2])
+			},
+			2,
+			nil,
+		},
+		{
+			"failure: client state not found",
+			func() {
+				maxConsensusStates = 1
+
+				store := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+				store.Delete(host.ClientStateKey())
+			},
+			0,
+			clienttypes.ErrClientNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			heights = []exported.Height{path.EndpointA.GetClientLatestHeight()}
+			for range 4 {
+				suite.coordinator.IncrementTimeBy(time.Hour)
+
+				err := path.EndpointA.UpdateClient()
+				suite.Require().NoError(err)
+
+				heights = append(heights, path.EndpointA.GetClientLatestHeight())
+			}
+
+			maxConsensusStates, maxAge, limit = 0, 0, 100
+
+			lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), path.EndpointA.ClientID)
+			suite.Require().NoError(err)
+
+			pruner, ok := lightClientModule.(exported.ConsensusStatePruner)
+			suite.Require().True(ok)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			pruned, err := pruner.PruneConsensusStates(ctx, path.EndpointA.ClientID, maxConsensusStates, maxAge, limit)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(tc.expPruned), pruned)
+
+				// the consensus states at the lowest heights are pruned along with their metadata
+				clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, path.EndpointA.ClientID)
+				for i, height := range heights {
+					_, found := ibctm.GetConsensusState(clientStore, suite.chainA.Codec, height)
+					suite.Require().Equal(i >= tc.expPruned, found, "consensus state at height %s", height)
+
+					_, found = ibctm.GetProcessedTime(clientStore, height)
+					suite.Require().Equal(i >= tc.expPruned, found, "processed time at height %s", height)
+
+					suite.Require().Equal(i >= tc.expPruned, ibctm.GetIterationKey(clientStore, height) != nil, "iteration key at height %s", height)
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *TendermintTestSuite) TestFreezeClient() {
+	var clientID string
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: cannot find client state",
+			func() {
+				clientID = tmClientID
+			},
+			clienttypes.ErrClientNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+			clientID = path.EndpointA.ClientID
+
+			lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), clientID)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			freezer, ok := lightClientModule.(exported.ClientFreezer)
+			suite.Require().True(ok)
+
+			err = freezer.FreezeClient(suite.chainA.GetContext(), clientID)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+				suite.Require().Equal(ibctm.FrozenHeight, clientState.FrozenHeight)
+				suite.Require().Equal(exported.Frozen, lightClientModule.Status(suite.chainA.GetContext(), clientID))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *TendermintTestSuite) TestUnfreezeClient() {
+	var clientID string
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: cannot find client state",
+			func() {
+				clientID = tmClientID
+			},
+			clienttypes.ErrClientNotFound,
+		},
+		{
+			"failure: client is not frozen",
+			func() {
+				clientState, ok := suite.chainA.GetClientState(clientID).(*ibctm.ClientState)
+				suite.Require().True(ok)
+				clientState.FrozenHeight = clienttypes.ZeroHeight()
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), clientID, clientState)
+			},
+			clienttypes.ErrClientNotFrozen,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+			clientID = path.EndpointA.ClientID
+
+			clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+			suite.Require().True(ok)
+			clientState.FrozenHeight = clientState.LatestHeight
+			path.EndpointA.SetClientState(clientState)
+
+			lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), clientID)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			freezer, ok := lightClientModule.(exported.ClientFreezer)
+			suite.Require().True(ok)
+
+			err = freezer.UnfreezeClient(suite.chainA.GetContext(), clientID)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+				suite.Require().True(clientState.FrozenHeight.IsZero())
+				suite.Require().Equal(exported.Active, lightClientModule.Status(suite.chainA.GetContext(), clientID))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
@@ -13,7 +13,10 @@ import (
 	"github.com/cosmos/ibc-go/v10/modules/core/exported"
 )
 
-var _ exported.ClientMessage = (*Misbehaviour)(nil)
+var (
+	_ exported.ClientMessage        = (*Misbehaviour)(nil)
+	_ exported.MisbehaviourEvidence = (*Misbehaviour)(nil)
+)
 
 // FrozenHeight is same for all misbehaviour
 var FrozenHeight = clienttypes.NewHeight(0, 1)
@@ -43,6 +46,13 @@ func (misbehaviour Misbehaviour) GetTime() time.Time {
 	return t2
 }
 
+// ConflictingHeaders returns the heights, block hashes and times of both headers of the misbehaviour.
+func (misbehaviour Misbehaviour) ConflictingHeaders() ([]exported.Height, [][]byte, []uint64) {
+	return []exported.Height{misbehaviour.Header1.GetHeight(), misbehaviour.Header2.GetHeight()},
+		[][]byte{misbehaviour.Header1.Commit.BlockID.Hash, misbehaviour.Header2.Commit.BlockID.Hash},
+		[]uint64{uint64(misbehaviour.Header1.GetTime().UnixNano()), uint64(misbehaviour.Header2.GetTime().UnixNano())}
+}
+
 // ValidateBasic implements Misbehaviour interface
 func (misbehaviour Misbehaviour) ValidateBasic() error {
 	if misbehaviour.Header1 == nil {
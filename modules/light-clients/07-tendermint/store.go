@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
@@ -217,6 +218,20 @@ func IterateConsensusStateAscending(clientStore storetypes.KVStore, cb func(heig
 	}
 }
 
+// iterateConsensusStateDescending iterates through the consensus states in descending order. It calls the provided
+// callback on each height, until stop=true is returned.
+func iterateConsensusStateDescending(clientStore storetypes.KVStore, cb func(height exported.Height) (stop bool)) {
+	iterator := storetypes.KVStoreReversePrefixIterator(clientStore, []byte(KeyIterateConsensusStatePrefix))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		height := GetHeightFromIterationKey(iterator.Key())
+		if cb(height) {
+			break
+		}
+	}
+}
+
 // GetNextConsensusState returns the lowest consensus state that is larger than the given height.
 // The Iterator returns a storetypes.Iterator which iterates from start (inclusive) to end (exclusive).
 // If the starting height exists in store, we need to call iterator.Next() to get the next consensus state.
@@ -291,6 +306,77 @@ func PruneAllExpiredConsensusStates(
 	return len(heights)
 }
 
+// pruneConsensusStates deletes at most limit consensus states, along with their metadata, which fall outside
+// of the given retention limits. Consensus states beyond the most recent maxConsensusStates are pruned first,
+// followed by consensus states whose timestamp is older than maxAge relative to the block time. A zero value
+// disables the corresponding limit. The consensus state at the latest height is never pruned. The number of
+// consensus states pruned is returned.
+func pruneConsensusStates(
+	ctx sdk.Context, clientStore storetypes.KVStore, cdc codec.BinaryCodec,
+	maxConsensusStates uint64, maxAge time.Duration, limit uint64,
+) uint64 {
+	// the consensus states are iterated in descending order only until the most recent consensus state in excess
+	// of maxConsensusStates is found, so that the iteration is bounded by the retention limit rather than by the
+	// number of consensus states stored. Every consensus state up to and including it is in excess.
+	var (
+		latestHeight exported.Height
+		excessHeight exported.Height
+		count        uint64
+	)
+	iterateConsensusStateDescending(clientStore, func(height exported.Height) bool {
+		if count == 0 {
+			latestHeight = height
+		}
+		count++
+
+		if maxConsensusStates == 0 {
+			return true
+		}
+
+		if count > maxConsensusStates {
+			excessHeight = height
+			return true
+		}
+
+		return false
+	})
+
+	if latestHeight == nil {
+		return 0
+	}
+
+	var heights []exported.Height
+	pruneCb := func(height exported.Height) bool {
+		if uint64(len(heights)) == limit || height.EQ(latestHeight) {
+			return true
+		}
+
+		if excessHeight == nil || height.GT(excessHeight) {
+			if maxAge == 0 {
+				return true
+			}
+
+			// consensus states are iterated in ascending order, so all remaining consensus states are more recent
+			consState, found := GetConsensusState(clientStore, cdc, height)
+			if !found || !consState.Timestamp.Add(maxAge).Before(ctx.BlockTime()) {
+				return true
+			}
+		}
+
+		heights = append(heights, height)
+		return false
+	}
+
+	IterateConsensusStateAscending(clientStore, pruneCb)
+
+	for _, height := range heights {
+		deleteConsensusState(clientStore, height)
+		deleteConsensusMetadata(clientStore, height)
+	}
+
+	return uint64(len(heights))
+}
+
 // Helper function for GetNextConsensusState and GetPreviousConsensusState
 func getTmConsensusState(clientStore storetypes.KVStore, cdc codec.BinaryCodec, key []byte) (*ConsensusState, bool) {
 	bz := clientStore.Get(key)
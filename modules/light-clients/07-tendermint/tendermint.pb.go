@@ -256,6 +256,78 @@ func (m *Header) GetTrustedValidators() *types2.ValidatorSet {
 	return nil
 }
 
+// BatchHeader defines an ordered list of headers which are verified sequentially
+// within a single client update. The first header is verified against a consensus
+// state stored by the client and every following header is verified against the
+// previous header of the batch using the same skipping verification rules, so it
+// must set its TrustedHeight to the height of the previous header and its
+// TrustedValidators to the next validator set of the previous header.
+//
+// The consensus state of the last header is always stored. The consensus states
+// of other headers are only stored if they are among the last store_last headers
+// or their heights are listed in store_heights.
+type BatchHeader struct {
+	Headers []*Header `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty"`
+	// number of trailing headers whose consensus states are stored
+	StoreLast uint64 `protobuf:"varint,2,opt,name=store_last,json=storeLast,proto3" json:"store_last,omitempty"`
+	// heights of the headers whose consensus states are stored
+	StoreHeights []types.Height `protobuf:"bytes,3,rep,name=store_heights,json=storeHeights,proto3" json:"store_heights"`
+}
+
+func (m *BatchHeader) Reset()         { *m = BatchHeader{} }
+func (m *BatchHeader) String() string { return proto.CompactTextString(m) }
+func (*BatchHeader) ProtoMessage()    {}
+func (*BatchHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6d6cf2b288949be, []int{4}
+}
+func (m *BatchHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchHeader.Merge(m, src)
+}
+func (m *BatchHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchHeader proto.InternalMessageInfo
+
+func (m *BatchHeader) GetHeaders() []*Header {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *BatchHeader) GetStoreLast() uint64 {
+	if m != nil {
+		return m.StoreLast
+	}
+	return 0
+}
+
+func (m *BatchHeader) GetStoreHeights() []types.Height {
+	if m != nil {
+		return m.StoreHeights
+	}
+	return nil
+}
+
 // Fraction defines the protobuf message type for tmmath.Fraction that only
 // supports positive values.
 type Fraction struct {
@@ -267,7 +339,7 @@ func (m *Fraction) Reset()         { *m = Fraction{} }
 func (m *Fraction) String() string { return proto.CompactTextString(m) }
 func (*Fraction) ProtoMessage()    {}
 func (*Fraction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6d6cf2b288949be, []int{4}
+	return fileDescriptor_c6d6cf2b288949be, []int{5}
 }
 func (m *Fraction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -315,6 +387,7 @@ func init() {
 	proto.RegisterType((*ConsensusState)(nil), "ibc.lightclients.tendermint.v1.ConsensusState")
 	proto.RegisterType((*Misbehaviour)(nil), "ibc.lightclients.tendermint.v1.Misbehaviour")
 	proto.RegisterType((*Header)(nil), "ibc.lightclients.tendermint.v1.Header")
+	proto.RegisterType((*BatchHeader)(nil), "ibc.lightclients.tendermint.v1.BatchHeader")
 	proto.RegisterType((*Fraction)(nil), "ibc.lightclients.tendermint.v1.Fraction")
 }
 
@@ -323,66 +396,70 @@ func init() {
 }
 
 var fileDescriptor_c6d6cf2b288949be = []byte{
-	// 942 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0x4d, 0x6f, 0xe3, 0x44,
-	0x18, 0xc7, 0xe3, 0x34, 0xbb, 0x4d, 0x26, 0xc9, 0x16, 0x46, 0x2b, 0xe4, 0x56, 0x55, 0x12, 0x7a,
-	0x80, 0x5c, 0x6a, 0x37, 0x59, 0x24, 0x24, 0x16, 0x0e, 0xa4, 0xbb, 0xd0, 0xb2, 0x5b, 0xa8, 0x5c,
-	0xe0, 0xc0, 0xc5, 0x1a, 0xdb, 0x13, 0x7b, 0xb4, 0xb6, 0xc7, 0x9a, 0x19, 0x87, 0x94, 0x13, 0x47,
-	0x8e, 0x7b, 0xe4, 0xc8, 0x47, 0xe0, 0x63, 0xec, 0xb1, 0x17, 0x24, 0x4e, 0x05, 0xa5, 0xdf, 0x82,
-	0x13, 0x9a, 0x17, 0x27, 0xa6, 0xac, 0xd8, 0x8a, 0x4b, 0xf4, 0xcc, 0x3c, 0xff, 0xe7, 0x97, 0x99,
-	0xe7, 0x65, 0x0c, 0x5c, 0x12, 0x84, 0x6e, 0x4a, 0xe2, 0x44, 0x84, 0x29, 0xc1, 0xb9, 0xe0, 0xae,
-	0xc0, 0x79, 0x84, 0x59, 0x46, 0x72, 0xe1, 0x2e, 0x26, 0xb5, 0x95, 0x53, 0x30, 0x2a, 0x28, 0x1c,
-	0x90, 0x20, 0x74, 0xea, 0x01, 0x4e, 0x4d, 0xb2, 0x98, 0xec, 0x8d, 0x6a, 0xf1, 0xe2, 0xb2, 0xc0,
-	0xdc, 0x5d, 0xa0, 0x94, 0x44, 0x48, 0x50, 0xa6, 0x09, 0x7b, 0xfb, 0xff, 0x52, 0xa8, 0xdf, 0xca,
-	0x1b, 0x52, 0x9e, 0x51, 0xee, 0x92, 0x90, 0x4f, 0x1f, 0xc9, 0x13, 0x14, 0x8c, 0xd2, 0x79, 0xe5,
-	0x1d, 0xc4, 0x94, 0xc6, 0x29, 0x76, 0xd5, 0x2a, 0x28, 0xe7, 0x6e, 0x54, 0x32, 0x24, 0x08, 0xcd,
-	0x8d, 0x7f, 0x78, 0xdb, 0x2f, 0x48, 0x86, 0xb9, 0x40, 0x59, 0x51, 0x09, 0xe4, 0x7d, 0x43, 0xca,
-	0xb0, 0xab, 0x8f, 0x2f, 0xff, 0x41, 0x5b, 0x46, 0xf0, 0xfe, 0x46, 0x40, 0xb3, 0x8c, 0x88, 0xac,
-	0x12, 0xad, 0x57, 0x46, 0xf8, 0x30, 0xa6, 0x31, 0x55, 0xa6, 0x2b, 0x2d, 0xbd, 0x7b, 0xb0, 0xba,
-	0x07, 0xba, 0xc7, 0x8a, 0x77, 0x21, 0x90, 0xc0, 0x70, 0x17, 0xb4, 0xc3, 0x04, 0x91, 0xdc, 0x27,
-	0x91, 0x6d, 0x8d, 0xac, 0x71, 0xc7, 0xdb, 0x56, 0xeb, 0xd3, 0x08, 0x7e, 0x05, 0xba, 0x82, 0x95,
-	0x5c, 0xf8, 0x29, 0x5e, 0xe0, 0xd4, 0x6e, 0x8e, 0xac, 0x71, 0x77, 0x3a, 0x76, 0xfe, 0x3b, 0xbf,
-	0xce, 0x67, 0x0c, 0x85, 0xf2, 0xc2, 0xb3, 0xd6, 0xab, 0xeb, 0x61, 0xc3, 0x03, 0x0a, 0xf1, 0x5c,
-	0x12, 0xe0, 0x73, 0xb0, 0xa3, 0x56, 0x24, 0x8f, 0xfd, 0x02, 0x33, 0x42, 0x23, 0x7b, 0x4b, 0x41,
-	0x77, 0x1d, 0x9d, 0x16, 0xa7, 0x4a, 0x8b, 0xf3, 0xc4, 0xa4, 0x6d, 0xd6, 0x96, 0x94, 0x9f, 0xff,
-	0x18, 0x5a, 0xde, 0x83, 0x2a, 0xf6, 0x5c, 0x85, 0xc2, 0x2f, 0xc1, 0x5b, 0x65, 0x1e, 0xd0, 0x3c,
-	0xaa, 0xe1, 0x5a, 0x77, 0xc7, 0xed, 0xac, 0x83, 0x0d, 0xef, 0x19, 0xd8, 0xc9, 0xd0, 0xd2, 0x0f,
-	0x53, 0x1a, 0xbe, 0xf0, 0x23, 0x46, 0xe6, 0xc2, 0xbe, 0x77, 0x77, 0x5c, 0x3f, 0x43, 0xcb, 0x63,
-	0x19, 0xfa, 0x44, 0x46, 0xc2, 0xa7, 0xa0, 0x3f, 0x67, 0xf4, 0x07, 0x9c, 0xfb, 0x09, 0x96, 0xb9,
-	0xb2, 0xef, 0x2b, 0xd4, 0x9e, 0xca, 0x9e, 0xac, 0x9e, 0x63, 0x8a, 0xba, 0x98, 0x38, 0x27, 0x4a,
-	0x61, 0xf2, 0xd5, 0xd3, 0x61, 0x7a, 0x4f, 0x62, 0x52, 0x24, 0x30, 0x17, 0x15, 0x66, 0xfb, 0xae,
-	0x18, 0x1d, 0x66, 0x30, 0x8f, 0x41, 0x57, 0x75, 0xa9, 0xcf, 0x0b, 0x1c, 0x72, 0xbb, 0x3d, 0xda,
-	0x52, 0x10, 0xdd, 0xc9, 0x8e, 0xea, 0x64, 0x49, 0x38, 0x97, 0x9a, 0x8b, 0x02, 0x87, 0x1e, 0x28,
-	0x2a, 0x93, 0xc3, 0x77, 0x41, 0xaf, 0x2c, 0x62, 0x86, 0x22, 0xec, 0x17, 0x48, 0x24, 0x76, 0x67,
-	0xb4, 0x35, 0xee, 0x78, 0x5d, 0xb3, 0x77, 0x8e, 0x44, 0x02, 0x3f, 0x01, 0xbb, 0x28, 0x4d, 0xe9,
-	0xf7, 0x7e, 0x59, 0x44, 0x48, 0x60, 0x1f, 0xcd, 0x05, 0x66, 0x3e, 0x5e, 0x16, 0x84, 0x5d, 0xda,
-	0x60, 0x64, 0x8d, 0xdb, 0xb3, 0xa6, 0x6d, 0x79, 0xef, 0x28, 0xd1, 0x37, 0x4a, 0xf3, 0xa9, 0x94,
-	0x3c, 0x55, 0x0a, 0x78, 0x0a, 0x86, 0xaf, 0x09, 0xcf, 0x08, 0x0f, 0x70, 0x82, 0x16, 0x84, 0x96,
-	0xcc, 0xee, 0xae, 0x21, 0xfb, 0xb7, 0x21, 0x67, 0x35, 0xdd, 0x47, 0xad, 0x9f, 0x7e, 0x19, 0x36,
-	0x0e, 0x7e, 0x6c, 0x82, 0x07, 0xc7, 0x34, 0xe7, 0x38, 0xe7, 0x25, 0xd7, 0x7d, 0x3e, 0x03, 0x9d,
-	0xf5, 0xa8, 0xa9, 0x46, 0x97, 0x09, 0xb8, 0x5d, 0xd7, 0xaf, 0x2b, 0x85, 0x2e, 0xec, 0x4b, 0x59,
-	0xd8, 0x4d, 0x18, 0xfc, 0x18, 0xb4, 0x18, 0xa5, 0xc2, 0x4c, 0xc2, 0x41, 0xad, 0x08, 0x9b, 0xd9,
-	0x5b, 0x4c, 0x9c, 0x33, 0xcc, 0x5e, 0xa4, 0xd8, 0xa3, 0xb4, 0x2a, 0x86, 0x8a, 0x82, 0x73, 0xf0,
-	0x30, 0xc7, 0x4b, 0xe1, 0xaf, 0x9f, 0x1b, 0xee, 0x27, 0x88, 0x27, 0x6a, 0x04, 0x7a, 0xb3, 0x0f,
-	0xfe, 0xba, 0x1e, 0x1e, 0xc5, 0x44, 0x24, 0x65, 0x20, 0x71, 0x72, 0x9c, 0xb1, 0x08, 0xe6, 0x62,
-	0x63, 0xa4, 0x24, 0xe0, 0x6e, 0x70, 0x29, 0x30, 0x77, 0x4e, 0xf0, 0x72, 0x26, 0x0d, 0x0f, 0x4a,
-	0xe2, 0xb7, 0x6b, 0xe0, 0x09, 0xe2, 0x89, 0x49, 0xc1, 0x6f, 0x16, 0xe8, 0xd5, 0x33, 0x03, 0x87,
-	0xa0, 0xa3, 0x7b, 0x65, 0x3d, 0xe9, 0x2a, 0x9d, 0x6d, 0xbd, 0x79, 0x2a, 0xe7, 0xa9, 0x9d, 0x60,
-	0x14, 0x61, 0xe6, 0x4f, 0xcc, 0x0d, 0xdf, 0x7b, 0xd3, 0xac, 0x9f, 0x28, 0xfd, 0xac, 0xbb, 0xba,
-	0x1e, 0x6e, 0x6b, 0x7b, 0xe2, 0x6d, 0x6b, 0xc8, 0xa4, 0xc6, 0x9b, 0x9a, 0x31, 0xff, 0x1f, 0xbc,
-	0x69, 0xc5, 0x9b, 0x9a, 0x7b, 0xfd, 0xda, 0x04, 0xf7, 0xb5, 0x0b, 0x9e, 0x82, 0x3e, 0x27, 0x71,
-	0x8e, 0x23, 0x5f, 0x4b, 0x4c, 0x59, 0x07, 0x75, 0xa8, 0x7e, 0xb9, 0x2f, 0x94, 0xcc, 0xd0, 0x5b,
-	0x57, 0xd7, 0x43, 0xcb, 0xeb, 0xf1, 0xda, 0x1e, 0x3c, 0x06, 0xfd, 0x75, 0x59, 0x7c, 0x8e, 0xab,
-	0x12, 0xbf, 0x06, 0xb5, 0x4e, 0xf6, 0x05, 0x16, 0x5e, 0x6f, 0x51, 0x5b, 0xc1, 0xcf, 0x81, 0x7e,
-	0xa2, 0xd4, 0x81, 0xd4, 0xb4, 0x6e, 0xdd, 0x71, 0x5a, 0xfb, 0x26, 0xce, 0x8c, 0xeb, 0x19, 0x80,
-	0x15, 0x68, 0xd3, 0x2c, 0xe6, 0x6d, 0x7b, 0xd3, 0x91, 0xde, 0x36, 0x91, 0x9b, 0xa6, 0x38, 0xf8,
-	0x02, 0xb4, 0xab, 0x47, 0x19, 0xee, 0x83, 0x4e, 0x5e, 0x66, 0x98, 0x49, 0x8f, 0xca, 0x57, 0xcb,
-	0xdb, 0x6c, 0xc0, 0x11, 0xe8, 0x46, 0x38, 0xa7, 0x19, 0xc9, 0x95, 0xbf, 0xa9, 0xfc, 0xf5, 0xad,
-	0x19, 0x7e, 0xb5, 0x1a, 0x58, 0x57, 0xab, 0x81, 0xf5, 0xe7, 0x6a, 0x60, 0xbd, 0xbc, 0x19, 0x34,
-	0xae, 0x6e, 0x06, 0x8d, 0xdf, 0x6f, 0x06, 0x8d, 0xef, 0x9e, 0xfd, 0xa3, 0x79, 0xf5, 0x27, 0x32,
-	0x08, 0x0f, 0x63, 0xea, 0x2e, 0x26, 0x47, 0x6e, 0x46, 0xa3, 0x32, 0xc5, 0x5c, 0x7f, 0xc9, 0x0f,
-	0xab, 0x4f, 0xf9, 0xd1, 0x87, 0x87, 0x9b, 0xdb, 0x3c, 0xde, 0x98, 0xc1, 0x7d, 0x35, 0x92, 0x8f,
-	0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x38, 0x68, 0x4c, 0x64, 0xfe, 0x07, 0x00, 0x00,
+	// 1004 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4d, 0x6f, 0xe3, 0xc4,
+	0x1f, 0xae, 0xdb, 0x6c, 0x9b, 0x4c, 0xd2, 0xed, 0xff, 0x3f, 0x5a, 0x21, 0xb7, 0x2a, 0x49, 0xe8,
+	0x01, 0x72, 0xa9, 0xdd, 0x64, 0x91, 0x90, 0x58, 0x90, 0x20, 0xdd, 0x85, 0x96, 0x6d, 0xa1, 0x72,
+	0x81, 0x03, 0x17, 0x6b, 0x6c, 0x4f, 0xec, 0xd1, 0xda, 0x1e, 0x6b, 0x66, 0x1c, 0x52, 0x4e, 0x1c,
+	0x39, 0xee, 0x91, 0x23, 0x1f, 0x81, 0x0b, 0xdf, 0x61, 0x8f, 0xbd, 0x20, 0x71, 0x2a, 0x28, 0xfd,
+	0x16, 0x9c, 0xd0, 0xbc, 0x38, 0x31, 0x65, 0x45, 0x23, 0x2e, 0xd5, 0xcc, 0x6f, 0x9e, 0xe7, 0xe9,
+	0xcc, 0xf3, 0x7b, 0x89, 0x81, 0x4b, 0x82, 0xd0, 0x4d, 0x49, 0x9c, 0x88, 0x30, 0x25, 0x38, 0x17,
+	0xdc, 0x15, 0x38, 0x8f, 0x30, 0xcb, 0x48, 0x2e, 0xdc, 0xe9, 0xb0, 0xb6, 0x73, 0x0a, 0x46, 0x05,
+	0x85, 0x5d, 0x12, 0x84, 0x4e, 0x9d, 0xe0, 0xd4, 0x20, 0xd3, 0xe1, 0x5e, 0xbf, 0xc6, 0x17, 0x57,
+	0x05, 0xe6, 0xee, 0x14, 0xa5, 0x24, 0x42, 0x82, 0x32, 0xad, 0xb0, 0xb7, 0xff, 0x0f, 0x84, 0xfa,
+	0x5b, 0x9d, 0x86, 0x94, 0x67, 0x94, 0xbb, 0x24, 0xe4, 0xa3, 0xc7, 0xf2, 0x06, 0x05, 0xa3, 0x74,
+	0x52, 0x9d, 0x76, 0x63, 0x4a, 0xe3, 0x14, 0xbb, 0x6a, 0x17, 0x94, 0x13, 0x37, 0x2a, 0x19, 0x12,
+	0x84, 0xe6, 0xe6, 0xbc, 0x77, 0xf7, 0x5c, 0x90, 0x0c, 0x73, 0x81, 0xb2, 0xa2, 0x02, 0xc8, 0xf7,
+	0x86, 0x94, 0x61, 0x57, 0x5f, 0x5f, 0xfe, 0x07, 0xbd, 0x32, 0x80, 0x77, 0x96, 0x00, 0x9a, 0x65,
+	0x44, 0x64, 0x15, 0x68, 0xb1, 0x33, 0xc0, 0x47, 0x31, 0x8d, 0xa9, 0x5a, 0xba, 0x72, 0xa5, 0xa3,
+	0x07, 0xf3, 0x07, 0xa0, 0x7d, 0xac, 0xf4, 0x2e, 0x05, 0x12, 0x18, 0xee, 0x82, 0x66, 0x98, 0x20,
+	0x92, 0xfb, 0x24, 0xb2, 0xad, 0xbe, 0x35, 0x68, 0x79, 0x5b, 0x6a, 0x7f, 0x1a, 0xc1, 0x2f, 0x40,
+	0x5b, 0xb0, 0x92, 0x0b, 0x3f, 0xc5, 0x53, 0x9c, 0xda, 0xeb, 0x7d, 0x6b, 0xd0, 0x1e, 0x0d, 0x9c,
+	0x7f, 0xf7, 0xd7, 0xf9, 0x84, 0xa1, 0x50, 0x3e, 0x78, 0xdc, 0x78, 0x75, 0xd3, 0x5b, 0xf3, 0x80,
+	0x92, 0x38, 0x93, 0x0a, 0xf0, 0x0c, 0xec, 0xa8, 0x1d, 0xc9, 0x63, 0xbf, 0xc0, 0x8c, 0xd0, 0xc8,
+	0xde, 0x50, 0xa2, 0xbb, 0x8e, 0xb6, 0xc5, 0xa9, 0x6c, 0x71, 0x9e, 0x1a, 0xdb, 0xc6, 0x4d, 0xa9,
+	0xf2, 0xe3, 0xef, 0x3d, 0xcb, 0x7b, 0x58, 0x71, 0x2f, 0x14, 0x15, 0x7e, 0x0e, 0xfe, 0x57, 0xe6,
+	0x01, 0xcd, 0xa3, 0x9a, 0x5c, 0x63, 0x75, 0xb9, 0x9d, 0x05, 0xd9, 0xe8, 0x3d, 0x07, 0x3b, 0x19,
+	0x9a, 0xf9, 0x61, 0x4a, 0xc3, 0x17, 0x7e, 0xc4, 0xc8, 0x44, 0xd8, 0x0f, 0x56, 0x97, 0xdb, 0xce,
+	0xd0, 0xec, 0x58, 0x52, 0x9f, 0x4a, 0x26, 0x7c, 0x06, 0xb6, 0x27, 0x8c, 0x7e, 0x87, 0x73, 0x3f,
+	0xc1, 0xd2, 0x2b, 0x7b, 0x53, 0x49, 0xed, 0x29, 0xf7, 0x64, 0xf6, 0x1c, 0x93, 0xd4, 0xe9, 0xd0,
+	0x39, 0x51, 0x08, 0xe3, 0x57, 0x47, 0xd3, 0x74, 0x4c, 0xca, 0xa4, 0x48, 0x60, 0x2e, 0x2a, 0x99,
+	0xad, 0x55, 0x65, 0x34, 0xcd, 0xc8, 0x3c, 0x01, 0x6d, 0x55, 0xa5, 0x3e, 0x2f, 0x70, 0xc8, 0xed,
+	0x66, 0x7f, 0x43, 0x89, 0xe8, 0x4a, 0x76, 0x54, 0x25, 0x4b, 0x85, 0x0b, 0x89, 0xb9, 0x2c, 0x70,
+	0xe8, 0x81, 0xa2, 0x5a, 0x72, 0xf8, 0x16, 0xe8, 0x94, 0x45, 0xcc, 0x50, 0x84, 0xfd, 0x02, 0x89,
+	0xc4, 0x6e, 0xf5, 0x37, 0x06, 0x2d, 0xaf, 0x6d, 0x62, 0x17, 0x48, 0x24, 0xf0, 0x43, 0xb0, 0x8b,
+	0xd2, 0x94, 0x7e, 0xeb, 0x97, 0x45, 0x84, 0x04, 0xf6, 0xd1, 0x44, 0x60, 0xe6, 0xe3, 0x59, 0x41,
+	0xd8, 0x95, 0x0d, 0xfa, 0xd6, 0xa0, 0x39, 0x5e, 0xb7, 0x2d, 0xef, 0x0d, 0x05, 0xfa, 0x4a, 0x61,
+	0x3e, 0x96, 0x90, 0x67, 0x0a, 0x01, 0x4f, 0x41, 0xef, 0x35, 0xf4, 0x8c, 0xf0, 0x00, 0x27, 0x68,
+	0x4a, 0x68, 0xc9, 0xec, 0xf6, 0x42, 0x64, 0xff, 0xae, 0xc8, 0x79, 0x0d, 0xf7, 0x7e, 0xe3, 0x87,
+	0x9f, 0x7a, 0x6b, 0x07, 0xdf, 0xaf, 0x83, 0x87, 0xc7, 0x34, 0xe7, 0x38, 0xe7, 0x25, 0xd7, 0x75,
+	0x3e, 0x06, 0xad, 0x45, 0xab, 0xa9, 0x42, 0x97, 0x06, 0xdc, 0xcd, 0xeb, 0x97, 0x15, 0x42, 0x27,
+	0xf6, 0xa5, 0x4c, 0xec, 0x92, 0x06, 0x3f, 0x00, 0x0d, 0x46, 0xa9, 0x30, 0x9d, 0x70, 0x50, 0x4b,
+	0xc2, 0xb2, 0xf7, 0xa6, 0x43, 0xe7, 0x1c, 0xb3, 0x17, 0x29, 0xf6, 0x28, 0xad, 0x92, 0xa1, 0x58,
+	0x70, 0x02, 0x1e, 0xe5, 0x78, 0x26, 0xfc, 0xc5, 0xb8, 0xe1, 0x7e, 0x82, 0x78, 0xa2, 0x5a, 0xa0,
+	0x33, 0x7e, 0xf7, 0xcf, 0x9b, 0xde, 0x51, 0x4c, 0x44, 0x52, 0x06, 0x52, 0x4e, 0xb6, 0x33, 0x16,
+	0xc1, 0x44, 0x2c, 0x17, 0x29, 0x09, 0xb8, 0x1b, 0x5c, 0x09, 0xcc, 0x9d, 0x13, 0x3c, 0x1b, 0xcb,
+	0x85, 0x07, 0xa5, 0xe2, 0xd7, 0x0b, 0xc1, 0x13, 0xc4, 0x13, 0x63, 0xc1, 0xaf, 0x16, 0xe8, 0xd4,
+	0x9d, 0x81, 0x3d, 0xd0, 0xd2, 0xb5, 0xb2, 0xe8, 0x74, 0x65, 0x67, 0x53, 0x07, 0x4f, 0x65, 0x3f,
+	0x35, 0x13, 0x8c, 0x22, 0xcc, 0xfc, 0xa1, 0x79, 0xe1, 0xdb, 0xf7, 0xf5, 0xfa, 0x89, 0xc2, 0x8f,
+	0xdb, 0xf3, 0x9b, 0xde, 0x96, 0x5e, 0x0f, 0xbd, 0x2d, 0x2d, 0x32, 0xac, 0xe9, 0x8d, 0x4c, 0x9b,
+	0xff, 0x07, 0xbd, 0x51, 0xa5, 0x37, 0x32, 0xef, 0xfa, 0x79, 0x1d, 0x6c, 0xea, 0x23, 0x78, 0x0a,
+	0xb6, 0x39, 0x89, 0x73, 0x1c, 0xf9, 0x1a, 0x62, 0xd2, 0xda, 0xad, 0x8b, 0xea, 0xc9, 0x7d, 0xa9,
+	0x60, 0x46, 0xbd, 0x71, 0x7d, 0xd3, 0xb3, 0xbc, 0x0e, 0xaf, 0xc5, 0xe0, 0x31, 0xd8, 0x5e, 0xa4,
+	0xc5, 0xe7, 0xb8, 0x4a, 0xf1, 0x6b, 0xa4, 0x16, 0x66, 0x5f, 0x62, 0xe1, 0x75, 0xa6, 0xb5, 0x1d,
+	0xfc, 0x14, 0xe8, 0x11, 0xa5, 0x2e, 0xa4, 0xba, 0x75, 0x63, 0xc5, 0x6e, 0xdd, 0x36, 0x3c, 0xd3,
+	0xae, 0xe7, 0x00, 0x56, 0x42, 0xcb, 0x62, 0x31, 0xb3, 0xed, 0xbe, 0x2b, 0xfd, 0xdf, 0x30, 0x97,
+	0x45, 0x71, 0xf0, 0x8b, 0x05, 0xda, 0x63, 0x24, 0xc2, 0xc4, 0x3c, 0xf6, 0x23, 0x60, 0x3c, 0xe5,
+	0xb6, 0xa5, 0x26, 0xc1, 0x8a, 0x79, 0xa9, 0x52, 0xc1, 0xe1, 0x9b, 0x00, 0x70, 0x41, 0x19, 0xf6,
+	0x53, 0xc4, 0xb5, 0x57, 0x0d, 0xaf, 0xa5, 0x22, 0x67, 0x88, 0xab, 0xa9, 0xa5, 0x8f, 0xb5, 0x0d,
+	0xdc, 0xde, 0x30, 0x03, 0xe7, 0xde, 0xa9, 0xa5, 0x68, 0x3a, 0xc4, 0x0f, 0x3e, 0x03, 0xcd, 0xea,
+	0xc7, 0x04, 0xee, 0x83, 0x56, 0x5e, 0x66, 0x98, 0xc9, 0x17, 0xa9, 0x3c, 0x37, 0xbc, 0x65, 0x00,
+	0xf6, 0x41, 0x3b, 0xc2, 0x39, 0xcd, 0x48, 0xae, 0xce, 0xf5, 0x85, 0xea, 0xa1, 0x31, 0x7e, 0x35,
+	0xef, 0x5a, 0xd7, 0xf3, 0xae, 0xf5, 0xc7, 0xbc, 0x6b, 0xbd, 0xbc, 0xed, 0xae, 0x5d, 0xdf, 0x76,
+	0xd7, 0x7e, 0xbb, 0xed, 0xae, 0x7d, 0xf3, 0xfc, 0x6f, 0x4d, 0xa7, 0x7f, 0xda, 0x83, 0xf0, 0x30,
+	0xa6, 0xee, 0x74, 0x78, 0xe4, 0x66, 0x34, 0x2a, 0x53, 0xcc, 0xf5, 0x17, 0xc8, 0x61, 0xf5, 0x09,
+	0x72, 0xf4, 0xde, 0xe1, 0xd2, 0xa0, 0x27, 0xcb, 0x65, 0xb0, 0xa9, 0x46, 0xc9, 0xe3, 0xbf, 0x02,
+	0x00, 0x00, 0xff, 0xff, 0x31, 0x43, 0x06, 0x44, 0xb6, 0x08, 0x00, 0x00,
 }
 
 func (m *ClientState) Marshal() (dAtA []byte, err error) {
@@ -683,6 +760,62 @@ func (m *Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BatchHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StoreHeights) > 0 {
+		for iNdEx := len(m.StoreHeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StoreHeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTendermint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.StoreLast != 0 {
+		i = encodeVarintTendermint(dAtA, i, uint64(m.StoreLast))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTendermint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Fraction) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -831,6 +964,30 @@ func (m *Header) Size() (n int) {
 	return n
 }
 
+func (m *BatchHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovTendermint(uint64(l))
+		}
+	}
+	if m.StoreLast != 0 {
+		n += 1 + sovTendermint(uint64(m.StoreLast))
+	}
+	if len(m.StoreHeights) > 0 {
+		for _, e := range m.StoreHeights {
+			l = e.Size()
+			n += 1 + l + sovTendermint(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Fraction) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1733,6 +1890,143 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BatchHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTendermint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, &Header{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreLast", wireType)
+			}
+			m.StoreLast = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StoreLast |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreHeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreHeights = append(m.StoreHeights, types.Height{})
+			if err := m.StoreHeights[len(m.StoreHeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTendermint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Fraction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
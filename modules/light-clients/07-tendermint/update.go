@@ -19,7 +19,7 @@ import (
 	"github.com/cosmos/ibc-go/v10/modules/core/exported"
 )
 
-// VerifyClientMessage checks if the clientMessage is of type Header or Misbehaviour and verifies the message
+// VerifyClientMessage checks if the clientMessage is of type Header, BatchHeader or Misbehaviour and verifies the message
 func (cs *ClientState) VerifyClientMessage(
 	ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore,
 	clientMsg exported.ClientMessage,
@@ -27,6 +27,8 @@ func (cs *ClientState) VerifyClientMessage(
 	switch msg := clientMsg.(type) {
 	case *Header:
 		return cs.verifyHeader(ctx, clientStore, cdc, msg)
+	case *BatchHeader:
+		return cs.verifyBatchHeader(ctx, clientStore, cdc, msg)
 	case *Misbehaviour:
 		return cs.verifyMisbehaviour(ctx, clientStore, cdc, msg)
 	default:
@@ -46,14 +48,48 @@ func (cs *ClientState) verifyHeader(
 	ctx sdk.Context, clientStore storetypes.KVStore, cdc codec.BinaryCodec,
 	header *Header,
 ) error {
-	currentTimestamp := ctx.BlockTime()
-
 	// Retrieve trusted consensus states for each Header in misbehaviour
 	consState, found := GetConsensusState(clientStore, cdc, header.TrustedHeight)
 	if !found {
 		return errorsmod.Wrapf(clienttypes.ErrConsensusStateNotFound, "could not get trusted consensus state from clientStore for Header at TrustedHeight: %s", header.TrustedHeight)
 	}
 
+	return cs.verifyHeaderWithConsensusState(ctx, header, consState)
+}
+
+// verifyBatchHeader verifies the first header of the batch against the trusted consensus state
+// stored by the client and every following header against the consensus state of the previous
+// header of the batch. An error is returned if any header fails verification.
+func (cs *ClientState) verifyBatchHeader(
+	ctx sdk.Context, clientStore storetypes.KVStore, cdc codec.BinaryCodec,
+	batchHeader *BatchHeader,
+) error {
+	for i, header := range batchHeader.Headers {
+		var err error
+		if i == 0 {
+			err = cs.verifyHeader(ctx, clientStore, cdc, header)
+		} else {
+			previousHeader := batchHeader.Headers[i-1]
+			if !header.TrustedHeight.EQ(previousHeader.GetHeight()) {
+				return errorsmod.Wrapf(ErrInvalidHeaderHeight, "trusted height of header at index %d must equal the height of the previous header (%s != %s)", i, header.TrustedHeight, previousHeader.GetHeight())
+			}
+
+			err = cs.verifyHeaderWithConsensusState(ctx, header, previousHeader.ConsensusState())
+		}
+
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to verify header at index %d", i)
+		}
+	}
+
+	return nil
+}
+
+// verifyHeaderWithConsensusState verifies the header against the trusted consensus state at the
+// header's trusted height.
+func (cs *ClientState) verifyHeaderWithConsensusState(ctx sdk.Context, header *Header, consState *ConsensusState) error {
+	currentTimestamp := ctx.BlockTime()
+
 	if err := checkTrustedHeader(header, consState); err != nil {
 		return err
 	}
@@ -127,14 +163,21 @@ func (cs *ClientState) verifyHeader(
 // If we are updating to a past height, a consensus state is created for that height to be persisted in client store
 // If we are updating to a future height, the consensus state is created and the client state is updated to reflect
 // the new latest height
-// A list containing the updated consensus height is returned.
+// For a BatchHeader, consensus states are only created for the headers requested by the batch, which always
+// include the last header of the batch.
+// A list containing the updated consensus heights is returned.
 // UpdateState must only be used to update within a single revision, thus header revision number and trusted height's revision
 // number must be the same. To update to a new revision, use a separate upgrade path
 // UpdateState will prune the oldest consensus state if it is expired.
-// If the provided clientMsg is not of type of Header then the handler will noop and empty slice is returned.
+// If the provided clientMsg is not of type of Header or BatchHeader then the handler will noop and empty slice is returned.
 func (cs ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, clientMsg exported.ClientMessage) []exported.Height {
-	header, ok := clientMsg.(*Header)
-	if !ok {
+	var headers []*Header
+	switch msg := clientMsg.(type) {
+	case *Header:
+		headers = []*Header{msg}
+	case *BatchHeader:
+		headers = msg.headersToStore()
+	default:
 		// clientMsg is invalid Misbehaviour, no update necessary
 		return []exported.Height{}
 	}
@@ -145,32 +188,44 @@ func (cs ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, client
 		cs.pruneOldestConsensusState(ctx, cdc, clientStore)
 	}
 
-	// check for duplicate update
-	if _, found := GetConsensusState(clientStore, cdc, header.GetHeight()); found {
-		// perform no-op
-		return []exported.Height{header.GetHeight()}
-	}
+	var (
+		heights []exported.Height
+		updated bool
+	)
+	for _, header := range headers {
+		height, ok := header.GetHeight().(clienttypes.Height)
+		if !ok {
+			panic(fmt.Errorf("cannot convert %T to %T", header.GetHeight(), &clienttypes.Height{}))
+		}
+		heights = append(heights, height)
 
-	height, ok := header.GetHeight().(clienttypes.Height)
-	if !ok {
-		panic(fmt.Errorf("cannot convert %T to %T", header.GetHeight(), &clienttypes.Height{}))
-	}
-	if height.GT(cs.LatestHeight) {
-		cs.LatestHeight = height
-	}
+		// check for duplicate update
+		if _, found := GetConsensusState(clientStore, cdc, height); found {
+			// perform no-op
+			continue
+		}
+
+		if height.GT(cs.LatestHeight) {
+			cs.LatestHeight = height
+		}
 
-	consensusState := &ConsensusState{
-		Timestamp:          header.GetTime(),
-		Root:               commitmenttypes.NewMerkleRoot(header.Header.GetAppHash()),
-		NextValidatorsHash: header.Header.NextValidatorsHash,
+		consensusState := &ConsensusState{
+			Timestamp:          header.GetTime(),
+			Root:               commitmenttypes.NewMerkleRoot(header.Header.GetAppHash()),
+			NextValidatorsHash: header.Header.NextValidatorsHash,
+		}
+
+		// set consensus state and associated metadata
+		setConsensusState(clientStore, cdc, consensusState, height)
+		setConsensusMetadata(ctx, clientStore, height)
+		updated = true
 	}
 
-	// set client state, consensus state and associated metadata
-	setClientState(clientStore, cdc, &cs)
-	setConsensusState(clientStore, cdc, consensusState, header.GetHeight())
-	setConsensusMetadata(ctx, clientStore, header.GetHeight())
+	if updated {
+		setClientState(clientStore, cdc, &cs)
+	}
 
-	return []exported.Height{height}
+	return heights
 }
 
 // pruneOldestConsensusState will retrieve the earliest consensus state for this clientID and check if it is expired. If it is,
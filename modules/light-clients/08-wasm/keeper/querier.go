@@ -34,6 +34,8 @@ var _ wasmvmtypes.Querier = (*queryHandler)(nil)
 // defaultAcceptList defines a set of default allowed queries made available to the Querier.
 var defaultAcceptList = []string{
 	"/ibc.core.client.v1.Query/VerifyMembership",
+	"/ibc.core.client.v1.Query/VerifyNonMembership",
+	"/ibc.core.client.v1.Query/VerifyMembershipBatch",
 }
 
 // queryHandler is a wrapper around the sdk.Context and the CallerID that calls
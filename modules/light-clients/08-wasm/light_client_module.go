@@ -5,8 +5,10 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -19,7 +21,10 @@ import (
 	"github.com/cosmos/ibc-go/v10/modules/core/exported"
 )
 
-var _ exported.LightClientModule = (*LightClientModule)(nil)
+var (
+	_ exported.LightClientModule    = (*LightClientModule)(nil)
+	_ exported.ConsensusStatePruner = (*LightClientModule)(nil)
+)
 
 // LightClientModule implements the core IBC api.LightClientModule interface.
 type LightClientModule struct {
@@ -481,3 +486,57 @@ func (l LightClientModule) VerifyUpgradeAndUpdateState(
 	_, err := l.keeper.WasmSudo(ctx, clientID, clientStore, clientState, payload)
 	return err
 }
+
+// PruneConsensusStates calls the contract to prune at most limit consensus states which fall outside of the given
+// retention limits. The number of pruned consensus states returned by the contract is returned.
+func (l LightClientModule) PruneConsensusStates(ctx sdk.Context, clientID string, maxConsensusStates uint64, maxAge time.Duration, limit uint64) (uint64, error) {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	cdc := l.keeper.Codec()
+
+	clientState, found := types.GetClientState(clientStore, cdc)
+	if !found {
+		return 0, errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	payload := types.SudoMsg{
+		PruneConsensusStates: &types.PruneConsensusStatesMsg{
+			MaxConsensusStates: maxConsensusStates,
+			MaxAge:             uint64(maxAge.Nanoseconds()),
+			Limit:              limit,
+		},
+	}
+
+	res, err := l.pruneConsensusStates(ctx, clientID, clientStore, clientState, payload)
+	if err != nil {
+		return 0, err
+	}
+
+	var result types.PruneConsensusStatesResult
+	if err := json.Unmarshal(res, &result); err != nil {
+		return 0, errorsmod.Wrap(types.ErrWasmInvalidResponseData, err.Error())
+	}
+
+	return uint64(len(result.Heights)), nil
+}
+
+// pruneConsensusStates calls the contract with a gas meter limited to PruneConsensusStatesGasLimit. An out of gas panic
+// raised by the contract call is recovered and returned as an error, any other panic is propagated. The gas consumed by
+// the contract call is charged to the gas meter of the given context.
+func (l LightClientModule) pruneConsensusStates(ctx sdk.Context, clientID string, clientStore storetypes.KVStore, clientState *types.ClientState, payload types.SudoMsg) (res []byte, err error) {
+	pruneCtx := ctx.WithGasMeter(storetypes.NewGasMeter(types.PruneConsensusStatesGasLimit))
+
+	defer func() {
+		ctx.GasMeter().ConsumeGas(pruneCtx.GasMeter().GasConsumedToLimit(), "08-wasm prune consensus states")
+
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+
+			res, err = nil, errorsmod.Wrapf(types.ErrWasmContractCallFailed, "out of gas in location: %s", outOfGas.Descriptor)
+		}
+	}()
+
+	return l.keeper.WasmSudo(pruneCtx, clientID, clientStore, clientState, payload)
+}
@@ -1390,6 +1390,160 @@ func (suite *WasmTestSuite) TestUpdateStateOnMisbehaviour() {
 	}
 }
 
+func (suite *WasmTestSuite) TestPruneConsensusStates() {
+	var (
+		clientID  string
+		expPruned uint64
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {
+				suite.mockVM.RegisterSudoCallback(types.PruneConsensusStatesMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, sudoMsg []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+					var payload types.SudoMsg
+					err := json.Unmarshal(sudoMsg, &payload)
+					suite.Require().NoError(err)
+
+					suite.Require().NotNil(payload.PruneConsensusStates)
+					suite.Require().Equal(uint64(2), payload.PruneConsensusStates.MaxConsensusStates)
+					suite.Require().Equal(uint64(time.Hour.Nanoseconds()), payload.PruneConsensusStates.MaxAge)
+					suite.Require().Equal(uint64(10), payload.PruneConsensusStates.Limit)
+					suite.Require().Nil(payload.UpdateState)
+					suite.Require().Nil(payload.UpdateStateOnMisbehaviour)
+					suite.Require().Nil(payload.VerifyMembership)
+					suite.Require().Nil(payload.VerifyNonMembership)
+					suite.Require().Nil(payload.VerifyUpgradeAndUpdateState)
+
+					resp, err := json.Marshal(types.PruneConsensusStatesResult{Heights: []clienttypes.Height{clienttypes.NewHeight(1, 1), clienttypes.NewHeight(1, 2)}})
+					suite.Require().NoError(err)
+
+					return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{Data: resp}}, wasmtesting.DefaultGasUsed, nil
+				})
+
+				expPruned = 2
+			},
+			nil,
+		},
+		{
+			"failure: cannot find client state",
+			func() {
+				clientID = unusedWasmClientID
+			},
+			clienttypes.ErrClientNotFound,
+		},
+		{
+			"failure: VM returns error",
+			func() {
+				suite.mockVM.RegisterSudoCallback(types.PruneConsensusStatesMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+					return nil, wasmtesting.DefaultGasUsed, wasmtesting.ErrMockVM
+				})
+			},
+			types.ErrVMError,
+		},
+		{
+			"failure: contract returns error",
+			func() {
+				suite.mockVM.RegisterSudoCallback(types.PruneConsensusStatesMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+					return &wasmvmtypes.ContractResult{Err: wasmtesting.ErrMockContract.Error()}, wasmtesting.DefaultGasUsed, nil
+				})
+			},
+			types.ErrWasmContractCallFailed,
+		},
+		{
+			"failure: invalid response data",
+			func() {
+				suite.mockVM.RegisterSudoCallback(types.PruneConsensusStatesMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+					return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{Data: []byte("invalid json")}}, wasmtesting.DefaultGasUsed, nil
+				})
+			},
+			types.ErrWasmInvalidResponseData,
+		},
+		{
+			"failure: contract runs out of gas",
+			func() {
+				suite.mockVM.RegisterSudoCallback(types.PruneConsensusStatesMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, gasLimit uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+					// the contract is given at most the gas limit for pruning, converted to wasm VM gas
+					suite.Require().LessOrEqual(gasLimit, types.PruneConsensusStatesGasLimit*types.DefaultGasMultiplier)
+					return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, gasLimit, nil
+				})
+			},
+			types.ErrWasmContractCallFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWasmWithMockVM() // reset
+			expPruned = 0
+
+			endpoint := wasmtesting.NewWasmEndpoint(suite.chainA)
+			err := endpoint.CreateClient()
+			suite.Require().NoError(err)
+			clientID = endpoint.ClientID
+
+			lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), clientID)
+			suite.Require().NoError(err)
+
+			pruner, ok := lightClientModule.(exported.ConsensusStatePruner)
+			suite.Require().True(ok)
+
+			tc.malleate()
+
+			pruned, err := pruner.PruneConsensusStates(suite.chainA.GetContext(), clientID, 2, time.Hour, 10)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expPruned, pruned)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Zero(pruned)
+			}
+		})
+	}
+}
+
+func (suite *WasmTestSuite) TestFreezeClient() {
+	suite.SetupWasmWithMockVM()
+
+	endpoint := wasmtesting.NewWasmEndpoint(suite.chainA)
+	err := endpoint.CreateClient()
+	suite.Require().NoError(err)
+
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+	ctx := suite.chainA.GetContext()
+
+	// the 08-wasm light client module does not natively freeze clients
+	lightClientModule, err := clientKeeper.Route(ctx, endpoint.ClientID)
+	suite.Require().NoError(err)
+	_, ok := lightClientModule.(exported.ClientFreezer)
+	suite.Require().False(ok)
+
+	err = clientKeeper.FreezeClient(ctx, endpoint.ClientID)
+	suite.Require().NoError(err)
+	suite.Require().True(clientKeeper.ClientStore(ctx, endpoint.ClientID).Has(clienttypes.FrozenByAuthorityKey()))
+	suite.Require().Equal(exported.Frozen, clientKeeper.GetClientStatus(ctx, endpoint.ClientID))
+
+	err = clientKeeper.UnfreezeClient(ctx, endpoint.ClientID)
+	suite.Require().NoError(err)
+	suite.Require().False(clientKeeper.ClientStore(ctx, endpoint.ClientID).Has(clienttypes.FrozenByAuthorityKey()))
+	suite.Require().Equal(exported.Active, clientKeeper.GetClientStatus(ctx, endpoint.ClientID))
+
+	// clients frozen by the contract rather than the authority cannot be unfrozen
+	suite.mockVM.RegisterQueryCallback(types.StatusMsg{}, func(_ wasmvm.Checksum, _ wasmvmtypes.Env, _ []byte, _ wasmvm.KVStore, _ wasmvm.GoAPI, _ wasmvm.Querier, _ wasmvm.GasMeter, _ uint64, _ wasmvmtypes.UFraction) (*wasmvmtypes.QueryResult, uint64, error) {
+		resp, err := json.Marshal(types.StatusResult{Status: exported.Frozen.String()})
+		suite.Require().NoError(err)
+		return &wasmvmtypes.QueryResult{Ok: resp}, wasmtesting.DefaultGasUsed, nil
+	})
+
+	err = clientKeeper.UnfreezeClient(ctx, endpoint.ClientID)
+	suite.Require().ErrorIs(err, clienttypes.ErrClientNotFrozen)
+}
+
 func (suite *WasmTestSuite) TestRecoverClient() {
 	var (
 		expectedClientStateBz               []byte
@@ -52,6 +52,7 @@ type SudoMsg struct {
 	VerifyMembership            *VerifyMembershipMsg            `json:"verify_membership,omitempty"`
 	VerifyNonMembership         *VerifyNonMembershipMsg         `json:"verify_non_membership,omitempty"`
 	MigrateClientStore          *MigrateClientStoreMsg          `json:"migrate_client_store,omitempty"`
+	PruneConsensusStates        *PruneConsensusStatesMsg        `json:"prune_consensus_states,omitempty"`
 }
 
 // UpdateStateMsg is a sudoMsg sent to the contract to update the client state.
@@ -94,9 +95,23 @@ type VerifyUpgradeAndUpdateStateMsg struct {
 // MigrateClientStoreMsg is a sudoMsg sent to the contract to verify a given substitute client and update to its state.
 type MigrateClientStoreMsg struct{}
 
+// PruneConsensusStatesGasLimit is the maximum amount of gas a contract may consume when pruning the consensus states
+// of a single client. Pruning is executed in the begin blocker, where the block gas meter is not limited.
+const PruneConsensusStatesGasLimit uint64 = 10_000_000
+
+// PruneConsensusStatesMsg is a sudoMsg sent to the contract to prune the consensus states which fall outside of the
+// retention policy of the client. The contract must prune at most limit consensus states and never prune the
+// consensus state at the latest height. A zero value for max consensus states or max age disables the limit.
+type PruneConsensusStatesMsg struct {
+	MaxConsensusStates uint64 `json:"max_consensus_states"`
+	// MaxAge is the maximum age of a retained consensus state in nanoseconds.
+	MaxAge uint64 `json:"max_age"`
+	Limit  uint64 `json:"limit"`
+}
+
 // ContractResult is a type constraint that defines the expected results that can be returned by a contract call/query.
 type ContractResult interface {
-	EmptyResult | StatusResult | TimestampAtHeightResult | CheckForMisbehaviourResult | UpdateStateResult
+	EmptyResult | StatusResult | TimestampAtHeightResult | CheckForMisbehaviourResult | UpdateStateResult | PruneConsensusStatesResult
 }
 
 // EmptyResult is the default return type of any contract call that does not require a custom return type.
@@ -123,3 +138,9 @@ type CheckForMisbehaviourResult struct {
 type UpdateStateResult struct {
 	Heights []clienttypes.Height `json:"heights"`
 }
+
+// PruneConsensusStatesResult is the expected return type of the pruneConsensusStatesMsg sudo call. It returns the heights
+// of the pruned consensus states.
+type PruneConsensusStatesResult struct {
+	Heights []clienttypes.Height `json:"heights"`
+}
@@ -0,0 +1,268 @@
+package ethereum
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+var _ exported.ClientState = (*ClientState)(nil)
+
+const (
+	// finalizedRootIndex is the index of the finalized checkpoint root in the beacon state at the
+	// depth given by finalizedRootDepth (Deneb) or finalizedRootDepthElectra
+	finalizedRootIndex        = 41
+	finalizedRootDepth        = 6
+	finalizedRootDepthElectra = 7
+
+	// nextSyncCommitteeIndex is the index of the next sync committee in the beacon state at the
+	// depth given by nextSyncCommitteeDepth (Deneb) or nextSyncCommitteeDepthElectra
+	nextSyncCommitteeIndex        = 23
+	nextSyncCommitteeDepth        = 5
+	nextSyncCommitteeDepthElectra = 6
+
+	// executionPayloadIndex is the index of the execution payload in the beacon block body at the
+	// depth given by executionPayloadDepth
+	executionPayloadIndex = 9
+	executionPayloadDepth = 4
+)
+
+// NewClientState creates a new ClientState instance
+func NewClientState(
+	chainID uint64, genesisValidatorsRoot []byte, genesisTime, secondsPerSlot, slotsPerEpoch,
+	epochsPerSyncCommitteePeriod, syncCommitteeSize, minSyncCommitteeParticipants uint64,
+	forkParameters ForkParameters, latestSlot uint64, ibcContractAddress, ibcCommitmentSlot []byte,
+) *ClientState {
+	return &ClientState{
+		ChainId:                      chainID,
+		GenesisValidatorsRoot:        genesisValidatorsRoot,
+		GenesisTime:                  genesisTime,
+		SecondsPerSlot:               secondsPerSlot,
+		SlotsPerEpoch:                slotsPerEpoch,
+		EpochsPerSyncCommitteePeriod: epochsPerSyncCommitteePeriod,
+		SyncCommitteeSize:            syncCommitteeSize,
+		MinSyncCommitteeParticipants: minSyncCommitteeParticipants,
+		ForkParameters:               forkParameters,
+		LatestSlot:                   latestSlot,
+		IbcContractAddress:           ibcContractAddress,
+		IbcCommitmentSlot:            ibcCommitmentSlot,
+		IsFrozen:                     false,
+	}
+}
+
+// ClientType is 11-ethereum.
+func (ClientState) ClientType() string {
+	return ModuleName
+}
+
+// LatestHeight returns the latest height of the client. The revision number is always zero and
+// the revision height is the latest finalized beacon slot.
+func (cs ClientState) LatestHeight() exported.Height {
+	return clienttypes.NewHeight(0, cs.LatestSlot)
+}
+
+// Validate performs a basic validation of the client state fields.
+func (cs ClientState) Validate() error {
+	if cs.ChainId == 0 {
+		return errorsmod.Wrap(ErrInvalidChainID, "chain id cannot be zero")
+	}
+	if len(cs.GenesisValidatorsRoot) != rootSize {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidClient, "genesis validators root must be %d bytes, got %d", rootSize, len(cs.GenesisValidatorsRoot))
+	}
+	if cs.SecondsPerSlot == 0 {
+		return errorsmod.Wrap(clienttypes.ErrInvalidClient, "seconds per slot cannot be zero")
+	}
+	if cs.SlotsPerEpoch == 0 {
+		return errorsmod.Wrap(clienttypes.ErrInvalidClient, "slots per epoch cannot be zero")
+	}
+	if cs.EpochsPerSyncCommitteePeriod == 0 {
+		return errorsmod.Wrap(clienttypes.ErrInvalidClient, "epochs per sync committee period cannot be zero")
+	}
+	if cs.SyncCommitteeSize == 0 || cs.SyncCommitteeSize%8 != 0 {
+		return errorsmod.Wrapf(ErrInvalidSyncCommittee, "sync committee size must be a positive multiple of 8, got %d", cs.SyncCommitteeSize)
+	}
+	if cs.MinSyncCommitteeParticipants == 0 || cs.MinSyncCommitteeParticipants > cs.SyncCommitteeSize {
+		return errorsmod.Wrapf(ErrInvalidSyncCommittee, "minimum sync committee participants must be between 1 and %d, got %d", cs.SyncCommitteeSize, cs.MinSyncCommitteeParticipants)
+	}
+	if err := cs.ForkParameters.Validate(); err != nil {
+		return err
+	}
+	if cs.LatestSlot == 0 {
+		return errorsmod.Wrap(clienttypes.ErrInvalidClient, "latest slot cannot be zero")
+	}
+	if len(cs.IbcContractAddress) != addressSize {
+		return errorsmod.Wrapf(ErrInvalidContractConfiguration, "IBC contract address must be %d bytes, got %d", addressSize, len(cs.IbcContractAddress))
+	}
+	if len(cs.IbcCommitmentSlot) != rootSize {
+		return errorsmod.Wrapf(ErrInvalidContractConfiguration, "IBC commitment slot must be %d bytes, got %d", rootSize, len(cs.IbcCommitmentSlot))
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of the fork parameters. Forks must be scheduled in
+// chronological order. Forks which are not yet scheduled use the maximum epoch.
+func (fp ForkParameters) Validate() error {
+	if len(fp.GenesisForkVersion) != forkVersionSize {
+		return errorsmod.Wrapf(ErrInvalidForkParameters, "genesis fork version must be %d bytes, got %d", forkVersionSize, len(fp.GenesisForkVersion))
+	}
+
+	forks := []struct {
+		name string
+		fork Fork
+	}{
+		{"altair", fp.Altair},
+		{"bellatrix", fp.Bellatrix},
+		{"capella", fp.Capella},
+		{"deneb", fp.Deneb},
+		{"electra", fp.Electra},
+	}
+
+	var previousEpoch uint64
+	for _, f := range forks {
+		if len(f.fork.Version) != forkVersionSize {
+			return errorsmod.Wrapf(ErrInvalidForkParameters, "%s fork version must be %d bytes, got %d", f.name, forkVersionSize, len(f.fork.Version))
+		}
+		if f.fork.Epoch < previousEpoch {
+			return errorsmod.Wrapf(ErrInvalidForkParameters, "%s fork epoch %d cannot be before the previous fork epoch %d", f.name, f.fork.Epoch, previousEpoch)
+		}
+		previousEpoch = f.fork.Epoch
+	}
+
+	return nil
+}
+
+// computeEpoch returns the epoch of the slot.
+func (cs ClientState) computeEpoch(slot uint64) uint64 {
+	return slot / cs.SlotsPerEpoch
+}
+
+// computeSyncCommitteePeriod returns the sync committee period of the slot.
+func (cs ClientState) computeSyncCommitteePeriod(slot uint64) uint64 {
+	return cs.computeEpoch(slot) / cs.EpochsPerSyncCommitteePeriod
+}
+
+// computeSlotAtTime returns the slot at the given time. Times before genesis map to slot zero.
+func (cs ClientState) computeSlotAtTime(t time.Time) uint64 {
+	now := t.Unix()
+	if now < 0 || uint64(now) < cs.GenesisTime {
+		return 0
+	}
+
+	return (uint64(now) - cs.GenesisTime) / cs.SecondsPerSlot
+}
+
+// computeForkVersion returns the fork version active at the epoch.
+func (cs ClientState) computeForkVersion(epoch uint64) []byte {
+	fp := cs.ForkParameters
+	switch {
+	case epoch >= fp.Electra.Epoch:
+		return fp.Electra.Version
+	case epoch >= fp.Deneb.Epoch:
+		return fp.Deneb.Version
+	case epoch >= fp.Capella.Epoch:
+		return fp.Capella.Version
+	case epoch >= fp.Bellatrix.Epoch:
+		return fp.Bellatrix.Version
+	case epoch >= fp.Altair.Epoch:
+		return fp.Altair.Version
+	default:
+		return fp.GenesisForkVersion
+	}
+}
+
+// isElectra returns true if the slot is at or after the Electra fork.
+func (cs ClientState) isElectra(slot uint64) bool {
+	return cs.computeEpoch(slot) >= cs.ForkParameters.Electra.Epoch
+}
+
+// finalizedRootGeneralizedIndex returns the depth and index of the finalized checkpoint root in
+// the beacon state at the slot.
+func (cs ClientState) finalizedRootGeneralizedIndex(slot uint64) (uint64, uint64) {
+	if cs.isElectra(slot) {
+		return finalizedRootDepthElectra, finalizedRootIndex
+	}
+
+	return finalizedRootDepth, finalizedRootIndex
+}
+
+// nextSyncCommitteeGeneralizedIndex returns the depth and index of the next sync committee in
+// the beacon state at the slot.
+func (cs ClientState) nextSyncCommitteeGeneralizedIndex(slot uint64) (uint64, uint64) {
+	if cs.isElectra(slot) {
+		return nextSyncCommitteeDepthElectra, nextSyncCommitteeIndex
+	}
+
+	return nextSyncCommitteeDepth, nextSyncCommitteeIndex
+}
+
+// getTimestampAtHeight returns the timestamp in nanoseconds of the consensus state at the given height.
+func (ClientState) getTimestampAtHeight(
+	clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+) (uint64, error) {
+	consState, found := GetConsensusState(clientStore, cdc, height)
+	if !found {
+		return 0, errorsmod.Wrapf(clienttypes.ErrConsensusStateNotFound, "height (%s)", height)
+	}
+	return consState.GetTimestamp(), nil
+}
+
+// status returns the status of the ethereum client.
+// The client may be:
+// - Active: IsFrozen is false and the client is not expired
+// - Frozen: IsFrozen is true
+// - Expired: the current sync committee period is past the period following the latest consensus
+// state, so that no sync committee known to the client can sign updates anymore
+//
+// A frozen client will become expired, so the Frozen status
+// has higher precedence.
+func (cs ClientState) status(
+	ctx sdk.Context,
+	clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec,
+) exported.Status {
+	if cs.IsFrozen {
+		return exported.Frozen
+	}
+
+	// if the client state does not have an associated consensus state for its latest height
+	// then it must be expired
+	if _, found := GetConsensusState(clientStore, cdc, cs.LatestHeight()); !found {
+		return exported.Expired
+	}
+
+	currentPeriod := cs.computeSyncCommitteePeriod(cs.computeSlotAtTime(ctx.BlockTime()))
+	if currentPeriod > cs.computeSyncCommitteePeriod(cs.LatestSlot)+1 {
+		return exported.Expired
+	}
+
+	return exported.Active
+}
+
+// initialize checks that the initial consensus state is an 11-ethereum consensus state for the
+// latest slot of the client and sets the client state and consensus state in the provided client store.
+func (cs ClientState) initialize(cdc codec.BinaryCodec, clientStore storetypes.KVStore, consState exported.ConsensusState) error {
+	consensusState, ok := consState.(*ConsensusState)
+	if !ok {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidConsensus, "invalid initial consensus state. expected type: %T, got: %T",
+			&ConsensusState{}, consState)
+	}
+
+	if consensusState.Slot != cs.LatestSlot {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidConsensus, "initial consensus state slot must equal the client latest slot (%d != %d)", consensusState.Slot, cs.LatestSlot)
+	}
+
+	setClientState(clientStore, cdc, &cs)
+	setConsensusState(clientStore, cdc, consensusState, cs.LatestHeight())
+
+	return nil
+}
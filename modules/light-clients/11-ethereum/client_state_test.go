@@ -0,0 +1,50 @@
+package ethereum_test
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	ethereum "github.com/cosmos/ibc-go/v10/modules/light-clients/11-ethereum"
+)
+
+func (suite *EthereumTestSuite) TestValidate() {
+	var clientState *ethereum.ClientState
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{"valid client", func() {}, nil},
+		{"valid client with unscheduled electra fork", func() { clientState.ForkParameters.Electra.Epoch = farFutureEpoch }, nil},
+		{"invalid chain id", func() { clientState.ChainId = 0 }, ethereum.ErrInvalidChainID},
+		{"invalid genesis validators root", func() { clientState.GenesisValidatorsRoot = []byte{0x01} }, clienttypes.ErrInvalidClient},
+		{"zero seconds per slot", func() { clientState.SecondsPerSlot = 0 }, clienttypes.ErrInvalidClient},
+		{"zero slots per epoch", func() { clientState.SlotsPerEpoch = 0 }, clienttypes.ErrInvalidClient},
+		{"zero epochs per sync committee period", func() { clientState.EpochsPerSyncCommitteePeriod = 0 }, clienttypes.ErrInvalidClient},
+		{"zero sync committee size", func() { clientState.SyncCommitteeSize = 0 }, ethereum.ErrInvalidSyncCommittee},
+		{"sync committee size is not a multiple of 8", func() { clientState.SyncCommitteeSize = 31 }, ethereum.ErrInvalidSyncCommittee},
+		{"zero minimum participants", func() { clientState.MinSyncCommitteeParticipants = 0 }, ethereum.ErrInvalidSyncCommittee},
+		{"minimum participants exceed sync committee size", func() { clientState.MinSyncCommitteeParticipants = syncCommitteeSize + 1 }, ethereum.ErrInvalidSyncCommittee},
+		{"invalid genesis fork version", func() { clientState.ForkParameters.GenesisForkVersion = nil }, ethereum.ErrInvalidForkParameters},
+		{"invalid fork version", func() { clientState.ForkParameters.Capella.Version = []byte{0x01} }, ethereum.ErrInvalidForkParameters},
+		{"forks are not in chronological order", func() { clientState.ForkParameters.Altair.Epoch = 10 }, ethereum.ErrInvalidForkParameters},
+		{"zero latest slot", func() { clientState.LatestSlot = 0 }, clienttypes.ErrInvalidClient},
+		{"invalid IBC contract address", func() { clientState.IbcContractAddress = make([]byte, 32) }, ethereum.ErrInvalidContractConfiguration},
+		{"invalid IBC commitment slot", func() { clientState.IbcCommitmentSlot = nil }, ethereum.ErrInvalidContractConfiguration},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			clientState = suite.newClientState(trustedSlot)
+
+			tc.malleate()
+
+			err := clientState.Validate()
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
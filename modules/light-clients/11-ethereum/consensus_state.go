@@ -0,0 +1,59 @@
+package ethereum
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+var _ exported.ConsensusState = (*ConsensusState)(nil)
+
+// NewConsensusState creates a new ConsensusState instance.
+func NewConsensusState(
+	slot uint64, stateRoot, storageRoot []byte, timestamp uint64, currentSyncCommittee, nextSyncCommittee []byte,
+) *ConsensusState {
+	return &ConsensusState{
+		Slot:                 slot,
+		StateRoot:            stateRoot,
+		StorageRoot:          storageRoot,
+		Timestamp:            timestamp,
+		CurrentSyncCommittee: currentSyncCommittee,
+		NextSyncCommittee:    nextSyncCommittee,
+	}
+}
+
+// ClientType returns 11-ethereum.
+func (ConsensusState) ClientType() string {
+	return ModuleName
+}
+
+// GetTimestamp returns the execution block time in nanoseconds of the header that created the consensus state.
+func (cs ConsensusState) GetTimestamp() uint64 {
+	return cs.Timestamp * uint64(1e9)
+}
+
+// ValidateBasic defines a basic validation for the ethereum consensus state.
+// The next sync committee may be empty if it has not been attested to yet.
+func (cs ConsensusState) ValidateBasic() error {
+	if cs.Slot == 0 {
+		return errorsmod.Wrap(clienttypes.ErrInvalidConsensus, "slot cannot be zero")
+	}
+	if len(cs.StateRoot) != rootSize {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidConsensus, "state root must be %d bytes, got %d", rootSize, len(cs.StateRoot))
+	}
+	if len(cs.StorageRoot) != rootSize {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidConsensus, "storage root must be %d bytes, got %d", rootSize, len(cs.StorageRoot))
+	}
+	if cs.Timestamp == 0 {
+		return errorsmod.Wrap(clienttypes.ErrInvalidConsensus, "timestamp cannot be zero")
+	}
+	if len(cs.CurrentSyncCommittee) != rootSize {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidConsensus, "current sync committee root must be %d bytes, got %d", rootSize, len(cs.CurrentSyncCommittee))
+	}
+	if len(cs.NextSyncCommittee) != 0 && len(cs.NextSyncCommittee) != rootSize {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidConsensus, "next sync committee root must be empty or %d bytes, got %d", rootSize, len(cs.NextSyncCommittee))
+	}
+
+	return nil
+}
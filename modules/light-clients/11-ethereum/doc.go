@@ -0,0 +1,14 @@
+/*
+Package ethereum implements a concrete LightClientModule, ClientState, ConsensusState
+and Header types for a light client of Ethereum.
+The client follows the finalized beacon chain using the sync committee updates of the Altair
+light client sync protocol
+(https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/sync-protocol.md),
+verifies the BLS aggregate signatures of the sync committee, and stores the execution layer state
+root and the storage root of the IBC contract as consensus states. Membership and non-membership
+of IBC v2 commitments are verified against Merkle Patricia storage proofs of the IBC contract.
+
+Note that client identifiers are expected to be in the form: 11-ethereum-{N}.
+Client identifiers are generated and validated by core IBC, unexpected client identifiers will result in errors.
+*/
+package ethereum
@@ -0,0 +1,20 @@
+package ethereum
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// IBC ethereum client sentinel errors
+var (
+	ErrInvalidChainID               = errorsmod.Register(ModuleName, 2, "invalid chain-id")
+	ErrInvalidForkParameters        = errorsmod.Register(ModuleName, 3, "invalid fork parameters")
+	ErrInvalidSyncCommittee         = errorsmod.Register(ModuleName, 4, "invalid sync committee")
+	ErrInvalidHeader                = errorsmod.Register(ModuleName, 5, "invalid header")
+	ErrInvalidMerkleBranch          = errorsmod.Register(ModuleName, 6, "invalid merkle branch")
+	ErrInsufficientParticipants     = errorsmod.Register(ModuleName, 7, "insufficient sync committee participants")
+	ErrSignatureVerificationFailed  = errorsmod.Register(ModuleName, 8, "sync committee signature verification failed")
+	ErrInvalidProof                 = errorsmod.Register(ModuleName, 9, "invalid merkle patricia proof")
+	ErrInvalidPath                  = errorsmod.Register(ModuleName, 10, "invalid commitment path")
+	ErrUnsupportedFork              = errorsmod.Register(ModuleName, 11, "unsupported beacon chain fork")
+	ErrInvalidContractConfiguration = errorsmod.Register(ModuleName, 12, "invalid IBC contract configuration")
+)
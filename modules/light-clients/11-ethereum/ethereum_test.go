@@ -0,0 +1,350 @@
+package ethereum_test
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math/big"
+	"math/bits"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	blsu "github.com/protolambda/bls12-381-util"
+	testifysuite "github.com/stretchr/testify/suite"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	ethereum "github.com/cosmos/ibc-go/v10/modules/light-clients/11-ethereum"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+const (
+	syncCommitteeSize = 32
+	slotsPerEpoch     = 32
+	epochsPerPeriod   = 256
+	secondsPerSlot    = 12
+	slotsPerPeriod    = slotsPerEpoch * epochsPerPeriod
+
+	// trustedSlot is the slot of the initial consensus state, in sync committee period 10
+	trustedSlot = 10*slotsPerPeriod + 64
+	// currentSlot is the slot of the testing chain block time
+	currentSlot = trustedSlot + 1000
+)
+
+var (
+	farFutureEpoch = ^uint64(0)
+
+	genesisValidatorsRoot = sha256Hash([]byte("genesis validators root"))
+	ibcContractAddress    = crypto.Keccak256([]byte("ibc contract"))[12:]
+	ibcCommitmentSlot     = sha256Hash([]byte("ibc commitment slot"))
+
+	commitmentPath  = []byte("07-tendermint-0\x01\x00\x00\x00\x00\x00\x00\x00\x01")
+	commitmentValue = sha256Hash([]byte("packet commitment"))
+)
+
+type EthereumTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chainA      *ibctesting.TestChain
+
+	// genesisTime is the beacon chain genesis time for which the testing chain block time is at currentSlot
+	genesisTime uint64
+	// forkParameters are the fork parameters used by the client state
+	forkParameters ethereum.ForkParameters
+
+	// current and next sync committees of the trusted consensus state
+	currentKeys          []*blsu.SecretKey
+	currentSyncCommittee ethereum.SyncCommittee
+	nextKeys             []*blsu.SecretKey
+	nextSyncCommittee    ethereum.SyncCommittee
+}
+
+func TestEthereumTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(EthereumTestSuite))
+}
+
+func (suite *EthereumTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 1)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+
+	suite.genesisTime = uint64(suite.chainA.GetContext().BlockTime().Unix()) - currentSlot*secondsPerSlot
+	suite.forkParameters = newForkParameters(0)
+	suite.currentKeys, suite.currentSyncCommittee = suite.newSyncCommittee(1)
+	suite.nextKeys, suite.nextSyncCommittee = suite.newSyncCommittee(syncCommitteeSize + 1)
+}
+
+// newForkParameters returns fork parameters with all forks up to deneb scheduled at genesis and
+// electra scheduled at the given epoch.
+func newForkParameters(electraEpoch uint64) ethereum.ForkParameters {
+	return ethereum.ForkParameters{
+		GenesisForkVersion: []byte{0x00, 0x00, 0x00, 0x01},
+		Altair:             ethereum.Fork{Version: []byte{0x01, 0x00, 0x00, 0x01}, Epoch: 0},
+		Bellatrix:          ethereum.Fork{Version: []byte{0x02, 0x00, 0x00, 0x01}, Epoch: 0},
+		Capella:            ethereum.Fork{Version: []byte{0x03, 0x00, 0x00, 0x01}, Epoch: 0},
+		Deneb:              ethereum.Fork{Version: []byte{0x04, 0x00, 0x00, 0x01}, Epoch: 0},
+		Electra:            ethereum.Fork{Version: []byte{0x05, 0x00, 0x00, 0x01}, Epoch: electraEpoch},
+	}
+}
+
+// newSyncCommittee deterministically generates the secret keys and the sync committee of
+// syncCommitteeSize members, starting from the given seed.
+func (suite *EthereumTestSuite) newSyncCommittee(seed uint64) ([]*blsu.SecretKey, ethereum.SyncCommittee) {
+	keys := make([]*blsu.SecretKey, syncCommitteeSize)
+	pubkeys := make([]*blsu.Pubkey, syncCommitteeSize)
+	syncCommittee := ethereum.SyncCommittee{}
+	for i := range keys {
+		var keyBz [32]byte
+		binary.BigEndian.PutUint64(keyBz[24:], seed+uint64(i))
+
+		keys[i] = new(blsu.SecretKey)
+		suite.Require().NoError(keys[i].Deserialize(&keyBz))
+
+		pubkey, err := blsu.SkToPk(keys[i])
+		suite.Require().NoError(err)
+		pubkeys[i] = pubkey
+
+		pubkeyBz := pubkey.Serialize()
+		syncCommittee.Pubkeys = append(syncCommittee.Pubkeys, pubkeyBz[:])
+	}
+
+	aggregatePubkey, err := blsu.AggregatePubkeys(pubkeys)
+	suite.Require().NoError(err)
+	aggregatePubkeyBz := aggregatePubkey.Serialize()
+	syncCommittee.AggregatePubkey = aggregatePubkeyBz[:]
+
+	return keys, syncCommittee
+}
+
+// newClientState returns a valid client state with the given latest slot.
+func (suite *EthereumTestSuite) newClientState(latestSlot uint64) *ethereum.ClientState {
+	return ethereum.NewClientState(
+		1, genesisValidatorsRoot, suite.genesisTime, secondsPerSlot, slotsPerEpoch, epochsPerPeriod,
+		syncCommitteeSize, syncCommitteeSize/2, suite.forkParameters, latestSlot, ibcContractAddress, ibcCommitmentSlot,
+	)
+}
+
+// newConsensusState returns the trusted consensus state at trustedSlot, whose storage root commits
+// to commitmentValue at commitmentPath.
+func (suite *EthereumTestSuite) newConsensusState() *ethereum.ConsensusState {
+	currentRoot := suite.currentSyncCommittee.HashTreeRoot()
+	nextRoot := suite.nextSyncCommittee.HashTreeRoot()
+	storageRoot, _ := suite.storageTrie()
+
+	return ethereum.NewConsensusState(trustedSlot, sha256Hash([]byte("trusted state root")), storageRoot, 1_600_000_000, currentRoot[:], nextRoot[:])
+}
+
+// createClient creates an ethereum client on chainA with the trusted consensus state.
+func (suite *EthereumTestSuite) createClient() string {
+	clientState := suite.newClientState(trustedSlot)
+	consensusState := suite.newConsensusState()
+
+	clientStateBz := suite.chainA.Codec.MustMarshal(clientState)
+	consensusStateBz := suite.chainA.Codec.MustMarshal(consensusState)
+
+	clientID, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.CreateClient(suite.chainA.GetContext(), ethereum.ModuleName, clientStateBz, consensusStateBz)
+	suite.Require().NoError(err)
+
+	return clientID
+}
+
+// storageTrie returns the root of a storage trie containing commitmentValue at the storage slot of
+// commitmentPath, along with the storage proof of the commitment.
+func (suite *EthereumTestSuite) storageTrie() ([]byte, ethereum.StorageProof) {
+	clientState := ethereum.ClientState{IbcCommitmentSlot: ibcCommitmentSlot}
+	slot := clientState.CommitmentStorageSlot(commitmentPath)
+
+	value, err := rlp.EncodeToBytes(commitmentValue)
+	suite.Require().NoError(err)
+
+	leaf := suite.leafNode(crypto.Keccak256(slot), value)
+	return crypto.Keccak256(leaf), ethereum.StorageProof{Key: slot, Value: commitmentValue, Proof: [][]byte{leaf}}
+}
+
+// accountTrie returns the root of a state trie containing the IBC contract account with the given
+// storage root, along with the account proof.
+func (suite *EthereumTestSuite) accountTrie(storageRoot []byte) ([]byte, ethereum.AccountProof) {
+	account, err := rlp.EncodeToBytes([]any{uint64(1), big.NewInt(0), storageRoot, crypto.Keccak256(nil)})
+	suite.Require().NoError(err)
+
+	leaf := suite.leafNode(crypto.Keccak256(ibcContractAddress), account)
+	return crypto.Keccak256(leaf), ethereum.AccountProof{Proof: [][]byte{leaf}, StorageRoot: storageRoot}
+}
+
+// leafNode returns the RLP encoded leaf node of a trie with a single key.
+func (suite *EthereumTestSuite) leafNode(key, value []byte) []byte {
+	// the hex prefix 0x20 flags an even length leaf path
+	node, err := rlp.EncodeToBytes([][]byte{append([]byte{0x20}, key...), value})
+	suite.Require().NoError(err)
+	return node
+}
+
+// newHeader returns a header, signed by all members of the current sync committee, which updates the
+// client from the trusted consensus state to the finalized slot. The next sync committee is attested if
+// withNextSyncCommittee is true.
+func (suite *EthereumTestSuite) newHeader(finalizedSlot, attestedSlot, signatureSlot uint64, withNextSyncCommittee bool) *ethereum.Header {
+	storageRoot, _ := suite.storageTrie()
+	stateRoot, accountProof := suite.accountTrie(storageRoot)
+
+	execution := ethereum.ExecutionPayloadHeader{
+		ParentHash:       sha256Hash([]byte("parent hash")),
+		FeeRecipient:     make([]byte, 20),
+		StateRoot:        stateRoot,
+		ReceiptsRoot:     sha256Hash([]byte("receipts root")),
+		LogsBloom:        make([]byte, 256),
+		PrevRandao:       sha256Hash([]byte("prev randao")),
+		BlockNumber:      finalizedSlot,
+		GasLimit:         30_000_000,
+		GasUsed:          21_000,
+		Timestamp:        suite.genesisTime + finalizedSlot*secondsPerSlot,
+		ExtraData:        []byte("extra data"),
+		BaseFeePerGas:    "1000000000",
+		BlockHash:        sha256Hash([]byte("block hash")),
+		TransactionsRoot: sha256Hash([]byte("transactions root")),
+		WithdrawalsRoot:  sha256Hash([]byte("withdrawals root")),
+		BlobGasUsed:      0,
+		ExcessBlobGas:    0,
+	}
+
+	bodyTree := merkleTree{25: execution.HashTreeRoot(), 24: sha256Array([]byte("sibling"))}
+	bodyRoot := bodyTree.root()
+
+	finalized := ethereum.LightClientHeader{
+		Beacon: ethereum.BeaconBlockHeader{
+			Slot:          finalizedSlot,
+			ProposerIndex: 7,
+			ParentRoot:    sha256Hash([]byte("finalized parent root")),
+			StateRoot:     sha256Hash([]byte("finalized state root")),
+			BodyRoot:      bodyRoot[:],
+		},
+		Execution:       execution,
+		ExecutionBranch: bodyTree.branch(25),
+	}
+
+	// the attested beacon state commits to the finalized header and the next sync committee
+	finalizedRootGindex, nextSyncCommitteeGindex := uint64(105), uint64(55)
+	if attestedSlot/slotsPerEpoch >= suite.forkParameters.Electra.Epoch {
+		finalizedRootGindex, nextSyncCommitteeGindex = 169, 87
+	}
+
+	// the next sync committee of the trusted period is already known to the trusted consensus state
+	nextSyncCommittee := suite.nextSyncCommittee
+	if finalizedSlot/slotsPerPeriod != trustedSlot/slotsPerPeriod {
+		_, nextSyncCommittee = suite.newSyncCommittee(2*syncCommitteeSize + 1)
+	}
+	stateTree := merkleTree{
+		finalizedRootGindex:     finalized.Beacon.HashTreeRoot(),
+		nextSyncCommitteeGindex: nextSyncCommittee.HashTreeRoot(),
+	}
+	attestedStateRoot := stateTree.root()
+
+	update := ethereum.LightClientUpdate{
+		AttestedHeader: ethereum.LightClientHeader{
+			Beacon: ethereum.BeaconBlockHeader{
+				Slot:          attestedSlot,
+				ProposerIndex: 9,
+				ParentRoot:    sha256Hash([]byte("attested parent root")),
+				StateRoot:     attestedStateRoot[:],
+				BodyRoot:      bodyRoot[:],
+			},
+			Execution:       execution,
+			ExecutionBranch: bodyTree.branch(25),
+		},
+		FinalizedHeader: finalized,
+		FinalityBranch:  stateTree.branch(finalizedRootGindex),
+		SignatureSlot:   signatureSlot,
+	}
+
+	if withNextSyncCommittee {
+		update.NextSyncCommittee = &nextSyncCommittee
+		update.NextSyncCommitteeBranch = stateTree.branch(nextSyncCommitteeGindex)
+	}
+
+	header := &ethereum.Header{
+		TrustedSyncCommittee: ethereum.TrustedSyncCommittee{
+			TrustedHeight: clienttypes.NewHeight(0, trustedSlot),
+			SyncCommittee: suite.currentSyncCommittee,
+		},
+		ConsensusUpdate: update,
+		AccountProof:    accountProof,
+	}
+
+	suite.signHeader(header, suite.currentKeys, syncCommitteeSize)
+
+	return header
+}
+
+// signHeader sets the sync aggregate of the header, signed by the first participants of the keys.
+func (suite *EthereumTestSuite) signHeader(header *ethereum.Header, keys []*blsu.SecretKey, participants int) {
+	update := &header.ConsensusUpdate
+
+	forkVersion := suite.forkParameters.Deneb.Version
+	if (update.SignatureSlot-1)/slotsPerEpoch >= suite.forkParameters.Electra.Epoch {
+		forkVersion = suite.forkParameters.Electra.Version
+	}
+	domain := ethereum.ComputeDomain(ethereum.DomainSyncCommittee, forkVersion, genesisValidatorsRoot)
+	signingRoot := ethereum.ComputeSigningRoot(update.AttestedHeader.Beacon.HashTreeRoot(), domain)
+
+	committeeBits := make([]byte, syncCommitteeSize/8)
+	signatures := make([]*blsu.Signature, participants)
+	for i := range participants {
+		committeeBits[i/8] |= 1 << (i % 8)
+		signatures[i] = blsu.Sign(keys[i], signingRoot[:])
+	}
+
+	signature, err := blsu.Aggregate(signatures)
+	suite.Require().NoError(err)
+	signatureBz := signature.Serialize()
+
+	update.SyncAggregate = ethereum.SyncAggregate{
+		SyncCommitteeBits:      committeeBits,
+		SyncCommitteeSignature: signatureBz[:],
+	}
+}
+
+// merkleTree is a sparse SSZ merkle tree, mapping generalized indices to nodes. All nodes which are
+// not set, and which have no descendants set, are zero.
+type merkleTree map[uint64][32]byte
+
+// depth returns the depth of the deepest node set in the tree.
+func (t merkleTree) depth() int {
+	depth := 0
+	for gindex := range t {
+		depth = max(depth, bits.Len64(gindex)-1)
+	}
+	return depth
+}
+
+func (t merkleTree) node(gindex uint64, depth int) [32]byte {
+	if node, ok := t[gindex]; ok {
+		return node
+	}
+	if bits.Len64(gindex)-1 >= depth {
+		return [32]byte{}
+	}
+
+	left, right := t.node(2*gindex, depth), t.node(2*gindex+1, depth)
+	return sha256.Sum256(append(left[:], right[:]...))
+}
+
+func (t merkleTree) root() [32]byte {
+	return t.node(1, t.depth())
+}
+
+// branch returns the sibling nodes on the path from the node at gindex to the root.
+func (t merkleTree) branch(gindex uint64) [][]byte {
+	var branch [][]byte
+	for ; gindex > 1; gindex /= 2 {
+		sibling := t.node(gindex^1, t.depth())
+		branch = append(branch, sibling[:])
+	}
+	return branch
+}
+
+func sha256Hash(bz []byte) []byte {
+	hash := sha256.Sum256(bz)
+	return hash[:]
+}
+
+func sha256Array(bz []byte) [32]byte {
+	return sha256.Sum256(bz)
+}
@@ -0,0 +1,39 @@
+package ethereum
+
+import (
+	commitmenttypesv2 "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types/v2"
+)
+
+// This file is used to export unexported functions for testing.
+
+// DomainSyncCommittee is a wrapper around domainSyncCommittee to allow the function to be directly called in tests.
+var DomainSyncCommittee = domainSyncCommittee
+
+// ComputeDomain is a wrapper around computeDomain to allow the function to be directly called in tests.
+func ComputeDomain(domainType [4]byte, forkVersion, genesisValidatorsRoot []byte) [32]byte {
+	return computeDomain(domainType, forkVersion, genesisValidatorsRoot)
+}
+
+// ComputeSigningRoot is a wrapper around computeSigningRoot to allow the function to be directly called in tests.
+func ComputeSigningRoot(objectRoot, domain [32]byte) [32]byte {
+	return computeSigningRoot(objectRoot, domain)
+}
+
+// IsValidMerkleBranch is a wrapper around isValidMerkleBranch to allow the function to be directly called in tests.
+func IsValidMerkleBranch(leaf [32]byte, branch [][]byte, depth, index uint64, root [32]byte) bool {
+	return isValidMerkleBranch(leaf, branch, depth, index, root)
+}
+
+// VerifyMerklePatriciaProof is a wrapper around verifyMerklePatriciaProof to allow the function to be directly called in tests.
+func VerifyMerklePatriciaProof(root, key []byte, proof [][]byte) ([]byte, error) {
+	return verifyMerklePatriciaProof(root, key, proof)
+}
+
+// CommitmentStorageSlot is a wrapper around ClientState.commitmentStorageSlot to allow the function to be directly called in tests.
+func (cs ClientState) CommitmentStorageSlot(path []byte) []byte {
+	slot, err := cs.commitmentStorageSlot(commitmenttypesv2.NewMerklePath(path))
+	if err != nil {
+		panic(err)
+	}
+	return slot
+}
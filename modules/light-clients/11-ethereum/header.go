@@ -0,0 +1,166 @@
+package ethereum
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+var _ exported.ClientMessage = (*Header)(nil)
+
+// maxUint256 is the maximum value of an unsigned 256 bit integer
+var maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+// ClientType defines that the Header is an ethereum sync committee update
+func (Header) ClientType() string {
+	return ModuleName
+}
+
+// GetHeight returns the height of the finalized beacon slot of the header.
+func (h Header) GetHeight() exported.Height {
+	return clienttypes.NewHeight(0, h.ConsensusUpdate.FinalizedHeader.Beacon.Slot)
+}
+
+// ValidateBasic ensures that the trusted sync committee, the light client update and the
+// account proof are well formed. The header is verified against the client state and the
+// trusted consensus state in VerifyClientMessage.
+func (h Header) ValidateBasic() error {
+	if h.TrustedSyncCommittee.TrustedHeight.IsZero() {
+		return errorsmod.Wrap(clienttypes.ErrInvalidHeader, "trusted height cannot be zero")
+	}
+	if h.TrustedSyncCommittee.TrustedHeight.RevisionNumber != 0 {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidHeader, "trusted height revision number must be zero, got %d", h.TrustedSyncCommittee.TrustedHeight.RevisionNumber)
+	}
+	if err := h.TrustedSyncCommittee.SyncCommittee.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "invalid trusted sync committee")
+	}
+
+	if err := h.ConsensusUpdate.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if h.TrustedSyncCommittee.TrustedHeight.RevisionHeight >= h.ConsensusUpdate.FinalizedHeader.Beacon.Slot {
+		return errorsmod.Wrapf(ErrInvalidHeader, "trusted slot %d must be less than finalized slot %d",
+			h.TrustedSyncCommittee.TrustedHeight.RevisionHeight, h.ConsensusUpdate.FinalizedHeader.Beacon.Slot)
+	}
+
+	if len(h.AccountProof.Proof) == 0 {
+		return errorsmod.Wrap(ErrInvalidProof, "account proof cannot be empty")
+	}
+	if len(h.AccountProof.StorageRoot) != rootSize {
+		return errorsmod.Wrapf(ErrInvalidProof, "account storage root must be %d bytes, got %d", rootSize, len(h.AccountProof.StorageRoot))
+	}
+
+	return nil
+}
+
+// ValidateBasic ensures that the sync committee public keys are well formed.
+func (sc SyncCommittee) ValidateBasic() error {
+	if len(sc.Pubkeys) == 0 {
+		return errorsmod.Wrap(ErrInvalidSyncCommittee, "sync committee public keys cannot be empty")
+	}
+	for i, pubkey := range sc.Pubkeys {
+		if len(pubkey) != pubkeySize {
+			return errorsmod.Wrapf(ErrInvalidSyncCommittee, "public key at index %d must be %d bytes, got %d", i, pubkeySize, len(pubkey))
+		}
+	}
+	if len(sc.AggregatePubkey) != pubkeySize {
+		return errorsmod.Wrapf(ErrInvalidSyncCommittee, "aggregate public key must be %d bytes, got %d", pubkeySize, len(sc.AggregatePubkey))
+	}
+
+	return nil
+}
+
+// ValidateBasic ensures that the light client update is well formed and that its slots are ordered.
+func (u LightClientUpdate) ValidateBasic() error {
+	if err := u.AttestedHeader.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "invalid attested header")
+	}
+	if err := u.FinalizedHeader.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "invalid finalized header")
+	}
+
+	if u.FinalizedHeader.Beacon.Slot == 0 {
+		return errorsmod.Wrap(ErrInvalidHeader, "finalized slot cannot be zero")
+	}
+	if u.AttestedHeader.Beacon.Slot < u.FinalizedHeader.Beacon.Slot {
+		return errorsmod.Wrapf(ErrInvalidHeader, "attested slot %d cannot be less than finalized slot %d", u.AttestedHeader.Beacon.Slot, u.FinalizedHeader.Beacon.Slot)
+	}
+	if u.SignatureSlot <= u.AttestedHeader.Beacon.Slot {
+		return errorsmod.Wrapf(ErrInvalidHeader, "signature slot %d must be greater than attested slot %d", u.SignatureSlot, u.AttestedHeader.Beacon.Slot)
+	}
+	if len(u.FinalityBranch) == 0 {
+		return errorsmod.Wrap(ErrInvalidMerkleBranch, "finality branch cannot be empty")
+	}
+
+	if u.NextSyncCommittee != nil {
+		if err := u.NextSyncCommittee.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "invalid next sync committee")
+		}
+		if len(u.NextSyncCommitteeBranch) == 0 {
+			return errorsmod.Wrap(ErrInvalidMerkleBranch, "next sync committee branch cannot be empty")
+		}
+	} else if len(u.NextSyncCommitteeBranch) != 0 {
+		return errorsmod.Wrap(ErrInvalidMerkleBranch, "next sync committee branch must be empty if no next sync committee is provided")
+	}
+
+	if len(u.SyncAggregate.SyncCommitteeBits) == 0 {
+		return errorsmod.Wrap(ErrInvalidHeader, "sync committee bits cannot be empty")
+	}
+	if len(u.SyncAggregate.SyncCommitteeSignature) != signatureSize {
+		return errorsmod.Wrapf(ErrInvalidHeader, "sync committee signature must be %d bytes, got %d", signatureSize, len(u.SyncAggregate.SyncCommitteeSignature))
+	}
+
+	return nil
+}
+
+// ValidateBasic ensures that the beacon block header and the execution payload header are well formed.
+func (h LightClientHeader) ValidateBasic() error {
+	beacon := h.Beacon
+	for name, root := range map[string][]byte{
+		"parent root": beacon.ParentRoot,
+		"state root":  beacon.StateRoot,
+		"body root":   beacon.BodyRoot,
+	} {
+		if len(root) != rootSize {
+			return errorsmod.Wrapf(ErrInvalidHeader, "beacon %s must be %d bytes, got %d", name, rootSize, len(root))
+		}
+	}
+
+	execution := h.Execution
+	for name, root := range map[string][]byte{
+		"parent hash":       execution.ParentHash,
+		"state root":        execution.StateRoot,
+		"receipts root":     execution.ReceiptsRoot,
+		"prev randao":       execution.PrevRandao,
+		"block hash":        execution.BlockHash,
+		"transactions root": execution.TransactionsRoot,
+		"withdrawals root":  execution.WithdrawalsRoot,
+	} {
+		if len(root) != rootSize {
+			return errorsmod.Wrapf(ErrInvalidHeader, "execution %s must be %d bytes, got %d", name, rootSize, len(root))
+		}
+	}
+	if len(execution.FeeRecipient) != addressSize {
+		return errorsmod.Wrapf(ErrInvalidHeader, "execution fee recipient must be %d bytes, got %d", addressSize, len(execution.FeeRecipient))
+	}
+	if len(execution.LogsBloom) != logsBloomSize {
+		return errorsmod.Wrapf(ErrInvalidHeader, "execution logs bloom must be %d bytes, got %d", logsBloomSize, len(execution.LogsBloom))
+	}
+	if len(execution.ExtraData) > maxExtraDataBytes {
+		return errorsmod.Wrapf(ErrInvalidHeader, "execution extra data cannot exceed %d bytes, got %d", maxExtraDataBytes, len(execution.ExtraData))
+	}
+	baseFeePerGas, ok := new(big.Int).SetString(execution.BaseFeePerGas, 10)
+	if !ok || baseFeePerGas.Sign() < 0 || baseFeePerGas.Cmp(maxUint256) > 0 {
+		return errorsmod.Wrapf(ErrInvalidHeader, "execution base fee per gas must be a uint256 decimal string, got %s", execution.BaseFeePerGas)
+	}
+
+	if len(h.ExecutionBranch) != executionPayloadDepth {
+		return errorsmod.Wrapf(ErrInvalidMerkleBranch, "execution branch must have %d nodes, got %d", executionPayloadDepth, len(h.ExecutionBranch))
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package ethereum_test
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	ethereum "github.com/cosmos/ibc-go/v10/modules/light-clients/11-ethereum"
+)
+
+func (suite *EthereumTestSuite) TestHeaderValidateBasic() {
+	var header *ethereum.Header
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{"valid header", func() {}, nil},
+		{"valid header without next sync committee", func() {
+			header.ConsensusUpdate.NextSyncCommittee = nil
+			header.ConsensusUpdate.NextSyncCommitteeBranch = nil
+		}, nil},
+		{"zero trusted height", func() { header.TrustedSyncCommittee.TrustedHeight = clienttypes.ZeroHeight() }, clienttypes.ErrInvalidHeader},
+		{"non-zero trusted revision number", func() { header.TrustedSyncCommittee.TrustedHeight.RevisionNumber = 1 }, clienttypes.ErrInvalidHeader},
+		{"invalid trusted sync committee public key", func() {
+			pubkeys := append([][]byte{}, header.TrustedSyncCommittee.SyncCommittee.Pubkeys...)
+			pubkeys[3] = []byte{0x01}
+			header.TrustedSyncCommittee.SyncCommittee.Pubkeys = pubkeys
+		}, ethereum.ErrInvalidSyncCommittee},
+		{"empty trusted sync committee", func() { header.TrustedSyncCommittee.SyncCommittee.Pubkeys = nil }, ethereum.ErrInvalidSyncCommittee},
+		{"invalid aggregate public key", func() { header.TrustedSyncCommittee.SyncCommittee.AggregatePubkey = nil }, ethereum.ErrInvalidSyncCommittee},
+		{"invalid beacon state root", func() { header.ConsensusUpdate.AttestedHeader.Beacon.StateRoot = nil }, ethereum.ErrInvalidHeader},
+		{"invalid execution fee recipient", func() { header.ConsensusUpdate.FinalizedHeader.Execution.FeeRecipient = nil }, ethereum.ErrInvalidHeader},
+		{"invalid execution logs bloom", func() { header.ConsensusUpdate.FinalizedHeader.Execution.LogsBloom = nil }, ethereum.ErrInvalidHeader},
+		{"execution extra data too long", func() { header.ConsensusUpdate.FinalizedHeader.Execution.ExtraData = make([]byte, 33) }, ethereum.ErrInvalidHeader},
+		{"invalid execution base fee", func() { header.ConsensusUpdate.FinalizedHeader.Execution.BaseFeePerGas = "-1" }, ethereum.ErrInvalidHeader},
+		{"invalid execution branch length", func() { header.ConsensusUpdate.FinalizedHeader.ExecutionBranch = nil }, ethereum.ErrInvalidMerkleBranch},
+		{"attested slot before finalized slot", func() { header.ConsensusUpdate.AttestedHeader.Beacon.Slot = trustedSlot + 63 }, ethereum.ErrInvalidHeader},
+		{"signature slot not after attested slot", func() { header.ConsensusUpdate.SignatureSlot = trustedSlot + 96 }, ethereum.ErrInvalidHeader},
+		{"empty finality branch", func() { header.ConsensusUpdate.FinalityBranch = nil }, ethereum.ErrInvalidMerkleBranch},
+		{"next sync committee without branch", func() { header.ConsensusUpdate.NextSyncCommitteeBranch = nil }, ethereum.ErrInvalidMerkleBranch},
+		{"next sync committee branch without next sync committee", func() { header.ConsensusUpdate.NextSyncCommittee = nil }, ethereum.ErrInvalidMerkleBranch},
+		{"empty sync committee bits", func() { header.ConsensusUpdate.SyncAggregate.SyncCommitteeBits = nil }, ethereum.ErrInvalidHeader},
+		{"invalid sync committee signature", func() { header.ConsensusUpdate.SyncAggregate.SyncCommitteeSignature = nil }, ethereum.ErrInvalidHeader},
+		{"trusted slot not before finalized slot", func() { header.TrustedSyncCommittee.TrustedHeight = clienttypes.NewHeight(0, trustedSlot+64) }, ethereum.ErrInvalidHeader},
+		{"empty account proof", func() { header.AccountProof.Proof = nil }, ethereum.ErrInvalidProof},
+		{"invalid account storage root", func() { header.AccountProof.StorageRoot = nil }, ethereum.ErrInvalidProof},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			header = suite.newHeader(trustedSlot+64, trustedSlot+96, trustedSlot+97, true)
+
+			tc.malleate()
+
+			err := header.ValidateBasic()
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(clienttypes.NewHeight(0, trustedSlot+64), header.GetHeight())
+				suite.Require().Equal(ethereum.ModuleName, header.ClientType())
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *EthereumTestSuite) TestConsensusStateValidateBasic() {
+	var consensusState *ethereum.ConsensusState
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{"valid consensus state", func() {}, nil},
+		{"valid consensus state without next sync committee", func() { consensusState.NextSyncCommittee = nil }, nil},
+		{"zero slot", func() { consensusState.Slot = 0 }, clienttypes.ErrInvalidConsensus},
+		{"invalid state root", func() { consensusState.StateRoot = nil }, clienttypes.ErrInvalidConsensus},
+		{"invalid storage root", func() { consensusState.StorageRoot = []byte{0x01} }, clienttypes.ErrInvalidConsensus},
+		{"zero timestamp", func() { consensusState.Timestamp = 0 }, clienttypes.ErrInvalidConsensus},
+		{"invalid current sync committee", func() { consensusState.CurrentSyncCommittee = nil }, clienttypes.ErrInvalidConsensus},
+		{"invalid next sync committee", func() { consensusState.NextSyncCommittee = []byte{0x01} }, clienttypes.ErrInvalidConsensus},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			consensusState = suite.newConsensusState()
+
+			tc.malleate()
+
+			err := consensusState.ValidateBasic()
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(consensusState.Timestamp*1e9, consensusState.GetTimestamp())
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,5 @@
+package ethereum
+
+const (
+	ModuleName = "11-ethereum"
+)
@@ -0,0 +1,501 @@
+package ethereum_test
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	commitmenttypesv2 "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types/v2"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+	ethereum "github.com/cosmos/ibc-go/v10/modules/light-clients/11-ethereum"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+	ibcmock "github.com/cosmos/ibc-go/v10/testing/mock"
+)
+
+var ethClientID = clienttypes.FormatClientIdentifier(ethereum.ModuleName, 100)
+
+func (suite *EthereumTestSuite) TestInitialize() {
+	var (
+		clientState    *ethereum.ClientState
+		consensusState *ethereum.ConsensusState
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"invalid client state",
+			func() {
+				clientState.ChainId = 0
+			},
+			ethereum.ErrInvalidChainID,
+		},
+		{
+			"invalid consensus state",
+			func() {
+				consensusState.StateRoot = nil
+			},
+			clienttypes.ErrInvalidConsensus,
+		},
+		{
+			"consensus state slot does not match the latest slot",
+			func() {
+				clientState.LatestSlot = trustedSlot + 1
+			},
+			clienttypes.ErrInvalidConsensus,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			clientState = suite.newClientState(trustedSlot)
+			consensusState = suite.newConsensusState()
+
+			clientID := suite.chainA.App.GetIBCKeeper().ClientKeeper.GenerateClientIdentifier(suite.chainA.GetContext(), ethereum.ModuleName)
+
+			lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), clientID)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			clientStateBz := suite.chainA.Codec.MustMarshal(clientState)
+			consensusStateBz := suite.chainA.Codec.MustMarshal(consensusState)
+
+			err = lightClientModule.Initialize(suite.chainA.GetContext(), clientID, clientStateBz, consensusStateBz)
+
+			store := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), clientID)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().True(store.Has(host.ClientStateKey()))
+				suite.Require().True(store.Has(host.ConsensusStateKey(clienttypes.NewHeight(0, trustedSlot))))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().False(store.Has(host.ClientStateKey()))
+			}
+		})
+	}
+}
+
+func (suite *EthereumTestSuite) TestStatus() {
+	var clientID string
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expStatus exported.Status
+	}{
+		{
+			"client is active",
+			func() {},
+			exported.Active,
+		},
+		{
+			"client is active in the period following the latest consensus state",
+			func() {
+				suite.coordinator.IncrementTimeBy(periodDuration)
+			},
+			exported.Active,
+		},
+		{
+			"client is expired",
+			func() {
+				suite.coordinator.IncrementTimeBy(2 * periodDuration)
+			},
+			exported.Expired,
+		},
+		{
+			"client is frozen",
+			func() {
+				clientState, ok := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientState(suite.chainA.GetContext(), clientID)
+				suite.Require().True(ok)
+
+				clientState.(*ethereum.ClientState).IsFrozen = true
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), clientID, clientState)
+			},
+			exported.Frozen,
+		},
+		{
+			"client state not found",
+			func() {
+				clientID = ethClientID
+			},
+			exported.Unknown,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			clientID = suite.createClient()
+
+			tc.malleate()
+
+			lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), clientID)
+			suite.Require().NoError(err)
+
+			status := lightClientModule.Status(suite.chainA.GetContext(), clientID)
+			suite.Require().Equal(tc.expStatus, status)
+		})
+	}
+}
+
+func (suite *EthereumTestSuite) TestLatestHeightAndTimestampAtHeight() {
+	clientID := suite.createClient()
+
+	lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), clientID)
+	suite.Require().NoError(err)
+
+	height := lightClientModule.LatestHeight(suite.chainA.GetContext(), clientID)
+	suite.Require().Equal(clienttypes.NewHeight(0, trustedSlot), height)
+
+	timestamp, err := lightClientModule.TimestampAtHeight(suite.chainA.GetContext(), clientID, height)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.newConsensusState().GetTimestamp(), timestamp)
+
+	_, err = lightClientModule.TimestampAtHeight(suite.chainA.GetContext(), clientID, clienttypes.NewHeight(0, trustedSlot-1))
+	suite.Require().ErrorIs(err, clienttypes.ErrConsensusStateNotFound)
+
+	height = lightClientModule.LatestHeight(suite.chainA.GetContext(), ethClientID)
+	suite.Require().True(height.IsZero())
+}
+
+func (suite *EthereumTestSuite) TestVerifyMembership() {
+	var (
+		clientID     string
+		height       exported.Height
+		storageProof ethereum.StorageProof
+		proof        []byte
+		path         exported.Path
+		value        []byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"client state not found",
+			func() {
+				clientID = ethClientID
+			},
+			clienttypes.ErrClientNotFound,
+		},
+		{
+			"proof height is greater than the latest height",
+			func() {
+				height = clienttypes.NewHeight(0, trustedSlot+1)
+			},
+			ibcerrors.ErrInvalidHeight,
+		},
+		{
+			"consensus state not found",
+			func() {
+				height = clienttypes.NewHeight(0, trustedSlot-1)
+			},
+			clienttypes.ErrConsensusStateNotFound,
+		},
+		{
+			"invalid value length",
+			func() {
+				value = []byte("value")
+			},
+			ethereum.ErrInvalidProof,
+		},
+		{
+			"value does not match the storage proof value",
+			func() {
+				value = sha256Hash([]byte("other commitment"))
+			},
+			ethereum.ErrInvalidProof,
+		},
+		{
+			"stored value does not match the value",
+			func() {
+				value = sha256Hash([]byte("other commitment"))
+				storageProof.Value = value
+				proof = suite.chainA.Codec.MustMarshal(&storageProof)
+			},
+			ethereum.ErrInvalidProof,
+		},
+		{
+			"failed to unmarshal the proof",
+			func() {
+				proof = []byte("invalid proof")
+			},
+			ethereum.ErrInvalidProof,
+		},
+		{
+			"invalid path type",
+			func() {
+				path = ibcmock.KeyPath{}
+			},
+			ibcerrors.ErrInvalidType,
+		},
+		{
+			"empty path",
+			func() {
+				path = commitmenttypesv2.NewMerklePath()
+			},
+			ethereum.ErrInvalidPath,
+		},
+		{
+			"storage proof key does not match the commitment storage slot",
+			func() {
+				path = commitmenttypesv2.NewMerklePath([]byte("other path"))
+			},
+			ethereum.ErrInvalidProof,
+		},
+		{
+			"invalid storage proof",
+			func() {
+				storageProof.Proof = [][]byte{[]byte("invalid node")}
+				proof = suite.chainA.Codec.MustMarshal(&storageProof)
+			},
+			ethereum.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			clientID = suite.createClient()
+			height = clienttypes.NewHeight(0, trustedSlot)
+			_, storageProof = suite.storageTrie()
+			proof = suite.chainA.Codec.MustMarshal(&storageProof)
+			path = commitmenttypesv2.NewMerklePath(commitmentPath)
+			value = commitmentValue
+
+			lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), clientID)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			err = lightClientModule.VerifyMembership(suite.chainA.GetContext(), clientID, height, 0, 0, proof, path, value)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *EthereumTestSuite) TestVerifyNonMembership() {
+	var (
+		clientID     string
+		height       exported.Height
+		storageProof ethereum.StorageProof
+		proof        []byte
+		path         exported.Path
+	)
+
+	absentPath := []byte("07-tendermint-0\x01\x00\x00\x00\x00\x00\x00\x00\x02")
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"client state not found",
+			func() {
+				clientID = ethClientID
+			},
+			clienttypes.ErrClientNotFound,
+		},
+		{
+			"proof height is greater than the latest height",
+			func() {
+				height = clienttypes.NewHeight(0, trustedSlot+1)
+			},
+			ibcerrors.ErrInvalidHeight,
+		},
+		{
+			"storage proof value is not empty",
+			func() {
+				storageProof.Value = commitmentValue
+				proof = suite.chainA.Codec.MustMarshal(&storageProof)
+			},
+			ethereum.ErrInvalidProof,
+		},
+		{
+			"value is stored under the path",
+			func() {
+				_, storageProof = suite.storageTrie()
+				storageProof.Value = nil
+				proof = suite.chainA.Codec.MustMarshal(&storageProof)
+				path = commitmenttypesv2.NewMerklePath(commitmentPath)
+			},
+			ethereum.ErrInvalidProof,
+		},
+		{
+			"storage proof key does not match the commitment storage slot",
+			func() {
+				path = commitmenttypesv2.NewMerklePath(commitmentPath)
+			},
+			ethereum.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			clientID = suite.createClient()
+			height = clienttypes.NewHeight(0, trustedSlot)
+
+			// the storage trie only contains the commitment at commitmentPath, so that the proof of the
+			// commitment leaf proves the absence of any other key
+			_, storageProof = suite.storageTrie()
+			storageProof.Key = ethereum.ClientState{IbcCommitmentSlot: ibcCommitmentSlot}.CommitmentStorageSlot(absentPath)
+			storageProof.Value = nil
+			proof = suite.chainA.Codec.MustMarshal(&storageProof)
+			path = commitmenttypesv2.NewMerklePath(absentPath)
+
+			lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), clientID)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			err = lightClientModule.VerifyNonMembership(suite.chainA.GetContext(), clientID, height, 0, 0, proof, path)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *EthereumTestSuite) TestRecoverClient() {
+	var (
+		subjectClientID, substituteClientID string
+		substituteClientState               *ethereum.ClientState
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"cannot parse substitute client identifier",
+			func() {
+				substituteClientID = "invalid-client-id"
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"substitute client is not an ethereum client",
+			func() {
+				substituteClientID = ibctesting.FirstClientID
+			},
+			clienttypes.ErrInvalidClientType,
+		},
+		{
+			"subject client state not found",
+			func() {
+				subjectClientID = ethClientID
+			},
+			clienttypes.ErrClientNotFound,
+		},
+		{
+			"substitute client state not found",
+			func() {
+				substituteClientID = ethClientID
+			},
+			clienttypes.ErrClientNotFound,
+		},
+		{
+			"subject and substitute client states do not match",
+			func() {
+				substituteClientState.ChainId = 2
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), substituteClientID, substituteClientState)
+			},
+			clienttypes.ErrInvalidSubstitute,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			subjectClientID = suite.createClient()
+
+			clientState, ok := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientState(suite.chainA.GetContext(), subjectClientID)
+			suite.Require().True(ok)
+			clientState.(*ethereum.ClientState).IsFrozen = true
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), subjectClientID, clientState)
+
+			// the substitute schedules the electra fork and requires all members of the sync committee to participate
+			suite.forkParameters = newForkParameters(farFutureEpoch - 1)
+			substituteClientID = suite.createClient()
+
+			substituteClient, ok := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientState(suite.chainA.GetContext(), substituteClientID)
+			suite.Require().True(ok)
+			substituteClientState = substituteClient.(*ethereum.ClientState)
+			substituteClientState.MinSyncCommitteeParticipants = syncCommitteeSize
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), substituteClientID, substituteClientState)
+
+			lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), subjectClientID)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			err = lightClientModule.RecoverClient(suite.chainA.GetContext(), subjectClientID, substituteClientID)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				clientState, ok := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientState(suite.chainA.GetContext(), subjectClientID)
+				suite.Require().True(ok)
+
+				ethClientState := clientState.(*ethereum.ClientState)
+				suite.Require().False(ethClientState.IsFrozen)
+				suite.Require().Equal(substituteClientState.ForkParameters, ethClientState.ForkParameters)
+				suite.Require().Equal(uint64(syncCommitteeSize), ethClientState.MinSyncCommitteeParticipants)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *EthereumTestSuite) TestVerifyUpgradeAndUpdateState() {
+	clientID := suite.createClient()
+
+	lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), clientID)
+	suite.Require().NoError(err)
+
+	err = lightClientModule.VerifyUpgradeAndUpdateState(suite.chainA.GetContext(), clientID, nil, nil, nil, nil)
+	suite.Require().ErrorIs(err, clienttypes.ErrInvalidUpgradeClient)
+}
@@ -0,0 +1,259 @@
+package ethereum
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+/*
+This file contains the verification of Merkle Patricia Trie proofs as returned by the
+eth_getProof JSON-RPC method (https://eips.ethereum.org/EIPS/eip-1186). The account proof
+proves the IBC contract account in the execution state trie and the storage proofs prove
+the IBC commitments in the storage trie of the IBC contract.
+*/
+
+// emptyTrieRoot is the root hash of an empty Merkle Patricia Trie
+var emptyTrieRoot = crypto.Keccak256(rlp.EmptyString)
+
+// stateAccount is the RLP encoded account stored in the execution state trie.
+type stateAccount struct {
+	Nonce    uint64
+	Balance  *big.Int
+	Root     []byte
+	CodeHash []byte
+}
+
+// verifyAccountStorageRoot verifies the proof of the account at the address against the state root
+// and returns the storage root of the account.
+func verifyAccountStorageRoot(stateRoot, address []byte, proof [][]byte) ([]byte, error) {
+	value, err := verifyMerklePatriciaProof(stateRoot, crypto.Keccak256(address), proof)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to verify account proof")
+	}
+	if value == nil {
+		return nil, errorsmod.Wrapf(ErrInvalidProof, "account %X does not exist", address)
+	}
+
+	var account stateAccount
+	if err := rlp.DecodeBytes(value, &account); err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidProof, "failed to decode account: %s", err)
+	}
+
+	return account.Root, nil
+}
+
+// verifyStorageProof verifies the proof of the storage slot against the storage root and returns
+// the 32 byte word stored in the slot, or nil if the slot is empty.
+func verifyStorageProof(storageRoot, slot []byte, proof [][]byte) ([]byte, error) {
+	value, err := verifyMerklePatriciaProof(storageRoot, crypto.Keccak256(slot), proof)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to verify storage proof")
+	}
+	if value == nil {
+		return nil, nil
+	}
+
+	var word []byte
+	if err := rlp.DecodeBytes(value, &word); err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidProof, "failed to decode storage value: %s", err)
+	}
+	if len(word) > rootSize {
+		return nil, errorsmod.Wrapf(ErrInvalidProof, "storage value cannot exceed %d bytes, got %d", rootSize, len(word))
+	}
+
+	padded := make([]byte, rootSize)
+	copy(padded[rootSize-len(word):], word)
+	return padded, nil
+}
+
+// verifyMerklePatriciaProof verifies the proof of the key in the trie with the given root. The proof
+// contains the RLP encoded nodes on the path from the root to the key. It returns the value stored
+// under the key, or nil if the proof shows that the key is absent from the trie.
+func verifyMerklePatriciaProof(root, key []byte, proof [][]byte) ([]byte, error) {
+	if len(proof) == 0 {
+		if bytes.Equal(root, emptyTrieRoot) {
+			return nil, nil
+		}
+		return nil, errorsmod.Wrap(ErrInvalidProof, "proof cannot be empty for a non-empty trie")
+	}
+
+	nibbles := keyToNibbles(key)
+	expectedHash := root
+	for i, encoded := range proof {
+		if !bytes.Equal(crypto.Keccak256(encoded), expectedHash) {
+			return nil, errorsmod.Wrapf(ErrInvalidProof, "hash of proof node %d does not match its reference", i)
+		}
+
+		node := encoded
+		for {
+			var (
+				next step
+				err  error
+			)
+			next, nibbles, err = walkNode(node, nibbles)
+			if err != nil {
+				return nil, errorsmod.Wrapf(err, "proof node %d", i)
+			}
+
+			if next.embedded != nil {
+				node = next.embedded
+				continue
+			}
+
+			if next.hash != nil {
+				expectedHash = next.hash
+				break
+			}
+
+			if i != len(proof)-1 {
+				return nil, errorsmod.Wrapf(ErrInvalidProof, "proof contains %d unused nodes", len(proof)-1-i)
+			}
+
+			return next.value, nil
+		}
+	}
+
+	return nil, errorsmod.Wrap(ErrInvalidProof, "proof is incomplete")
+}
+
+// step is the result of walking a single trie node. Exactly one of the fields is set, unless the
+// key is absent from the trie in which case all fields are nil.
+type step struct {
+	// hash is the reference to the next node in the proof
+	hash []byte
+	// embedded is the next node, embedded in the current node
+	embedded []byte
+	// value is the value stored under the key
+	value []byte
+}
+
+// walkNode walks the RLP encoded trie node along the key nibbles and returns the next step along
+// with the remaining key nibbles.
+func walkNode(node []byte, nibbles []byte) (step, []byte, error) {
+	var items []rlp.RawValue
+	if err := rlp.DecodeBytes(node, &items); err != nil {
+		return step{}, nil, errorsmod.Wrapf(ErrInvalidProof, "failed to decode trie node: %s", err)
+	}
+
+	switch len(items) {
+	case 17:
+		// branch node
+		if len(nibbles) == 0 {
+			value, err := decodeString(items[16])
+			if err != nil {
+				return step{}, nil, err
+			}
+			if len(value) == 0 {
+				return step{}, nil, nil
+			}
+			return step{value: value}, nil, nil
+		}
+
+		next, err := childReference(items[nibbles[0]])
+		return next, nibbles[1:], err
+	case 2:
+		// extension or leaf node
+		encodedPath, err := decodeString(items[0])
+		if err != nil {
+			return step{}, nil, err
+		}
+		path, isLeaf, err := decodeCompactPath(encodedPath)
+		if err != nil {
+			return step{}, nil, err
+		}
+
+		if isLeaf {
+			if !bytes.Equal(path, nibbles) {
+				return step{}, nil, nil
+			}
+			value, err := decodeString(items[1])
+			if err != nil {
+				return step{}, nil, err
+			}
+			return step{value: value}, nil, nil
+		}
+
+		if !bytes.HasPrefix(nibbles, path) {
+			return step{}, nil, nil
+		}
+
+		next, err := childReference(items[1])
+		return next, nibbles[len(path):], err
+	default:
+		return step{}, nil, errorsmod.Wrapf(ErrInvalidProof, "invalid trie node with %d items", len(items))
+	}
+}
+
+// childReference decodes a reference to a child node, which is either the hash of the child node,
+// the child node itself if its encoding is shorter than 32 bytes, or empty if there is no child.
+func childReference(item rlp.RawValue) (step, error) {
+	kind, content, _, err := rlp.Split(item)
+	if err != nil {
+		return step{}, errorsmod.Wrapf(ErrInvalidProof, "failed to decode child reference: %s", err)
+	}
+
+	switch {
+	case kind == rlp.List:
+		return step{embedded: item}, nil
+	case len(content) == 0:
+		return step{}, nil
+	case len(content) == rootSize:
+		return step{hash: content}, nil
+	default:
+		return step{}, errorsmod.Wrapf(ErrInvalidProof, "invalid child reference of %d bytes", len(content))
+	}
+}
+
+// decodeString decodes an RLP string item.
+func decodeString(item rlp.RawValue) ([]byte, error) {
+	kind, content, _, err := rlp.Split(item)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidProof, "failed to decode trie node item: %s", err)
+	}
+	if kind == rlp.List {
+		return nil, errorsmod.Wrap(ErrInvalidProof, "expected trie node item to be a string")
+	}
+
+	return content, nil
+}
+
+// decodeCompactPath decodes the hex prefix encoded path of an extension or leaf node and returns
+// the path nibbles and whether the node is a leaf.
+func decodeCompactPath(encoded []byte) ([]byte, bool, error) {
+	if len(encoded) == 0 {
+		return nil, false, errorsmod.Wrap(ErrInvalidProof, "trie node path cannot be empty")
+	}
+
+	nibbles := keyToNibbles(encoded)
+	flag := nibbles[0]
+	if flag > 3 {
+		return nil, false, errorsmod.Wrapf(ErrInvalidProof, "invalid trie node path flag %d", flag)
+	}
+
+	isLeaf := flag&2 != 0
+	if flag&1 == 1 {
+		// odd length paths store the first nibble alongside the flag
+		return nibbles[1:], isLeaf, nil
+	}
+
+	if nibbles[1] != 0 {
+		return nil, false, errorsmod.Wrap(ErrInvalidProof, "invalid trie node path padding")
+	}
+
+	return nibbles[2:], isLeaf, nil
+}
+
+// keyToNibbles splits the bytes of the key into nibbles.
+func keyToNibbles(key []byte) []byte {
+	nibbles := make([]byte, 2*len(key))
+	for i, b := range key {
+		nibbles[2*i] = b >> 4
+		nibbles[2*i+1] = b & 0x0f
+	}
+	return nibbles
+}
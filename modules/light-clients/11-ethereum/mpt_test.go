@@ -0,0 +1,115 @@
+package ethereum_test
+
+import (
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	ethereum "github.com/cosmos/ibc-go/v10/modules/light-clients/11-ethereum"
+)
+
+func (suite *EthereumTestSuite) TestVerifyMerklePatriciaProof() {
+	// keyA and keyB share the first two nibbles, keyC diverges at the first nibble
+	keyA := append([]byte{0xaa, 0xb0}, make([]byte, 30)...)
+	keyB := append([]byte{0xaa, 0xc0}, make([]byte, 30)...)
+	keyC := append([]byte{0x50}, make([]byte, 31)...)
+
+	// the leaves of keyA and keyB store the remaining 61 nibbles, the leaf of keyC the remaining 63
+	leafA := suite.encode([]any{append([]byte{0x30}, make([]byte, 30)...), []byte("value a")})
+	leafB := suite.encode([]any{append([]byte{0x30}, make([]byte, 30)...), []byte("value b")})
+	leafC := suite.encode([]any{append([]byte{0x30}, make([]byte, 31)...), []byte("value c")})
+
+	branchItems := make([]any, 17)
+	for i := range branchItems {
+		branchItems[i] = []byte{}
+	}
+	branchItems[0xb] = crypto.Keccak256(leafA)
+	branchItems[0xc] = crypto.Keccak256(leafB)
+	branch := suite.encode(branchItems)
+
+	// the extension stores the shared odd length path of a single nibble
+	extension := suite.encode([]any{[]byte{0x1a}, crypto.Keccak256(branch)})
+
+	rootItems := make([]any, 17)
+	for i := range rootItems {
+		rootItems[i] = []byte{}
+	}
+	rootItems[0xa] = crypto.Keccak256(extension)
+	rootItems[0x5] = crypto.Keccak256(leafC)
+	rootNode := suite.encode(rootItems)
+	root := crypto.Keccak256(rootNode)
+
+	testCases := []struct {
+		name     string
+		root     []byte
+		key      []byte
+		proof    [][]byte
+		expValue []byte
+		expErr   error
+	}{
+		{"success: membership through extension and branch", root, keyA, [][]byte{rootNode, extension, branch, leafA}, []byte("value a"), nil},
+		{"success: membership of sibling leaf", root, keyB, [][]byte{rootNode, extension, branch, leafB}, []byte("value b"), nil},
+		{"success: membership of leaf below root", root, keyC, [][]byte{rootNode, leafC}, []byte("value c"), nil},
+		{"success: absence at empty branch child", root, append([]byte{0xaa, 0xd0}, make([]byte, 30)...), [][]byte{rootNode, extension, branch}, nil, nil},
+		{"success: absence at diverging extension", root, append([]byte{0xab}, make([]byte, 31)...), [][]byte{rootNode, extension}, nil, nil},
+		{"success: absence at diverging leaf", root, append([]byte{0x51}, make([]byte, 31)...), [][]byte{rootNode, leafC}, nil, nil},
+		{"success: absence at empty root child", root, append([]byte{0x60}, make([]byte, 31)...), [][]byte{rootNode}, nil, nil},
+		{"success: absence in empty trie", crypto.Keccak256(rlp.EmptyString), keyA, nil, nil, nil},
+		{"failure: empty proof for non-empty trie", root, keyA, nil, nil, ethereum.ErrInvalidProof},
+		{"failure: root hash mismatch", crypto.Keccak256([]byte("invalid")), keyA, [][]byte{rootNode, extension, branch, leafA}, nil, ethereum.ErrInvalidProof},
+		{"failure: node hash mismatch", root, keyA, [][]byte{rootNode, extension, branch, leafB}, nil, ethereum.ErrInvalidProof},
+		{"failure: incomplete proof", root, keyA, [][]byte{rootNode, extension, branch}, nil, ethereum.ErrInvalidProof},
+		{"failure: unused proof nodes", root, keyC, [][]byte{rootNode, leafC, leafA}, nil, ethereum.ErrInvalidProof},
+		{"failure: invalid node encoding", crypto.Keccak256([]byte{0x01}), keyA, [][]byte{{0x01}}, nil, ethereum.ErrInvalidProof},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			value, err := ethereum.VerifyMerklePatriciaProof(tc.root, tc.key, tc.proof)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expValue, value)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *EthereumTestSuite) TestVerifyMerklePatriciaProofEmbeddedNodes() {
+	// a trie of the one byte keys 0x01 and 0x02, whose nodes are short enough to be embedded in their parent
+	leafA := rlp.RawValue(suite.encode([]any{[]byte{0x20}, []byte("a")}))
+	leafB := rlp.RawValue(suite.encode([]any{[]byte{0x20}, []byte("b")}))
+
+	branchItems := make([]any, 17)
+	for i := range branchItems {
+		branchItems[i] = []byte{}
+	}
+	branchItems[1] = leafA
+	branchItems[2] = leafB
+	branch := rlp.RawValue(suite.encode(branchItems))
+	suite.Require().Less(len(branch), 32)
+
+	// the extension stores the shared odd length path of the nibble 0
+	extension := suite.encode([]any{[]byte{0x10}, branch})
+	root := crypto.Keccak256(extension)
+
+	value, err := ethereum.VerifyMerklePatriciaProof(root, []byte{0x01}, [][]byte{extension})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]byte("a"), value)
+
+	value, err = ethereum.VerifyMerklePatriciaProof(root, []byte{0x02}, [][]byte{extension})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]byte("b"), value)
+
+	value, err = ethereum.VerifyMerklePatriciaProof(root, []byte{0x03}, [][]byte{extension})
+	suite.Require().NoError(err)
+	suite.Require().Nil(value)
+}
+
+// encode returns the RLP encoding of the value.
+func (suite *EthereumTestSuite) encode(value any) []byte {
+	bz, err := rlp.EncodeToBytes(value)
+	suite.Require().NoError(err)
+	return bz
+}
@@ -0,0 +1,143 @@
+package ethereum
+
+import (
+	"bytes"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	commitmenttypesv2 "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types/v2"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+// verifyMembership verifies a storage proof of the IBC contract that the commitment stored under the
+// given path at the specified height equals the provided value. The IBC contract stores commitments
+// in a mapping, so that the storage slot of a path is keccak256(keccak256(path) || commitmentSlot).
+// NOTE: delay periods are not supported and are ignored, as the execution state is only proven once finalized.
+func (cs ClientState) verifyMembership(
+	clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	proof []byte,
+	path exported.Path,
+	value []byte,
+) error {
+	if len(value) != rootSize {
+		return errorsmod.Wrapf(ErrInvalidProof, "value must be a %d byte commitment, got %d bytes", rootSize, len(value))
+	}
+
+	storageProof, consensusState, slot, err := cs.resolveStorageProof(clientStore, cdc, height, proof, path)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(storageProof.Value, value) {
+		return errorsmod.Wrapf(ErrInvalidProof, "storage proof value %X does not match the expected value %X", storageProof.Value, value)
+	}
+
+	storedValue, err := verifyStorageProof(consensusState.StorageRoot, slot, storageProof.Proof)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(storedValue, value) {
+		return errorsmod.Wrapf(ErrInvalidProof, "stored value %X does not match the expected value %X", storedValue, value)
+	}
+
+	return nil
+}
+
+// verifyNonMembership verifies a storage proof of the IBC contract that no commitment is stored under
+// the given path at the specified height.
+// NOTE: delay periods are not supported and are ignored, as the execution state is only proven once finalized.
+func (cs ClientState) verifyNonMembership(
+	clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	proof []byte,
+	path exported.Path,
+) error {
+	storageProof, consensusState, slot, err := cs.resolveStorageProof(clientStore, cdc, height, proof, path)
+	if err != nil {
+		return err
+	}
+
+	if len(storageProof.Value) != 0 {
+		return errorsmod.Wrapf(ErrInvalidProof, "storage proof value must be empty, got %X", storageProof.Value)
+	}
+
+	storedValue, err := verifyStorageProof(consensusState.StorageRoot, slot, storageProof.Proof)
+	if err != nil {
+		return err
+	}
+	if storedValue != nil {
+		return errorsmod.Wrapf(ErrInvalidProof, "expected no value to be stored, got %X", storedValue)
+	}
+
+	return nil
+}
+
+// resolveStorageProof unmarshals the storage proof, retrieves the consensus state at the proof height
+// and checks that the storage proof key is the storage slot of the commitment path.
+func (cs ClientState) resolveStorageProof(
+	clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	proof []byte,
+	path exported.Path,
+) (StorageProof, *ConsensusState, []byte, error) {
+	if cs.LatestHeight().LT(height) {
+		return StorageProof{}, nil, nil, errorsmod.Wrapf(
+			ibcerrors.ErrInvalidHeight,
+			"client state height < proof height (%d < %d), please ensure the client has been updated", cs.LatestHeight(), height,
+		)
+	}
+
+	var storageProof StorageProof
+	if err := cdc.Unmarshal(proof, &storageProof); err != nil {
+		return StorageProof{}, nil, nil, errorsmod.Wrap(ErrInvalidProof, "failed to unmarshal proof into ethereum storage proof")
+	}
+
+	merklePath, ok := path.(commitmenttypesv2.MerklePath)
+	if !ok {
+		return StorageProof{}, nil, nil, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected %T, got %T", commitmenttypesv2.MerklePath{}, path)
+	}
+
+	consensusState, found := GetConsensusState(clientStore, cdc, height)
+	if !found {
+		return StorageProof{}, nil, nil, errorsmod.Wrap(clienttypes.ErrConsensusStateNotFound, "please ensure the proof was constructed against a height that exists on the client")
+	}
+
+	slot, err := cs.commitmentStorageSlot(merklePath)
+	if err != nil {
+		return StorageProof{}, nil, nil, err
+	}
+	if !bytes.Equal(storageProof.Key, slot) {
+		return StorageProof{}, nil, nil, errorsmod.Wrapf(ErrInvalidProof, "storage proof key %X does not match the commitment storage slot %X", storageProof.Key, slot)
+	}
+
+	return storageProof, consensusState, slot, nil
+}
+
+// commitmentStorageSlot returns the storage slot of the IBC contract commitments mapping for the
+// path. The path is the concatenation of the merkle path key elements.
+func (cs ClientState) commitmentStorageSlot(merklePath commitmenttypesv2.MerklePath) ([]byte, error) {
+	if len(merklePath.KeyPath) == 0 {
+		return nil, errorsmod.Wrap(ErrInvalidPath, "merkle path cannot be empty")
+	}
+
+	var path []byte
+	for _, key := range merklePath.KeyPath {
+		path = append(path, key...)
+	}
+	if len(path) == 0 {
+		return nil, errorsmod.Wrap(ErrInvalidPath, "path cannot be empty")
+	}
+
+	return crypto.Keccak256(crypto.Keccak256(path), cs.IbcCommitmentSlot), nil
+}
@@ -0,0 +1,216 @@
+package ethereum
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math/big"
+	"math/bits"
+)
+
+/*
+This file contains the subset of the SSZ merkleization
+(https://github.com/ethereum/consensus-specs/blob/dev/ssz/simple-serialize.md#merkleization)
+required to compute the hash tree roots of the beacon chain containers verified by the client,
+along with the merkle branch verification and the signing root computation of the consensus specs.
+*/
+
+const (
+	// bytesPerChunk is the size in bytes of a SSZ merkleization chunk
+	bytesPerChunk = 32
+	// maxExtraDataBytes is the maximum length of the extra data of an execution payload header
+	maxExtraDataBytes = 32
+	// logsBloomSize is the size of the logs bloom of an execution payload header
+	logsBloomSize = 256
+	// pubkeySize is the size of a compressed BLS public key
+	pubkeySize = 48
+	// signatureSize is the size of a compressed BLS signature
+	signatureSize = 96
+	// rootSize is the size of a SSZ root or hash
+	rootSize = 32
+	// addressSize is the size of an execution layer address
+	addressSize = 20
+	// forkVersionSize is the size of a fork version
+	forkVersionSize = 4
+)
+
+// domainSyncCommittee is the signature domain type of the sync committee
+var domainSyncCommittee = [4]byte{0x07, 0x00, 0x00, 0x00}
+
+// zeroHashes contains the roots of zero filled subtrees of increasing depth
+var zeroHashes = func() [][bytesPerChunk]byte {
+	hashes := make([][bytesPerChunk]byte, 64)
+	for i := 1; i < len(hashes); i++ {
+		hashes[i] = hashPair(hashes[i-1], hashes[i-1])
+	}
+	return hashes
+}()
+
+// hashPair returns the sha256 hash of the concatenation of the two chunks.
+func hashPair(left, right [bytesPerChunk]byte) [bytesPerChunk]byte {
+	return sha256.Sum256(append(left[:], right[:]...))
+}
+
+// merkleize returns the root of the merkle tree of the chunks, padded with zero chunks up to the
+// next power of two of the limit.
+func merkleize(chunks [][bytesPerChunk]byte, limit uint64) [bytesPerChunk]byte {
+	depth := 0
+	if limit > 1 {
+		depth = bits.Len64(limit - 1)
+	}
+
+	layer := chunks
+	for d := range depth {
+		if len(layer)%2 == 1 {
+			layer = append(layer, zeroHashes[d])
+		}
+
+		next := make([][bytesPerChunk]byte, len(layer)/2)
+		for i := range next {
+			next[i] = hashPair(layer[2*i], layer[2*i+1])
+		}
+		layer = next
+	}
+
+	if len(layer) == 0 {
+		return zeroHashes[depth]
+	}
+
+	return layer[0]
+}
+
+// mixInLength mixes the length of a list into its root.
+func mixInLength(root [bytesPerChunk]byte, length uint64) [bytesPerChunk]byte {
+	var lengthChunk [bytesPerChunk]byte
+	binary.LittleEndian.PutUint64(lengthChunk[:], length)
+	return hashPair(root, lengthChunk)
+}
+
+// pack splits the bytes into right padded chunks.
+func pack(bz []byte) [][bytesPerChunk]byte {
+	chunks := make([][bytesPerChunk]byte, (len(bz)+bytesPerChunk-1)/bytesPerChunk)
+	for i := range chunks {
+		copy(chunks[i][:], bz[i*bytesPerChunk:])
+	}
+	return chunks
+}
+
+// uint64Chunk returns the chunk of a little endian encoded uint64.
+func uint64Chunk(value uint64) [bytesPerChunk]byte {
+	var chunk [bytesPerChunk]byte
+	binary.LittleEndian.PutUint64(chunk[:], value)
+	return chunk
+}
+
+// uint256Chunk returns the chunk of a little endian encoded uint256. The value is expected to
+// have been validated to fit in 256 bits.
+func uint256Chunk(value *big.Int) [bytesPerChunk]byte {
+	var chunk [bytesPerChunk]byte
+	bigEndian := value.FillBytes(make([]byte, bytesPerChunk))
+	for i := range bigEndian {
+		chunk[i] = bigEndian[bytesPerChunk-1-i]
+	}
+	return chunk
+}
+
+// bytesRoot returns the hash tree root of a fixed size byte vector.
+func bytesRoot(bz []byte) [bytesPerChunk]byte {
+	chunks := pack(bz)
+	return merkleize(chunks, uint64(len(chunks)))
+}
+
+// toRoot converts a validated 32 byte slice into a root.
+func toRoot(bz []byte) [bytesPerChunk]byte {
+	var root [bytesPerChunk]byte
+	copy(root[:], bz)
+	return root
+}
+
+// HashTreeRoot returns the SSZ hash tree root of the beacon block header.
+func (h BeaconBlockHeader) HashTreeRoot() [32]byte {
+	return merkleize([][bytesPerChunk]byte{
+		uint64Chunk(h.Slot),
+		uint64Chunk(h.ProposerIndex),
+		toRoot(h.ParentRoot),
+		toRoot(h.StateRoot),
+		toRoot(h.BodyRoot),
+	}, 5)
+}
+
+// HashTreeRoot returns the SSZ hash tree root of the execution payload header. The base fee
+// per gas is expected to have been validated.
+func (h ExecutionPayloadHeader) HashTreeRoot() [32]byte {
+	baseFeePerGas, _ := new(big.Int).SetString(h.BaseFeePerGas, 10)
+
+	return merkleize([][bytesPerChunk]byte{
+		toRoot(h.ParentHash),
+		bytesRoot(h.FeeRecipient),
+		toRoot(h.StateRoot),
+		toRoot(h.ReceiptsRoot),
+		bytesRoot(h.LogsBloom),
+		toRoot(h.PrevRandao),
+		uint64Chunk(h.BlockNumber),
+		uint64Chunk(h.GasLimit),
+		uint64Chunk(h.GasUsed),
+		uint64Chunk(h.Timestamp),
+		mixInLength(merkleize(pack(h.ExtraData), (maxExtraDataBytes+bytesPerChunk-1)/bytesPerChunk), uint64(len(h.ExtraData))),
+		uint256Chunk(baseFeePerGas),
+		toRoot(h.BlockHash),
+		toRoot(h.TransactionsRoot),
+		toRoot(h.WithdrawalsRoot),
+		uint64Chunk(h.BlobGasUsed),
+		uint64Chunk(h.ExcessBlobGas),
+	}, 17)
+}
+
+// HashTreeRoot returns the SSZ hash tree root of the sync committee.
+func (sc SyncCommittee) HashTreeRoot() [32]byte {
+	pubkeyRoots := make([][bytesPerChunk]byte, len(sc.Pubkeys))
+	for i, pubkey := range sc.Pubkeys {
+		pubkeyRoots[i] = bytesRoot(pubkey)
+	}
+
+	return merkleize([][bytesPerChunk]byte{
+		merkleize(pubkeyRoots, uint64(len(sc.Pubkeys))),
+		bytesRoot(sc.AggregatePubkey),
+	}, 2)
+}
+
+// isValidMerkleBranch returns true if the branch proves the leaf at the given depth and index
+// of the merkle tree with the given root.
+func isValidMerkleBranch(leaf [bytesPerChunk]byte, branch [][]byte, depth, index uint64, root [bytesPerChunk]byte) bool {
+	if uint64(len(branch)) != depth {
+		return false
+	}
+
+	value := leaf
+	for i, node := range branch {
+		if len(node) != bytesPerChunk {
+			return false
+		}
+
+		if (index>>uint(i))&1 == 1 {
+			value = hashPair(toRoot(node), value)
+		} else {
+			value = hashPair(value, toRoot(node))
+		}
+	}
+
+	return value == root
+}
+
+// computeDomain returns the signature domain of the domain type for the fork version and genesis validators root.
+func computeDomain(domainType [4]byte, forkVersion, genesisValidatorsRoot []byte) [32]byte {
+	var versionChunk [bytesPerChunk]byte
+	copy(versionChunk[:], forkVersion)
+	forkDataRoot := hashPair(versionChunk, toRoot(genesisValidatorsRoot))
+
+	var domain [32]byte
+	copy(domain[:], domainType[:])
+	copy(domain[len(domainType):], forkDataRoot[:bytesPerChunk-len(domainType)])
+	return domain
+}
+
+// computeSigningRoot returns the signing root of the object root in the given domain.
+func computeSigningRoot(objectRoot, domain [32]byte) [32]byte {
+	return hashPair(objectRoot, domain)
+}
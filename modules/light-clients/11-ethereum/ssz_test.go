@@ -0,0 +1,47 @@
+package ethereum_test
+
+import (
+	"encoding/hex"
+
+	ethereum "github.com/cosmos/ibc-go/v10/modules/light-clients/11-ethereum"
+)
+
+func (suite *EthereumTestSuite) TestBeaconBlockHeaderHashTreeRoot() {
+	header := ethereum.BeaconBlockHeader{
+		ParentRoot: make([]byte, 32),
+		StateRoot:  make([]byte, 32),
+		BodyRoot:   make([]byte, 32),
+	}
+
+	// the hash tree root of a zero beacon block header is the root of eight zero chunks
+	root := header.HashTreeRoot()
+	suite.Require().Equal("c78009fdf07fc56a11f122370658a353aaa542ed63e44c4bc15ff4cd105ab33c", hex.EncodeToString(root[:]))
+
+	header.Slot = 1
+	suite.Require().NotEqual(root, header.HashTreeRoot())
+}
+
+func (suite *EthereumTestSuite) TestComputeDomain() {
+	// the deposit domain of the zero fork version and genesis validators root
+	domain := ethereum.ComputeDomain([4]byte{0x03, 0x00, 0x00, 0x00}, make([]byte, 4), make([]byte, 32))
+	suite.Require().Equal("03000000f5a5fd42d16a20302798ef6ed309979b43003d2320d9f0e8ea9831a9", hex.EncodeToString(domain[:]))
+}
+
+func (suite *EthereumTestSuite) TestIsValidMerkleBranch() {
+	leaf := sha256Array([]byte("leaf"))
+	tree := merkleTree{169: leaf, 87: sha256Array([]byte("other leaf"))}
+	root := tree.root()
+	branch := tree.branch(169)
+
+	suite.Require().True(ethereum.IsValidMerkleBranch(leaf, branch, 7, 41, root))
+
+	// invalid depth
+	suite.Require().False(ethereum.IsValidMerkleBranch(leaf, branch, 6, 41, root))
+	// invalid index
+	suite.Require().False(ethereum.IsValidMerkleBranch(leaf, branch, 7, 40, root))
+	// invalid leaf
+	suite.Require().False(ethereum.IsValidMerkleBranch(sha256Array([]byte("invalid")), branch, 7, 41, root))
+	// invalid branch node length
+	branch[0] = branch[0][:31]
+	suite.Require().False(ethereum.IsValidMerkleBranch(leaf, branch, 7, 41, root))
+}
@@ -0,0 +1,283 @@
+package ethereum
+
+import (
+	"bytes"
+
+	blsu "github.com/protolambda/bls12-381-util"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+// VerifyClientMessage checks if the clientMessage is of type Header and verifies the message
+func (cs *ClientState) VerifyClientMessage(
+	ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore,
+	clientMsg exported.ClientMessage,
+) error {
+	switch msg := clientMsg.(type) {
+	case *Header:
+		return cs.verifyHeader(ctx, clientStore, cdc, msg)
+	default:
+		return clienttypes.ErrInvalidClientType
+	}
+}
+
+// verifyHeader returns an error if:
+// - the trusted consensus state does not exist
+// - the finalized slot is not greater than the trusted slot
+// - the signature slot is in the future
+// - the update is not signed by the current or the next sync committee of the trusted consensus state
+// - the trusted sync committee does not hash to the sync committee root of the trusted consensus state
+// - the finalized header is not in the same or the following sync committee period as the trusted consensus state
+// - the finalized header is from before the Deneb fork
+// - the sync committee participation is below the minimum or the supermajority
+// - the finality, execution payload or next sync committee branches are invalid
+// - the sync committee signature over the attested header is invalid
+// - the account proof of the IBC contract does not prove the provided storage root
+func (cs *ClientState) verifyHeader(
+	ctx sdk.Context, clientStore storetypes.KVStore, cdc codec.BinaryCodec,
+	header *Header,
+) error {
+	trustedConsState, found := GetConsensusState(clientStore, cdc, header.TrustedSyncCommittee.TrustedHeight)
+	if !found {
+		return errorsmod.Wrapf(clienttypes.ErrConsensusStateNotFound, "could not get trusted consensus state from clientStore for Header at TrustedHeight: %s", header.TrustedSyncCommittee.TrustedHeight)
+	}
+
+	update := header.ConsensusUpdate
+	attested := update.AttestedHeader
+	finalized := update.FinalizedHeader
+
+	if finalized.Beacon.Slot <= trustedConsState.Slot {
+		return errorsmod.Wrapf(ErrInvalidHeader, "finalized slot %d must be greater than trusted slot %d", finalized.Beacon.Slot, trustedConsState.Slot)
+	}
+
+	currentSlot := cs.computeSlotAtTime(ctx.BlockTime())
+	if update.SignatureSlot > currentSlot {
+		return errorsmod.Wrapf(ErrInvalidHeader, "signature slot %d cannot be in the future, current slot is %d", update.SignatureSlot, currentSlot)
+	}
+
+	if cs.computeEpoch(finalized.Beacon.Slot) < cs.ForkParameters.Deneb.Epoch {
+		return errorsmod.Wrapf(ErrUnsupportedFork, "finalized slot %d is before the deneb fork epoch %d", finalized.Beacon.Slot, cs.ForkParameters.Deneb.Epoch)
+	}
+
+	// the update must be signed by a sync committee known to the trusted consensus state
+	storePeriod := cs.computeSyncCommitteePeriod(trustedConsState.Slot)
+	signaturePeriod := cs.computeSyncCommitteePeriod(update.SignatureSlot)
+
+	var trustedSyncCommitteeRoot []byte
+	switch signaturePeriod {
+	case storePeriod:
+		trustedSyncCommitteeRoot = trustedConsState.CurrentSyncCommittee
+	case storePeriod + 1:
+		if len(trustedConsState.NextSyncCommittee) == 0 {
+			return errorsmod.Wrapf(ErrInvalidSyncCommittee, "next sync committee of the trusted consensus state at slot %d is unknown", trustedConsState.Slot)
+		}
+		trustedSyncCommitteeRoot = trustedConsState.NextSyncCommittee
+	default:
+		return errorsmod.Wrapf(ErrInvalidHeader, "signature period %d must be the trusted period %d or the following period", signaturePeriod, storePeriod)
+	}
+
+	syncCommittee := header.TrustedSyncCommittee.SyncCommittee
+	if uint64(len(syncCommittee.Pubkeys)) != cs.SyncCommitteeSize {
+		return errorsmod.Wrapf(ErrInvalidSyncCommittee, "expected %d sync committee public keys, got %d", cs.SyncCommitteeSize, len(syncCommittee.Pubkeys))
+	}
+	if root := syncCommittee.HashTreeRoot(); !bytes.Equal(root[:], trustedSyncCommitteeRoot) {
+		return errorsmod.Wrapf(ErrInvalidSyncCommittee, "trusted sync committee root %X does not match the expected root %X", root, trustedSyncCommitteeRoot)
+	}
+
+	// the new consensus state must be in a period for which the sync committees can be derived
+	finalizedPeriod := cs.computeSyncCommitteePeriod(finalized.Beacon.Slot)
+	if finalizedPeriod != storePeriod && finalizedPeriod != storePeriod+1 {
+		return errorsmod.Wrapf(ErrInvalidHeader, "finalized period %d must be the trusted period %d or the following period", finalizedPeriod, storePeriod)
+	}
+
+	participants, err := cs.syncCommitteeParticipants(syncCommittee, update.SyncAggregate)
+	if err != nil {
+		return err
+	}
+	if uint64(len(participants)) < cs.MinSyncCommitteeParticipants {
+		return errorsmod.Wrapf(ErrInsufficientParticipants, "expected at least %d participants, got %d", cs.MinSyncCommitteeParticipants, len(participants))
+	}
+	if uint64(len(participants))*3 < cs.SyncCommitteeSize*2 {
+		return errorsmod.Wrapf(ErrInsufficientParticipants, "expected a supermajority of the %d sync committee members, got %d", cs.SyncCommitteeSize, len(participants))
+	}
+
+	depth, index := cs.finalizedRootGeneralizedIndex(attested.Beacon.Slot)
+	if !isValidMerkleBranch(finalized.Beacon.HashTreeRoot(), update.FinalityBranch, depth, index, toRoot(attested.Beacon.StateRoot)) {
+		return errorsmod.Wrap(ErrInvalidMerkleBranch, "finality branch does not prove the finalized header in the attested beacon state")
+	}
+
+	if !isValidMerkleBranch(finalized.Execution.HashTreeRoot(), finalized.ExecutionBranch, executionPayloadDepth, executionPayloadIndex, toRoot(finalized.Beacon.BodyRoot)) {
+		return errorsmod.Wrap(ErrInvalidMerkleBranch, "execution branch does not prove the execution payload in the finalized beacon block body")
+	}
+
+	if update.NextSyncCommittee != nil {
+		if cs.computeSyncCommitteePeriod(attested.Beacon.Slot) != finalizedPeriod {
+			return errorsmod.Wrap(ErrInvalidHeader, "next sync committee must be attested in the period of the finalized header")
+		}
+		if uint64(len(update.NextSyncCommittee.Pubkeys)) != cs.SyncCommitteeSize {
+			return errorsmod.Wrapf(ErrInvalidSyncCommittee, "expected %d next sync committee public keys, got %d", cs.SyncCommitteeSize, len(update.NextSyncCommittee.Pubkeys))
+		}
+
+		depth, index := cs.nextSyncCommitteeGeneralizedIndex(attested.Beacon.Slot)
+		if !isValidMerkleBranch(update.NextSyncCommittee.HashTreeRoot(), update.NextSyncCommitteeBranch, depth, index, toRoot(attested.Beacon.StateRoot)) {
+			return errorsmod.Wrap(ErrInvalidMerkleBranch, "next sync committee branch does not prove the next sync committee in the attested beacon state")
+		}
+	}
+
+	// the sync committee signs the attested header at the slot preceding the signature slot
+	forkVersion := cs.computeForkVersion(cs.computeEpoch(max(update.SignatureSlot, 1) - 1))
+	domain := computeDomain(domainSyncCommittee, forkVersion, cs.GenesisValidatorsRoot)
+	signingRoot := computeSigningRoot(attested.Beacon.HashTreeRoot(), domain)
+	if err := verifySyncAggregateSignature(participants, signingRoot, update.SyncAggregate.SyncCommitteeSignature); err != nil {
+		return err
+	}
+
+	storageRoot, err := verifyAccountStorageRoot(finalized.Execution.StateRoot, cs.IbcContractAddress, header.AccountProof.Proof)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(storageRoot, header.AccountProof.StorageRoot) {
+		return errorsmod.Wrapf(ErrInvalidProof, "IBC contract storage root %X does not match the proven storage root %X", header.AccountProof.StorageRoot, storageRoot)
+	}
+
+	return nil
+}
+
+// syncCommitteeParticipants returns the public keys of the sync committee members which participated
+// in the sync aggregate.
+func (cs ClientState) syncCommitteeParticipants(syncCommittee SyncCommittee, syncAggregate SyncAggregate) ([][]byte, error) {
+	if uint64(len(syncAggregate.SyncCommitteeBits)) != cs.SyncCommitteeSize/8 {
+		return nil, errorsmod.Wrapf(ErrInvalidHeader, "expected %d bytes of sync committee bits, got %d", cs.SyncCommitteeSize/8, len(syncAggregate.SyncCommitteeBits))
+	}
+
+	var participants [][]byte
+	for i, pubkey := range syncCommittee.Pubkeys {
+		if (syncAggregate.SyncCommitteeBits[i/8]>>(uint(i)%8))&1 == 1 {
+			participants = append(participants, pubkey)
+		}
+	}
+
+	return participants, nil
+}
+
+// verifySyncAggregateSignature verifies the aggregate BLS signature of the participating sync
+// committee members over the signing root.
+func verifySyncAggregateSignature(pubkeys [][]byte, signingRoot [32]byte, signature []byte) error {
+	blsPubkeys := make([]*blsu.Pubkey, len(pubkeys))
+	for i, pubkey := range pubkeys {
+		var pubkeyBz [pubkeySize]byte
+		copy(pubkeyBz[:], pubkey)
+
+		blsPubkeys[i] = new(blsu.Pubkey)
+		if err := blsPubkeys[i].Deserialize(&pubkeyBz); err != nil {
+			return errorsmod.Wrapf(ErrInvalidSyncCommittee, "invalid sync committee public key %X: %s", pubkey, err)
+		}
+	}
+
+	var signatureBz [signatureSize]byte
+	copy(signatureBz[:], signature)
+
+	blsSignature := new(blsu.Signature)
+	if err := blsSignature.Deserialize(&signatureBz); err != nil {
+		return errorsmod.Wrapf(ErrSignatureVerificationFailed, "invalid sync committee signature: %s", err)
+	}
+
+	if !blsu.FastAggregateVerify(blsPubkeys, signingRoot[:], blsSignature) {
+		return errorsmod.Wrap(ErrSignatureVerificationFailed, "sync committee signature does not verify the attested header")
+	}
+
+	return nil
+}
+
+// consensusStateFromHeader returns the consensus state of the verified header. The sync committees
+// are derived from the trusted consensus state, advancing by one period if the finalized header is
+// in the period following the trusted consensus state.
+func (cs ClientState) consensusStateFromHeader(trustedConsState *ConsensusState, header *Header) *ConsensusState {
+	finalized := header.ConsensusUpdate.FinalizedHeader
+
+	currentSyncCommittee, nextSyncCommittee := trustedConsState.CurrentSyncCommittee, trustedConsState.NextSyncCommittee
+	if cs.computeSyncCommitteePeriod(finalized.Beacon.Slot) != cs.computeSyncCommitteePeriod(trustedConsState.Slot) {
+		currentSyncCommittee, nextSyncCommittee = trustedConsState.NextSyncCommittee, nil
+	}
+
+	if header.ConsensusUpdate.NextSyncCommittee != nil {
+		root := header.ConsensusUpdate.NextSyncCommittee.HashTreeRoot()
+		nextSyncCommittee = root[:]
+	}
+
+	return NewConsensusState(
+		finalized.Beacon.Slot,
+		finalized.Execution.StateRoot,
+		header.AccountProof.StorageRoot,
+		finalized.Execution.Timestamp,
+		currentSyncCommittee,
+		nextSyncCommittee,
+	)
+}
+
+// UpdateState sets the consensus state of the finalized header and updates the latest slot of the
+// client state if the finalized slot is newer. If a consensus state already exists for the finalized
+// slot, its known next sync committee is retained.
+func (cs ClientState) UpdateState(_ sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, clientMsg exported.ClientMessage) []exported.Height {
+	header, ok := clientMsg.(*Header)
+	if !ok {
+		// clientMsg is invalid Misbehaviour, no update necessary
+		return []exported.Height{}
+	}
+
+	trustedConsState, found := GetConsensusState(clientStore, cdc, header.TrustedSyncCommittee.TrustedHeight)
+	if !found {
+		panic(errorsmod.Wrapf(clienttypes.ErrConsensusStateNotFound, "could not get trusted consensus state from clientStore for Header at TrustedHeight: %s", header.TrustedSyncCommittee.TrustedHeight))
+	}
+
+	consensusState := cs.consensusStateFromHeader(trustedConsState, header)
+	if existing, found := GetConsensusState(clientStore, cdc, header.GetHeight()); found && len(consensusState.NextSyncCommittee) == 0 {
+		consensusState.NextSyncCommittee = existing.NextSyncCommittee
+	}
+
+	if consensusState.Slot > cs.LatestSlot {
+		cs.LatestSlot = consensusState.Slot
+	}
+
+	setClientState(clientStore, cdc, &cs)
+	setConsensusState(clientStore, cdc, consensusState, header.GetHeight())
+
+	return []exported.Height{header.GetHeight()}
+}
+
+// CheckForMisbehaviour detects duplicate height misbehaviour. A header is misbehaviour if a consensus
+// state already exists for its finalized slot with a different execution state root, storage root or timestamp.
+// Two conflicting finalized headers can only be produced if the sync committee signed conflicting
+// updates, which should freeze the client.
+func (ClientState) CheckForMisbehaviour(_ sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, msg exported.ClientMessage) bool {
+	header, ok := msg.(*Header)
+	if !ok {
+		return false
+	}
+
+	existingConsState, found := GetConsensusState(clientStore, cdc, header.GetHeight())
+	if !found {
+		return false
+	}
+
+	finalized := header.ConsensusUpdate.FinalizedHeader
+	return !bytes.Equal(existingConsState.StateRoot, finalized.Execution.StateRoot) ||
+		!bytes.Equal(existingConsState.StorageRoot, header.AccountProof.StorageRoot) ||
+		existingConsState.Timestamp != finalized.Execution.Timestamp
+}
+
+// UpdateStateOnMisbehaviour updates state upon misbehaviour, freezing the ClientState. This method should only be called when misbehaviour is detected
+// as it does not perform any misbehaviour checks.
+func (cs ClientState) UpdateStateOnMisbehaviour(_ sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, _ exported.ClientMessage) {
+	cs.IsFrozen = true
+
+	clientStore.Set(host.ClientStateKey(), clienttypes.MustMarshalClientState(cdc, &cs))
+}
@@ -0,0 +1,426 @@
+package ethereum_test
+
+import (
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+	ethereum "github.com/cosmos/ibc-go/v10/modules/light-clients/11-ethereum"
+)
+
+// periodDuration is the duration of a sync committee period
+const periodDuration = time.Duration(slotsPerPeriod*secondsPerSlot) * time.Second
+
+func (suite *EthereumTestSuite) TestVerifyClientMessage() {
+	var (
+		clientID  string
+		clientMsg exported.ClientMessage
+		header    *ethereum.Header
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: update within the trusted period",
+			func() {},
+			nil,
+		},
+		{
+			"success: update without next sync committee",
+			func() {
+				header.ConsensusUpdate.NextSyncCommittee = nil
+				header.ConsensusUpdate.NextSyncCommitteeBranch = nil
+			},
+			nil,
+		},
+		{
+			"success: update before the electra fork",
+			func() {
+				suite.forkParameters = newForkParameters(farFutureEpoch)
+				clientID = suite.createClient()
+				header = suite.newHeader(trustedSlot+64, trustedSlot+96, trustedSlot+97, true)
+				clientMsg = header
+			},
+			nil,
+		},
+		{
+			"success: update signed by the next sync committee in the following period",
+			func() {
+				suite.coordinator.IncrementTimeBy(periodDuration)
+
+				header = suite.newHeader(trustedSlot+slotsPerPeriod, trustedSlot+slotsPerPeriod+32, trustedSlot+slotsPerPeriod+33, true)
+				header.TrustedSyncCommittee.SyncCommittee = suite.nextSyncCommittee
+				suite.signHeader(header, suite.nextKeys, syncCommitteeSize)
+				clientMsg = header
+			},
+			nil,
+		},
+		{
+			"success: supermajority of the sync committee participates",
+			func() {
+				suite.signHeader(header, suite.currentKeys, 22)
+			},
+			nil,
+		},
+		{
+			"failure: invalid client message type",
+			func() {
+				clientMsg = &ibctm.Header{}
+			},
+			clienttypes.ErrInvalidClientType,
+		},
+		{
+			"failure: trusted consensus state not found",
+			func() {
+				header.TrustedSyncCommittee.TrustedHeight = clienttypes.NewHeight(0, trustedSlot-1)
+			},
+			clienttypes.ErrConsensusStateNotFound,
+		},
+		{
+			"failure: finalized slot is not greater than the trusted slot",
+			func() {
+				header = suite.newHeader(trustedSlot, trustedSlot+32, trustedSlot+33, true)
+				clientMsg = header
+			},
+			ethereum.ErrInvalidHeader,
+		},
+		{
+			"failure: signature slot is in the future",
+			func() {
+				header = suite.newHeader(trustedSlot+64, trustedSlot+96, currentSlot+1, true)
+				clientMsg = header
+			},
+			ethereum.ErrInvalidHeader,
+		},
+		{
+			"failure: finalized header is before the deneb fork",
+			func() {
+				suite.forkParameters.Deneb.Epoch = farFutureEpoch
+				suite.forkParameters.Electra.Epoch = farFutureEpoch
+				clientID = suite.createClient()
+				header = suite.newHeader(trustedSlot+64, trustedSlot+96, trustedSlot+97, true)
+				clientMsg = header
+			},
+			ethereum.ErrUnsupportedFork,
+		},
+		{
+			"failure: signature period is more than one period after the trusted period",
+			func() {
+				suite.coordinator.IncrementTimeBy(2 * periodDuration)
+
+				header = suite.newHeader(trustedSlot+64, trustedSlot+96, trustedSlot+2*slotsPerPeriod, true)
+				clientMsg = header
+			},
+			ethereum.ErrInvalidHeader,
+		},
+		{
+			"failure: next sync committee of the trusted consensus state is unknown",
+			func() {
+				consensusState := suite.newConsensusState()
+				consensusState.NextSyncCommittee = nil
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientConsensusState(suite.chainA.GetContext(), clientID, clienttypes.NewHeight(0, trustedSlot), consensusState)
+
+				suite.coordinator.IncrementTimeBy(periodDuration)
+
+				header = suite.newHeader(trustedSlot+slotsPerPeriod, trustedSlot+slotsPerPeriod+32, trustedSlot+slotsPerPeriod+33, true)
+				header.TrustedSyncCommittee.SyncCommittee = suite.nextSyncCommittee
+				suite.signHeader(header, suite.nextKeys, syncCommitteeSize)
+				clientMsg = header
+			},
+			ethereum.ErrInvalidSyncCommittee,
+		},
+		{
+			"failure: trusted sync committee does not match the trusted consensus state",
+			func() {
+				header.TrustedSyncCommittee.SyncCommittee = suite.nextSyncCommittee
+				suite.signHeader(header, suite.nextKeys, syncCommitteeSize)
+			},
+			ethereum.ErrInvalidSyncCommittee,
+		},
+		{
+			"failure: participation is below the supermajority",
+			func() {
+				suite.signHeader(header, suite.currentKeys, 21)
+			},
+			ethereum.ErrInsufficientParticipants,
+		},
+		{
+			"failure: invalid sync committee bits length",
+			func() {
+				header.ConsensusUpdate.SyncAggregate.SyncCommitteeBits = append(header.ConsensusUpdate.SyncAggregate.SyncCommitteeBits, 0xff)
+			},
+			ethereum.ErrInvalidHeader,
+		},
+		{
+			"failure: invalid finality branch",
+			func() {
+				header.ConsensusUpdate.FinalityBranch[0] = sha256Hash([]byte("invalid"))
+			},
+			ethereum.ErrInvalidMerkleBranch,
+		},
+		{
+			"failure: invalid execution branch",
+			func() {
+				header.ConsensusUpdate.FinalizedHeader.Execution.GasUsed++
+			},
+			ethereum.ErrInvalidMerkleBranch,
+		},
+		{
+			"failure: invalid next sync committee branch",
+			func() {
+				header.ConsensusUpdate.NextSyncCommitteeBranch[0] = sha256Hash([]byte("invalid"))
+			},
+			ethereum.ErrInvalidMerkleBranch,
+		},
+		{
+			"failure: signature by a different sync committee",
+			func() {
+				suite.signHeader(header, suite.nextKeys, syncCommitteeSize)
+			},
+			ethereum.ErrSignatureVerificationFailed,
+		},
+		{
+			"failure: signature over a different attested header",
+			func() {
+				header.ConsensusUpdate.AttestedHeader.Beacon.ProposerIndex++
+			},
+			ethereum.ErrSignatureVerificationFailed,
+		},
+		{
+			"failure: invalid account proof",
+			func() {
+				header.AccountProof.Proof = [][]byte{sha256Hash([]byte("invalid"))}
+			},
+			ethereum.ErrInvalidProof,
+		},
+		{
+			"failure: account storage root does not match the proven storage root",
+			func() {
+				header.AccountProof.StorageRoot = sha256Hash([]byte("invalid"))
+			},
+			ethereum.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			clientID = suite.createClient()
+			header = suite.newHeader(trustedSlot+64, trustedSlot+96, trustedSlot+97, true)
+			clientMsg = header
+
+			tc.malleate()
+
+			lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), clientID)
+			suite.Require().NoError(err)
+
+			err = lightClientModule.VerifyClientMessage(suite.chainA.GetContext(), clientID, clientMsg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *EthereumTestSuite) TestUpdateState() {
+	var (
+		header                         *ethereum.Header
+		expCurrentSyncCommittee        []byte
+		expNextSyncCommittee           []byte
+		currentRoot, nextRoot, newRoot [32]byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"update within the trusted period",
+			func() {
+				expCurrentSyncCommittee = currentRoot[:]
+				expNextSyncCommittee = nextRoot[:]
+			},
+		},
+		{
+			"update within the trusted period without next sync committee",
+			func() {
+				header.ConsensusUpdate.NextSyncCommittee = nil
+				header.ConsensusUpdate.NextSyncCommitteeBranch = nil
+
+				expCurrentSyncCommittee = currentRoot[:]
+				expNextSyncCommittee = nextRoot[:]
+			},
+		},
+		{
+			"update to the following period",
+			func() {
+				suite.coordinator.IncrementTimeBy(periodDuration)
+
+				header = suite.newHeader(trustedSlot+slotsPerPeriod, trustedSlot+slotsPerPeriod+32, trustedSlot+slotsPerPeriod+33, true)
+				header.TrustedSyncCommittee.SyncCommittee = suite.nextSyncCommittee
+				suite.signHeader(header, suite.nextKeys, syncCommitteeSize)
+
+				expCurrentSyncCommittee = nextRoot[:]
+				expNextSyncCommittee = newRoot[:]
+			},
+		},
+		{
+			"update to the following period without next sync committee",
+			func() {
+				suite.coordinator.IncrementTimeBy(periodDuration)
+
+				header = suite.newHeader(trustedSlot+slotsPerPeriod, trustedSlot+slotsPerPeriod+32, trustedSlot+slotsPerPeriod+33, false)
+				header.TrustedSyncCommittee.SyncCommittee = suite.nextSyncCommittee
+				suite.signHeader(header, suite.nextKeys, syncCommitteeSize)
+
+				expCurrentSyncCommittee = nextRoot[:]
+				expNextSyncCommittee = nil
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			currentRoot = suite.currentSyncCommittee.HashTreeRoot()
+			nextRoot = suite.nextSyncCommittee.HashTreeRoot()
+			_, newSyncCommittee := suite.newSyncCommittee(2*syncCommitteeSize + 1)
+			newRoot = newSyncCommittee.HashTreeRoot()
+
+			clientID := suite.createClient()
+			header = suite.newHeader(trustedSlot+64, trustedSlot+96, trustedSlot+97, true)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			err := suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(ctx, clientID, header)
+			suite.Require().NoError(err)
+
+			finalized := header.ConsensusUpdate.FinalizedHeader
+			expConsensusState := ethereum.NewConsensusState(
+				finalized.Beacon.Slot, finalized.Execution.StateRoot, header.AccountProof.StorageRoot,
+				finalized.Execution.Timestamp, expCurrentSyncCommittee, expNextSyncCommittee,
+			)
+
+			consensusState, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientConsensusState(ctx, clientID, header.GetHeight())
+			suite.Require().True(found)
+			suite.Require().Equal(expConsensusState, consensusState)
+
+			clientState, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientState(ctx, clientID)
+			suite.Require().True(found)
+			suite.Require().Equal(finalized.Beacon.Slot, clientState.(*ethereum.ClientState).LatestSlot)
+			suite.Require().Equal(exported.Active, suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientStatus(ctx, clientID))
+		})
+	}
+}
+
+func (suite *EthereumTestSuite) TestUpdateStateRetainsNextSyncCommittee() {
+	clientID := suite.createClient()
+
+	header := suite.newHeader(trustedSlot+64, trustedSlot+96, trustedSlot+97, true)
+	err := suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(suite.chainA.GetContext(), clientID, header)
+	suite.Require().NoError(err)
+
+	// a later update of the same finalized slot without next sync committee retains the known committee
+	header.ConsensusUpdate.NextSyncCommittee = nil
+	header.ConsensusUpdate.NextSyncCommitteeBranch = nil
+
+	lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), clientID)
+	suite.Require().NoError(err)
+	lightClientModule.UpdateState(suite.chainA.GetContext(), clientID, header)
+
+	consensusState, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientConsensusState(suite.chainA.GetContext(), clientID, header.GetHeight())
+	suite.Require().True(found)
+
+	nextRoot := suite.nextSyncCommittee.HashTreeRoot()
+	suite.Require().Equal(nextRoot[:], consensusState.(*ethereum.ConsensusState).NextSyncCommittee)
+}
+
+func (suite *EthereumTestSuite) TestCheckForMisbehaviour() {
+	var (
+		clientMsg exported.ClientMessage
+		header    *ethereum.Header
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"no consensus state at the finalized slot",
+			func() {
+				clientMsg = suite.newHeader(trustedSlot+128, trustedSlot+160, trustedSlot+161, true)
+			},
+			false,
+		},
+		{
+			"consensus state matches the header",
+			func() {},
+			false,
+		},
+		{
+			"invalid client message type",
+			func() {
+				clientMsg = &ibctm.Header{}
+			},
+			false,
+		},
+		{
+			"conflicting execution state root",
+			func() {
+				header.ConsensusUpdate.FinalizedHeader.Execution.StateRoot = sha256Hash([]byte("conflicting"))
+			},
+			true,
+		},
+		{
+			"conflicting storage root",
+			func() {
+				header.AccountProof.StorageRoot = sha256Hash([]byte("conflicting"))
+			},
+			true,
+		},
+		{
+			"conflicting timestamp",
+			func() {
+				header.ConsensusUpdate.FinalizedHeader.Execution.Timestamp++
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			clientID := suite.createClient()
+			header = suite.newHeader(trustedSlot+64, trustedSlot+96, trustedSlot+97, true)
+			err := suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(suite.chainA.GetContext(), clientID, header)
+			suite.Require().NoError(err)
+
+			clientMsg = header
+
+			tc.malleate()
+
+			lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), clientID)
+			suite.Require().NoError(err)
+
+			foundMisbehaviour := lightClientModule.CheckForMisbehaviour(suite.chainA.GetContext(), clientID, clientMsg)
+			suite.Require().Equal(tc.expPass, foundMisbehaviour)
+
+			if foundMisbehaviour {
+				lightClientModule.UpdateStateOnMisbehaviour(suite.chainA.GetContext(), clientID, clientMsg)
+				suite.Require().Equal(exported.Frozen, lightClientModule.Status(suite.chainA.GetContext(), clientID))
+			}
+		})
+	}
+}
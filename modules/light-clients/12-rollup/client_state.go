@@ -0,0 +1,282 @@
+package rollup
+
+import (
+	"strings"
+
+	ics23 "github.com/cosmos/ics23/go"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	commitmenttypesv2 "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types/v2"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+)
+
+var _ exported.ClientState = (*ClientState)(nil)
+
+// NewClientState creates a new ClientState instance
+func NewClientState(
+	chainID, hostClientID string, stateCommitmentPrefix commitmenttypesv2.MerklePath,
+	specs []*ics23.ProofSpec, latestHeight clienttypes.Height,
+) *ClientState {
+	return &ClientState{
+		ChainId:               chainID,
+		HostClientId:          hostClientID,
+		StateCommitmentPrefix: stateCommitmentPrefix,
+		ProofSpecs:            specs,
+		LatestHeight:          latestHeight,
+		IsFrozen:              false,
+	}
+}
+
+// ClientType is 12-rollup.
+func (ClientState) ClientType() string {
+	return ModuleName
+}
+
+// Validate performs a basic validation of the client state fields. The host client must be a
+// 07-tendermint client.
+func (cs ClientState) Validate() error {
+	if strings.TrimSpace(cs.ChainId) == "" {
+		return errorsmod.Wrap(ErrInvalidChainID, "chain id cannot be empty string")
+	}
+
+	clientType, _, err := clienttypes.ParseClientIdentifier(cs.HostClientId)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidHostClient, err.Error())
+	}
+	if clientType != exported.Tendermint {
+		return errorsmod.Wrapf(ErrInvalidHostClient, "expected host client type %s, got %s", exported.Tendermint, clientType)
+	}
+
+	if err := cs.StateCommitmentPrefix.ValidateAsPrefix(); err != nil {
+		return errorsmod.Wrap(ErrInvalidStateCommitmentPrefix, err.Error())
+	}
+
+	if cs.ProofSpecs == nil {
+		return errorsmod.Wrap(ErrInvalidProofSpecs, "proof specs cannot be nil for rollup client")
+	}
+	for i, spec := range cs.ProofSpecs {
+		if spec == nil {
+			return errorsmod.Wrapf(ErrInvalidProofSpecs, "proof spec cannot be nil at index: %d", i)
+		}
+	}
+
+	// the latest height revision number must match the chain id revision number
+	if cs.LatestHeight.RevisionNumber != clienttypes.ParseChainID(cs.ChainId) {
+		return errorsmod.Wrapf(ErrInvalidHeaderHeight,
+			"latest height revision number must match chain id revision number (%d != %d)", cs.LatestHeight.RevisionNumber, clienttypes.ParseChainID(cs.ChainId))
+	}
+	if cs.LatestHeight.RevisionHeight == 0 {
+		return errorsmod.Wrap(ErrInvalidHeaderHeight, "rollup client's latest height revision height cannot be zero")
+	}
+
+	return nil
+}
+
+// StateCommitmentPath returns the path of the state commitment of the rollup height in the settlement
+// chain store. The height is appended to the last element of the state commitment prefix.
+func (cs ClientState) StateCommitmentPath(height exported.Height) commitmenttypesv2.MerklePath {
+	prefix := cs.StateCommitmentPrefix.KeyPath
+	keyPath := make([][]byte, len(prefix))
+	copy(keyPath, prefix)
+
+	lastElement := make([]byte, len(keyPath[len(keyPath)-1]))
+	copy(lastElement, keyPath[len(keyPath)-1])
+	keyPath[len(keyPath)-1] = append(lastElement, []byte(height.String())...)
+
+	return commitmenttypesv2.NewMerklePath(keyPath...)
+}
+
+// getTimestampAtHeight returns the timestamp in nanoseconds of the consensus state at the given height.
+func (ClientState) getTimestampAtHeight(
+	clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+) (uint64, error) {
+	consState, found := GetConsensusState(clientStore, cdc, height)
+	if !found {
+		return 0, errorsmod.Wrapf(clienttypes.ErrConsensusStateNotFound, "height (%s)", height)
+	}
+	return consState.GetTimestamp(), nil
+}
+
+// status returns the status of the rollup client.
+// The client may be:
+// - Active: IsFrozen is false and the host client is Active
+// - Frozen: IsFrozen is true
+// - Expired: the latest consensus state of the client is not found
+// - the status of the host client, if the host client is not Active
+//
+// The consensus states of the client can only be trusted as long as the host client
+// of the settlement chain can be trusted.
+func (cs ClientState) status(
+	ctx sdk.Context,
+	clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	clientKeeper ClientKeeper,
+) exported.Status {
+	if cs.IsFrozen {
+		return exported.Frozen
+	}
+
+	// if the client state does not have an associated consensus state for its latest height
+	// then it must be expired
+	if _, found := GetConsensusState(clientStore, cdc, cs.LatestHeight); !found {
+		return exported.Expired
+	}
+
+	if status := clientKeeper.GetClientStatus(ctx, cs.HostClientId); status != exported.Active {
+		return status
+	}
+
+	return exported.Active
+}
+
+// initialize checks that the initial consensus state is a 12-rollup consensus state and
+// sets the client state, consensus state and associated metadata in the provided client store.
+func (cs ClientState) initialize(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, consState exported.ConsensusState) error {
+	consensusState, ok := consState.(*ConsensusState)
+	if !ok {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidConsensus, "invalid initial consensus state. expected type: %T, got: %T",
+			&ConsensusState{}, consState)
+	}
+
+	setClientState(clientStore, cdc, &cs)
+	setConsensusState(clientStore, cdc, consensusState, cs.LatestHeight)
+	setConsensusMetadata(ctx, clientStore, cs.LatestHeight)
+
+	return nil
+}
+
+// verifyMembership is a generic proof verification method which verifies a proof of the existence of a value at a given
+// CommitmentPath in the rollup state tree at the specified height.
+// The caller is expected to construct the full CommitmentPath from a CommitmentPrefix and a standardized path (as defined in ICS 24).
+func (cs ClientState) verifyMembership(
+	ctx sdk.Context,
+	clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+	value []byte,
+) error {
+	if cs.LatestHeight.LT(height) {
+		return errorsmod.Wrapf(
+			ibcerrors.ErrInvalidHeight,
+			"client state height < proof height (%d < %d), please ensure the client has been updated", cs.LatestHeight, height,
+		)
+	}
+
+	if err := verifyDelayPeriodPassed(ctx, clientStore, height, delayTimePeriod, delayBlockPeriod); err != nil {
+		return err
+	}
+
+	var merkleProof commitmenttypes.MerkleProof
+	if err := cdc.Unmarshal(proof, &merkleProof); err != nil {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "failed to unmarshal proof into ICS 23 commitment merkle proof")
+	}
+
+	merklePath, ok := path.(commitmenttypesv2.MerklePath)
+	if !ok {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected %T, got %T", commitmenttypesv2.MerklePath{}, path)
+	}
+
+	consensusState, found := GetConsensusState(clientStore, cdc, height)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrConsensusStateNotFound, "please ensure the proof was constructed against a height that exists on the client")
+	}
+
+	return merkleProof.VerifyMembership(cs.ProofSpecs, consensusState.GetRoot(), merklePath, value)
+}
+
+// verifyNonMembership is a generic proof verification method which verifies the absence of a given CommitmentPath
+// in the rollup state tree at the specified height.
+// The caller is expected to construct the full CommitmentPath from a CommitmentPrefix and a standardized path (as defined in ICS 24).
+func (cs ClientState) verifyNonMembership(
+	ctx sdk.Context,
+	clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+) error {
+	if cs.LatestHeight.LT(height) {
+		return errorsmod.Wrapf(
+			ibcerrors.ErrInvalidHeight,
+			"client state height < proof height (%d < %d), please ensure the client has been updated", cs.LatestHeight, height,
+		)
+	}
+
+	if err := verifyDelayPeriodPassed(ctx, clientStore, height, delayTimePeriod, delayBlockPeriod); err != nil {
+		return err
+	}
+
+	var merkleProof commitmenttypes.MerkleProof
+	if err := cdc.Unmarshal(proof, &merkleProof); err != nil {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "failed to unmarshal proof into ICS 23 commitment merkle proof")
+	}
+
+	merklePath, ok := path.(commitmenttypesv2.MerklePath)
+	if !ok {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected %T, got %T", commitmenttypesv2.MerklePath{}, path)
+	}
+
+	consensusState, found := GetConsensusState(clientStore, cdc, height)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrConsensusStateNotFound, "please ensure the proof was constructed against a height that exists on the client")
+	}
+
+	return merkleProof.VerifyNonMembership(cs.ProofSpecs, consensusState.GetRoot(), merklePath)
+}
+
+// verifyDelayPeriodPassed will ensure that at least delayTimePeriod amount of time and delayBlockPeriod number of blocks have passed
+// since consensus state was submitted before allowing verification to continue.
+func verifyDelayPeriodPassed(ctx sdk.Context, store storetypes.KVStore, proofHeight exported.Height, delayTimePeriod, delayBlockPeriod uint64) error {
+	if delayTimePeriod != 0 {
+		// check that executing chain's timestamp has passed consensusState's processed time + delay time period
+		processedTime, ok := ibctm.GetProcessedTime(store, proofHeight)
+		if !ok {
+			return errorsmod.Wrapf(ErrProcessedTimeNotFound, "processed time not found for height: %s", proofHeight)
+		}
+
+		currentTimestamp := uint64(ctx.BlockTime().UnixNano())
+		validTime := processedTime + delayTimePeriod
+
+		// NOTE: delay time period is inclusive, so if currentTimestamp is validTime, then we return no error
+		if currentTimestamp < validTime {
+			return errorsmod.Wrapf(ErrDelayPeriodNotPassed, "cannot verify packet until time: %d, current time: %d",
+				validTime, currentTimestamp)
+		}
+	}
+
+	if delayBlockPeriod != 0 {
+		// check that executing chain's height has passed consensusState's processed height + delay block period
+		processedHeight, ok := ibctm.GetProcessedHeight(store, proofHeight)
+		if !ok {
+			return errorsmod.Wrapf(ErrProcessedHeightNotFound, "processed height not found for height: %s", proofHeight)
+		}
+
+		currentHeight := clienttypes.GetSelfHeight(ctx)
+		validHeight := clienttypes.NewHeight(processedHeight.GetRevisionNumber(), processedHeight.GetRevisionHeight()+delayBlockPeriod)
+
+		// NOTE: delay block period is inclusive, so if currentHeight is validHeight, then we return no error
+		if currentHeight.LT(validHeight) {
+			return errorsmod.Wrapf(ErrDelayPeriodNotPassed, "cannot verify packet until height: %s, current height: %s",
+				validHeight, currentHeight)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package rollup_test
+
+import (
+	ics23 "github.com/cosmos/ics23/go"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	commitmenttypesv2 "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types/v2"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+	rollup "github.com/cosmos/ibc-go/v10/modules/light-clients/12-rollup"
+)
+
+func (suite *RollupTestSuite) TestValidate() {
+	var clientState *rollup.ClientState
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{"valid client", func() {}, nil},
+		{"valid client with empty last element of state commitment prefix", func() {
+			clientState.StateCommitmentPrefix = commitmenttypesv2.NewMerklePath([]byte(exported.StoreKey), []byte{})
+		}, nil},
+		{"empty chain id", func() { clientState.ChainId = " " }, rollup.ErrInvalidChainID},
+		{"invalid host client identifier", func() { clientState.HostClientId = "invalid" }, rollup.ErrInvalidHostClient},
+		{"host client is not a tendermint client", func() {
+			clientState.HostClientId = clienttypes.FormatClientIdentifier(rollup.ModuleName, 0)
+		}, rollup.ErrInvalidHostClient},
+		{"empty state commitment prefix", func() { clientState.StateCommitmentPrefix = commitmenttypesv2.MerklePath{} }, rollup.ErrInvalidStateCommitmentPrefix},
+		{"empty element of state commitment prefix", func() {
+			clientState.StateCommitmentPrefix = commitmenttypesv2.NewMerklePath([]byte{}, []byte(stateCommitmentKeyPrefix))
+		}, rollup.ErrInvalidStateCommitmentPrefix},
+		{"nil proof specs", func() { clientState.ProofSpecs = nil }, rollup.ErrInvalidProofSpecs},
+		{"nil proof spec", func() { clientState.ProofSpecs = []*ics23.ProofSpec{nil} }, rollup.ErrInvalidProofSpecs},
+		{"latest height revision number does not match chain id", func() { clientState.LatestHeight.RevisionNumber++ }, rollup.ErrInvalidHeaderHeight},
+		{"zero latest height", func() { clientState.LatestHeight.RevisionHeight = 0 }, rollup.ErrInvalidHeaderHeight},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			height, _ := suite.rollupState()
+			clientState = suite.newClientState(height)
+
+			tc.malleate()
+
+			err := clientState.Validate()
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *RollupTestSuite) TestStateCommitmentPath() {
+	height := clienttypes.NewHeight(1, 100)
+	clientState := suite.newClientState(height)
+
+	path := clientState.StateCommitmentPath(height)
+	suite.Require().Equal(commitmenttypesv2.NewMerklePath([]byte(exported.StoreKey), []byte(stateCommitmentKeyPrefix+"1-100")), path)
+
+	// the state commitment prefix of the client state is not modified
+	suite.Require().Equal(stateCommitmentPrefix, clientState.StateCommitmentPrefix)
+}
@@ -0,0 +1,46 @@
+package rollup
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+var _ exported.ConsensusState = (*ConsensusState)(nil)
+
+// NewConsensusState creates a new ConsensusState instance.
+func NewConsensusState(timestamp uint64, root commitmenttypes.MerkleRoot) *ConsensusState {
+	return &ConsensusState{
+		Timestamp: timestamp,
+		Root:      root,
+	}
+}
+
+// ClientType returns 12-rollup.
+func (ConsensusState) ClientType() string {
+	return ModuleName
+}
+
+// GetRoot returns the root of the rollup state tree.
+func (cs ConsensusState) GetRoot() exported.Root {
+	return cs.Root
+}
+
+// GetTimestamp returns the timestamp in nanoseconds of the rollup block.
+func (cs ConsensusState) GetTimestamp() uint64 {
+	return cs.Timestamp
+}
+
+// ValidateBasic defines a basic validation for the rollup consensus state.
+func (cs ConsensusState) ValidateBasic() error {
+	if cs.Root.Empty() {
+		return errorsmod.Wrap(clienttypes.ErrInvalidConsensus, "root cannot be empty")
+	}
+	if cs.Timestamp == 0 {
+		return errorsmod.Wrap(clienttypes.ErrInvalidConsensus, "timestamp cannot be zero")
+	}
+
+	return nil
+}
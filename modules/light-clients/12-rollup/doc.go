@@ -0,0 +1,14 @@
+/*
+Package rollup implements a concrete LightClientModule, ClientState, ConsensusState
+and Header types for a light client of a rollup which settles its state on a chain
+tracked by a 07-tendermint client.
+The settlement chain stores a commitment to the state root and timestamp of every settled rollup
+block. The client is updated by proving such a state commitment against the store of the settlement
+chain through the 07-tendermint host client and the ICS 23 proofs of 23-commitment, and stores the
+state root as a consensus state. Membership and non-membership are then verified against ICS 23
+proofs of the rollup state tree.
+
+Note that client identifiers are expected to be in the form: 12-rollup-{N}.
+Client identifiers are generated and validated by core IBC, unexpected client identifiers will result in errors.
+*/
+package rollup
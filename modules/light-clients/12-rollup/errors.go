@@ -0,0 +1,18 @@
+package rollup
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// IBC rollup client sentinel errors
+var (
+	ErrInvalidChainID               = errorsmod.Register(ModuleName, 2, "invalid chain-id")
+	ErrInvalidHostClient            = errorsmod.Register(ModuleName, 3, "invalid host client")
+	ErrInvalidProofSpecs            = errorsmod.Register(ModuleName, 4, "invalid proof specs")
+	ErrInvalidHeaderHeight          = errorsmod.Register(ModuleName, 5, "invalid header height")
+	ErrInvalidStateCommitment       = errorsmod.Register(ModuleName, 6, "invalid state commitment")
+	ErrInvalidStateCommitmentPrefix = errorsmod.Register(ModuleName, 7, "invalid state commitment prefix")
+	ErrProcessedTimeNotFound        = errorsmod.Register(ModuleName, 8, "processed time not found")
+	ErrProcessedHeightNotFound      = errorsmod.Register(ModuleName, 9, "processed height not found")
+	ErrDelayPeriodNotPassed         = errorsmod.Register(ModuleName, 10, "packet-specified delay period has not been reached")
+)
@@ -0,0 +1,14 @@
+package rollup
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+// ClientKeeper defines the expected 02-client keeper. It is used to verify the state commitments
+// of the rollup against the host client of the settlement chain.
+type ClientKeeper interface {
+	GetClientStatus(ctx sdk.Context, clientID string) exported.Status
+	VerifyMembership(ctx sdk.Context, clientID string, height exported.Height, delayTimePeriod uint64, delayBlockPeriod uint64, proof []byte, path exported.Path, value []byte) error
+}
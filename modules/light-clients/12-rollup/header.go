@@ -0,0 +1,67 @@
+package rollup
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+var _ exported.ClientMessage = (*Header)(nil)
+
+// NewHeader creates a new Header instance.
+func NewHeader(height clienttypes.Height, stateCommitment StateCommitment, hostHeight clienttypes.Height, proofStateCommitment []byte) *Header {
+	return &Header{
+		Height:               height,
+		StateCommitment:      stateCommitment,
+		HostHeight:           hostHeight,
+		ProofStateCommitment: proofStateCommitment,
+	}
+}
+
+// ClientType defines that the Header is a rollup header
+func (Header) ClientType() string {
+	return ModuleName
+}
+
+// GetHeight returns the rollup height of the header
+func (h Header) GetHeight() exported.Height {
+	return h.Height
+}
+
+// ValidateBasic performs a basic validation of the header. The height of the rollup and of the
+// host client must be non-zero and the state commitment and its proof must be set.
+func (h Header) ValidateBasic() error {
+	if h.Height.RevisionHeight == 0 {
+		return errorsmod.Wrap(ErrInvalidHeaderHeight, "revision height cannot be zero")
+	}
+	if h.HostHeight.IsZero() {
+		return errorsmod.Wrap(ErrInvalidHeaderHeight, "host height cannot be zero")
+	}
+	if err := h.StateCommitment.ValidateBasic(); err != nil {
+		return err
+	}
+	if len(h.ProofStateCommitment) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "state commitment proof cannot be empty")
+	}
+
+	return nil
+}
+
+// ValidateBasic performs a basic validation of the state commitment.
+func (sc StateCommitment) ValidateBasic() error {
+	if len(sc.StateRoot) == 0 {
+		return errorsmod.Wrap(ErrInvalidStateCommitment, "state root cannot be empty")
+	}
+	if sc.Timestamp == 0 {
+		return errorsmod.Wrap(ErrInvalidStateCommitment, "timestamp cannot be zero")
+	}
+
+	return nil
+}
+
+// ConsensusState returns the consensus state of the state commitment.
+func (sc StateCommitment) ConsensusState() *ConsensusState {
+	return NewConsensusState(sc.Timestamp, commitmenttypes.NewMerkleRoot(sc.StateRoot))
+}
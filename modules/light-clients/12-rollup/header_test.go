@@ -0,0 +1,72 @@
+package rollup_test
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	rollup "github.com/cosmos/ibc-go/v10/modules/light-clients/12-rollup"
+)
+
+func (suite *RollupTestSuite) TestHeaderValidateBasic() {
+	var header *rollup.Header
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{"valid header", func() {}, nil},
+		{"zero revision height", func() { header.Height.RevisionHeight = 0 }, rollup.ErrInvalidHeaderHeight},
+		{"zero host height", func() { header.HostHeight = clienttypes.ZeroHeight() }, rollup.ErrInvalidHeaderHeight},
+		{"empty state root", func() { header.StateCommitment.StateRoot = nil }, rollup.ErrInvalidStateCommitment},
+		{"zero timestamp", func() { header.StateCommitment.Timestamp = 0 }, rollup.ErrInvalidStateCommitment},
+		{"empty state commitment proof", func() { header.ProofStateCommitment = nil }, commitmenttypes.ErrInvalidProof},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			height, stateCommitment := suite.rollupState()
+			header = rollup.NewHeader(height, stateCommitment, clienttypes.NewHeight(0, 10), []byte("proof"))
+
+			tc.malleate()
+
+			err := header.ValidateBasic()
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *RollupTestSuite) TestConsensusStateValidateBasic() {
+	var consensusState *rollup.ConsensusState
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{"valid consensus state", func() {}, nil},
+		{"empty root", func() { consensusState.Root = commitmenttypes.MerkleRoot{} }, clienttypes.ErrInvalidConsensus},
+		{"zero timestamp", func() { consensusState.Timestamp = 0 }, clienttypes.ErrInvalidConsensus},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, stateCommitment := suite.rollupState()
+			consensusState = stateCommitment.ConsensusState()
+
+			tc.malleate()
+
+			err := consensusState.ValidateBasic()
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,5 @@
+package rollup
+
+const (
+	ModuleName = "12-rollup"
+)
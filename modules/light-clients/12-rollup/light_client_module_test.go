@@ -0,0 +1,652 @@
+package rollup_test
+
+import (
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	commitmenttypesv2 "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types/v2"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+	rollup "github.com/cosmos/ibc-go/v10/modules/light-clients/12-rollup"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+	ibcmock "github.com/cosmos/ibc-go/v10/testing/mock"
+)
+
+var rollupClientID = clienttypes.FormatClientIdentifier(rollup.ModuleName, 100)
+
+func (suite *RollupTestSuite) TestInitialize() {
+	var (
+		clientState    *rollup.ClientState
+		consensusState *rollup.ConsensusState
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"invalid client state",
+			func() {
+				clientState.ChainId = ""
+			},
+			rollup.ErrInvalidChainID,
+		},
+		{
+			"host client is not a tendermint client",
+			func() {
+				clientState.HostClientId = clienttypes.FormatClientIdentifier(exported.Solomachine, 0)
+			},
+			rollup.ErrInvalidHostClient,
+		},
+		{
+			"invalid consensus state",
+			func() {
+				consensusState.Timestamp = 0
+			},
+			clienttypes.ErrInvalidConsensus,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			height, stateCommitment := suite.rollupState()
+			clientState = suite.newClientState(height)
+			consensusState = stateCommitment.ConsensusState()
+
+			clientID := suite.chainA.App.GetIBCKeeper().ClientKeeper.GenerateClientIdentifier(suite.chainA.GetContext(), rollup.ModuleName)
+
+			lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), clientID)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			clientStateBz := suite.chainA.Codec.MustMarshal(clientState)
+			consensusStateBz := suite.chainA.Codec.MustMarshal(consensusState)
+
+			err = lightClientModule.Initialize(suite.chainA.GetContext(), clientID, clientStateBz, consensusStateBz)
+
+			store := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), clientID)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().True(store.Has(host.ClientStateKey()))
+				suite.Require().True(store.Has(host.ConsensusStateKey(height)))
+
+				_, found := ibctm.GetProcessedTime(store, height)
+				suite.Require().True(found)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().False(store.Has(host.ClientStateKey()))
+			}
+		})
+	}
+}
+
+func (suite *RollupTestSuite) TestUpdateClient() {
+	var (
+		clientID  string
+		header    *rollup.Header
+		expHeight clienttypes.Height
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: state commitment for a height lower than the latest height",
+			func() {
+				lowerHeight, lowerStateCommitment := suite.rollupState()
+				suite.coordinator.CommitBlock(suite.chainC)
+
+				latestHeader := suite.settle(suite.rollupState())
+				err := suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(suite.chainA.GetContext(), clientID, latestHeader)
+				suite.Require().NoError(err)
+
+				header = suite.settle(lowerHeight, lowerStateCommitment)
+				expHeight = latestHeader.Height
+			},
+			nil,
+		},
+		{
+			"success: duplicate update",
+			func() {
+				err := suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(suite.chainA.GetContext(), clientID, header)
+				suite.Require().NoError(err)
+			},
+			nil,
+		},
+		{
+			"failure: state commitment does not match the settled state commitment",
+			func() {
+				header.StateCommitment.Timestamp++
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: state commitment has not been settled for the height",
+			func() {
+				header.Height = header.Height.Increment().(clienttypes.Height)
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: header height revision number does not match the chain id",
+			func() {
+				header.Height.RevisionNumber++
+			},
+			rollup.ErrInvalidHeaderHeight,
+		},
+		{
+			"failure: host height is greater than the latest height of the host client",
+			func() {
+				header.HostHeight = header.HostHeight.Increment().(clienttypes.Height)
+			},
+			ibcerrors.ErrInvalidHeight,
+		},
+		{
+			"failure: host client is frozen",
+			func() {
+				err := suite.chainA.App.GetIBCKeeper().ClientKeeper.FreezeClient(suite.chainA.GetContext(), suite.hostClientID())
+				suite.Require().NoError(err)
+			},
+			clienttypes.ErrClientNotActive,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			clientID = suite.createClient()
+
+			suite.coordinator.CommitBlock(suite.chainC)
+			header = suite.settle(suite.rollupState())
+			expHeight = header.Height
+
+			tc.malleate()
+
+			err := suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(suite.chainA.GetContext(), clientID, header)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				clientState := suite.getClientState(clientID)
+				suite.Require().Equal(expHeight, clientState.LatestHeight)
+
+				consensusState, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientConsensusState(suite.chainA.GetContext(), clientID, header.Height)
+				suite.Require().True(found)
+				suite.Require().Equal(header.StateCommitment.ConsensusState(), consensusState)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *RollupTestSuite) TestMisbehaviour() {
+	clientID := suite.createClient()
+
+	suite.coordinator.CommitBlock(suite.chainC)
+	height, stateCommitment := suite.rollupState()
+
+	err := suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(suite.chainA.GetContext(), clientID, suite.settle(height, stateCommitment))
+	suite.Require().NoError(err)
+
+	lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), clientID)
+	suite.Require().NoError(err)
+
+	// the settlement chain commits to a conflicting state of the rollup at the same height
+	conflictingStateCommitment := stateCommitment
+	conflictingStateCommitment.StateRoot = []byte("conflicting state root")
+	conflictingHeader := suite.settle(height, conflictingStateCommitment)
+
+	suite.Require().False(lightClientModule.CheckForMisbehaviour(suite.chainA.GetContext(), clientID, suite.settle(height, stateCommitment)))
+	suite.Require().True(lightClientModule.CheckForMisbehaviour(suite.chainA.GetContext(), clientID, conflictingHeader))
+
+	err = suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(suite.chainA.GetContext(), clientID, conflictingHeader)
+	suite.Require().NoError(err)
+
+	suite.Require().True(suite.getClientState(clientID).IsFrozen)
+	suite.Require().Equal(exported.Frozen, lightClientModule.Status(suite.chainA.GetContext(), clientID))
+}
+
+func (suite *RollupTestSuite) TestStatus() {
+	var clientID string
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expStatus exported.Status
+	}{
+		{
+			"client is active",
+			func() {},
+			exported.Active,
+		},
+		{
+			"client is frozen",
+			func() {
+				clientState := suite.getClientState(clientID)
+				clientState.IsFrozen = true
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), clientID, clientState)
+			},
+			exported.Frozen,
+		},
+		{
+			"host client is frozen",
+			func() {
+				err := suite.chainA.App.GetIBCKeeper().ClientKeeper.FreezeClient(suite.chainA.GetContext(), suite.hostClientID())
+				suite.Require().NoError(err)
+			},
+			exported.Frozen,
+		},
+		{
+			"host client is expired",
+			func() {
+				suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod)
+			},
+			exported.Expired,
+		},
+		{
+			"client is expired: latest consensus state not found",
+			func() {
+				clientState := suite.getClientState(clientID)
+				clientState.LatestHeight = clientState.LatestHeight.Increment().(clienttypes.Height)
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), clientID, clientState)
+			},
+			exported.Expired,
+		},
+		{
+			"client state not found",
+			func() {
+				clientID = rollupClientID
+			},
+			exported.Unknown,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			clientID = suite.createClient()
+
+			lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), clientID)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			suite.Require().Equal(tc.expStatus, lightClientModule.Status(suite.chainA.GetContext(), clientID))
+		})
+	}
+}
+
+func (suite *RollupTestSuite) TestLatestHeightAndTimestampAtHeight() {
+	clientID := suite.createClient()
+	height, stateCommitment := suite.rollupState()
+
+	lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), clientID)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(height, lightClientModule.LatestHeight(suite.chainA.GetContext(), clientID))
+	suite.Require().Equal(clienttypes.ZeroHeight(), lightClientModule.LatestHeight(suite.chainA.GetContext(), rollupClientID))
+
+	timestamp, err := lightClientModule.TimestampAtHeight(suite.chainA.GetContext(), clientID, height)
+	suite.Require().NoError(err)
+	suite.Require().Equal(stateCommitment.Timestamp, timestamp)
+
+	_, err = lightClientModule.TimestampAtHeight(suite.chainA.GetContext(), clientID, height.Increment())
+	suite.Require().ErrorIs(err, clienttypes.ErrConsensusStateNotFound)
+
+	_, err = lightClientModule.TimestampAtHeight(suite.chainA.GetContext(), rollupClientID, height)
+	suite.Require().ErrorIs(err, clienttypes.ErrClientNotFound)
+}
+
+func (suite *RollupTestSuite) TestVerifyMembership() {
+	var (
+		clientID         string
+		height           exported.Height
+		delayTimePeriod  uint64
+		delayBlockPeriod uint64
+		proof            []byte
+		path             exported.Path
+		value            []byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: delay periods have passed",
+			func() {
+				delayTimePeriod = uint64(time.Hour.Nanoseconds())
+				delayBlockPeriod = 1
+
+				suite.coordinator.IncrementTimeBy(time.Hour)
+				suite.coordinator.CommitBlock(suite.chainA)
+			},
+			nil,
+		},
+		{
+			"success: consensus state of an updated height",
+			func() {
+				suite.chainC.GetContext().KVStore(suite.chainC.GetSimApp().GetKey(exported.StoreKey)).Set(rollupKey, []byte("updated value"))
+				suite.coordinator.CommitNBlocks(suite.chainC, 2)
+
+				rollupHeight, stateCommitment := suite.rollupState()
+				err := suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(suite.chainA.GetContext(), clientID, suite.settle(rollupHeight, stateCommitment))
+				suite.Require().NoError(err)
+
+				proof, height = suite.chainC.QueryProof(rollupKey)
+				value = []byte("updated value")
+			},
+			nil,
+		},
+		{
+			"failure: delay time period has not passed",
+			func() {
+				delayTimePeriod = uint64(time.Hour.Nanoseconds())
+			},
+			rollup.ErrDelayPeriodNotPassed,
+		},
+		{
+			"failure: delay block period has not passed",
+			func() {
+				delayBlockPeriod = 10
+			},
+			rollup.ErrDelayPeriodNotPassed,
+		},
+		{
+			"failure: proof height is greater than the latest height",
+			func() {
+				height = height.Increment()
+			},
+			ibcerrors.ErrInvalidHeight,
+		},
+		{
+			"failure: consensus state not found",
+			func() {
+				height = clienttypes.NewHeight(height.GetRevisionNumber(), 1)
+			},
+			clienttypes.ErrConsensusStateNotFound,
+		},
+		{
+			"failure: invalid proof",
+			func() {
+				proof = []byte("invalid proof")
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: invalid path type",
+			func() {
+				path = ibcmock.KeyPath{}
+			},
+			ibcerrors.ErrInvalidType,
+		},
+		{
+			"failure: value does not match",
+			func() {
+				value = []byte("invalid value")
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: client not found",
+			func() {
+				clientID = rollupClientID
+			},
+			clienttypes.ErrClientNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			clientID = suite.createClient()
+
+			delayTimePeriod, delayBlockPeriod = 0, 0
+			proof, height = suite.chainC.QueryProof(rollupKey)
+			path = commitmenttypesv2.NewMerklePath([]byte(exported.StoreKey), rollupKey)
+			value = rollupValue
+
+			lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), clientID)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			err = lightClientModule.VerifyMembership(suite.chainA.GetContext(), clientID, height, delayTimePeriod, delayBlockPeriod, proof, path, value)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *RollupTestSuite) TestVerifyNonMembership() {
+	var (
+		clientID string
+		proof    []byte
+		height   exported.Height
+		path     exported.Path
+	)
+
+	absentKey := []byte("absentKey")
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: key exists in the rollup state",
+			func() {
+				proof, height = suite.chainC.QueryProof(rollupKey)
+				path = commitmenttypesv2.NewMerklePath([]byte(exported.StoreKey), rollupKey)
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: proof height is greater than the latest height",
+			func() {
+				height = height.Increment()
+			},
+			ibcerrors.ErrInvalidHeight,
+		},
+		{
+			"failure: client not found",
+			func() {
+				clientID = rollupClientID
+			},
+			clienttypes.ErrClientNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			clientID = suite.createClient()
+
+			proof, height = suite.chainC.QueryProof(absentKey)
+			path = commitmenttypesv2.NewMerklePath([]byte(exported.StoreKey), absentKey)
+
+			lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), clientID)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			err = lightClientModule.VerifyNonMembership(suite.chainA.GetContext(), clientID, height, 0, 0, proof, path)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *RollupTestSuite) TestRecoverClient() {
+	var (
+		subjectClientID, substituteClientID string
+		substituteHostClientID              string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: substitute client does not match subject client",
+			func() {
+				substituteClientState := suite.getClientState(substituteClientID)
+				substituteClientState.StateCommitmentPrefix = commitmenttypesv2.NewMerklePath([]byte(exported.StoreKey), []byte("other/"))
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), substituteClientID, substituteClientState)
+			},
+			clienttypes.ErrInvalidSubstitute,
+		},
+		{
+			"failure: substitute client is not a rollup client",
+			func() {
+				substituteClientID = suite.hostClientID()
+			},
+			clienttypes.ErrInvalidClientType,
+		},
+		{
+			"failure: subject client not found",
+			func() {
+				subjectClientID = rollupClientID
+			},
+			clienttypes.ErrClientNotFound,
+		},
+		{
+			"failure: substitute client not found",
+			func() {
+				substituteClientID = rollupClientID
+			},
+			clienttypes.ErrClientNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			subjectClientID = suite.createClient()
+
+			subjectClientState := suite.getClientState(subjectClientID)
+			subjectClientState.IsFrozen = true
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), subjectClientID, subjectClientState)
+
+			// the substitute client uses a new host client of the settlement chain
+			substitutePath := ibctesting.NewPath(suite.chainA, suite.chainB)
+			substitutePath.SetupClients()
+			substituteHostClientID = substitutePath.EndpointA.ClientID
+
+			suite.coordinator.CommitBlock(suite.chainC)
+			height, stateCommitment := suite.rollupState()
+			substituteClientState := rollup.NewClientState(suite.chainC.ChainID, substituteHostClientID, stateCommitmentPrefix, commitmenttypes.GetSDKSpecs(), height)
+
+			var err error
+			substituteClientID, err = suite.chainA.App.GetIBCKeeper().ClientKeeper.CreateClient(
+				suite.chainA.GetContext(), rollup.ModuleName,
+				suite.chainA.Codec.MustMarshal(substituteClientState), suite.chainA.Codec.MustMarshal(stateCommitment.ConsensusState()),
+			)
+			suite.Require().NoError(err)
+
+			lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), subjectClientID)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			err = lightClientModule.RecoverClient(suite.chainA.GetContext(), subjectClientID, substituteClientID)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				clientState := suite.getClientState(subjectClientID)
+				suite.Require().False(clientState.IsFrozen)
+				suite.Require().Equal(substituteHostClientID, clientState.HostClientId)
+				suite.Require().Equal(height, clientState.LatestHeight)
+				suite.Require().Equal(exported.Active, lightClientModule.Status(suite.chainA.GetContext(), subjectClientID))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *RollupTestSuite) TestFreezeClient() {
+	clientID := suite.createClient()
+
+	lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), clientID)
+	suite.Require().NoError(err)
+
+	freezer, ok := lightClientModule.(exported.ClientFreezer)
+	suite.Require().True(ok)
+
+	err = freezer.UnfreezeClient(suite.chainA.GetContext(), clientID)
+	suite.Require().ErrorIs(err, clienttypes.ErrClientNotFrozen)
+
+	err = freezer.FreezeClient(suite.chainA.GetContext(), clientID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(exported.Frozen, lightClientModule.Status(suite.chainA.GetContext(), clientID))
+
+	err = freezer.UnfreezeClient(suite.chainA.GetContext(), clientID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(exported.Active, lightClientModule.Status(suite.chainA.GetContext(), clientID))
+
+	err = freezer.FreezeClient(suite.chainA.GetContext(), rollupClientID)
+	suite.Require().ErrorIs(err, clienttypes.ErrClientNotFound)
+}
+
+func (suite *RollupTestSuite) TestVerifyUpgradeAndUpdateState() {
+	clientID := suite.createClient()
+
+	lightClientModule, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(suite.chainA.GetContext(), clientID)
+	suite.Require().NoError(err)
+
+	err = lightClientModule.VerifyUpgradeAndUpdateState(suite.chainA.GetContext(), clientID, nil, nil, nil, nil)
+	suite.Require().ErrorIs(err, clienttypes.ErrInvalidUpgradeClient)
+}
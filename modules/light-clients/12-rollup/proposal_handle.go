@@ -0,0 +1,73 @@
+package rollup
+
+import (
+	"reflect"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+// CheckSubstituteAndUpdateState will try to update the client with the state of the
+// substitute.
+//
+// The following must always be true:
+//   - The substitute client is the same type as the subject client
+//   - The subject and substitute client states match in all parameters (except the host client
+//     identifier, the latest height and the frozen flag)
+//
+// The host client identifier is copied from the substitute, which allows governance to move the
+// client to a new host client of the settlement chain. Before updating the client, the client is unfrozen.
+func (cs ClientState) CheckSubstituteAndUpdateState(
+	ctx sdk.Context, cdc codec.BinaryCodec, subjectClientStore,
+	substituteClientStore storetypes.KVStore, substituteClient exported.ClientState,
+) error {
+	substituteClientState, ok := substituteClient.(*ClientState)
+	if !ok {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidClient, "expected type %T, got %T", &ClientState{}, substituteClient)
+	}
+
+	if !IsMatchingClientState(cs, *substituteClientState) {
+		return errorsmod.Wrap(clienttypes.ErrInvalidSubstitute, "subject client state does not match substitute client state")
+	}
+
+	// copy the latest consensus state from substitute to subject
+	height := substituteClientState.LatestHeight
+
+	consensusState, found := GetConsensusState(substituteClientStore, cdc, height)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrConsensusStateNotFound, "unable to retrieve latest consensus state for substitute client")
+	}
+
+	setConsensusState(subjectClientStore, cdc, consensusState, height)
+	setConsensusMetadata(ctx, subjectClientStore, height)
+
+	cs.HostClientId = substituteClientState.HostClientId
+	cs.LatestHeight = substituteClientState.LatestHeight
+	cs.IsFrozen = false
+
+	// no validation is necessary since the substitute is verified to be Active
+	// in 02-client.
+	setClientState(subjectClientStore, cdc, &cs)
+
+	return nil
+}
+
+// IsMatchingClientState returns true if all the client state parameters match
+// except for the host client identifier, latest height and frozen flag.
+func IsMatchingClientState(subject, substitute ClientState) bool {
+	// zero out parameters which do not need to match
+	subject.HostClientId = ""
+	subject.LatestHeight = clienttypes.ZeroHeight()
+	subject.IsFrozen = false
+	substitute.HostClientId = ""
+	substitute.LatestHeight = clienttypes.ZeroHeight()
+	substitute.IsFrozen = false
+
+	return reflect.DeepEqual(subject, substitute)
+}
@@ -0,0 +1,115 @@
+package rollup_test
+
+import (
+	"testing"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	commitmenttypesv2 "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types/v2"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+	rollup "github.com/cosmos/ibc-go/v10/modules/light-clients/12-rollup"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+// stateCommitmentKeyPrefix is the key prefix in the IBC store of the settlement chain under which
+// the rollup state commitments are stored in tests.
+const stateCommitmentKeyPrefix = "rollupStateCommitments/"
+
+var (
+	stateCommitmentPrefix = commitmenttypesv2.NewMerklePath([]byte(exported.StoreKey), []byte(stateCommitmentKeyPrefix))
+
+	rollupKey   = []byte("rollupKey")
+	rollupValue = []byte("rollupValue")
+)
+
+// RollupTestSuite tests the rollup client on chainA. chainB acts as the settlement chain, tracked by
+// a 07-tendermint client on chainA, and chainC acts as the rollup whose state roots are settled on chainB.
+type RollupTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chainA      *ibctesting.TestChain
+	chainB      *ibctesting.TestChain
+	chainC      *ibctesting.TestChain
+
+	// settlementPath contains the 07-tendermint client of chainB on chainA
+	settlementPath *ibctesting.Path
+}
+
+func TestRollupTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(RollupTestSuite))
+}
+
+func (suite *RollupTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 3)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+	suite.chainC = suite.coordinator.GetChain(ibctesting.GetChainID(3))
+
+	suite.settlementPath = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.settlementPath.SetupClients()
+
+	// store a value in the rollup state which is proven in membership tests. The value is committed to
+	// by the state root of the following block.
+	suite.chainC.GetContext().KVStore(suite.chainC.GetSimApp().GetKey(exported.StoreKey)).Set(rollupKey, rollupValue)
+	suite.coordinator.CommitNBlocks(suite.chainC, 2)
+}
+
+// hostClientID returns the identifier of the 07-tendermint client of the settlement chain on chainA.
+func (suite *RollupTestSuite) hostClientID() string {
+	return suite.settlementPath.EndpointA.ClientID
+}
+
+// newClientState returns a rollup client state of chainC at the given height.
+func (suite *RollupTestSuite) newClientState(height clienttypes.Height) *rollup.ClientState {
+	return rollup.NewClientState(suite.chainC.ChainID, suite.hostClientID(), stateCommitmentPrefix, commitmenttypes.GetSDKSpecs(), height)
+}
+
+// rollupState returns the latest committed height of chainC along with the state commitment of its state root
+// and timestamp. Proofs of chainC queried with QueryProof are verified against this state commitment.
+func (suite *RollupTestSuite) rollupState() (clienttypes.Height, rollup.StateCommitment) {
+	header := suite.chainC.LatestCommittedHeader
+	height := header.GetHeight().(clienttypes.Height)
+
+	return height, rollup.StateCommitment{
+		StateRoot: header.Header.AppHash,
+		Timestamp: uint64(header.GetTime().UnixNano()),
+	}
+}
+
+// settle stores the state commitment for the rollup height on the settlement chain, updates the host client
+// on chainA and returns a header proving the state commitment against the host client.
+func (suite *RollupTestSuite) settle(height clienttypes.Height, stateCommitment rollup.StateCommitment) *rollup.Header {
+	key := append([]byte(stateCommitmentKeyPrefix), []byte(height.String())...)
+	suite.chainB.GetContext().KVStore(suite.chainB.GetSimApp().GetKey(exported.StoreKey)).Set(key, suite.chainB.Codec.MustMarshal(&stateCommitment))
+	suite.coordinator.CommitBlock(suite.chainB)
+
+	suite.Require().NoError(suite.settlementPath.EndpointA.UpdateClient())
+
+	proof, hostHeight := suite.chainB.QueryProof(key)
+
+	return rollup.NewHeader(height, stateCommitment, hostHeight, proof)
+}
+
+// createClient creates a rollup client on chainA from the latest state of chainC and returns its identifier.
+func (suite *RollupTestSuite) createClient() string {
+	height, stateCommitment := suite.rollupState()
+
+	clientStateBz := suite.chainA.Codec.MustMarshal(suite.newClientState(height))
+	consensusStateBz := suite.chainA.Codec.MustMarshal(stateCommitment.ConsensusState())
+
+	clientID, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.CreateClient(suite.chainA.GetContext(), rollup.ModuleName, clientStateBz, consensusStateBz)
+	suite.Require().NoError(err)
+
+	return clientID
+}
+
+// getClientState returns the rollup client state of the client on chainA.
+func (suite *RollupTestSuite) getClientState(clientID string) *rollup.ClientState {
+	clientState, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientState(suite.chainA.GetContext(), clientID)
+	suite.Require().True(found)
+
+	return clientState.(*rollup.ClientState)
+}
@@ -0,0 +1,105 @@
+package rollup
+
+import (
+	"bytes"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+// VerifyClientMessage checks if the clientMessage is of type Header and verifies the message
+func (cs *ClientState) VerifyClientMessage(
+	ctx sdk.Context, cdc codec.BinaryCodec, clientKeeper ClientKeeper,
+	clientMsg exported.ClientMessage,
+) error {
+	switch msg := clientMsg.(type) {
+	case *Header:
+		return cs.verifyHeader(ctx, cdc, clientKeeper, msg)
+	default:
+		return clienttypes.ErrInvalidClientType
+	}
+}
+
+// verifyHeader returns an error if:
+// - the revision number of the header height does not match the chain id revision number
+// - the state commitment is not stored at the state commitment path of the header height in the
+// settlement chain store, as proven against the consensus state of the host client at the host height
+func (cs *ClientState) verifyHeader(
+	ctx sdk.Context, cdc codec.BinaryCodec, clientKeeper ClientKeeper,
+	header *Header,
+) error {
+	if header.Height.RevisionNumber != clienttypes.ParseChainID(cs.ChainId) {
+		return errorsmod.Wrapf(ErrInvalidHeaderHeight, "header height revision number must match chain id revision number (%d != %d)", header.Height.RevisionNumber, clienttypes.ParseChainID(cs.ChainId))
+	}
+
+	value, err := cdc.Marshal(&header.StateCommitment)
+	if err != nil {
+		return err
+	}
+
+	path := cs.StateCommitmentPath(header.Height)
+	if err := clientKeeper.VerifyMembership(ctx, cs.HostClientId, header.HostHeight, 0, 0, header.ProofStateCommitment, path, value); err != nil {
+		return errorsmod.Wrapf(err, "failed to verify state commitment for height %s against host client %s at height %s", header.Height, cs.HostClientId, header.HostHeight)
+	}
+
+	return nil
+}
+
+// UpdateState sets the consensus state of the verified state commitment along with its metadata and updates
+// the latest height of the client state if the header height is newer. If a consensus state already exists for
+// the header height, no update is performed.
+func (cs ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, clientMsg exported.ClientMessage) []exported.Height {
+	header, ok := clientMsg.(*Header)
+	if !ok {
+		// clientMsg is invalid Misbehaviour, no update necessary
+		return []exported.Height{}
+	}
+
+	// check for duplicate update
+	if _, found := GetConsensusState(clientStore, cdc, header.GetHeight()); found {
+		// perform no-op
+		return []exported.Height{header.GetHeight()}
+	}
+
+	if header.Height.GT(cs.LatestHeight) {
+		cs.LatestHeight = header.Height
+	}
+
+	setClientState(clientStore, cdc, &cs)
+	setConsensusState(clientStore, cdc, header.StateCommitment.ConsensusState(), header.GetHeight())
+	setConsensusMetadata(ctx, clientStore, header.GetHeight())
+
+	return []exported.Height{header.GetHeight()}
+}
+
+// CheckForMisbehaviour detects duplicate height misbehaviour. A header is misbehaviour if a consensus state
+// already exists for its height with a different state root or timestamp. Two conflicting state commitments
+// can only be proven if the settlement chain committed to conflicting rollup states, which should freeze the client.
+func (ClientState) CheckForMisbehaviour(_ sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, msg exported.ClientMessage) bool {
+	header, ok := msg.(*Header)
+	if !ok {
+		return false
+	}
+
+	existingConsState, found := GetConsensusState(clientStore, cdc, header.GetHeight())
+	if !found {
+		return false
+	}
+
+	return !bytes.Equal(existingConsState.Root.GetHash(), header.StateCommitment.StateRoot) ||
+		existingConsState.Timestamp != header.StateCommitment.Timestamp
+}
+
+// UpdateStateOnMisbehaviour updates state upon misbehaviour, freezing the ClientState. This method should only be called when misbehaviour is detected
+// as it does not perform any misbehaviour checks.
+func (cs ClientState) UpdateStateOnMisbehaviour(_ sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, _ exported.ClientMessage) {
+	cs.IsFrozen = true
+
+	setClientState(clientStore, cdc, &cs)
+}
@@ -121,6 +121,8 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
 	solomachine "github.com/cosmos/ibc-go/v10/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+	ethereum "github.com/cosmos/ibc-go/v10/modules/light-clients/11-ethereum"
+	rollup "github.com/cosmos/ibc-go/v10/modules/light-clients/12-rollup"
 )
 
 const appName = "SimApp"
@@ -397,6 +399,7 @@ func NewSimApp(
 		appCodec, runtime.NewKVStoreService(keys[ibctransfertypes.StoreKey]), app.GetSubspace(ibctransfertypes.ModuleName),
 		app.IBCKeeper.ChannelKeeper,
 		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ClientV2Keeper,
 		app.MsgServiceRouter(),
 		app.AccountKeeper, app.BankKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
@@ -445,6 +448,12 @@ func NewSimApp(
 	smLightClientModule := solomachine.NewLightClientModule(appCodec, storeProvider)
 	clientKeeper.AddRoute(solomachine.ModuleName, &smLightClientModule)
 
+	ethLightClientModule := ethereum.NewLightClientModule(appCodec, storeProvider)
+	clientKeeper.AddRoute(ethereum.ModuleName, &ethLightClientModule)
+
+	rollupLightClientModule := rollup.NewLightClientModule(appCodec, storeProvider, clientKeeper)
+	clientKeeper.AddRoute(rollup.ModuleName, &rollupLightClientModule)
+
 	// create evidence keeper with router
 	evidenceKeeper := evidencekeeper.NewKeeper(
 		appCodec, runtime.NewKVStoreService(keys[evidencetypes.StoreKey]), app.StakingKeeper, app.SlashingKeeper, app.AccountKeeper.AddressCodec(), runtime.ProvideCometInfoService(),
@@ -491,6 +500,8 @@ func NewSimApp(
 		// IBC light clients
 		ibctm.NewAppModule(tmLightClientModule),
 		solomachine.NewAppModule(smLightClientModule),
+		ethereum.NewAppModule(ethLightClientModule),
+		rollup.NewAppModule(rollupLightClientModule),
 	)
 
 	// BasicModuleManager defines the module BasicManager is in charge of setting up basic,
@@ -2,11 +2,14 @@ package ibctesting
 
 import (
 	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	clientv2types "github.com/cosmos/ibc-go/v10/modules/core/02-client/v2/types"
 	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
 	hostv2 "github.com/cosmos/ibc-go/v10/modules/core/24-host/v2"
 )
 
@@ -20,6 +23,15 @@ func (endpoint *Endpoint) RegisterCounterparty() (err error) {
 	return err
 }
 
+// UpdateClientConfig will construct and execute a MsgUpdateClientConfig on the associated endpoint.
+func (endpoint *Endpoint) UpdateClientConfig(config clientv2types.Config) (err error) {
+	msg := clientv2types.NewMsgUpdateClientConfig(endpoint.ClientID, endpoint.Chain.SenderAccount.GetAddress().String(), config)
+
+	_, err = endpoint.Chain.SendMsgs(msg)
+
+	return err
+}
+
 // MsgSendPacket sends a packet on the associated endpoint using a predefined sender. The constructed packet is returned.
 func (endpoint *Endpoint) MsgSendPacket(timeoutTimestamp uint64, payload channeltypesv2.Payload) (channeltypesv2.Packet, error) {
 	senderAccount := SenderAccount{
@@ -30,9 +42,24 @@ func (endpoint *Endpoint) MsgSendPacket(timeoutTimestamp uint64, payload channel
 	return endpoint.MsgSendPacketWithSender(timeoutTimestamp, payload, senderAccount)
 }
 
+// MsgSendPacketWithPayloads sends a packet containing the provided payloads on the associated endpoint using a predefined sender.
+// The constructed packet is returned.
+func (endpoint *Endpoint) MsgSendPacketWithPayloads(timeoutTimestamp uint64, payloads ...channeltypesv2.Payload) (channeltypesv2.Packet, error) {
+	senderAccount := SenderAccount{
+		SenderPrivKey: endpoint.Chain.SenderPrivKey,
+		SenderAccount: endpoint.Chain.SenderAccount,
+	}
+
+	return endpoint.msgSendPacketWithSender(timeoutTimestamp, senderAccount, payloads...)
+}
+
 // MsgSendPacketWithSender sends a packet on the associated endpoint using the provided sender. The constructed packet is returned.
 func (endpoint *Endpoint) MsgSendPacketWithSender(timeoutTimestamp uint64, payload channeltypesv2.Payload, sender SenderAccount) (channeltypesv2.Packet, error) {
-	msgSendPacket := channeltypesv2.NewMsgSendPacket(endpoint.ClientID, timeoutTimestamp, sender.SenderAccount.GetAddress().String(), payload)
+	return endpoint.msgSendPacketWithSender(timeoutTimestamp, sender, payload)
+}
+
+func (endpoint *Endpoint) msgSendPacketWithSender(timeoutTimestamp uint64, sender SenderAccount, payloads ...channeltypesv2.Payload) (channeltypesv2.Packet, error) {
+	msgSendPacket := channeltypesv2.NewMsgSendPacket(endpoint.ClientID, timeoutTimestamp, sender.SenderAccount.GetAddress().String(), payloads...)
 
 	res, err := endpoint.Chain.SendMsgsWithSender(sender, msgSendPacket)
 	if err != nil {
@@ -56,7 +83,8 @@ func (endpoint *Endpoint) MsgSendPacketWithSender(timeoutTimestamp uint64, paylo
 	if err != nil {
 		return channeltypesv2.Packet{}, err
 	}
-	packet := channeltypesv2.NewPacket(sendResponse.Sequence, endpoint.ClientID, endpoint.Counterparty.ClientID, timeoutTimestamp, payload)
+	packet := channeltypesv2.NewPacket(sendResponse.Sequence, endpoint.ClientID, endpoint.Counterparty.ClientID, timeoutTimestamp, payloads...)
+	packet.PortSequence = sendResponse.PortSequence
 
 	return packet, nil
 }
@@ -103,3 +131,98 @@ func (endpoint *Endpoint) MsgTimeoutPacket(packet channeltypesv2.Packet) error {
 
 	return endpoint.Counterparty.UpdateClient()
 }
+
+// MsgTimeoutOrderedPacket sends a MsgTimeout on the associated endpoint for a packet sent to an ordered port,
+// proving the absence of the packet receipt along with the next sequence receive of the port on the counterparty.
+func (endpoint *Endpoint) MsgTimeoutOrderedPacket(packet channeltypesv2.Packet) error {
+	nextSequenceRecv, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ChannelKeeperV2.GetNextSequenceRecv(endpoint.Counterparty.Chain.GetContext(), packet.DestinationClient, packet.Payloads[0].DestinationPort)
+	require.True(endpoint.Chain.TB, found)
+
+	proof, proofHeight := endpoint.Counterparty.QueryMultiProof(
+		hostv2.PacketReceiptKey(packet.DestinationClient, packet.Sequence),
+		hostv2.NextSequenceRecvKey(packet.DestinationClient, packet.Payloads[0].DestinationPort),
+	)
+
+	msg := channeltypesv2.NewMsgTimeout(packet, proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String())
+	msg.NextSequenceRecv = nextSequenceRecv
+
+	if err := endpoint.Chain.sendMsgs(msg); err != nil {
+		return err
+	}
+
+	return endpoint.Counterparty.UpdateClient()
+}
+
+// MsgRecvPackets sends a MsgRecvPackets on the associated endpoint with the provided packets, proven by a single
+// multi-proof of their packet commitments.
+func (endpoint *Endpoint) MsgRecvPackets(packets ...channeltypesv2.Packet) error {
+	keys := make([][]byte, len(packets))
+	for i, packet := range packets {
+		keys[i] = hostv2.PacketCommitmentKey(packet.SourceClient, packet.Sequence)
+	}
+	proof, proofHeight := endpoint.Counterparty.QueryMultiProof(keys...)
+
+	msg := channeltypesv2.NewMsgRecvPackets(packets, proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String())
+
+	if err := endpoint.Chain.sendMsgs(msg); err != nil {
+		return err
+	}
+
+	return endpoint.Counterparty.UpdateClient()
+}
+
+// MsgAcknowledgePackets sends a MsgAcknowledgements on the associated endpoint with the provided packets and acks,
+// proven by a single multi-proof of their acknowledgement commitments.
+func (endpoint *Endpoint) MsgAcknowledgePackets(packets []channeltypesv2.Packet, acks []channeltypesv2.Acknowledgement) error {
+	keys := make([][]byte, len(packets))
+	for i, packet := range packets {
+		keys[i] = hostv2.PacketAcknowledgementKey(packet.DestinationClient, packet.Sequence)
+	}
+	proof, proofHeight := endpoint.Counterparty.QueryMultiProof(keys...)
+
+	msg := channeltypesv2.NewMsgAcknowledgements(packets, acks, proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String())
+
+	if err := endpoint.Chain.sendMsgs(msg); err != nil {
+		return err
+	}
+
+	return endpoint.Counterparty.UpdateClient()
+}
+
+// MsgTimeoutPackets sends a MsgTimeouts on the associated endpoint with the provided packets, proven by a single
+// multi-proof of the absence of their packet receipts.
+func (endpoint *Endpoint) MsgTimeoutPackets(packets ...channeltypesv2.Packet) error {
+	keys := make([][]byte, len(packets))
+	for i, packet := range packets {
+		keys[i] = hostv2.PacketReceiptKey(packet.DestinationClient, packet.Sequence)
+	}
+	proof, proofHeight := endpoint.Counterparty.QueryMultiProof(keys...)
+
+	msg := channeltypesv2.NewMsgTimeouts(packets, proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String())
+
+	if err := endpoint.Chain.sendMsgs(msg); err != nil {
+		return err
+	}
+
+	return endpoint.Counterparty.UpdateClient()
+}
+
+// QueryMultiProof queries the proofs of the provided keys associated with this endpoint at the latest height
+// of the counterparty client and combines them into a single multi-proof.
+func (endpoint *Endpoint) QueryMultiProof(keys ...[]byte) ([]byte, clienttypes.Height) {
+	var proofHeight clienttypes.Height
+	merkleProofs := make([]commitmenttypes.MerkleProof, len(keys))
+	for i, key := range keys {
+		var proof []byte
+		proof, proofHeight = endpoint.QueryProof(key)
+		require.NoError(endpoint.Chain.TB, endpoint.Chain.Codec.Unmarshal(proof, &merkleProofs[i]))
+	}
+
+	multiProof, err := commitmenttypes.CombineMerkleProofs(merkleProofs)
+	require.NoError(endpoint.Chain.TB, err)
+
+	proof, err := endpoint.Chain.Codec.Marshal(&multiProof)
+	require.NoError(endpoint.Chain.TB, err)
+
+	return proof, proofHeight
+}
@@ -0,0 +1,184 @@
+package nft
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	corestore "cosmossdk.io/core/store"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	nfttransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/nft-transfer/types"
+)
+
+// StoreKey is the store key string of the mock non-fungible token keeper
+const StoreKey = "mocknft"
+
+var (
+	_ nfttransfertypes.NFTKeeper = (*Keeper)(nil)
+
+	classKeyPrefix = []byte{0x01}
+	tokenKeyPrefix = []byte{0x02}
+)
+
+// class defines the stored metadata of a class.
+type class struct {
+	URI  string `json:"uri"`
+	Data string `json:"data"`
+}
+
+// token defines the stored metadata and owner of a non-fungible token.
+type token struct {
+	URI   string `json:"uri"`
+	Data  string `json:"data"`
+	Owner string `json:"owner"`
+}
+
+// Keeper is a minimal store backed implementation of the non-fungible token keeper expected by
+// the nft-transfer application. It is only intended for testing, applications should use the
+// keeper of the x/nft module.
+type Keeper struct {
+	storeService corestore.KVStoreService
+}
+
+// NewKeeper creates a new mock non-fungible token Keeper instance
+func NewKeeper(storeService corestore.KVStoreService) Keeper {
+	return Keeper{
+		storeService: storeService,
+	}
+}
+
+// SaveClass implements the NFTKeeper interface.
+func (k Keeper) SaveClass(ctx context.Context, classID, classURI, classData string) error {
+	if k.HasClass(ctx, classID) {
+		return fmt.Errorf("class %s already exists", classID)
+	}
+
+	k.set(ctx, classKey(classID), class{URI: classURI, Data: classData})
+	return nil
+}
+
+// HasClass implements the NFTKeeper interface.
+func (k Keeper) HasClass(ctx context.Context, classID string) bool {
+	has, err := k.storeService.OpenKVStore(ctx).Has(classKey(classID))
+	if err != nil {
+		panic(err)
+	}
+
+	return has
+}
+
+// GetClass implements the NFTKeeper interface.
+func (k Keeper) GetClass(ctx context.Context, classID string) (string, string, bool) {
+	var c class
+	if !k.get(ctx, classKey(classID), &c) {
+		return "", "", false
+	}
+
+	return c.URI, c.Data, true
+}
+
+// Mint implements the NFTKeeper interface.
+func (k Keeper) Mint(ctx context.Context, classID, tokenID, tokenURI, tokenData string, receiver sdk.AccAddress) error {
+	if !k.HasClass(ctx, classID) {
+		return fmt.Errorf("class %s does not exist", classID)
+	}
+
+	if k.get(ctx, tokenKey(classID, tokenID), &token{}) {
+		return fmt.Errorf("token %s of class %s already exists", tokenID, classID)
+	}
+
+	k.set(ctx, tokenKey(classID, tokenID), token{URI: tokenURI, Data: tokenData, Owner: receiver.String()})
+	return nil
+}
+
+// Burn implements the NFTKeeper interface.
+func (k Keeper) Burn(ctx context.Context, classID, tokenID string) error {
+	if !k.get(ctx, tokenKey(classID, tokenID), &token{}) {
+		return fmt.Errorf("token %s of class %s does not exist", tokenID, classID)
+	}
+
+	if err := k.storeService.OpenKVStore(ctx).Delete(tokenKey(classID, tokenID)); err != nil {
+		panic(err)
+	}
+
+	return nil
+}
+
+// Transfer implements the NFTKeeper interface.
+func (k Keeper) Transfer(ctx context.Context, classID, tokenID string, receiver sdk.AccAddress) error {
+	var t token
+	if !k.get(ctx, tokenKey(classID, tokenID), &t) {
+		return fmt.Errorf("token %s of class %s does not exist", tokenID, classID)
+	}
+
+	t.Owner = receiver.String()
+	k.set(ctx, tokenKey(classID, tokenID), t)
+	return nil
+}
+
+// GetOwner implements the NFTKeeper interface.
+func (k Keeper) GetOwner(ctx context.Context, classID, tokenID string) sdk.AccAddress {
+	var t token
+	if !k.get(ctx, tokenKey(classID, tokenID), &t) {
+		return nil
+	}
+
+	return sdk.MustAccAddressFromBech32(t.Owner)
+}
+
+// GetNFT implements the NFTKeeper interface.
+func (k Keeper) GetNFT(ctx context.Context, classID, tokenID string) (string, string, bool) {
+	var t token
+	if !k.get(ctx, tokenKey(classID, tokenID), &t) {
+		return "", "", false
+	}
+
+	return t.URI, t.Data, true
+}
+
+// get unmarshals the value stored under the key into v and returns false if the key does not exist.
+func (k Keeper) get(ctx context.Context, key []byte, v any) bool {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(key)
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return false
+	}
+
+	if err := json.Unmarshal(bz, v); err != nil {
+		panic(err)
+	}
+
+	return true
+}
+
+// set marshals v and stores it under the key.
+func (k Keeper) set(ctx context.Context, key []byte, v any) {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		panic(errors.New("cannot marshal non-fungible token metadata"))
+	}
+
+	if err := k.storeService.OpenKVStore(ctx).Set(key, bz); err != nil {
+		panic(err)
+	}
+}
+
+// classKey returns the store key of the class.
+func classKey(classID string) []byte {
+	key := append([]byte{}, classKeyPrefix...)
+	return append(key, classID...)
+}
+
+// tokenKey returns the store key of the token of the class. A zero byte separates the class ID
+// from the token ID as class IDs may contain slashes.
+func tokenKey(classID, tokenID string) []byte {
+	key := append([]byte{}, tokenKeyPrefix...)
+	key = append(key, classID...)
+	key = append(key, 0)
+	return append(key, tokenID...)
+}
@@ -87,10 +87,28 @@ import (
 	icacontroller "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller"
 	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
 	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+	icacontrollerv2 "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/v2"
 	icahost "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host"
 	icahostkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/keeper"
 	icahosttypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/types"
+	icahostv2 "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/v2"
 	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	ibcfee "github.com/cosmos/ibc-go/v10/modules/apps/29-fee"
+	ibcfeekeeper "github.com/cosmos/ibc-go/v10/modules/apps/29-fee/keeper"
+	ibcfeetypes "github.com/cosmos/ibc-go/v10/modules/apps/29-fee/types"
+	ibcfeev2 "github.com/cosmos/ibc-go/v10/modules/apps/29-fee/v2"
+	icq "github.com/cosmos/ibc-go/v10/modules/apps/async-icq"
+	icqkeeper "github.com/cosmos/ibc-go/v10/modules/apps/async-icq/keeper"
+	icqtypes "github.com/cosmos/ibc-go/v10/modules/apps/async-icq/types"
+	icqv2 "github.com/cosmos/ibc-go/v10/modules/apps/async-icq/v2"
+	nfttransfer "github.com/cosmos/ibc-go/v10/modules/apps/nft-transfer"
+	nfttransferkeeper "github.com/cosmos/ibc-go/v10/modules/apps/nft-transfer/keeper"
+	nfttransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/nft-transfer/types"
+	nfttransferv2 "github.com/cosmos/ibc-go/v10/modules/apps/nft-transfer/v2"
+	ratelimiting "github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting"
+	ratelimitingkeeper "github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/keeper"
+	ratelimitingtypes "github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/types"
+	ratelimitingv2 "github.com/cosmos/ibc-go/v10/modules/apps/rate-limiting/v2"
 	"github.com/cosmos/ibc-go/v10/modules/apps/transfer"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v10/modules/apps/transfer/keeper"
 	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
@@ -104,7 +122,10 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
 	solomachine "github.com/cosmos/ibc-go/v10/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+	ethereum "github.com/cosmos/ibc-go/v10/modules/light-clients/11-ethereum"
+	rollup "github.com/cosmos/ibc-go/v10/modules/light-clients/12-rollup"
 	ibcmock "github.com/cosmos/ibc-go/v10/testing/mock"
+	mocknft "github.com/cosmos/ibc-go/v10/testing/mock/nft"
 	mockv2 "github.com/cosmos/ibc-go/v10/testing/mock/v2"
 )
 
@@ -123,6 +144,7 @@ var (
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:            {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
+		ibcfeetypes.ModuleName:         nil,
 		icatypes.ModuleName:            nil,
 		ibcmock.ModuleName:             nil,
 	}
@@ -159,6 +181,11 @@ type SimApp struct {
 	ICAControllerKeeper   icacontrollerkeeper.Keeper
 	ICAHostKeeper         icahostkeeper.Keeper
 	TransferKeeper        ibctransferkeeper.Keeper
+	RateLimitKeeper       ratelimitingkeeper.Keeper
+	IBCFeeKeeper          ibcfeekeeper.Keeper
+	ICQKeeper             icqkeeper.Keeper
+	NFTTransferKeeper     nfttransferkeeper.Keeper
+	NFTKeeper             mocknft.Keeper
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
 
 	// make IBC modules public for test purposes
@@ -253,7 +280,8 @@ func NewSimApp(
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, group.StoreKey, paramstypes.StoreKey, ibcexported.StoreKey, upgradetypes.StoreKey,
 		ibctransfertypes.StoreKey, icacontrollertypes.StoreKey, icahosttypes.StoreKey,
-		authzkeeper.StoreKey, consensusparamtypes.StoreKey,
+		authzkeeper.StoreKey, consensusparamtypes.StoreKey, ratelimitingtypes.StoreKey,
+		ibcfeetypes.StoreKey, icqtypes.StoreKey, nfttransfertypes.StoreKey, mocknft.StoreKey,
 	)
 
 	// register streaming services
@@ -371,11 +399,49 @@ func NewSimApp(
 		appCodec, runtime.NewKVStoreService(keys[ibctransfertypes.StoreKey]), app.GetSubspace(ibctransfertypes.ModuleName),
 		app.IBCKeeper.ChannelKeeper,
 		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ClientV2Keeper,
 		app.MsgServiceRouter(),
 		app.AccountKeeper, app.BankKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	// Create RateLimit Keeper
+	app.RateLimitKeeper = ratelimitingkeeper.NewKeeper(
+		appCodec, runtime.NewKVStoreService(keys[ratelimitingtypes.StoreKey]),
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ClientKeeper,
+		app.BankKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	// Create IBC Fee Keeper
+	app.IBCFeeKeeper = ibcfeekeeper.NewKeeper(
+		appCodec, runtime.NewKVStoreService(keys[ibcfeetypes.StoreKey]),
+		app.IBCKeeper.ClientKeeper,
+		app.IBCKeeper.ChannelKeeperV2,
+		app.AccountKeeper, app.BankKeeper,
+	)
+
+	// Create Async ICQ Keeper
+	app.ICQKeeper = icqkeeper.NewKeeper(
+		appCodec, runtime.NewKVStoreService(keys[icqtypes.StoreKey]),
+		app.IBCKeeper.ChannelKeeper,
+		app.GRPCQueryRouter(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	// Create NFT Transfer Keeper
+	// NOTE: a store backed mock is used in place of the x/nft keeper
+	app.NFTKeeper = mocknft.NewKeeper(runtime.NewKVStoreService(keys[mocknft.StoreKey]))
+	app.NFTTransferKeeper = nfttransferkeeper.NewKeeper(
+		appCodec, runtime.NewKVStoreService(keys[nfttransfertypes.StoreKey]),
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		app.MsgServiceRouter(),
+		app.NFTKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	// Mock Module Stack
 
 	// Mock Module setup for testing IBC and also acts as the interchain accounts authentication module
@@ -397,13 +463,18 @@ func NewSimApp(
 
 	// Create Transfer Stack
 	// SendPacket, since it is originating from the application to core IBC:
-	// transferKeeper.SendPacket -> channel.SendPacket
+	// transferKeeper.SendPacket -> ratelimiting.SendPacket -> channel.SendPacket
 
 	// RecvPacket, message that originates from core IBC and goes down to app, the flow is the other way
-	// channel.RecvPacket -> transfer.OnRecvPacket
+	// channel.RecvPacket -> ratelimiting.OnRecvPacket -> transfer.OnRecvPacket
 
 	// create IBC module from bottom to top of stack
 	var transferStack porttypes.IBCModule = transfer.NewIBCModule(app.TransferKeeper)
+	rateLimitMiddleware := ratelimiting.NewIBCMiddleware(transferStack, app.IBCKeeper.ChannelKeeper, app.RateLimitKeeper)
+	transferStack = rateLimitMiddleware
+
+	// set the rate-limiting middleware as the ICS4Wrapper of the transfer keeper
+	app.TransferKeeper.WithICS4Wrapper(rateLimitMiddleware)
 
 	// Add transfer stack to IBC Router
 	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack)
@@ -433,6 +504,16 @@ func NewSimApp(
 		AddRoute(icahosttypes.SubModuleName, icaHostStack).
 		AddRoute(ibcmock.ModuleName+icacontrollertypes.SubModuleName, icaControllerStack) // ica with mock auth module stack route to ica (top level of middleware stack)
 
+	// Create Async ICQ Stack
+	// SendPacket: icqKeeper.SendInterchainQuery -> channel.SendPacket
+	// RecvPacket: channel.RecvPacket -> icq.OnRecvPacket
+	ibcRouter.AddRoute(icqtypes.PortID, icq.NewIBCModule(app.ICQKeeper))
+
+	// Create NFT Transfer Stack
+	// SendPacket: nftTransferKeeper.SendPacket -> channel.SendPacket
+	// RecvPacket: channel.RecvPacket -> nfttransfer.OnRecvPacket
+	ibcRouter.AddRoute(nfttransfertypes.PortID, nfttransfer.NewIBCModule(app.NFTTransferKeeper))
+
 	// create two separate mock v2 applications so that it is possible to test multi packet data.
 	mockV2A := mockv2.NewIBCModule()
 	ibcRouterV2.AddRoute(mockv2.PortIDA, mockV2A)
@@ -443,7 +524,22 @@ func NewSimApp(
 	app.MockModuleV2B = mockV2B
 
 	// register the transfer v2 module.
-	ibcRouterV2.AddRoute(ibctransfertypes.PortID, transferv2.NewIBCModule(app.TransferKeeper))
+	// channel.RecvPacket -> fee.OnRecvPacket -> ratelimiting.OnRecvPacket -> transfer.OnRecvPacket
+	// The fee middleware is used to write the acknowledgements of forwarded packets.
+	transferModuleV2 := transferv2.NewIBCModule(app.TransferKeeper)
+	feeTransferModuleV2 := ibcfeev2.NewIBCMiddleware(ratelimitingv2.NewIBCMiddleware(transferModuleV2, app.RateLimitKeeper), app.IBCFeeKeeper)
+	transferModuleV2.WithWriteAckWrapper(feeTransferModuleV2)
+	ibcRouterV2.AddRoute(ibctransfertypes.PortID, feeTransferModuleV2)
+
+	// register the interchain accounts v2 host and controller modules.
+	ibcRouterV2.AddRoute(icatypes.HostPortID, icahostv2.NewIBCModule(app.ICAHostKeeper))
+	ibcRouterV2.AddRoute(icatypes.ControllerPortID, icacontrollerv2.NewIBCModule(app.ICAControllerKeeper))
+
+	// register the async interchain queries v2 module.
+	ibcRouterV2.AddRoute(icqtypes.PortID, icqv2.NewIBCModule(app.ICQKeeper))
+
+	// register the nft transfer v2 module.
+	ibcRouterV2.AddRoute(nfttransfertypes.PortID, nfttransferv2.NewIBCModule(app.NFTTransferKeeper))
 
 	// Seal the IBC Router
 	app.IBCKeeper.SetRouter(ibcRouter)
@@ -458,6 +554,12 @@ func NewSimApp(
 	smLightClientModule := solomachine.NewLightClientModule(appCodec, storeProvider)
 	clientKeeper.AddRoute(solomachine.ModuleName, &smLightClientModule)
 
+	ethLightClientModule := ethereum.NewLightClientModule(appCodec, storeProvider)
+	clientKeeper.AddRoute(ethereum.ModuleName, &ethLightClientModule)
+
+	rollupLightClientModule := rollup.NewLightClientModule(appCodec, storeProvider, clientKeeper)
+	clientKeeper.AddRoute(rollup.ModuleName, &rollupLightClientModule)
+
 	// ****  Module Options ****
 
 	// NOTE: Any module instantiated in the module manager that is later modified
@@ -483,12 +585,18 @@ func NewSimApp(
 		// IBC modules
 		ibc.NewAppModule(app.IBCKeeper),
 		transfer.NewAppModule(app.TransferKeeper),
+		ratelimiting.NewAppModule(app.RateLimitKeeper),
+		ibcfee.NewAppModule(app.IBCFeeKeeper),
+		icq.NewAppModule(app.ICQKeeper),
+		nfttransfer.NewAppModule(app.NFTTransferKeeper),
 		ica.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper),
 		mockModule,
 
 		// IBC light clients
 		ibctm.NewAppModule(tmLightClientModule),
 		solomachine.NewAppModule(smLightClientModule),
+		ethereum.NewAppModule(ethLightClientModule),
+		rollup.NewAppModule(rollupLightClientModule),
 	)
 
 	// BasicModuleManager defines the module BasicManager is in charge of setting up basic,
@@ -525,6 +633,7 @@ func NewSimApp(
 		stakingtypes.ModuleName,
 		ibcexported.ModuleName,
 		ibctransfertypes.ModuleName,
+		ratelimitingtypes.ModuleName,
 		genutiltypes.ModuleName,
 		authz.ModuleName,
 		icatypes.ModuleName,
@@ -549,7 +658,7 @@ func NewSimApp(
 		banktypes.ModuleName, distrtypes.ModuleName, stakingtypes.ModuleName,
 		slashingtypes.ModuleName, govtypes.ModuleName, minttypes.ModuleName,
 		ibcexported.ModuleName, genutiltypes.ModuleName, authz.ModuleName, ibctransfertypes.ModuleName,
-		icatypes.ModuleName, ibcmock.ModuleName, paramstypes.ModuleName, upgradetypes.ModuleName,
+		ratelimitingtypes.ModuleName, ibcfeetypes.ModuleName, icqtypes.ModuleName, nfttransfertypes.ModuleName, icatypes.ModuleName, ibcmock.ModuleName, paramstypes.ModuleName, upgradetypes.ModuleName,
 		vestingtypes.ModuleName, group.ModuleName, consensusparamtypes.ModuleName,
 	}
 	app.ModuleManager.SetOrderInitGenesis(genesisModuleOrder...)
@@ -99,6 +99,46 @@ func GenerateKeys(t *testing.T, n uint64) ([]cryptotypes.PrivKey, []cryptotypes.
 	return privKeys, pubKeys, pk
 }
 
+// NewWeightedSolomachine returns a new solomachine instance with a weighted multisig public key
+// over len(weights) generated private/public key pairs and a sequence starting at 1. The signers
+// must hold a summed weight of at least threshold.
+func NewWeightedSolomachine(t *testing.T, cdc codec.BinaryCodec, clientID, diversifier string, threshold uint64, weights []uint64) *Solomachine {
+	t.Helper()
+	privKeys, pubKeys, pk := GenerateWeightedKeys(t, threshold, weights)
+
+	return &Solomachine{
+		t:           t,
+		cdc:         cdc,
+		ClientID:    clientID,
+		PrivateKeys: privKeys,
+		PublicKeys:  pubKeys,
+		PublicKey:   pk,
+		Sequence:    1,
+		Time:        10,
+		Diversifier: diversifier,
+	}
+}
+
+// GenerateWeightedKeys generates a new set of secp256k1 private keys and public keys, and
+// a weighted multisig public key which assigns the weight at each index to the public key at the
+// same index.
+func GenerateWeightedKeys(t *testing.T, threshold uint64, weights []uint64) ([]cryptotypes.PrivKey, []cryptotypes.PubKey, cryptotypes.PubKey) {
+	t.Helper()
+	require.NotEmpty(t, weights, "generation of zero keys is not allowed")
+
+	privKeys := make([]cryptotypes.PrivKey, len(weights))
+	pubKeys := make([]cryptotypes.PubKey, len(weights))
+	for i := range weights {
+		privKeys[i] = secp256k1.GenPrivKey()
+		pubKeys[i] = privKeys[i].PubKey()
+	}
+
+	pk, err := solomachine.NewWeightedMultisigPubKey(threshold, pubKeys, weights)
+	require.NoError(t, err)
+
+	return privKeys, pubKeys, pk
+}
+
 // ClientState returns a new solo machine ClientState instance.
 func (solo *Solomachine) ClientState() *solomachine.ClientState {
 	return solomachine.NewClientState(solo.Sequence, solo.ConsensusState())
@@ -152,7 +192,20 @@ func (solo *Solomachine) UpdateClient(chain *TestChain, clientID string) {
 // A new diversifier will be used as well
 func (solo *Solomachine) CreateHeader(newDiversifier string) *solomachine.Header {
 	// generate new private keys and signature for header
-	newPrivKeys, newPubKeys, newPubKey := GenerateKeys(solo.t, uint64(len(solo.PrivateKeys)))
+	var (
+		newPrivKeys []cryptotypes.PrivKey
+		newPubKeys  []cryptotypes.PubKey
+		newPubKey   cryptotypes.PubKey
+	)
+	if weightedPubKey, ok := solo.PublicKey.(*solomachine.WeightedMultisigPubKey); ok {
+		weights := make([]uint64, len(weightedPubKey.PublicKeys))
+		for i, weightedKey := range weightedPubKey.PublicKeys {
+			weights[i] = weightedKey.Weight
+		}
+		newPrivKeys, newPubKeys, newPubKey = GenerateWeightedKeys(solo.t, weightedPubKey.Threshold, weights)
+	} else {
+		newPrivKeys, newPubKeys, newPubKey = GenerateKeys(solo.t, uint64(len(solo.PrivateKeys)))
+	}
 
 	publicKey, err := codectypes.NewAnyWithValue(newPubKey)
 	require.NoError(solo.t, err)
@@ -447,7 +500,8 @@ func (solo *Solomachine) TimeoutPacketOnClose(chain *TestChain, packet channelty
 
 // GenerateSignature uses the stored private keys to generate a signature
 // over the sign bytes with each key. If the amount of keys is greater than
-// 1 then a multisig data type is returned.
+// 1 or the public key is a weighted multisig public key then a multisig data
+// type is returned.
 func (solo *Solomachine) GenerateSignature(signBytes []byte) []byte {
 	sigs := make([]signing.SignatureData, len(solo.PrivateKeys))
 	for i, key := range solo.PrivateKeys {
@@ -459,8 +513,10 @@ func (solo *Solomachine) GenerateSignature(signBytes []byte) []byte {
 		}
 	}
 
+	_, isWeighted := solo.PublicKey.(*solomachine.WeightedMultisigPubKey)
+
 	var sigData signing.SignatureData
-	if len(sigs) == 1 {
+	if len(sigs) == 1 && !isWeighted {
 		// single public key
 		sigData = sigs[0]
 	} else {